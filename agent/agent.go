@@ -94,8 +94,8 @@ type Options struct {
 }
 
 type Client interface {
-	ConnectRPC26(ctx context.Context) (
-		proto.DRPCAgentClient26, tailnetproto.DRPCTailnetClient26, error,
+	ConnectRPC27(ctx context.Context) (
+		proto.DRPCAgentClient27, tailnetproto.DRPCTailnetClient27, error,
 	)
 	tailnet.DERPMapRewriter
 	agentsdk.RefreshableSessionTokenProvider
@@ -175,6 +175,7 @@ func New(options Options) Agent {
 		lifecycleReported:                  make(chan codersdk.WorkspaceAgentLifecycle, 1),
 		lifecycleStates:                    []agentsdk.PostLifecycleRequest{{State: codersdk.WorkspaceAgentLifecycleCreated}},
 		reportConnectionsUpdate:            make(chan struct{}, 1),
+		sessionRecordingsUpdate:            make(chan struct{}, 1),
 		ignorePorts:                        options.IgnorePorts,
 		portCacheDuration:                  options.PortCacheDuration,
 		reportMetadataInterval:             options.ReportMetadataInterval,
@@ -260,6 +261,11 @@ type agent struct {
 	reportConnectionsMu     sync.Mutex
 	reportConnections       []*proto.ReportConnectionRequest
 
+	sessionRecordingsUpdate chan struct{}
+	sessionRecordingsMu     sync.Mutex // Protects following.
+	sessionRecordings       []*proto.UploadSessionRecordingRequest
+	sessionRecordingsSize   int
+
 	logSender *agentsdk.LogSender
 
 	prometheusRegistry *prometheus.Registry
@@ -306,6 +312,7 @@ func (a *agent) init() {
 
 			return a.reportConnection(id, connectionType, ip)
 		},
+		RecordSession: a.recordSession,
 
 		ExperimentalContainers: a.devcontainers,
 	})
@@ -348,6 +355,7 @@ func (a *agent) init() {
 		a.reconnectingPTYTimeout,
 		func(s *reconnectingpty.Server) {
 			s.ExperimentalContainers = a.devcontainers
+			s.RecordSession = a.recordSession
 		},
 	)
 	go a.runLoop()
@@ -463,7 +471,7 @@ func (t *trySingleflight) Do(key string, fn func()) {
 	fn()
 }
 
-func (a *agent) reportMetadata(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+func (a *agent) reportMetadata(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 	tickerDone := make(chan struct{})
 	collectDone := make(chan struct{})
 	ctx, cancel := context.WithCancel(ctx)
@@ -678,7 +686,7 @@ func (a *agent) reportMetadata(ctx context.Context, aAPI proto.DRPCAgentClient26
 
 // reportLifecycle reports the current lifecycle state once. All state
 // changes are reported in order.
-func (a *agent) reportLifecycle(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+func (a *agent) reportLifecycle(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 	for {
 		select {
 		case <-a.lifecycleUpdate:
@@ -758,7 +766,7 @@ func (a *agent) setLifecycle(state codersdk.WorkspaceAgentLifecycle) {
 }
 
 // reportConnectionsLoop reports connections to the agent for auditing.
-func (a *agent) reportConnectionsLoop(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+func (a *agent) reportConnectionsLoop(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 	for {
 		select {
 		case <-a.reportConnectionsUpdate:
@@ -886,10 +894,106 @@ func (a *agent) reportConnection(id uuid.UUID, connectionType proto.Connection_T
 	}
 }
 
+// recordSession starts a recording of the session with the given connection
+// ID if session recording is enabled for the workspace's template.
+func (a *agent) recordSession(id uuid.UUID, width, height int, term string) *agentssh.SessionRecorder {
+	manifest := a.manifest.Load()
+	if manifest == nil || !manifest.SessionRecording.Enabled {
+		return nil
+	}
+	return agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+		ConnectionID: id,
+		Width:        width,
+		Height:       height,
+		Term:         term,
+		MaxSizeBytes: manifest.SessionRecording.MaxSizeBytes,
+		Clock:        a.clock,
+		Upload:       a.uploadSessionRecordingChunk,
+	})
+}
+
+const (
+	// sessionRecordingBufferLimit limits the amount of recording data we
+	// buffer while waiting for it to be uploaded. Recordings that exceed the
+	// limit are truncated.
+	sessionRecordingBufferLimit = 16 << 20 // 16 MiB
+)
+
+func (a *agent) uploadSessionRecordingChunk(chunk agentssh.SessionRecordingChunk) error {
+	a.sessionRecordingsMu.Lock()
+	defer a.sessionRecordingsMu.Unlock()
+
+	// Chunks without data are always accepted so that recordings can be
+	// marked as truncated or completed.
+	if len(chunk.Data) > 0 && a.sessionRecordingsSize+len(chunk.Data) > sessionRecordingBufferLimit {
+		a.logger.Warn(a.hardCtx, "session recording buffer limit reached, truncating recording",
+			slog.F("limit", sessionRecordingBufferLimit),
+			slog.F("connection_id", chunk.ConnectionID),
+		)
+		return xerrors.New("session recording buffer limit reached")
+	}
+
+	a.sessionRecordings = append(a.sessionRecordings, &proto.UploadSessionRecordingRequest{
+		ConnectionId: chunk.ConnectionID[:],
+		Sequence:     chunk.Sequence,
+		Data:         chunk.Data,
+		Final:        chunk.Final,
+		Truncated:    chunk.Truncated,
+	})
+	a.sessionRecordingsSize += len(chunk.Data)
+	select {
+	case a.sessionRecordingsUpdate <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// uploadSessionRecordingsLoop uploads buffered session recording chunks.
+func (a *agent) uploadSessionRecordingsLoop(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
+	for {
+		select {
+		case <-a.sessionRecordingsUpdate:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		for {
+			a.sessionRecordingsMu.Lock()
+			if len(a.sessionRecordings) == 0 {
+				a.sessionRecordingsMu.Unlock()
+				break
+			}
+			payload := a.sessionRecordings[0]
+			// Release lock while we send the payload, this is safe
+			// since we only append to the slice.
+			a.sessionRecordingsMu.Unlock()
+
+			_, err := aAPI.UploadSessionRecording(ctx, payload)
+			if err != nil {
+				if ctx.Err() != nil {
+					// Keep the chunk, it is retried once we reconnect.
+					return ctx.Err()
+				}
+				a.logger.Warn(ctx, "failed to upload session recording chunk",
+					slog.F("sequence", payload.Sequence),
+					slog.Error(err),
+				)
+			}
+
+			// Remove the payload we sent.
+			a.sessionRecordingsMu.Lock()
+			a.sessionRecordingsSize -= len(payload.Data)
+			a.sessionRecordings[0] = nil // Release the pointer from the underlying array.
+			a.sessionRecordings = a.sessionRecordings[1:]
+			a.sessionRecordingsMu.Unlock()
+		}
+	}
+}
+
 // fetchServiceBannerLoop fetches the service banner on an interval.  It will
 // not be fetched immediately; the expectation is that it is primed elsewhere
 // (and must be done before the session actually starts).
-func (a *agent) fetchServiceBannerLoop(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+func (a *agent) fetchServiceBannerLoop(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 	ticker := time.NewTicker(a.announcementBannersRefreshInterval)
 	defer ticker.Stop()
 	for {
@@ -924,7 +1028,7 @@ func (a *agent) run() (retErr error) {
 	}
 
 	// ConnectRPC returns the dRPC connection we use for the Agent and Tailnet v2+ APIs
-	aAPI, tAPI, err := a.client.ConnectRPC26(a.hardCtx)
+	aAPI, tAPI, err := a.client.ConnectRPC27(a.hardCtx)
 	if err != nil {
 		return err
 	}
@@ -941,7 +1045,7 @@ func (a *agent) run() (retErr error) {
 	connMan := newAPIConnRoutineManager(a.gracefulCtx, a.hardCtx, a.logger, aAPI, tAPI)
 
 	connMan.startAgentAPI("init notification banners", gracefulShutdownBehaviorStop,
-		func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+		func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 			bannersProto, err := aAPI.GetAnnouncementBanners(ctx, &proto.GetAnnouncementBannersRequest{})
 			if err != nil {
 				return xerrors.Errorf("fetch service banner: %w", err)
@@ -958,7 +1062,7 @@ func (a *agent) run() (retErr error) {
 	// sending logs gets gracefulShutdownBehaviorRemain because we want to send logs generated by
 	// shutdown scripts.
 	connMan.startAgentAPI("send logs", gracefulShutdownBehaviorRemain,
-		func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+		func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 			err := a.logSender.SendLoop(ctx, aAPI)
 			if xerrors.Is(err, agentsdk.ErrLogLimitExceeded) {
 				// we don't want this error to tear down the API connection and propagate to the
@@ -977,7 +1081,7 @@ func (a *agent) run() (retErr error) {
 	connMan.startAgentAPI("report metadata", gracefulShutdownBehaviorStop, a.reportMetadata)
 
 	// resources monitor can cease as soon as we start gracefully shutting down.
-	connMan.startAgentAPI("resources monitor", gracefulShutdownBehaviorStop, func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+	connMan.startAgentAPI("resources monitor", gracefulShutdownBehaviorStop, func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 		logger := a.logger.Named("resources_monitor")
 		clk := quartz.NewReal()
 		config, err := aAPI.GetResourcesMonitoringConfiguration(ctx, &proto.GetResourcesMonitoringConfigurationRequest{})
@@ -1001,6 +1105,9 @@ func (a *agent) run() (retErr error) {
 	// Connection reports are part of auditing, we should keep sending them via
 	// gracefulShutdownBehaviorRemain.
 	connMan.startAgentAPI("report connections", gracefulShutdownBehaviorRemain, a.reportConnectionsLoop)
+	// Session recordings are linked to connection logs, so they are kept
+	// alive for the same reason.
+	connMan.startAgentAPI("upload session recordings", gracefulShutdownBehaviorRemain, a.uploadSessionRecordingsLoop)
 
 	// channels to sync goroutines below
 	//  handle manifest
@@ -1024,7 +1131,7 @@ func (a *agent) run() (retErr error) {
 	connMan.startAgentAPI("handle manifest", gracefulShutdownBehaviorStop, a.handleManifest(manifestOK))
 
 	connMan.startAgentAPI("app health reporter", gracefulShutdownBehaviorStop,
-		func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+		func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 			if err := manifestOK.wait(ctx); err != nil {
 				return xerrors.Errorf("no manifest: %w", err)
 			}
@@ -1057,7 +1164,7 @@ func (a *agent) run() (retErr error) {
 
 	connMan.startAgentAPI("fetch service banner loop", gracefulShutdownBehaviorStop, a.fetchServiceBannerLoop)
 
-	connMan.startAgentAPI("stats report loop", gracefulShutdownBehaviorStop, func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+	connMan.startAgentAPI("stats report loop", gracefulShutdownBehaviorStop, func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 		if err := networkOK.wait(ctx); err != nil {
 			return xerrors.Errorf("no network: %w", err)
 		}
@@ -1072,8 +1179,8 @@ func (a *agent) run() (retErr error) {
 }
 
 // handleManifest returns a function that fetches and processes the manifest
-func (a *agent) handleManifest(manifestOK *checkpoint) func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
-	return func(ctx context.Context, aAPI proto.DRPCAgentClient26) error {
+func (a *agent) handleManifest(manifestOK *checkpoint) func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
+	return func(ctx context.Context, aAPI proto.DRPCAgentClient27) error {
 		var (
 			sentResult = false
 			err        error
@@ -1236,7 +1343,7 @@ func (a *agent) handleManifest(manifestOK *checkpoint) func(ctx context.Context,
 
 func (a *agent) createDevcontainer(
 	ctx context.Context,
-	aAPI proto.DRPCAgentClient26,
+	aAPI proto.DRPCAgentClient27,
 	dc codersdk.WorkspaceAgentDevcontainer,
 	script codersdk.WorkspaceAgentScript,
 ) (err error) {
@@ -1268,8 +1375,8 @@ func (a *agent) createDevcontainer(
 
 // createOrUpdateNetwork waits for the manifest to be set using manifestOK, then creates or updates
 // the tailnet using the information in the manifest
-func (a *agent) createOrUpdateNetwork(manifestOK, networkOK *checkpoint) func(context.Context, proto.DRPCAgentClient26) error {
-	return func(ctx context.Context, aAPI proto.DRPCAgentClient26) (retErr error) {
+func (a *agent) createOrUpdateNetwork(manifestOK, networkOK *checkpoint) func(context.Context, proto.DRPCAgentClient27) error {
+	return func(ctx context.Context, aAPI proto.DRPCAgentClient27) (retErr error) {
 		if err := manifestOK.wait(ctx); err != nil {
 			return xerrors.Errorf("no manifest: %w", err)
 		}
@@ -2048,7 +2155,7 @@ const (
 
 type apiConnRoutineManager struct {
 	logger    slog.Logger
-	aAPI      proto.DRPCAgentClient26
+	aAPI      proto.DRPCAgentClient27
 	tAPI      tailnetproto.DRPCTailnetClient24
 	eg        *errgroup.Group
 	stopCtx   context.Context
@@ -2057,7 +2164,7 @@ type apiConnRoutineManager struct {
 
 func newAPIConnRoutineManager(
 	gracefulCtx, hardCtx context.Context, logger slog.Logger,
-	aAPI proto.DRPCAgentClient26, tAPI tailnetproto.DRPCTailnetClient24,
+	aAPI proto.DRPCAgentClient27, tAPI tailnetproto.DRPCTailnetClient24,
 ) *apiConnRoutineManager {
 	// routines that remain in operation during graceful shutdown use the remainCtx.  They'll still
 	// exit if the errgroup hits an error, which usually means a problem with the conn.
@@ -2090,7 +2197,7 @@ func newAPIConnRoutineManager(
 // but for Tailnet.
 func (a *apiConnRoutineManager) startAgentAPI(
 	name string, behavior gracefulShutdownBehavior,
-	f func(context.Context, proto.DRPCAgentClient26) error,
+	f func(context.Context, proto.DRPCAgentClient27) error,
 ) {
 	logger := a.logger.With(slog.F("name", name))
 	var ctx context.Context
@@ -512,6 +512,63 @@ func TestAgent_SessionTTYExitCode(t *testing.T) {
 	}
 }
 
+func TestAgent_SessionTTYRecording(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("ConPTY appears to be inconsistent on Windows.")
+	}
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	//nolint:dogsled
+	conn, agentClient, _, _, _ := setupAgent(t, agentsdk.Manifest{
+		SessionRecording: agentsdk.SessionRecordingConfig{
+			Enabled:      true,
+			MaxSizeBytes: 1 << 20,
+		},
+	}, 0)
+	sshClient, err := conn.SSHClient(ctx)
+	require.NoError(t, err)
+	defer sshClient.Close()
+	session, err := sshClient.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	err = session.RequestPty("xterm", 24, 80, ssh.TerminalModes{})
+	require.NoError(t, err)
+	ptty := ptytest.New(t)
+	session.Stdout = ptty.Output()
+	session.Stderr = ptty.Output()
+	session.Stdin = ptty.Input()
+	err = session.Start("echo recorded")
+	require.NoError(t, err)
+	ptty.ExpectMatch("recorded")
+	err = session.Wait()
+	require.NoError(t, err)
+
+	var chunks []*proto.UploadSessionRecordingRequest
+	require.Eventually(t, func() bool {
+		chunks = agentClient.GetSessionRecordingChunks()
+		return len(chunks) > 0 && chunks[len(chunks)-1].GetFinal()
+	}, testutil.WaitMedium, testutil.IntervalFast)
+
+	var recording bytes.Buffer
+	for i, chunk := range chunks {
+		require.EqualValues(t, i, chunk.GetSequence())
+		require.False(t, chunk.GetTruncated())
+		recording.Write(chunk.GetData())
+	}
+	header, events, _ := strings.Cut(recording.String(), "\n")
+	require.Contains(t, header, `"version":2`)
+	require.Contains(t, header, `"width":80`)
+	require.Contains(t, header, `"height":24`)
+	require.Contains(t, events, "recorded")
+
+	// The recording must be linked to the reported connection.
+	reports := agentClient.GetConnectionReports()
+	require.NotEmpty(t, reports)
+	require.Equal(t, reports[0].GetConnection().GetId(), chunks[0].GetConnectionId())
+}
+
 func TestAgent_Session_TTY_MOTD(t *testing.T) {
 	t.Parallel()
 	if runtime.GOOS == "windows" {
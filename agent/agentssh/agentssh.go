@@ -91,6 +91,9 @@ var BlockedFileTransferCommands = []string{"nc", "rsync", "scp", "sftp"}
 
 type reportConnectionFunc func(id uuid.UUID, sessionType MagicSessionType, ip string) (disconnected func(code int, reason string))
 
+// RecordSessionFunc starts a recording for a reported PTY session.
+type RecordSessionFunc func(id uuid.UUID, width, height int, term string) *SessionRecorder
+
 // Config sets configuration parameters for the agent SSH server.
 type Config struct {
 	// MaxTimeout sets the absolute connection timeout, none if empty. If set to
@@ -115,6 +118,9 @@ type Config struct {
 	BlockFileTransfer bool
 	// ReportConnection.
 	ReportConnection reportConnectionFunc
+	// RecordSession returns a recorder for the PTY session with the given
+	// connection ID, or nil if the session should not be recorded.
+	RecordSession RecordSessionFunc
 	// Experimental: allow connecting to running containers via Docker exec.
 	// Note that this is different from the devcontainers feature, which uses
 	// subagents.
@@ -422,7 +428,11 @@ func (s *Server) sessionHandler(session ssh.Session) {
 	}
 
 	closeCause := func(string) {}
+	// Only sessions that are reported can be recorded, since recordings are
+	// linked to their connection log entry.
+	recordID := uuid.Nil
 	if reportSession {
+		recordID = id
 		var reason string
 		closeCause = func(r string) { reason = r }
 
@@ -488,7 +498,7 @@ func (s *Server) sessionHandler(session ssh.Session) {
 		env = append(env, fmt.Sprintf("DISPLAY=localhost:%d.%d", display, x11.ScreenNumber))
 	}
 
-	err := s.sessionStart(logger, session, env, magicType, container, containerUser)
+	err := s.sessionStart(logger, session, recordID, env, magicType, container, containerUser)
 	var exitError *exec.ExitError
 	if xerrors.As(err, &exitError) {
 		code := exitError.ExitCode()
@@ -561,7 +571,7 @@ func (s *Server) fileTransferBlocked(session ssh.Session) bool {
 	return false
 }
 
-func (s *Server) sessionStart(logger slog.Logger, session ssh.Session, env []string, magicType MagicSessionType, container, containerUser string) (retErr error) {
+func (s *Server) sessionStart(logger slog.Logger, session ssh.Session, recordID uuid.UUID, env []string, magicType MagicSessionType, container, containerUser string) (retErr error) {
 	ctx := session.Context()
 
 	magicTypeLabel := magicTypeMetricLabel(magicType)
@@ -598,7 +608,7 @@ func (s *Server) sessionStart(logger slog.Logger, session ssh.Session, env []str
 	}
 
 	if isPty {
-		return s.startPTYSession(logger, session, magicTypeLabel, cmd, sshPty, windowSize)
+		return s.startPTYSession(logger, session, recordID, magicTypeLabel, cmd, sshPty, windowSize)
 	}
 	return s.startNonPTYSession(logger, session, magicTypeLabel, cmd.AsExec())
 }
@@ -672,7 +682,7 @@ type ptySession interface {
 	Signals(chan<- ssh.Signal)
 }
 
-func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTypeLabel string, cmd *pty.Cmd, sshPty ssh.Pty, windowSize <-chan ssh.Window) (retErr error) {
+func (s *Server) startPTYSession(logger slog.Logger, session ptySession, recordID uuid.UUID, magicTypeLabel string, cmd *pty.Cmd, sshPty ssh.Pty, windowSize <-chan ssh.Window) (retErr error) {
 	s.metrics.sessionsTotal.WithLabelValues(magicTypeLabel, "yes").Add(1)
 
 	ctx := session.Context()
@@ -704,6 +714,19 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 
 	cmd.Env = append(cmd.Env, fmt.Sprintf("TERM=%s", sshPty.Term))
 
+	var recorder *SessionRecorder
+	if recordID != uuid.Nil && s.config.RecordSession != nil {
+		recorder = s.config.RecordSession(recordID, sshPty.Window.Width, sshPty.Window.Height, sshPty.Term)
+	}
+	if recorder != nil {
+		defer func() {
+			closeErr := recorder.Close()
+			if closeErr != nil {
+				logger.Warn(ctx, "failed to close session recording", slog.Error(closeErr))
+			}
+		}()
+	}
+
 	// The pty package sets `SSH_TTY` on supported platforms.
 	ptty, process, err := pty.Start(cmd, pty.WithPTYOption(
 		pty.WithSSHRequest(sshPty),
@@ -747,6 +770,9 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 					windowSize = nil
 					continue
 				}
+				if recorder != nil {
+					recorder.Resize(win.Width, win.Height)
+				}
 				// #nosec G115 - Safe conversions for terminal dimensions which are expected to be within uint16 range
 				resizeErr := ptty.Resize(uint16(win.Height), uint16(win.Width))
 				// If the pty is closed, then command has exited, no need to log.
@@ -773,7 +799,11 @@ func (s *Server) startPTYSession(logger slog.Logger, session ptySession, magicTy
 	//    after we've Read() all the buffered data from the PTY.
 	// 2. The client hangs up, which cancels the command's Context, and go will
 	//    kill the command's process.  This then has the same effect as (1).
-	n, err := io.Copy(session, ptty.OutputReader())
+	var output io.Writer = session
+	if recorder != nil {
+		output = io.MultiWriter(session, recorder)
+	}
+	n, err := io.Copy(output, ptty.OutputReader())
 	logger.Debug(ctx, "copy output done", slog.F("bytes", n), slog.Error(err))
 	if err != nil {
 		s.metrics.sessionErrors.WithLabelValues(magicTypeLabel, "yes", "output_io_copy").Add(1)
@@ -0,0 +1,267 @@
+package agentssh
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/quartz"
+)
+
+const (
+	// DefaultSessionRecordingChunkSize is the size at which buffered
+	// recording data is handed off for upload.
+	DefaultSessionRecordingChunkSize = 64 * 1024
+	// DefaultSessionRecordingFlushInterval is the maximum amount of time
+	// output is buffered before being handed off for upload, provided the
+	// session keeps producing output.
+	DefaultSessionRecordingFlushInterval = 5 * time.Second
+)
+
+// SessionRecordingChunk is a piece of an asciicast v2 recording. Chunks of a
+// recording are numbered sequentially starting from zero and, concatenated in
+// order, form a valid asciicast file.
+type SessionRecordingChunk struct {
+	ConnectionID uuid.UUID
+	Sequence     int32
+	Data         []byte
+	// Final is set on the last chunk of a recording.
+	Final bool
+	// Truncated is set once output was dropped because the recording
+	// reached its maximum size.
+	Truncated bool
+}
+
+// SessionRecorderOptions configures a SessionRecorder.
+type SessionRecorderOptions struct {
+	// ConnectionID is the ID of the connection being recorded, as reported to
+	// coderd for connection logging.
+	ConnectionID uuid.UUID
+	Width        int
+	Height       int
+	Term         string
+	Shell        string
+	// MaxSizeBytes is the maximum size of the recording including the
+	// header. Zero means unlimited.
+	MaxSizeBytes  int64
+	ChunkSize     int
+	FlushInterval time.Duration
+	Clock         quartz.Clock
+	// Upload is called with each completed chunk, in order. It is called with
+	// the recorder lock held and must not block. If it returns an error, the
+	// recorder stops recording and the recording is marked as truncated.
+	Upload func(SessionRecordingChunk) error
+}
+
+// SessionRecorder captures terminal output in the asciicast v2 format. It is
+// safe for concurrent use.
+type SessionRecorder struct {
+	opts SessionRecorderOptions
+
+	mu        sync.Mutex
+	start     time.Time
+	lastFlush time.Time
+	buf       bytes.Buffer
+	// pending holds a trailing, incomplete UTF-8 sequence from the previous
+	// write so that multi-byte characters split across writes are encoded
+	// correctly.
+	pending   []byte
+	seq       int32
+	size      int64
+	truncated bool
+	stopped   bool
+	closed    bool
+}
+
+// asciicastHeader is the first line of an asciicast v2 file.
+// See https://docs.asciinema.org/manual/asciicast/v2/.
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// NewSessionRecorder starts a new recording and buffers its header.
+func NewSessionRecorder(opts SessionRecorderOptions) *SessionRecorder {
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultSessionRecordingChunkSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultSessionRecordingFlushInterval
+	}
+	if opts.Upload == nil {
+		opts.Upload = func(SessionRecordingChunk) error { return nil }
+	}
+
+	now := opts.Clock.Now()
+	r := &SessionRecorder{
+		opts:      opts,
+		start:     now,
+		lastFlush: now,
+	}
+
+	env := map[string]string{}
+	if opts.Term != "" {
+		env["TERM"] = opts.Term
+	}
+	if opts.Shell != "" {
+		env["SHELL"] = opts.Shell
+	}
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     opts.Width,
+		Height:    opts.Height,
+		Timestamp: now.Unix(),
+		Env:       env,
+	})
+	if err != nil {
+		// This can't happen, the header only contains basic types.
+		panic(xerrors.Errorf("marshal asciicast header: %w", err))
+	}
+	r.mu.Lock()
+	r.appendLocked(append(header, '\n'))
+	r.mu.Unlock()
+
+	return r
+}
+
+// Write records p as terminal output. It never fails so that it can be used
+// with io.MultiWriter without affecting the session.
+func (r *SessionRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped || len(p) == 0 {
+		return len(p), nil
+	}
+
+	data := append(r.pending, p...)
+	cut := completeUTF8Prefix(data)
+	r.pending = append([]byte(nil), data[cut:]...)
+	if cut == 0 {
+		return len(p), nil
+	}
+
+	r.eventLocked("o", string(data[:cut]))
+	return len(p), nil
+}
+
+// Resize records a terminal resize.
+func (r *SessionRecorder) Resize(width, height int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return
+	}
+	r.eventLocked("r", strconv.Itoa(width)+"x"+strconv.Itoa(height))
+}
+
+// Close flushes any buffered output and uploads the final chunk. Calling
+// Close more than once is a no-op.
+func (r *SessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if !r.stopped && len(r.pending) > 0 {
+		// Whatever is left is not valid UTF-8, let the encoder replace it.
+		r.eventLocked("o", string(r.pending))
+	}
+	r.pending = nil
+	r.stopped = true
+	r.flushLocked(true)
+	return nil
+}
+
+func (r *SessionRecorder) eventLocked(code string, data string) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		// This can't happen, strings always marshal.
+		return
+	}
+	elapsed := r.opts.Clock.Since(r.start).Seconds()
+
+	line := make([]byte, 0, len(encoded)+32)
+	line = append(line, '[')
+	line = strconv.AppendFloat(line, elapsed, 'f', 6, 64)
+	line = append(line, `, "`...)
+	line = append(line, code...)
+	line = append(line, `", `...)
+	line = append(line, encoded...)
+	line = append(line, "]\n"...)
+	r.appendLocked(line)
+}
+
+func (r *SessionRecorder) appendLocked(line []byte) {
+	if r.opts.MaxSizeBytes > 0 && r.size+int64(len(line)) > r.opts.MaxSizeBytes {
+		// Stop recording once the limit is reached, the rest of the session
+		// is dropped.
+		r.truncated = true
+		r.stopped = true
+		r.pending = nil
+		r.flushLocked(false)
+		return
+	}
+
+	r.size += int64(len(line))
+	r.buf.Write(line)
+	if r.buf.Len() >= r.opts.ChunkSize || r.opts.Clock.Since(r.lastFlush) >= r.opts.FlushInterval {
+		r.flushLocked(false)
+	}
+}
+
+func (r *SessionRecorder) flushLocked(final bool) {
+	if r.buf.Len() == 0 && !final && !r.truncated {
+		return
+	}
+	r.lastFlush = r.opts.Clock.Now()
+
+	chunk := SessionRecordingChunk{
+		ConnectionID: r.opts.ConnectionID,
+		Sequence:     r.seq,
+		Data:         bytes.Clone(r.buf.Bytes()),
+		Final:        final,
+		Truncated:    r.truncated,
+	}
+	r.buf.Reset()
+	r.seq++
+
+	err := r.opts.Upload(chunk)
+	if err != nil && !r.stopped {
+		r.truncated = true
+		r.stopped = true
+		r.pending = nil
+	}
+}
+
+// completeUTF8Prefix returns the length of the longest prefix of p that does
+// not end in an incomplete UTF-8 sequence.
+func completeUTF8Prefix(p []byte) int {
+	// A UTF-8 sequence is at most utf8.UTFMax bytes, so only the tail needs
+	// to be inspected.
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(p[i]) {
+			continue
+		}
+		if utf8.FullRune(p[i:]) {
+			return len(p)
+		}
+		return i
+	}
+	return len(p)
+}
@@ -0,0 +1,196 @@
+package agentssh_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agentssh"
+	"github.com/coder/quartz"
+)
+
+func TestSessionRecorder(t *testing.T) {
+	t.Parallel()
+
+	// recordingLines concatenates the chunks and splits the result into
+	// asciicast lines, asserting the sequence numbers are contiguous.
+	recordingLines := func(t *testing.T, chunks []agentssh.SessionRecordingChunk) [][]byte {
+		t.Helper()
+		var buf bytes.Buffer
+		for i, c := range chunks {
+			require.EqualValues(t, i, c.Sequence)
+			buf.Write(c.Data)
+		}
+		var lines [][]byte
+		s := bufio.NewScanner(&buf)
+		for s.Scan() {
+			lines = append(lines, bytes.Clone(s.Bytes()))
+		}
+		require.NoError(t, s.Err())
+		return lines
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var chunks []agentssh.SessionRecordingChunk
+		clock := quartz.NewMock(t)
+		id := uuid.New()
+		r := agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+			ConnectionID: id,
+			Width:        80,
+			Height:       24,
+			Term:         "xterm-256color",
+			Clock:        clock,
+			Upload: func(c agentssh.SessionRecordingChunk) error {
+				chunks = append(chunks, c)
+				return nil
+			},
+		})
+		clock.Advance(1500 * time.Millisecond)
+		_, err := r.Write([]byte("hello\r\n"))
+		require.NoError(t, err)
+		r.Resize(100, 30)
+		// Split a multi-byte character across two writes.
+		_, err = r.Write([]byte("caf\xc3"))
+		require.NoError(t, err)
+		_, err = r.Write([]byte("\xa9"))
+		require.NoError(t, err)
+		require.Empty(t, chunks, "output should be buffered")
+		require.NoError(t, r.Close())
+		require.NoError(t, r.Close())
+
+		require.Len(t, chunks, 1)
+		require.True(t, chunks[0].Final)
+		require.False(t, chunks[0].Truncated)
+		require.Equal(t, id, chunks[0].ConnectionID)
+
+		lines := recordingLines(t, chunks)
+		require.Len(t, lines, 5)
+		var header map[string]any
+		require.NoError(t, json.Unmarshal(lines[0], &header))
+		require.EqualValues(t, 2, header["version"])
+		require.EqualValues(t, 80, header["width"])
+		require.EqualValues(t, 24, header["height"])
+		require.Equal(t, map[string]any{"TERM": "xterm-256color"}, header["env"])
+		require.Equal(t, `[1.500000, "o", "hello\r\n"]`, string(lines[1]))
+		require.Equal(t, `[1.500000, "r", "100x30"]`, string(lines[2]))
+		require.Equal(t, `[1.500000, "o", "caf"]`, string(lines[3]))
+		require.Equal(t, `[1.500000, "o", "é"]`, string(lines[4]))
+	})
+
+	t.Run("ChunkSize", func(t *testing.T) {
+		t.Parallel()
+
+		var chunks []agentssh.SessionRecordingChunk
+		r := agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+			ConnectionID: uuid.New(),
+			ChunkSize:    64,
+			Clock:        quartz.NewMock(t),
+			Upload: func(c agentssh.SessionRecordingChunk) error {
+				chunks = append(chunks, c)
+				return nil
+			},
+		})
+		for range 10 {
+			_, err := r.Write(bytes.Repeat([]byte("a"), 40))
+			require.NoError(t, err)
+		}
+		require.NotEmpty(t, chunks)
+		require.NoError(t, r.Close())
+		require.Greater(t, len(chunks), 1)
+		require.True(t, chunks[len(chunks)-1].Final)
+		require.Len(t, recordingLines(t, chunks), 11)
+	})
+
+	t.Run("FlushInterval", func(t *testing.T) {
+		t.Parallel()
+
+		var chunks []agentssh.SessionRecordingChunk
+		clock := quartz.NewMock(t)
+		r := agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+			ConnectionID:  uuid.New(),
+			FlushInterval: time.Second,
+			Clock:         clock,
+			Upload: func(c agentssh.SessionRecordingChunk) error {
+				chunks = append(chunks, c)
+				return nil
+			},
+		})
+		_, err := r.Write([]byte("one"))
+		require.NoError(t, err)
+		require.Empty(t, chunks)
+		clock.Advance(2 * time.Second)
+		_, err = r.Write([]byte("two"))
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		require.False(t, chunks[0].Final)
+	})
+
+	t.Run("MaxSize", func(t *testing.T) {
+		t.Parallel()
+
+		var chunks []agentssh.SessionRecordingChunk
+		r := agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+			ConnectionID: uuid.New(),
+			MaxSizeBytes: 200,
+			Clock:        quartz.NewMock(t),
+			Upload: func(c agentssh.SessionRecordingChunk) error {
+				chunks = append(chunks, c)
+				return nil
+			},
+		})
+		for range 10 {
+			_, err := r.Write(bytes.Repeat([]byte("a"), 40))
+			require.NoError(t, err)
+		}
+		require.NoError(t, r.Close())
+
+		var size int
+		for _, c := range chunks {
+			size += len(c.Data)
+		}
+		require.LessOrEqual(t, size, 200)
+		last := chunks[len(chunks)-1]
+		require.True(t, last.Final)
+		require.True(t, last.Truncated)
+		// The recording must still be valid up to the truncation point.
+		for _, line := range recordingLines(t, chunks) {
+			require.True(t, json.Valid(line), string(line))
+		}
+	})
+
+	t.Run("UploadError", func(t *testing.T) {
+		t.Parallel()
+
+		var chunks []agentssh.SessionRecordingChunk
+		fail := true
+		r := agentssh.NewSessionRecorder(agentssh.SessionRecorderOptions{
+			ConnectionID: uuid.New(),
+			ChunkSize:    1,
+			Clock:        quartz.NewMock(t),
+			Upload: func(c agentssh.SessionRecordingChunk) error {
+				if fail {
+					fail = false
+					return assert.AnError
+				}
+				chunks = append(chunks, c)
+				return nil
+			},
+		})
+		_, err := r.Write([]byte("dropped"))
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		require.Len(t, chunks, 1)
+		require.True(t, chunks[0].Final)
+		require.True(t, chunks[0].Truncated)
+		require.Empty(t, chunks[0].Data)
+	})
+}
@@ -124,8 +124,8 @@ func (c *Client) Close() {
 	c.derpMapOnce.Do(func() { close(c.derpMapUpdates) })
 }
 
-func (c *Client) ConnectRPC26(ctx context.Context) (
-	agentproto.DRPCAgentClient26, proto.DRPCTailnetClient26, error,
+func (c *Client) ConnectRPC27(ctx context.Context) (
+	agentproto.DRPCAgentClient27, proto.DRPCTailnetClient27, error,
 ) {
 	conn, lis := drpcsdk.MemTransportPipe()
 	c.LastWorkspaceAgent = func() {
@@ -189,6 +189,10 @@ func (c *Client) GetConnectionReports() []*agentproto.ReportConnectionRequest {
 	return c.fakeAgentAPI.GetConnectionReports()
 }
 
+func (c *Client) GetSessionRecordingChunks() []*agentproto.UploadSessionRecordingRequest {
+	return c.fakeAgentAPI.GetSessionRecordingChunks()
+}
+
 func (c *Client) GetSubAgents() []*agentproto.SubAgent {
 	return c.fakeAgentAPI.GetSubAgents()
 }
@@ -219,6 +223,7 @@ type FakeAgentAPI struct {
 	metadata            map[string]agentsdk.Metadata
 	timings             []*agentproto.Timing
 	connectionReports   []*agentproto.ReportConnectionRequest
+	recordingChunks     []*agentproto.UploadSessionRecordingRequest
 	subAgents           map[uuid.UUID]*agentproto.SubAgent
 	subAgentDirs        map[uuid.UUID]string
 	subAgentDisplayApps map[uuid.UUID][]agentproto.CreateSubAgentRequest_DisplayApp
@@ -411,6 +416,20 @@ func (f *FakeAgentAPI) GetConnectionReports() []*agentproto.ReportConnectionRequ
 	return slices.Clone(f.connectionReports)
 }
 
+func (f *FakeAgentAPI) UploadSessionRecording(_ context.Context, req *agentproto.UploadSessionRecordingRequest) (*agentproto.UploadSessionRecordingResponse, error) {
+	f.Lock()
+	f.recordingChunks = append(f.recordingChunks, req)
+	f.Unlock()
+
+	return &agentproto.UploadSessionRecordingResponse{}, nil
+}
+
+func (f *FakeAgentAPI) GetSessionRecordingChunks() []*agentproto.UploadSessionRecordingRequest {
+	f.Lock()
+	defer f.Unlock()
+	return slices.Clone(f.recordingChunks)
+}
+
 func (f *FakeAgentAPI) CreateSubAgent(ctx context.Context, req *agentproto.CreateSubAgentRequest) (*agentproto.CreateSubAgentResponse, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -55,6 +55,7 @@ type DRPCAgentClient interface {
 	CreateSubAgent(ctx context.Context, in *CreateSubAgentRequest) (*CreateSubAgentResponse, error)
 	DeleteSubAgent(ctx context.Context, in *DeleteSubAgentRequest) (*DeleteSubAgentResponse, error)
 	ListSubAgents(ctx context.Context, in *ListSubAgentsRequest) (*ListSubAgentsResponse, error)
+	UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
 }
 
 type drpcAgentClient struct {
@@ -211,6 +212,15 @@ func (c *drpcAgentClient) ListSubAgents(ctx context.Context, in *ListSubAgentsRe
 	return out, nil
 }
 
+func (c *drpcAgentClient) UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error) {
+	out := new(UploadSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/coder.agent.v2.Agent/UploadSessionRecording", drpcEncoding_File_agent_proto_agent_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCAgentServer interface {
 	GetManifest(context.Context, *GetManifestRequest) (*Manifest, error)
 	GetServiceBanner(context.Context, *GetServiceBannerRequest) (*ServiceBanner, error)
@@ -228,6 +238,7 @@ type DRPCAgentServer interface {
 	CreateSubAgent(context.Context, *CreateSubAgentRequest) (*CreateSubAgentResponse, error)
 	DeleteSubAgent(context.Context, *DeleteSubAgentRequest) (*DeleteSubAgentResponse, error)
 	ListSubAgents(context.Context, *ListSubAgentsRequest) (*ListSubAgentsResponse, error)
+	UploadSessionRecording(context.Context, *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
 }
 
 type DRPCAgentUnimplementedServer struct{}
@@ -296,9 +307,13 @@ func (s *DRPCAgentUnimplementedServer) ListSubAgents(context.Context, *ListSubAg
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCAgentUnimplementedServer) UploadSessionRecording(context.Context, *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCAgentDescription struct{}
 
-func (DRPCAgentDescription) NumMethods() int { return 16 }
+func (DRPCAgentDescription) NumMethods() int { return 17 }
 
 func (DRPCAgentDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -446,6 +461,15 @@ func (DRPCAgentDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 						in1.(*ListSubAgentsRequest),
 					)
 			}, DRPCAgentServer.ListSubAgents, true
+	case 16:
+		return "/coder.agent.v2.Agent/UploadSessionRecording", drpcEncoding_File_agent_proto_agent_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAgentServer).
+					UploadSessionRecording(
+						ctx,
+						in1.(*UploadSessionRecordingRequest),
+					)
+			}, DRPCAgentServer.UploadSessionRecording, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -710,3 +734,19 @@ func (x *drpcAgent_ListSubAgentsStream) SendAndClose(m *ListSubAgentsResponse) e
 	}
 	return x.CloseSend()
 }
+
+type DRPCAgent_UploadSessionRecordingStream interface {
+	drpc.Stream
+	SendAndClose(*UploadSessionRecordingResponse) error
+}
+
+type drpcAgent_UploadSessionRecordingStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAgent_UploadSessionRecordingStream) SendAndClose(m *UploadSessionRecordingResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_agent_proto_agent_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -65,3 +65,10 @@ type DRPCAgentClient26 interface {
 	DeleteSubAgent(ctx context.Context, in *DeleteSubAgentRequest) (*DeleteSubAgentResponse, error)
 	ListSubAgents(ctx context.Context, in *ListSubAgentsRequest) (*ListSubAgentsResponse, error)
 }
+
+// DRPCAgentClient27 is the Agent API at v2.7. It adds the UploadSessionRecording
+// RPC and a SessionRecording field to the agent manifest.
+type DRPCAgentClient27 interface {
+	DRPCAgentClient26
+	UploadSessionRecording(ctx context.Context, in *UploadSessionRecordingRequest) (*UploadSessionRecordingResponse, error)
+}
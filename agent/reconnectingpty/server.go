@@ -35,6 +35,9 @@ type Server struct {
 	// Note that this is different from the devcontainers feature, which uses
 	// subagents.
 	ExperimentalContainers bool
+	// RecordSession returns a recorder for the connection with the given ID,
+	// or nil if the connection should not be recorded.
+	RecordSession agentssh.RecordSessionFunc
 }
 
 // NewServer returns a new ReconnectingPTY server
@@ -78,7 +81,8 @@ func (s *Server) Serve(ctx, hardCtx context.Context, l net.Listener) (retErr err
 			slog.F("local", conn.LocalAddr().String()))
 		clog.Info(ctx, "accepted conn")
 		wg.Add(1)
-		disconnected := s.reportConnection(uuid.New(), conn.RemoteAddr().String())
+		id := uuid.New()
+		disconnected := s.reportConnection(id, conn.RemoteAddr().String())
 		closed := make(chan struct{})
 		go func() {
 			defer wg.Done()
@@ -93,7 +97,7 @@ func (s *Server) Serve(ctx, hardCtx context.Context, l net.Listener) (retErr err
 		go func() {
 			defer close(closed)
 			defer wg.Done()
-			err := s.handleConn(ctx, clog, conn)
+			err := s.handleConn(ctx, clog, id, conn)
 			if err != nil {
 				if ctx.Err() != nil {
 					disconnected(1, "server shutting down")
@@ -113,7 +117,7 @@ func (s *Server) ConnCount() int64 {
 	return s.connCount.Load()
 }
 
-func (s *Server) handleConn(ctx context.Context, logger slog.Logger, conn net.Conn) (retErr error) {
+func (s *Server) handleConn(ctx context.Context, logger slog.Logger, id uuid.UUID, conn net.Conn) (retErr error) {
 	defer conn.Close()
 	s.connectionsTotal.Add(1)
 	s.connCount.Add(1)
@@ -232,5 +236,34 @@ func (s *Server) handleConn(ctx context.Context, logger slog.Logger, conn net.Co
 		connected = true
 		sendConnected <- rpty
 	}
+	if s.RecordSession != nil {
+		// Resizes are not recorded since they are sent in-band by the
+		// client, the recording uses the initial size.
+		recorder := s.RecordSession(id, int(msg.Width), int(msg.Height), "xterm-256color")
+		if recorder != nil {
+			defer func() {
+				closeErr := recorder.Close()
+				if closeErr != nil {
+					connLogger.Warn(ctx, "failed to close session recording", slog.Error(closeErr))
+				}
+			}()
+			conn = &recordedConn{Conn: conn, recorder: recorder}
+		}
+	}
 	return rpty.Attach(ctx, connectionID, conn, msg.Height, msg.Width, connLogger)
 }
+
+// recordedConn tees everything written to the connection, i.e. the output
+// of the PTY, to a session recorder.
+type recordedConn struct {
+	net.Conn
+	recorder *agentssh.SessionRecorder
+}
+
+func (c *recordedConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	if n > 0 {
+		_, _ = c.recorder.Write(p[:n])
+	}
+	return n, err
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -24,10 +25,17 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 		presetName          string
 		stdin               bool
 		quiet               bool
+		followUps           []string
+		completionCheck     string
+		fromFile            string
+		concurrency         int64
+		wait                bool
+
+		formatter = taskCreateFileFormatter()
 	)
 
 	cmd := &serpent.Command{
-		Use:   "create [input]",
+		Use:   "create [input | --from-file <file>]",
 		Short: "Create an experimental task",
 		Long: FormatExamples(
 			Example{
@@ -46,10 +54,18 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 				Description: "Create a task from a specific template / preset",
 				Command:     "coder exp task create --template backend-dev --preset \"My Preset\" \"Add authentication to the user service\"",
 			},
+			Example{
+				Description: "Create a task which sends follow-up prompts once the agent is idle, as long as the tests pass",
+				Command:     "coder exp task create --follow-up \"Run the tests and fix any failures\" --follow-up \"Open a pull request\" --completion-check \"make test\" \"Upgrade the logging dependency\"",
+			},
 			Example{
 				Description: "Create a task for another user (requires appropriate permissions)",
 				Command:     "coder exp task create --owner user@example.com \"Add authentication to the user service\"",
 			},
+			Example{
+				Description: "Create the tasks listed in a manifest file and wait for all of them to finish",
+				Command:     "coder exp task create --from-file tasks.yaml --wait",
+			},
 		),
 		Middleware: serpent.Chain(
 			serpent.RequireRangeArgs(0, 1),
@@ -96,6 +112,37 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 				Description: "Reads from stdin for the task input.",
 				Value:       serpent.BoolOf(&stdin),
 			},
+			{
+				Name:        "follow-up",
+				Flag:        "follow-up",
+				Description: "A prompt which is sent to the task once the agent reports the previous prompt idle. May be repeated to chain several prompts.",
+				Value:       serpent.StringArrayOf(&followUps),
+			},
+			{
+				Name:        "completion-check",
+				Flag:        "completion-check",
+				Description: "A command which is run in the workspace after each prompt completes. A non-zero exit code fails the task instead of sending the next prompt.",
+				Value:       serpent.StringOf(&completionCheck),
+			},
+			{
+				Name:        "from-file",
+				Flag:        "from-file",
+				Description: "Create the tasks listed in a YAML manifest file, or \"-\" to read it from stdin. Each entry in the file's \"tasks\" list may set a name, owner, template, template_version, preset, parameters and prompt. Other flags are used as defaults for the entries.",
+				Value:       serpent.StringOf(&fromFile),
+			},
+			{
+				Name:        "concurrency",
+				Flag:        "concurrency",
+				Description: "The number of tasks to create at once when using --from-file.",
+				Value:       serpent.Int64Of(&concurrency),
+				Default:     "4",
+			},
+			{
+				Name:        "wait",
+				Flag:        "wait",
+				Description: "Wait for the tasks created with --from-file to finish and report the outcome of each.",
+				Value:       serpent.BoolOf(&wait),
+			},
 			{
 				Name:          "quiet",
 				Flag:          "quiet",
@@ -124,6 +171,35 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 				return xerrors.Errorf("get current organization: %w", err)
 			}
 
+			if fromFile != "" {
+				if stdin || len(inv.Args) > 0 {
+					return xerrors.New("an input cannot be specified with --from-file")
+				}
+				if taskName != "" {
+					return xerrors.New("--name cannot be used with --from-file, set the name of each entry instead")
+				}
+
+				return taskCreateFromFile(inv, client, organization.ID, taskCreateFileOptions{
+					path:                fromFile,
+					concurrency:         concurrency,
+					wait:                wait,
+					owner:               ownerArg,
+					templateName:        templateName,
+					templateVersionName: templateVersionName,
+					presetName:          presetName,
+					followUps:           followUps,
+					completionCheck:     completionCheck,
+				}, formatter)
+			}
+			if wait {
+				return xerrors.New("--wait can only be used with --from-file")
+			}
+			for _, flag := range []string{"output", "column"} {
+				if inv.ParsedFlags().Changed(flag) {
+					return xerrors.Errorf("--%s can only be used with --from-file", flag)
+				}
+			}
+
 			if stdin {
 				bytes, err := io.ReadAll(inv.Stdin)
 				if err != nil {
@@ -143,77 +219,33 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 				return xerrors.Errorf("a task cannot be started with an empty input")
 			}
 
-			switch {
-			case templateName == "":
-				templates, err := client.Templates(ctx, codersdk.TemplateFilter{SearchQuery: "has-ai-task:true", OrganizationID: organization.ID})
-				if err != nil {
-					return xerrors.Errorf("list templates: %w", err)
-				}
-
-				if len(templates) == 0 {
-					return xerrors.Errorf("no task templates configured")
-				}
-
-				// When a deployment has only 1 AI task template, we will
-				// allow omitting the template. Otherwise we will require
-				// the user to be explicit with their choice of template.
-				if len(templates) > 1 {
-					templateNames := make([]string, 0, len(templates))
-					for _, template := range templates {
-						templateNames = append(templateNames, template.Name)
-					}
-
-					return xerrors.Errorf("template name not provided, available templates: %s", strings.Join(templateNames, ", "))
-				}
-
-				if templateVersionName != "" {
-					templateVersion, err := client.TemplateVersionByOrganizationAndName(ctx, organization.ID, templates[0].Name, templateVersionName)
-					if err != nil {
-						return xerrors.Errorf("get template version: %w", err)
-					}
-
-					templateVersionID = templateVersion.ID
-				} else {
-					templateVersionID = templates[0].ActiveVersionID
-				}
-
-			case templateVersionName != "":
-				templateVersion, err := client.TemplateVersionByOrganizationAndName(ctx, organization.ID, templateName, templateVersionName)
-				if err != nil {
-					return xerrors.Errorf("get template version: %w", err)
-				}
-
-				templateVersionID = templateVersion.ID
-
-			default:
-				template, err := client.TemplateByName(ctx, organization.ID, templateName)
-				if err != nil {
-					return xerrors.Errorf("get template: %w", err)
-				}
-
-				templateVersionID = template.ActiveVersionID
+			templateVersionID, err = resolveTaskTemplateVersion(ctx, client, organization.ID, templateName, templateVersionName)
+			if err != nil {
+				return err
 			}
 
-			if presetName != PresetNone {
-				templatePresets, err := client.TemplateVersionPresets(ctx, templateVersionID)
-				if err != nil {
-					return xerrors.Errorf("get template presets: %w", err)
-				}
-
-				preset, err := resolvePreset(templatePresets, presetName)
-				if err != nil {
-					return xerrors.Errorf("resolve preset: %w", err)
-				}
-
-				templateVersionPresetID = preset.ID
+			templateVersionPresetID, err = resolveTaskPreset(ctx, client, templateVersionID, presetName)
+			if err != nil {
+				return err
 			}
 
-			task, err := expClient.CreateTask(ctx, ownerArg, codersdk.CreateTaskRequest{
+			req := codersdk.CreateTaskRequest{
 				Name:                    taskName,
 				TemplateVersionID:       templateVersionID,
 				TemplateVersionPresetID: templateVersionPresetID,
 				Input:                   taskInput,
-			})
+			}
+			if len(followUps) > 0 || completionCheck != "" {
+				req.Input = ""
+				for _, prompt := range append([]string{taskInput}, followUps...) {
+					req.Steps = append(req.Steps, codersdk.CreateTaskStep{
+						Prompt:          prompt,
+						CompletionCheck: completionCheck,
+					})
+				}
+			}
+
+			task, err := expClient.CreateTask(ctx, ownerArg, req)
 			if err != nil {
 				return xerrors.Errorf("create task: %w", err)
 			}
@@ -233,5 +265,89 @@ func (r *RootCmd) taskCreate() *serpent.Command {
 		},
 	}
 	orgContext.AttachOptions(cmd)
+
+	// The output of a single task is not formatted, so the formatter's
+	// options only apply to the results of --from-file.
+	var formatterOptions serpent.OptionSet
+	formatter.AttachOptions(&formatterOptions)
+	for i := range formatterOptions {
+		formatterOptions[i].Description = strings.TrimSuffix(formatterOptions[i].Description, ".") + " of the --from-file results."
+	}
+	cmd.Options = append(cmd.Options, formatterOptions...)
 	return cmd
 }
+
+// resolveTaskTemplateVersion returns the ID of the template version to create
+// a task from. The template may be omitted when there is only one task
+// template in the organization.
+func resolveTaskTemplateVersion(ctx context.Context, client *codersdk.Client, organizationID uuid.UUID, templateName, templateVersionName string) (uuid.UUID, error) {
+	switch {
+	case templateName == "":
+		templates, err := client.Templates(ctx, codersdk.TemplateFilter{SearchQuery: "has-ai-task:true", OrganizationID: organizationID})
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("list templates: %w", err)
+		}
+
+		if len(templates) == 0 {
+			return uuid.Nil, xerrors.Errorf("no task templates configured")
+		}
+
+		// When a deployment has only 1 AI task template, we will
+		// allow omitting the template. Otherwise we will require
+		// the user to be explicit with their choice of template.
+		if len(templates) > 1 {
+			templateNames := make([]string, 0, len(templates))
+			for _, template := range templates {
+				templateNames = append(templateNames, template.Name)
+			}
+
+			return uuid.Nil, xerrors.Errorf("template name not provided, available templates: %s", strings.Join(templateNames, ", "))
+		}
+
+		if templateVersionName != "" {
+			templateVersion, err := client.TemplateVersionByOrganizationAndName(ctx, organizationID, templates[0].Name, templateVersionName)
+			if err != nil {
+				return uuid.Nil, xerrors.Errorf("get template version: %w", err)
+			}
+
+			return templateVersion.ID, nil
+		}
+
+		return templates[0].ActiveVersionID, nil
+
+	case templateVersionName != "":
+		templateVersion, err := client.TemplateVersionByOrganizationAndName(ctx, organizationID, templateName, templateVersionName)
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("get template version: %w", err)
+		}
+
+		return templateVersion.ID, nil
+
+	default:
+		template, err := client.TemplateByName(ctx, organizationID, templateName)
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("get template: %w", err)
+		}
+
+		return template.ActiveVersionID, nil
+	}
+}
+
+// resolveTaskPreset returns the ID of the named preset of a template version,
+// or uuid.Nil if no preset is selected.
+func resolveTaskPreset(ctx context.Context, client *codersdk.Client, templateVersionID uuid.UUID, presetName string) (uuid.UUID, error) {
+	if presetName != PresetNone {
+		templatePresets, err := client.TemplateVersionPresets(ctx, templateVersionID)
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("get template presets: %w", err)
+		}
+
+		preset, err := resolvePreset(templatePresets, presetName)
+		if err != nil {
+			return uuid.Nil, xerrors.Errorf("resolve preset: %w", err)
+		}
+
+		return preset.ID, nil
+	}
+	return uuid.Nil, nil
+}
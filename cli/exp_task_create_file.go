@@ -0,0 +1,386 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+// taskManifest is the file read by `coder exp task create --from-file`.
+type taskManifest struct {
+	Tasks []taskManifestEntry `yaml:"tasks"`
+}
+
+// taskManifestEntry describes a single task to create. Fields which are
+// omitted fall back to the flags passed to the command.
+type taskManifestEntry struct {
+	Name            string                 `yaml:"name"`
+	Owner           string                 `yaml:"owner"`
+	Template        string                 `yaml:"template"`
+	TemplateVersion string                 `yaml:"template_version"`
+	Preset          string                 `yaml:"preset"`
+	Parameters      map[string]interface{} `yaml:"parameters"`
+	Prompt          string                 `yaml:"prompt"`
+}
+
+// taskCreateFileOptions are the flags of `coder exp task create` which apply
+// when creating tasks from a manifest.
+type taskCreateFileOptions struct {
+	path                string
+	concurrency         int64
+	wait                bool
+	owner               string
+	templateName        string
+	templateVersionName string
+	presetName          string
+	followUps           []string
+	completionCheck     string
+}
+
+// taskCreateResult is the outcome of a single manifest entry.
+type taskCreateResult struct {
+	Entry    int    `json:"entry" table:"entry,default_sort"`
+	Name     string `json:"name" table:"name"`
+	TaskID   string `json:"task_id,omitempty" table:"task id"`
+	Owner    string `json:"owner" table:"owner"`
+	Template string `json:"template" table:"template"`
+	// Status is either "created" or "failed", depending on whether the task
+	// could be created.
+	Status string `json:"status" table:"status"`
+	// Outcome is the final state of the task when waiting for it.
+	Outcome string `json:"outcome,omitempty" table:"outcome"`
+	Error   string `json:"error,omitempty" table:"error"`
+}
+
+// taskCreateSummary is the JSON output of `coder exp task create
+// --from-file`.
+type taskCreateSummary struct {
+	Total   int                `json:"total"`
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	Results []taskCreateResult `json:"results"`
+}
+
+func taskCreateFileFormatter() *cliui.OutputFormatter {
+	return cliui.NewOutputFormatter(
+		cliui.TableFormat(
+			[]taskCreateResult{},
+			[]string{
+				"entry",
+				"name",
+				"task id",
+				"template",
+				"status",
+				"outcome",
+				"error",
+			},
+		),
+		cliui.ChangeFormatterData(
+			cliui.JSONFormat(),
+			func(data any) (any, error) {
+				results, ok := data.([]taskCreateResult)
+				if !ok {
+					return nil, xerrors.Errorf("expected []taskCreateResult, got %T", data)
+				}
+				return summarizeTaskCreateResults(results), nil
+			},
+		),
+	)
+}
+
+func summarizeTaskCreateResults(results []taskCreateResult) taskCreateSummary {
+	summary := taskCreateSummary{
+		Total:   len(results),
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Status == "created" {
+			summary.Created++
+		}
+		if result.Error != "" {
+			summary.Failed++
+		}
+	}
+	return summary
+}
+
+func taskCreateFromFile(inv *serpent.Invocation, client *codersdk.Client, organizationID uuid.UUID, opts taskCreateFileOptions, formatter *cliui.OutputFormatter) error {
+	var (
+		ctx       = inv.Context()
+		expClient = codersdk.NewExperimentalClient(client)
+	)
+
+	if opts.concurrency < 1 {
+		return xerrors.New("--concurrency must be at least 1")
+	}
+
+	manifest, err := readTaskManifest(inv, opts.path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		results = make([]taskCreateResult, len(manifest.Tasks))
+		tasks   = make([]*codersdk.Task, len(manifest.Tasks))
+
+		// Progress is written from several goroutines.
+		stderrMu sync.Mutex
+		progress = func(format string, args ...any) {
+			stderrMu.Lock()
+			defer stderrMu.Unlock()
+			_, _ = fmt.Fprintf(inv.Stderr, format+"\n", args...)
+		}
+	)
+
+	// Entries are created independently, so a failure to create one of them
+	// is recorded in its result rather than aborting the batch.
+	var eg errgroup.Group
+	eg.SetLimit(int(opts.concurrency))
+	for i, entry := range manifest.Tasks {
+		results[i] = taskCreateResult{
+			Entry:    i + 1,
+			Name:     entry.Name,
+			Owner:    entry.Owner,
+			Template: entry.Template,
+		}
+		if results[i].Owner == "" {
+			results[i].Owner = opts.owner
+		}
+		if results[i].Template == "" {
+			results[i].Template = opts.templateName
+		}
+
+		eg.Go(func() error {
+			task, err := createTaskFromManifestEntry(ctx, client, expClient, organizationID, entry, opts)
+			if err != nil {
+				results[i].Status = "failed"
+				results[i].Error = err.Error()
+				progress("Failed to create entry %d: %s", i+1, err)
+				return nil
+			}
+
+			tasks[i] = &task
+			results[i].Status = "created"
+			results[i].Name = task.Name
+			results[i].TaskID = task.ID.String()
+			results[i].Owner = task.OwnerName
+			results[i].Template = task.TemplateName
+			progress("Created task %s for entry %d.", task.Name, i+1)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	if opts.wait {
+		var wg sync.WaitGroup
+		for i, task := range tasks {
+			if task == nil {
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				outcome, err := waitForTaskOutcome(ctx, expClient, *task)
+				results[i].Outcome = outcome
+				if err != nil {
+					results[i].Error = err.Error()
+					progress("Task %s failed: %s", task.Name, err)
+					return
+				}
+				progress("Task %s finished: %s", task.Name, outcome)
+			}()
+		}
+		wg.Wait()
+	}
+
+	out, err := formatter.Format(ctx, results)
+	if err != nil {
+		return xerrors.Errorf("format results: %w", err)
+	}
+	_, _ = fmt.Fprintln(inv.Stdout, out)
+
+	summary := summarizeTaskCreateResults(results)
+	if summary.Failed > 0 {
+		return xerrors.Errorf("%d of %d tasks failed", summary.Failed, summary.Total)
+	}
+	return nil
+}
+
+func readTaskManifest(inv *serpent.Invocation, path string) (taskManifest, error) {
+	var (
+		contents []byte
+		err      error
+	)
+	if path == "-" {
+		contents, err = io.ReadAll(inv.Stdin)
+	} else {
+		contents, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return taskManifest{}, xerrors.Errorf("read task manifest: %w", err)
+	}
+
+	var manifest taskManifest
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil && !xerrors.Is(err, io.EOF) {
+		return taskManifest{}, xerrors.Errorf("parse task manifest: %w", err)
+	}
+	if len(manifest.Tasks) == 0 {
+		return taskManifest{}, xerrors.New("task manifest does not contain any tasks")
+	}
+	return manifest, nil
+}
+
+func createTaskFromManifestEntry(ctx context.Context, client *codersdk.Client, expClient *codersdk.ExperimentalClient, organizationID uuid.UUID, entry taskManifestEntry, opts taskCreateFileOptions) (codersdk.Task, error) {
+	if entry.Prompt == "" {
+		return codersdk.Task{}, xerrors.New("a task cannot be started with an empty prompt")
+	}
+
+	owner := entry.Owner
+	if owner == "" {
+		owner = opts.owner
+	}
+
+	// The template version and preset flags only make sense for the
+	// template selected by the flags, so they are only used as defaults
+	// when the entry doesn't select a template of its own.
+	templateName, templateVersionName, presetName := entry.Template, entry.TemplateVersion, entry.Preset
+	if templateName == "" {
+		templateName = opts.templateName
+		if templateVersionName == "" {
+			templateVersionName = opts.templateVersionName
+		}
+		if presetName == "" {
+			presetName = opts.presetName
+		}
+	}
+	if presetName == "" {
+		presetName = PresetNone
+	}
+
+	parameters, err := parameterMapFromValues(entry.Parameters)
+	if err != nil {
+		return codersdk.Task{}, xerrors.Errorf("parse parameters: %w", err)
+	}
+
+	templateVersionID, err := resolveTaskTemplateVersion(ctx, client, organizationID, templateName, templateVersionName)
+	if err != nil {
+		return codersdk.Task{}, err
+	}
+
+	templateVersionPresetID, err := resolveTaskPreset(ctx, client, templateVersionID, presetName)
+	if err != nil {
+		return codersdk.Task{}, err
+	}
+
+	req := codersdk.CreateTaskRequest{
+		Name:                    entry.Name,
+		TemplateVersionID:       templateVersionID,
+		TemplateVersionPresetID: templateVersionPresetID,
+		Input:                   entry.Prompt,
+	}
+	for name, value := range parameters {
+		req.RichParameterValues = append(req.RichParameterValues, codersdk.WorkspaceBuildParameter{
+			Name:  name,
+			Value: value,
+		})
+	}
+	sort.Slice(req.RichParameterValues, func(i, j int) bool {
+		return req.RichParameterValues[i].Name < req.RichParameterValues[j].Name
+	})
+	if len(opts.followUps) > 0 || opts.completionCheck != "" {
+		req.Input = ""
+		for _, prompt := range append([]string{entry.Prompt}, opts.followUps...) {
+			req.Steps = append(req.Steps, codersdk.CreateTaskStep{
+				Prompt:          prompt,
+				CompletionCheck: opts.completionCheck,
+			})
+		}
+	}
+
+	task, err := expClient.CreateTask(ctx, owner, req)
+	if err != nil {
+		return codersdk.Task{}, xerrors.Errorf("create task: %w", err)
+	}
+	return task, nil
+}
+
+// waitForTaskOutcome watches a task until it has finished, returning its
+// outcome. An error is returned if the task did not finish successfully.
+func waitForTaskOutcome(ctx context.Context, expClient *codersdk.ExperimentalClient, task codersdk.Task) (string, error) {
+	// Closes the event stream once the outcome is known.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := expClient.WatchTask(ctx, task.OwnerName, task.ID)
+	if err != nil {
+		return "", xerrors.Errorf("watch task: %w", err)
+	}
+
+	for event := range events {
+		if event.Task == nil {
+			continue
+		}
+		if outcome, done, err := taskCreateOutcome(*event.Task); done {
+			return outcome, err
+		}
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+	// The stream is closed by the server once the task is deleted.
+	return string(codersdk.WorkspaceStatusDeleted), xerrors.New("task was deleted")
+}
+
+// taskCreateOutcome reports whether the task has finished and, if so, its
+// outcome. Multi-step tasks finish once all of their steps have completed,
+// other tasks once the agent has reported that it is no longer working.
+func taskCreateOutcome(task codersdk.Task) (outcome string, done bool, err error) {
+	switch task.Status {
+	case codersdk.WorkspaceStatusFailed, codersdk.WorkspaceStatusCanceled:
+		return string(task.Status), true, xerrors.Errorf("workspace build %s", task.Status)
+	case codersdk.WorkspaceStatusDeleted:
+		return string(task.Status), true, xerrors.New("task was deleted")
+	}
+
+	if len(task.Steps) > 0 {
+		for _, step := range task.Steps {
+			if step.Status == codersdk.TaskStepStatusFailed {
+				return string(codersdk.TaskStepStatusFailed), true, xerrors.Errorf("step %d failed", step.Index+1)
+			}
+		}
+		if !slices.ContainsFunc(task.Steps, func(step codersdk.TaskStep) bool {
+			return step.Status != codersdk.TaskStepStatusCompleted
+		}) {
+			return string(codersdk.TaskStepStatusCompleted), true, nil
+		}
+	}
+
+	if task.Status == codersdk.WorkspaceStatusStopped {
+		return string(task.Status), true, nil
+	}
+	if len(task.Steps) > 0 || !taskWatchIsEnded(task) {
+		return "", false, nil
+	}
+	if task.CurrentState.State == codersdk.TaskStateFailed {
+		return string(task.CurrentState.State), true, xerrors.Errorf("agent reported failure: %s", task.CurrentState.Message)
+	}
+	return string(task.CurrentState.State), true, nil
+}
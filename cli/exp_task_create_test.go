@@ -2,19 +2,24 @@ package cli_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/coder/coder/v2/cli/clitest"
 	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/util/ptr"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/testutil"
 	"github.com/coder/serpent"
@@ -190,6 +195,50 @@ func TestTaskCreate(t *testing.T) {
 				return templateAndVersionFoundHandler(t, ctx, organizationID, "my-template", "my-template-version", "", "my custom prompt", "task-wild-goldfish-27", codersdk.Me)
 			},
 		},
+		{
+			args:         []string{"upgrade the dependency", "--follow-up", "run the tests", "--follow-up", "open a pull request", "--completion-check", "make test"},
+			expectOutput: fmt.Sprintf("The task %s has been created at %s!", cliui.Keyword("task-wild-goldfish-27"), cliui.Timestamp(taskCreatedAt)),
+			handler: func(t *testing.T, ctx context.Context) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/api/v2/users/me/organizations":
+						httpapi.Write(ctx, w, http.StatusOK, []codersdk.Organization{
+							{MinimalOrganization: codersdk.MinimalOrganization{
+								ID: organizationID,
+							}},
+						})
+					case "/api/v2/templates":
+						httpapi.Write(ctx, w, http.StatusOK, []codersdk.Template{
+							{
+								ID:              templateID,
+								Name:            "my-template",
+								ActiveVersionID: templateVersionID,
+							},
+						})
+					case fmt.Sprintf("/api/experimental/tasks/%s", codersdk.Me):
+						var req codersdk.CreateTaskRequest
+						if !httpapi.Read(ctx, w, r, &req) {
+							return
+						}
+
+						assert.Empty(t, req.Input, "expected prompts to be given as steps")
+						assert.Equal(t, []codersdk.CreateTaskStep{
+							{Prompt: "upgrade the dependency", CompletionCheck: "make test"},
+							{Prompt: "run the tests", CompletionCheck: "make test"},
+							{Prompt: "open a pull request", CompletionCheck: "make test"},
+						}, req.Steps)
+
+						httpapi.Write(ctx, w, http.StatusCreated, codersdk.Task{
+							ID:        taskID,
+							Name:      "task-wild-goldfish-27",
+							CreatedAt: taskCreatedAt,
+						})
+					default:
+						t.Errorf("unexpected path: %s", r.URL.Path)
+					}
+				}
+			},
+		},
 		{
 			args:        []string{"my custom prompt", "--template", "my-template", "--preset", "not-real-preset"},
 			expectError: `preset "not-real-preset" not found`,
@@ -354,3 +403,331 @@ func TestTaskCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskCreateFromFile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		organizationID          = uuid.New()
+		templateVersionID       = uuid.New()
+		templateVersionPresetID = uuid.New()
+	)
+
+	const manifest = `
+tasks:
+  - name: frontend
+    template: coding-agent
+    preset: Large
+    parameters:
+      repository: coder/frontend
+    prompt: Upgrade the logging dependency
+  - name: backend
+    template: missing-template
+    prompt: Upgrade the logging dependency
+  - name: docs
+    template: coding-agent
+    parameters:
+      repository: coder/docs
+    prompt: Upgrade the logging dependency
+`
+
+	// taskHandler serves a template named "coding-agent" and creates tasks
+	// from it. The agent of the task named "docs" reports a failure.
+	taskHandler := func(t *testing.T, ctx context.Context, requests chan<- codersdk.CreateTaskRequest) http.HandlerFunc {
+		t.Helper()
+
+		tasks := map[string]codersdk.Task{
+			"frontend": {ID: uuid.New(), Name: "frontend"},
+			"docs":     {ID: uuid.New(), Name: "docs"},
+		}
+		for name, task := range tasks {
+			task.OwnerName = "alice"
+			task.TemplateName = "coding-agent"
+			task.Status = codersdk.WorkspaceStatusRunning
+			task.WorkspaceAgentHealth = &codersdk.WorkspaceAgentHealth{Healthy: true}
+			task.WorkspaceAgentLifecycle = ptr.Ref(codersdk.WorkspaceAgentLifecycleReady)
+			tasks[name] = task
+		}
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/api/v2/users/me/organizations":
+				httpapi.Write(ctx, w, http.StatusOK, []codersdk.Organization{
+					{MinimalOrganization: codersdk.MinimalOrganization{
+						ID: organizationID,
+					}},
+				})
+			case r.URL.Path == fmt.Sprintf("/api/v2/organizations/%s/templates/coding-agent", organizationID):
+				httpapi.Write(ctx, w, http.StatusOK, codersdk.Template{
+					ActiveVersionID: templateVersionID,
+				})
+			case r.URL.Path == fmt.Sprintf("/api/v2/organizations/%s/templates/missing-template", organizationID):
+				httpapi.ResourceNotFound(w)
+			case r.URL.Path == fmt.Sprintf("/api/v2/templateversions/%s/presets", templateVersionID):
+				httpapi.Write(ctx, w, http.StatusOK, []codersdk.Preset{
+					{ID: templateVersionPresetID, Name: "Large"},
+				})
+			case r.URL.Path == "/api/experimental/tasks/me":
+				var req codersdk.CreateTaskRequest
+				if !httpapi.Read(ctx, w, r, &req) {
+					return
+				}
+				requests <- req
+				httpapi.Write(ctx, w, http.StatusCreated, tasks[req.Name])
+			case strings.HasPrefix(r.URL.Path, "/api/experimental/tasks/alice/") && strings.HasSuffix(r.URL.Path, "/watch"):
+				var task codersdk.Task
+				for _, candidate := range tasks {
+					if strings.Contains(r.URL.Path, candidate.ID.String()) {
+						task = candidate
+					}
+				}
+				working := task
+				working.CurrentState = &codersdk.TaskStateEntry{State: codersdk.TaskStateWorking}
+				finished := task
+				finished.CurrentState = &codersdk.TaskStateEntry{State: codersdk.TaskStateComplete}
+				if task.Name == "docs" {
+					finished.CurrentState = &codersdk.TaskStateEntry{State: codersdk.TaskStateFailed, Message: "Tests are failing"}
+				}
+
+				sendEvent, closed, err := httpapi.ServerSentEventSender(w, r)
+				if err != nil {
+					httpapi.InternalServerError(w, err)
+					return
+				}
+				for _, event := range []codersdk.TaskEvent{
+					{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: &task},
+					{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: &working},
+					{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: &finished},
+				} {
+					_ = sendEvent(codersdk.ServerSentEvent{Type: codersdk.ServerSentEventTypeData, Data: event})
+				}
+				<-closed
+			default:
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+		}
+	}
+
+	t.Run("FailedEntryDoesNotAbortBatch", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = testutil.Context(t, testutil.WaitShort)
+			requests = make(chan codersdk.CreateTaskRequest, 3)
+			srv      = httptest.NewServer(taskHandler(t, ctx, requests))
+			client   = codersdk.New(testutil.MustURL(t, srv.URL))
+			path     = filepath.Join(t.TempDir(), "tasks.yaml")
+			stdout   strings.Builder
+		)
+		t.Cleanup(srv.Close)
+		require.NoError(t, os.WriteFile(path, []byte(manifest), 0o600))
+
+		// Given: a manifest where the second entry uses a template which
+		// does not exist.
+		inv, root := clitest.New(t, "exp", "task", "create", "--from-file", path, "--concurrency", "1")
+		inv.Stdout = &stdout
+		clitest.SetupConfig(t, client, root)
+
+		// When: the tasks are created.
+		err := inv.WithContext(ctx).Run()
+
+		// Then: the other entries are created, and the failure is reported.
+		require.ErrorContains(t, err, "1 of 3 tasks failed")
+		close(requests)
+		var created []codersdk.CreateTaskRequest
+		for req := range requests {
+			created = append(created, req)
+		}
+		require.Len(t, created, 2)
+		assert.Equal(t, "frontend", created[0].Name)
+		assert.Equal(t, templateVersionID, created[0].TemplateVersionID)
+		assert.Equal(t, templateVersionPresetID, created[0].TemplateVersionPresetID)
+		assert.Equal(t, "Upgrade the logging dependency", created[0].Input)
+		assert.Equal(t, []codersdk.WorkspaceBuildParameter{{Name: "repository", Value: "coder/frontend"}}, created[0].RichParameterValues)
+		assert.Equal(t, "docs", created[1].Name)
+		assert.Equal(t, uuid.Nil, created[1].TemplateVersionPresetID)
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.Len(t, lines, 4)
+		assert.Regexp(t, `^ENTRY\s+NAME\s+TASK ID\s+TEMPLATE\s+STATUS\s+OUTCOME\s+ERROR\s*$`, lines[0])
+		assert.Regexp(t, `^\s*1\s+frontend\s+\S+\s+coding-agent\s+created\s*$`, lines[1])
+		assert.Regexp(t, `^\s*2\s+backend\s+missing-template\s+failed\s+get template: .*Resource not found`, lines[2])
+		assert.Regexp(t, `^\s*3\s+docs\s+\S+\s+coding-agent\s+created\s*$`, lines[3])
+	})
+
+	t.Run("WaitJSON", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = testutil.Context(t, testutil.WaitShort)
+			requests = make(chan codersdk.CreateTaskRequest, 3)
+			srv      = httptest.NewServer(taskHandler(t, ctx, requests))
+			client   = codersdk.New(testutil.MustURL(t, srv.URL))
+			stdout   strings.Builder
+		)
+		t.Cleanup(srv.Close)
+
+		// Given: a manifest read from stdin.
+		inv, root := clitest.New(t, "exp", "task", "create", "--from-file", "-", "--wait", "--output", "json")
+		inv.Stdin = strings.NewReader(manifest)
+		inv.Stdout = &stdout
+		clitest.SetupConfig(t, client, root)
+
+		// When: the tasks are created and waited for.
+		err := inv.WithContext(ctx).Run()
+
+		// Then: the outcome of each task is included in the summary.
+		require.ErrorContains(t, err, "2 of 3 tasks failed")
+		var summary struct {
+			Total   int `json:"total"`
+			Created int `json:"created"`
+			Failed  int `json:"failed"`
+			Results []struct {
+				Entry   int    `json:"entry"`
+				Name    string `json:"name"`
+				Status  string `json:"status"`
+				Outcome string `json:"outcome"`
+				Error   string `json:"error"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(stdout.String()), &summary))
+		assert.Equal(t, 3, summary.Total)
+		assert.Equal(t, 2, summary.Created)
+		assert.Equal(t, 2, summary.Failed)
+		require.Len(t, summary.Results, 3)
+		assert.Equal(t, "created", summary.Results[0].Status)
+		assert.Equal(t, "complete", summary.Results[0].Outcome)
+		assert.Empty(t, summary.Results[0].Error)
+		assert.Equal(t, "failed", summary.Results[1].Status)
+		assert.Empty(t, summary.Results[1].Outcome)
+		assert.Equal(t, "created", summary.Results[2].Status)
+		assert.Equal(t, "failed", summary.Results[2].Outcome)
+		assert.Equal(t, "agent reported failure: Tests are failing", summary.Results[2].Error)
+	})
+
+	t.Run("WaitSteps", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = testutil.Context(t, testutil.WaitShort)
+			requests = make(chan codersdk.CreateTaskRequest, 1)
+			task     = codersdk.Task{
+				ID:           uuid.New(),
+				Name:         "frontend",
+				OwnerName:    "alice",
+				TemplateName: "coding-agent",
+				Status:       codersdk.WorkspaceStatusRunning,
+			}
+			stdout strings.Builder
+		)
+		// withSteps returns the task with its steps in the given statuses.
+		withSteps := func(statuses ...codersdk.TaskStepStatus) *codersdk.Task {
+			task := task
+			for i, status := range statuses {
+				task.Steps = append(task.Steps, codersdk.TaskStep{Index: int32(i), Status: status})
+			}
+			return &task
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v2/users/me/organizations":
+				httpapi.Write(ctx, w, http.StatusOK, []codersdk.Organization{
+					{MinimalOrganization: codersdk.MinimalOrganization{
+						ID: organizationID,
+					}},
+				})
+			case fmt.Sprintf("/api/v2/organizations/%s/templates/coding-agent", organizationID):
+				httpapi.Write(ctx, w, http.StatusOK, codersdk.Template{
+					ActiveVersionID: templateVersionID,
+				})
+			case "/api/experimental/tasks/me":
+				var req codersdk.CreateTaskRequest
+				if !httpapi.Read(ctx, w, r, &req) {
+					return
+				}
+				requests <- req
+				httpapi.Write(ctx, w, http.StatusCreated, task)
+			case fmt.Sprintf("/api/experimental/tasks/alice/%s/watch", task.ID):
+				sendEvent, closed, err := httpapi.ServerSentEventSender(w, r)
+				if err != nil {
+					httpapi.InternalServerError(w, err)
+					return
+				}
+				// The agent becomes idle between the steps, which must not
+				// be mistaken for the task having finished.
+				idle := withSteps(codersdk.TaskStepStatusCompleted, codersdk.TaskStepStatusRunning)
+				idle.CurrentState = &codersdk.TaskStateEntry{State: codersdk.TaskStateIdle}
+				for _, event := range []codersdk.TaskEvent{
+					{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: withSteps(codersdk.TaskStepStatusRunning, codersdk.TaskStepStatusPending)},
+					{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: idle},
+					{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: withSteps(codersdk.TaskStepStatusCompleted, codersdk.TaskStepStatusCompleted)},
+				} {
+					_ = sendEvent(codersdk.ServerSentEvent{Type: codersdk.ServerSentEventTypeData, Data: event})
+				}
+				<-closed
+			default:
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+		}))
+		client := codersdk.New(testutil.MustURL(t, srv.URL))
+		t.Cleanup(srv.Close)
+
+		// Given: a manifest entry with a follow-up prompt.
+		inv, root := clitest.New(t, "exp", "task", "create", "--from-file", "-", "--wait", "--output", "json",
+			"--template", "coding-agent", "--follow-up", "Open a pull request")
+		inv.Stdin = strings.NewReader(`
+tasks:
+  - name: frontend
+    prompt: Upgrade the logging dependency
+`)
+		inv.Stdout = &stdout
+		clitest.SetupConfig(t, client, root)
+
+		// When: the task is created and waited for.
+		err := inv.WithContext(ctx).Run()
+
+		// Then: the task finishes once all of its steps have completed.
+		require.NoError(t, err)
+		req := testutil.RequireReceive(ctx, t, requests)
+		require.Len(t, req.Steps, 2)
+		var summary struct {
+			Results []struct {
+				Outcome string `json:"outcome"`
+				Error   string `json:"error"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(stdout.String()), &summary))
+		require.Len(t, summary.Results, 1)
+		assert.Equal(t, "completed", summary.Results[0].Outcome)
+		assert.Empty(t, summary.Results[0].Error)
+	})
+
+	t.Run("OutputWithoutFromFile", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx = testutil.Context(t, testutil.WaitShort)
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/users/me/organizations":
+					httpapi.Write(ctx, w, http.StatusOK, []codersdk.Organization{
+						{MinimalOrganization: codersdk.MinimalOrganization{
+							ID: organizationID,
+						}},
+					})
+				default:
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+			}))
+			client = codersdk.New(testutil.MustURL(t, srv.URL))
+		)
+		t.Cleanup(srv.Close)
+
+		// The output of a single task is not formatted.
+		inv, root := clitest.New(t, "exp", "task", "create", "--output", "json", "Upgrade the logging dependency")
+		clitest.SetupConfig(t, client, root)
+
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "--output can only be used with --from-file")
+	})
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
@@ -12,6 +13,7 @@ import (
 )
 
 func (r *RootCmd) taskLogs() *serpent.Command {
+	var followArg bool
 	formatter := cliui.NewOutputFormatter(
 		cliui.TableFormat(
 			[]codersdk.TaskLogEntry{},
@@ -30,7 +32,21 @@ func (r *RootCmd) taskLogs() *serpent.Command {
 			Example{
 				Description: "Show logs for a given task.",
 				Command:     "coder exp task logs task1",
+			},
+			Example{
+				Description: "Stream new logs for a given task until it stops.",
+				Command:     "coder exp task logs task1 --follow",
 			}),
+		Options: serpent.OptionSet{
+			{
+				Default:       "false",
+				Description:   "Stream new logs as they are written, until the underlying workspace is stopped.",
+				Flag:          "follow",
+				FlagShorthand: "f",
+				Name:          "follow",
+				Value:         serpent.BoolOf(&followArg),
+			},
+		},
 		Middleware: serpent.Chain(
 			serpent.RequireNArgs(1),
 		),
@@ -58,6 +74,10 @@ func (r *RootCmd) taskLogs() *serpent.Command {
 				taskID = ws.ID
 			}
 
+			if followArg {
+				return followTaskLogs(inv, exp, taskID, formatter)
+			}
+
 			logs, err := exp.TaskLogs(ctx, codersdk.Me, taskID)
 			if err != nil {
 				return xerrors.Errorf("get task logs: %w", err)
@@ -76,3 +96,80 @@ func (r *RootCmd) taskLogs() *serpent.Command {
 	formatter.AttachOptions(&cmd.Options)
 	return cmd
 }
+
+// followTaskLogs prints the logs of a task, followed by new log entries as
+// they are written, until the task's workspace stops.
+func followTaskLogs(inv *serpent.Invocation, exp *codersdk.ExperimentalClient, taskID uuid.UUID, formatter *cliui.OutputFormatter) error {
+	ctx := inv.Context()
+	events, err := exp.WatchTask(ctx, codersdk.Me, taskID)
+	if err != nil {
+		return xerrors.Errorf("watch task: %w", err)
+	}
+
+	// The current task is sent once the stream is ready, so wait for it
+	// before reading the existing logs to avoid missing any entries.
+	var event codersdk.TaskEvent
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case e, ok := <-events:
+		if !ok {
+			return xerrors.New("task event stream closed unexpectedly")
+		}
+		event = e
+	}
+
+	logs, err := exp.TaskLogs(ctx, codersdk.Me, taskID)
+	if err != nil {
+		return xerrors.Errorf("get task logs: %w", err)
+	}
+	out, err := formatter.Format(ctx, logs.Logs)
+	if err != nil {
+		return xerrors.Errorf("format task logs: %w", err)
+	}
+	_, _ = fmt.Fprintln(inv.Stdout, out)
+
+	lastLogID := -1
+	if len(logs.Logs) > 0 {
+		lastLogID = logs.Logs[len(logs.Logs)-1].ID
+	}
+	for {
+		if event.Task != nil && taskLogsFollowIsEnded(*event.Task) {
+			return nil
+		}
+		if event.Log != nil && event.Log.ID > lastLogID {
+			lastLogID = event.Log.ID
+			out, err := formatter.Format(ctx, []codersdk.TaskLogEntry{*event.Log})
+			if err != nil {
+				return xerrors.Errorf("format task logs: %w", err)
+			}
+			// hack: skip the extra column header from formatter
+			if formatter.FormatID() != cliui.JSONFormat().ID() {
+				out = strings.SplitN(out, "\n", 2)[1]
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+		}
+
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok = <-events:
+			if !ok {
+				// The stream is closed by the server once the task is deleted.
+				return nil
+			}
+		}
+	}
+}
+
+// taskLogsFollowIsEnded reports whether no more logs can be written by the
+// task, as its workspace is no longer running.
+func taskLogsFollowIsEnded(task codersdk.Task) bool {
+	switch task.Status {
+	case codersdk.WorkspaceStatusPending, codersdk.WorkspaceStatusStarting, codersdk.WorkspaceStatusRunning:
+		return false
+	default:
+		return true
+	}
+}
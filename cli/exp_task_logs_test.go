@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/coder/coder/v2/coderd/coderdtest"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/pty/ptytest"
 	"github.com/coder/coder/v2/testutil"
 )
 
@@ -112,6 +114,44 @@ func Test_TaskLogs(t *testing.T) {
 		require.Contains(t, output, "output")
 	})
 
+	t.Run("Follow", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		var (
+			mu       sync.Mutex
+			messages = testMessages[:1]
+		)
+		client, workspace := setupCLITaskTest(ctx, t, map[string]http.HandlerFunc{
+			"/messages": func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"messages": messages,
+				})
+			},
+		})
+		userClient := client
+
+		inv, root := clitest.New(t, "exp", "task", "logs", workspace.Name, "--follow")
+		clitest.SetupConfig(t, userClient, root)
+		pty := ptytest.New(t).Attach(inv)
+		w := clitest.StartWithWaiter(t, inv.WithContext(ctx))
+
+		pty.ExpectMatchContext(ctx, "What is 1 + 1?")
+
+		// New log entries are printed as they are written.
+		mu.Lock()
+		messages = testMessages
+		mu.Unlock()
+		pty.ExpectMatchContext(ctx, "output")
+
+		// Following stops once the task's workspace is stopped.
+		coderdtest.MustTransitionWorkspace(t, userClient, workspace.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		require.NoError(t, w.Wait())
+	})
+
 	t.Run("WorkspaceNotFound_ByName", func(t *testing.T) {
 		t.Parallel()
 		ctx := testutil.Context(t, testutil.WaitLong)
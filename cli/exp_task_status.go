@@ -42,6 +42,7 @@ func (r *RootCmd) taskStatus() *serpent.Command {
 		)
 		watchArg         bool
 		watchIntervalArg time.Duration
+		followArg        bool
 	)
 	cmd := &serpent.Command{
 		Short: "Show the status of a task.",
@@ -54,6 +55,10 @@ func (r *RootCmd) taskStatus() *serpent.Command {
 				Description: "Watch the status of a given task until it completes (idle or stopped).",
 				Command:     "coder exp task status task1 --watch",
 			},
+			Example{
+				Description: "Stream the status of a given task until it completes (idle or stopped).",
+				Command:     "coder exp task status task1 --follow",
+			},
 		),
 		Use:     "status",
 		Aliases: []string{"stat"},
@@ -65,6 +70,13 @@ func (r *RootCmd) taskStatus() *serpent.Command {
 				Name:        "watch",
 				Value:       serpent.BoolOf(&watchArg),
 			},
+			{
+				Default:     "false",
+				Description: "Stream changes to the task status as they happen, rather than polling for them. This will stream updates to the terminal until the underlying workspace is stopped.",
+				Flag:        "follow",
+				Name:        "follow",
+				Value:       serpent.BoolOf(&followArg),
+			},
 			{
 				Default:     "1s",
 				Description: "Interval to poll the task for updates. Only used in tests.",
@@ -98,10 +110,16 @@ func (r *RootCmd) taskStatus() *serpent.Command {
 				}
 				taskID = ws.ID
 			}
+			if followArg {
+				return followTaskStatus(i, ec, taskID, formatter)
+			}
 			task, err := ec.TaskByID(ctx, taskID)
 			if err != nil {
 				return err
 			}
+			if len(task.Steps) > 0 {
+				showTaskStepColumn(i)
+			}
 
 			tsr := toStatusRow(task)
 			out, err := formatter.Format(ctx, []taskStatusRow{tsr})
@@ -151,6 +169,78 @@ func (r *RootCmd) taskStatus() *serpent.Command {
 	return cmd
 }
 
+// followTaskStatus prints the status of a task whenever it changes, until the
+// task completes.
+func followTaskStatus(inv *serpent.Invocation, ec *codersdk.ExperimentalClient, taskID uuid.UUID, formatter *cliui.OutputFormatter) error {
+	ctx := inv.Context()
+	events, err := ec.WatchTask(ctx, codersdk.Me, taskID)
+	if err != nil {
+		return xerrors.Errorf("watch task: %w", err)
+	}
+
+	var lastStatusRow *taskStatusRow
+	for event := range events {
+		if event.Task == nil {
+			continue
+		}
+		if lastStatusRow == nil && len(event.Task.Steps) > 0 {
+			showTaskStepColumn(inv)
+		}
+		newStatusRow := toStatusRow(*event.Task)
+		if lastStatusRow == nil || !taskStatusRowEqual(*lastStatusRow, newStatusRow) {
+			out, err := formatter.Format(ctx, []taskStatusRow{newStatusRow})
+			if err != nil {
+				return xerrors.Errorf("format task status: %w", err)
+			}
+			// hack: skip the extra column header from formatter
+			if lastStatusRow != nil && formatter.FormatID() != cliui.JSONFormat().ID() {
+				out = strings.SplitN(out, "\n", 2)[1]
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+		}
+		lastStatusRow = &newStatusRow
+
+		if taskWatchIsEnded(*event.Task) {
+			return nil
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	// The stream is closed by the server once the task is deleted.
+	if lastStatusRow != nil && lastStatusRow.Task.Status == codersdk.WorkspaceStatusDeleted {
+		return nil
+	}
+	return xerrors.New("task event stream closed unexpectedly")
+}
+
+// showTaskStepColumn adds the step column after the healthy column, unless
+// the columns were chosen explicitly. The column is only shown by default for
+// multi-step tasks, since it is empty for other tasks.
+func showTaskStepColumn(inv *serpent.Invocation) {
+	for _, opt := range inv.Command.Options {
+		if opt.Flag != "column" {
+			continue
+		}
+		if opt.ValueSource != serpent.ValueSourceDefault {
+			return
+		}
+		v, ok := opt.Value.(*serpent.EnumArray)
+		if !ok {
+			return
+		}
+		columns := make([]string, 0, len(v.GetSlice())+1)
+		for _, column := range v.GetSlice() {
+			columns = append(columns, column)
+			if column == "healthy" {
+				columns = append(columns, "step")
+			}
+		}
+		_ = v.Replace(columns)
+		return
+	}
+}
+
 func taskWatchIsEnded(task codersdk.Task) bool {
 	if task.Status == codersdk.WorkspaceStatusStopped {
 		return true
@@ -173,6 +263,7 @@ type taskStatusRow struct {
 	Timestamp     time.Time `json:"-" table:"-"`
 	TaskStatus    string    `json:"-" table:"status"`
 	Healthy       bool      `json:"-" table:"healthy"`
+	Step          string    `json:"-" table:"step"`
 	TaskState     string    `json:"-" table:"state"`
 	Message       string    `json:"-" table:"message"`
 }
@@ -180,6 +271,7 @@ type taskStatusRow struct {
 func taskStatusRowEqual(r1, r2 taskStatusRow) bool {
 	return r1.TaskStatus == r2.TaskStatus &&
 		r1.Healthy == r2.Healthy &&
+		r1.Step == r2.Step &&
 		r1.TaskState == r2.TaskState &&
 		r1.Message == r2.Message
 }
@@ -190,6 +282,7 @@ func toStatusRow(task codersdk.Task) taskStatusRow {
 		ChangedAgo: time.Since(task.UpdatedAt).Truncate(time.Second).String() + " ago",
 		Timestamp:  task.UpdatedAt,
 		TaskStatus: string(task.Status),
+		Step:       taskStepSummary(task.Steps),
 	}
 	tsr.Healthy = task.WorkspaceAgentHealth != nil &&
 		task.WorkspaceAgentHealth.Healthy &&
@@ -205,3 +298,21 @@ func toStatusRow(task codersdk.Task) taskStatusRow {
 	}
 	return tsr
 }
+
+// taskStepSummary describes the progress of a multi-step task, e.g. "2/3
+// running". It is empty for tasks without steps.
+func taskStepSummary(steps []codersdk.TaskStep) string {
+	if len(steps) == 0 {
+		return ""
+	}
+	// Report the first step which has not completed, or the last step once
+	// they all have.
+	current := steps[len(steps)-1]
+	for _, step := range steps {
+		if step.Status != codersdk.TaskStepStatusCompleted {
+			current = step
+			break
+		}
+	}
+	return fmt.Sprintf("%d/%d %s", current.Index+1, len(steps), current.Status)
+}
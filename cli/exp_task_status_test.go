@@ -95,6 +95,44 @@ func Test_TaskStatus(t *testing.T) {
 				}
 			},
 		},
+		{
+			args: []string{"steps"},
+			expectOutput: `STATE CHANGED  STATUS   HEALTHY  STEP         STATE    MESSAGE
+0s ago         running  true     2/3 running  working  Running the tests...`,
+			hf: func(ctx context.Context, now time.Time) func(w http.ResponseWriter, r *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/api/v2/users/me/workspace/steps":
+						httpapi.Write(ctx, w, http.StatusOK, codersdk.Workspace{
+							ID: uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+						})
+					case "/api/experimental/tasks/me/11111111-1111-1111-1111-111111111111":
+						httpapi.Write(ctx, w, http.StatusOK, codersdk.Task{
+							ID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+							Status:    codersdk.WorkspaceStatusRunning,
+							CreatedAt: now,
+							UpdatedAt: now,
+							CurrentState: &codersdk.TaskStateEntry{
+								State:     codersdk.TaskStateWorking,
+								Timestamp: now,
+								Message:   "Running the tests...",
+							},
+							WorkspaceAgentLifecycle: ptr.Ref(codersdk.WorkspaceAgentLifecycleReady),
+							WorkspaceAgentHealth: &codersdk.WorkspaceAgentHealth{
+								Healthy: true,
+							},
+							Steps: []codersdk.TaskStep{
+								{Index: 0, Prompt: "Upgrade the logging dependency.", Status: codersdk.TaskStepStatusCompleted},
+								{Index: 1, Prompt: "Run the tests.", CompletionCheck: "make test", Status: codersdk.TaskStepStatusRunning},
+								{Index: 2, Prompt: "Open a pull request.", Status: codersdk.TaskStepStatusPending},
+							},
+						})
+					default:
+						t.Errorf("unexpected path: %s", r.URL.Path)
+					}
+				}
+			},
+		},
 		{
 			args: []string{"exists", "--watch"},
 			expectOutput: `
@@ -177,6 +215,64 @@ STATE CHANGED  STATUS   HEALTHY  STATE  MESSAGE
 				}
 			},
 		},
+		{
+			args: []string{"exists", "--follow"},
+			expectOutput: `
+STATE CHANGED  STATUS   HEALTHY  STATE  MESSAGE
+4s ago         running  true
+3s ago         running  true     working  Reticulating splines...
+2s ago         running  true     complete  Splines reticulated successfully!`,
+			hf: func(ctx context.Context, now time.Time) func(http.ResponseWriter, *http.Request) {
+				task := codersdk.Task{
+					ID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+					Status:    codersdk.WorkspaceStatusRunning,
+					CreatedAt: now.Add(-5 * time.Second),
+					UpdatedAt: now.Add(-4 * time.Second),
+					WorkspaceAgentHealth: &codersdk.WorkspaceAgentHealth{
+						Healthy: true,
+					},
+					WorkspaceAgentLifecycle: ptr.Ref(codersdk.WorkspaceAgentLifecycleReady),
+				}
+				working := task
+				working.CurrentState = &codersdk.TaskStateEntry{
+					State:     codersdk.TaskStateWorking,
+					Timestamp: now.Add(-3 * time.Second),
+					Message:   "Reticulating splines...",
+				}
+				complete := task
+				complete.CurrentState = &codersdk.TaskStateEntry{
+					State:     codersdk.TaskStateComplete,
+					Timestamp: now.Add(-2 * time.Second),
+					Message:   "Splines reticulated successfully!",
+				}
+				return func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/api/v2/users/me/workspace/exists":
+						httpapi.Write(ctx, w, http.StatusOK, codersdk.Workspace{
+							ID: uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+						})
+					case "/api/experimental/tasks/me/11111111-1111-1111-1111-111111111111/watch":
+						sendEvent, closed, err := httpapi.ServerSentEventSender(w, r)
+						if err != nil {
+							httpapi.InternalServerError(w, err)
+							return
+						}
+						for _, event := range []codersdk.TaskEvent{
+							{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: &task},
+							// Log events do not change the status.
+							{Type: codersdk.TaskEventTypeLog, TaskID: task.ID, Log: &codersdk.TaskLogEntry{ID: 0, Content: "Hello", Type: codersdk.TaskLogTypeInput}},
+							{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: &working},
+							{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: &complete},
+						} {
+							_ = sendEvent(codersdk.ServerSentEvent{Type: codersdk.ServerSentEventTypeData, Data: event})
+						}
+						<-closed
+					default:
+						httpapi.InternalServerError(w, xerrors.Errorf("unexpected path: %q", r.URL.Path))
+					}
+				}
+			},
+		},
 		{
 			args: []string{"exists", "--output", "json"},
 			expectOutput: `{
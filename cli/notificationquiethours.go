@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) notificationQuietHours() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "quiet-hours [on|off]",
+		Short: "Show or change whether your notifications are deferred during your quiet hours",
+		Long: "Non-urgent notifications which would be delivered during your quiet hours are instead delivered when " +
+			"they end. Your quiet hours schedule can be changed in your account settings on Premium deployments. " +
+			"Notifications in Coder Inbox are never deferred.",
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(0, 1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			quietHours, err := client.GetUserNotificationQuietHours(inv.Context(), codersdk.Me)
+			if err != nil {
+				return xerrors.Errorf("get notification quiet hours: %w", err)
+			}
+
+			if len(inv.Args) == 1 {
+				switch inv.Args[0] {
+				case "on":
+					quietHours.Enabled = true
+				case "off":
+					quietHours.Enabled = false
+				default:
+					return xerrors.Errorf("expected \"on\" or \"off\", got %q", inv.Args[0])
+				}
+				quietHours, err = client.UpdateUserNotificationQuietHours(inv.Context(), codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+					Enabled:     quietHours.Enabled,
+					SnoozeUntil: quietHours.SnoozeUntil,
+				})
+				if err != nil {
+					return xerrors.Errorf("update notification quiet hours: %w", err)
+				}
+			}
+
+			state := "off"
+			if quietHours.Enabled {
+				state = "on"
+			}
+			_, _ = fmt.Fprintf(inv.Stdout, "Quiet hours are %s.\n", state)
+			if quietHours.Enabled && quietHours.Schedule == "" {
+				cliui.Warn(inv.Stderr, "No quiet hours schedule is available on this deployment, so notifications will not be deferred.")
+			}
+			if quietHours.SnoozeUntil != nil {
+				_, _ = fmt.Fprintf(inv.Stdout, "Notifications are snoozed until %s.\n", quietHours.SnoozeUntil.Local().Format(time.RFC1123))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) snoozeNotifications() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "snooze <duration>",
+		Short: "Defer all of your non-urgent notifications for a while",
+		Long: "Notifications are delivered once the snooze ends, rather than being dropped. Notifications can be " +
+			"snoozed for up to 72 hours. Notifications in Coder Inbox are never deferred.\n" + FormatExamples(
+			Example{
+				Description: "Snooze notifications for the next two hours",
+				Command:     "coder notifications snooze 2h",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			d, err := time.ParseDuration(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("parse duration: %w", err)
+			}
+			if d <= 0 {
+				return xerrors.New("duration must be positive")
+			}
+
+			until := time.Now().Add(d)
+			if err := updateNotificationSnooze(inv, client, &until); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(inv.Stderr, "Notifications are snoozed until %s.\n", until.Format(time.RFC1123))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) unsnoozeNotifications() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "unsnooze",
+		Short: "Stop snoozing your notifications",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			if err := updateNotificationSnooze(inv, client, nil); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(inv.Stderr, "Notifications are no longer snoozed.")
+			return nil
+		},
+	}
+	return cmd
+}
+
+// updateNotificationSnooze changes when the current user's snooze ends, leaving their quiet hours setting as is.
+func updateNotificationSnooze(inv *serpent.Invocation, client *codersdk.Client, until *time.Time) error {
+	quietHours, err := client.GetUserNotificationQuietHours(inv.Context(), codersdk.Me)
+	if err != nil {
+		return xerrors.Errorf("get notification quiet hours: %w", err)
+	}
+
+	_, err = client.UpdateUserNotificationQuietHours(inv.Context(), codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+		Enabled:     quietHours.Enabled,
+		SnoozeUntil: until,
+	})
+	if err != nil {
+		return xerrors.Errorf("update notification snooze: %w", err)
+	}
+	return nil
+}
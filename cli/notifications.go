@@ -31,6 +31,14 @@ func (r *RootCmd) notifications() *serpent.Command {
 				Description: "Send a custom notification to the requesting user. Sending notifications targeting other users or groups is currently not supported",
 				Command:     "coder notifications custom \"Custom Title\" \"Custom Message\"",
 			},
+			Example{
+				Description: "Snooze your non-urgent notifications for the next two hours",
+				Command:     "coder notifications snooze 2h",
+			},
+			Example{
+				Description: "Customize the wording of a notification template",
+				Command:     "coder notifications templates set \"Workspace Deleted\" --body-file body.md",
+			},
 		),
 		Aliases: []string{"notification"},
 		Handler: func(inv *serpent.Invocation) error {
@@ -41,6 +49,10 @@ func (r *RootCmd) notifications() *serpent.Command {
 			r.resumeNotifications(),
 			r.testNotifications(),
 			r.customNotifications(),
+			r.notificationTemplates(),
+			r.notificationQuietHours(),
+			r.snoozeNotifications(),
+			r.unsnoozeNotifications(),
 		},
 	}
 	return cmd
@@ -5,7 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -267,3 +271,109 @@ func TestCustomNotifications(t *testing.T) {
 		require.Equal(t, memberUser.ID.String(), sent[0].CreatedBy)
 	})
 }
+
+func TestNotificationTemplates(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	ownerClient := coderdtest.New(t, createOpts(t))
+	_ = coderdtest.CreateFirstUser(t, ownerClient)
+
+	// Given: a template body in a file.
+	bodyFile := filepath.Join(t.TempDir(), "body.md")
+	require.NoError(t, os.WriteFile(bodyFile, []byte("**{{.Labels.name}}** is gone."), 0o600))
+
+	// When: the template is overridden by name.
+	inv, root := clitest.New(t, "notifications", "templates", "set", "workspace deleted", "--title", "Goodbye, {{.Labels.name}}", "--body-file", bodyFile)
+	clitest.SetupConfig(t, ownerClient, root)
+	require.NoError(t, inv.Run())
+
+	// Then: it is listed as overridden.
+	inv, root = clitest.New(t, "notifications", "templates", "list", "--output", "json")
+	clitest.SetupConfig(t, ownerClient, root)
+	var buf bytes.Buffer
+	inv.Stdout = &buf
+	require.NoError(t, inv.Run())
+
+	var templates []codersdk.NotificationTemplate
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &templates))
+	idx := slices.IndexFunc(templates, func(tmpl codersdk.NotificationTemplate) bool {
+		return tmpl.ID == notifications.TemplateWorkspaceDeleted
+	})
+	require.NotEqual(t, -1, idx)
+	require.Equal(t, "Goodbye, {{.Labels.name}}", templates[idx].TitleTemplateOverride)
+	require.Equal(t, "**{{.Labels.name}}** is gone.", templates[idx].BodyTemplateOverride)
+
+	// Then: it is previewed with the given labels.
+	inv, root = clitest.New(t, "notifications", "templates", "preview", notifications.TemplateWorkspaceDeleted.String(), "--label", "name=dev")
+	clitest.SetupConfig(t, ownerClient, root)
+	buf.Reset()
+	inv.Stdout = &buf
+	require.NoError(t, inv.Run())
+	require.Equal(t, "Goodbye, dev\n\n**dev** is gone.\n", buf.String())
+
+	// When: the template is reset.
+	inv, root = clitest.New(t, "notifications", "templates", "reset", "workspace deleted")
+	clitest.SetupConfig(t, ownerClient, root)
+	require.NoError(t, inv.Run())
+
+	// Then: the built-in template is used again.
+	templates, err := ownerClient.GetSystemNotificationTemplates(ctx)
+	require.NoError(t, err)
+	idx = slices.IndexFunc(templates, func(tmpl codersdk.NotificationTemplate) bool {
+		return tmpl.ID == notifications.TemplateWorkspaceDeleted
+	})
+	require.NotEqual(t, -1, idx)
+	require.Empty(t, templates[idx].TitleTemplateOverride)
+	require.Empty(t, templates[idx].BodyTemplateOverride)
+}
+
+func TestNotificationQuietHours(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	ownerClient := coderdtest.New(t, createOpts(t))
+	owner := coderdtest.CreateFirstUser(t, ownerClient)
+	memberClient, _ := coderdtest.CreateAnotherUser(t, ownerClient, owner.OrganizationID)
+
+	// When: a member turns on quiet hours and snoozes their notifications.
+	inv, root := clitest.New(t, "notifications", "quiet-hours", "on")
+	clitest.SetupConfig(t, memberClient, root)
+	require.NoError(t, inv.Run())
+
+	inv, root = clitest.New(t, "notifications", "snooze", "2h")
+	clitest.SetupConfig(t, memberClient, root)
+	require.NoError(t, inv.Run())
+
+	// Then: both are in effect.
+	quietHours, err := memberClient.GetUserNotificationQuietHours(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.True(t, quietHours.Enabled)
+	require.NotNil(t, quietHours.SnoozeUntil)
+	require.WithinDuration(t, time.Now().Add(2*time.Hour), *quietHours.SnoozeUntil, time.Minute)
+
+	// When: the member stops snoozing.
+	inv, root = clitest.New(t, "notifications", "unsnooze")
+	clitest.SetupConfig(t, memberClient, root)
+	require.NoError(t, inv.Run())
+
+	// Then: quiet hours stay on.
+	quietHours, err = memberClient.GetUserNotificationQuietHours(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.True(t, quietHours.Enabled)
+	require.Nil(t, quietHours.SnoozeUntil)
+
+	// When: an owner marks a template as urgent.
+	inv, root = clitest.New(t, "notifications", "templates", "urgent", "Workspace Marked as Dormant")
+	clitest.SetupConfig(t, ownerClient, root)
+	require.NoError(t, inv.Run())
+
+	// Then: it is urgent.
+	templates, err := ownerClient.GetSystemNotificationTemplates(ctx)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(templates, func(tmpl codersdk.NotificationTemplate) bool {
+		return tmpl.ID == notifications.TemplateWorkspaceDormant
+	})
+	require.NotEqual(t, -1, idx)
+	require.True(t, templates[idx].Urgent)
+}
@@ -0,0 +1,442 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) notificationTemplates() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "templates",
+		Short: "Customize notification templates",
+		Long: "Administrators can override the title and body of a notification template for this deployment. " +
+			"Templates which are not overridden use the built-in title and body.\n" + FormatExamples(
+			Example{
+				Description: "Preview a change to a template's title before saving it",
+				Command:     "coder notifications templates preview \"Workspace Deleted\" --title 'Goodbye, {{.Labels.name}}'",
+			},
+			Example{
+				Description: "Override a template's body with the contents of a file",
+				Command:     "coder notifications templates set \"Workspace Deleted\" --body-file body.md",
+			},
+			Example{
+				Description: "Send yourself a test message from a template by email",
+				Command:     "coder notifications templates test \"Workspace Deleted\" --method smtp",
+			},
+		),
+		Aliases: []string{"template"},
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.listNotificationTemplates(),
+			r.setNotificationTemplate(),
+			r.resetNotificationTemplate(),
+			r.previewNotificationTemplate(),
+			r.testNotificationTemplate(),
+			r.urgentNotificationTemplate(),
+		},
+	}
+	return cmd
+}
+
+type notificationTemplateRow struct {
+	ID         uuid.UUID `table:"id"`
+	Name       string    `table:"name,default_sort"`
+	Group      string    `table:"group"`
+	Method     string    `table:"method"`
+	Overridden bool      `table:"overridden"`
+	Urgent     bool      `table:"urgent"`
+}
+
+func (r *RootCmd) listNotificationTemplates() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]notificationTemplateRow{}, []string{"id", "name", "group", "overridden", "urgent"}),
+			func(data any) (any, error) {
+				templates, ok := data.([]codersdk.NotificationTemplate)
+				if !ok {
+					return nil, xerrors.Errorf("expected []codersdk.NotificationTemplate got %T", data)
+				}
+				rows := make([]notificationTemplateRow, 0, len(templates))
+				for _, tmpl := range templates {
+					rows = append(rows, notificationTemplateRow{
+						ID:         tmpl.ID,
+						Name:       tmpl.Name,
+						Group:      tmpl.Group,
+						Method:     tmpl.Method,
+						Overridden: tmpl.TitleTemplateOverride != "" || tmpl.BodyTemplateOverride != "",
+						Urgent:     tmpl.Urgent,
+					})
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+
+	cmd := &serpent.Command{
+		Use:     "list",
+		Short:   "List notification templates",
+		Aliases: []string{"ls"},
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			templates, err := client.GetSystemNotificationTemplates(inv.Context())
+			if err != nil {
+				return xerrors.Errorf("list notification templates: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), templates)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				cliui.Infof(inv.Stderr, "No notification templates found.")
+				return nil
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+// notificationTemplateContentFlags are the options with which a template's title and body are given.
+type notificationTemplateContentFlags struct {
+	title    string
+	body     string
+	bodyFile string
+}
+
+func (f *notificationTemplateContentFlags) options() serpent.OptionSet {
+	return serpent.OptionSet{
+		{
+			Flag:        "title",
+			Description: "The title template, in markdown.",
+			Value:       serpent.StringOf(&f.title),
+		},
+		{
+			Flag:        "body",
+			Description: "The body template, in markdown.",
+			Value:       serpent.StringOf(&f.body),
+		},
+		{
+			Flag:        "body-file",
+			Description: "A file to read the body template from. Use \"-\" to read from stdin.",
+			Value:       serpent.StringOf(&f.bodyFile),
+		},
+	}
+}
+
+func (f *notificationTemplateContentFlags) read(inv *serpent.Invocation) (title, body string, err error) {
+	if f.bodyFile == "" {
+		return f.title, f.body, nil
+	}
+	if f.body != "" {
+		return "", "", xerrors.New("only one of --body and --body-file can be set")
+	}
+
+	var content []byte
+	if f.bodyFile == "-" {
+		content, err = io.ReadAll(inv.Stdin)
+	} else {
+		content, err = os.ReadFile(f.bodyFile)
+	}
+	if err != nil {
+		return "", "", xerrors.Errorf("read body file: %w", err)
+	}
+	return f.title, string(content), nil
+}
+
+func (r *RootCmd) setNotificationTemplate() *serpent.Command {
+	var content notificationTemplateContentFlags
+
+	cmd := &serpent.Command{
+		Use:   "set <template>",
+		Short: "Override the title and body of a notification template",
+		Long: "The template is given by its name or ID. The title and body use the same markdown and Go templating " +
+			"syntax as the built-in templates. A title or body which is not given uses the built-in one.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			title, body, err := content.read(inv)
+			if err != nil {
+				return err
+			}
+			if title == "" && body == "" {
+				return xerrors.New("at least one of --title, --body or --body-file must be set; use \"reset\" to remove overrides")
+			}
+
+			tmpl, err := notificationTemplateByNameOrID(inv, client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			_, err = client.UpdateNotificationTemplateOverrides(inv.Context(), tmpl.ID, codersdk.UpdateNotificationTemplateOverrides{
+				TitleTemplate: title,
+				BodyTemplate:  body,
+			})
+			if err != nil {
+				return xerrors.Errorf("update notification template: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stderr, "Notification template %q has been updated.\n", tmpl.Name)
+			return nil
+		},
+		Options: content.options(),
+	}
+	return cmd
+}
+
+func (r *RootCmd) resetNotificationTemplate() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "reset <template>",
+		Short: "Revert a notification template to its built-in title and body",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := notificationTemplateByNameOrID(inv, client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteNotificationTemplateOverrides(inv.Context(), tmpl.ID); err != nil {
+				return xerrors.Errorf("reset notification template: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stderr, "Notification template %q has been reset.\n", tmpl.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) previewNotificationTemplate() *serpent.Command {
+	var (
+		content notificationTemplateContentFlags
+		labels  []string
+
+		formatter = cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+				preview, ok := data.(codersdk.NotificationTemplatePreview)
+				if !ok {
+					return nil, xerrors.Errorf("expected codersdk.NotificationTemplatePreview got %T", data)
+				}
+				return preview.Title + "\n\n" + preview.Body, nil
+			}),
+			cliui.JSONFormat(),
+		)
+	)
+
+	cmd := &serpent.Command{
+		Use:   "preview <template>",
+		Short: "Render a notification template against sample data",
+		Long: "Labels which are referenced by the template but not given with --label are rendered as \"[name]\". " +
+			"Give --title, --body or --body-file to preview changes before they are saved.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			title, body, err := content.read(inv)
+			if err != nil {
+				return err
+			}
+			sampleLabels, err := parseNotificationLabels(labels)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := notificationTemplateByNameOrID(inv, client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			preview, err := client.PreviewNotificationTemplate(inv.Context(), tmpl.ID, codersdk.NotificationTemplatePreviewRequest{
+				TitleTemplate: title,
+				BodyTemplate:  body,
+				Labels:        sampleLabels,
+			})
+			if err != nil {
+				return xerrors.Errorf("preview notification template: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), preview)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+		Options: append(content.options(), notificationLabelsOption(&labels)),
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) testNotificationTemplate() *serpent.Command {
+	var (
+		method string
+		labels []string
+	)
+
+	cmd := &serpent.Command{
+		Use:   "test <template>",
+		Short: "Send yourself a test message from a notification template",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			sampleLabels, err := parseNotificationLabels(labels)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := notificationTemplateByNameOrID(inv, client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			err = client.TestNotificationTemplate(inv.Context(), tmpl.ID, codersdk.NotificationTemplateTestRequest{
+				Method: method,
+				Labels: sampleLabels,
+			})
+			if err != nil {
+				return xerrors.Errorf("send test notification: %w", err)
+			}
+
+			_, _ = fmt.Fprintln(inv.Stderr, "A test notification has been sent. If you don't receive the notification, check Coder's logs for any errors.")
+			return nil
+		},
+		Options: serpent.OptionSet{
+			{
+				Flag:        "method",
+				Description: "The method to send the test message through. Defaults to the deployment's notification method.",
+				Value:       serpent.EnumOf(&method, "smtp", "webhook", "inbox"),
+			},
+			notificationLabelsOption(&labels),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) urgentNotificationTemplate() *serpent.Command {
+	var unset bool
+
+	cmd := &serpent.Command{
+		Use:   "urgent <template>",
+		Short: "Mark a notification template as urgent",
+		Long: "Urgent notifications are delivered immediately, even during the recipient's quiet hours or while " +
+			"they have snoozed notifications.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := notificationTemplateByNameOrID(inv, client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			_, err = client.UpdateNotificationTemplateUrgency(inv.Context(), tmpl.ID, codersdk.UpdateNotificationTemplateUrgency{
+				Urgent: !unset,
+			})
+			if err != nil {
+				return xerrors.Errorf("update notification template urgency: %w", err)
+			}
+
+			if unset {
+				_, _ = fmt.Fprintf(inv.Stderr, "Notification template %q is no longer urgent.\n", tmpl.Name)
+			} else {
+				_, _ = fmt.Fprintf(inv.Stderr, "Notification template %q is now urgent.\n", tmpl.Name)
+			}
+			return nil
+		},
+		Options: serpent.OptionSet{
+			{
+				Flag:        "unset",
+				Description: "Stop treating the template as urgent.",
+				Value:       serpent.BoolOf(&unset),
+			},
+		},
+	}
+	return cmd
+}
+
+func notificationLabelsOption(labels *[]string) serpent.Option {
+	return serpent.Option{
+		Flag:        "label",
+		Description: "A sample label to render the template with, in the form name=value.",
+		Value:       serpent.StringArrayOf(labels),
+	}
+}
+
+func parseNotificationLabels(in []string) (map[string]string, error) {
+	labels := make(map[string]string, len(in))
+	for _, label := range in {
+		k, v, ok := strings.Cut(label, "=")
+		if !ok {
+			return nil, xerrors.Errorf("invalid label %q: must be in the form name=value", label)
+		}
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// notificationTemplateByNameOrID finds a system notification template by its ID or its case-insensitive name.
+func notificationTemplateByNameOrID(inv *serpent.Invocation, client *codersdk.Client, nameOrID string) (codersdk.NotificationTemplate, error) {
+	templates, err := client.GetSystemNotificationTemplates(inv.Context())
+	if err != nil {
+		return codersdk.NotificationTemplate{}, xerrors.Errorf("list notification templates: %w", err)
+	}
+
+	id, err := uuid.Parse(nameOrID)
+	for _, tmpl := range templates {
+		if (err == nil && tmpl.ID == id) || strings.EqualFold(tmpl.Name, nameOrID) {
+			return tmpl, nil
+		}
+	}
+	return codersdk.NotificationTemplate{}, xerrors.Errorf("notification template %q not found", nameOrID)
+}
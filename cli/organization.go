@@ -27,6 +27,7 @@ func (r *RootCmd) organizations() *serpent.Command {
 			r.organizationMembers(orgContext),
 			r.organizationRoles(orgContext),
 			r.organizationSettings(orgContext),
+			r.organizationAutostartCalendars(orgContext),
 		},
 	}
 
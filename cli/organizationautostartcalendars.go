@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/coderd/schedule/ical"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/pretty"
+	"github.com/coder/serpent"
+)
+
+func (r *RootCmd) organizationAutostartCalendars(orgContext *OrganizationContext) *serpent.Command {
+	cmd := &serpent.Command{
+		Use:     "autostart-calendars",
+		Short:   "Manage the calendars of days on which workspaces in the organization are not autostarted.",
+		Aliases: []string{"autostart-calendar"},
+		Long: FormatExamples(
+			Example{
+				Description: "Skip autostart on the public holidays of an iCalendar file",
+				Command:     "coder organizations autostart-calendars set holidays --ics holidays.ics",
+			},
+			Example{
+				Description: "Skip autostart during a company shutdown",
+				Command:     `coder organizations autostart-calendars set shutdown --exception "Winter shutdown=2025-12-22:2026-01-02"`,
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.listOrganizationAutostartCalendars(orgContext),
+			r.setOrganizationAutostartCalendar(orgContext),
+			r.deleteOrganizationAutostartCalendar(orgContext),
+		},
+	}
+	return cmd
+}
+
+type autostartCalendarTableRow struct {
+	Name        string `table:"name,default_sort"`
+	Description string `table:"description"`
+	Exceptions  string `table:"exceptions"`
+	UpdatedAt   string `table:"updated at"`
+}
+
+func (r *RootCmd) listOrganizationAutostartCalendars(orgContext *OrganizationContext) *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]autostartCalendarTableRow{}, []string{"name", "description", "exceptions"}),
+			func(data any) (any, error) {
+				calendars, ok := data.([]codersdk.AutostartExceptionCalendar)
+				if !ok {
+					return nil, xerrors.Errorf("expected []codersdk.AutostartExceptionCalendar got %T", data)
+				}
+
+				rows := make([]autostartCalendarTableRow, 0, len(calendars))
+				for _, calendar := range calendars {
+					exceptions := make([]string, 0, len(calendar.Exceptions))
+					for _, e := range calendar.Exceptions {
+						exceptions = append(exceptions, formatAutostartException(e))
+					}
+					rows = append(rows, autostartCalendarTableRow{
+						Name:        calendar.Name,
+						Description: calendar.Description,
+						Exceptions:  strings.Join(exceptions, ", "),
+						UpdatedAt:   calendar.UpdatedAt.Format("2006-01-02 15:04:05"),
+					})
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+
+	cmd := &serpent.Command{
+		Use:   "list",
+		Short: "List the organization's autostart exception calendars.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			org, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+
+			calendars, err := client.AutostartExceptionCalendars(inv.Context(), org.ID)
+			if err != nil {
+				return xerrors.Errorf("list autostart exception calendars: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), calendars)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				cliui.Infof(inv.Stderr, "No autostart exception calendars found.")
+				return nil
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) setOrganizationAutostartCalendar(orgContext *OrganizationContext) *serpent.Command {
+	var (
+		icsPath     string
+		exceptions  []string
+		description string
+	)
+	cmd := &serpent.Command{
+		Use:   "set <name>",
+		Short: "Create or replace an autostart exception calendar.",
+		Long: "The calendar is replaced with the exceptions given by --ics and --exception. " +
+			"Dates are interpreted in the timezone of each workspace's autostart schedule.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "ics",
+				Description: "Path to an iCalendar (.ics) file whose events are added as exceptions.",
+				Value:       serpent.StringOf(&icsPath),
+			},
+			{
+				Flag:        "exception",
+				Description: `An exception in the form "name=YYYY-MM-DD" or "name=YYYY-MM-DD:YYYY-MM-DD", with an inclusive end date. May be repeated.`,
+				Value:       serpent.StringArrayOf(&exceptions),
+			},
+			{
+				Flag:        "description",
+				Description: "Description of the calendar.",
+				Value:       serpent.StringOf(&description),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			org, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+
+			req := codersdk.UpsertAutostartExceptionCalendarRequest{
+				Description: description,
+				Exceptions:  []codersdk.AutostartException{},
+			}
+			if icsPath != "" {
+				f, err := os.Open(icsPath)
+				if err != nil {
+					return xerrors.Errorf("open calendar file: %w", err)
+				}
+				events, err := ical.Parse(f)
+				_ = f.Close()
+				if err != nil {
+					return xerrors.Errorf("parse calendar file %q: %w", icsPath, err)
+				}
+				for _, event := range events {
+					req.Exceptions = append(req.Exceptions, codersdk.AutostartException(event))
+				}
+			}
+			for _, raw := range exceptions {
+				exception, err := parseAutostartException(raw)
+				if err != nil {
+					return err
+				}
+				req.Exceptions = append(req.Exceptions, exception)
+			}
+
+			calendar, err := client.UpsertAutostartExceptionCalendar(inv.Context(), org.ID, inv.Args[0], req)
+			if err != nil {
+				return xerrors.Errorf("set autostart exception calendar: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stdout, "Autostart exception calendar %s now has %d exception(s).\n",
+				pretty.Sprint(cliui.DefaultStyles.Keyword, calendar.Name), len(calendar.Exceptions))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) deleteOrganizationAutostartCalendar(orgContext *OrganizationContext) *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "delete <name>",
+		Short: "Delete an autostart exception calendar.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Options: serpent.OptionSet{
+			cliui.SkipPromptOption(),
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			org, err := orgContext.Selected(inv, client)
+			if err != nil {
+				return err
+			}
+
+			name := inv.Args[0]
+			_, err = cliui.Prompt(inv, cliui.PromptOptions{
+				Text:      fmt.Sprintf("Delete autostart exception calendar %s?", pretty.Sprint(cliui.DefaultStyles.Code, name)),
+				IsConfirm: true,
+				Default:   cliui.ConfirmNo,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteAutostartExceptionCalendar(inv.Context(), org.ID, name); err != nil {
+				return xerrors.Errorf("delete autostart exception calendar: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(inv.Stdout, "Deleted autostart exception calendar %s.\n", pretty.Sprint(cliui.DefaultStyles.Keyword, name))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// parseAutostartException parses an exception given on the command line as
+// "name=YYYY-MM-DD" or "name=YYYY-MM-DD:YYYY-MM-DD".
+func parseAutostartException(raw string) (codersdk.AutostartException, error) {
+	idx := strings.LastIndex(raw, "=")
+	if idx <= 0 {
+		return codersdk.AutostartException{}, xerrors.Errorf("invalid exception %q, expected name=YYYY-MM-DD[:YYYY-MM-DD]", raw)
+	}
+	name, dates := raw[:idx], raw[idx+1:]
+	start, end, ok := strings.Cut(dates, ":")
+	if !ok {
+		end = start
+	}
+	return codersdk.AutostartException{
+		Name:      strings.TrimSpace(name),
+		StartDate: strings.TrimSpace(start),
+		EndDate:   strings.TrimSpace(end),
+	}, nil
+}
+
+func formatAutostartException(e codersdk.AutostartException) string {
+	if e.StartDate == e.EndDate {
+		return fmt.Sprintf("%s (%s)", e.Name, e.StartDate)
+	}
+	return fmt.Sprintf("%s (%s to %s)", e.Name, e.StartDate, e.EndDate)
+}
@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestOrganizationAutostartCalendars(t *testing.T) {
+	t.Parallel()
+
+	client := coderdtest.New(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+
+	icsPath := filepath.Join(t.TempDir(), "holidays.ics")
+	err := os.WriteFile(icsPath, []byte("BEGIN:VCALENDAR\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"DTSTART;VALUE=DATE:20991225\r\n"+
+		"DTEND;VALUE=DATE:20991227\r\n"+
+		"SUMMARY:Christmas\r\n"+
+		"END:VEVENT\r\n"+
+		"END:VCALENDAR\r\n"), 0o600)
+	require.NoError(t, err)
+
+	// When: a calendar is set from an iCalendar file and a flag
+	ctx := testutil.Context(t, testutil.WaitMedium)
+	inv, root := clitest.New(t, "organizations", "autostart-calendars", "set", "holidays",
+		"--ics", icsPath,
+		"--exception", "Winter shutdown=2099-12-28:2099-12-31",
+		"--description", "Public holidays",
+	)
+	clitest.SetupConfig(t, client, root)
+	buf := new(bytes.Buffer)
+	inv.Stdout = buf
+	require.NoError(t, inv.WithContext(ctx).Run())
+	require.Contains(t, buf.String(), "2 exception(s)")
+
+	// Then: both exceptions are stored
+	calendars, err := client.AutostartExceptionCalendars(ctx, owner.OrganizationID)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	require.Equal(t, []codersdk.AutostartException{
+		{Name: "Christmas", StartDate: "2099-12-25", EndDate: "2099-12-26"},
+		{Name: "Winter shutdown", StartDate: "2099-12-28", EndDate: "2099-12-31"},
+	}, calendars[0].Exceptions)
+
+	// Then: the calendar is listed
+	inv, root = clitest.New(t, "organizations", "autostart-calendars", "list")
+	clitest.SetupConfig(t, client, root)
+	buf = new(bytes.Buffer)
+	inv.Stdout = buf
+	require.NoError(t, inv.WithContext(ctx).Run())
+	require.Contains(t, buf.String(), "holidays")
+	require.Contains(t, buf.String(), "Christmas (2099-12-25 to 2099-12-26)")
+
+	// When: the calendar is deleted
+	inv, root = clitest.New(t, "organizations", "autostart-calendars", "delete", "holidays", "--yes")
+	clitest.SetupConfig(t, client, root)
+	require.NoError(t, inv.WithContext(ctx).Run())
+
+	// Then: it is gone
+	calendars, err = client.AutostartExceptionCalendars(ctx, owner.OrganizationID)
+	require.NoError(t, err)
+	require.Empty(t, calendars)
+}
@@ -135,6 +135,12 @@ func parseParameterMapFile(parameterFile string) (map[string]string, error) {
 		return nil, err
 	}
 
+	return parameterMapFromValues(mapStringInterface)
+}
+
+// parameterMapFromValues converts parameter values decoded from YAML into
+// their string representation.
+func parameterMapFromValues(mapStringInterface map[string]interface{}) (map[string]string, error) {
 	parameterMap := map[string]string{}
 	for k, v := range mapStringInterface {
 		switch val := v.(type) {
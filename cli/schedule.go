@@ -45,6 +45,15 @@ When enabling scheduled stop, enter a duration in one of the following formats:
   * 3h   (3 hours)
   * 2m   (2 minutes)
   * 2    (2 minutes)
+`
+	scheduleExceptionsDescriptionLong = `Shows the autostart exceptions that apply to a workspace.
+  * Organization admins define exceptions, such as public holidays, on which workspaces are not autostarted.
+  * Use "on" to skip autostart on exception days (the default), or "off" to always autostart the workspace.
+`
+	scheduleExplainDescriptionLong = `Explains the automatic transitions of a workspace.
+  * Upcoming transitions are those due according to the workspace's schedule and its template's policies, if nothing changes.
+  * Recent decisions show the checks made each time the workspace was evaluated, and why it was or was not transitioned.
+  * Decisions are only kept for a few days.
 `
 	scheduleExtendDescriptionLong = `Extends the workspace deadline.
   * The new stop time is calculated from *now*.
@@ -56,7 +65,7 @@ When enabling scheduled stop, enter a duration in one of the following formats:
 func (r *RootCmd) schedules() *serpent.Command {
 	scheduleCmd := &serpent.Command{
 		Annotations: workspaceCommand,
-		Use:         "schedule { show | start | stop | extend } <workspace>",
+		Use:         "schedule { show | start | stop | extend | exceptions | windows | explain } <workspace>",
 		Short:       "Schedule automated start and stop times for workspaces",
 		Handler: func(inv *serpent.Invocation) error {
 			return inv.Command.HelpHandler(inv)
@@ -66,6 +75,9 @@ func (r *RootCmd) schedules() *serpent.Command {
 			r.scheduleStart(),
 			r.scheduleStop(),
 			r.scheduleExtend(),
+			r.scheduleExceptions(),
+			r.scheduleWindows(),
+			r.scheduleExplain(),
 		},
 	}
 
@@ -314,6 +326,151 @@ func (r *RootCmd) scheduleExtend() *serpent.Command {
 	return extendCmd
 }
 
+func (r *RootCmd) scheduleExceptions() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "exceptions <workspace-name> [ on | off ]",
+		Short: "Show or change whether a workspace skips autostart on its organization's exception days",
+		Long: scheduleExceptionsDescriptionLong + "\n" + FormatExamples(
+			Example{
+				Description: "Autostart the workspace even on public holidays",
+				Command:     "coder schedule exceptions my-workspace off",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(1, 2),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(inv.Args) == 2 {
+				var ignore bool
+				switch inv.Args[1] {
+				case "on":
+					ignore = false
+				case "off":
+					ignore = true
+				default:
+					return xerrors.Errorf("expected \"on\" or \"off\", got %q", inv.Args[1])
+				}
+				err = client.UpdateWorkspaceAutostartExceptions(inv.Context(), workspace.ID, codersdk.UpdateWorkspaceAutostartExceptionsRequest{
+					Ignore: ignore,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			exceptions, err := client.WorkspaceAutostartExceptions(inv.Context(), workspace.ID)
+			if err != nil {
+				return err
+			}
+			if exceptions.Ignore {
+				_, _ = fmt.Fprintf(inv.Stdout, "%s ignores its organization's autostart exceptions.\n", workspace.Name)
+			} else {
+				_, _ = fmt.Fprintf(inv.Stdout, "%s is not autostarted on its organization's exception days.\n", workspace.Name)
+			}
+			if len(exceptions.Upcoming) == 0 {
+				_, _ = fmt.Fprintln(inv.Stdout, "There are no upcoming exceptions.")
+				return nil
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, "Upcoming exceptions:")
+			for _, e := range exceptions.Upcoming {
+				_, _ = fmt.Fprintf(inv.Stdout, "  * %s\n", formatAutostartException(e))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) scheduleExplain() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "explain <workspace-name>",
+		Short: "Explain when and why a workspace is automatically started, stopped or deleted",
+		Long: scheduleExplainDescriptionLong + "\n" + FormatExamples(
+			Example{
+				Description: "Find out why a workspace was not autostarted",
+				Command:     "coder schedule explain my-workspace",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			lifecycle, err := client.WorkspaceLifecycle(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get workspace lifecycle: %w", err)
+			}
+
+			if lifecycle.DryRun {
+				cliui.Warn(inv.Stderr, "Autobuild dry run is enabled on this deployment, so transitions are recorded but not executed.")
+			}
+			if len(lifecycle.Upcoming) == 0 {
+				_, _ = fmt.Fprintf(inv.Stdout, "%s has no upcoming automatic transitions.\n", workspace.Name)
+			} else {
+				_, _ = fmt.Fprintln(inv.Stdout, "Upcoming transitions:")
+				for _, u := range lifecycle.Upcoming {
+					_, _ = fmt.Fprintf(inv.Stdout, "  * %s at %s (%s)\n",
+						lifecycleTransitionDisplay(u.Transition, u.Reason), timeDisplay(u.At), relative(time.Until(u.At)))
+				}
+			}
+
+			if len(lifecycle.Decisions) == 0 {
+				_, _ = fmt.Fprintln(inv.Stdout, "There are no recent decisions.")
+				return nil
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, "Recent decisions:")
+			for _, d := range lifecycle.Decisions {
+				line := fmt.Sprintf("  * %s %s", timeDisplay(d.CreatedAt), d.Outcome)
+				if transition := lifecycleTransitionDisplay(d.Transition, d.Reason); transition != "" {
+					line += ": " + transition
+				}
+				if d.Detail != "" {
+					line += " - " + d.Detail
+				}
+				_, _ = fmt.Fprintln(inv.Stdout, line)
+				for _, c := range d.Checks {
+					result := "failed"
+					if c.Passed {
+						result = "passed"
+					}
+					_, _ = fmt.Fprintf(inv.Stdout, "      %s %s: %s\n", c.Check, result, c.Detail)
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// lifecycleTransitionDisplay describes an automatic transition, such as
+// "stop (autostop)".
+func lifecycleTransitionDisplay(transition codersdk.WorkspaceTransition, reason codersdk.BuildReason) string {
+	if transition == "" {
+		if reason == codersdk.BuildReasonDormancy {
+			return "mark dormant (dormancy)"
+		}
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", transition, reason)
+}
+
 func displaySchedule(ws codersdk.Workspace, out io.Writer) error {
 	rows := []WorkspaceListRow{WorkspaceListRowFromWorkspace(time.Now(), ws)}
 	rendered, err := cliui.DisplayTable(rows, "workspace", []string{
@@ -342,8 +499,18 @@ func scheduleListRowFromWorkspace(now time.Time, workspace codersdk.Workspace) s
 	if !ptr.NilOrEmpty(workspace.AutostartSchedule) {
 		if sched, err := cron.Weekly(*workspace.AutostartSchedule); err == nil {
 			autostartDisplay = sched.Humanize()
-			nextStartDisplay = timeDisplay(sched.Next(now))
+			nextStart := sched.Next(now)
+			// The server's next start time also accounts for the template's
+			// allowed days and the organization's autostart exceptions, such
+			// as public holidays, so prefer it while it is current.
+			if workspace.NextStartAt != nil && workspace.NextStartAt.After(now) {
+				nextStart = *workspace.NextStartAt
+			}
+			nextStartDisplay = timeDisplay(nextStart)
 		}
+	} else if workspace.NextStartAt != nil && workspace.NextStartAt.After(now) {
+		// The workspace is only started by its additional schedule windows.
+		nextStartDisplay = timeDisplay(*workspace.NextStartAt)
 	}
 
 	autostopDisplay := ""
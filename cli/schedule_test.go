@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/coder/coder/v2/coderd/coderdtest"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/schedule"
 	"github.com/coder/coder/v2/coderd/schedule/cron"
 	"github.com/coder/coder/v2/coderd/util/tz"
 	"github.com/coder/coder/v2/codersdk"
@@ -373,3 +376,156 @@ func TestScheduleOverride(t *testing.T) {
 		})
 	}
 }
+
+//nolint:paralleltest // t.Setenv
+func TestScheduleExceptions(t *testing.T) {
+	// Given
+	t.Setenv("TZ", "Asia/Kolkata")
+	loc, err := tz.TimezoneIANA()
+	require.NoError(t, err)
+	sched, err := cron.Weekly("CRON_TZ=Europe/Dublin 30 7 * * *")
+	require.NoError(t, err, "invalid schedule")
+	ownerClient, _, _, ws := setupTestSchedule(t, sched)
+	ctx := testutil.Context(t, testutil.WaitMedium)
+	now := time.Now()
+
+	// Given: the next scheduled autostart falls on an exception
+	excepted := sched.Next(now).In(sched.Location()).Format(schedule.ExceptionDateLayout)
+	_, err = ownerClient.UpsertAutostartExceptionCalendar(ctx, ws[0].OrganizationID, "holidays", codersdk.UpsertAutostartExceptionCalendarRequest{
+		Exceptions: []codersdk.AutostartException{{Name: "Holiday", StartDate: excepted, EndDate: excepted}},
+	})
+	require.NoError(t, err)
+	expectedNext, err := schedule.NextAllowedAutostart(now, sched.String(), schedule.TemplateScheduleOptions{
+		AutostartRequirement: schedule.TemplateAutostartRequirement{DaysOfWeek: 0b01111111},
+		AutostartExceptions: schedule.AutostartExceptions{
+			{Name: "Holiday", StartDate: excepted, EndDate: excepted},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("ShowSkipsException", func(t *testing.T) {
+		// When: the start schedule is set
+		inv, root := clitest.New(t,
+			"schedule", "start", ws[0].OwnerName+"/"+ws[0].Name, "7:30AM", "Europe/Dublin",
+		)
+		//nolint:gocritic // this workspace is owned by owner
+		clitest.SetupConfig(t, ownerClient, root)
+		pty := ptytest.New(t).Attach(inv)
+		require.NoError(t, inv.Run())
+
+		// Then: the next start is the day after the exception
+		pty.ExpectMatch(ws[0].OwnerName + "/" + ws[0].Name)
+		pty.ExpectMatch(expectedNext.In(loc).Format(time.RFC3339))
+	})
+
+	t.Run("OptOut", func(t *testing.T) {
+		// When: the workspace opts out of the exceptions
+		inv, root := clitest.New(t,
+			"schedule", "exceptions", ws[0].OwnerName+"/"+ws[0].Name, "off",
+		)
+		//nolint:gocritic // this workspace is owned by owner
+		clitest.SetupConfig(t, ownerClient, root)
+		buf := new(bytes.Buffer)
+		inv.Stdout = buf
+		require.NoError(t, inv.Run())
+
+		// Then: the setting and the upcoming exception are shown
+		require.Contains(t, buf.String(), "ignores its organization's autostart exceptions")
+		require.Contains(t, buf.String(), "Holiday ("+excepted+")")
+		exceptions, err := ownerClient.WorkspaceAutostartExceptions(ctx, ws[0].ID)
+		require.NoError(t, err)
+		require.True(t, exceptions.Ignore)
+	})
+}
+
+//nolint:paralleltest // t.Setenv
+func TestScheduleWindows(t *testing.T) {
+	// Given
+	t.Setenv("TZ", "Asia/Kolkata")
+	sched, err := cron.Weekly("CRON_TZ=Europe/Dublin 0 6 * * 1-3")
+	require.NoError(t, err, "invalid schedule")
+	ownerClient, _, _, ws := setupTestSchedule(t, sched)
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	t.Run("Add", func(t *testing.T) {
+		// When: a window is added
+		inv, root := clitest.New(t,
+			"schedule", "windows", "add", ws[0].OwnerName+"/"+ws[0].Name, "2:00PM", "Thu-Fri", "Europe/Dublin", "--stop-after", "10h",
+		)
+		//nolint:gocritic // this workspace is owned by owner
+		clitest.SetupConfig(t, ownerClient, root)
+		buf := new(bytes.Buffer)
+		inv.Stdout = buf
+		require.NoError(t, inv.Run())
+
+		// Then: it is stored alongside the workspace's own schedule
+		require.Contains(t, buf.String(), "Added autostart window 1")
+		windows, err := ownerClient.WorkspaceScheduleWindows(ctx, ws[0].ID)
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		require.Equal(t, "CRON_TZ=Europe/Dublin 0 14 * * Thu-Fri", windows[0].Schedule)
+		require.Equal(t, (10 * time.Hour).Milliseconds(), *windows[0].TTLMillis)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		inv, root := clitest.New(t,
+			"schedule", "windows", "list", ws[0].OwnerName+"/"+ws[0].Name,
+		)
+		//nolint:gocritic // this workspace is owned by owner
+		clitest.SetupConfig(t, ownerClient, root)
+		buf := new(bytes.Buffer)
+		inv.Stdout = buf
+		require.NoError(t, inv.Run())
+
+		require.Contains(t, buf.String(), "Thu-Fri")
+		require.Contains(t, buf.String(), "10h")
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		inv, root := clitest.New(t,
+			"schedule", "windows", "remove", ws[0].OwnerName+"/"+ws[0].Name, "1",
+		)
+		//nolint:gocritic // this workspace is owned by owner
+		clitest.SetupConfig(t, ownerClient, root)
+		require.NoError(t, inv.Run())
+
+		windows, err := ownerClient.WorkspaceScheduleWindows(ctx, ws[0].ID)
+		require.NoError(t, err)
+		require.Empty(t, windows)
+	})
+}
+
+//nolint:paralleltest // t.Setenv
+func TestScheduleExplain(t *testing.T) {
+	// Given
+	t.Setenv("TZ", "Asia/Kolkata")
+	sched, err := cron.Weekly("CRON_TZ=Europe/Dublin 30 7 * * *")
+	require.NoError(t, err, "invalid schedule")
+	ownerClient, _, db, ws := setupTestSchedule(t, sched)
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	// Given: the executor decided not to stop the workspace
+	_, err = db.InsertWorkspaceLifecycleDecision(ctx, database.InsertWorkspaceLifecycleDecisionParams{
+		ID:          uuid.New(),
+		WorkspaceID: ws[0].ID,
+		CreatedAt:   dbtime.Now(),
+		Outcome:     database.WorkspaceLifecycleOutcomeSkipped,
+		Checks:      []byte(`[{"check":"autostop","passed":false,"detail":"deadline has not passed"}]`),
+	})
+	require.NoError(t, err)
+
+	// When: the schedule is explained
+	inv, root := clitest.New(t,
+		"schedule", "explain", ws[0].OwnerName+"/"+ws[0].Name,
+	)
+	//nolint:gocritic // this workspace is owned by owner
+	clitest.SetupConfig(t, ownerClient, root)
+	buf := new(bytes.Buffer)
+	inv.Stdout = buf
+	require.NoError(t, inv.Run())
+
+	// Then: the decision and its checks are shown
+	require.Contains(t, buf.String(), "Recent decisions:")
+	require.Contains(t, buf.String(), "skipped")
+	require.Contains(t, buf.String(), "autostop failed: deadline has not passed")
+}
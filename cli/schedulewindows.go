@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
+)
+
+const scheduleWindowsDescriptionLong = `Manages additional autostart windows of a workspace.
+  * Each window has its own start schedule, stop duration and timezone.
+  * The schedule set by "coder schedule start" and "coder schedule stop" is always the first window.
+  * When several windows start at the same time, the earlier window takes precedence.
+`
+
+func (r *RootCmd) scheduleWindows() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "windows",
+		Short: "Manage additional autostart windows of a workspace",
+		Long: scheduleWindowsDescriptionLong + "\n" + FormatExamples(
+			Example{
+				Description: "Also start the workspace at 2pm (in Dublin) on Thursday and Friday, alongside its own start schedule",
+				Command:     "coder schedule windows add my-workspace 2:00PM Thu-Fri Europe/Dublin --stop-after 10h",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.scheduleWindowsList(),
+			r.scheduleWindowsAdd(),
+			r.scheduleWindowsRemove(),
+		},
+	}
+	return cmd
+}
+
+// scheduleWindowRow is a row in the schedule windows list.
+type scheduleWindowRow struct {
+	Window     int    `json:"window" table:"window,default_sort"`
+	Schedule   string `json:"schedule" table:"schedule"`
+	StartsAt   string `json:"starts_at" table:"starts at"`
+	StopsAfter string `json:"stops_after" table:"stops after"`
+}
+
+func (r *RootCmd) scheduleWindowsList() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(
+			cliui.TableFormat([]scheduleWindowRow{}, []string{"window", "starts at", "stops after"}),
+			func(data any) (any, error) {
+				windows, ok := data.([]codersdk.WorkspaceScheduleWindow)
+				if !ok {
+					return nil, xerrors.Errorf("expected []codersdk.WorkspaceScheduleWindow got %T", data)
+				}
+
+				rows := make([]scheduleWindowRow, 0, len(windows))
+				for i, w := range windows {
+					row := scheduleWindowRow{
+						Window:   i + 1,
+						Schedule: w.Schedule,
+					}
+					if sched, err := cron.Weekly(w.Schedule); err == nil {
+						row.StartsAt = sched.Humanize()
+					}
+					if !ptr.NilOrZero(w.TTLMillis) {
+						row.StopsAfter = durationDisplay(time.Duration(*w.TTLMillis) * time.Millisecond)
+					}
+					rows = append(rows, row)
+				}
+				return rows, nil
+			},
+		),
+		cliui.JSONFormat(),
+	)
+
+	cmd := &serpent.Command{
+		Use:   "list <workspace-name>",
+		Short: "List the additional autostart windows of a workspace",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			windows, err := client.WorkspaceScheduleWindows(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get schedule windows: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), windows)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				cliui.Infof(inv.Stderr, "%s has no additional autostart windows.", workspace.Name)
+				return nil
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func (r *RootCmd) scheduleWindowsAdd() *serpent.Command {
+	var stopAfter string
+	cmd := &serpent.Command{
+		Use:   "add <workspace-name> <start-time> [day-of-week] [location]",
+		Short: "Add an autostart window to a workspace",
+		Long: "The start schedule has the same format as \"coder schedule start\".\n\n" + FormatExamples(
+			Example{
+				Description: "Start the workspace at 2pm (in Dublin) on Thursday and Friday, and stop it after 10 hours",
+				Command:     "coder schedule windows add my-workspace 2:00PM Thu-Fri Europe/Dublin --stop-after 10h",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireRangeArgs(2, 4),
+		),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "stop-after",
+				Description: "Duration after which workspaces started by the window are stopped. If unset, they are not stopped automatically.",
+				Value:       serpent.StringOf(&stopAfter),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			// Autostart configuration is not supported for prebuilt workspaces.
+			// Prebuild lifecycle is managed by the reconciliation loop, with scheduling behavior
+			// defined per preset at the template level, not per workspace.
+			if workspace.IsPrebuild {
+				return xerrors.Errorf("autostart configuration is not supported for prebuilt workspaces")
+			}
+
+			sched, err := parseCLISchedule(inv.Args[1:]...)
+			if err != nil {
+				return err
+			}
+			window := codersdk.WorkspaceScheduleWindow{
+				Schedule: sched.String(),
+			}
+			if stopAfter != "" {
+				dur, err := parseDuration(stopAfter)
+				if err != nil {
+					return err
+				}
+				window.TTLMillis = ptr.Ref(dur.Milliseconds())
+			}
+
+			windows, err := client.WorkspaceScheduleWindows(inv.Context(), workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get schedule windows: %w", err)
+			}
+			windows = append(windows, window)
+			err = client.UpdateWorkspaceScheduleWindows(inv.Context(), workspace.ID, codersdk.UpdateWorkspaceScheduleWindowsRequest{
+				Windows: windows,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(inv.Stdout, "Added autostart window %d to %s.\n", len(windows), workspace.Name)
+			updated, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			return displaySchedule(updated, inv.Stdout)
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) scheduleWindowsRemove() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "remove <workspace-name> { <window> | all }",
+		Short: "Remove autostart windows from a workspace",
+		Long: "Windows are numbered as shown by \"coder schedule windows list\".\n\n" + FormatExamples(
+			Example{
+				Command: "coder schedule windows remove my-workspace 1",
+			},
+		),
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(2),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			workspace, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+
+			windows := []codersdk.WorkspaceScheduleWindow{}
+			if inv.Args[1] != "all" {
+				windows, err = client.WorkspaceScheduleWindows(inv.Context(), workspace.ID)
+				if err != nil {
+					return xerrors.Errorf("get schedule windows: %w", err)
+				}
+				n, err := strconv.Atoi(inv.Args[1])
+				if err != nil || n < 1 || n > len(windows) {
+					return xerrors.Errorf("window must be \"all\" or between 1 and %d, got %q", len(windows), inv.Args[1])
+				}
+				windows = append(windows[:n-1], windows[n:]...)
+			}
+
+			err = client.UpdateWorkspaceScheduleWindows(inv.Context(), workspace.ID, codersdk.UpdateWorkspaceScheduleWindowsRequest{
+				Windows: windows,
+			})
+			if err != nil {
+				return err
+			}
+
+			updated, err := namedWorkspace(inv.Context(), client, inv.Args[0])
+			if err != nil {
+				return err
+			}
+			return displaySchedule(updated, inv.Stdout)
+		},
+	}
+	return cmd
+}
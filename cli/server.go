@@ -91,6 +91,7 @@ import (
 	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/coderd/runtimeconfig"
 	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
 	"github.com/coder/coder/v2/coderd/telemetry"
 	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/coder/v2/coderd/updatecheck"
@@ -350,6 +351,10 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 				return xerrors.Errorf("access-url must include a scheme (e.g. 'http://' or 'https://)")
 			}
 
+			if p := vals.Prebuilds.AutoscalingTargetHitProbability.Value(); p <= 0 || p >= 1 {
+				return xerrors.Errorf("workspace-prebuilds-autoscaling-target-hit-probability must be between 0 and 1 (exclusive), got %v", p)
+			}
+
 			// Cross-field configuration validation after initial parsing.
 			if err := vals.Validate(); err != nil {
 				return err
@@ -936,7 +941,20 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			// The notification manager is responsible for:
 			//   - creating notifiers and managing their lifecycles (notifiers are responsible for dequeueing/sending notifications)
 			//   - keeping the store updated with status updates
-			notificationsManager, err = notifications.NewManager(notificationsCfg, options.Database, options.Pubsub, helpers, metrics, logger.Named("notifications.manager"))
+			notificationsManager, err = notifications.NewManager(notificationsCfg, options.Database, options.Pubsub, helpers, metrics, logger.Named("notifications.manager"),
+				notifications.WithQuietHours(func(ctx context.Context, userID uuid.UUID) (*cron.Schedule, error) {
+					// The store is replaced by the enterprise implementation when the feature is entitled.
+					store := options.UserQuietHoursScheduleStore.Load()
+					if store == nil {
+						return nil, nil
+					}
+					// nolint:gocritic // The notifier is not permitted to read users.
+					opts, err := (*store).Get(dbauthz.AsSystemRestricted(ctx), options.Database, userID)
+					if err != nil {
+						return nil, err
+					}
+					return opts.Schedule, nil
+				}))
 			if err != nil {
 				return xerrors.Errorf("failed to instantiate notification manager: %w", err)
 			}
@@ -948,6 +966,10 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			notificationReportGenerator := reports.NewReportGenerator(ctx, logger.Named("notifications.report_generator"), options.Database, options.NotificationsEnqueuer, quartz.NewReal())
 			defer notificationReportGenerator.Close()
 
+			// Run digest generator to deliver notifications which users have chosen to receive as a digest.
+			notificationDigestGenerator := reports.NewDigestGenerator(ctx, logger.Named("notifications.digest_generator"), options.Database, helpers, notificationsCfg.DigestWindow.Value(), quartz.NewReal())
+			defer notificationDigestGenerator.Close()
+
 			// We use a separate coderAPICloser so the Enterprise API
 			// can have its own close functions. This is cleaner
 			// than abstracting the Coder API itself.
@@ -1028,7 +1050,7 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			defer shutdownConns()
 
 			// Ensures that old database entries are cleaned up over time!
-			purger := dbpurge.New(ctx, logger.Named("dbpurge"), options.Database, quartz.NewReal())
+			purger := dbpurge.New(ctx, logger.Named("dbpurge"), options.Database, vals, quartz.NewReal())
 			defer purger.Close()
 
 			// Updates workspace usage
@@ -1100,7 +1122,12 @@ func (r *RootCmd) Server(newAPI func(context.Context, *coderd.Options) (*coderd.
 			autobuildTicker := time.NewTicker(vals.AutobuildPollInterval.Value())
 			defer autobuildTicker.Stop()
 			autobuildExecutor := autobuild.NewExecutor(
-				ctx, options.Database, options.Pubsub, coderAPI.FileCache, options.PrometheusRegistry, coderAPI.TemplateScheduleStore, &coderAPI.Auditor, coderAPI.AccessControlStore, coderAPI.BuildUsageChecker, logger, autobuildTicker.C, options.NotificationsEnqueuer, coderAPI.Experiments)
+				ctx, options.Database, options.Pubsub, coderAPI.FileCache, options.PrometheusRegistry, coderAPI.TemplateScheduleStore, &coderAPI.Auditor, coderAPI.AccessControlStore, coderAPI.BuildUsageChecker, logger, autobuildTicker.C, options.NotificationsEnqueuer, coderAPI.Experiments).
+				WithTaskLifecyclePolicy(vals.AI.TasksConfig).
+				WithDryRun(vals.AutobuildDryRun.Value())
+			if vals.AutobuildDryRun.Value() {
+				logger.Warn(ctx, "autobuild dry run is enabled, scheduled workspace transitions will be recorded but not executed")
+			}
 			autobuildExecutor.Run()
 
 			jobReaperTicker := time.NewTicker(vals.JobReaperDetectorInterval.Value())
@@ -1087,6 +1087,25 @@ func TestServer(t *testing.T) {
 		require.ErrorContains(t, err, "must not be empty")
 	})
 
+	t.Run("InvalidAutoscalingTargetHitProbability", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+
+		for _, probability := range []string{"0", "1", "1.5", "-0.5"} {
+			inv, _ := clitest.New(t,
+				"server",
+				dbArg(t),
+				"--http-address", ":0",
+				"--access-url", "http://example.com",
+				"--workspace-prebuilds-autoscaling-target-hit-probability", probability,
+			)
+			err := inv.WithContext(ctx).Run()
+			require.Error(t, err)
+			require.ErrorContains(t, err, "must be between 0 and 1", "probability %s", probability)
+		}
+	})
+
 	// DeprecatedAddress is a test for the deprecated --address flag. If
 	// specified, --http-address and --tls-address are both ignored, a warning
 	// is printed, and the server will either be HTTP-only or TLS-only depending
@@ -35,6 +35,7 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 		requireActiveVersion           bool
 		deprecationMessage             string
 		disableEveryone                bool
+		recordSessions                 bool
 		orgContext                     = NewOrganizationContext()
 	)
 
@@ -169,6 +170,11 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 				disableEveryoneGroup = disableEveryone
 			}
 
+			var recordSessionsOpt *bool
+			if userSetOption(inv, "record-sessions") {
+				recordSessionsOpt = &recordSessions
+			}
+
 			req := codersdk.UpdateTemplateMeta{
 				Name:               name,
 				DisplayName:        &displayName,
@@ -192,6 +198,7 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 				RequireActiveVersion:           requireActiveVersion,
 				DeprecationMessage:             deprecated,
 				DisableEveryoneGroupAccess:     disableEveryoneGroup,
+				RecordSessions:                 recordSessionsOpt,
 			}
 
 			_, err = client.UpdateTemplateMeta(inv.Context(), template.ID, req)
@@ -304,6 +311,12 @@ func (r *RootCmd) templateEdit() *serpent.Command {
 			Value:   serpent.BoolOf(&disableEveryone),
 			Default: "false",
 		},
+		{
+			Flag: "record-sessions",
+			Description: "Record the terminal output of SSH and web terminal sessions to workspaces created from this template. " +
+				"Recordings can be played back from the matching connection log.",
+			Value: serpent.BoolOf(&recordSessions),
+		},
 		cliui.SkipPromptOption(),
 	}
 	orgContext.AttachOptions(cmd)
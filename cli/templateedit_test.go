@@ -836,6 +836,35 @@ func TestTemplateEdit(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorContains(t, err, "appears to be an AGPL deployment")
 	})
+	t.Run("RecordSessions", func(t *testing.T) {
+		t.Parallel()
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+
+		version := coderdtest.CreateTemplateVersion(t, client, owner.OrganizationID, nil)
+		_ = coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, owner.OrganizationID, version.ID)
+		require.False(t, template.RecordSessions)
+
+		inv, root := clitest.New(t, "templates", "edit", template.Name, "--record-sessions")
+		clitest.SetupConfig(t, client, root)
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		updated, err := client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.True(t, updated.RecordSessions)
+
+		// Editing another field leaves recording enabled.
+		inv, root = clitest.New(t, "templates", "edit", template.Name, "--description", "recorded")
+		clitest.SetupConfig(t, client, root)
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		updated, err = client.Template(ctx, template.ID)
+		require.NoError(t, err)
+		require.True(t, updated.RecordSessions)
+	})
 	t.Run("DefaultValues", func(t *testing.T) {
 		t.Parallel()
 		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
@@ -52,6 +52,7 @@ type API struct {
 	*ScriptsAPI
 	*ConnLogAPI
 	*SubAgentAPI
+	*SessionRecordingAPI
 	*tailnet.DRPCService
 
 	mu sync.Mutex
@@ -88,6 +89,9 @@ type Options struct {
 	DerpMapUpdateFrequency    time.Duration
 	ExternalAuthConfigs       []*externalauth.Config
 	Experiments               codersdk.Experiments
+	// SessionRecordingMaxSize is the maximum size in bytes of a single
+	// session recording.
+	SessionRecordingMaxSize int64
 
 	UpdateAgentMetricsFn func(ctx context.Context, labels prometheusmetrics.AgentMetricLabels, metrics []*agentproto.Stats_Metric)
 }
@@ -112,6 +116,7 @@ func New(opts Options) *API {
 		Database:                 opts.Database,
 		DerpMapFn:                opts.DerpMapFn,
 		WorkspaceID:              opts.WorkspaceID,
+		SessionRecordingMaxSize:  opts.SessionRecordingMaxSize,
 	}
 
 	api.AnnouncementBannerAPI = &AnnouncementBannerAPI{
@@ -205,6 +210,13 @@ func New(opts Options) *API {
 		Database:       opts.Database,
 	}
 
+	api.SessionRecordingAPI = &SessionRecordingAPI{
+		AgentFn:      api.agent,
+		Database:     opts.Database,
+		Log:          opts.Log,
+		MaxSizeBytes: opts.SessionRecordingMaxSize,
+	}
+
 	return api
 }
 
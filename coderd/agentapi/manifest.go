@@ -31,6 +31,10 @@ type ManifestAPI struct {
 	DisableDirectConnections bool
 	DerpForceWebSockets      bool
 	WorkspaceID              uuid.UUID
+	// SessionRecordingMaxSize is the maximum size in bytes of a single
+	// session recording. It is sent to agents of templates that have
+	// session recording enabled.
+	SessionRecordingMaxSize int64
 
 	AgentFn   func(context.Context) (database.WorkspaceAgent, error)
 	Database  database.Store
@@ -89,6 +93,12 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		return nil, xerrors.Errorf("fetching workspace agent data: %w", err)
 	}
 
+	// nolint:gocritic // The agent needs to know whether its template records sessions.
+	template, err := a.Database.GetTemplateByID(dbauthz.AsSystemRestricted(ctx), workspace.TemplateID)
+	if err != nil {
+		return nil, xerrors.Errorf("getting template by id: %w", err)
+	}
+
 	appSlug := appurl.ApplicationURL{
 		AppSlugOrPort: "{{port}}",
 		AgentName:     workspaceAgent.Name,
@@ -134,6 +144,10 @@ func (a *ManifestAPI) GetManifest(ctx context.Context, _ *agentproto.GetManifest
 		DisableDirectConnections: a.DisableDirectConnections,
 		DerpForceWebsockets:      a.DerpForceWebSockets,
 		ParentId:                 parentID,
+		SessionRecording: &agentproto.SessionRecordingConfig{
+			Enabled:      template.RecordSessions,
+			MaxSizeBytes: a.SessionRecordingMaxSize,
+		},
 
 		DerpMap:       tailnet.DERPMapToProto(a.DerpMapFn()),
 		Scripts:       dbAgentScriptsToProto(scripts),
@@ -45,11 +45,16 @@ func TestGetManifest(t *testing.T) {
 			ID:       uuid.New(),
 			Username: "cool-user",
 		}
+		template = database.Template{
+			ID:             uuid.New(),
+			RecordSessions: true,
+		}
 		workspace = database.Workspace{
 			ID:            uuid.New(),
 			OwnerID:       owner.ID,
 			OwnerUsername: owner.Username,
 			Name:          "cool-workspace",
+			TemplateID:    template.ID,
 		}
 		agent = database.WorkspaceAgent{
 			ID:   uuid.New(),
@@ -321,6 +326,7 @@ func TestGetManifest(t *testing.T) {
 			},
 			DisableDirectConnections: true,
 			DerpForceWebSockets:      true,
+			SessionRecordingMaxSize:  50 << 20,
 
 			AgentFn: func(ctx context.Context) (database.WorkspaceAgent, error) {
 				return agent, nil
@@ -338,6 +344,7 @@ func TestGetManifest(t *testing.T) {
 		}).Return(metadata, nil)
 		mDB.EXPECT().GetWorkspaceAgentDevcontainersByAgentID(gomock.Any(), agent.ID).Return(devcontainers, nil)
 		mDB.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Return(workspace, nil)
+		mDB.EXPECT().GetTemplateByID(gomock.Any(), template.ID).Return(template, nil)
 
 		got, err := api.GetManifest(context.Background(), &agentproto.GetManifestRequest{})
 		require.NoError(t, err)
@@ -364,6 +371,10 @@ func TestGetManifest(t *testing.T) {
 			Apps:          protoApps,
 			Metadata:      protoMetadata,
 			Devcontainers: protoDevcontainers,
+			SessionRecording: &agentproto.SessionRecordingConfig{
+				Enabled:      true,
+				MaxSizeBytes: 50 << 20,
+			},
 		}
 
 		// Log got and expected with spew.
@@ -388,6 +399,7 @@ func TestGetManifest(t *testing.T) {
 			},
 			DisableDirectConnections: true,
 			DerpForceWebSockets:      true,
+			SessionRecordingMaxSize:  50 << 20,
 
 			AgentFn: func(ctx context.Context) (database.WorkspaceAgent, error) {
 				return childAgent, nil
@@ -405,6 +417,7 @@ func TestGetManifest(t *testing.T) {
 		}).Return([]database.WorkspaceAgentMetadatum{}, nil)
 		mDB.EXPECT().GetWorkspaceAgentDevcontainersByAgentID(gomock.Any(), childAgent.ID).Return([]database.WorkspaceAgentDevcontainer{}, nil)
 		mDB.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Return(workspace, nil)
+		mDB.EXPECT().GetTemplateByID(gomock.Any(), template.ID).Return(template, nil)
 
 		got, err := api.GetManifest(context.Background(), &agentproto.GetManifestRequest{})
 		require.NoError(t, err)
@@ -431,6 +444,10 @@ func TestGetManifest(t *testing.T) {
 			Apps:          []*agentproto.WorkspaceApp{},
 			Metadata:      []*agentproto.WorkspaceAgentMetadata_Description{},
 			Devcontainers: []*agentproto.WorkspaceAgentDevcontainer{},
+			SessionRecording: &agentproto.SessionRecordingConfig{
+				Enabled:      true,
+				MaxSizeBytes: 50 << 20,
+			},
 		}
 
 		require.Equal(t, expected, got)
@@ -511,6 +528,7 @@ func TestGetManifest(t *testing.T) {
 			},
 			DisableDirectConnections: true,
 			DerpForceWebSockets:      true,
+			SessionRecordingMaxSize:  50 << 20,
 
 			AgentFn: func(ctx context.Context) (database.WorkspaceAgent, error) {
 				return agent, nil
@@ -528,6 +546,7 @@ func TestGetManifest(t *testing.T) {
 		}).Return(metadata, nil)
 		mDB.EXPECT().GetWorkspaceAgentDevcontainersByAgentID(gomock.Any(), agent.ID).Return(devcontainers, nil)
 		mDB.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Return(workspace, nil)
+		mDB.EXPECT().GetTemplateByID(gomock.Any(), template.ID).Return(template, nil)
 
 		got, err := api.GetManifest(context.Background(), &agentproto.GetManifestRequest{})
 		require.NoError(t, err)
@@ -553,6 +572,10 @@ func TestGetManifest(t *testing.T) {
 			Apps:          protoApps,
 			Metadata:      protoMetadata,
 			Devcontainers: protoDevcontainers,
+			SessionRecording: &agentproto.SessionRecordingConfig{
+				Enabled:      true,
+				MaxSizeBytes: 50 << 20,
+			},
 		}
 
 		// Log got and expected with spew.
@@ -0,0 +1,107 @@
+package agentapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+)
+
+type SessionRecordingAPI struct {
+	AgentFn  func(context.Context) (database.WorkspaceAgent, error)
+	Database database.Store
+	Log      slog.Logger
+	// MaxSizeBytes is the maximum size of a single recording. Chunks that
+	// would grow a recording past this limit are dropped and the recording is
+	// marked as truncated. Zero means unlimited.
+	MaxSizeBytes int64
+}
+
+func (a *SessionRecordingAPI) UploadSessionRecording(ctx context.Context, req *agentproto.UploadSessionRecordingRequest) (*agentproto.UploadSessionRecordingResponse, error) {
+	connectionID, err := uuid.FromBytes(req.GetConnectionId())
+	if err != nil {
+		return nil, xerrors.Errorf("connection id from bytes: %w", err)
+	}
+	if connectionID == uuid.Nil {
+		return nil, xerrors.New("connection ID cannot be nil")
+	}
+	if req.GetSequence() < 0 {
+		return nil, xerrors.Errorf("invalid sequence number %d", req.GetSequence())
+	}
+
+	workspaceAgent, err := a.AgentFn(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("get agent: %w", err)
+	}
+	workspace, err := a.Database.GetWorkspaceByAgentID(ctx, workspaceAgent.ID)
+	if err != nil {
+		return nil, xerrors.Errorf("get workspace by agent id: %w", err)
+	}
+	// The agent is told whether to record sessions in its manifest, but the
+	// template may have been changed since, or the agent may not honor it.
+	// nolint:gocritic // The agent needs to know whether its template records sessions.
+	template, err := a.Database.GetTemplateByID(dbauthz.AsSystemRestricted(ctx), workspace.TemplateID)
+	if err != nil {
+		return nil, xerrors.Errorf("get template by id: %w", err)
+	}
+	if !template.RecordSessions {
+		return nil, xerrors.New("session recording is disabled for this template")
+	}
+
+	params := database.UpsertWorkspaceSessionRecordingParams{
+		ID:             uuid.New(),
+		CreatedAt:      dbtime.Now(),
+		OrganizationID: workspace.OrganizationID,
+		WorkspaceID:    workspace.ID,
+		AgentName:      workspaceAgent.Name,
+		ConnectionID:   connectionID,
+		Truncated:      req.GetTruncated(),
+		Completed:      req.GetFinal(),
+	}
+	// Recordings are linked to connection logs and share their permissions.
+	// nolint:gocritic // The agent cannot write connection logs itself.
+	recording, err := a.Database.UpsertWorkspaceSessionRecording(dbauthz.AsConnectionLogger(ctx), params)
+	if err != nil {
+		return nil, xerrors.Errorf("upsert session recording: %w", err)
+	}
+
+	data := req.GetData()
+	if len(data) == 0 {
+		return &agentproto.UploadSessionRecordingResponse{}, nil
+	}
+
+	if a.MaxSizeBytes > 0 && recording.SizeBytes+int64(len(data)) > a.MaxSizeBytes {
+		if !recording.Truncated {
+			a.Log.Debug(ctx, "session recording exceeds maximum size, dropping chunk",
+				slog.F("connection_id", connectionID),
+				slog.F("size_bytes", recording.SizeBytes),
+				slog.F("max_size_bytes", a.MaxSizeBytes),
+			)
+			params.Truncated = true
+			// nolint:gocritic // The agent cannot write connection logs itself.
+			_, err = a.Database.UpsertWorkspaceSessionRecording(dbauthz.AsConnectionLogger(ctx), params)
+			if err != nil {
+				return nil, xerrors.Errorf("mark session recording truncated: %w", err)
+			}
+		}
+		return &agentproto.UploadSessionRecordingResponse{}, nil
+	}
+
+	// nolint:gocritic // The agent cannot write connection logs itself.
+	err = a.Database.InsertWorkspaceSessionRecordingChunk(dbauthz.AsConnectionLogger(ctx), database.InsertWorkspaceSessionRecordingChunkParams{
+		RecordingID: recording.ID,
+		Seq:         req.GetSequence(),
+		Data:        data,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("insert session recording chunk: %w", err)
+	}
+
+	return &agentproto.UploadSessionRecordingResponse{}, nil
+}
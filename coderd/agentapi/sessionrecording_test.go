@@ -0,0 +1,138 @@
+package agentapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	agentproto "github.com/coder/coder/v2/agent/proto"
+	"github.com/coder/coder/v2/coderd/agentapi"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbmock"
+)
+
+func TestUploadSessionRecording(t *testing.T) {
+	t.Parallel()
+
+	var (
+		template = database.Template{
+			ID:             uuid.New(),
+			RecordSessions: true,
+		}
+		workspace = database.Workspace{
+			ID:             uuid.New(),
+			OrganizationID: uuid.New(),
+			TemplateID:     template.ID,
+		}
+		agent = database.WorkspaceAgent{
+			ID:   uuid.New(),
+			Name: "dev",
+		}
+		connectionID = uuid.New()
+	)
+
+	upsertMatcher := func(truncated, completed bool) gomock.Matcher {
+		return gomock.Cond(func(x any) bool {
+			p, ok := x.(database.UpsertWorkspaceSessionRecordingParams)
+			return ok &&
+				p.WorkspaceID == workspace.ID &&
+				p.OrganizationID == workspace.OrganizationID &&
+				p.AgentName == agent.Name &&
+				p.ConnectionID == connectionID &&
+				p.Truncated == truncated &&
+				p.Completed == completed
+		})
+	}
+
+	newAPI := func(t *testing.T, maxSize int64) (*agentapi.SessionRecordingAPI, *dbmock.MockStore) {
+		mDB := dbmock.NewMockStore(gomock.NewController(t))
+		mDB.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agent.ID).Return(workspace, nil)
+		mDB.EXPECT().GetTemplateByID(gomock.Any(), template.ID).Return(template, nil)
+		return &agentapi.SessionRecordingAPI{
+			AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+				return agent, nil
+			},
+			Database:     mDB,
+			Log:          slogtest.Make(t, nil),
+			MaxSizeBytes: maxSize,
+		}, mDB
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		api, mDB := newAPI(t, 1024)
+		recording := database.WorkspaceSessionRecording{ID: uuid.New(), SizeBytes: 10}
+		mDB.EXPECT().UpsertWorkspaceSessionRecording(gomock.Any(), upsertMatcher(false, true)).Return(recording, nil)
+		mDB.EXPECT().InsertWorkspaceSessionRecordingChunk(gomock.Any(), database.InsertWorkspaceSessionRecordingChunkParams{
+			RecordingID: recording.ID,
+			Seq:         3,
+			Data:        []byte("hello"),
+		}).Return(nil)
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			ConnectionId: connectionID[:],
+			Sequence:     3,
+			Data:         []byte("hello"),
+			Final:        true,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("ExceedsMaxSize", func(t *testing.T) {
+		t.Parallel()
+
+		api, mDB := newAPI(t, 12)
+		recording := database.WorkspaceSessionRecording{ID: uuid.New(), SizeBytes: 10}
+		mDB.EXPECT().UpsertWorkspaceSessionRecording(gomock.Any(), upsertMatcher(false, false)).Return(recording, nil)
+		recording.Truncated = true
+		mDB.EXPECT().UpsertWorkspaceSessionRecording(gomock.Any(), upsertMatcher(true, false)).Return(recording, nil)
+		// No chunk must be inserted.
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			ConnectionId: connectionID[:],
+			Sequence:     0,
+			Data:         []byte("hello"),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("RecordingDisabled", func(t *testing.T) {
+		t.Parallel()
+
+		mDB := dbmock.NewMockStore(gomock.NewController(t))
+		mDB.EXPECT().GetWorkspaceByAgentID(gomock.Any(), agent.ID).Return(workspace, nil)
+		disabled := template
+		disabled.RecordSessions = false
+		mDB.EXPECT().GetTemplateByID(gomock.Any(), template.ID).Return(disabled, nil)
+		// No recording must be created.
+		api := &agentapi.SessionRecordingAPI{
+			AgentFn: func(context.Context) (database.WorkspaceAgent, error) {
+				return agent, nil
+			},
+			Database: mDB,
+			Log:      slogtest.Make(t, nil),
+		}
+
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			ConnectionId: connectionID[:],
+			Data:         []byte("hello"),
+		})
+		require.ErrorContains(t, err, "disabled")
+	})
+
+	t.Run("NilConnectionID", func(t *testing.T) {
+		t.Parallel()
+
+		api := &agentapi.SessionRecordingAPI{}
+		_, err := api.UploadSessionRecording(context.Background(), &agentproto.UploadSessionRecordingRequest{
+			ConnectionId: uuid.Nil[:],
+			Data:         []byte("hello"),
+		})
+		require.Error(t, err)
+	})
+}
@@ -18,6 +18,9 @@ import (
 	"cdr.dev/slog"
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpapi/httperror"
 	"github.com/coder/coder/v2/coderd/httpmw"
@@ -107,6 +110,46 @@ func (api *API) tasksCreate(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	input := req.Input
+	if len(req.Steps) > 0 {
+		if req.Input != "" {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Only one of input or steps may be given.",
+			})
+			return
+		}
+		var validations []codersdk.ValidationError
+		for i, step := range req.Steps {
+			if strings.TrimSpace(step.Prompt) == "" {
+				validations = append(validations, codersdk.ValidationError{
+					Field:  fmt.Sprintf("steps[%d].prompt", i),
+					Detail: "Step prompt is required.",
+				})
+			}
+		}
+		if len(validations) > 0 {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message:     "Invalid task steps.",
+				Validations: validations,
+			})
+			return
+		}
+		input = req.Steps[0].Prompt
+	}
+
+	for i, param := range req.RichParameterValues {
+		if param.Name == codersdk.AITaskPromptParameterName {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Invalid task parameters.",
+				Validations: []codersdk.ValidationError{{
+					Field:  fmt.Sprintf("rich_parameter_values[%d].name", i),
+					Detail: fmt.Sprintf("The %q parameter is set from the task input.", codersdk.AITaskPromptParameterName),
+				}},
+			})
+			return
+		}
+	}
+
 	hasAITask, err := api.Database.GetTemplateVersionHasAITask(ctx, req.TemplateVersionID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || rbac.IsUnauthorizedError(err) {
@@ -144,7 +187,7 @@ func (api *API) tasksCreate(rw http.ResponseWriter, r *http.Request) {
 		if anthropicAPIKey := taskname.GetAnthropicAPIKeyFromEnv(); anthropicAPIKey != "" {
 			anthropicModel := taskname.GetAnthropicModelFromEnv()
 
-			generatedName, err := taskname.Generate(ctx, req.Input, taskname.WithAPIKey(anthropicAPIKey), taskname.WithModel(anthropicModel))
+			generatedName, err := taskname.Generate(ctx, input, taskname.WithAPIKey(anthropicAPIKey), taskname.WithModel(anthropicModel))
 			if err != nil {
 				api.Logger.Error(ctx, "unable to generate task name", slog.Error(err))
 			} else {
@@ -157,9 +200,9 @@ func (api *API) tasksCreate(rw http.ResponseWriter, r *http.Request) {
 		Name:                    taskName,
 		TemplateVersionID:       req.TemplateVersionID,
 		TemplateVersionPresetID: req.TemplateVersionPresetID,
-		RichParameterValues: []codersdk.WorkspaceBuildParameter{
-			{Name: codersdk.AITaskPromptParameterName, Value: req.Input},
-		},
+		RichParameterValues: append([]codersdk.WorkspaceBuildParameter{
+			{Name: codersdk.AITaskPromptParameterName, Value: input},
+		}, req.RichParameterValues...),
 	}
 
 	var owner workspaceOwner
@@ -208,6 +251,11 @@ func (api *API) tasksCreate(rw http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if err := api.checkRunningTaskLimit(ctx, api.Database, owner.ID, uuid.Nil); err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+
 	aReq, commitAudit := audit.InitRequest[database.WorkspaceTable](rw, &audit.RequestParams{
 		Audit:   *auditor,
 		Log:     api.Logger,
@@ -218,17 +266,86 @@ func (api *API) tasksCreate(rw http.ResponseWriter, r *http.Request) {
 		},
 	})
 	defer commitAudit()
-	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, createReq, r)
+
+	// The steps are inserted along with the workspace, so that a task is
+	// never left without the steps it was created with. The first step is
+	// running as soon as the task starts, since its prompt is the task's
+	// initial prompt.
+	var steps []database.TaskStep
+	insertSteps := func(db database.Store, workspace database.Workspace) error {
+		steps = make([]database.TaskStep, 0, len(req.Steps))
+		for i, step := range req.Steps {
+			arg := database.InsertTaskStepParams{
+				WorkspaceID:     workspace.ID,
+				StepIndex:       int32(i), //nolint:gosec // The number of steps is bounded by the request size.
+				Prompt:          step.Prompt,
+				CompletionCheck: step.CompletionCheck,
+				Status:          database.TaskStepStatusPending,
+			}
+			if i == 0 {
+				arg.Status = database.TaskStepStatusRunning
+				arg.StartedAt = sql.NullTime{Time: dbtime.Time(workspace.CreatedAt), Valid: true}
+			}
+			inserted, err := db.InsertTaskStep(ctx, arg)
+			if err != nil {
+				return httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+					Message: "Internal error inserting task steps.",
+					Detail:  err.Error(),
+				})
+			}
+			steps = append(steps, inserted)
+		}
+		return nil
+	}
+	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, createReq, r, insertSteps)
 	if err != nil {
 		httperror.WriteResponseError(ctx, rw, err)
 		return
 	}
 
-	task := taskFromWorkspace(w, req.Input)
+	task := taskFromWorkspace(w, input, steps)
 	httpapi.Write(ctx, rw, http.StatusCreated, task)
 }
 
-func taskFromWorkspace(ws codersdk.Workspace, initialPrompt string) codersdk.Task {
+// checkRunningTaskLimit returns an error if the given user already runs as
+// many tasks as the deployment allows, so that starting another one would
+// exceed the limit. Starting the task of the given workspace again does not
+// count if it is already running. The lifecycle executor stops the tasks of
+// users who exceed the limit anyway, e.g. when tasks are started concurrently.
+func (api *API) checkRunningTaskLimit(ctx context.Context, db database.Store, ownerID, workspaceID uuid.UUID) error {
+	maxRunning := api.DeploymentValues.AI.TasksConfig.MaxRunningPerUser.Value()
+	if maxRunning <= 0 {
+		return nil
+	}
+	// nolint:gocritic // The owner's running tasks are counted regardless of
+	// whether the caller can read them.
+	tasks, err := db.GetTaskWorkspacesForLifecyclePolicy(dbauthz.AsSystemRestricted(ctx), ownerID)
+	if err != nil {
+		return httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching running tasks.",
+			Detail:  err.Error(),
+		})
+	}
+	running := 0
+	for _, task := range tasks {
+		if !task.Running() {
+			continue
+		}
+		if task.WorkspaceID == workspaceID {
+			return nil
+		}
+		running++
+	}
+	if int64(running) >= maxRunning {
+		return httperror.NewResponseError(http.StatusConflict, codersdk.Response{
+			Message: fmt.Sprintf("The limit of %d running tasks per user has been reached.", maxRunning),
+			Detail:  "Stop or delete a running task before starting another one.",
+		})
+	}
+	return nil
+}
+
+func taskFromWorkspace(ws codersdk.Workspace, initialPrompt string, steps []database.TaskStep) codersdk.Task {
 	// TODO(DanielleMaywood):
 	// This just picks up the first agent it discovers.
 	// This approach _might_ break when a task has multiple agents,
@@ -295,6 +412,7 @@ func taskFromWorkspace(ws codersdk.Workspace, initialPrompt string) codersdk.Tas
 		CreatedAt:               ws.CreatedAt,
 		UpdatedAt:               ws.UpdatedAt,
 		InitialPrompt:           initialPrompt,
+		Steps:                   db2sdk.TaskSteps(steps),
 		Status:                  ws.LatestBuild.Status,
 		CurrentState:            currentState,
 	}
@@ -320,9 +438,24 @@ func (api *API) tasksFromWorkspaces(ctx context.Context, apiWorkspaces []codersd
 		}
 	}
 
+	workspaceIDs := make([]uuid.UUID, 0, len(apiWorkspaces))
+	for _, ws := range apiWorkspaces {
+		workspaceIDs = append(workspaceIDs, ws.ID)
+	}
+	// The workspaces have already been authorized.
+	// nolint:gocritic // Reading the steps of authorized workspaces.
+	steps, err := api.Database.GetTaskStepsByWorkspaceIDs(dbauthz.AsSystemRestricted(ctx), workspaceIDs)
+	if err != nil {
+		return nil, err
+	}
+	stepsByWorkspaceID := make(map[uuid.UUID][]database.TaskStep)
+	for _, step := range steps {
+		stepsByWorkspaceID[step.WorkspaceID] = append(stepsByWorkspaceID[step.WorkspaceID], step)
+	}
+
 	tasks := make([]codersdk.Task, 0, len(apiWorkspaces))
 	for _, ws := range apiWorkspaces {
-		tasks = append(tasks, taskFromWorkspace(ws, promptsByBuildID[ws.LatestBuild.ID]))
+		tasks = append(tasks, taskFromWorkspace(ws, promptsByBuildID[ws.LatestBuild.ID], stepsByWorkspaceID[ws.ID]))
 	}
 
 	return tasks, nil
@@ -495,17 +628,28 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	task, err := api.taskFromDatabaseWorkspace(ctx, apiKey.UserID, workspace)
+	if err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, task)
+}
+
+// taskFromDatabaseWorkspace converts an already authorized workspace into a
+// task. It returns httperror.ErrResourceNotFound if the workspace is not a
+// task.
+func (api *API) taskFromDatabaseWorkspace(ctx context.Context, requesterID uuid.UUID, workspace database.Workspace) (codersdk.Task, error) {
 	data, err := api.workspaceData(ctx, []database.Workspace{workspace})
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		return codersdk.Task{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error fetching workspace resources.",
 			Detail:  err.Error(),
 		})
-		return
 	}
 	if len(data.builds) == 0 || len(data.templates) == 0 {
-		httpapi.ResourceNotFound(rw)
-		return
+		return codersdk.Task{}, httperror.ErrResourceNotFound
 	}
 	if data.builds[0].HasAITask == nil || !*data.builds[0].HasAITask {
 		// TODO(DanielleMaywood):
@@ -519,11 +663,10 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 		// special "AI Task" parameter.
 		parameters, err := api.Database.GetWorkspaceBuildParameters(ctx, data.builds[0].ID)
 		if err != nil {
-			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			return codersdk.Task{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 				Message: "Internal error fetching workspace build parameters.",
 				Detail:  err.Error(),
 			})
-			return
 		}
 
 		_, hasAITask := slice.Find(parameters, func(t database.WorkspaceBuildParameter) bool {
@@ -531,8 +674,7 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 		})
 
 		if !hasAITask {
-			httpapi.ResourceNotFound(rw)
-			return
+			return codersdk.Task{}, httperror.ErrResourceNotFound
 		}
 	}
 
@@ -542,7 +684,7 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	ws, err := convertWorkspace(
-		apiKey.UserID,
+		requesterID,
 		workspace,
 		data.builds[0],
 		data.templates[0],
@@ -550,23 +692,20 @@ func (api *API) taskGet(rw http.ResponseWriter, r *http.Request) {
 		appStatus,
 	)
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		return codersdk.Task{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error converting workspace.",
 			Detail:  err.Error(),
 		})
-		return
 	}
 
 	tasks, err := api.tasksFromWorkspaces(ctx, []codersdk.Workspace{ws})
 	if err != nil {
-		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+		return codersdk.Task{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error fetching task prompt and state.",
 			Detail:  err.Error(),
 		})
-		return
 	}
-
-	httpapi.Write(ctx, rw, http.StatusOK, tasks[0])
+	return tasks[0], nil
 }
 
 // @Summary Delete AI task by ID
@@ -694,47 +833,53 @@ func (api *API) taskSend(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	if err = api.authAndDoWithTaskSidebarAppClient(r, taskID, func(ctx context.Context, client *http.Client, appURL *url.URL) error {
-		agentAPIClient, err := aiagentapi.NewClient(appURL.String(), aiagentapi.WithHTTPClient(client))
-		if err != nil {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Failed to create agentapi client.",
-				Detail:  err.Error(),
-			})
-		}
+		return sendTaskInput(ctx, client, appURL, req.Input)
+	}); err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
 
-		statusResp, err := agentAPIClient.GetStatus(ctx)
-		if err != nil {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Failed to get status from task app.",
-				Detail:  err.Error(),
-			})
-		}
+	rw.WriteHeader(http.StatusNoContent)
+}
 
-		if statusResp.Status != aiagentapi.StatusStable {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Task app is not ready to accept input.",
-				Detail:  fmt.Sprintf("Status: %s", statusResp.Status),
-			})
-		}
+// sendTaskInput posts input to the task's AI agent through the sidebar app,
+// provided the agent is ready to accept it.
+func sendTaskInput(ctx context.Context, client *http.Client, appURL *url.URL, input string) error {
+	agentAPIClient, err := aiagentapi.NewClient(appURL.String(), aiagentapi.WithHTTPClient(client))
+	if err != nil {
+		return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to create agentapi client.",
+			Detail:  err.Error(),
+		})
+	}
 
-		_, err = agentAPIClient.PostMessage(ctx, aiagentapi.PostMessageParams{
-			Content: req.Input,
-			Type:    aiagentapi.MessageTypeUser,
+	statusResp, err := agentAPIClient.GetStatus(ctx)
+	if err != nil {
+		return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to get status from task app.",
+			Detail:  err.Error(),
 		})
-		if err != nil {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Task app rejected the message.",
-				Detail:  err.Error(),
-			})
-		}
+	}
 
-		return nil
-	}); err != nil {
-		httperror.WriteResponseError(ctx, rw, err)
-		return
+	if statusResp.Status != aiagentapi.StatusStable {
+		return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Task app is not ready to accept input.",
+			Detail:  fmt.Sprintf("Status: %s", statusResp.Status),
+		})
 	}
 
-	rw.WriteHeader(http.StatusNoContent)
+	_, err = agentAPIClient.PostMessage(ctx, aiagentapi.PostMessageParams{
+		Content: input,
+		Type:    aiagentapi.MessageTypeUser,
+	})
+	if err != nil {
+		return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Task app rejected the message.",
+			Detail:  err.Error(),
+		})
+	}
+
+	return nil
 }
 
 // @Summary Get AI task logs
@@ -764,42 +909,9 @@ func (api *API) taskLogs(rw http.ResponseWriter, r *http.Request) {
 
 	var out codersdk.TaskLogsResponse
 	if err := api.authAndDoWithTaskSidebarAppClient(r, taskID, func(ctx context.Context, client *http.Client, appURL *url.URL) error {
-		agentAPIClient, err := aiagentapi.NewClient(appURL.String(), aiagentapi.WithHTTPClient(client))
+		logs, err := getTaskLogs(ctx, client, appURL)
 		if err != nil {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Failed to create agentapi client.",
-				Detail:  err.Error(),
-			})
-		}
-
-		messagesResp, err := agentAPIClient.GetMessages(ctx)
-		if err != nil {
-			return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-				Message: "Failed to get messages from task app.",
-				Detail:  err.Error(),
-			})
-		}
-
-		logs := make([]codersdk.TaskLogEntry, 0, len(messagesResp.Messages))
-		for _, m := range messagesResp.Messages {
-			var typ codersdk.TaskLogType
-			switch m.Role {
-			case aiagentapi.RoleUser:
-				typ = codersdk.TaskLogTypeInput
-			case aiagentapi.RoleAgent:
-				typ = codersdk.TaskLogTypeOutput
-			default:
-				return httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
-					Message: "Invalid task app response message role.",
-					Detail:  fmt.Sprintf(`Expected "user" or "agent", got %q.`, m.Role),
-				})
-			}
-			logs = append(logs, codersdk.TaskLogEntry{
-				ID:      int(m.Id),
-				Content: m.Content,
-				Type:    typ,
-				Time:    m.Time,
-			})
+			return err
 		}
 		out = codersdk.TaskLogsResponse{Logs: logs}
 		return nil
@@ -811,6 +923,49 @@ func (api *API) taskLogs(rw http.ResponseWriter, r *http.Request) {
 	httpapi.Write(ctx, rw, http.StatusOK, out)
 }
 
+// getTaskLogs reads the messages of the task's AI agent through the sidebar
+// app and converts them into task log entries.
+func getTaskLogs(ctx context.Context, client *http.Client, appURL *url.URL) ([]codersdk.TaskLogEntry, error) {
+	agentAPIClient, err := aiagentapi.NewClient(appURL.String(), aiagentapi.WithHTTPClient(client))
+	if err != nil {
+		return nil, httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to create agentapi client.",
+			Detail:  err.Error(),
+		})
+	}
+
+	messagesResp, err := agentAPIClient.GetMessages(ctx)
+	if err != nil {
+		return nil, httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+			Message: "Failed to get messages from task app.",
+			Detail:  err.Error(),
+		})
+	}
+
+	logs := make([]codersdk.TaskLogEntry, 0, len(messagesResp.Messages))
+	for _, m := range messagesResp.Messages {
+		var typ codersdk.TaskLogType
+		switch m.Role {
+		case aiagentapi.RoleUser:
+			typ = codersdk.TaskLogTypeInput
+		case aiagentapi.RoleAgent:
+			typ = codersdk.TaskLogTypeOutput
+		default:
+			return nil, httperror.NewResponseError(http.StatusBadGateway, codersdk.Response{
+				Message: "Invalid task app response message role.",
+				Detail:  fmt.Sprintf(`Expected "user" or "agent", got %q.`, m.Role),
+			})
+		}
+		logs = append(logs, codersdk.TaskLogEntry{
+			ID:      int(m.Id),
+			Content: m.Content,
+			Type:    typ,
+			Time:    m.Time,
+		})
+	}
+	return logs, nil
+}
+
 // authAndDoWithTaskSidebarAppClient centralizes the shared logic to:
 //
 //   - Fetch the task workspace
@@ -844,6 +999,17 @@ func (api *API) authAndDoWithTaskSidebarAppClient(
 		return httperror.ErrResourceNotFound
 	}
 
+	return api.doWithTaskSidebarAppClient(ctx, workspace, do)
+}
+
+// doWithTaskSidebarAppClient validates the AI task and sidebar app health of an
+// already authorized workspace, and calls do with an HTTP client which dials
+// the sidebar app through the agent.
+func (api *API) doWithTaskSidebarAppClient(
+	ctx context.Context,
+	workspace database.Workspace,
+	do func(ctx context.Context, client *http.Client, appURL *url.URL) error,
+) error {
 	data, err := api.workspaceData(ctx, []database.Workspace{workspace})
 	if err != nil {
 		return httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
@@ -2,11 +2,15 @@ package coderd_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 	"unicode/utf8"
@@ -647,6 +651,410 @@ func TestTasks(t *testing.T) {
 		})
 	})
 
+	t.Run("Steps", func(t *testing.T) {
+		t.Parallel()
+
+		if runtime.GOOS == "windows" {
+			t.Skip("completion checks use POSIX shell commands")
+		}
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+
+		// Start a fake AgentAPI which records the prompts it is sent.
+		messages := make(chan string, 10)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/status":
+				_, _ = io.WriteString(w, `{"status": "stable"}`)
+			case r.Method == http.MethodPost && r.URL.Path == "/message":
+				var msg struct {
+					Content string `json:"content"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				messages <- msg.Content
+				_, _ = io.WriteString(w, `{"ok": true}`)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer srv.Close()
+
+		authToken := uuid.NewString()
+		template := createAITemplate(t, client, owner, withSidebarURL(srv.URL), withAgentToken(authToken))
+
+		// Given: a task whose second step passes its check and whose third
+		// step fails it.
+		ctx := testutil.Context(t, testutil.WaitLong)
+		exp := codersdk.NewExperimentalClient(client)
+		task, err := exp.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Steps: []codersdk.CreateTaskStep{
+				{Prompt: "Upgrade the logging dependency."},
+				{Prompt: "Run the tests.", CompletionCheck: "true"},
+				{Prompt: "Open a pull request.", CompletionCheck: "exit 3"},
+				{Prompt: "Never sent."},
+			},
+		})
+		require.NoError(t, err)
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		agentClient := agentsdk.New(client.URL, agentsdk.WithFixedToken(authToken))
+		_ = agenttest.New(t, client.URL, authToken, func(o *agent.Options) {
+			o.Client = agentClient
+		})
+		coderdtest.NewWorkspaceAgentWaiter(t, client, ws.ID).WithContext(ctx).WaitFor(coderdtest.AgentsReady)
+
+		report := func(state codersdk.WorkspaceAppStatusState) {
+			t.Helper()
+			require.NoError(t, agentClient.PatchAppStatus(ctx, agentsdk.PatchAppStatus{
+				AppSlug: "task-sidebar",
+				State:   state,
+				Message: string(state),
+			}))
+		}
+		stepStatuses := func() []codersdk.TaskStepStatus {
+			task, err := exp.TaskByID(ctx, task.ID)
+			if !assert.NoError(t, err) {
+				return nil
+			}
+			statuses := make([]codersdk.TaskStepStatus, 0, len(task.Steps))
+			for _, step := range task.Steps {
+				statuses = append(statuses, step.Status)
+			}
+			return statuses
+		}
+
+		// When: the agent reports the first step idle.
+		report(codersdk.WorkspaceAppStatusStateWorking)
+		report(codersdk.WorkspaceAppStatusStateIdle)
+
+		// Then: the second prompt is sent.
+		require.Equal(t, "Run the tests.", testutil.RequireReceive(ctx, t, messages))
+		require.Eventually(t, func() bool {
+			return slices.Equal(stepStatuses(), []codersdk.TaskStepStatus{
+				codersdk.TaskStepStatusCompleted,
+				codersdk.TaskStepStatusRunning,
+				codersdk.TaskStepStatusPending,
+				codersdk.TaskStepStatusPending,
+			})
+		}, testutil.WaitMedium, testutil.IntervalFast)
+
+		// When: the agent reports the second step idle.
+		report(codersdk.WorkspaceAppStatusStateWorking)
+		report(codersdk.WorkspaceAppStatusStateIdle)
+
+		// Then: its check passes, and the third prompt is sent.
+		require.Equal(t, "Open a pull request.", testutil.RequireReceive(ctx, t, messages))
+
+		// When: the agent reports the third step idle.
+		report(codersdk.WorkspaceAppStatusStateWorking)
+		report(codersdk.WorkspaceAppStatusStateIdle)
+
+		// Then: its check fails, so the task stops without sending the last prompt.
+		require.Eventually(t, func() bool {
+			return slices.Equal(stepStatuses(), []codersdk.TaskStepStatus{
+				codersdk.TaskStepStatusCompleted,
+				codersdk.TaskStepStatusCompleted,
+				codersdk.TaskStepStatusFailed,
+				codersdk.TaskStepStatusPending,
+			})
+		}, testutil.WaitMedium, testutil.IntervalFast)
+		task, err = exp.TaskByID(ctx, task.ID)
+		require.NoError(t, err)
+		require.NotNil(t, task.Steps[1].CheckExitCode)
+		assert.EqualValues(t, 0, *task.Steps[1].CheckExitCode)
+		require.NotNil(t, task.Steps[2].CheckExitCode)
+		assert.EqualValues(t, 3, *task.Steps[2].CheckExitCode)
+		require.Empty(t, messages)
+	})
+
+	t.Run("StepsRecovery", func(t *testing.T) {
+		t.Parallel()
+
+		client, db := coderdtest.NewWithDatabase(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+
+		// Start a fake AgentAPI which records the prompts it is sent.
+		messages := make(chan string, 10)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/status":
+				_, _ = io.WriteString(w, `{"status": "stable"}`)
+			case r.Method == http.MethodPost && r.URL.Path == "/message":
+				var msg struct {
+					Content string `json:"content"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				messages <- msg.Content
+				_, _ = io.WriteString(w, `{"ok": true}`)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer srv.Close()
+
+		authToken := uuid.NewString()
+		template := createAITemplate(t, client, owner, withSidebarURL(srv.URL), withAgentToken(authToken))
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		exp := codersdk.NewExperimentalClient(client)
+		task, err := exp.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Steps: []codersdk.CreateTaskStep{
+				{Prompt: "Upgrade the logging dependency."},
+				{Prompt: "Run the tests."},
+				{Prompt: "Open a pull request."},
+			},
+		})
+		require.NoError(t, err)
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		agentClient := agentsdk.New(client.URL, agentsdk.WithFixedToken(authToken))
+		_ = agenttest.New(t, client.URL, authToken, func(o *agent.Options) {
+			o.Client = agentClient
+		})
+		coderdtest.NewWorkspaceAgentWaiter(t, client, ws.ID).WithContext(ctx).WaitFor(coderdtest.AgentsReady)
+
+		report := func(state codersdk.WorkspaceAppStatusState) {
+			t.Helper()
+			require.NoError(t, agentClient.PatchAppStatus(ctx, agentsdk.PatchAppStatus{
+				AppSlug: "task-sidebar",
+				State:   state,
+				Message: string(state),
+			}))
+		}
+		updateStep := func(index int32, from, to database.TaskStepStatus, updatedAt time.Time) {
+			t.Helper()
+			_, err := db.UpdateTaskStepStatus(dbauthz.AsSystemRestricted(ctx), database.UpdateTaskStepStatusParams{
+				WorkspaceID: ws.ID,
+				StepIndex:   index,
+				Status:      to,
+				FromStatus:  from,
+				UpdatedAt:   updatedAt,
+			})
+			require.NoError(t, err)
+		}
+
+		report(codersdk.WorkspaceAppStatusStateWorking)
+		report(codersdk.WorkspaceAppStatusStateIdle)
+		require.Equal(t, "Run the tests.", testutil.RequireReceive(ctx, t, messages))
+
+		// Given: the prompt of the second step could not be sent.
+		updateStep(1, database.TaskStepStatusRunning, database.TaskStepStatusPending, dbtime.Now())
+
+		// When: the agent reports that it is still idle.
+		report(codersdk.WorkspaceAppStatusStateIdle)
+
+		// Then: the prompt is sent again.
+		require.Equal(t, "Run the tests.", testutil.RequireReceive(ctx, t, messages))
+
+		// Given: a replica stopped while checking the second step, long ago.
+		require.Eventually(t, func() bool {
+			steps, err := db.GetTaskStepsByWorkspaceID(dbauthz.AsSystemRestricted(ctx), ws.ID)
+			return assert.NoError(t, err) && steps[1].Status == database.TaskStepStatusRunning
+		}, testutil.WaitMedium, testutil.IntervalFast)
+		updateStep(1, database.TaskStepStatusRunning, database.TaskStepStatusChecking, dbtime.Now().Add(-time.Hour))
+
+		// When: the agent reports that it is still idle.
+		report(codersdk.WorkspaceAppStatusStateIdle)
+
+		// Then: the step is checked again, and the third prompt is sent.
+		require.Equal(t, "Open a pull request.", testutil.RequireReceive(ctx, t, messages))
+		require.Empty(t, messages)
+	})
+
+	t.Run("Watch", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+
+		// Start a fake AgentAPI whose messages can be appended to.
+		var (
+			messagesMu sync.Mutex
+			messages   []string
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/status":
+				_, _ = io.WriteString(w, `{"status": "stable"}`)
+			case r.Method == http.MethodGet && r.URL.Path == "/messages":
+				messagesMu.Lock()
+				defer messagesMu.Unlock()
+				resp := struct {
+					Messages []map[string]any `json:"messages"`
+				}{Messages: []map[string]any{}}
+				for i, content := range messages {
+					resp.Messages = append(resp.Messages, map[string]any{
+						"id":      i,
+						"content": content,
+						"role":    "agent",
+						"time":    time.Now().UTC().Format(time.RFC3339Nano),
+					})
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(resp))
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer srv.Close()
+
+		authToken := uuid.NewString()
+		template := createAITemplate(t, client, owner, withSidebarURL(srv.URL), withAgentToken(authToken))
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		ws := coderdtest.CreateWorkspace(t, client, template.ID, func(req *codersdk.CreateWorkspaceRequest) {
+			req.RichParameterValues = []codersdk.WorkspaceBuildParameter{
+				{Name: codersdk.AITaskPromptParameterName, Value: "watch me"},
+			}
+		})
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+		agentClient := agentsdk.New(client.URL, agentsdk.WithFixedToken(authToken))
+		_ = agenttest.New(t, client.URL, authToken, func(o *agent.Options) {
+			o.Client = agentClient
+		})
+		coderdtest.NewWorkspaceAgentWaiter(t, client, ws.ID).WithContext(ctx).WaitFor(coderdtest.AgentsReady)
+
+		// Given: a watched task.
+		exp := codersdk.NewExperimentalClient(client)
+		events, err := exp.WatchTask(ctx, "me", ws.ID)
+		require.NoError(t, err)
+
+		// Then: the current task is sent first.
+		event := testutil.RequireReceive(ctx, t, events)
+		require.Equal(t, codersdk.TaskEventTypeStatus, event.Type)
+		require.NotNil(t, event.Task)
+		assert.Equal(t, ws.ID, event.Task.ID)
+		assert.Equal(t, codersdk.WorkspaceStatusRunning, event.Task.Status)
+
+		// When: the agent writes a message and reports its state.
+		messagesMu.Lock()
+		messages = append(messages, "Reticulating splines...")
+		messagesMu.Unlock()
+		require.NoError(t, agentClient.PatchAppStatus(ctx, agentsdk.PatchAppStatus{
+			AppSlug: "task-sidebar",
+			State:   codersdk.WorkspaceAppStatusStateWorking,
+			Message: "Reticulating",
+		}))
+
+		// Then: the new state and log entry are sent.
+		var gotState, gotLog bool
+		for !gotState || !gotLog {
+			event := testutil.RequireReceive(ctx, t, events)
+			switch event.Type {
+			case codersdk.TaskEventTypeState:
+				require.NotNil(t, event.Task)
+				require.NotNil(t, event.Task.CurrentState)
+				assert.Equal(t, codersdk.TaskStateWorking, event.Task.CurrentState.State)
+				assert.Equal(t, "Reticulating", event.Task.CurrentState.Message)
+				gotState = true
+			case codersdk.TaskEventTypeLog:
+				require.NotNil(t, event.Log)
+				assert.Equal(t, 0, event.Log.ID)
+				assert.Equal(t, "Reticulating splines...", event.Log.Content)
+				gotLog = true
+			}
+		}
+
+		// When: the task is stopped.
+		coderdtest.MustTransitionWorkspace(t, client, ws.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+
+		// Then: its status change is sent.
+		for {
+			event := testutil.RequireReceive(ctx, t, events)
+			if event.Type == codersdk.TaskEventTypeStatus && event.Task.Status == codersdk.WorkspaceStatusStopped {
+				break
+			}
+		}
+	})
+
+	t.Run("WatchSteps", func(t *testing.T) {
+		t.Parallel()
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		owner := coderdtest.CreateFirstUser(t, client)
+
+		// Start a fake AgentAPI which accepts prompts.
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/status":
+				_, _ = io.WriteString(w, `{"status": "stable"}`)
+			case r.Method == http.MethodPost && r.URL.Path == "/message":
+				_, _ = io.WriteString(w, `{"ok": true}`)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer srv.Close()
+
+		authToken := uuid.NewString()
+		template := createAITemplate(t, client, owner, withSidebarURL(srv.URL), withAgentToken(authToken))
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+		exp := codersdk.NewExperimentalClient(client)
+		task, err := exp.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Steps: []codersdk.CreateTaskStep{
+				{Prompt: "Upgrade the logging dependency."},
+				{Prompt: "Run the tests."},
+			},
+		})
+		require.NoError(t, err)
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		agentClient := agentsdk.New(client.URL, agentsdk.WithFixedToken(authToken))
+		_ = agenttest.New(t, client.URL, authToken, func(o *agent.Options) {
+			o.Client = agentClient
+		})
+		coderdtest.NewWorkspaceAgentWaiter(t, client, ws.ID).WithContext(ctx).WaitFor(coderdtest.AgentsReady)
+
+		// Given: all of the user's tasks are watched.
+		events, err := exp.WatchTasks(ctx, "me")
+		require.NoError(t, err)
+
+		// Then: the existing task is sent first.
+		event := testutil.RequireReceive(ctx, t, events)
+		require.Equal(t, codersdk.TaskEventTypeStatus, event.Type)
+		require.NotNil(t, event.Task)
+		assert.Equal(t, task.ID, event.Task.ID)
+		require.Len(t, event.Task.Steps, 2)
+
+		// When: the agent reports the first step idle.
+		for _, state := range []codersdk.WorkspaceAppStatusState{codersdk.WorkspaceAppStatusStateWorking, codersdk.WorkspaceAppStatusStateIdle} {
+			require.NoError(t, agentClient.PatchAppStatus(ctx, agentsdk.PatchAppStatus{
+				AppSlug: "task-sidebar",
+				State:   state,
+				Message: string(state),
+			}))
+		}
+
+		// Then: the step transition is sent, although it happens after the
+		// agent's report was published.
+		for {
+			event := testutil.RequireReceive(ctx, t, events)
+			if event.Type != codersdk.TaskEventTypeStatus {
+				continue
+			}
+			require.NotNil(t, event.Task)
+			require.Len(t, event.Task.Steps, 2)
+			if event.Task.Steps[0].Status == codersdk.TaskStepStatusCompleted &&
+				event.Task.Steps[1].Status == codersdk.TaskStepStatusRunning {
+				break
+			}
+		}
+	})
+
 	t.Run("Logs", func(t *testing.T) {
 		t.Parallel()
 
@@ -904,6 +1312,303 @@ func TestTasksCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("Steps", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		user := coderdtest.CreateFirstUser(t, client)
+
+		// Given: A template with an "AI Prompt" parameter
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+			Parse:          echo.ParseComplete,
+			ProvisionApply: echo.ApplyComplete,
+			ProvisionPlan: []*proto.Response{
+				{Type: &proto.Response_Plan{Plan: &proto.PlanComplete{
+					Parameters: []*proto.RichParameter{{Name: "AI Prompt", Type: "string"}},
+					HasAiTasks: true,
+				}}},
+			},
+		})
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// When: We create a Task with several steps.
+		task, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Steps: []codersdk.CreateTaskStep{
+				{Prompt: "Upgrade the logging dependency."},
+				{Prompt: "Run the tests and fix any failures.", CompletionCheck: "make test"},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, task.WorkspaceID.Valid)
+
+		// Then: We expect the first step to be running, and the next to be pending.
+		require.Len(t, task.Steps, 2)
+		assert.Equal(t, codersdk.TaskStepStatusRunning, task.Steps[0].Status)
+		assert.NotNil(t, task.Steps[0].StartedAt)
+		assert.Equal(t, codersdk.TaskStepStatusPending, task.Steps[1].Status)
+		assert.Equal(t, "make test", task.Steps[1].CompletionCheck)
+
+		// And: We expect the first step's prompt to be the "AI Prompt" parameter.
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+		parameters, err := client.WorkspaceBuildParameters(ctx, ws.LatestBuild.ID)
+		require.NoError(t, err)
+		require.Len(t, parameters, 1)
+		assert.Equal(t, "Upgrade the logging dependency.", parameters[0].Value)
+
+		// And: We expect the steps to be returned when fetching the task.
+		fetched, err := expClient.TaskByID(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, task.Steps, fetched.Steps)
+	})
+
+	t.Run("StepsInsertFailure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		user := coderdtest.CreateFirstUser(t, client)
+
+		// Given: A template with an "AI Prompt" parameter
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+			Parse:          echo.ParseComplete,
+			ProvisionApply: echo.ApplyComplete,
+			ProvisionPlan: []*proto.Response{
+				{Type: &proto.Response_Plan{Plan: &proto.PlanComplete{
+					Parameters: []*proto.RichParameter{{Name: "AI Prompt", Type: "string"}},
+					HasAiTasks: true,
+				}}},
+			},
+		})
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// When: We create a Task with a step which cannot be stored, as
+		// Postgres does not allow NUL characters in text.
+		_, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Steps: []codersdk.CreateTaskStep{
+				{Prompt: "Upgrade the logging dependency."},
+				{Prompt: "Open a pull request.\x00"},
+			},
+		})
+
+		// Then: We expect the task to fail to be created.
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		assert.Equal(t, http.StatusInternalServerError, sdkErr.StatusCode())
+		assert.Equal(t, "Internal error inserting task steps.", sdkErr.Message)
+
+		// And: We expect the workspace to not have been created either.
+		workspaces, err := client.Workspaces(ctx, codersdk.WorkspaceFilter{Owner: codersdk.Me})
+		require.NoError(t, err)
+		assert.Empty(t, workspaces.Workspaces)
+	})
+
+	t.Run("RichParameterValues", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		user := coderdtest.CreateFirstUser(t, client)
+
+		// Given: A template with an "AI Prompt" parameter and a repository
+		// parameter.
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+			Parse:          echo.ParseComplete,
+			ProvisionApply: echo.ApplyComplete,
+			ProvisionPlan: []*proto.Response{
+				{Type: &proto.Response_Plan{Plan: &proto.PlanComplete{
+					Parameters: []*proto.RichParameter{
+						{Name: "AI Prompt", Type: "string"},
+						{Name: "repository", Type: "string", Mutable: true},
+					},
+					HasAiTasks: true,
+				}}},
+			},
+		})
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// When: We create a Task with a value for the repository parameter.
+		task, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Input:             "Some task prompt",
+			RichParameterValues: []codersdk.WorkspaceBuildParameter{
+				{Name: "repository", Value: "github.com/coder/coder"},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, task.WorkspaceID.Valid)
+
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		// Then: We expect both parameters to be set.
+		parameters, err := client.WorkspaceBuildParameters(ctx, ws.LatestBuild.ID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []codersdk.WorkspaceBuildParameter{
+			{Name: codersdk.AITaskPromptParameterName, Value: "Some task prompt"},
+			{Name: "repository", Value: "github.com/coder/coder"},
+		}, parameters)
+	})
+
+	t.Run("FailsOnPromptParameter", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		_ = coderdtest.CreateFirstUser(t, client)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// When: We attempt to create a Task which sets the prompt parameter.
+		_, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: uuid.New(),
+			Input:             "Some task prompt",
+			RichParameterValues: []codersdk.WorkspaceBuildParameter{
+				{Name: codersdk.AITaskPromptParameterName, Value: "Another task prompt"},
+			},
+		})
+
+		// Then: We expect it to fail.
+		var sdkErr *codersdk.Error
+		require.Error(t, err)
+		require.ErrorAsf(t, err, &sdkErr, "error should be of type *codersdk.Error")
+		assert.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	})
+
+	t.Run("FailsOnStepsAndInput", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true})
+		_ = coderdtest.CreateFirstUser(t, client)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// When: We attempt to create a Task with both input and steps.
+		_, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: uuid.New(),
+			Input:             "Some task prompt",
+			Steps:             []codersdk.CreateTaskStep{{Prompt: "Another task prompt"}},
+		})
+
+		// Then: We expect it to fail.
+		var sdkErr *codersdk.Error
+		require.Error(t, err)
+		require.ErrorAsf(t, err, &sdkErr, "error should be of type *codersdk.Error")
+		assert.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	})
+
+	t.Run("FailsOnRunningTaskLimit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+
+		dv := coderdtest.DeploymentValues(t)
+		dv.AI.TasksConfig.MaxRunningPerUser = 1
+		client := coderdtest.New(t, &coderdtest.Options{IncludeProvisionerDaemon: true, DeploymentValues: dv})
+		user := coderdtest.CreateFirstUser(t, client)
+
+		// Given: A template with an AI task
+		taskAppID := uuid.New()
+		version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+			Parse: echo.ParseComplete,
+			ProvisionPlan: []*proto.Response{
+				{Type: &proto.Response_Plan{Plan: &proto.PlanComplete{
+					Parameters: []*proto.RichParameter{{Name: "AI Prompt", Type: "string"}},
+					HasAiTasks: true,
+				}}},
+			},
+			ProvisionApply: []*proto.Response{
+				{Type: &proto.Response_Apply{Apply: &proto.ApplyComplete{
+					Resources: []*proto.Resource{{
+						Name: "example",
+						Type: "aws_instance",
+						Agents: []*proto.Agent{{
+							Id:   uuid.NewString(),
+							Name: "example",
+							Apps: []*proto.App{{Id: taskAppID.String(), Slug: "task-sidebar"}},
+						}},
+					}},
+					AiTasks: []*proto.AITask{{SidebarApp: &proto.AITaskSidebarApp{Id: taskAppID.String()}}},
+				}}},
+			},
+		})
+		coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+		template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+
+		expClient := codersdk.NewExperimentalClient(client)
+
+		// And: A running task
+		task, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Input:             "Some task prompt",
+		})
+		require.NoError(t, err)
+		ws, err := client.Workspace(ctx, task.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		// When: We attempt to create another Task.
+		_, err = expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Input:             "Another task prompt",
+		})
+
+		// Then: We expect it to fail.
+		var sdkErr *codersdk.Error
+		require.Error(t, err)
+		require.ErrorAsf(t, err, &sdkErr, "error should be of type *codersdk.Error")
+		assert.Equal(t, http.StatusConflict, sdkErr.StatusCode())
+
+		// When: The running task is stopped.
+		ws = coderdtest.MustTransitionWorkspace(t, client, ws.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+
+		// Then: Another Task can be created.
+		another, err := expClient.CreateTask(ctx, "me", codersdk.CreateTaskRequest{
+			TemplateVersionID: template.ActiveVersionID,
+			Input:             "Another task prompt",
+		})
+		require.NoError(t, err)
+		anotherWs, err := client.Workspace(ctx, another.WorkspaceID.UUID)
+		require.NoError(t, err)
+		coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, anotherWs.LatestBuild.ID)
+
+		// When: We attempt to start the stopped Task again.
+		_, err = client.CreateWorkspaceBuild(ctx, ws.ID, codersdk.CreateWorkspaceBuildRequest{
+			Transition: codersdk.WorkspaceTransitionStart,
+		})
+
+		// Then: We expect it to fail too.
+		require.ErrorAsf(t, err, &sdkErr, "error should be of type *codersdk.Error")
+		assert.Equal(t, http.StatusConflict, sdkErr.StatusCode())
+
+		// But: The running Task can still be restarted.
+		_, err = client.CreateWorkspaceBuild(ctx, anotherWs.ID, codersdk.CreateWorkspaceBuildRequest{
+			Transition: codersdk.WorkspaceTransitionStart,
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("FailsOnNonTaskTemplate", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,343 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/wspubsub"
+)
+
+const (
+	// taskStepCheckTimeout bounds how long a step's completion check may run
+	// in the workspace.
+	taskStepCheckTimeout = 10 * time.Minute
+	// taskStepSendTimeout bounds how long to wait for the task app to accept
+	// the next step's prompt. The agent may report itself idle slightly
+	// before the task app is ready for input.
+	taskStepSendTimeout  = 2 * time.Minute
+	taskStepSendInterval = 5 * time.Second
+	// taskStepStaleTimeout is how long a step may keep its status before it
+	// is considered abandoned, e.g. by a replica which stopped while checking
+	// it. It must exceed the check and send timeouts, so that steps which are
+	// still being worked on are not recovered.
+	taskStepStaleTimeout = 15 * time.Minute
+)
+
+// advanceTaskSteps moves a multi-step task on to its next step in the
+// background, once the task's AI agent has reported that it is idle. Tasks
+// without steps are left untouched. becameIdle is false if the agent was
+// already idle, in which case only steps whose prompt could not be sent, or
+// which were abandoned, are advanced.
+func (api *API) advanceTaskSteps(workspace database.Workspace, agentID, appID uuid.UUID, becameIdle bool) {
+	// Like WebSockets, advancing a task outlives the request which triggered
+	// it, so it must finish before the API is closed.
+	api.WebsocketWaitMutex.Lock()
+	api.WebsocketWaitGroup.Add(1)
+	api.WebsocketWaitMutex.Unlock()
+	go func() {
+		defer api.WebsocketWaitGroup.Done()
+
+		// nolint:gocritic // Task steps are advanced by the system on behalf of the task's agent.
+		ctx := dbauthz.AsSystemRestricted(api.ctx)
+		logger := api.Logger.With(slog.F("workspace_id", workspace.ID))
+		if err := api.runTaskSteps(ctx, logger, workspace, agentID, appID, becameIdle); err != nil {
+			logger.Warn(ctx, "advance task steps", slog.Error(err))
+		}
+	}()
+}
+
+// recoverTaskSteps advances the tasks whose next step has not changed status
+// for a while. This retries prompts which could not be sent while the agent
+// stays idle, and picks up steps abandoned by a replica which stopped.
+func (api *API) recoverTaskSteps() error {
+	// nolint:gocritic // Task steps are recovered by the system.
+	ctx := dbauthz.AsSystemRestricted(api.ctx)
+	steps, err := api.Database.GetStaleTaskSteps(ctx, dbtime.Now().Add(-taskStepStaleTimeout))
+	if err != nil {
+		api.Logger.Warn(ctx, "get stale task steps", slog.Error(err))
+		// Returning an error would stop the ticker.
+		return nil
+	}
+	for _, step := range steps {
+		logger := api.Logger.With(slog.F("workspace_id", step.WorkspaceID))
+		workspace, err := api.Database.GetWorkspaceByID(ctx, step.WorkspaceID)
+		if err != nil {
+			logger.Warn(ctx, "get workspace of stale task step", slog.Error(err))
+			continue
+		}
+		build, err := api.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, step.WorkspaceID)
+		if err != nil {
+			logger.Warn(ctx, "get latest workspace build of stale task step", slog.Error(err))
+			continue
+		}
+		if !build.AITaskSidebarAppID.Valid {
+			continue
+		}
+		// Steps only move on once the task's agent has reported the state
+		// of its app, so the agent is the one which reported it last.
+		statuses, err := api.Database.GetLatestWorkspaceAppStatusesByAppID(ctx, build.AITaskSidebarAppID.UUID)
+		if err != nil {
+			logger.Warn(ctx, "get workspace app statuses of stale task step", slog.Error(err))
+			continue
+		}
+		if len(statuses) == 0 {
+			continue
+		}
+		api.advanceTaskSteps(workspace, statuses[0].AgentID, build.AITaskSidebarAppID.UUID, false)
+	}
+	return nil
+}
+
+func (api *API) runTaskSteps(ctx context.Context, logger slog.Logger, workspace database.Workspace, agentID, appID uuid.UUID, becameIdle bool) error {
+	steps, err := api.Database.GetTaskStepsByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return xerrors.Errorf("get task steps: %w", err)
+	}
+	i := slices.IndexFunc(steps, func(step database.TaskStep) bool {
+		return step.Status != database.TaskStepStatusCompleted
+	})
+	if i == -1 {
+		return nil
+	}
+
+	// Only the task's sidebar app reports the progress of its steps.
+	build, err := api.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return xerrors.Errorf("get latest workspace build: %w", err)
+	}
+	if !build.AITaskSidebarAppID.Valid || build.AITaskSidebarAppID.UUID != appID {
+		return nil
+	}
+
+	step := steps[i]
+	stale := dbtime.Now().Sub(step.UpdatedAt) > taskStepStaleTimeout
+	switch step.Status {
+	case database.TaskStepStatusRunning, database.TaskStepStatusChecking:
+		// Claim the step, so that it is only checked once even if several
+		// idle reports arrive at once.
+		claim := database.UpdateTaskStepStatusParams{
+			WorkspaceID: workspace.ID,
+			StepIndex:   step.StepIndex,
+			Status:      database.TaskStepStatusChecking,
+			FromStatus:  step.Status,
+			UpdatedAt:   dbtime.Now(),
+		}
+		if step.Status == database.TaskStepStatusChecking || !becameIdle {
+			// The step is only recovered once it is stale, and only by the
+			// first caller to see it so.
+			if !stale {
+				return nil
+			}
+			claim.FromUpdatedAt = sql.NullTime{Time: step.UpdatedAt, Valid: true}
+		}
+		if step.Status == database.TaskStepStatusRunning && !becameIdle {
+			idle, worked, err := api.taskAppActivitySince(ctx, appID, step.UpdatedAt)
+			if err != nil {
+				return err
+			}
+			if !idle {
+				return nil
+			}
+			if !worked {
+				// The agent never picked up the prompt of the step, so send it
+				// again.
+				claim.Status = database.TaskStepStatusPending
+			}
+		}
+		_, err := api.updateTaskStepStatus(ctx, workspace, claim)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return xerrors.Errorf("claim task step %d: %w", step.StepIndex, err)
+		}
+		if claim.Status == database.TaskStepStatusPending {
+			break
+		}
+
+		status := database.TaskStepStatusCompleted
+		var exitCode sql.NullInt32
+		if step.CompletionCheck != "" {
+			code, err := api.runTaskStepCheck(ctx, agentID, step.CompletionCheck)
+			if err != nil {
+				logger.Warn(ctx, "run task step completion check", slog.F("step", step.StepIndex), slog.Error(err))
+				status = database.TaskStepStatusFailed
+			} else {
+				exitCode = sql.NullInt32{Int32: code, Valid: true}
+				if code != 0 {
+					status = database.TaskStepStatusFailed
+				}
+			}
+		}
+		_, err = api.updateTaskStepStatus(ctx, workspace, database.UpdateTaskStepStatusParams{
+			WorkspaceID:   workspace.ID,
+			StepIndex:     step.StepIndex,
+			Status:        status,
+			FromStatus:    database.TaskStepStatusChecking,
+			CheckExitCode: exitCode,
+			UpdatedAt:     dbtime.Now(),
+		})
+		if err != nil {
+			return xerrors.Errorf("finish task step %d: %w", step.StepIndex, err)
+		}
+		if status == database.TaskStepStatusFailed || i+1 == len(steps) {
+			return nil
+		}
+		step = steps[i+1]
+	case database.TaskStepStatusPending:
+		// The prompt of this step could not be sent when the previous step
+		// completed, so try again while the agent is idle.
+		if !becameIdle {
+			idle, _, err := api.taskAppActivitySince(ctx, appID, step.UpdatedAt)
+			if err != nil {
+				return err
+			}
+			if !idle {
+				return nil
+			}
+		}
+	default:
+		return nil
+	}
+
+	return api.startTaskStep(ctx, workspace, step)
+}
+
+// updateTaskStepStatus updates the status of a task step, and publishes a
+// workspace event so that watchers of the task see the step transition.
+func (api *API) updateTaskStepStatus(ctx context.Context, workspace database.Workspace, arg database.UpdateTaskStepStatusParams) (database.TaskStep, error) {
+	step, err := api.Database.UpdateTaskStepStatus(ctx, arg)
+	if err != nil {
+		return step, err
+	}
+	api.publishWorkspaceUpdate(ctx, workspace.OwnerID, wspubsub.WorkspaceEvent{
+		Kind:        wspubsub.WorkspaceEventKindTaskStepUpdate,
+		WorkspaceID: workspace.ID,
+	})
+	return step, nil
+}
+
+// taskAppActivitySince reports whether the latest status of a task's app is
+// idle, and whether the app has reported that it is working since the given
+// time.
+func (api *API) taskAppActivitySince(ctx context.Context, appID uuid.UUID, since time.Time) (idle bool, worked bool, err error) {
+	statuses, err := api.Database.GetLatestWorkspaceAppStatusesByAppID(ctx, appID)
+	if err != nil {
+		return false, false, xerrors.Errorf("get workspace app statuses: %w", err)
+	}
+	if len(statuses) == 0 || statuses[0].State != database.WorkspaceAppStatusStateIdle {
+		return false, false, nil
+	}
+	worked = slices.ContainsFunc(statuses, func(status database.WorkspaceAppStatus) bool {
+		return status.State == database.WorkspaceAppStatusStateWorking && status.CreatedAt.After(since)
+	})
+	return true, worked, nil
+}
+
+// startTaskStep sends the prompt of a pending step to the task's AI agent and
+// marks it as running. The step is left pending if the prompt cannot be sent,
+// so that it is retried on the agent's next idle report, or once it is stale.
+func (api *API) startTaskStep(ctx context.Context, workspace database.Workspace, step database.TaskStep) error {
+	_, err := api.updateTaskStepStatus(ctx, workspace, database.UpdateTaskStepStatusParams{
+		WorkspaceID: workspace.ID,
+		StepIndex:   step.StepIndex,
+		Status:      database.TaskStepStatusRunning,
+		FromStatus:  database.TaskStepStatusPending,
+		UpdatedAt:   dbtime.Now(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("start task step %d: %w", step.StepIndex, err)
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, taskStepSendTimeout)
+	defer cancel()
+	send := func() error {
+		return api.doWithTaskSidebarAppClient(sendCtx, workspace, func(ctx context.Context, client *http.Client, appURL *url.URL) error {
+			return sendTaskInput(ctx, client, appURL, step.Prompt)
+		})
+	}
+	ticker := time.NewTicker(taskStepSendInterval)
+	defer ticker.Stop()
+	err = send()
+	for err != nil && sendCtx.Err() == nil {
+		select {
+		case <-sendCtx.Done():
+		case <-ticker.C:
+			err = send()
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	// Use the parent context, since the send context has expired.
+	_, revertErr := api.updateTaskStepStatus(ctx, workspace, database.UpdateTaskStepStatusParams{
+		WorkspaceID: workspace.ID,
+		StepIndex:   step.StepIndex,
+		Status:      database.TaskStepStatusPending,
+		FromStatus:  database.TaskStepStatusRunning,
+		UpdatedAt:   dbtime.Now(),
+	})
+	if revertErr != nil {
+		return xerrors.Errorf("revert task step %d: %w", step.StepIndex, errors.Join(err, revertErr))
+	}
+	return xerrors.Errorf("send prompt of task step %d: %w", step.StepIndex, err)
+}
+
+// runTaskStepCheck runs a completion check command in the workspace over SSH
+// and returns its exit code.
+func (api *API) runTaskStepCheck(ctx context.Context, agentID uuid.UUID, command string) (int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, taskStepCheckTimeout)
+	defer cancel()
+
+	agentConn, release, err := api.agentProvider.AgentConn(ctx, agentID)
+	if err != nil {
+		return 0, xerrors.Errorf("dial agent: %w", err)
+	}
+	defer release()
+
+	sshClient, err := agentConn.SSHClient(ctx)
+	if err != nil {
+		return 0, xerrors.Errorf("ssh client: %w", err)
+	}
+	defer sshClient.Close()
+
+	session, err := sshClient.NewSession()
+	if err != nil {
+		return 0, xerrors.Errorf("ssh session: %w", err)
+	}
+	defer session.Close()
+
+	// Running the command does not take a context, so close the session if
+	// the check times out.
+	go func() {
+		<-ctx.Done()
+		_ = session.Close()
+	}()
+
+	err = session.Run(command)
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return int32(exitErr.ExitStatus()), nil //nolint:gosec // Exit codes fit in an int32.
+	}
+	if err != nil {
+		return 0, xerrors.Errorf("run command: %w", err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,425 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"cdr.dev/slog"
+	aiagentapi "github.com/coder/agentapi-sdk-go"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpapi/httperror"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
+	"github.com/coder/coder/v2/coderd/wspubsub"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const (
+	// taskWatchLogInterval is how often the logs of running tasks are read
+	// while they are watched. The messages of a task's AI agent are not
+	// published, so they must be polled.
+	taskWatchLogInterval = 5 * time.Second
+	// taskWatchLogTimeout bounds each read of a task's logs.
+	taskWatchLogTimeout = 10 * time.Second
+)
+
+// @Summary Watch AI tasks
+// @Description: EXPERIMENTAL: this endpoint is experimental and not guaranteed to be stable.
+// @ID watch-tasks
+// @Security CoderSessionToken
+// @Produce text/event-stream
+// @Tags Experimental
+// @Param user path string true "Username, user ID, or 'me' for the authenticated user"
+// @Success 200 {object} codersdk.TaskEvent
+// @Router /api/experimental/tasks/{user}/watch [get]
+//
+// EXPERIMENTAL: This endpoint is experimental and not guaranteed to be stable.
+// tasksWatchSSE streams the status changes, state reports and new log entries
+// of all of a user's tasks.
+func (api *API) tasksWatchSSE(rw http.ResponseWriter, r *http.Request) {
+	api.tasksWatch(rw, r, httpapi.ServerSentEventSender)
+}
+
+// @Summary Watch AI tasks via WebSockets
+// @Description: EXPERIMENTAL: this endpoint is experimental and not guaranteed to be stable.
+// @ID watch-tasks-via-websockets
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Experimental
+// @Param user path string true "Username, user ID, or 'me' for the authenticated user"
+// @Success 200 {object} codersdk.TaskEvent
+// @Router /api/experimental/tasks/{user}/watch-ws [get]
+//
+// EXPERIMENTAL: This endpoint is experimental and not guaranteed to be stable.
+func (api *API) tasksWatchWS(rw http.ResponseWriter, r *http.Request) {
+	api.tasksWatch(rw, r, httpapi.OneWayWebSocketEventSender)
+}
+
+func (api *API) tasksWatch(rw http.ResponseWriter, r *http.Request, connect httpapi.EventSender) {
+	var (
+		ctx  = r.Context()
+		mems = httpmw.OrganizationMembersParam(r)
+	)
+
+	ownerID := uuid.Nil
+	if mems.User != nil {
+		ownerID = mems.User.ID
+	} else if len(mems.Memberships) > 0 {
+		ownerID = mems.Memberships[0].UserID
+	}
+
+	rows, err := api.Database.GetWorkspaces(ctx, database.GetWorkspacesParams{
+		OwnerID:   ownerID,
+		HasAITask: sql.NullBool{Valid: true, Bool: true},
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspaces.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	api.watchTasks(rw, r, connect, ownerID, uuid.Nil, database.ConvertWorkspaceRows(rows))
+}
+
+// @Summary Watch AI task by ID
+// @Description: EXPERIMENTAL: this endpoint is experimental and not guaranteed to be stable.
+// @ID watch-task
+// @Security CoderSessionToken
+// @Produce text/event-stream
+// @Tags Experimental
+// @Param user path string true "Username, user ID, or 'me' for the authenticated user"
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} codersdk.TaskEvent
+// @Router /api/experimental/tasks/{user}/{id}/watch [get]
+//
+// EXPERIMENTAL: This endpoint is experimental and not guaranteed to be stable.
+// taskWatchSSE streams the status changes, state reports and new log entries
+// of a task until it is deleted.
+func (api *API) taskWatchSSE(rw http.ResponseWriter, r *http.Request) {
+	api.taskWatch(rw, r, httpapi.ServerSentEventSender)
+}
+
+// @Summary Watch AI task by ID via WebSockets
+// @Description: EXPERIMENTAL: this endpoint is experimental and not guaranteed to be stable.
+// @ID watch-task-via-websockets
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Experimental
+// @Param user path string true "Username, user ID, or 'me' for the authenticated user"
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} codersdk.TaskEvent
+// @Router /api/experimental/tasks/{user}/{id}/watch-ws [get]
+//
+// EXPERIMENTAL: This endpoint is experimental and not guaranteed to be stable.
+func (api *API) taskWatchWS(rw http.ResponseWriter, r *http.Request) {
+	api.taskWatch(rw, r, httpapi.OneWayWebSocketEventSender)
+}
+
+func (api *API) taskWatch(rw http.ResponseWriter, r *http.Request, connect httpapi.EventSender) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, "id")
+	taskID, err := uuid.Parse(idStr)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Invalid UUID %q for task ID.", idStr),
+		})
+		return
+	}
+
+	// For now, taskID = workspaceID, once we have a task data model in
+	// the DB, we can change this lookup.
+	workspace, err := api.Database.GetWorkspaceByID(ctx, taskID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	// Check that the workspace is a task before the stream is established,
+	// so that the error can be returned as a response.
+	if _, err := api.taskFromDatabaseWorkspace(ctx, httpmw.APIKey(r).UserID, workspace); err != nil {
+		httperror.WriteResponseError(ctx, rw, err)
+		return
+	}
+
+	api.watchTasks(rw, r, connect, workspace.OwnerID, workspace.ID, []database.Workspace{workspace})
+}
+
+// watchedTask is the last state of a task which was sent to a watcher.
+type watchedTask struct {
+	workspace database.Workspace
+	task      codersdk.Task
+	// canConnect is whether the watcher may connect to the task's apps, which
+	// is required to read its logs.
+	canConnect bool
+	// lastLogID is the ID of the last log entry which was read, or -1.
+	lastLogID int
+}
+
+// watchTasks streams the changes to the given task workspaces, and to any
+// other task workspaces of the owner which change while they are watched,
+// unless only the task with the given ID is watched.
+func (api *API) watchTasks(
+	rw http.ResponseWriter,
+	r *http.Request,
+	connect httpapi.EventSender,
+	ownerID uuid.UUID,
+	taskID uuid.UUID,
+	workspaces []database.Workspace,
+) {
+	var (
+		requesterID = httpmw.APIKey(r).UserID
+		logger      = api.Logger.Named("task_watcher").With(slog.F("owner_id", ownerID))
+		tasks       = make(map[uuid.UUID]*watchedTask)
+	)
+
+	// The stream is closed once a single watched task is deleted, which
+	// requires the sender to be closed before the client disconnects.
+	ctx, cancel := context.WithCancel(r.Context())
+	r = r.WithContext(ctx)
+
+	sendEvent, senderClosed, err := connect(rw, r)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error setting up server-sent events.",
+			Detail:  err.Error(),
+		})
+		cancel()
+		return
+	}
+	// Prevent handler from returning until the sender is closed.
+	defer func() {
+		<-senderClosed
+	}()
+	defer cancel()
+
+	sendTaskEvent := func(event codersdk.TaskEvent) {
+		_ = sendEvent(codersdk.ServerSentEvent{
+			Type: codersdk.ServerSentEventTypeData,
+			Data: event,
+		})
+	}
+	sendError := func(message string, err error) {
+		_ = sendEvent(codersdk.ServerSentEvent{
+			Type: codersdk.ServerSentEventTypeError,
+			Data: codersdk.Response{
+				Message: message,
+				Detail:  err.Error(),
+			},
+		})
+	}
+
+	// readLogs sends the log entries of a task which have not been read yet.
+	// The latest entry is held back while the task's AI agent is working, as
+	// it may still be writing it.
+	readLogs := func(wt *watchedTask, send bool) {
+		if !wt.canConnect || wt.task.Status != codersdk.WorkspaceStatusRunning {
+			return
+		}
+		readCtx, cancel := context.WithTimeout(ctx, taskWatchLogTimeout)
+		defer cancel()
+
+		var logs []codersdk.TaskLogEntry
+		err := api.doWithTaskSidebarAppClient(readCtx, wt.workspace, func(ctx context.Context, client *http.Client, appURL *url.URL) error {
+			agentAPIClient, err := aiagentapi.NewClient(appURL.String(), aiagentapi.WithHTTPClient(client))
+			if err != nil {
+				return err
+			}
+			status, err := agentAPIClient.GetStatus(ctx)
+			if err != nil {
+				return err
+			}
+			logs, err = getTaskLogs(ctx, client, appURL)
+			if err != nil {
+				return err
+			}
+			if status.Status != aiagentapi.StatusStable && len(logs) > 0 {
+				logs = logs[:len(logs)-1]
+			}
+			return nil
+		})
+		if err != nil {
+			// The task's app may not be ready yet, so try again later.
+			logger.Debug(ctx, "read task logs", slog.F("task_id", wt.task.ID), slog.Error(err))
+			return
+		}
+		for _, entry := range logs {
+			if entry.ID <= wt.lastLogID {
+				continue
+			}
+			wt.lastLogID = entry.ID
+			if send {
+				sendTaskEvent(codersdk.TaskEvent{
+					Type:   codersdk.TaskEventTypeLog,
+					TaskID: wt.task.ID,
+					Log:    &entry,
+				})
+			}
+		}
+	}
+
+	// update sends the changes to a task since it was last sent, and reports
+	// whether the task should still be watched.
+	update := func(workspace database.Workspace, initial bool) bool {
+		task, err := api.taskFromDatabaseWorkspace(ctx, requesterID, workspace)
+		if errors.Is(err, httperror.ErrResourceNotFound) {
+			return false
+		}
+		if err != nil {
+			sendError("Internal error fetching task.", err)
+			return true
+		}
+
+		wt, ok := tasks[workspace.ID]
+		if !ok {
+			wt = &watchedTask{
+				canConnect: api.Authorize(r, policy.ActionApplicationConnect, workspace),
+				lastLogID:  -1,
+			}
+			tasks[workspace.ID] = wt
+		}
+		previous := wt.task
+		wt.workspace = workspace
+		wt.task = task
+
+		switch {
+		case !ok:
+			sendTaskEvent(codersdk.TaskEvent{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: &task})
+		case taskStatusChanged(previous, task):
+			sendTaskEvent(codersdk.TaskEvent{Type: codersdk.TaskEventTypeStatus, TaskID: task.ID, Task: &task})
+		case taskStateChanged(previous, task):
+			sendTaskEvent(codersdk.TaskEvent{Type: codersdk.TaskEventTypeState, TaskID: task.ID, Task: &task})
+		}
+		// Logs which exist when the watch starts are not sent, as they can be
+		// read from the logs endpoint. The agent reports a new state when it
+		// has written a message, so read its logs right away.
+		readLogs(wt, !initial)
+		return task.Status != codersdk.WorkspaceStatusDeleted
+	}
+
+	var (
+		pendingMu sync.Mutex
+		pending   = make(map[uuid.UUID]struct{})
+		notify    = make(chan struct{}, 1)
+	)
+	cancelWorkspaceSubscribe, err := api.Pubsub.SubscribeWithErr(wspubsub.WorkspaceEventChannel(ownerID),
+		wspubsub.HandleWorkspaceEvent(
+			func(_ context.Context, payload wspubsub.WorkspaceEvent, err error) {
+				if err != nil {
+					return
+				}
+				if taskID != uuid.Nil && payload.WorkspaceID != taskID {
+					return
+				}
+				switch payload.Kind {
+				case wspubsub.WorkspaceEventKindStatsUpdate, wspubsub.WorkspaceEventKindMetadataUpdate:
+					// These do not change the task.
+					return
+				}
+				pendingMu.Lock()
+				pending[payload.WorkspaceID] = struct{}{}
+				pendingMu.Unlock()
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			}))
+	if err != nil {
+		sendError("Internal error subscribing to workspace events.", err)
+		return
+	}
+	defer cancelWorkspaceSubscribe()
+
+	for _, workspace := range workspaces {
+		if !update(workspace, true) {
+			delete(tasks, workspace.ID)
+		}
+	}
+	// An initial ping signals to the request that the server is now ready
+	// and the client can begin servicing a channel with data.
+	_ = sendEvent(codersdk.ServerSentEvent{
+		Type: codersdk.ServerSentEventTypePing,
+	})
+	if taskID != uuid.Nil && len(tasks) == 0 {
+		return
+	}
+
+	ticker := api.Clock.NewTicker(taskWatchLogInterval, "tasks", "watch")
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-senderClosed:
+			return
+		case <-ticker.C:
+			for _, wt := range tasks {
+				readLogs(wt, true)
+			}
+		case <-notify:
+			pendingMu.Lock()
+			workspaceIDs := pending
+			pending = make(map[uuid.UUID]struct{})
+			pendingMu.Unlock()
+
+			for workspaceID := range workspaceIDs {
+				workspace, err := api.Database.GetWorkspaceByID(ctx, workspaceID)
+				if httpapi.Is404Error(err) {
+					delete(tasks, workspaceID)
+					continue
+				}
+				if err != nil {
+					sendError("Internal error fetching workspace.", err)
+					continue
+				}
+				if !update(workspace, false) {
+					delete(tasks, workspaceID)
+				}
+			}
+			if taskID != uuid.Nil && len(tasks) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// taskStatusChanged reports whether the status of a task's workspace, agent
+// or steps has changed.
+func taskStatusChanged(previous, current codersdk.Task) bool {
+	return previous.Status != current.Status ||
+		previous.WorkspaceBuildNumber != current.WorkspaceBuildNumber ||
+		!reflect.DeepEqual(previous.WorkspaceAgentLifecycle, current.WorkspaceAgentLifecycle) ||
+		!reflect.DeepEqual(previous.WorkspaceAgentHealth, current.WorkspaceAgentHealth) ||
+		!reflect.DeepEqual(previous.Steps, current.Steps)
+}
+
+// taskStateChanged reports whether the task's AI agent has reported a new
+// state.
+func taskStateChanged(previous, current codersdk.Task) bool {
+	if previous.CurrentState == nil || current.CurrentState == nil {
+		return previous.CurrentState != current.CurrentState
+	}
+	return !previous.CurrentState.Timestamp.Equal(current.CurrentState.Timestamp) ||
+		previous.CurrentState.State != current.CurrentState.State ||
+		previous.CurrentState.Message != current.CurrentState.Message ||
+		previous.CurrentState.URI != current.CurrentState.URI
+}
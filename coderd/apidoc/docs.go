@@ -85,7 +85,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/experimental/aibridge/interceptions": {
+        "/api/experimental/aibridge/insights": {
             "get": {
                 "security": [
                     {
@@ -98,25 +98,29 @@ const docTemplate = `{
                 "tags": [
                     "AIBridge"
                 ],
-                "summary": "List AIBridge interceptions",
-                "operationId": "list-aibridge-interceptions",
+                "summary": "Get AIBridge usage insights",
+                "operationId": "get-aibridge-usage-insights",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query in the format ` + "`" + `key:value` + "`" + `. Available keys are: initiator, provider, model, started_after, started_before.",
-                        "name": "q",
-                        "in": "query"
+                        "format": "date-time",
+                        "description": "Start time, on the hour",
+                        "name": "start_time",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "End time, on the hour",
+                        "name": "end_time",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Cursor pagination after ID",
-                        "name": "after_id",
+                        "description": "Comma separated dimensions to group by. Available values are: user, template, provider, model. Defaults to all.",
+                        "name": "group_by",
                         "in": "query"
                     }
                 ],
@@ -124,52 +128,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.AIBridgeListInterceptionsResponse"
+                            "$ref": "#/definitions/codersdk.AIBridgeInsightsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/experimental/tasks": {
+        "/api/experimental/aibridge/interceptions": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Experimental"
+                    "AIBridge"
                 ],
-                "summary": "List AI tasks",
-                "operationId": "list-tasks",
+                "summary": "List AIBridge interceptions",
+                "operationId": "list-aibridge-interceptions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query for filtering tasks",
+                        "description": "Search query in the format ` + "`" + `key:value` + "`" + `. Available keys are: initiator, provider, model, started_after, started_before.",
                         "name": "q",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Return tasks after this ID for pagination",
-                        "name": "after_id",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 25,
-                        "description": "Maximum number of tasks to return",
+                        "description": "Page limit",
                         "name": "limit",
                         "in": "query"
                     },
                     {
-                        "minimum": 0,
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
+                        "type": "string",
+                        "description": "Cursor pagination after ID",
+                        "name": "after_id",
                         "in": "query"
                     }
                 ],
@@ -177,86 +173,129 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coderd.tasksListResponse"
+                            "$ref": "#/definitions/codersdk.AIBridgeListInterceptionsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/experimental/tasks/{user}": {
-            "post": {
+        "/api/experimental/aibridge/interceptions/{interception}/capture": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Experimental"
+                    "AIBridge"
                 ],
-                "summary": "Create a new AI task",
-                "operationId": "create-task",
+                "summary": "Get AIBridge interception capture",
+                "operationId": "get-aibridge-interception-capture",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username, user ID, or 'me' for the authenticated user",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Interception ID",
+                        "name": "interception",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create task request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTaskRequest"
+                            "$ref": "#/definitions/codersdk.AIBridgeInterceptionCapture"
                         }
                     }
+                }
+            }
+        },
+        "/api/experimental/aibridge/limits": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "AIBridge"
+                ],
+                "summary": "List AIBridge limits",
+                "operationId": "list-aibridge-limits",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Task"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.AIBridgeLimit"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/experimental/tasks/{user}/{id}": {
-            "get": {
+        "/api/experimental/aibridge/limits/{scope}/{scope_id}": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Experimental"
+                    "AIBridge"
                 ],
-                "summary": "Get AI task by ID",
-                "operationId": "get-task",
+                "summary": "Upsert AIBridge limit",
+                "operationId": "upsert-aibridge-limit",
                 "parameters": [
                     {
+                        "enum": [
+                            "user",
+                            "group",
+                            "organization"
+                        ],
                         "type": "string",
-                        "description": "Username, user ID, or 'me' for the authenticated user",
-                        "name": "user",
+                        "description": "Limit scope",
+                        "name": "scope",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
-                        "name": "id",
+                        "description": "User, group or organization ID",
+                        "name": "scope_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Limit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpsertAIBridgeLimitRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Task"
+                            "$ref": "#/definitions/codersdk.AIBridgeLimit"
                         }
                     }
                 }
@@ -268,119 +307,108 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "Experimental"
+                    "AIBridge"
                 ],
-                "summary": "Delete AI task by ID",
-                "operationId": "delete-task",
+                "summary": "Delete AIBridge limit",
+                "operationId": "delete-aibridge-limit",
                 "parameters": [
                     {
+                        "enum": [
+                            "user",
+                            "group",
+                            "organization"
+                        ],
                         "type": "string",
-                        "description": "Username, user ID, or 'me' for the authenticated user",
-                        "name": "user",
+                        "description": "Limit scope",
+                        "name": "scope",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
-                        "name": "id",
+                        "description": "User, group or organization ID",
+                        "name": "scope_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Task deletion initiated"
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/api/experimental/tasks/{user}/{id}/logs": {
+        "/api/experimental/aibridge/prices": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "tags": [
-                    "Experimental"
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Get AI task logs",
-                "operationId": "get-task-logs",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Username, user ID, or 'me' for the authenticated user",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "AIBridge"
                 ],
+                "summary": "List AIBridge model prices",
+                "operationId": "list-aibridge-model-prices",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TaskLogsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.AIBridgeModelPrice"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/api/experimental/tasks/{user}/{id}/send": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Experimental"
+                    "AIBridge"
                 ],
-                "summary": "Send input to AI task",
-                "operationId": "send-task-input",
+                "summary": "Update AIBridge model prices",
+                "operationId": "update-aibridge-model-prices",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Username, user ID, or 'me' for the authenticated user",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Task input request",
+                        "description": "Model prices",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TaskSendRequest"
+                            "$ref": "#/definitions/codersdk.UpdateAIBridgeModelPricesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Input sent successfully"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.AIBridgeModelPrice"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/appearance": {
+        "/api/experimental/aibridge/users/{user}/capture": {
             "get": {
                 "security": [
                     {
@@ -391,15 +419,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "AIBridge"
+                ],
+                "summary": "Get AIBridge capture settings of user",
+                "operationId": "get-aibridge-capture-settings-of-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get appearance",
-                "operationId": "get-appearance",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.AppearanceConfig"
+                            "$ref": "#/definitions/codersdk.AIBridgeCaptureSettings"
                         }
                     }
                 }
@@ -417,18 +454,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "AIBridge"
                 ],
-                "summary": "Update appearance",
-                "operationId": "update-appearance",
+                "summary": "Update AIBridge capture settings of user",
+                "operationId": "update-aibridge-capture-settings-of-user",
                 "parameters": [
                     {
-                        "description": "Update appearance request",
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Capture settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateAppearanceConfig"
+                            "$ref": "#/definitions/codersdk.AIBridgeCaptureSettings"
                         }
                     }
                 ],
@@ -436,139 +480,85 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateAppearanceConfig"
+                            "$ref": "#/definitions/codersdk.AIBridgeCaptureSettings"
                         }
                     }
                 }
             }
         },
-        "/applications/auth-redirect": {
+        "/api/experimental/aibridge/users/{user}/usage": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Applications"
+                    "AIBridge"
                 ],
-                "summary": "Redirect to URI with encrypted API key",
-                "operationId": "redirect-to-uri-with-encrypted-api-key",
+                "summary": "Get AIBridge usage of user",
+                "operationId": "get-aibridge-usage-of-user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Redirect destination",
-                        "name": "redirect_uri",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "307": {
-                        "description": "Temporary Redirect"
-                    }
-                }
-            }
-        },
-        "/applications/host": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Applications"
-                ],
-                "summary": "Get applications host",
-                "operationId": "get-applications-host",
-                "deprecated": true,
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.AppHostResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/applications/reconnecting-pty-signed-token": {
-            "post": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Issue signed app token for reconnecting PTY",
-                "operationId": "issue-signed-app-token-for-reconnecting-pty",
-                "parameters": [
-                    {
-                        "description": "Issue reconnecting PTY signed token request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.IssueReconnectingPTYSignedTokenRequest"
-                        }
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.IssueReconnectingPTYSignedTokenResponse"
+                            "$ref": "#/definitions/codersdk.AIBridgeUsage"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/audit": {
+        "/api/experimental/tasks": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Audit"
+                    "Experimental"
                 ],
-                "summary": "Get audit logs",
-                "operationId": "get-audit-logs",
+                "summary": "List AI tasks",
+                "operationId": "list-tasks",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
+                        "description": "Search query for filtering tasks",
                         "name": "q",
                         "in": "query"
                     },
                     {
+                        "type": "string",
+                        "description": "Return tasks after this ID for pagination",
+                        "name": "after_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Page limit",
+                        "default": 25,
+                        "description": "Maximum number of tasks to return",
                         "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     },
                     {
+                        "minimum": 0,
                         "type": "integer",
-                        "description": "Page offset",
+                        "default": 0,
+                        "description": "Offset for pagination",
                         "name": "offset",
                         "in": "query"
                     }
@@ -577,260 +567,278 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.AuditLogResponse"
+                            "$ref": "#/definitions/coderd.tasksListResponse"
                         }
                     }
                 }
             }
         },
-        "/audit/testgenerate": {
+        "/api/experimental/tasks/{user}": {
             "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Audit"
+                    "Experimental"
                 ],
-                "summary": "Generate fake audit log",
-                "operationId": "generate-fake-audit-log",
+                "summary": "Create a new AI task",
+                "operationId": "create-task",
                 "parameters": [
                     {
-                        "description": "Audit log request",
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create task request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTestAuditLogRequest"
+                            "$ref": "#/definitions/codersdk.CreateTaskRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                },
-                "x-apidocgen": {
-                    "skip": true
-                }
-            }
-        },
-        "/auth/scopes": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Authorization"
-                ],
-                "summary": "List API key scopes",
-                "operationId": "list-api-key-scopes",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAPIKeyScopes"
+                            "$ref": "#/definitions/codersdk.Task"
                         }
                     }
                 }
             }
         },
-        "/authcheck": {
-            "post": {
+        "/api/experimental/tasks/{user}/watch": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Authorization"
+                    "Experimental"
                 ],
-                "summary": "Check authorization",
-                "operationId": "check-authorization",
+                "summary": "Watch AI tasks",
+                "operationId": "watch-tasks",
                 "parameters": [
                     {
-                        "description": "Authorization request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.AuthorizationRequest"
-                        }
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.AuthorizationResponse"
+                            "$ref": "#/definitions/codersdk.TaskEvent"
                         }
                     }
                 }
             }
         },
-        "/buildinfo": {
+        "/api/experimental/tasks/{user}/watch-ws": {
             "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Experimental"
+                ],
+                "summary": "Watch AI tasks via WebSockets",
+                "operationId": "watch-tasks-via-websockets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Build info",
-                "operationId": "build-info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.BuildInfoResponse"
+                            "$ref": "#/definitions/codersdk.TaskEvent"
                         }
                     }
                 }
             }
         },
-        "/connectionlog": {
+        "/api/experimental/tasks/{user}/{id}": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Enterprise"
+                    "Experimental"
                 ],
-                "summary": "Get connection logs",
-                "operationId": "get-connection-logs",
+                "summary": "Get AI task by ID",
+                "operationId": "get-task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ConnectionLogResponse"
+                            "$ref": "#/definitions/codersdk.Task"
                         }
                     }
                 }
-            }
-        },
-        "/csp/reports": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
-                    "General"
+                    "Experimental"
                 ],
-                "summary": "Report CSP violations",
-                "operationId": "report-csp-violations",
+                "summary": "Delete AI task by ID",
+                "operationId": "delete-task",
                 "parameters": [
                     {
-                        "description": "Violation report",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/coderd.cspViolation"
-                        }
-                    }
-                ],
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "202": {
+                        "description": "Task deletion initiated"
                     }
                 }
             }
         },
-        "/debug/coordinator": {
+        "/api/experimental/tasks/{user}/{id}/logs": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "text/html"
-                ],
                 "tags": [
-                    "Debug"
+                    "Experimental"
+                ],
+                "summary": "Get AI task logs",
+                "operationId": "get-task-logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Debug Info Wireguard Coordinator",
-                "operationId": "debug-info-wireguard-coordinator",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TaskLogsResponse"
+                        }
                     }
                 }
             }
         },
-        "/debug/derp/traffic": {
-            "get": {
+        "/api/experimental/tasks/{user}/{id}/send": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Debug"
+                    "Experimental"
                 ],
-                "summary": "Debug DERP traffic",
-                "operationId": "debug-derp-traffic",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Send input to AI task",
+                "operationId": "send-task-input",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task input request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/derp.BytesSentRecv"
-                            }
+                            "$ref": "#/definitions/codersdk.TaskSendRequest"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Input sent successfully"
+                    }
                 }
             }
         },
-        "/debug/expvar": {
+        "/api/experimental/tasks/{user}/{id}/watch": {
             "get": {
                 "security": [
                     {
@@ -838,28 +846,41 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Debug"
+                    "Experimental"
+                ],
+                "summary": "Watch AI task by ID",
+                "operationId": "watch-task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Debug expvar",
-                "operationId": "debug-expvar",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/codersdk.TaskEvent"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/debug/health": {
+        "/api/experimental/tasks/{user}/{id}/watch-ws": {
             "get": {
                 "security": [
                     {
@@ -870,29 +891,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Experimental"
                 ],
-                "summary": "Debug Info Deployment Health",
-                "operationId": "debug-info-deployment-health",
+                "summary": "Watch AI task by ID via WebSockets",
+                "operationId": "watch-task-via-websockets",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Force a healthcheck to run",
-                        "name": "force",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Username, user ID, or 'me' for the authenticated user",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/healthsdk.HealthcheckReport"
+                            "$ref": "#/definitions/codersdk.TaskEvent"
                         }
                     }
                 }
             }
         },
-        "/debug/health/settings": {
+        "/appearance": {
             "get": {
                 "security": [
                     {
@@ -903,15 +933,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Enterprise"
                 ],
-                "summary": "Get health settings",
-                "operationId": "get-health-settings",
+                "summary": "Get appearance",
+                "operationId": "get-appearance",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/healthsdk.HealthSettings"
+                            "$ref": "#/definitions/codersdk.AppearanceConfig"
                         }
                     }
                 }
@@ -929,18 +959,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Enterprise"
                 ],
-                "summary": "Update health settings",
-                "operationId": "update-health-settings",
+                "summary": "Update appearance",
+                "operationId": "update-appearance",
                 "parameters": [
                     {
-                        "description": "Update health settings",
+                        "description": "Update appearance request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/healthsdk.UpdateHealthSettings"
+                            "$ref": "#/definitions/codersdk.UpdateAppearanceConfig"
                         }
                     }
                 ],
@@ -948,35 +978,40 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/healthsdk.UpdateHealthSettings"
+                            "$ref": "#/definitions/codersdk.UpdateAppearanceConfig"
                         }
                     }
                 }
             }
         },
-        "/debug/tailnet": {
+        "/applications/auth-redirect": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "text/html"
-                ],
                 "tags": [
-                    "Debug"
+                    "Applications"
+                ],
+                "summary": "Redirect to URI with encrypted API key",
+                "operationId": "redirect-to-uri-with-encrypted-api-key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Redirect destination",
+                        "name": "redirect_uri",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Debug Info Tailnet",
-                "operationId": "debug-info-tailnet",
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "307": {
+                        "description": "Temporary Redirect"
                     }
                 }
             }
         },
-        "/debug/ws": {
+        "/applications/host": {
             "get": {
                 "security": [
                     {
@@ -987,47 +1022,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Applications"
                 ],
-                "summary": "Debug Info Websocket Test",
-                "operationId": "debug-info-websocket-test",
+                "summary": "Get applications host",
+                "operationId": "get-applications-host",
+                "deprecated": true,
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.AppHostResponse"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/debug/{user}/debug-link": {
-            "get": {
+        "/applications/reconnecting-pty-signed-token": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Agents"
+                    "Enterprise"
                 ],
-                "summary": "Debug OIDC context for a user",
-                "operationId": "debug-oidc-context-for-a-user",
+                "summary": "Issue signed app token for reconnecting PTY",
+                "operationId": "issue-signed-app-token-for-reconnecting-pty",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
+                        "description": "Issue reconnecting PTY signed token request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.IssueReconnectingPTYSignedTokenRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.IssueReconnectingPTYSignedTokenResponse"
+                        }
                     }
                 },
                 "x-apidocgen": {
@@ -1035,7 +1079,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/deployment/config": {
+        "/audit": {
             "get": {
                 "security": [
                     {
@@ -1046,46 +1090,78 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Audit"
+                ],
+                "summary": "Get audit logs",
+                "operationId": "get-audit-logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get deployment config",
-                "operationId": "get-deployment-config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DeploymentConfig"
+                            "$ref": "#/definitions/codersdk.AuditLogResponse"
                         }
                     }
                 }
             }
         },
-        "/deployment/ssh": {
-            "get": {
+        "/audit/testgenerate": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Audit"
                 ],
-                "summary": "SSH Config",
-                "operationId": "ssh-config",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Generate fake audit log",
+                "operationId": "generate-fake-audit-log",
+                "parameters": [
+                    {
+                        "description": "Audit log request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.SSHConfigResponse"
+                            "$ref": "#/definitions/codersdk.CreateTestAuditLogRequest"
                         }
                     }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/deployment/stats": {
+        "/audit/verify": {
             "get": {
                 "security": [
                     {
@@ -1096,121 +1172,100 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Audit"
                 ],
-                "summary": "Get deployment stats",
-                "operationId": "get-deployment-stats",
+                "summary": "Verify audit log hash chain",
+                "operationId": "verify-audit-log-hash-chain",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DeploymentStats"
+                            "$ref": "#/definitions/codersdk.AuditLogVerifyResponse"
                         }
                     }
                 }
             }
         },
-        "/derp-map": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Agents"
-                ],
-                "summary": "Get DERP map updates",
-                "operationId": "get-derp-map-updates",
-                "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
-                    }
-                }
-            }
-        },
-        "/entitlements": {
+        "/auth/scopes": {
             "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Authorization"
                 ],
-                "summary": "Get entitlements",
-                "operationId": "get-entitlements",
+                "summary": "List API key scopes",
+                "operationId": "list-api-key-scopes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Entitlements"
+                            "$ref": "#/definitions/codersdk.ExternalAPIKeyScopes"
                         }
                     }
                 }
             }
         },
-        "/experiments": {
-            "get": {
+        "/authcheck": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Authorization"
+                ],
+                "summary": "Check authorization",
+                "operationId": "check-authorization",
+                "parameters": [
+                    {
+                        "description": "Authorization request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.AuthorizationRequest"
+                        }
+                    }
                 ],
-                "summary": "Get enabled experiments",
-                "operationId": "get-enabled-experiments",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Experiment"
-                            }
+                            "$ref": "#/definitions/codersdk.AuthorizationResponse"
                         }
                     }
                 }
             }
         },
-        "/experiments/available": {
+        "/buildinfo": {
             "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "General"
                 ],
-                "summary": "Get safe experiments",
-                "operationId": "get-safe-experiments",
+                "summary": "Build info",
+                "operationId": "build-info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Experiment"
-                            }
+                            "$ref": "#/definitions/codersdk.BuildInfoResponse"
                         }
                     }
                 }
             }
         },
-        "/external-auth": {
+        "/connectionlog": {
             "get": {
                 "security": [
                     {
@@ -1221,21 +1276,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Git"
+                    "Enterprise"
+                ],
+                "summary": "Get connection logs",
+                "operationId": "get-connection-logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get user external auths",
-                "operationId": "get-user-external-auths",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAuthLink"
+                            "$ref": "#/definitions/codersdk.ConnectionLogResponse"
                         }
                     }
                 }
             }
         },
-        "/external-auth/{externalauth}": {
+        "/connectionlog/{connectionlog}/recording": {
             "get": {
                 "security": [
                     {
@@ -1243,67 +1319,64 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/x-asciicast"
                 ],
                 "tags": [
-                    "Git"
+                    "Enterprise"
                 ],
-                "summary": "Get external auth by ID",
-                "operationId": "get-external-auth-by-id",
+                "summary": "Get connection log session recording",
+                "operationId": "get-connection-log-session-recording",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "string",
-                        "description": "Git Provider ID",
-                        "name": "externalauth",
+                        "format": "uuid",
+                        "description": "Connection log ID",
+                        "name": "connectionlog",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAuth"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/csp/reports": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Git"
+                    "General"
                 ],
-                "summary": "Delete external auth user link by ID",
-                "operationId": "delete-external-auth-user-link-by-id",
+                "summary": "Report CSP violations",
+                "operationId": "report-csp-violations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Git Provider ID",
-                        "name": "externalauth",
-                        "in": "path",
-                        "required": true
+                        "description": "Violation report",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/coderd.cspViolation"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.DeleteExternalAuthByIDResponse"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/external-auth/{externalauth}/device": {
+        "/debug/coordinator": {
             "get": {
                 "security": [
                     {
@@ -1311,136 +1384,114 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "text/html"
                 ],
                 "tags": [
-                    "Git"
-                ],
-                "summary": "Get external auth device by ID.",
-                "operationId": "get-external-auth-device-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Git Provider ID",
-                        "name": "externalauth",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Debug"
                 ],
+                "summary": "Debug Info Wireguard Coordinator",
+                "operationId": "debug-info-wireguard-coordinator",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAuthDevice"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/debug/derp/traffic": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Git"
-                ],
-                "summary": "Post external auth device by ID",
-                "operationId": "post-external-auth-device-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "description": "External Provider ID",
-                        "name": "externalauth",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Debug"
                 ],
+                "summary": "Debug DERP traffic",
+                "operationId": "debug-derp-traffic",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/derp.BytesSentRecv"
+                            }
+                        }
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/files": {
-            "post": {
+        "/debug/expvar": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "description": "Swagger notice: Swagger 2.0 doesn't support file upload with a ` + "`" + `content-type` + "`" + ` different than ` + "`" + `application/x-www-form-urlencoded` + "`" + `.",
-                "consumes": [
-                    "application/x-tar"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Files"
-                ],
-                "summary": "Upload file",
-                "operationId": "upload-file",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "application/x-tar",
-                        "description": "Content-Type must be ` + "`" + `application/x-tar` + "`" + ` or ` + "`" + `application/zip` + "`" + `",
-                        "name": "Content-Type",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "File to be uploaded. If using tar format, file must conform to ustar (pax may cause problems).",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Debug"
                 ],
+                "summary": "Debug expvar",
+                "operationId": "debug-expvar",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UploadResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/files/{fileID}": {
+        "/debug/health": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Files"
+                    "Debug"
                 ],
-                "summary": "Get file by ID",
-                "operationId": "get-file-by-id",
+                "summary": "Debug Info Deployment Health",
+                "operationId": "debug-info-deployment-health",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "File ID",
-                        "name": "fileID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Force a healthcheck to run",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/healthsdk.HealthcheckReport"
+                        }
                     }
                 }
             }
         },
-        "/groups": {
+        "/debug/health/settings": {
             "get": {
                 "security": [
                     {
@@ -1451,178 +1502,124 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Get groups",
-                "operationId": "get-groups",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Organization ID or name",
-                        "name": "organization",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID or name",
-                        "name": "has_member",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Comma separated list of group IDs",
-                        "name": "group_ids",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Debug"
                 ],
+                "summary": "Get health settings",
+                "operationId": "get-health-settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Group"
-                            }
+                            "$ref": "#/definitions/healthsdk.HealthSettings"
                         }
                     }
                 }
-            }
-        },
-        "/groups/{group}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Debug"
                 ],
-                "summary": "Get group by ID",
-                "operationId": "get-group-by-id",
+                "summary": "Update health settings",
+                "operationId": "update-health-settings",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group id",
-                        "name": "group",
-                        "in": "path",
-                        "required": true
+                        "description": "Update health settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/healthsdk.UpdateHealthSettings"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Group"
+                            "$ref": "#/definitions/healthsdk.UpdateHealthSettings"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/debug/tailnet": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "text/html"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Delete group by name",
-                "operationId": "delete-group-by-name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group name",
-                        "name": "group",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Debug"
                 ],
+                "summary": "Debug Info Tailnet",
+                "operationId": "debug-info-tailnet",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Group"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/debug/ws": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Update group by name",
-                "operationId": "update-group-by-name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group name",
-                        "name": "group",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Patch group request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchGroupRequest"
-                        }
-                    }
+                    "Debug"
                 ],
+                "summary": "Debug Info Websocket Test",
+                "operationId": "debug-info-websocket-test",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Group"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/init-script/{os}/{arch}": {
+        "/debug/{user}/debug-link": {
             "get": {
-                "produces": [
-                    "text/plain"
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
                 ],
                 "tags": [
-                    "InitScript"
+                    "Agents"
                 ],
-                "summary": "Get agent init script",
-                "operationId": "get-agent-init-script",
+                "summary": "Debug OIDC context for a user",
+                "operationId": "debug-oidc-context-for-a-user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Operating system",
-                        "name": "os",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Architecture",
-                        "name": "arch",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
@@ -1631,10 +1628,13 @@ const docTemplate = `{
                     "200": {
                         "description": "Success"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/insights/daus": {
+        "/deployment/config": {
             "get": {
                 "security": [
                     {
@@ -1645,30 +1645,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Insights"
-                ],
-                "summary": "Get deployment DAUs",
-                "operationId": "get-deployment-daus",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Time-zone offset (e.g. -2)",
-                        "name": "tz_offset",
-                        "in": "query",
-                        "required": true
-                    }
+                    "General"
                 ],
+                "summary": "Get deployment config",
+                "operationId": "get-deployment-config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DAUsResponse"
+                            "$ref": "#/definitions/codersdk.DeploymentConfig"
                         }
                     }
                 }
             }
         },
-        "/insights/templates": {
+        "/deployment/ssh": {
             "get": {
                 "security": [
                     {
@@ -1679,60 +1670,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Insights"
-                ],
-                "summary": "Get insights about templates",
-                "operationId": "get-insights-about-templates",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "Start time",
-                        "name": "start_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "End time",
-                        "name": "end_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "week",
-                            "day"
-                        ],
-                        "type": "string",
-                        "description": "Interval",
-                        "name": "interval",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Template IDs",
-                        "name": "template_ids",
-                        "in": "query"
-                    }
+                    "General"
                 ],
+                "summary": "SSH Config",
+                "operationId": "ssh-config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateInsightsResponse"
+                            "$ref": "#/definitions/codersdk.SSHConfigResponse"
                         }
                     }
                 }
             }
         },
-        "/insights/user-activity": {
+        "/deployment/stats": {
             "get": {
                 "security": [
                     {
@@ -1743,102 +1695,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Insights"
-                ],
-                "summary": "Get insights about user activity",
-                "operationId": "get-insights-about-user-activity",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "Start time",
-                        "name": "start_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "End time",
-                        "name": "end_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Template IDs",
-                        "name": "template_ids",
-                        "in": "query"
-                    }
+                    "General"
                 ],
+                "summary": "Get deployment stats",
+                "operationId": "get-deployment-stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UserActivityInsightsResponse"
+                            "$ref": "#/definitions/codersdk.DeploymentStats"
                         }
                     }
                 }
             }
         },
-        "/insights/user-latency": {
+        "/derp-map": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Insights"
-                ],
-                "summary": "Get insights about user latency",
-                "operationId": "get-insights-about-user-latency",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "Start time",
-                        "name": "start_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "End time",
-                        "name": "end_time",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Template IDs",
-                        "name": "template_ids",
-                        "in": "query"
-                    }
+                    "Agents"
                 ],
+                "summary": "Get DERP map updates",
+                "operationId": "get-derp-map-updates",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UserLatencyInsightsResponse"
-                        }
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
             }
         },
-        "/insights/user-status-counts": {
+        "/entitlements": {
             "get": {
                 "security": [
                     {
@@ -1849,30 +1739,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Insights"
-                ],
-                "summary": "Get insights about user status counts",
-                "operationId": "get-insights-about-user-status-counts",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Time-zone offset (e.g. -2)",
-                        "name": "tz_offset",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Enterprise"
                 ],
+                "summary": "Get entitlements",
+                "operationId": "get-entitlements",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GetUserStatusCountsResponse"
+                            "$ref": "#/definitions/codersdk.Entitlements"
                         }
                     }
                 }
             }
         },
-        "/licenses": {
+        "/experiments": {
             "get": {
                 "security": [
                     {
@@ -1883,62 +1764,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "General"
                 ],
-                "summary": "Get licenses",
-                "operationId": "get-licenses",
+                "summary": "Get enabled experiments",
+                "operationId": "get-enabled-experiments",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.License"
+                                "$ref": "#/definitions/codersdk.Experiment"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/experiments/available": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
-                ],
-                "summary": "Add new license",
-                "operationId": "add-new-license",
-                "parameters": [
-                    {
-                        "description": "Add license request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.AddLicenseRequest"
-                        }
-                    }
+                    "General"
                 ],
+                "summary": "Get safe experiments",
+                "operationId": "get-safe-experiments",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.License"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Experiment"
+                            }
                         }
                     }
                 }
             }
         },
-        "/licenses/refresh-entitlements": {
-            "post": {
+        "/external-auth": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -1948,22 +1820,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Git"
                 ],
-                "summary": "Update license entitlements",
-                "operationId": "update-license-entitlements",
+                "summary": "Get user external auths",
+                "operationId": "get-user-external-auths",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.ExternalAuthLink"
                         }
                     }
                 }
             }
         },
-        "/licenses/{id}": {
-            "delete": {
+        "/external-auth/{externalauth}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -1973,82 +1845,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Git"
                 ],
-                "summary": "Delete license",
-                "operationId": "delete-license",
+                "summary": "Get external auth by ID",
+                "operationId": "get-external-auth-by-id",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "number",
-                        "description": "License ID",
-                        "name": "id",
+                        "format": "string",
+                        "description": "Git Provider ID",
+                        "name": "externalauth",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ExternalAuth"
+                        }
                     }
                 }
-            }
-        },
-        "/notifications/custom": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Git"
                 ],
-                "summary": "Send a custom notification",
-                "operationId": "send-a-custom-notification",
+                "summary": "Delete external auth user link by ID",
+                "operationId": "delete-external-auth-user-link-by-id",
                 "parameters": [
                     {
-                        "description": "Provide a non-empty title or message",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CustomNotificationRequest"
-                        }
+                        "type": "string",
+                        "format": "string",
+                        "description": "Git Provider ID",
+                        "name": "externalauth",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Invalid request body",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
-                    },
-                    "403": {
-                        "description": "System users cannot send custom notifications",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Failed to send custom notification",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.DeleteExternalAuthByIDResponse"
                         }
                     }
                 }
             }
         },
-        "/notifications/dispatch-methods": {
+        "/external-auth/{externalauth}/device": {
             "get": {
                 "security": [
                     {
@@ -2059,95 +1913,133 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Git"
+                ],
+                "summary": "Get external auth device by ID.",
+                "operationId": "get-external-auth-device-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "Git Provider ID",
+                        "name": "externalauth",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get notification dispatch methods",
-                "operationId": "get-notification-dispatch-methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.NotificationMethodsResponse"
-                            }
+                            "$ref": "#/definitions/codersdk.ExternalAuthDevice"
                         }
                     }
                 }
+            },
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Git"
+                ],
+                "summary": "Post external auth device by ID",
+                "operationId": "post-external-auth-device-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "External Provider ID",
+                        "name": "externalauth",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
             }
         },
-        "/notifications/inbox": {
-            "get": {
+        "/files": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Swagger notice: Swagger 2.0 doesn't support file upload with a ` + "`" + `content-type` + "`" + ` different than ` + "`" + `application/x-www-form-urlencoded` + "`" + `.",
+                "consumes": [
+                    "application/x-tar"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Files"
                 ],
-                "summary": "List inbox notifications",
-                "operationId": "list-inbox-notifications",
+                "summary": "Upload file",
+                "operationId": "upload-file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Comma-separated list of target IDs to filter notifications",
-                        "name": "targets",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Comma-separated list of template IDs to filter notifications",
-                        "name": "templates",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter notifications by read status. Possible values: read, unread, all",
-                        "name": "read_status",
-                        "in": "query"
+                        "default": "application/x-tar",
+                        "description": "Content-Type must be ` + "`" + `application/x-tar` + "`" + ` or ` + "`" + `application/zip` + "`" + `",
+                        "name": "Content-Type",
+                        "in": "header",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "ID of the last notification from the current page. Notifications returned will be older than the associated one",
-                        "name": "starting_before",
-                        "in": "query"
+                        "type": "file",
+                        "description": "File to be uploaded. If using tar format, file must conform to ustar (pax may cause problems).",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ListInboxNotificationsResponse"
+                            "$ref": "#/definitions/codersdk.UploadResponse"
                         }
                     }
                 }
             }
         },
-        "/notifications/inbox/mark-all-as-read": {
-            "put": {
+        "/files/{fileID}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
                 "tags": [
-                    "Notifications"
+                    "Files"
+                ],
+                "summary": "Get file by ID",
+                "operationId": "get-file-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "File ID",
+                        "name": "fileID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Mark all unread notifications as read",
-                "operationId": "mark-all-unread-notifications-as-read",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/notifications/inbox/watch": {
+        "/groups": {
             "get": {
                 "security": [
                     {
@@ -2158,52 +2050,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Enterprise"
                 ],
-                "summary": "Watch for new inbox notifications",
-                "operationId": "watch-for-new-inbox-notifications",
+                "summary": "Get groups",
+                "operationId": "get-groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Comma-separated list of target IDs to filter notifications",
-                        "name": "targets",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Comma-separated list of template IDs to filter notifications",
-                        "name": "templates",
-                        "in": "query"
+                        "description": "Organization ID or name",
+                        "name": "organization",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter notifications by read status. Possible values: read, unread, all",
-                        "name": "read_status",
-                        "in": "query"
+                        "description": "User ID or name",
+                        "name": "has_member",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "plaintext",
-                            "markdown"
-                        ],
                         "type": "string",
-                        "description": "Define the output format for notifications title and body.",
-                        "name": "format",
-                        "in": "query"
+                        "description": "Comma separated list of group IDs",
+                        "name": "group_ids",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GetInboxNotificationResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Group"
+                            }
                         }
                     }
                 }
             }
         },
-        "/notifications/inbox/{id}/read-status": {
-            "put": {
+        "/groups/{group}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2213,15 +2101,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Enterprise"
                 ],
-                "summary": "Update read status of a notification",
-                "operationId": "update-read-status-of-a-notification",
+                "summary": "Get group by ID",
+                "operationId": "get-group-by-id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id of the notification",
-                        "name": "id",
+                        "description": "Group id",
+                        "name": "group",
                         "in": "path",
                         "required": true
                     }
@@ -2230,14 +2118,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.Group"
                         }
                     }
                 }
-            }
-        },
-        "/notifications/settings": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2247,20 +2133,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Enterprise"
+                ],
+                "summary": "Delete group by name",
+                "operationId": "delete-group-by-name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group name",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get notifications settings",
-                "operationId": "get-notifications-settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.NotificationsSettings"
+                            "$ref": "#/definitions/codersdk.Group"
                         }
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2273,18 +2168,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Enterprise"
                 ],
-                "summary": "Update notifications settings",
-                "operationId": "update-notifications-settings",
+                "summary": "Update group by name",
+                "operationId": "update-group-by-name",
                 "parameters": [
                     {
-                        "description": "Notifications settings request",
+                        "type": "string",
+                        "description": "Group name",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Patch group request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.NotificationsSettings"
+                            "$ref": "#/definitions/codersdk.PatchGroupRequest"
                         }
                     }
                 ],
@@ -2292,50 +2194,46 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.NotificationsSettings"
+                            "$ref": "#/definitions/codersdk.Group"
                         }
-                    },
-                    "304": {
-                        "description": "Not Modified"
                     }
                 }
             }
         },
-        "/notifications/templates/custom": {
+        "/init-script/{os}/{arch}": {
             "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Notifications"
+                    "InitScript"
+                ],
+                "summary": "Get agent init script",
+                "operationId": "get-agent-init-script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Operating system",
+                        "name": "os",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Architecture",
+                        "name": "arch",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get custom notification templates",
-                "operationId": "get-custom-notification-templates",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.NotificationTemplate"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Failed to retrieve 'custom' notifications template",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
+                        "description": "Success"
                     }
                 }
             }
         },
-        "/notifications/templates/system": {
+        "/insights/daus": {
             "get": {
                 "security": [
                     {
@@ -2346,31 +2244,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Insights"
+                ],
+                "summary": "Get deployment DAUs",
+                "operationId": "get-deployment-daus",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Time-zone offset (e.g. -2)",
+                        "name": "tz_offset",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get system notification templates",
-                "operationId": "get-system-notification-templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.NotificationTemplate"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Failed to retrieve 'system' notifications template",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.DAUsResponse"
                         }
                     }
                 }
             }
         },
-        "/notifications/templates/{notification_template}/method": {
-            "put": {
+        "/insights/prebuilds": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -2380,49 +2278,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Insights"
                 ],
-                "summary": "Update notification template dispatch method",
-                "operationId": "update-notification-template-dispatch-method",
+                "summary": "Get insights about prebuilt workspaces",
+                "operationId": "get-insights-about-prebuilt-workspaces",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification template UUID",
-                        "name": "notification_template",
-                        "in": "path",
+                        "format": "date-time",
+                        "description": "Start time",
+                        "name": "start_time",
+                        "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Success"
                     },
-                    "304": {
-                        "description": "Not modified"
-                    }
-                }
-            }
-        },
-        "/notifications/test": {
-            "post": {
-                "security": [
                     {
-                        "CoderSessionToken": []
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "End time",
+                        "name": "end_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Template IDs",
+                        "name": "template_ids",
+                        "in": "query"
                     }
                 ],
-                "tags": [
-                    "Notifications"
-                ],
-                "summary": "Send a test notification",
-                "operationId": "send-a-test-notification",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PrebuildsInsightsResponse"
+                        }
                     }
                 }
             }
         },
-        "/oauth2-provider/apps": {
+        "/insights/templates": {
             "get": {
                 "security": [
                     {
@@ -2433,15 +2331,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Insights"
                 ],
-                "summary": "Get OAuth2 applications.",
-                "operationId": "get-oauth2-applications",
+                "summary": "Get insights about templates",
+                "operationId": "get-insights-about-templates",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by applications authorized for a user",
-                        "name": "user_id",
+                        "format": "date-time",
+                        "description": "Start time",
+                        "name": "start_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "End time",
+                        "name": "end_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "day"
+                        ],
+                        "type": "string",
+                        "description": "Interval",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Template IDs",
+                        "name": "template_ids",
                         "in": "query"
                     }
                 ],
@@ -2449,53 +2378,66 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
-                            }
+                            "$ref": "#/definitions/codersdk.TemplateInsightsResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/insights/user-activity": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Insights"
                 ],
-                "summary": "Create OAuth2 application.",
-                "operationId": "create-oauth2-application",
+                "summary": "Get insights about user activity",
+                "operationId": "get-insights-about-user-activity",
                 "parameters": [
                     {
-                        "description": "The OAuth2 application to create.",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PostOAuth2ProviderAppRequest"
-                        }
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Start time",
+                        "name": "start_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "End time",
+                        "name": "end_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Template IDs",
+                        "name": "template_ids",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
+                            "$ref": "#/definitions/codersdk.UserActivityInsightsResponse"
                         }
                     }
                 }
             }
         },
-        "/oauth2-provider/apps/{app}": {
+        "/insights/user-latency": {
             "get": {
                 "security": [
                     {
@@ -2506,100 +2448,83 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Insights"
                 ],
-                "summary": "Get OAuth2 application.",
-                "operationId": "get-oauth2-application",
+                "summary": "Get insights about user latency",
+                "operationId": "get-insights-about-user-latency",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
-                        "required": true
+                        "format": "date-time",
+                        "description": "Start time",
+                        "name": "start_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "End time",
+                        "name": "end_time",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Template IDs",
+                        "name": "template_ids",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
+                            "$ref": "#/definitions/codersdk.UserLatencyInsightsResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/insights/user-status-counts": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Insights"
                 ],
-                "summary": "Update OAuth2 application.",
-                "operationId": "update-oauth2-application",
+                "summary": "Get insights about user status counts",
+                "operationId": "get-insights-about-user-status-counts",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "Time-zone offset (e.g. -2)",
+                        "name": "tz_offset",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "description": "Update an OAuth2 application.",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PutOAuth2ProviderAppRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
+                            "$ref": "#/definitions/codersdk.GetUserStatusCountsResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Delete OAuth2 application.",
-                "operationId": "delete-oauth2-application",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
             }
         },
-        "/oauth2-provider/apps/{app}/secrets": {
+        "/licenses": {
             "get": {
                 "security": [
                     {
@@ -2612,24 +2537,15 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get OAuth2 application secrets.",
-                "operationId": "get-oauth2-application-secrets",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get licenses",
+                "operationId": "get-licenses",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.OAuth2ProviderAppSecret"
+                                "$ref": "#/definitions/codersdk.License"
                             }
                         }
                     }
@@ -2641,268 +2557,241 @@ const docTemplate = `{
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Create OAuth2 application secret.",
-                "operationId": "create-oauth2-application-secret",
+                "summary": "Add new license",
+                "operationId": "add-new-license",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
-                        "required": true
+                        "description": "Add license request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.AddLicenseRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.OAuth2ProviderAppSecretFull"
-                            }
+                            "$ref": "#/definitions/codersdk.License"
                         }
                     }
                 }
             }
         },
-        "/oauth2-provider/apps/{app}/secrets/{secretID}": {
-            "delete": {
+        "/licenses/refresh-entitlements": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "tags": [
-                    "Enterprise"
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Delete OAuth2 application secret.",
-                "operationId": "delete-oauth2-application-secret",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "App ID",
-                        "name": "app",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Secret ID",
-                        "name": "secretID",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "Organizations"
                 ],
+                "summary": "Update license entitlements",
+                "operationId": "update-license-entitlements",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     }
                 }
             }
         },
-        "/oauth2/authorize": {
-            "get": {
+        "/licenses/{id}": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "OAuth2 authorization request (GET - show authorization page).",
-                "operationId": "oauth2-authorization-request-get",
+                "summary": "Delete license",
+                "operationId": "delete-license",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "A random unguessable string",
-                        "name": "state",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "code"
-                        ],
-                        "type": "string",
-                        "description": "Response type",
-                        "name": "response_type",
-                        "in": "query",
+                        "format": "number",
+                        "description": "License ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Redirect here after authorization",
-                        "name": "redirect_uri",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Token scopes (currently ignored)",
-                        "name": "scope",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Returns HTML authorization page"
+                        "description": "OK"
                     }
                 }
-            },
+            }
+        },
+        "/notifications/custom": {
             "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "OAuth2 authorization request (POST - process authorization).",
-                "operationId": "oauth2-authorization-request-post",
+                "summary": "Send a custom notification",
+                "operationId": "send-a-custom-notification",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Provide a non-empty title or message",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CustomNotificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "type": "string",
-                        "description": "A random unguessable string",
-                        "name": "state",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "code"
-                        ],
-                        "type": "string",
-                        "description": "Response type",
-                        "name": "response_type",
-                        "in": "query",
-                        "required": true
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Redirect here after authorization",
-                        "name": "redirect_uri",
-                        "in": "query"
+                    "403": {
+                        "description": "System users cannot send custom notifications",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Token scopes (currently ignored)",
-                        "name": "scope",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "302": {
-                        "description": "Returns redirect with authorization code"
+                    "500": {
+                        "description": "Failed to send custom notification",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     }
                 }
             }
         },
-        "/oauth2/clients/{client_id}": {
+        "/notifications/dispatch-methods": {
             "get": {
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Get OAuth2 client configuration (RFC 7592)",
-                "operationId": "get-oauth2-client-configuration",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Notifications"
                 ],
+                "summary": "Get notification dispatch methods",
+                "operationId": "get-notification-dispatch-methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationMethodsResponse"
+                            }
                         }
                     }
                 }
-            },
-            "put": {
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/notifications/inbox": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "Update OAuth2 client configuration (RFC 7592)",
-                "operationId": "put-oauth2-client-configuration",
+                "summary": "List inbox notifications",
+                "operationId": "list-inbox-notifications",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma-separated list of target IDs to filter notifications",
+                        "name": "targets",
+                        "in": "query"
                     },
                     {
-                        "description": "Client update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
-                        }
+                        "type": "string",
+                        "description": "Comma-separated list of template IDs to filter notifications",
+                        "name": "templates",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter notifications by read status. Possible values: read, unread, all",
+                        "name": "read_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the last notification from the current page. Notifications returned will be older than the associated one",
+                        "name": "starting_before",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                            "$ref": "#/definitions/codersdk.ListInboxNotificationsResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Delete OAuth2 client registration (RFC 7592)",
-                "operationId": "delete-oauth2-client-configuration",
-                "parameters": [
+            }
+        },
+        "/notifications/inbox/mark-all-as-read": {
+            "put": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "path",
-                        "required": true
+                        "CoderSessionToken": []
                     }
                 ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all unread notifications as read",
+                "operationId": "mark-all-unread-notifications-as-read",
                 "responses": {
                     "204": {
                         "description": "No Content"
@@ -2910,124 +2799,96 @@ const docTemplate = `{
                 }
             }
         },
-        "/oauth2/register": {
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "OAuth2 dynamic client registration (RFC 7591)",
-                "operationId": "oauth2-dynamic-client-registration",
-                "parameters": [
+        "/notifications/inbox/watch": {
+            "get": {
+                "security": [
                     {
-                        "description": "Client registration request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
-                        }
+                        "CoderSessionToken": []
                     }
                 ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/oauth2/tokens": {
-            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "OAuth2 token exchange.",
-                "operationId": "oauth2-token-exchange",
+                "summary": "Watch for new inbox notifications",
+                "operationId": "watch-for-new-inbox-notifications",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID, required if grant_type=authorization_code",
-                        "name": "client_id",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Client secret, required if grant_type=authorization_code",
-                        "name": "client_secret",
-                        "in": "formData"
+                        "description": "Comma-separated list of target IDs to filter notifications",
+                        "name": "targets",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Authorization code, required if grant_type=authorization_code",
-                        "name": "code",
-                        "in": "formData"
+                        "description": "Comma-separated list of template IDs to filter notifications",
+                        "name": "templates",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Refresh token, required if grant_type=refresh_token",
-                        "name": "refresh_token",
-                        "in": "formData"
+                        "description": "Filter notifications by read status. Possible values: read, unread, all",
+                        "name": "read_status",
+                        "in": "query"
                     },
                     {
                         "enum": [
-                            "authorization_code",
-                            "refresh_token"
+                            "plaintext",
+                            "markdown"
                         ],
                         "type": "string",
-                        "description": "Grant type",
-                        "name": "grant_type",
-                        "in": "formData",
-                        "required": true
+                        "description": "Define the output format for notifications title and body.",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/oauth2.Token"
+                            "$ref": "#/definitions/codersdk.GetInboxNotificationResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/notifications/inbox/{id}/read-status": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "Delete OAuth2 application tokens.",
-                "operationId": "delete-oauth2-application-tokens",
+                "summary": "Update read status of a notification",
+                "operationId": "update-read-status-of-a-notification",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "client_id",
-                        "in": "query",
+                        "description": "id of the notification",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     }
                 }
             }
         },
-        "/organizations": {
+        "/notifications/settings": {
             "get": {
                 "security": [
                     {
@@ -3038,23 +2899,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Notifications"
                 ],
-                "summary": "Get organizations",
-                "operationId": "get-organizations",
+                "summary": "Get notifications settings",
+                "operationId": "get-notifications-settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Organization"
-                            }
+                            "$ref": "#/definitions/codersdk.NotificationsSettings"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3067,32 +2925,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Notifications"
                 ],
-                "summary": "Create organization",
-                "operationId": "create-organization",
+                "summary": "Update notifications settings",
+                "operationId": "update-notifications-settings",
                 "parameters": [
                     {
-                        "description": "Create organization request",
+                        "description": "Notifications settings request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateOrganizationRequest"
+                            "$ref": "#/definitions/codersdk.NotificationsSettings"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Organization"
+                            "$ref": "#/definitions/codersdk.NotificationsSettings"
                         }
+                    },
+                    "304": {
+                        "description": "Not Modified"
                     }
                 }
             }
         },
-        "/organizations/{organization}": {
+        "/notifications/templates/custom": {
             "get": {
                 "security": [
                     {
@@ -3103,30 +2964,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Notifications"
                 ],
-                "summary": "Get organization by ID",
-                "operationId": "get-organization-by-id",
-                "parameters": [
+                "summary": "Get custom notification templates",
+                "operationId": "get-custom-notification-templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve 'custom' notifications template",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/templates/system": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
+                        "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get system notification templates",
+                "operationId": "get-system-notification-templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Organization"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve 'system' notifications template",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/notifications/templates/{notification_template}/method": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3136,29 +3032,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Enterprise"
                 ],
-                "summary": "Delete organization",
-                "operationId": "delete-organization",
+                "summary": "Update notification template dispatch method",
+                "operationId": "update-notification-template-dispatch-method",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID or name",
-                        "name": "organization",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
+                        "description": "Success"
+                    },
+                    "304": {
+                        "description": "Not modified"
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/notifications/templates/{notification_template}/overrides": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3171,25 +3069,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Notifications"
                 ],
-                "summary": "Update organization",
-                "operationId": "update-organization",
+                "summary": "Update notification template overrides",
+                "operationId": "update-notification-template-overrides",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID or name",
-                        "name": "organization",
+                        "format": "uuid",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Patch organization request",
+                        "description": "Overrides",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateOrganizationRequest"
+                            "$ref": "#/definitions/codersdk.UpdateNotificationTemplateOverrides"
                         }
                     }
                 ],
@@ -3197,49 +3096,40 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Organization"
+                            "$ref": "#/definitions/codersdk.NotificationTemplate"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/groups": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "Get groups by organization",
-                "operationId": "get-groups-by-organization",
+                "summary": "Delete notification template overrides",
+                "operationId": "delete-notification-template-overrides",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Group"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
+            }
+        },
+        "/notifications/templates/{notification_template}/preview": {
             "post": {
                 "security": [
                     {
@@ -3253,156 +3143,174 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "Create group for organization",
-                "operationId": "create-group-for-organization",
+                "summary": "Preview notification template",
+                "operationId": "preview-notification-template",
                 "parameters": [
                     {
-                        "description": "Create group request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preview request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateGroupRequest"
+                            "$ref": "#/definitions/codersdk.NotificationTemplatePreviewRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Group"
+                            "$ref": "#/definitions/codersdk.NotificationTemplatePreview"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/groups/{groupName}": {
-            "get": {
+        "/notifications/templates/{notification_template}/test": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Notifications"
                 ],
-                "summary": "Get group by organization and group name",
-                "operationId": "get-group-by-organization-and-group-name",
+                "summary": "Send a test notification from a template",
+                "operationId": "send-a-test-notification-from-a-template",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Group name",
-                        "name": "groupName",
-                        "in": "path",
-                        "required": true
+                        "description": "Test request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.NotificationTemplateTestRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Group"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/members": {
-            "get": {
+        "/notifications/templates/{notification_template}/urgency": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Notifications"
                 ],
-                "summary": "List organization members",
-                "operationId": "list-organization-members",
-                "deprecated": true,
+                "summary": "Update notification template urgency",
+                "operationId": "update-notification-template-urgency",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "format": "uuid",
+                        "description": "Notification template UUID",
+                        "name": "notification_template",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Urgency",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateNotificationTemplateUrgency"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.OrganizationMemberWithUserData"
-                            }
+                            "$ref": "#/definitions/codersdk.NotificationTemplate"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/members/roles": {
-            "get": {
+        "/notifications/test": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Members"
+                    "Notifications"
                 ],
-                "summary": "Get member roles by organization",
-                "operationId": "get-member-roles-by-organization",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
+                "summary": "Send a test notification",
+                "operationId": "send-a-test-notification",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/notifications/webhook-secrets": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get notification webhook secrets",
+                "operationId": "get-notification-webhook-secrets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.AssignableRoles"
+                                "$ref": "#/definitions/codersdk.NotificationWebhookSecret"
                             }
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3415,28 +3323,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Notifications"
                 ],
-                "summary": "Upsert a custom organization role",
-                "operationId": "upsert-a-custom-organization-role",
+                "summary": "Rotate notification webhook secret",
+                "operationId": "rotate-notification-webhook-secret",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Upsert role request",
+                        "description": "Rotation request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                            "$ref": "#/definitions/codersdk.RotateNotificationWebhookSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.NotificationWebhookSecret"
                         }
                     }
+                }
+            }
+        },
+        "/oauth2-provider/apps": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get OAuth2 applications.",
+                "operationId": "get-oauth2-applications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by applications authorized for a user",
+                        "name": "user_id",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -3444,7 +3377,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.Role"
+                                "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
                             }
                         }
                     }
@@ -3463,26 +3396,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Insert a custom organization role",
-                "operationId": "insert-a-custom-organization-role",
+                "summary": "Create OAuth2 application.",
+                "operationId": "create-oauth2-application",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Insert role request",
+                        "description": "The OAuth2 application to create.",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                            "$ref": "#/definitions/codersdk.PostOAuth2ProviderAppRequest"
                         }
                     }
                 ],
@@ -3490,17 +3415,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Role"
-                            }
+                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/members/roles/{roleName}": {
-            "delete": {
+        "/oauth2-provider/apps/{app}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3510,23 +3432,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Delete a custom organization role",
-                "operationId": "delete-a-custom-organization-role",
+                "summary": "Get OAuth2 application.",
+                "operationId": "get-oauth2-application",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Role name",
-                        "name": "roleName",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
                     }
@@ -3535,51 +3449,51 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Role"
-                            }
+                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/members/{user}": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Add organization member",
-                "operationId": "add-organization-member",
+                "summary": "Update OAuth2 application.",
+                "operationId": "update-oauth2-application",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
+                        "description": "Update an OAuth2 application.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PutOAuth2ProviderAppRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationMember"
+                            "$ref": "#/definitions/codersdk.OAuth2ProviderApp"
                         }
                     }
                 }
@@ -3591,22 +3505,15 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Remove organization member",
-                "operationId": "remove-organization-member",
+                "summary": "Delete OAuth2 application.",
+                "operationId": "delete-oauth2-application",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
                     }
@@ -3618,61 +3525,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/organizations/{organization}/members/{user}/roles": {
-            "put": {
+        "/oauth2-provider/apps/{app}/secrets": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Assign role to organization member",
-                "operationId": "assign-role-to-organization-member",
+                "summary": "Get OAuth2 application secrets.",
+                "operationId": "get-oauth2-application-secrets",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update roles request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateRoles"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationMember"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.OAuth2ProviderAppSecret"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/members/{user}/workspace-quota": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -3684,21 +3573,13 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get workspace quota by user",
-                "operationId": "get-workspace-quota-by-user",
+                "summary": "Create OAuth2 application secret.",
+                "operationId": "create-oauth2-application-secret",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
                     }
@@ -3707,379 +3588,332 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.OAuth2ProviderAppSecretFull"
+                            }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/members/{user}/workspaces": {
-            "post": {
+        "/oauth2-provider/apps/{app}/secrets/{secretID}": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "description": "Create a new workspace using a template. The request must\nspecify either the Template ID or the Template Version ID,\nnot both. If the Template ID is specified, the active version\nof the template will be used.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Workspaces"
+                    "Enterprise"
                 ],
-                "summary": "Create user workspace by organization",
-                "operationId": "create-user-workspace-by-organization",
-                "deprecated": true,
+                "summary": "Delete OAuth2 application secret.",
+                "operationId": "delete-oauth2-application-secret",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "App ID",
+                        "name": "app",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Username, UUID, or me",
-                        "name": "user",
+                        "description": "Secret ID",
+                        "name": "secretID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create workspace request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateWorkspaceRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Workspace"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/paginated-members": {
+        "/oauth2/authorize": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Members"
+                    "Enterprise"
                 ],
-                "summary": "Paginated organization members",
-                "operationId": "paginated-organization-members",
+                "summary": "OAuth2 authorization request (GET - show authorization page).",
+                "operationId": "oauth2-authorization-request-get",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page limit, if 0 returns all members",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "A random unguessable string",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code"
+                        ],
+                        "type": "string",
+                        "description": "Response type",
+                        "name": "response_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Redirect here after authorization",
+                        "name": "redirect_uri",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
+                        "type": "string",
+                        "description": "Token scopes (currently ignored)",
+                        "name": "scope",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.PaginatedMembersResponse"
-                            }
-                        }
+                        "description": "Returns HTML authorization page"
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/provisionerdaemons": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Provisioning"
+                    "Enterprise"
                 ],
-                "summary": "Get provisioner daemons",
-                "operationId": "get-provisioner-daemons",
+                "summary": "OAuth2 authorization request (POST - process authorization).",
+                "operationId": "oauth2-authorization-request-post",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "format": "uuid",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "Filter results by job IDs",
-                        "name": "ids",
-                        "in": "query"
+                        "type": "string",
+                        "description": "A random unguessable string",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "enum": [
-                            "pending",
-                            "running",
-                            "succeeded",
-                            "canceling",
-                            "canceled",
-                            "failed",
-                            "unknown",
-                            "pending",
-                            "running",
-                            "succeeded",
-                            "canceling",
-                            "canceled",
-                            "failed"
+                            "code"
                         ],
                         "type": "string",
-                        "description": "Filter results by status",
-                        "name": "status",
+                        "description": "Response type",
+                        "name": "response_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Redirect here after authorization",
+                        "name": "redirect_uri",
                         "in": "query"
                     },
                     {
-                        "type": "object",
-                        "description": "Provisioner tags to filter by (JSON of the form {'tag1':'value1','tag2':'value2'})",
-                        "name": "tags",
+                        "type": "string",
+                        "description": "Token scopes (currently ignored)",
+                        "name": "scope",
                         "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerDaemon"
-                            }
-                        }
+                    "302": {
+                        "description": "Returns redirect with authorization code"
                     }
                 }
             }
         },
-        "/organizations/{organization}/provisionerdaemons/serve": {
+        "/oauth2/clients/{client_id}": {
             "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Serve provisioner daemon",
-                "operationId": "serve-provisioner-daemon",
+                "summary": "Get OAuth2 client configuration (RFC 7592)",
+                "operationId": "get-oauth2-client-configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "Client ID",
+                        "name": "client_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                        }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/provisionerjobs": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Enterprise"
                 ],
-                "summary": "Get provisioner jobs",
-                "operationId": "get-provisioner-jobs",
+                "summary": "Update OAuth2 client configuration (RFC 7592)",
+                "operationId": "put-oauth2-client-configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
+                        "description": "Client ID",
+                        "name": "client_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "format": "uuid",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "Filter results by job IDs",
-                        "name": "ids",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "pending",
-                            "running",
-                            "succeeded",
-                            "canceling",
-                            "canceled",
-                            "failed",
-                            "unknown",
-                            "pending",
-                            "running",
-                            "succeeded",
-                            "canceling",
-                            "canceled",
-                            "failed"
-                        ],
-                        "type": "string",
-                        "description": "Filter results by status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "object",
-                        "description": "Provisioner tags to filter by (JSON of the form {'tag1':'value1','tag2':'value2'})",
-                        "name": "tags",
-                        "in": "query"
-                    },
+                        "description": "Client update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientConfiguration"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Delete OAuth2 client registration (RFC 7592)",
+                "operationId": "delete-oauth2-client-configuration",
+                "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Filter results by initiator",
-                        "name": "initiator",
-                        "in": "query"
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerJob"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/provisionerjobs/{job}": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
+        "/oauth2/register": {
+            "post": {
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organizations"
+                    "Enterprise"
                 ],
-                "summary": "Get provisioner job",
-                "operationId": "get-provisioner-job",
+                "summary": "OAuth2 dynamic client registration (RFC 7591)",
+                "operationId": "oauth2-dynamic-client-registration",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Job ID",
-                        "name": "job",
-                        "in": "path",
-                        "required": true
+                        "description": "Client registration request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ProvisionerJob"
+                            "$ref": "#/definitions/codersdk.OAuth2ClientRegistrationResponse"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/provisionerkeys": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
+        "/oauth2/tokens": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "List provisioner key",
-                "operationId": "list-provisioner-key",
+                "summary": "OAuth2 token exchange.",
+                "operationId": "oauth2-token-exchange",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
+                        "description": "Client ID, required if grant_type=authorization_code",
+                        "name": "client_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client secret, required if grant_type=authorization_code",
+                        "name": "client_secret",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code, required if grant_type=authorization_code",
+                        "name": "code",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refresh token, required if grant_type=refresh_token",
+                        "name": "refresh_token",
+                        "in": "formData"
+                    },
+                    {
+                        "enum": [
+                            "authorization_code",
+                            "refresh_token"
+                        ],
+                        "type": "string",
+                        "description": "Grant type",
+                        "name": "grant_type",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -4087,48 +3921,39 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerKey"
-                            }
+                            "$ref": "#/definitions/oauth2.Token"
                         }
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Create provisioner key",
-                "operationId": "create-provisioner-key",
+                "summary": "Delete OAuth2 application tokens.",
+                "operationId": "delete-oauth2-application-tokens",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateProvisionerKeyResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/provisionerkeys/daemons": {
+        "/organizations": {
             "get": {
                 "security": [
                     {
@@ -4139,68 +3964,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "List provisioner key daemons",
-                "operationId": "list-provisioner-key-daemons",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Organizations"
                 ],
+                "summary": "Get organizations",
+                "operationId": "get-organizations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerKeyDaemons"
+                                "$ref": "#/definitions/codersdk.Organization"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/provisionerkeys/{provisionerkey}": {
-            "delete": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Delete provisioner key",
-                "operationId": "delete-provisioner-key",
+                "summary": "Create organization",
+                "operationId": "create-organization",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Provisioner key name",
-                        "name": "provisionerkey",
-                        "in": "path",
-                        "required": true
+                        "description": "Create organization request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateOrganizationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Organization"
+                        }
                     }
                 }
             }
         },
-        "/organizations/{organization}/settings/idpsync/available-fields": {
+        "/organizations/{organization}": {
             "get": {
                 "security": [
                     {
@@ -4211,10 +4029,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Get the available organization idp sync claim fields",
-                "operationId": "get-the-available-organization-idp-sync-claim-fields",
+                "summary": "Get organization by ID",
+                "operationId": "get-organization-by-id",
                 "parameters": [
                     {
                         "type": "string",
@@ -4229,17 +4047,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/codersdk.Organization"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/settings/idpsync/field-values": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4249,92 +4062,88 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Get the organization idp sync claim field values",
-                "operationId": "get-the-organization-idp-sync-claim-field-values",
+                "summary": "Delete organization",
+                "operationId": "delete-organization",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
+                        "description": "Organization ID or name",
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Claim Field",
-                        "name": "claimField",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/settings/idpsync/groups": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Get group IdP Sync settings by organization",
-                "operationId": "get-group-idp-sync-settings-by-organization",
+                "summary": "Update organization",
+                "operationId": "update-organization",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
+                        "description": "Organization ID or name",
                         "name": "organization",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Patch organization request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateOrganizationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "$ref": "#/definitions/codersdk.Organization"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/organizations/{organization}/autostart-calendars": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Update group IdP Sync settings by organization",
-                "operationId": "update-group-idp-sync-settings-by-organization",
+                "summary": "Get autostart exception calendars by organization",
+                "operationId": "get-autostart-exception-calendars-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -4343,29 +4152,23 @@ const docTemplate = `{
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "New settings",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.AutostartExceptionCalendar"
+                            }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/settings/idpsync/groups/config": {
-            "patch": {
+        "/organizations/{organization}/autostart-calendars/{calendar}": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4378,26 +4181,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Update group IdP Sync config",
-                "operationId": "update-group-idp-sync-config",
+                "summary": "Upsert autostart exception calendar",
+                "operationId": "upsert-autostart-exception-calendar",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New config values",
+                        "type": "string",
+                        "description": "Calendar name",
+                        "name": "calendar",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upsert calendar request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncConfigRequest"
+                            "$ref": "#/definitions/codersdk.UpsertAutostartExceptionCalendarRequest"
                         }
                     }
                 ],
@@ -4405,60 +4215,47 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
+                            "$ref": "#/definitions/codersdk.AutostartExceptionCalendar"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/settings/idpsync/groups/mapping": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "Update group IdP Sync mapping",
-                "operationId": "update-group-idp-sync-mapping",
+                "summary": "Delete autostart exception calendar",
+                "operationId": "delete-autostart-exception-calendar",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Description of the mappings to add and remove",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncMappingRequest"
-                        }
+                        "type": "string",
+                        "description": "Calendar name",
+                        "name": "calendar",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/settings/idpsync/roles": {
+        "/organizations/{organization}/groups": {
             "get": {
                 "security": [
                     {
@@ -4471,8 +4268,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get role IdP Sync settings by organization",
-                "operationId": "get-role-idp-sync-settings-by-organization",
+                "summary": "Get groups by organization",
+                "operationId": "get-groups-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -4487,12 +4284,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Group"
+                            }
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4507,147 +4307,131 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update role IdP Sync settings by organization",
-                "operationId": "update-role-idp-sync-settings-by-organization",
+                "summary": "Create group for organization",
+                "operationId": "create-group-for-organization",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID",
-                        "name": "organization",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "New settings",
+                        "description": "Create group request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.CreateGroupRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.Group"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/settings/idpsync/roles/config": {
-            "patch": {
+        "/organizations/{organization}/groups/{groupName}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update role IdP Sync config",
-                "operationId": "update-role-idp-sync-config",
+                "summary": "Get group by organization and group name",
+                "operationId": "get-group-by-organization-and-group-name",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New config values",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncConfigRequest"
-                        }
+                        "type": "string",
+                        "description": "Group name",
+                        "name": "groupName",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "$ref": "#/definitions/codersdk.Group"
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/settings/idpsync/roles/mapping": {
-            "patch": {
+        "/organizations/{organization}/members": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Members"
                 ],
-                "summary": "Update role IdP Sync mapping",
-                "operationId": "update-role-idp-sync-mapping",
+                "summary": "List organization members",
+                "operationId": "list-organization-members",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Organization ID or name",
+                        "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Description of the mappings to add and remove",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncMappingRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.OrganizationMemberWithUserData"
+                            }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/templates": {
+        "/organizations/{organization}/members/roles": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "description": "Returns a list of templates for the specified organization.\nBy default, only non-deprecated templates are returned.\nTo include deprecated templates, specify ` + "`" + `deprecated:true` + "`" + ` in the search query.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Get templates by organization",
-                "operationId": "get-templates-by-organization",
+                "summary": "Get member roles by organization",
+                "operationId": "get-member-roles-by-organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -4664,13 +4448,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.Template"
+                                "$ref": "#/definitions/codersdk.AssignableRoles"
                             }
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4683,54 +4467,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Create template by organization",
-                "operationId": "create-template-by-organization",
+                "summary": "Upsert a custom organization role",
+                "operationId": "upsert-a-custom-organization-role",
                 "parameters": [
-                    {
-                        "description": "Request body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTemplateRequest"
-                        }
-                    },
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Upsert role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Template"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Role"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/templates/examples": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Get template examples by organization",
-                "operationId": "get-template-examples-by-organization",
-                "deprecated": true,
+                "summary": "Insert a custom organization role",
+                "operationId": "insert-a-custom-organization-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -4739,6 +4527,15 @@ const docTemplate = `{
                         "name": "organization",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Insert role request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CustomRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -4747,15 +4544,15 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.TemplateExample"
+                                "$ref": "#/definitions/codersdk.Role"
                             }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/templates/{templatename}": {
-            "get": {
+        "/organizations/{organization}/members/roles/{roleName}": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4765,10 +4562,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Get templates by organization and template name",
-                "operationId": "get-templates-by-organization-and-template-name",
+                "summary": "Delete a custom organization role",
+                "operationId": "delete-a-custom-organization-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -4780,8 +4577,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Template name",
-                        "name": "templatename",
+                        "description": "Role name",
+                        "name": "roleName",
                         "in": "path",
                         "required": true
                     }
@@ -4790,14 +4587,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Template"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Role"
+                            }
                         }
                     }
                 }
             }
         },
-        "/organizations/{organization}/templates/{templatename}/versions/{templateversionname}": {
-            "get": {
+        "/organizations/{organization}/members/{user}": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4807,14 +4607,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Get template version by organization, template, and name",
-                "operationId": "get-template-version-by-organization-template-and-name",
+                "summary": "Add organization member",
+                "operationId": "add-organization-member",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
@@ -4822,15 +4621,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Template name",
-                        "name": "templatename",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Template version name",
-                        "name": "templateversionname",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
@@ -4839,31 +4631,25 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateVersion"
+                            "$ref": "#/definitions/codersdk.OrganizationMember"
                         }
                     }
                 }
-            }
-        },
-        "/organizations/{organization}/templates/{templatename}/versions/{templateversionname}/previous": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Get previous template version by organization, template, and name",
-                "operationId": "get-previous-template-version-by-organization-template-and-name",
+                "summary": "Remove organization member",
+                "operationId": "remove-organization-member",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
@@ -4871,31 +4657,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Template name",
-                        "name": "templatename",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Template version name",
-                        "name": "templateversionname",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateVersion"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/organizations/{organization}/templateversions": {
-            "post": {
+        "/organizations/{organization}/members/{user}/roles": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -4908,40 +4684,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Members"
                 ],
-                "summary": "Create template version by organization",
-                "operationId": "create-template-version-by-organization",
+                "summary": "Assign role to organization member",
+                "operationId": "assign-role-to-organization-member",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
                         "description": "Organization ID",
                         "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Create template version request",
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update roles request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTemplateVersionRequest"
+                            "$ref": "#/definitions/codersdk.UpdateRoles"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateVersion"
+                            "$ref": "#/definitions/codersdk.OrganizationMember"
                         }
                     }
                 }
             }
         },
-        "/prebuilds/settings": {
+        "/organizations/{organization}/members/{user}/workspace-quota": {
             "get": {
                 "security": [
                     {
@@ -4952,25 +4734,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Prebuilds"
+                    "Enterprise"
+                ],
+                "summary": "Get workspace quota by user",
+                "operationId": "get-workspace-quota-by-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get prebuilds settings",
-                "operationId": "get-prebuilds-settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
+                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/organizations/{organization}/members/{user}/workspaces": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Create a new workspace using a template. The request must\nspecify either the Template ID or the Template Version ID,\nnot both. If the Template ID is specified, the active version\nof the template will be used.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4978,18 +4780,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Prebuilds"
+                    "Workspaces"
                 ],
-                "summary": "Update prebuilds settings",
-                "operationId": "update-prebuilds-settings",
+                "summary": "Create user workspace by organization",
+                "operationId": "create-user-workspace-by-organization",
+                "deprecated": true,
                 "parameters": [
                     {
-                        "description": "Prebuilds settings request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username, UUID, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create workspace request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceRequest"
                         }
                     }
                 ],
@@ -4997,75 +4815,62 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
+                            "$ref": "#/definitions/codersdk.Workspace"
                         }
-                    },
-                    "304": {
-                        "description": "Not Modified"
                     }
                 }
             }
         },
-        "/provisionerkeys/{provisionerkey}": {
+        "/organizations/{organization}/paginated-members": {
             "get": {
                 "security": [
                     {
-                        "CoderProvisionerKey": []
+                        "CoderSessionToken": []
                     }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Members"
                 ],
-                "summary": "Fetch provisioner key details",
-                "operationId": "fetch-provisioner-key-details",
+                "summary": "Paginated organization members",
+                "operationId": "paginated-organization-members",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Provisioner Key",
-                        "name": "provisionerkey",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ProvisionerKey"
-                        }
-                    }
-                }
-            }
-        },
-        "/regions": {
-            "get": {
-                "security": [
+                    },
                     {
-                        "CoderSessionToken": []
+                        "type": "integer",
+                        "description": "Page limit, if 0 returns all members",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "WorkspaceProxies"
-                ],
-                "summary": "Get site-wide regions for workspace connections",
-                "operationId": "get-site-wide-regions-for-workspace-connections",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.RegionsResponse-codersdk_Region"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.PaginatedMembersResponse"
+                            }
                         }
                     }
                 }
             }
         },
-        "/replicas": {
+        "/organizations/{organization}/provisionerdaemons": {
             "get": {
                 "security": [
                     {
@@ -5076,213 +4881,378 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Provisioning"
                 ],
-                "summary": "Get active replicas",
-                "operationId": "get-active-replicas",
-                "responses": {
+                "summary": "Get provisioner daemons",
+                "operationId": "get-provisioner-daemons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "format": "uuid",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "Filter results by job IDs",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "running",
+                            "succeeded",
+                            "canceling",
+                            "canceled",
+                            "failed",
+                            "unknown",
+                            "pending",
+                            "running",
+                            "succeeded",
+                            "canceling",
+                            "canceled",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Filter results by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Provisioner tags to filter by (JSON of the form {'tag1':'value1','tag2':'value2'})",
+                        "name": "tags",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.Replica"
+                                "$ref": "#/definitions/codersdk.ProvisionerDaemon"
                             }
                         }
                     }
                 }
             }
         },
-        "/scim/v2/ServiceProviderConfig": {
-            "get": {
-                "produces": [
-                    "application/scim+json"
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "SCIM 2.0: Service Provider Config",
-                "operationId": "scim-get-service-provider-config",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/scim/v2/Users": {
+        "/organizations/{organization}/provisionerdaemons/serve": {
             "get": {
                 "security": [
                     {
-                        "Authorization": []
+                        "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/scim+json"
-                ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "SCIM 2.0: Get users",
-                "operationId": "scim-get-users",
+                "summary": "Serve provisioner daemon",
+                "operationId": "serve-provisioner-daemon",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/organizations/{organization}/provisionerjobs": {
+            "get": {
                 "security": [
                     {
-                        "Authorization": []
+                        "CoderSessionToken": []
                     }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "SCIM 2.0: Create new user",
-                "operationId": "scim-create-new-user",
+                "summary": "Get provisioner jobs",
+                "operationId": "get-provisioner-jobs",
                 "parameters": [
                     {
-                        "description": "New user",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/coderd.SCIMUser"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "format": "uuid",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "Filter results by job IDs",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "running",
+                            "succeeded",
+                            "canceling",
+                            "canceled",
+                            "failed",
+                            "unknown",
+                            "pending",
+                            "running",
+                            "succeeded",
+                            "canceling",
+                            "canceled",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Filter results by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Provisioner tags to filter by (JSON of the form {'tag1':'value1','tag2':'value2'})",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Filter results by initiator",
+                        "name": "initiator",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coderd.SCIMUser"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJob"
+                            }
                         }
                     }
                 }
             }
         },
-        "/scim/v2/Users/{id}": {
+        "/organizations/{organization}/provisionerjobs/{job}": {
             "get": {
                 "security": [
                     {
-                        "Authorization": []
+                        "CoderSessionToken": []
                     }
                 ],
                 "produces": [
-                    "application/scim+json"
+                    "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Organizations"
                 ],
-                "summary": "SCIM 2.0: Get user by ID",
-                "operationId": "scim-get-user-by-id",
+                "summary": "Get provisioner job",
+                "operationId": "get-provisioner-job",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "job",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "404": {
-                        "description": "Not Found"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ProvisionerJob"
+                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/organizations/{organization}/provisionerkeys": {
+            "get": {
                 "security": [
                     {
-                        "Authorization": []
+                        "CoderSessionToken": []
                     }
                 ],
                 "produces": [
-                    "application/scim+json"
+                    "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "SCIM 2.0: Replace user account",
-                "operationId": "scim-replace-user-status",
+                "summary": "List provisioner key",
+                "operationId": "list-provisioner-key",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Replace user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/coderd.SCIMUser"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerKey"
+                            }
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
-                        "Authorization": []
+                        "CoderSessionToken": []
                     }
                 ],
                 "produces": [
-                    "application/scim+json"
+                    "application/json"
                 ],
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "SCIM 2.0: Update user account",
-                "operationId": "scim-update-user-status",
+                "summary": "Create provisioner key",
+                "operationId": "create-provisioner-key",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/coderd.SCIMUser"
+                            "$ref": "#/definitions/codersdk.CreateProvisionerKeyResponse"
                         }
                     }
+                }
+            }
+        },
+        "/organizations/{organization}/provisionerkeys/daemons": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "List provisioner key daemons",
+                "operationId": "list-provisioner-key-daemons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerKeyDaemons"
+                            }
                         }
                     }
                 }
             }
         },
-        "/settings/idpsync/available-fields": {
+        "/organizations/{organization}/provisionerkeys/{provisionerkey}": {
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Delete provisioner key",
+                "operationId": "delete-provisioner-key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Provisioner key name",
+                        "name": "provisionerkey",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/organizations/{organization}/settings/idpsync/available-fields": {
             "get": {
                 "security": [
                     {
@@ -5295,8 +5265,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get the available idp sync claim fields",
-                "operationId": "get-the-available-idp-sync-claim-fields",
+                "summary": "Get the available organization idp sync claim fields",
+                "operationId": "get-the-available-organization-idp-sync-claim-fields",
                 "parameters": [
                     {
                         "type": "string",
@@ -5320,7 +5290,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/settings/idpsync/field-values": {
+        "/organizations/{organization}/settings/idpsync/field-values": {
             "get": {
                 "security": [
                     {
@@ -5333,8 +5303,8 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get the idp sync claim field values",
-                "operationId": "get-the-idp-sync-claim-field-values",
+                "summary": "Get the organization idp sync claim field values",
+                "operationId": "get-the-organization-idp-sync-claim-field-values",
                 "parameters": [
                     {
                         "type": "string",
@@ -5366,7 +5336,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/settings/idpsync/organization": {
+        "/organizations/{organization}/settings/idpsync/groups": {
             "get": {
                 "security": [
                     {
@@ -5379,13 +5349,23 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Get organization IdP Sync settings",
-                "operationId": "get-organization-idp-sync-settings",
+                "summary": "Get group IdP Sync settings by organization",
+                "operationId": "get-group-idp-sync-settings-by-organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 }
@@ -5405,16 +5385,24 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update organization IdP Sync settings",
-                "operationId": "update-organization-idp-sync-settings",
+                "summary": "Update group IdP Sync settings by organization",
+                "operationId": "update-group-idp-sync-settings-by-organization",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "New settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 ],
@@ -5422,13 +5410,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/settings/idpsync/organization/config": {
+        "/organizations/{organization}/settings/idpsync/groups/config": {
             "patch": {
                 "security": [
                     {
@@ -5444,16 +5432,24 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update organization IdP Sync config",
-                "operationId": "update-organization-idp-sync-config",
+                "summary": "Update group IdP Sync config",
+                "operationId": "update-group-idp-sync-config",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID or name",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "New config values",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PatchOrganizationIDPSyncConfigRequest"
+                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncConfigRequest"
                         }
                     }
                 ],
@@ -5461,13 +5457,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/settings/idpsync/organization/mapping": {
+        "/organizations/{organization}/settings/idpsync/groups/mapping": {
             "patch": {
                 "security": [
                     {
@@ -5483,16 +5479,24 @@ const docTemplate = `{
                 "tags": [
                     "Enterprise"
                 ],
-                "summary": "Update organization IdP Sync mapping",
-                "operationId": "update-organization-idp-sync-mapping",
+                "summary": "Update group IdP Sync mapping",
+                "operationId": "update-group-idp-sync-mapping",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID or name",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Description of the mappings to add and remove",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PatchOrganizationIDPSyncMappingRequest"
+                            "$ref": "#/definitions/codersdk.PatchGroupIDPSyncMappingRequest"
                         }
                     }
                 ],
@@ -5500,155 +5504,140 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                            "$ref": "#/definitions/codersdk.GroupSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/tailnet": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Agents"
-                ],
-                "summary": "User-scoped tailnet RPC connection",
-                "operationId": "user-scoped-tailnet-rpc-connection",
-                "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
-                    }
-                }
-            }
-        },
-        "/templates": {
+        "/organizations/{organization}/settings/idpsync/roles": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "description": "Returns a list of templates.\nBy default, only non-deprecated templates are returned.\nTo include deprecated templates, specify ` + "`" + `deprecated:true` + "`" + ` in the search query.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
+                ],
+                "summary": "Get role IdP Sync settings by organization",
+                "operationId": "get-role-idp-sync-settings-by-organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all templates",
-                "operationId": "get-all-templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Template"
-                            }
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
                         }
                     }
                 }
-            }
-        },
-        "/templates/examples": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
+                ],
+                "summary": "Update role IdP Sync settings by organization",
+                "operationId": "update-role-idp-sync-settings-by-organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
+                        }
+                    }
                 ],
-                "summary": "Get template examples",
-                "operationId": "get-template-examples",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateExample"
-                            }
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}": {
-            "get": {
+        "/organizations/{organization}/settings/idpsync/roles/config": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template settings by ID",
-                "operationId": "get-template-settings-by-id",
+                "summary": "Update role IdP Sync config",
+                "operationId": "update-role-idp-sync-config",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID or name",
+                        "name": "organization",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    {
+                        "description": "New config values",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Template"
+                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncConfigRequest"
                         }
                     }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Templates"
-                ],
-                "summary": "Delete template by ID",
-                "operationId": "delete-template-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/organizations/{organization}/settings/idpsync/roles/mapping": {
             "patch": {
                 "security": [
                     {
@@ -5662,26 +5651,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Update template settings by ID",
-                "operationId": "update-template-settings-by-id",
+                "summary": "Update role IdP Sync mapping",
+                "operationId": "update-role-idp-sync-mapping",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID or name",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Patch template settings request",
+                        "description": "Description of the mappings to add and remove",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateTemplateMeta"
+                            "$ref": "#/definitions/codersdk.PatchRoleIDPSyncMappingRequest"
                         }
                     }
                 ],
@@ -5689,33 +5678,34 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Template"
+                            "$ref": "#/definitions/codersdk.RoleSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/acl": {
+        "/organizations/{organization}/templates": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Returns a list of templates for the specified organization.\nBy default, only non-deprecated templates are returned.\nTo include deprecated templates, specify ` + "`" + `deprecated:true` + "`" + ` in the search query.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Get template ACLs",
-                "operationId": "get-template-acls",
+                "summary": "Get templates by organization",
+                "operationId": "get-templates-by-organization",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     }
@@ -5724,12 +5714,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateACL"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Template"
+                            }
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -5742,40 +5735,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Update template ACL",
-                "operationId": "update-template-acl",
+                "summary": "Create template by organization",
+                "operationId": "create-template-by-organization",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update template ACL request",
+                        "description": "Request body",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateTemplateACL"
+                            "$ref": "#/definitions/codersdk.CreateTemplateRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.Template"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/acl/available": {
+        "/organizations/{organization}/templates/examples": {
             "get": {
                 "security": [
                     {
@@ -5786,16 +5778,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Templates"
                 ],
-                "summary": "Get template available acl users/groups",
-                "operationId": "get-template-available-acl-usersgroups",
+                "summary": "Get template examples by organization",
+                "operationId": "get-template-examples-by-organization",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     }
@@ -5806,14 +5799,14 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.ACLAvailable"
+                                "$ref": "#/definitions/codersdk.TemplateExample"
                             }
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/daus": {
+        "/organizations/{organization}/templates/{templatename}": {
             "get": {
                 "security": [
                     {
@@ -5826,14 +5819,21 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Get template DAUs by ID",
-                "operationId": "get-template-daus-by-id",
+                "summary": "Get templates by organization and template name",
+                "operationId": "get-templates-by-organization-and-template-name",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template name",
+                        "name": "templatename",
                         "in": "path",
                         "required": true
                     }
@@ -5842,13 +5842,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DAUsResponse"
+                            "$ref": "#/definitions/codersdk.Template"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/versions": {
+        "/organizations/{organization}/templates/{templatename}/versions/{templateversionname}": {
             "get": {
                 "security": [
                     {
@@ -5861,87 +5861,77 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "List template versions by template ID",
-                "operationId": "list-template-versions-by-template-id",
+                "summary": "Get template version by organization, template, and name",
+                "operationId": "get-template-version-by-organization-template-and-name",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "After ID",
-                        "name": "after_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Include archived versions in the list",
-                        "name": "include_archived",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Template name",
+                        "name": "templatename",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Template version name",
+                        "name": "templateversionname",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersion"
-                            }
+                            "$ref": "#/definitions/codersdk.TemplateVersion"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/organizations/{organization}/templates/{templatename}/versions/{templateversionname}/previous": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Update active template version by template ID",
-                "operationId": "update-active-template-version-by-template-id",
+                "summary": "Get previous template version by organization, template, and name",
+                "operationId": "get-previous-template-version-by-organization-template-and-name",
                 "parameters": [
                     {
-                        "description": "Modified template version",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Organization ID",
+                        "name": "organization",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Template name",
+                        "name": "templatename",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template version name",
+                        "name": "templateversionname",
                         "in": "path",
                         "required": true
                     }
@@ -5950,13 +5940,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.TemplateVersion"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/versions/archive": {
+        "/organizations/{organization}/templateversions": {
             "post": {
                 "security": [
                     {
@@ -5972,38 +5962,38 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Archive template unused versions by template id",
-                "operationId": "archive-template-unused-versions-by-template-id",
+                "summary": "Create template version by organization",
+                "operationId": "create-template-version-by-organization",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Archive request",
+                        "description": "Create template version request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ArchiveTemplateVersionsRequest"
+                            "$ref": "#/definitions/codersdk.CreateTemplateVersionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.TemplateVersion"
                         }
                     }
                 }
             }
         },
-        "/templates/{template}/versions/{templateversionname}": {
+        "/prebuilds/settings": {
             "get": {
                 "security": [
                     {
@@ -6014,122 +6004,96 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
-                ],
-                "summary": "Get template version by template ID and name",
-                "operationId": "get-template-version-by-template-id-and-name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template ID",
-                        "name": "template",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Template version name",
-                        "name": "templateversionname",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Prebuilds"
                 ],
+                "summary": "Get prebuilds settings",
+                "operationId": "get-prebuilds-settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersion"
-                            }
+                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
                         }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Prebuilds"
                 ],
-                "summary": "Get template version by ID",
-                "operationId": "get-template-version-by-id",
+                "summary": "Update prebuilds settings",
+                "operationId": "update-prebuilds-settings",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
+                        "description": "Prebuilds settings request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateVersion"
+                            "$ref": "#/definitions/codersdk.PrebuildsSettings"
                         }
+                    },
+                    "304": {
+                        "description": "Not Modified"
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/provisionerkeys/{provisionerkey}": {
+            "get": {
                 "security": [
                     {
-                        "CoderSessionToken": []
+                        "CoderProvisionerKey": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Patch template version by ID",
-                "operationId": "patch-template-version-by-id",
+                "summary": "Fetch provisioner key details",
+                "operationId": "fetch-provisioner-key-details",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Provisioner Key",
+                        "name": "provisionerkey",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Patch template version request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PatchTemplateVersionRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TemplateVersion"
+                            "$ref": "#/definitions/codersdk.ProvisionerKey"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/archive": {
-            "post": {
+        "/regions": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -6139,32 +6103,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
-                ],
-                "summary": "Archive template version",
-                "operationId": "archive-template-version",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    }
+                    "WorkspaceProxies"
                 ],
+                "summary": "Get site-wide regions for workspace connections",
+                "operationId": "get-site-wide-regions-for-workspace-connections",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.RegionsResponse-codersdk_Region"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/cancel": {
-            "patch": {
+        "/replicas": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -6174,228 +6128,213 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
-                ],
-                "summary": "Cancel template version by ID",
-                "operationId": "cancel-template-version-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Enterprise"
                 ],
+                "summary": "Get active replicas",
+                "operationId": "get-active-replicas",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Replica"
+                            }
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/dry-run": {
-            "post": {
+        "/scim/v2/ServiceProviderConfig": {
+            "get": {
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "SCIM 2.0: Service Provider Config",
+                "operationId": "scim-get-service-provider-config",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/scim/v2/Users": {
+            "get": {
                 "security": [
                     {
-                        "CoderSessionToken": []
+                        "Authorization": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "SCIM 2.0: Get users",
+                "operationId": "scim-get-users",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Create template version dry-run",
-                "operationId": "create-template-version-dry-run",
+                "summary": "SCIM 2.0: Create new user",
+                "operationId": "scim-create-new-user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Dry-run request",
+                        "description": "New user",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTemplateVersionDryRunRequest"
+                            "$ref": "#/definitions/coderd.SCIMUser"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ProvisionerJob"
+                            "$ref": "#/definitions/coderd.SCIMUser"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/dry-run/{jobID}": {
+        "/scim/v2/Users/{id}": {
             "get": {
                 "security": [
                     {
-                        "CoderSessionToken": []
+                        "Authorization": []
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/scim+json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template version dry-run by job ID",
-                "operationId": "get-template-version-dry-run-by-job-id",
+                "summary": "SCIM 2.0: Get user by ID",
+                "operationId": "scim-get-user-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Job ID",
-                        "name": "jobID",
+                        "description": "User ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ProvisionerJob"
-                        }
+                    "404": {
+                        "description": "Not Found"
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/dry-run/{jobID}/cancel": {
-            "patch": {
+            },
+            "put": {
                 "security": [
                     {
-                        "CoderSessionToken": []
+                        "Authorization": []
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/scim+json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Cancel template version dry-run by job ID",
-                "operationId": "cancel-template-version-dry-run-by-job-id",
+                "summary": "SCIM 2.0: Replace user account",
+                "operationId": "scim-replace-user-status",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Job ID",
-                        "name": "jobID",
+                        "description": "User ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
+                        "description": "Replace user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/coderd.SCIMUser"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.User"
                         }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/dry-run/{jobID}/logs": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
-                        "CoderSessionToken": []
+                        "Authorization": []
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/scim+json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template version dry-run logs by job ID",
-                "operationId": "get-template-version-dry-run-logs-by-job-id",
+                "summary": "SCIM 2.0: Update user account",
+                "operationId": "scim-update-user-status",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Job ID",
-                        "name": "jobID",
+                        "description": "User ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Before Unix timestamp",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After Unix timestamp",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
-                        "in": "query"
+                        "description": "Update user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/coderd.SCIMUser"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
-                            }
+                            "$ref": "#/definitions/codersdk.User"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/dry-run/{jobID}/matched-provisioners": {
+        "/settings/idpsync/available-fields": {
             "get": {
                 "security": [
                     {
@@ -6406,24 +6345,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template version dry-run matched provisioners",
-                "operationId": "get-template-version-dry-run-matched-provisioners",
+                "summary": "Get the available idp sync claim fields",
+                "operationId": "get-the-available-idp-sync-claim-fields",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Job ID",
-                        "name": "jobID",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     }
@@ -6432,13 +6363,16 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.MatchedProvisioners"
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/dry-run/{jobID}/resources": {
+        "/settings/idpsync/field-values": {
             "get": {
                 "security": [
                     {
@@ -6449,25 +6383,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template version dry-run resources by job ID",
-                "operationId": "get-template-version-dry-run-resources-by-job-id",
+                "summary": "Get the idp sync claim field values",
+                "operationId": "get-the-idp-sync-claim-field-values",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Organization ID",
+                        "name": "organization",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Job ID",
-                        "name": "jobID",
-                        "in": "path",
+                        "format": "string",
+                        "description": "Claim Field",
+                        "name": "claimField",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -6477,44 +6411,38 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/dynamic-parameters": {
+        "/settings/idpsync/organization": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "tags": [
-                    "Templates"
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Open dynamic parameters WebSocket by template version",
-                "operationId": "open-dynamic-parameters-websocket-by-template-version",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "Enterprise"
                 ],
+                "summary": "Get organization IdP Sync settings",
+                "operationId": "get-organization-idp-sync-settings",
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
+                        }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/dynamic-parameters/evaluate": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -6527,26 +6455,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Evaluate dynamic parameters for template version",
-                "operationId": "evaluate-dynamic-parameters-for-template-version",
+                "summary": "Update organization IdP Sync settings",
+                "operationId": "update-organization-idp-sync-settings",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Initial parameter values",
+                        "description": "New settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DynamicParametersRequest"
+                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
                         }
                     }
                 ],
@@ -6554,136 +6474,167 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DynamicParametersResponse"
+                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/external-auth": {
-            "get": {
+        "/settings/idpsync/organization/config": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get external auth by template version",
-                "operationId": "get-external-auth-by-template-version",
+                "summary": "Update organization IdP Sync config",
+                "operationId": "update-organization-idp-sync-config",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
+                        "description": "New config values",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PatchOrganizationIDPSyncConfigRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersionExternalAuth"
-                            }
+                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/logs": {
-            "get": {
+        "/settings/idpsync/organization/mapping": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get logs by template version",
-                "operationId": "get-logs-by-template-version",
+                "summary": "Update organization IdP Sync mapping",
+                "operationId": "update-organization-idp-sync-mapping",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Before log id",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After log id",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
-                        "in": "query"
+                        "description": "Description of the mappings to add and remove",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PatchOrganizationIDPSyncMappingRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
-                            }
+                            "$ref": "#/definitions/codersdk.OrganizationSyncSettings"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/parameters": {
+        "/tailnet": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Agents"
+                ],
+                "summary": "User-scoped tailnet RPC connection",
+                "operationId": "user-scoped-tailnet-rpc-connection",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    }
+                }
+            }
+        },
+        "/templates": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Returns a list of templates.\nBy default, only non-deprecated templates are returned.\nTo include deprecated templates, specify ` + "`" + `deprecated:true` + "`" + ` in the search query.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Removed: Get parameters by template version",
-                "operationId": "removed-get-parameters-by-template-version",
-                "parameters": [
+                "summary": "Get all templates",
+                "operationId": "get-all-templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Template"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/examples": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
-                        "in": "path",
-                        "required": true
+                        "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Templates"
+                ],
+                "summary": "Get template examples",
+                "operationId": "get-template-examples",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateExample"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/presets": {
+        "/templates/{template}": {
             "get": {
                 "security": [
                     {
@@ -6696,14 +6647,14 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Get template version presets",
-                "operationId": "get-template-version-presets",
+                "summary": "Get template settings by ID",
+                "operationId": "get-template-settings-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
@@ -6712,17 +6663,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Preset"
-                            }
+                            "$ref": "#/definitions/codersdk.Template"
                         }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/resources": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -6734,14 +6680,14 @@ const docTemplate = `{
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Get resources by template version",
-                "operationId": "get-resources-by-template-version",
+                "summary": "Delete template by ID",
+                "operationId": "delete-template-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
@@ -6750,105 +6696,125 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceResource"
-                            }
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/rich-parameters": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Templates"
                 ],
-                "summary": "Get rich parameters by template version",
-                "operationId": "get-rich-parameters-by-template-version",
+                "summary": "Update template settings by ID",
+                "operationId": "update-template-settings-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Patch template settings request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateTemplateMeta"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersionParameter"
-                            }
+                            "$ref": "#/definitions/codersdk.Template"
                         }
                     }
                 }
             }
         },
-        "/templateversions/{templateversion}/schema": {
+        "/templates/{template}/acl": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Removed: Get schema by template version",
-                "operationId": "removed-get-schema-by-template-version",
+                "summary": "Get template ACLs",
+                "operationId": "get-template-acls",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.TemplateACL"
+                        }
                     }
                 }
-            }
-        },
-        "/templateversions/{templateversion}/unarchive": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Unarchive template version",
-                "operationId": "unarchive-template-version",
+                "summary": "Update template ACL",
+                "operationId": "update-template-acl",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update template ACL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateTemplateACL"
+                        }
                     }
                 ],
                 "responses": {
@@ -6861,7 +6827,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/templateversions/{templateversion}/variables": {
+        "/templates/{template}/acl/available": {
             "get": {
                 "security": [
                     {
@@ -6872,16 +6838,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Templates"
+                    "Enterprise"
                 ],
-                "summary": "Get template variables by template version",
-                "operationId": "get-template-variables-by-template-version",
+                "summary": "Get template available acl users/groups",
+                "operationId": "get-template-available-acl-usersgroups",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Template version ID",
-                        "name": "templateversion",
+                        "description": "Template ID",
+                        "name": "template",
                         "in": "path",
                         "required": true
                     }
@@ -6892,34 +6858,49 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.TemplateVersionVariable"
+                                "$ref": "#/definitions/codersdk.ACLAvailable"
                             }
                         }
                     }
                 }
             }
         },
-        "/updatecheck": {
+        "/templates/{template}/daus": {
             "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Templates"
+                ],
+                "summary": "Get template DAUs by ID",
+                "operationId": "get-template-daus-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Update check",
-                "operationId": "update-check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateCheckResponse"
+                            "$ref": "#/definitions/codersdk.DAUsResponse"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/templates/{template}/versions": {
             "get": {
                 "security": [
                     {
@@ -6930,16 +6911,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get users",
-                "operationId": "get-users",
+                "summary": "List template versions by template ID",
+                "operationId": "list-template-versions-by-template-id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query"
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -6948,6 +6931,12 @@ const docTemplate = `{
                         "name": "after_id",
                         "in": "query"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived versions in the list",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Page limit",
@@ -6965,12 +6954,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GetUsersResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateVersion"
+                            }
                         }
                     }
                 }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -6983,57 +6975,87 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Create new user",
-                "operationId": "create-new-user",
+                "summary": "Update active template version by template ID",
+                "operationId": "update-active-template-version-by-template-id",
                 "parameters": [
                     {
-                        "description": "Create user request",
+                        "description": "Modified template version",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateUserRequestWithOrgs"
+                            "$ref": "#/definitions/codersdk.UpdateActiveTemplateVersion"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/authmethods": {
-            "get": {
+        "/templates/{template}/versions/archive": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
+                ],
+                "summary": "Archive template unused versions by template id",
+                "operationId": "archive-template-unused-versions-by-template-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Archive request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ArchiveTemplateVersionsRequest"
+                        }
+                    }
                 ],
-                "summary": "Get authentication methods",
-                "operationId": "get-authentication-methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.AuthMethods"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/first": {
+        "/templates/{template}/versions/{templateversionname}": {
             "get": {
                 "security": [
                     {
@@ -7044,59 +7066,80 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
+                ],
+                "summary": "Get template version by template ID and name",
+                "operationId": "get-template-version-by-template-id-and-name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template ID",
+                        "name": "template",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template version name",
+                        "name": "templateversionname",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Check initial user created",
-                "operationId": "check-initial-user-created",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateVersion"
+                            }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/templateversions/{templateversion}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Create initial user",
-                "operationId": "create-initial-user",
+                "summary": "Get template version by ID",
+                "operationId": "get-template-version-by-id",
                 "parameters": [
                     {
-                        "description": "First user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateFirstUserRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateFirstUserResponse"
+                            "$ref": "#/definitions/codersdk.TemplateVersion"
                         }
                     }
                 }
-            }
-        },
-        "/users/login": {
-            "post": {
+            },
+            "patch": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -7104,32 +7147,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authorization"
+                    "Templates"
                 ],
-                "summary": "Log in user",
-                "operationId": "log-in-user",
+                "summary": "Patch template version by ID",
+                "operationId": "patch-template-version-by-id",
                 "parameters": [
                     {
-                        "description": "Login request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Patch template version request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.LoginWithPasswordRequest"
+                            "$ref": "#/definitions/codersdk.PatchTemplateVersionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.LoginWithPasswordResponse"
+                            "$ref": "#/definitions/codersdk.TemplateVersion"
                         }
                     }
                 }
             }
         },
-        "/users/logout": {
+        "/templateversions/{templateversion}/archive": {
             "post": {
                 "security": [
                     {
@@ -7140,10 +7191,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
+                ],
+                "summary": "Archive template version",
+                "operationId": "archive-template-version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Log out user",
-                "operationId": "log-out-user",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7154,27 +7215,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/oauth2/github/callback": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "OAuth 2.0 GitHub Callback",
-                "operationId": "oauth-20-github-callback",
-                "responses": {
-                    "307": {
-                        "description": "Temporary Redirect"
-                    }
-                }
-            }
-        },
-        "/users/oauth2/github/device": {
-            "get": {
+        "/templateversions/{templateversion}/cancel": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7184,97 +7226,122 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
+                ],
+                "summary": "Cancel template version by ID",
+                "operationId": "cancel-template-version-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Github device auth.",
-                "operationId": "get-github-device-auth",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAuthDevice"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/oidc/callback": {
-            "get": {
+        "/templateversions/{templateversion}/dry-run": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "OpenID Connect Callback",
-                "operationId": "openid-connect-callback",
-                "responses": {
-                    "307": {
-                        "description": "Temporary Redirect"
-                    }
-                }
-            }
-        },
-        "/users/otp/change-password": {
-            "post": {
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Authorization"
+                    "Templates"
                 ],
-                "summary": "Change password with a one-time passcode",
-                "operationId": "change-password-with-a-one-time-passcode",
+                "summary": "Create template version dry-run",
+                "operationId": "create-template-version-dry-run",
                 "parameters": [
                     {
-                        "description": "Change password request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dry-run request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ChangePasswordWithOneTimePasscodeRequest"
+                            "$ref": "#/definitions/codersdk.CreateTemplateVersionDryRunRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ProvisionerJob"
+                        }
                     }
                 }
             }
         },
-        "/users/otp/request": {
-            "post": {
-                "consumes": [
+        "/templateversions/{templateversion}/dry-run/{jobID}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authorization"
+                    "Templates"
                 ],
-                "summary": "Request one-time passcode",
-                "operationId": "request-one-time-passcode",
+                "summary": "Get template version dry-run by job ID",
+                "operationId": "get-template-version-dry-run-by-job-id",
                 "parameters": [
                     {
-                        "description": "One-time passcode request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.RequestOneTimePasscodeRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ProvisionerJob"
+                        }
                     }
                 }
             }
         },
-        "/users/roles": {
-            "get": {
+        "/templateversions/{templateversion}/dry-run/{jobID}/cancel": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7284,63 +7351,103 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Members"
+                    "Templates"
+                ],
+                "summary": "Cancel template version dry-run by job ID",
+                "operationId": "cancel-template-version-dry-run-by-job-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get site member roles",
-                "operationId": "get-site-member-roles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.AssignableRoles"
-                            }
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/validate-password": {
-            "post": {
+        "/templateversions/{templateversion}/dry-run/{jobID}/logs": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authorization"
+                    "Templates"
                 ],
-                "summary": "Validate user password",
-                "operationId": "validate-user-password",
+                "summary": "Get template version dry-run logs by job ID",
+                "operationId": "get-template-version-dry-run-logs-by-job-id",
                 "parameters": [
                     {
-                        "description": "Validate user password request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ValidateUserPasswordRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Before Unix timestamp",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After Unix timestamp",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ValidateUserPasswordResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/{user}": {
+        "/templateversions/{templateversion}/dry-run/{jobID}/matched-provisioners": {
             "get": {
                 "security": [
                     {
@@ -7351,15 +7458,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get user by name",
-                "operationId": "get-user-by-name",
+                "summary": "Get template version dry-run matched provisioners",
+                "operationId": "get-template-version-dry-run-matched-provisioners",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, username, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
                         "in": "path",
                         "required": true
                     }
@@ -7368,72 +7484,89 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "$ref": "#/definitions/codersdk.MatchedProvisioners"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/templateversions/{templateversion}/dry-run/{jobID}/resources": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Delete user",
-                "operationId": "delete-user",
+                "summary": "Get template version dry-run resources by job ID",
+                "operationId": "get-template-version-dry-run-resources-by-job-id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Job ID",
+                        "name": "jobID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/users/{user}/appearance": {
+        "/templateversions/{templateversion}/dynamic-parameters": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get user appearance settings",
-                "operationId": "get-user-appearance-settings",
+                "summary": "Open dynamic parameters WebSocket by template version",
+                "operationId": "open-dynamic-parameters-websocket-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UserAppearanceSettings"
-                        }
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/templateversions/{templateversion}/dynamic-parameters/evaluate": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7446,25 +7579,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Update user appearance settings",
-                "operationId": "update-user-appearance-settings",
+                "summary": "Evaluate dynamic parameters for template version",
+                "operationId": "evaluate-dynamic-parameters-for-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New appearance settings",
+                        "description": "Initial parameter values",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateUserAppearanceSettingsRequest"
+                            "$ref": "#/definitions/codersdk.DynamicParametersRequest"
                         }
                     }
                 ],
@@ -7472,13 +7606,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UserAppearanceSettings"
+                            "$ref": "#/definitions/codersdk.DynamicParametersResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/autofill-parameters": {
+        "/templateversions/{templateversion}/external-auth": {
             "get": {
                 "security": [
                     {
@@ -7489,24 +7623,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get autofill build parameters for user",
-                "operationId": "get-autofill-build-parameters-for-user",
+                "summary": "Get external auth by template version",
+                "operationId": "get-external-auth-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, username, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Template ID",
-                        "name": "template_id",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -7515,93 +7643,100 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.UserParameter"
+                                "$ref": "#/definitions/codersdk.TemplateVersionExternalAuth"
                             }
                         }
                     }
                 }
             }
         },
-        "/users/{user}/convert-login": {
-            "post": {
+        "/templateversions/{templateversion}/logs": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authorization"
+                    "Templates"
                 ],
-                "summary": "Convert user from password to oauth authentication",
-                "operationId": "convert-user-from-password-to-oauth-authentication",
+                "summary": "Get logs by template version",
+                "operationId": "get-logs-by-template-version",
                 "parameters": [
-                    {
-                        "description": "Convert request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ConvertLoginRequest"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Before log id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After log id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.OAuthConversionResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/{user}/gitsshkey": {
+        "/templateversions/{templateversion}/parameters": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get user Git SSH key",
-                "operationId": "get-user-git-ssh-key",
+                "summary": "Removed: Get parameters by template version",
+                "operationId": "removed-get-parameters-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.GitSSHKey"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/templateversions/{templateversion}/presets": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7611,15 +7746,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Regenerate user SSH key",
-                "operationId": "regenerate-user-ssh-key",
+                "summary": "Get template version presets",
+                "operationId": "get-template-version-presets",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
@@ -7628,14 +7764,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GitSSHKey"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Preset"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/{user}/keys": {
-            "post": {
+        "/templateversions/{templateversion}/resources": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7645,30 +7784,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Create new session key",
-                "operationId": "create-new-session-key",
+                "summary": "Get resources by template version",
+                "operationId": "get-resources-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.GenerateAPIKeyResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/{user}/keys/tokens": {
+        "/templateversions/{templateversion}/rich-parameters": {
             "get": {
                 "security": [
                     {
@@ -7679,15 +7822,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get user tokens",
-                "operationId": "get-user-tokens",
+                "summary": "Get rich parameters by template version",
+                "operationId": "get-rich-parameters-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
@@ -7698,59 +7842,44 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.APIKey"
+                                "$ref": "#/definitions/codersdk.TemplateVersionParameter"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/templateversions/{templateversion}/schema": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Create token API key",
-                "operationId": "create-token-api-key",
+                "summary": "Removed: Get schema by template version",
+                "operationId": "removed-get-schema-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create token request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateTokenRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.GenerateAPIKeyResponse"
-                        }
+                    "200": {
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/users/{user}/keys/tokens/tokenconfig": {
-            "get": {
+        "/templateversions/{templateversion}/unarchive": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7760,15 +7889,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "General"
+                    "Templates"
                 ],
-                "summary": "Get token config",
-                "operationId": "get-token-config",
+                "summary": "Unarchive template version",
+                "operationId": "unarchive-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
@@ -7777,13 +7907,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.TokenConfig"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/keys/tokens/{keyname}": {
+        "/templateversions/{templateversion}/variables": {
             "get": {
                 "security": [
                     {
@@ -7794,23 +7924,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Templates"
                 ],
-                "summary": "Get API key by token name",
-                "operationId": "get-api-key-by-token-name",
+                "summary": "Get template variables by template version",
+                "operationId": "get-template-variables-by-template-version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Key Name",
-                        "name": "keyname",
+                        "format": "uuid",
+                        "description": "Template version ID",
+                        "name": "templateversion",
                         "in": "path",
                         "required": true
                     }
@@ -7819,13 +7942,36 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.APIKey"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.TemplateVersionVariable"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/{user}/keys/{keyid}": {
+        "/updatecheck": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "General"
+                ],
+                "summary": "Update check",
+                "operationId": "update-check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateCheckResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
             "get": {
                 "security": [
                     {
@@ -7838,70 +7984,83 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get API key by ID",
-                "operationId": "get-api-key-by-id",
+                "summary": "Get users",
+                "operationId": "get-users",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
                         "type": "string",
-                        "format": "string",
-                        "description": "Key ID",
-                        "name": "keyid",
-                        "in": "path",
-                        "required": true
+                        "format": "uuid",
+                        "description": "After ID",
+                        "name": "after_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.APIKey"
+                            "$ref": "#/definitions/codersdk.GetUsersResponse"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Users"
                 ],
-                "summary": "Delete API key",
-                "operationId": "delete-api-key",
+                "summary": "Create new user",
+                "operationId": "create-new-user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "description": "Key ID",
-                        "name": "keyid",
-                        "in": "path",
-                        "required": true
+                        "description": "Create user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateUserRequestWithOrgs"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.User"
+                        }
                     }
                 }
             }
         },
-        "/users/{user}/login-type": {
+        "/users/authmethods": {
             "get": {
                 "security": [
                     {
@@ -7914,28 +8073,19 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get user login type",
-                "operationId": "get-user-login-type",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get authentication methods",
+                "operationId": "get-authentication-methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UserLoginType"
+                            "$ref": "#/definitions/codersdk.AuthMethods"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/notifications/preferences": {
+        "/users/first": {
             "get": {
                 "security": [
                     {
@@ -7946,32 +8096,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
-                ],
-                "summary": "Get user notification preferences",
-                "operationId": "get-user-notification-preferences",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Users"
                 ],
+                "summary": "Check initial user created",
+                "operationId": "check-initial-user-created",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.NotificationPreference"
-                            }
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -7984,80 +8122,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Users"
                 ],
-                "summary": "Update user notification preferences",
-                "operationId": "update-user-notification-preferences",
+                "summary": "Create initial user",
+                "operationId": "create-initial-user",
                 "parameters": [
                     {
-                        "description": "Preferences",
+                        "description": "First user request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateUserNotificationPreferences"
+                            "$ref": "#/definitions/codersdk.CreateFirstUserRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.NotificationPreference"
-                            }
+                            "$ref": "#/definitions/codersdk.CreateFirstUserResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/organizations": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
+        "/users/login": {
+            "post": {
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Authorization"
                 ],
-                "summary": "Get organizations by user",
-                "operationId": "get-organizations-by-user",
+                "summary": "Log in user",
+                "operationId": "log-in-user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
+                        "description": "Login request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.LoginWithPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.Organization"
-                            }
+                            "$ref": "#/definitions/codersdk.LoginWithPasswordResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/organizations/{organizationname}": {
-            "get": {
+        "/users/logout": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -8069,121 +8194,138 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get organization by user and organization name",
-                "operationId": "get-organization-by-user-and-organization-name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Organization name",
-                        "name": "organizationname",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Log out user",
+                "operationId": "log-out-user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Organization"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/password": {
-            "put": {
+        "/users/oauth2/github/callback": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
                     "Users"
                 ],
-                "summary": "Update user password",
-                "operationId": "update-user-password",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update password request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateUserPasswordRequest"
-                        }
-                    }
-                ],
+                "summary": "OAuth 2.0 GitHub Callback",
+                "operationId": "oauth-20-github-callback",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "307": {
+                        "description": "Temporary Redirect"
                     }
                 }
             }
         },
-        "/users/{user}/profile": {
-            "put": {
+        "/users/oauth2/github/device": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Users"
                 ],
-                "summary": "Update user profile",
-                "operationId": "update-user-profile",
-                "parameters": [
+                "summary": "Get Github device auth.",
+                "operationId": "get-github-device-auth",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ExternalAuthDevice"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/oidc/callback": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "OpenID Connect Callback",
+                "operationId": "openid-connect-callback",
+                "responses": {
+                    "307": {
+                        "description": "Temporary Redirect"
+                    }
+                }
+            }
+        },
+        "/users/otp/change-password": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authorization"
+                ],
+                "summary": "Change password with a one-time passcode",
+                "operationId": "change-password-with-a-one-time-passcode",
+                "parameters": [
                     {
-                        "description": "Updated profile",
+                        "description": "Change password request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateUserProfileRequest"
+                            "$ref": "#/definitions/codersdk.ChangePasswordWithOneTimePasscodeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/users/otp/request": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authorization"
+                ],
+                "summary": "Request one-time passcode",
+                "operationId": "request-one-time-passcode",
+                "parameters": [
+                    {
+                        "description": "One-time passcode request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "$ref": "#/definitions/codersdk.RequestOneTimePasscodeRequest"
                         }
                     }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
                 }
             }
         },
-        "/users/{user}/quiet-hours": {
+        "/users/roles": {
             "get": {
                 "security": [
                     {
@@ -8194,33 +8336,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Get user quiet hours schedule",
-                "operationId": "get-user-quiet-hours-schedule",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "User ID",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Members"
                 ],
+                "summary": "Get site member roles",
+                "operationId": "get-site-member-roles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.UserQuietHoursScheduleResponse"
+                                "$ref": "#/definitions/codersdk.AssignableRoles"
                             }
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/users/validate-password": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -8233,26 +8367,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Authorization"
                 ],
-                "summary": "Update user quiet hours schedule",
-                "operationId": "update-user-quiet-hours-schedule",
+                "summary": "Validate user password",
+                "operationId": "validate-user-password",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "User ID",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update schedule request",
+                        "description": "Validate user password request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateUserQuietHoursScheduleRequest"
+                            "$ref": "#/definitions/codersdk.ValidateUserPasswordRequest"
                         }
                     }
                 ],
@@ -8260,16 +8386,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.UserQuietHoursScheduleResponse"
-                            }
+                            "$ref": "#/definitions/codersdk.ValidateUserPasswordResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/roles": {
+        "/users/{user}": {
             "get": {
                 "security": [
                     {
@@ -8282,12 +8405,12 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get user roles",
-                "operationId": "get-user-roles",
+                "summary": "Get user by name",
+                "operationId": "get-user-by-name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
+                        "description": "User ID, username, or me",
                         "name": "user",
                         "in": "path",
                         "required": true
@@ -8302,23 +8425,17 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "Users"
                 ],
-                "summary": "Assign role to user",
-                "operationId": "assign-role-to-user",
+                "summary": "Delete user",
+                "operationId": "delete-user",
                 "parameters": [
                     {
                         "type": "string",
@@ -8326,29 +8443,17 @@ const docTemplate = `{
                         "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update roles request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateRoles"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.User"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/users/{user}/status/activate": {
-            "put": {
+        "/users/{user}/appearance": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -8360,8 +8465,8 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Activate user account",
-                "operationId": "activate-user-account",
+                "summary": "Get user appearance settings",
+                "operationId": "get-user-appearance-settings",
                 "parameters": [
                     {
                         "type": "string",
@@ -8375,27 +8480,28 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "$ref": "#/definitions/codersdk.UserAppearanceSettings"
                         }
                     }
                 }
-            }
-        },
-        "/users/{user}/status/suspend": {
+            },
             "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Users"
                 ],
-                "summary": "Suspend user account",
-                "operationId": "suspend-user-account",
+                "summary": "Update user appearance settings",
+                "operationId": "update-user-appearance-settings",
                 "parameters": [
                     {
                         "type": "string",
@@ -8403,61 +8509,73 @@ const docTemplate = `{
                         "name": "user",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New appearance settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateUserAppearanceSettingsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.User"
+                            "$ref": "#/definitions/codersdk.UserAppearanceSettings"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/webpush/subscription": {
-            "post": {
+        "/users/{user}/autofill-parameters": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Users"
                 ],
-                "summary": "Create user webpush subscription",
-                "operationId": "create-user-webpush-subscription",
+                "summary": "Get autofill build parameters for user",
+                "operationId": "get-autofill-build-parameters-for-user",
                 "parameters": [
-                    {
-                        "description": "Webpush subscription",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.WebpushSubscription"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "User ID, name, or me",
+                        "description": "User ID, username, or me",
                         "name": "user",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.UserParameter"
+                            }
+                        }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
-            },
-            "delete": {
+            }
+        },
+        "/users/{user}/convert-login": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -8466,19 +8584,22 @@ const docTemplate = `{
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifications"
+                    "Authorization"
                 ],
-                "summary": "Delete user webpush subscription",
-                "operationId": "delete-user-webpush-subscription",
+                "summary": "Convert user from password to oauth authentication",
+                "operationId": "convert-user-from-password-to-oauth-authentication",
                 "parameters": [
                     {
-                        "description": "Webpush subscription",
+                        "description": "Convert request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.DeleteWebpushSubscription"
+                            "$ref": "#/definitions/codersdk.ConvertLoginRequest"
                         }
                     },
                     {
@@ -8490,47 +8611,16 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.OAuthConversionResponse"
+                        }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/users/{user}/webpush/test": {
-            "post": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Notifications"
-                ],
-                "summary": "Send a test push notification",
-                "operationId": "send-a-test-push-notification",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID, name, or me",
-                        "name": "user",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                },
-                "x-apidocgen": {
-                    "skip": true
-                }
-            }
-        },
-        "/users/{user}/workspace/{workspacename}": {
+        "/users/{user}/gitsshkey": {
             "get": {
                 "security": [
                     {
@@ -8541,10 +8631,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Users"
                 ],
-                "summary": "Get workspace metadata by user and workspace name",
-                "operationId": "get-workspace-metadata-by-user-and-workspace-name",
+                "summary": "Get user Git SSH key",
+                "operationId": "get-user-git-ssh-key",
                 "parameters": [
                     {
                         "type": "string",
@@ -8552,33 +8642,18 @@ const docTemplate = `{
                         "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Workspace name",
-                        "name": "workspacename",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Return data instead of HTTP 404 if the workspace is deleted",
-                        "name": "include_deleted",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Workspace"
+                            "$ref": "#/definitions/codersdk.GitSSHKey"
                         }
                     }
                 }
-            }
-        },
-        "/users/{user}/workspace/{workspacename}/builds/{buildnumber}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -8588,10 +8663,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Users"
                 ],
-                "summary": "Get workspace build by user, workspace name, and build number",
-                "operationId": "get-workspace-build-by-user-workspace-name-and-build-number",
+                "summary": "Regenerate user SSH key",
+                "operationId": "regenerate-user-ssh-key",
                 "parameters": [
                     {
                         "type": "string",
@@ -8599,81 +8674,53 @@ const docTemplate = `{
                         "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Workspace name",
-                        "name": "workspacename",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "number",
-                        "description": "Build number",
-                        "name": "buildnumber",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                            "$ref": "#/definitions/codersdk.GitSSHKey"
                         }
                     }
                 }
             }
         },
-        "/users/{user}/workspaces": {
+        "/users/{user}/keys": {
             "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "description": "Create a new workspace using a template. The request must\nspecify either the Template ID or the Template Version ID,\nnot both. If the Template ID is specified, the active version\nof the template will be used.",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Users"
                 ],
-                "summary": "Create user workspace",
-                "operationId": "create-user-workspace",
+                "summary": "Create new session key",
+                "operationId": "create-new-session-key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username, UUID, or me",
+                        "description": "User ID, name, or me",
                         "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create workspace request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateWorkspaceRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Workspace"
+                            "$ref": "#/definitions/codersdk.GenerateAPIKeyResponse"
                         }
                     }
                 }
             }
         },
-        "/workspace-quota/{user}": {
+        "/users/{user}/keys/tokens": {
             "get": {
                 "security": [
                     {
@@ -8684,11 +8731,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Users"
                 ],
-                "summary": "Get workspace quota by user deprecated",
-                "operationId": "get-workspace-quota-by-user-deprecated",
-                "deprecated": true,
+                "summary": "Get user tokens",
+                "operationId": "get-user-tokens",
                 "parameters": [
                     {
                         "type": "string",
@@ -8702,13 +8748,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.APIKey"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/aws-instance-identity": {
+            },
             "post": {
                 "security": [
                     {
@@ -8722,71 +8769,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Authenticate agent on AWS instance",
-                "operationId": "authenticate-agent-on-aws-instance",
+                "summary": "Create token API key",
+                "operationId": "create-token-api-key",
                 "parameters": [
                     {
-                        "description": "Instance identity token",
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create token request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.AWSInstanceIdentityToken"
+                            "$ref": "#/definitions/codersdk.CreateTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
+                            "$ref": "#/definitions/codersdk.GenerateAPIKeyResponse"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/azure-instance-identity": {
-            "post": {
+        "/users/{user}/keys/tokens/tokenconfig": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "General"
                 ],
-                "summary": "Authenticate agent on Azure instance",
-                "operationId": "authenticate-agent-on-azure-instance",
+                "summary": "Get token config",
+                "operationId": "get-token-config",
                 "parameters": [
                     {
-                        "description": "Instance identity token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/agentsdk.AzureInstanceIdentityToken"
-                        }
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
+                            "$ref": "#/definitions/codersdk.TokenConfig"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/connection": {
+        "/users/{user}/keys/tokens/{keyname}": {
             "get": {
                 "security": [
                     {
@@ -8797,102 +8846,114 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
+                ],
+                "summary": "Get API key by token name",
+                "operationId": "get-api-key-by-token-name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "Key Name",
+                        "name": "keyname",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get connection info for workspace agent generic",
-                "operationId": "get-connection-info-for-workspace-agent-generic",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/workspacesdk.AgentConnectionInfo"
+                            "$ref": "#/definitions/codersdk.APIKey"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspaceagents/google-instance-identity": {
-            "post": {
+        "/users/{user}/keys/{keyid}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Authenticate agent on Google Cloud instance",
-                "operationId": "authenticate-agent-on-google-cloud-instance",
+                "summary": "Get API key by ID",
+                "operationId": "get-api-key-by-id",
                 "parameters": [
                     {
-                        "description": "Instance identity token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/agentsdk.GoogleInstanceIdentityToken"
-                        }
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "Key ID",
+                        "name": "keyid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
+                            "$ref": "#/definitions/codersdk.APIKey"
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/me/app-status": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Patch workspace agent app status",
-                "operationId": "patch-workspace-agent-app-status",
+                "summary": "Delete API key",
+                "operationId": "delete-api-key",
                 "parameters": [
                     {
-                        "description": "app status",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/agentsdk.PatchAppStatus"
-                        }
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "Key ID",
+                        "name": "keyid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/workspaceagents/me/external-auth": {
+        "/users/{user}/login-type": {
             "get": {
                 "security": [
                     {
@@ -8903,43 +8964,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Get workspace agent external auth",
-                "operationId": "get-workspace-agent-external-auth",
+                "summary": "Get user login type",
+                "operationId": "get-user-login-type",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Match",
-                        "name": "match",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Provider ID",
-                        "name": "id",
-                        "in": "query",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Wait for a new token to be issued",
-                        "name": "listen",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.ExternalAuthResponse"
+                            "$ref": "#/definitions/codersdk.UserLoginType"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/me/gitauth": {
+        "/users/{user}/notifications/preferences": {
             "get": {
                 "security": [
                     {
@@ -8950,108 +8998,113 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
                 ],
-                "summary": "Removed: Get workspace agent git auth",
-                "operationId": "removed-get-workspace-agent-git-auth",
+                "summary": "Get user notification preferences",
+                "operationId": "get-user-notification-preferences",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Match",
-                        "name": "match",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Provider ID",
-                        "name": "id",
-                        "in": "query",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Wait for a new token to be issued",
-                        "name": "listen",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.ExternalAuthResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationPreference"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/me/gitsshkey": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
+                ],
+                "summary": "Update user notification preferences",
+                "operationId": "update-user-notification-preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateUserNotificationPreferences"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get workspace agent Git SSH key",
-                "operationId": "get-workspace-agent-git-ssh-key",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.GitSSHKey"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationPreference"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/me/log-source": {
-            "post": {
+        "/users/{user}/notifications/quiet-hours": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
                 ],
-                "summary": "Post workspace agent log source",
-                "operationId": "post-workspace-agent-log-source",
+                "summary": "Get user notification quiet hours",
+                "operationId": "get-user-notification-quiet-hours",
                 "parameters": [
                     {
-                        "description": "Log source request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/agentsdk.PostLogSourceRequest"
-                        }
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentLogSource"
+                            "$ref": "#/definitions/codersdk.UserNotificationQuietHours"
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/me/logs": {
-            "patch": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -9064,18 +9117,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
                 ],
-                "summary": "Patch workspace agent logs",
-                "operationId": "patch-workspace-agent-logs",
+                "summary": "Update user notification quiet hours",
+                "operationId": "update-user-notification-quiet-hours",
                 "parameters": [
                     {
-                        "description": "logs",
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quiet hours",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.PatchLogs"
+                            "$ref": "#/definitions/codersdk.UpdateUserNotificationQuietHoursRequest"
                         }
                     }
                 ],
@@ -9083,13 +9143,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.UserNotificationQuietHours"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/me/reinit": {
+        "/users/{user}/notifications/targets": {
             "get": {
                 "security": [
                     {
@@ -9100,113 +9160,113 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
+                ],
+                "summary": "Get user notification targets",
+                "operationId": "get-user-notification-targets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get workspace agent reinitialization",
-                "operationId": "get-workspace-agent-reinitialization",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/agentsdk.ReinitializationEvent"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.NotificationUserTarget"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/me/rpc": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "tags": [
-                    "Agents"
-                ],
-                "summary": "Workspace agent RPC API",
-                "operationId": "workspace-agent-rpc-api",
-                "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
-                    }
-                },
-                "x-apidocgen": {
-                    "skip": true
-                }
-            }
-        },
-        "/workspaceagents/{workspaceagent}": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
                 ],
-                "summary": "Get workspace agent by ID",
-                "operationId": "get-workspace-agent-by-id",
+                "summary": "Create user notification target",
+                "operationId": "create-user-notification-target",
                 "parameters": [
+                    {
+                        "description": "Target",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateNotificationUserTargetRequest"
+                        }
+                    },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgent"
+                            "$ref": "#/definitions/codersdk.NotificationUserTarget"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/connection": {
-            "get": {
+        "/users/{user}/notifications/targets/{target}": {
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Agents"
+                    "Notifications"
                 ],
-                "summary": "Get connection info for workspace agent",
-                "operationId": "get-connection-info-for-workspace-agent",
+                "summary": "Delete user notification target",
+                "operationId": "delete-user-notification-target",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target ID",
+                        "name": "target",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/workspacesdk.AgentConnectionInfo"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/containers": {
+        "/users/{user}/organizations": {
             "get": {
                 "security": [
                     {
@@ -9217,40 +9277,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Get running containers for workspace agent",
-                "operationId": "get-running-containers-for-workspace-agent",
+                "summary": "Get organizations by user",
+                "operationId": "get-organizations-by-user",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "key=value",
-                        "description": "Labels",
-                        "name": "label",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.Organization"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/containers/devcontainers/{devcontainer}/recreate": {
-            "post": {
+        "/users/{user}/organizations/{organizationname}": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -9260,102 +9314,123 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Recreate devcontainer for workspace agent",
-                "operationId": "recreate-devcontainer-for-workspace-agent",
+                "summary": "Get organization by user and organization name",
+                "operationId": "get-organization-by-user-and-organization-name",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Devcontainer ID",
-                        "name": "devcontainer",
+                        "description": "Organization name",
+                        "name": "organizationname",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/codersdk.Organization"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/containers/watch": {
-            "get": {
+        "/users/{user}/password": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Watch workspace agent for container updates.",
-                "operationId": "watch-workspace-agent-for-container-updates",
+                "summary": "Update user password",
+                "operationId": "update-user-password",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update password request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateUserPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/coordinate": {
-            "get": {
+        "/users/{user}/profile": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Coordinate workspace agent",
-                "operationId": "coordinate-workspace-agent",
+                "summary": "Update user profile",
+                "operationId": "update-user-profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated profile",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateUserProfileRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.User"
+                        }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/listening-ports": {
+        "/users/{user}/quiet-hours": {
             "get": {
                 "security": [
                     {
@@ -9366,16 +9441,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Enterprise"
                 ],
-                "summary": "Get listening ports for workspace agent",
-                "operationId": "get-listening-ports-for-workspace-agent",
+                "summary": "Get user quiet hours schedule",
+                "operationId": "get-user-quiet-hours-schedule",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
@@ -9384,59 +9459,48 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentListeningPortsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.UserQuietHoursScheduleResponse"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/workspaceagents/{workspaceagent}/logs": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Enterprise"
                 ],
-                "summary": "Get logs by workspace agent",
-                "operationId": "get-logs-by-workspace-agent",
+                "summary": "Update user quiet hours schedule",
+                "operationId": "update-user-quiet-hours-schedule",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Before log id",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After log id",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Disable compression for WebSocket connection",
-                        "name": "no_compression",
-                        "in": "query"
+                        "description": "Update schedule request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateUserQuietHoursScheduleRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -9445,139 +9509,127 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
+                                "$ref": "#/definitions/codersdk.UserQuietHoursScheduleResponse"
                             }
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/pty": {
+        "/users/{user}/roles": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Open PTY to workspace agent",
-                "operationId": "open-pty-to-workspace-agent",
+                "summary": "Get user roles",
+                "operationId": "get-user-roles",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.User"
+                        }
                     }
                 }
-            }
-        },
-        "/workspaceagents/{workspaceagent}/startup-logs": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Removed: Get logs by workspace agent",
-                "operationId": "removed-get-logs-by-workspace-agent",
+                "summary": "Assign role to user",
+                "operationId": "assign-role-to-user",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Before log id",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After log id",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Disable compression for WebSocket connection",
-                        "name": "no_compression",
-                        "in": "query"
+                        "description": "Update roles request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateRoles"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
-                            }
+                            "$ref": "#/definitions/codersdk.User"
                         }
                     }
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/watch-metadata": {
-            "get": {
+        "/users/{user}/status/activate": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Watch for workspace agent metadata updates",
-                "operationId": "watch-for-workspace-agent-metadata-updates",
-                "deprecated": true,
+                "summary": "Activate user account",
+                "operationId": "activate-user-account",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.User"
+                        }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspaceagents/{workspaceagent}/watch-metadata-ws": {
-            "get": {
+        "/users/{user}/status/suspend": {
+            "put": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -9587,16 +9639,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Agents"
+                    "Users"
                 ],
-                "summary": "Watch for workspace agent metadata updates via WebSockets",
-                "operationId": "watch-for-workspace-agent-metadata-updates-via-websockets",
+                "summary": "Suspend user account",
+                "operationId": "suspend-user-account",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace agent ID",
-                        "name": "workspaceagent",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
@@ -9605,94 +9656,128 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ServerSentEvent"
+                            "$ref": "#/definitions/codersdk.User"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspacebuilds/{workspacebuild}": {
-            "get": {
+        "/users/{user}/webpush/subscription": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Notifications"
                 ],
-                "summary": "Get workspace build",
-                "operationId": "get-workspace-build",
+                "summary": "Create user webpush subscription",
+                "operationId": "create-user-webpush-subscription",
                 "parameters": [
+                    {
+                        "description": "Webpush subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WebpushSubscription"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
-            }
-        },
-        "/workspacebuilds/{workspacebuild}/cancel": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Notifications"
                 ],
-                "summary": "Cancel workspace build",
-                "operationId": "cancel-workspace-build",
+                "summary": "Delete user webpush subscription",
+                "operationId": "delete-user-webpush-subscription",
                 "parameters": [
+                    {
+                        "description": "Webpush subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.DeleteWebpushSubscription"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                },
+                "x-apidocgen": {
+                    "skip": true
+                }
+            }
+        },
+        "/users/{user}/webpush/test": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Send a test push notification",
+                "operationId": "send-a-test-push-notification",
+                "parameters": [
                     {
-                        "enum": [
-                            "running",
-                            "pending"
-                        ],
                         "type": "string",
-                        "description": "Expected status of the job. If expect_status is supplied, the request will be rejected with 412 Precondition Failed if the job doesn't match the state when performing the cancellation.",
-                        "name": "expect_status",
-                        "in": "query"
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/workspacebuilds/{workspacebuild}/logs": {
+        "/users/{user}/workspace/{workspacename}": {
             "get": {
                 "security": [
                     {
@@ -9703,34 +9788,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Workspaces"
                 ],
-                "summary": "Get workspace build logs",
-                "operationId": "get-workspace-build-logs",
+                "summary": "Get workspace metadata by user and workspace name",
+                "operationId": "get-workspace-metadata-by-user-and-workspace-name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Before log id",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "After log id",
-                        "name": "after",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Workspace name",
+                        "name": "workspacename",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "boolean",
-                        "description": "Follow log stream",
-                        "name": "follow",
+                        "description": "Return data instead of HTTP 404 if the workspace is deleted",
+                        "name": "include_deleted",
                         "in": "query"
                     }
                 ],
@@ -9738,16 +9818,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
-                            }
+                            "$ref": "#/definitions/codersdk.Workspace"
                         }
                     }
                 }
             }
         },
-        "/workspacebuilds/{workspacebuild}/parameters": {
+        "/users/{user}/workspace/{workspacename}/builds/{buildnumber}": {
             "get": {
                 "security": [
                     {
@@ -9760,13 +9837,28 @@ const docTemplate = `{
                 "tags": [
                     "Builds"
                 ],
-                "summary": "Get build parameters for workspace build",
-                "operationId": "get-build-parameters-for-workspace-build",
+                "summary": "Get workspace build by user, workspace name, and build number",
+                "operationId": "get-workspace-build-by-user-workspace-name-and-build-number",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace name",
+                        "name": "workspacename",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "number",
+                        "description": "Build number",
+                        "name": "buildnumber",
                         "in": "path",
                         "required": true
                     }
@@ -9775,88 +9867,60 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
-                            }
+                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
                         }
                     }
                 }
             }
         },
-        "/workspacebuilds/{workspacebuild}/resources": {
-            "get": {
+        "/users/{user}/workspaces": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "description": "Create a new workspace using a template. The request must\nspecify either the Template ID or the Template Version ID,\nnot both. If the Template ID is specified, the active version\nof the template will be used.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Workspaces"
                 ],
-                "summary": "Removed: Get workspace resources for workspace build",
-                "operationId": "removed-get-workspace-resources-for-workspace-build",
-                "deprecated": true,
+                "summary": "Create user workspace",
+                "operationId": "create-user-workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "Username, UUID, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    {
+                        "description": "Create workspace request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceResource"
-                            }
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceRequest"
                         }
                     }
-                }
-            }
-        },
-        "/workspacebuilds/{workspacebuild}/state": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Builds"
-                ],
-                "summary": "Get provisioner state for workspace build",
-                "operationId": "get-provisioner-state-for-workspace-build",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                            "$ref": "#/definitions/codersdk.Workspace"
                         }
                     }
                 }
             }
         },
-        "/workspacebuilds/{workspacebuild}/timings": {
+        "/workspace-quota/{user}": {
             "get": {
                 "security": [
                     {
@@ -9867,16 +9931,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Enterprise"
                 ],
-                "summary": "Get workspace build timings by ID",
-                "operationId": "get-workspace-build-timings-by-id",
+                "summary": "Get workspace quota by user deprecated",
+                "operationId": "get-workspace-quota-by-user-deprecated",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace build ID",
-                        "name": "workspacebuild",
+                        "description": "User ID, name, or me",
+                        "name": "user",
                         "in": "path",
                         "required": true
                     }
@@ -9885,39 +9949,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
+                            "$ref": "#/definitions/codersdk.WorkspaceQuota"
                         }
                     }
                 }
             }
         },
-        "/workspaceproxies": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Get workspace proxies",
-                "operationId": "get-workspace-proxies",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.RegionsResponse-codersdk_WorkspaceProxy"
-                            }
-                        }
-                    }
-                }
-            },
+        "/workspaceagents/aws-instance-identity": {
             "post": {
                 "security": [
                     {
@@ -9931,32 +9969,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Create workspace proxy",
-                "operationId": "create-workspace-proxy",
+                "summary": "Authenticate agent on AWS instance",
+                "operationId": "authenticate-agent-on-aws-instance",
                 "parameters": [
                     {
-                        "description": "Create workspace proxy request",
+                        "description": "Instance identity token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.CreateWorkspaceProxyRequest"
+                            "$ref": "#/definitions/agentsdk.AWSInstanceIdentityToken"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
                         }
                     }
                 }
             }
         },
-        "/workspaceproxies/me/app-stats": {
+        "/workspaceagents/azure-instance-identity": {
             "post": {
                 "security": [
                     {
@@ -9966,55 +10004,36 @@ const docTemplate = `{
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Report workspace app stats",
-                "operationId": "report-workspace-app-stats",
+                "summary": "Authenticate agent on Azure instance",
+                "operationId": "authenticate-agent-on-azure-instance",
                 "parameters": [
                     {
-                        "description": "Report app stats request",
+                        "description": "Instance identity token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/wsproxysdk.ReportAppStatsRequest"
+                            "$ref": "#/definitions/agentsdk.AzureInstanceIdentityToken"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                },
-                "x-apidocgen": {
-                    "skip": true
-                }
-            }
-        },
-        "/workspaceproxies/me/coordinate": {
-            "get": {
-                "security": [
-                    {
-                        "CoderSessionToken": []
-                    }
-                ],
-                "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Workspace Proxy Coordinate",
-                "operationId": "workspace-proxy-coordinate",
-                "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
+                        }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspaceproxies/me/crypto-keys": {
+        "/workspaceagents/connection": {
             "get": {
                 "security": [
                     {
@@ -10025,24 +10044,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Get workspace proxy crypto keys",
-                "operationId": "get-workspace-proxy-crypto-keys",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Feature key",
-                        "name": "feature",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Agents"
                 ],
+                "summary": "Get connection info for workspace agent generic",
+                "operationId": "get-connection-info-for-workspace-agent-generic",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/wsproxysdk.CryptoKeysResponse"
+                            "$ref": "#/definitions/workspacesdk.AgentConnectionInfo"
                         }
                     }
                 },
@@ -10051,7 +10061,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspaceproxies/me/deregister": {
+        "/workspaceagents/google-instance-identity": {
             "post": {
                 "security": [
                     {
@@ -10061,34 +10071,37 @@ const docTemplate = `{
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Deregister workspace proxy",
-                "operationId": "deregister-workspace-proxy",
+                "summary": "Authenticate agent on Google Cloud instance",
+                "operationId": "authenticate-agent-on-google-cloud-instance",
                 "parameters": [
                     {
-                        "description": "Deregister workspace proxy request",
+                        "description": "Instance identity token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/wsproxysdk.DeregisterWorkspaceProxyRequest"
+                            "$ref": "#/definitions/agentsdk.GoogleInstanceIdentityToken"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/agentsdk.AuthenticateResponse"
+                        }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspaceproxies/me/issue-signed-app-token": {
-            "post": {
+        "/workspaceagents/me/app-status": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -10101,77 +10114,79 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Issue signed workspace app token",
-                "operationId": "issue-signed-workspace-app-token",
+                "summary": "Patch workspace agent app status",
+                "operationId": "patch-workspace-agent-app-status",
                 "parameters": [
                     {
-                        "description": "Issue signed app token request",
+                        "description": "app status",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/workspaceapps.IssueTokenRequest"
+                            "$ref": "#/definitions/agentsdk.PatchAppStatus"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/wsproxysdk.IssueSignedAppTokenResponse"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
-                },
-                "x-apidocgen": {
-                    "skip": true
                 }
             }
         },
-        "/workspaceproxies/me/register": {
-            "post": {
+        "/workspaceagents/me/external-auth": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Register workspace proxy",
-                "operationId": "register-workspace-proxy",
+                "summary": "Get workspace agent external auth",
+                "operationId": "get-workspace-agent-external-auth",
                 "parameters": [
                     {
-                        "description": "Register workspace proxy request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/wsproxysdk.RegisterWorkspaceProxyRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/wsproxysdk.RegisterWorkspaceProxyResponse"
-                        }
-                    }
-                },
-                "x-apidocgen": {
-                    "skip": true
+                        "type": "string",
+                        "description": "Match",
+                        "name": "match",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Wait for a new token to be issued",
+                        "name": "listen",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/agentsdk.ExternalAuthResponse"
+                        }
+                    }
                 }
             }
         },
-        "/workspaceproxies/{workspaceproxy}": {
+        "/workspaceagents/me/gitauth": {
             "get": {
                 "security": [
                     {
@@ -10182,30 +10197,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Get workspace proxy",
-                "operationId": "get-workspace-proxy",
+                "summary": "Removed: Get workspace agent git auth",
+                "operationId": "removed-get-workspace-agent-git-auth",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Proxy ID or name",
-                        "name": "workspaceproxy",
-                        "in": "path",
+                        "description": "Match",
+                        "name": "match",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "id",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Wait for a new token to be issued",
+                        "name": "listen",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                            "$ref": "#/definitions/agentsdk.ExternalAuthResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/workspaceagents/me/gitsshkey": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -10215,30 +10244,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
-                ],
-                "summary": "Delete workspace proxy",
-                "operationId": "delete-workspace-proxy",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Proxy ID or name",
-                        "name": "workspaceproxy",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Agents"
                 ],
+                "summary": "Get workspace agent Git SSH key",
+                "operationId": "get-workspace-agent-git-ssh-key",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/agentsdk.GitSSHKey"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/workspaceagents/me/log-source": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
@@ -10251,26 +10272,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Update workspace proxy",
-                "operationId": "update-workspace-proxy",
+                "summary": "Post workspace agent log source",
+                "operationId": "post-workspace-agent-log-source",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Proxy ID or name",
-                        "name": "workspaceproxy",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update workspace proxy request",
+                        "description": "Log source request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PatchWorkspaceProxy"
+                            "$ref": "#/definitions/agentsdk.PostLogSourceRequest"
                         }
                     }
                 ],
@@ -10278,58 +10291,52 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentLogSource"
                         }
                     }
                 }
             }
         },
-        "/workspaces": {
-            "get": {
+        "/workspaceagents/me/logs": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "List workspaces",
-                "operationId": "list-workspaces",
+                "summary": "Patch workspace agent logs",
+                "operationId": "patch-workspace-agent-logs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search query in the format ` + "`" + `key:value` + "`" + `. Available keys are: owner, template, name, status, has-agent, dormant, last_used_after, last_used_before, has-ai-task, has_external_agent.",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "logs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/agentsdk.PatchLogs"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspacesResponse"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}": {
+        "/workspaceagents/me/reinit": {
             "get": {
                 "security": [
                     {
@@ -10340,76 +10347,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
-                ],
-                "summary": "Get workspace metadata by ID",
-                "operationId": "get-workspace-metadata-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Return data instead of HTTP 404 if the workspace is deleted",
-                        "name": "include_deleted",
-                        "in": "query"
-                    }
+                    "Agents"
                 ],
+                "summary": "Get workspace agent reinitialization",
+                "operationId": "get-workspace-agent-reinitialization",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Workspace"
+                            "$ref": "#/definitions/agentsdk.ReinitializationEvent"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/workspaceagents/me/rpc": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Workspaces"
-                ],
-                "summary": "Update workspace metadata by ID",
-                "operationId": "update-workspace-metadata-by-id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Metadata update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceRequest"
-                        }
-                    }
+                    "Agents"
                 ],
+                "summary": "Workspace agent RPC API",
+                "operationId": "workspace-agent-rpc-api",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "101": {
+                        "description": "Switching Protocols"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/workspaces/{workspace}/acl": {
+        "/workspaceagents/{workspaceagent}": {
             "get": {
                 "security": [
                     {
@@ -10420,16 +10394,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Get workspace ACLs",
-                "operationId": "get-workspace-acls",
+                "summary": "Get workspace agent by ID",
+                "operationId": "get-workspace-agent-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     }
@@ -10438,367 +10412,323 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceACL"
+                            "$ref": "#/definitions/codersdk.WorkspaceAgent"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/workspaceagents/{workspaceagent}/connection": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Completely clears the workspace's user and group ACLs.",
-                "operationId": "completely-clears-the-workspaces-user-and-group-acls",
+                "summary": "Get connection info for workspace agent",
+                "operationId": "get-connection-info-for-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/workspacesdk.AgentConnectionInfo"
+                        }
                     }
                 }
-            },
-            "patch": {
-                "security": [
+            }
+        },
+        "/workspaceagents/{workspaceagent}/containers": {
+            "get": {
+                "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Update workspace ACL",
-                "operationId": "update-workspace-acl",
+                "summary": "Get running containers for workspace agent",
+                "operationId": "get-running-containers-for-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update workspace ACL request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceACL"
-                        }
+                        "type": "string",
+                        "format": "key=value",
+                        "description": "Labels",
+                        "name": "label",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
+                        }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/autostart": {
-            "put": {
+        "/workspaceagents/{workspaceagent}/containers/devcontainers/{devcontainer}/recreate": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Update workspace autostart schedule by ID",
-                "operationId": "update-workspace-autostart-schedule-by-id",
+                "summary": "Recreate devcontainer for workspace agent",
+                "operationId": "recreate-devcontainer-for-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Schedule update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutostartRequest"
-                        }
+                        "type": "string",
+                        "description": "Devcontainer ID",
+                        "name": "devcontainer",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/autoupdates": {
-            "put": {
+        "/workspaceagents/{workspaceagent}/containers/watch": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Update workspace automatic updates by ID",
-                "operationId": "update-workspace-automatic-updates-by-id",
+                "summary": "Watch workspace agent for container updates.",
+                "operationId": "watch-workspace-agent-for-container-updates",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Automatic updates request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutomaticUpdatesRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentListContainersResponse"
+                        }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/builds": {
+        "/workspaceagents/{workspaceagent}/coordinate": {
             "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Builds"
+                    "Agents"
                 ],
-                "summary": "Get workspace builds by workspace ID",
-                "operationId": "get-workspace-builds-by-workspace-id",
+                "summary": "Coordinate workspace agent",
+                "operationId": "coordinate-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "After ID",
-                        "name": "after_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "description": "Since timestamp",
-                        "name": "since",
-                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/codersdk.WorkspaceBuild"
-                            }
-                        }
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/workspaceagents/{workspaceagent}/listening-ports": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Builds"
+                    "Agents"
                 ],
-                "summary": "Create workspace build",
-                "operationId": "create-workspace-build",
+                "summary": "Get listening ports for workspace agent",
+                "operationId": "get-listening-ports-for-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create workspace build request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.CreateWorkspaceBuildRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentListeningPortsResponse"
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/dormant": {
-            "put": {
+        "/workspaceagents/{workspaceagent}/logs": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Update workspace dormancy status by id.",
-                "operationId": "update-workspace-dormancy-status-by-id",
+                "summary": "Get logs by workspace agent",
+                "operationId": "get-logs-by-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Make a workspace dormant or active",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceDormancy"
-                        }
+                        "type": "integer",
+                        "description": "Before log id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After log id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Disable compression for WebSocket connection",
+                        "name": "no_compression",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Workspace"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/extend": {
-            "put": {
+        "/workspaceagents/{workspaceagent}/pty": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Extend workspace deadline by ID",
-                "operationId": "extend-workspace-deadline-by-id",
+                "summary": "Open PTY to workspace agent",
+                "operationId": "open-pty-to-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Extend deadline update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.PutExtendWorkspaceRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
-                        }
+                    "101": {
+                        "description": "Switching Protocols"
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/external-agent/{agent}/credentials": {
+        "/workspaceagents/{workspaceagent}/startup-logs": {
             "get": {
                 "security": [
                     {
@@ -10809,94 +10739,129 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Enterprise"
+                    "Agents"
                 ],
-                "summary": "Get workspace external agent credentials",
-                "operationId": "get-workspace-external-agent-credentials",
+                "summary": "Removed: Get logs by workspace agent",
+                "operationId": "removed-get-logs-by-workspace-agent",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Agent name",
-                        "name": "agent",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Before log id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After log id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Disable compression for WebSocket connection",
+                        "name": "no_compression",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ExternalAgentCredentials"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceAgentLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/favorite": {
-            "put": {
+        "/workspaceagents/{workspaceagent}/watch-metadata": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Favorite workspace by ID.",
-                "operationId": "favorite-workspace-by-id",
+                "summary": "Watch for workspace agent metadata updates",
+                "operationId": "watch-for-workspace-agent-metadata-updates",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Success"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
-            },
-            "delete": {
+            }
+        },
+        "/workspaceagents/{workspaceagent}/watch-metadata-ws": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Workspaces"
+                    "Agents"
                 ],
-                "summary": "Unfavorite workspace by ID.",
-                "operationId": "unfavorite-workspace-by-id",
+                "summary": "Watch for workspace agent metadata updates via WebSockets",
+                "operationId": "watch-for-workspace-agent-metadata-updates-via-websockets",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace agent ID",
+                        "name": "workspaceagent",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ServerSentEvent"
+                        }
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/workspaces/{workspace}/port-share": {
+        "/workspacebuilds/{workspacebuild}": {
             "get": {
                 "security": [
                     {
@@ -10907,16 +10872,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PortSharing"
+                    "Builds"
                 ],
-                "summary": "Get workspace agent port shares",
-                "operationId": "get-workspace-agent-port-shares",
+                "summary": "Get workspace build",
+                "operationId": "get-workspace-build",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
                     }
@@ -10925,97 +10889,112 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentPortShares"
+                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/workspacebuilds/{workspacebuild}/cancel": {
+            "patch": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PortSharing"
+                    "Builds"
                 ],
-                "summary": "Upsert workspace agent port share",
-                "operationId": "upsert-workspace-agent-port-share",
+                "summary": "Cancel workspace build",
+                "operationId": "cancel-workspace-build",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Upsert port sharing level request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.UpsertWorkspaceAgentPortShareRequest"
-                        }
+                        "enum": [
+                            "running",
+                            "pending"
+                        ],
+                        "type": "string",
+                        "description": "Expected status of the job. If expect_status is supplied, the request will be rejected with 412 Precondition Failed if the job doesn't match the state when performing the cancellation.",
+                        "name": "expect_status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceAgentPortShare"
+                            "$ref": "#/definitions/codersdk.Response"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/workspacebuilds/{workspacebuild}/logs": {
+            "get": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PortSharing"
+                    "Builds"
                 ],
-                "summary": "Delete workspace agent port share",
-                "operationId": "delete-workspace-agent-port-share",
+                "summary": "Get workspace build logs",
+                "operationId": "get-workspace-build-logs",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Delete port sharing level request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.DeleteWorkspaceAgentPortShareRequest"
-                        }
+                        "type": "integer",
+                        "description": "Before log id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "After log id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Follow log stream",
+                        "name": "follow",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.ProvisionerJobLog"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/resolve-autostart": {
+        "/workspacebuilds/{workspacebuild}/parameters": {
             "get": {
                 "security": [
                     {
@@ -11026,16 +11005,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Builds"
                 ],
-                "summary": "Resolve workspace autostart by id.",
-                "operationId": "resolve-workspace-autostart-by-id",
+                "summary": "Get build parameters for workspace build",
+                "operationId": "get-build-parameters-for-workspace-build",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
                     }
@@ -11044,13 +11022,16 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.ResolveAutostartResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/timings": {
+        "/workspacebuilds/{workspacebuild}/resources": {
             "get": {
                 "security": [
                     {
@@ -11061,16 +11042,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Builds"
                 ],
-                "summary": "Get workspace timings by ID",
-                "operationId": "get-workspace-timings-by-id",
+                "summary": "Removed: Get workspace resources for workspace build",
+                "operationId": "removed-get-workspace-resources-for-workspace-build",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
                     }
@@ -11079,54 +11060,150 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceResource"
+                            }
                         }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/ttl": {
-            "put": {
-                "security": [
+        "/workspacebuilds/{workspacebuild}/state": {
+            "get": {
+                "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "consumes": [
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Builds"
                 ],
-                "summary": "Update workspace TTL by ID",
-                "operationId": "update-workspace-ttl-by-id",
+                "summary": "Get provisioner state for workspace build",
+                "operationId": "get-provisioner-state-for-workspace-build",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspacebuilds/{workspacebuild}/timings": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Get workspace build timings by ID",
+                "operationId": "get-workspace-build-timings-by-id",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
+                        "description": "Workspace build ID",
+                        "name": "workspacebuild",
                         "in": "path",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaceproxies": {
+            "get": {
+                "security": [
                     {
-                        "description": "Workspace TTL update request",
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get workspace proxies",
+                "operationId": "get-workspace-proxies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.RegionsResponse-codersdk_WorkspaceProxy"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Create workspace proxy",
+                "operationId": "create-workspace-proxy",
+                "parameters": [
+                    {
+                        "description": "Create workspace proxy request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.UpdateWorkspaceTTLRequest"
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceProxyRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                        }
                     }
                 }
             }
         },
-        "/workspaces/{workspace}/usage": {
+        "/workspaceproxies/me/app-stats": {
             "post": {
                 "security": [
                     {
@@ -11137,25 +11214,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Enterprise"
                 ],
-                "summary": "Post Workspace Usage by ID",
-                "operationId": "post-workspace-usage-by-id",
+                "summary": "Report workspace app stats",
+                "operationId": "report-workspace-app-stats",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Post workspace usage request",
+                        "description": "Report app stats request",
                         "name": "request",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/codersdk.PostWorkspaceUsageRequest"
+                            "$ref": "#/definitions/wsproxysdk.ReportAppStatsRequest"
                         }
                     }
                 ],
@@ -11163,10 +11233,35 @@ const docTemplate = `{
                     "204": {
                         "description": "No Content"
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/workspaces/{workspace}/watch": {
+        "/workspaceproxies/me/coordinate": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Workspace Proxy Coordinate",
+                "operationId": "workspace-proxy-coordinate",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    }
+                },
+                "x-apidocgen": {
+                    "skip": true
+                }
+            }
+        },
+        "/workspaceproxies/me/crypto-keys": {
             "get": {
                 "security": [
                     {
@@ -11174,21 +11269,19 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "text/event-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Enterprise"
                 ],
-                "summary": "Watch workspace by ID",
-                "operationId": "watch-workspace-by-id",
-                "deprecated": true,
+                "summary": "Get workspace proxy crypto keys",
+                "operationId": "get-workspace-proxy-crypto-keys",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
+                        "description": "Feature key",
+                        "name": "feature",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -11196,83 +11289,1424 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/codersdk.Response"
+                            "$ref": "#/definitions/wsproxysdk.CryptoKeysResponse"
                         }
                     }
+                },
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "/workspaces/{workspace}/watch-ws": {
-            "get": {
+        "/workspaceproxies/me/deregister": {
+            "post": {
                 "security": [
                     {
                         "CoderSessionToken": []
                     }
                 ],
-                "produces": [
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Workspaces"
+                    "Enterprise"
                 ],
-                "summary": "Watch workspace by ID via WebSockets",
-                "operationId": "watch-workspace-by-id-via-websockets",
+                "summary": "Deregister workspace proxy",
+                "operationId": "deregister-workspace-proxy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Workspace ID",
-                        "name": "workspace",
-                        "in": "path",
-                        "required": true
+                        "description": "Deregister workspace proxy request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/wsproxysdk.DeregisterWorkspaceProxyRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/codersdk.ServerSentEvent"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "agentsdk.AWSInstanceIdentityToken": {
-            "type": "object",
-            "required": [
-                "document",
-                "signature"
-            ],
-            "properties": {
-                "document": {
-                    "type": "string"
                 },
-                "signature": {
-                    "type": "string"
-                }
-            }
-        },
-        "agentsdk.AuthenticateResponse": {
-            "type": "object",
-            "properties": {
-                "session_token": {
-                    "type": "string"
+                "x-apidocgen": {
+                    "skip": true
                 }
             }
         },
-        "agentsdk.AzureInstanceIdentityToken": {
-            "type": "object",
-            "required": [
-                "encoding",
-                "signature"
-            ],
-            "properties": {
-                "encoding": {
-                    "type": "string"
-                },
-                "signature": {
+        "/workspaceproxies/me/issue-signed-app-token": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Issue signed workspace app token",
+                "operationId": "issue-signed-workspace-app-token",
+                "parameters": [
+                    {
+                        "description": "Issue signed app token request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/workspaceapps.IssueTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/wsproxysdk.IssueSignedAppTokenResponse"
+                        }
+                    }
+                },
+                "x-apidocgen": {
+                    "skip": true
+                }
+            }
+        },
+        "/workspaceproxies/me/register": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Register workspace proxy",
+                "operationId": "register-workspace-proxy",
+                "parameters": [
+                    {
+                        "description": "Register workspace proxy request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/wsproxysdk.RegisterWorkspaceProxyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/wsproxysdk.RegisterWorkspaceProxyResponse"
+                        }
+                    }
+                },
+                "x-apidocgen": {
+                    "skip": true
+                }
+            }
+        },
+        "/workspaceproxies/{workspaceproxy}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get workspace proxy",
+                "operationId": "get-workspace-proxy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Proxy ID or name",
+                        "name": "workspaceproxy",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Delete workspace proxy",
+                "operationId": "delete-workspace-proxy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Proxy ID or name",
+                        "name": "workspaceproxy",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Update workspace proxy",
+                "operationId": "update-workspace-proxy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Proxy ID or name",
+                        "name": "workspaceproxy",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update workspace proxy request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PatchWorkspaceProxy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceProxy"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "List workspaces",
+                "operationId": "list-workspaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query in the format ` + "`" + `key:value` + "`" + `. Available keys are: owner, template, name, status, has-agent, dormant, last_used_after, last_used_before, has-ai-task, has_external_agent.",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspacesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace metadata by ID",
+                "operationId": "get-workspace-metadata-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return data instead of HTTP 404 if the workspace is deleted",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Workspace"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace metadata by ID",
+                "operationId": "update-workspace-metadata-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Metadata update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/acl": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace ACLs",
+                "operationId": "get-workspace-acls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceACL"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Completely clears the workspace's user and group ACLs.",
+                "operationId": "completely-clears-the-workspaces-user-and-group-acls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace ACL",
+                "operationId": "update-workspace-acl",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update workspace ACL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceACL"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/autostart": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace autostart schedule by ID",
+                "operationId": "update-workspace-autostart-schedule-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutostartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/autostart-exceptions": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace autostart exceptions",
+                "operationId": "get-workspace-autostart-exceptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAutostartExceptions"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace autostart exceptions",
+                "operationId": "update-workspace-autostart-exceptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutostartExceptionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/autoupdates": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace automatic updates by ID",
+                "operationId": "update-workspace-automatic-updates-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Automatic updates request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceAutomaticUpdatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/builds": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Get workspace builds by workspace ID",
+                "operationId": "get-workspace-builds-by-workspace-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "After ID",
+                        "name": "after_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Since timestamp",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Builds"
+                ],
+                "summary": "Create workspace build",
+                "operationId": "create-workspace-build",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create workspace build request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.CreateWorkspaceBuildRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBuild"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/dormant": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace dormancy status by id.",
+                "operationId": "update-workspace-dormancy-status-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Make a workspace dormant or active",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceDormancy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/extend": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Extend workspace deadline by ID",
+                "operationId": "extend-workspace-deadline-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Extend deadline update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PutExtendWorkspaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/external-agent/{agent}/credentials": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Enterprise"
+                ],
+                "summary": "Get workspace external agent credentials",
+                "operationId": "get-workspace-external-agent-credentials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Agent name",
+                        "name": "agent",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ExternalAgentCredentials"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/favorite": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Favorite workspace by ID.",
+                "operationId": "favorite-workspace-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Unfavorite workspace by ID.",
+                "operationId": "unfavorite-workspace-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/lifecycle": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "description": "Returns the upcoming automatic transitions of a workspace, and\nthe recent decisions made about it by the autobuild lifecycle executor.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace lifecycle",
+                "operationId": "get-workspace-lifecycle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceLifecycle"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/port-share": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PortSharing"
+                ],
+                "summary": "Get workspace agent port shares",
+                "operationId": "get-workspace-agent-port-shares",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentPortShares"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PortSharing"
+                ],
+                "summary": "Upsert workspace agent port share",
+                "operationId": "upsert-workspace-agent-port-share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upsert port sharing level request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpsertWorkspaceAgentPortShareRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceAgentPortShare"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PortSharing"
+                ],
+                "summary": "Delete workspace agent port share",
+                "operationId": "delete-workspace-agent-port-share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Delete port sharing level request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.DeleteWorkspaceAgentPortShareRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/resolve-autostart": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Resolve workspace autostart by id.",
+                "operationId": "resolve-workspace-autostart-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ResolveAutostartResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/schedule-windows": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace schedule windows",
+                "operationId": "get-workspace-schedule-windows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/codersdk.WorkspaceScheduleWindow"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace schedule windows",
+                "operationId": "update-workspace-schedule-windows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule windows update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceScheduleWindowsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/timings": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Get workspace timings by ID",
+                "operationId": "get-workspace-timings-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.WorkspaceBuildTimings"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/ttl": {
+            "put": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Update workspace TTL by ID",
+                "operationId": "update-workspace-ttl-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workspace TTL update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.UpdateWorkspaceTTLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/usage": {
+            "post": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Post Workspace Usage by ID",
+                "operationId": "post-workspace-usage-by-id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post workspace usage request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.PostWorkspaceUsageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/watch": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Watch workspace by ID",
+                "operationId": "watch-workspace-by-id",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace}/watch-ws": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspaces"
+                ],
+                "summary": "Watch workspace by ID via WebSockets",
+                "operationId": "watch-workspace-by-id-via-websockets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Workspace ID",
+                        "name": "workspace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ServerSentEvent"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "agentsdk.AWSInstanceIdentityToken": {
+            "type": "object",
+            "required": [
+                "document",
+                "signature"
+            ],
+            "properties": {
+                "document": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.AuthenticateResponse": {
+            "type": "object",
+            "properties": {
+                "session_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.AzureInstanceIdentityToken": {
+            "type": "object",
+            "required": [
+                "encoding",
+                "signature"
+            ],
+            "properties": {
+                "encoding": {
+                    "type": "string"
+                },
+                "signature": {
                     "type": "string"
                 }
             }
@@ -11296,315 +12730,642 @@ const docTemplate = `{
                 "url": {
                     "type": "string"
                 },
-                "username": {
-                    "description": "Deprecated: Only supported on ` + "`" + `/workspaceagents/me/gitauth` + "`" + `\nfor backwards compatibility.",
-                    "type": "string"
+                "username": {
+                    "description": "Deprecated: Only supported on ` + "`" + `/workspaceagents/me/gitauth` + "`" + `\nfor backwards compatibility.",
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.GitSSHKey": {
+            "type": "object",
+            "properties": {
+                "private_key": {
+                    "type": "string"
+                },
+                "public_key": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.GoogleInstanceIdentityToken": {
+            "type": "object",
+            "required": [
+                "json_web_token"
+            ],
+            "properties": {
+                "json_web_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.Log": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "level": {
+                    "$ref": "#/definitions/codersdk.LogLevel"
+                },
+                "output": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.PatchAppStatus": {
+            "type": "object",
+            "properties": {
+                "app_slug": {
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "Deprecated: this field is unused and will be removed in a future version.",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "needs_user_attention": {
+                    "description": "Deprecated: this field is unused and will be removed in a future version.",
+                    "type": "boolean"
+                },
+                "state": {
+                    "$ref": "#/definitions/codersdk.WorkspaceAppStatusState"
+                },
+                "uri": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.PatchLogs": {
+            "type": "object",
+            "properties": {
+                "log_source_id": {
+                    "type": "string"
+                },
+                "logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/agentsdk.Log"
+                    }
+                }
+            }
+        },
+        "agentsdk.PostLogSourceRequest": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is a unique identifier for the log source.\nIt is scoped to a workspace agent, and can be statically\ndefined inside code to prevent duplicate sources from being\ncreated for the same agent.",
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.ReinitializationEvent": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "$ref": "#/definitions/agentsdk.ReinitializationReason"
+                },
+                "workspaceID": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentsdk.ReinitializationReason": {
+            "type": "string",
+            "enum": [
+                "prebuild_claimed"
+            ],
+            "x-enum-varnames": [
+                "ReinitializeReasonPrebuildClaimed"
+            ]
+        },
+        "coderd.SCIMUser": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active is a ptr to prevent the empty value from being interpreted as false.",
+                    "type": "boolean"
+                },
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "display": {
+                                "type": "string"
+                            },
+                            "primary": {
+                                "type": "boolean"
+                            },
+                            "type": {
+                                "type": "string"
+                            },
+                            "value": {
+                                "type": "string",
+                                "format": "email"
+                            }
+                        }
+                    }
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {}
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meta": {
+                    "type": "object",
+                    "properties": {
+                        "resourceType": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "name": {
+                    "type": "object",
+                    "properties": {
+                        "familyName": {
+                            "type": "string"
+                        },
+                        "givenName": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "coderd.cspViolation": {
+            "type": "object",
+            "properties": {
+                "csp-report": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "coderd.tasksListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.Task"
+                    }
+                }
+            }
+        },
+        "codersdk.ACLAvailable": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.Group"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ReducedUser"
+                    }
                 }
             }
         },
-        "agentsdk.GitSSHKey": {
+        "codersdk.AIBridgeAnthropicConfig": {
             "type": "object",
             "properties": {
-                "private_key": {
+                "base_url": {
                     "type": "string"
                 },
-                "public_key": {
+                "key": {
                     "type": "string"
                 }
             }
         },
-        "agentsdk.GoogleInstanceIdentityToken": {
+        "codersdk.AIBridgeCaptureConfig": {
             "type": "object",
-            "required": [
-                "json_web_token"
-            ],
             "properties": {
-                "json_web_token": {
-                    "type": "string"
+                "max_body_bytes": {
+                    "type": "integer"
                 }
             }
         },
-        "agentsdk.Log": {
+        "codersdk.AIBridgeCaptureSettings": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "level": {
-                    "$ref": "#/definitions/codersdk.LogLevel"
-                },
-                "output": {
-                    "type": "string"
+                "enabled": {
+                    "type": "boolean"
                 }
             }
         },
-        "agentsdk.PatchAppStatus": {
+        "codersdk.AIBridgeConfig": {
             "type": "object",
             "properties": {
-                "app_slug": {
-                    "type": "string"
-                },
-                "icon": {
-                    "description": "Deprecated: this field is unused and will be removed in a future version.",
-                    "type": "string"
+                "anthropic": {
+                    "$ref": "#/definitions/codersdk.AIBridgeAnthropicConfig"
                 },
-                "message": {
-                    "type": "string"
+                "capture": {
+                    "$ref": "#/definitions/codersdk.AIBridgeCaptureConfig"
                 },
-                "needs_user_attention": {
-                    "description": "Deprecated: this field is unused and will be removed in a future version.",
+                "enabled": {
                     "type": "boolean"
                 },
-                "state": {
-                    "$ref": "#/definitions/codersdk.WorkspaceAppStatusState"
+                "openai": {
+                    "$ref": "#/definitions/codersdk.AIBridgeOpenAIConfig"
                 },
-                "uri": {
-                    "type": "string"
+                "policy": {
+                    "$ref": "#/definitions/codersdk.AIBridgePolicyConfig"
                 }
             }
         },
-        "agentsdk.PatchLogs": {
+        "codersdk.AIBridgeInsightsGroupBy": {
+            "type": "string",
+            "enum": [
+                "user",
+                "template",
+                "provider",
+                "model"
+            ],
+            "x-enum-varnames": [
+                "AIBridgeInsightsGroupByUser",
+                "AIBridgeInsightsGroupByTemplate",
+                "AIBridgeInsightsGroupByProvider",
+                "AIBridgeInsightsGroupByModel"
+            ]
+        },
+        "codersdk.AIBridgeInsightsResponse": {
             "type": "object",
             "properties": {
-                "log_source_id": {
-                    "type": "string"
+                "end_time": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "logs": {
+                "group_by": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/agentsdk.Log"
+                        "$ref": "#/definitions/codersdk.AIBridgeInsightsGroupBy"
+                    }
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeInsightsRow"
                     }
+                },
+                "start_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "total": {
+                    "$ref": "#/definitions/codersdk.AIBridgeInsightsRow"
                 }
             }
         },
-        "agentsdk.PostLogSourceRequest": {
+        "codersdk.AIBridgeInsightsRow": {
             "type": "object",
             "properties": {
-                "display_name": {
+                "estimated_cost": {
+                    "type": "number"
+                },
+                "input_tokens": {
+                    "type": "integer"
+                },
+                "model": {
                     "type": "string"
                 },
-                "icon": {
+                "output_tokens": {
+                    "type": "integer"
+                },
+                "provider": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "ID is a unique identifier for the log source.\nIt is scoped to a workspace agent, and can be statically\ndefined inside code to prevent duplicate sources from being\ncreated for the same agent.",
+                "requests": {
+                    "type": "integer"
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_name": {
+                    "type": "string"
+                },
+                "unpriced_tokens": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "agentsdk.ReinitializationEvent": {
+        "codersdk.AIBridgeInterception": {
             "type": "object",
             "properties": {
-                "reason": {
-                    "$ref": "#/definitions/agentsdk.ReinitializationReason"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "workspaceID": {
+                "initiator_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "model": {
                     "type": "string"
+                },
+                "policy_decisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgePolicyDecision"
+                    }
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "token_usages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeTokenUsage"
+                    }
+                },
+                "tool_usages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeToolUsage"
+                    }
+                },
+                "user_prompts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeUserPrompt"
+                    }
                 }
             }
         },
-        "agentsdk.ReinitializationReason": {
-            "type": "string",
-            "enum": [
-                "prebuild_claimed"
-            ],
-            "x-enum-varnames": [
-                "ReinitializeReasonPrebuildClaimed"
-            ]
-        },
-        "coderd.SCIMUser": {
+        "codersdk.AIBridgeInterceptionCapture": {
             "type": "object",
             "properties": {
-                "active": {
-                    "description": "Active is a ptr to prevent the empty value from being interpreted as false.",
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "emails": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "display": {
-                                "type": "string"
-                            },
-                            "primary": {
-                                "type": "boolean"
-                            },
-                            "type": {
-                                "type": "string"
-                            },
-                            "value": {
-                                "type": "string",
-                                "format": "email"
-                            }
-                        }
-                    }
+                "interception_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "groups": {
-                    "type": "array",
-                    "items": {}
+                "method": {
+                    "type": "string"
                 },
-                "id": {
+                "path": {
                     "type": "string"
                 },
-                "meta": {
+                "request_body": {
+                    "type": "string"
+                },
+                "request_body_truncated": {
+                    "type": "boolean"
+                },
+                "request_headers": {
                     "type": "object",
-                    "properties": {
-                        "resourceType": {
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
                             "type": "string"
                         }
                     }
                 },
-                "name": {
+                "response_body": {
+                    "type": "string"
+                },
+                "response_body_truncated": {
+                    "type": "boolean"
+                },
+                "response_headers": {
                     "type": "object",
-                    "properties": {
-                        "familyName": {
-                            "type": "string"
-                        },
-                        "givenName": {
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
                             "type": "string"
                         }
                     }
                 },
-                "schemas": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "response_status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.AIBridgeLimit": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "daily_token_budget": {
+                    "type": "integer"
+                },
+                "monthly_token_budget": {
+                    "type": "integer"
+                },
+                "requests_per_minute": {
+                    "type": "integer"
+                },
+                "scope": {
+                    "enum": [
+                        "user",
+                        "group",
+                        "organization"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AIBridgeLimitScope"
+                        }
+                    ]
+                },
+                "scope_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "userName": {
-                    "type": "string"
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "coderd.cspViolation": {
-            "type": "object",
-            "properties": {
-                "csp-report": {
-                    "type": "object",
-                    "additionalProperties": true
-                }
-            }
+        "codersdk.AIBridgeLimitScope": {
+            "type": "string",
+            "enum": [
+                "user",
+                "group",
+                "organization"
+            ],
+            "x-enum-varnames": [
+                "AIBridgeLimitScopeUser",
+                "AIBridgeLimitScopeGroup",
+                "AIBridgeLimitScopeOrganization"
+            ]
         },
-        "coderd.tasksListResponse": {
+        "codersdk.AIBridgeLimitUsage": {
             "type": "object",
             "properties": {
-                "count": {
+                "daily_resets_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "daily_tokens": {
                     "type": "integer"
                 },
-                "tasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/codersdk.Task"
-                    }
+                "exceeded": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "$ref": "#/definitions/codersdk.AIBridgeLimit"
+                },
+                "monthly_resets_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "monthly_tokens": {
+                    "type": "integer"
+                },
+                "requests_last_minute": {
+                    "type": "integer"
                 }
             }
         },
-        "codersdk.ACLAvailable": {
+        "codersdk.AIBridgeListInterceptionsResponse": {
             "type": "object",
             "properties": {
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/codersdk.Group"
-                    }
-                },
-                "users": {
+                "results": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/codersdk.ReducedUser"
+                        "$ref": "#/definitions/codersdk.AIBridgeInterception"
                     }
                 }
             }
         },
-        "codersdk.AIBridgeAnthropicConfig": {
+        "codersdk.AIBridgeModelPrice": {
             "type": "object",
             "properties": {
-                "base_url": {
+                "input_price_per_million_tokens": {
+                    "type": "number"
+                },
+                "model": {
                     "type": "string"
                 },
-                "key": {
+                "output_price_per_million_tokens": {
+                    "type": "number"
+                },
+                "provider": {
                     "type": "string"
                 }
             }
         },
-        "codersdk.AIBridgeConfig": {
+        "codersdk.AIBridgeOpenAIConfig": {
             "type": "object",
             "properties": {
-                "anthropic": {
-                    "$ref": "#/definitions/codersdk.AIBridgeAnthropicConfig"
-                },
-                "enabled": {
-                    "type": "boolean"
+                "base_url": {
+                    "type": "string"
                 },
-                "openai": {
-                    "$ref": "#/definitions/codersdk.AIBridgeOpenAIConfig"
+                "key": {
+                    "type": "string"
                 }
             }
         },
-        "codersdk.AIBridgeInterception": {
+        "codersdk.AIBridgePolicyAction": {
+            "type": "string",
+            "enum": [
+                "redact",
+                "block"
+            ],
+            "x-enum-varnames": [
+                "AIBridgePolicyActionRedact",
+                "AIBridgePolicyActionBlock"
+            ]
+        },
+        "codersdk.AIBridgePolicyConfig": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
-                },
-                "initiator_id": {
-                    "type": "string",
-                    "format": "uuid"
-                },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "model": {
-                    "type": "string"
-                },
-                "provider": {
-                    "type": "string"
-                },
-                "started_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "token_usages": {
+                "denied_tools": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/codersdk.AIBridgeTokenUsage"
+                        "type": "string"
                     }
                 },
-                "tool_usages": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/codersdk.AIBridgeToolUsage"
-                    }
+                "max_prompt_bytes": {
+                    "type": "integer"
                 },
-                "user_prompts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/codersdk.AIBridgeUserPrompt"
-                    }
-                }
-            }
-        },
-        "codersdk.AIBridgeListInterceptionsResponse": {
-            "type": "object",
-            "properties": {
-                "results": {
+                "secret_detection": {
+                    "type": "string"
+                },
+                "secret_patterns": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/codersdk.AIBridgeInterception"
+                        "type": "string"
                     }
                 }
             }
         },
-        "codersdk.AIBridgeOpenAIConfig": {
+        "codersdk.AIBridgePolicyDecision": {
             "type": "object",
             "properties": {
-                "base_url": {
+                "action": {
+                    "enum": [
+                        "redact",
+                        "block"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.AIBridgePolicyAction"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "interception_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "policy": {
                     "type": "string"
                 },
-                "key": {
+                "reason": {
                     "type": "string"
                 }
             }
@@ -11678,6 +13439,30 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.AIBridgeUsage": {
+            "type": "object",
+            "properties": {
+                "daily_tokens": {
+                    "type": "integer"
+                },
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeLimitUsage"
+                    }
+                },
+                "monthly_tokens": {
+                    "type": "integer"
+                },
+                "requests_last_minute": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "codersdk.AIBridgeUserPrompt": {
             "type": "object",
             "properties": {
@@ -11710,6 +13495,23 @@ const docTemplate = `{
             "properties": {
                 "bridge": {
                     "$ref": "#/definitions/codersdk.AIBridgeConfig"
+                },
+                "tasks": {
+                    "$ref": "#/definitions/codersdk.AITasksConfig"
+                }
+            }
+        },
+        "codersdk.AITasksConfig": {
+            "type": "object",
+            "properties": {
+                "idle_stop_after": {
+                    "type": "integer"
+                },
+                "max_running_per_user": {
+                    "type": "integer"
+                },
+                "retention_period": {
+                    "type": "integer"
                 }
             }
         },
@@ -12380,7 +14182,8 @@ const docTemplate = `{
                 "connect",
                 "disconnect",
                 "open",
-                "close"
+                "close",
+                "invoke"
             ],
             "x-enum-varnames": [
                 "AuditActionCreate",
@@ -12395,7 +14198,8 @@ const docTemplate = `{
                 "AuditActionConnect",
                 "AuditActionDisconnect",
                 "AuditActionOpen",
-                "AuditActionClose"
+                "AuditActionClose",
+                "AuditActionInvoke"
             ]
         },
         "codersdk.AuditDiff": {
@@ -12451,52 +14255,186 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
-                "resource_icon": {
+                "resource_icon": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "resource_link": {
+                    "type": "string"
+                },
+                "resource_target": {
+                    "description": "ResourceTarget is the name of the resource.",
+                    "type": "string"
+                },
+                "resource_type": {
+                    "$ref": "#/definitions/codersdk.ResourceType"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user": {
+                    "$ref": "#/definitions/codersdk.User"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.AuditLogBrokenLink": {
+            "type": "object",
+            "properties": {
+                "audit_log_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "seq": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.AuditLogPurgedRange": {
+            "type": "object",
+            "properties": {
+                "first_seq": {
+                    "type": "integer"
+                },
+                "last_seq": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "audit_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AuditLog"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.AuditLogVerifyResponse": {
+            "type": "object",
+            "properties": {
+                "broken_link": {
+                    "$ref": "#/definitions/codersdk.AuditLogBrokenLink"
+                },
+                "entries": {
+                    "description": "Entries is the number of hash chain entries that were checked.",
+                    "type": "integer"
+                },
+                "first_seq": {
+                    "type": "integer"
+                },
+                "last_hash": {
+                    "description": "LastHash is the hex-encoded hash of the last intact entry. Recording it\nelsewhere allows detecting audit logs removed from the end of the chain.",
                     "type": "string"
                 },
-                "resource_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "last_seq": {
+                    "type": "integer"
                 },
-                "resource_link": {
-                    "type": "string"
+                "purged": {
+                    "description": "Purged lists the entries whose audit logs were purged, which were\nverified from their checkpoints rather than their audit logs. Purges\nthat retention does not account for should be investigated.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AuditLogPurgedRange"
+                    }
                 },
-                "resource_target": {
-                    "description": "ResourceTarget is the name of the resource.",
-                    "type": "string"
+                "verified": {
+                    "description": "Verified is true if every entry in the hash chain is intact.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "codersdk.AuditLoggingConfig": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "$ref": "#/definitions/codersdk.AuditLoggingFileConfig"
                 },
-                "resource_type": {
-                    "$ref": "#/definitions/codersdk.ResourceType"
+                "hash_chain": {
+                    "description": "HashChain appends every audit log to a tamper-evident hash chain.",
+                    "type": "boolean"
                 },
-                "status_code": {
-                    "type": "integer"
+                "http": {
+                    "$ref": "#/definitions/codersdk.AuditLoggingHTTPConfig"
                 },
-                "time": {
-                    "type": "string",
-                    "format": "date-time"
+                "syslog": {
+                    "$ref": "#/definitions/codersdk.AuditLoggingSyslogConfig"
+                }
+            }
+        },
+        "codersdk.AuditLoggingFileConfig": {
+            "type": "object",
+            "properties": {
+                "max_backups": {
+                    "type": "integer"
                 },
-                "user": {
-                    "$ref": "#/definitions/codersdk.User"
+                "max_size": {
+                    "description": "MaxSize is the size in megabytes at which the file is rotated.",
+                    "type": "integer"
                 },
-                "user_agent": {
+                "path": {
                     "type": "string"
                 }
             }
         },
-        "codersdk.AuditLogResponse": {
+        "codersdk.AuditLoggingHTTPConfig": {
             "type": "object",
             "properties": {
-                "audit_logs": {
+                "batch_size": {
+                    "type": "integer"
+                },
+                "buffer_dir": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "$ref": "#/definitions/serpent.URL"
+                },
+                "flush_interval": {
+                    "type": "integer"
+                },
+                "headers": {
+                    "description": "Headers are formatted as \"Name: value\".",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/codersdk.AuditLog"
+                        "type": "string"
                     }
                 },
-                "count": {
+                "max_buffered_events": {
                     "type": "integer"
                 }
             }
         },
+        "codersdk.AuditLoggingSyslogConfig": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Address is the host:port of the syslog receiver.",
+                    "type": "string"
+                },
+                "tls": {
+                    "type": "boolean"
+                },
+                "tls_ca_file": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.AuthMethod": {
             "type": "object",
             "properties": {
@@ -12608,6 +14546,55 @@ const docTemplate = `{
                 "AutomaticUpdatesNever"
             ]
         },
+        "codersdk.AutostartException": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "EndDate is the last day of the exception (inclusive), formatted as\nYYYY-MM-DD.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "StartDate is the first day of the exception, formatted as YYYY-MM-DD.",
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.AutostartExceptionCalendar": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AutostartException"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
         "codersdk.BannerConfig": {
             "type": "object",
             "properties": {
@@ -12673,22 +14660,28 @@ const docTemplate = `{
                 "autostart",
                 "autostop",
                 "dormancy",
+                "autodelete",
                 "dashboard",
                 "cli",
                 "ssh_connection",
                 "vscode_connection",
-                "jetbrains_connection"
+                "jetbrains_connection",
+                "task_autostop",
+                "task_autodelete"
             ],
             "x-enum-varnames": [
                 "BuildReasonInitiator",
                 "BuildReasonAutostart",
                 "BuildReasonAutostop",
                 "BuildReasonDormancy",
+                "BuildReasonAutodelete",
                 "BuildReasonDashboard",
                 "BuildReasonCLI",
                 "BuildReasonSSHConnection",
                 "BuildReasonVSCodeConnection",
-                "BuildReasonJetbrainsConnection"
+                "BuildReasonJetbrainsConnection",
+                "BuildReasonTaskAutostop",
+                "BuildReasonTaskAutodelete"
             ]
         },
         "codersdk.CORSBehavior": {
@@ -12975,6 +14968,37 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateNotificationUserTargetRequest": {
+            "type": "object",
+            "required": [
+                "endpoint",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "endpoint": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Secret is used to sign webhook deliveries with an HMAC-SHA256 signature. It is optional.",
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "webhook",
+                        "slack"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationUserTargetType"
+                        }
+                    ]
+                }
+            }
+        },
         "codersdk.CreateOrganizationRequest": {
             "type": "object",
             "required": [
@@ -13013,6 +15037,20 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "rich_parameter_values": {
+                    "description": "RichParameterValues are the values of the template's parameters other\nthan the AI prompt, which is taken from the input.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceBuildParameter"
+                    }
+                },
+                "steps": {
+                    "description": "Steps defines a multi-step task. The first step's prompt starts the\ntask, and each following prompt is sent once the agent reports the\nprevious step idle. Input must be empty when steps are given.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.CreateTaskStep"
+                    }
+                },
                 "template_version_id": {
                     "type": "string",
                     "format": "uuid"
@@ -13023,6 +15061,18 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.CreateTaskStep": {
+            "type": "object",
+            "properties": {
+                "completion_check": {
+                    "description": "CompletionCheck is an optional command which is run in the workspace\nonce the agent reports the step idle. A non-zero exit code fails the\ntask instead of moving on to the next step.",
+                    "type": "string"
+                },
+                "prompt": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.CreateTemplateRequest": {
             "type": "object",
             "required": [
@@ -13796,6 +15846,12 @@ const docTemplate = `{
                 "allow_workspace_renames": {
                     "type": "boolean"
                 },
+                "audit_logging": {
+                    "$ref": "#/definitions/codersdk.AuditLoggingConfig"
+                },
+                "autobuild_dry_run": {
+                    "type": "boolean"
+                },
                 "autobuild_poll_interval": {
                     "type": "integer"
                 },
@@ -13920,12 +15976,18 @@ const docTemplate = `{
                 "redirect_to_access_url": {
                     "type": "boolean"
                 },
+                "retention": {
+                    "$ref": "#/definitions/codersdk.RetentionConfig"
+                },
                 "scim_api_key": {
                     "type": "string"
                 },
                 "session_lifetime": {
                     "$ref": "#/definitions/codersdk.SessionLifetime"
                 },
+                "session_recording_max_size": {
+                    "type": "integer"
+                },
                 "ssh_keygen_algorithm": {
                     "type": "string"
                 },
@@ -14923,70 +16985,227 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
-                "username": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.NotificationMethodsResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.NotificationPreference": {
+            "type": "object",
+            "properties": {
+                "digest": {
+                    "description": "Digest is true when notifications from this template are held and delivered as a single digest.",
+                    "type": "boolean"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user_target_id": {
+                    "description": "UserTargetID is the user-defined target which notifications from this template are delivered to, instead of\nthe template's or deployment's notification method.",
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "codersdk.NotificationTemplate": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "type": "string"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "body_template_override": {
+                    "description": "BodyTemplateOverride is this deployment's customization of the body, which is used instead of BodyTemplate when\nset.",
+                    "type": "string"
+                },
+                "enabled_by_default": {
+                    "type": "boolean"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "title_template": {
+                    "type": "string"
+                },
+                "title_template_override": {
+                    "description": "TitleTemplateOverride is this deployment's customization of the title, which is used instead of TitleTemplate\nwhen set.",
+                    "type": "string"
+                },
+                "urgent": {
+                    "description": "Urgent notifications are delivered immediately, even during the recipient's quiet hours or while they have\nsnoozed notifications.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "codersdk.NotificationTemplatePreview": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is the rendered body as markdown.",
+                    "type": "string"
+                },
+                "body_html": {
+                    "description": "BodyHTML is the rendered body converted to HTML, as used in emails.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title is the rendered title as plain text.",
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.NotificationTemplatePreviewRequest": {
+            "type": "object",
+            "properties": {
+                "body_template": {
+                    "description": "BodyTemplate is rendered instead of the template's current body when set.",
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "labels": {
+                    "description": "Labels are substituted into the sample payload. Labels which are referenced by the template but not given are\nfilled with a placeholder of the form \"[name]\".",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "title_template": {
+                    "description": "TitleTemplate is rendered instead of the template's current title when set, so that changes can be previewed\nbefore they are saved.",
                     "type": "string"
                 }
             }
         },
-        "codersdk.NotificationMethodsResponse": {
+        "codersdk.NotificationTemplateTestRequest": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "array",
-                    "items": {
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "default": {
-                    "type": "string"
+                "method": {
+                    "description": "Method is the dispatch method to deliver the test message through. It must be enabled for this deployment.\nDefaults to the deployment's notification method.",
+                    "type": "string",
+                    "example": "smtp"
                 }
             }
         },
-        "codersdk.NotificationPreference": {
+        "codersdk.NotificationUserTarget": {
             "type": "object",
             "properties": {
-                "disabled": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "has_secret": {
+                    "description": "HasSecret indicates whether deliveries to this target are signed. The secret itself is never returned.",
                     "type": "boolean"
                 },
                 "id": {
                     "type": "string",
                     "format": "uuid"
                 },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "webhook",
+                        "slack"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.NotificationUserTargetType"
+                        }
+                    ]
+                },
                 "updated_at": {
                     "type": "string",
                     "format": "date-time"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "codersdk.NotificationTemplate": {
+        "codersdk.NotificationUserTargetType": {
+            "type": "string",
+            "enum": [
+                "webhook",
+                "slack"
+            ],
+            "x-enum-varnames": [
+                "NotificationUserTargetTypeWebhook",
+                "NotificationUserTargetTypeSlack"
+            ]
+        },
+        "codersdk.NotificationWebhookSecret": {
             "type": "object",
             "properties": {
-                "actions": {
-                    "type": "string"
-                },
-                "body_template": {
-                    "type": "string"
-                },
-                "enabled_by_default": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "group": {
-                    "type": "string"
+                "expires_at": {
+                    "description": "ExpiresAt is unset for the active secret. A rotated secret continues to sign deliveries until this time.",
+                    "type": "string",
+                    "format": "date-time"
                 },
                 "id": {
                     "type": "string",
                     "format": "uuid"
                 },
-                "kind": {
-                    "type": "string"
-                },
-                "method": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "title_template": {
+                "secret": {
+                    "description": "Secret is only returned once, in response to a rotation.",
                     "type": "string"
                 }
             }
@@ -14994,6 +17213,10 @@ const docTemplate = `{
         "codersdk.NotificationsConfig": {
             "type": "object",
             "properties": {
+                "digest_window": {
+                    "description": "How long to hold notifications which users have chosen to receive as a digest.",
+                    "type": "integer"
+                },
                 "dispatch_timeout": {
                     "description": "How long to wait while a notification is being sent before giving up.",
                     "type": "integer"
@@ -15034,6 +17257,10 @@ const docTemplate = `{
                     "description": "Which delivery method to use (available options: 'smtp', 'webhook').",
                     "type": "string"
                 },
+                "quiet_hours_duration": {
+                    "description": "How long each user's quiet hours last, from the start of their quiet hours schedule.",
+                    "type": "integer"
+                },
                 "retry_interval": {
                     "description": "The minimum time between retries.",
                     "type": "integer"
@@ -15046,6 +17273,10 @@ const docTemplate = `{
                     "description": "The notifications system buffers message updates in memory to ease pressure on the database.\nThis option controls how often it synchronizes its state with the database. The shorter this value the\nlower the change of state inconsistency in a non-graceful shutdown - but it also increases load on the\ndatabase. It is recommended to keep this option at its default value.",
                     "type": "integer"
                 },
+                "user_targets_allow_private_networks": {
+                    "description": "Whether users' personal notification targets may deliver to loopback, link-local and private network addresses.",
+                    "type": "boolean"
+                },
                 "webhook": {
                     "description": "Webhook settings.",
                     "allOf": [
@@ -16193,9 +18424,34 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.PrebuildInsightsPercentiles": {
+            "type": "object",
+            "properties": {
+                "p50": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "p95": {
+                    "type": "number",
+                    "example": 48.2
+                }
+            }
+        },
         "codersdk.PrebuildsConfig": {
             "type": "object",
             "properties": {
+                "autoscaling_max_instances": {
+                    "description": "AutoscalingMaxInstances is the maximum number of prebuilt workspaces kept for a preset\nwhen pools are sized by demand. Presets configured with more instances keep their count.",
+                    "type": "integer"
+                },
+                "autoscaling_target_hit_probability": {
+                    "description": "AutoscalingTargetHitProbability is the probability with which a claim should find an\neligible prebuilt workspace when pools are sized by demand.",
+                    "type": "number"
+                },
+                "autoscaling_window": {
+                    "description": "AutoscalingWindow is the time window over which claims of prebuilt workspaces are\nobserved to size prebuild pools by demand. Autoscaling is disabled when set to zero.",
+                    "type": "integer"
+                },
                 "failure_hard_limit": {
                     "description": "FailureHardLimit defines the maximum number of consecutive failed prebuild attempts allowed\nbefore a preset is considered to be in a hard limit state. When a preset hits this limit,\nno new prebuilds will be created until the limit is reset.\nFailureHardLimit is disabled when set to zero.",
                     "type": "integer"
@@ -16214,6 +18470,40 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.PrebuildsInsightsReport": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "presets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.PresetPrebuildInsights"
+                    }
+                },
+                "start_time": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "template_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                }
+            }
+        },
+        "codersdk.PrebuildsInsightsResponse": {
+            "type": "object",
+            "properties": {
+                "report": {
+                    "$ref": "#/definitions/codersdk.PrebuildsInsightsReport"
+                }
+            }
+        },
         "codersdk.PrebuildsSettings": {
             "type": "object",
             "properties": {
@@ -16262,6 +18552,65 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.PresetPrebuildInsights": {
+            "type": "object",
+            "properties": {
+                "claim_latency_ms": {
+                    "description": "ClaimLatencyMS is the time taken to claim a prebuilt workspace, or to\nfind there is none to claim.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.PrebuildInsightsPercentiles"
+                        }
+                    ]
+                },
+                "hit_rate": {
+                    "type": "number",
+                    "example": 0.9
+                },
+                "hit_time_to_ready_seconds": {
+                    "description": "HitTimeToReadySeconds is the time from the creation of a workspace served\nfrom a prebuilt workspace until its agents were ready.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.PrebuildInsightsPercentiles"
+                        }
+                    ]
+                },
+                "hits": {
+                    "description": "Hits is the number of those workspaces which were served from a prebuilt\nworkspace.",
+                    "type": "integer",
+                    "example": 36
+                },
+                "miss_time_to_ready_seconds": {
+                    "description": "MissTimeToReadySeconds is the time from the creation of a workspace built\nfrom scratch until its agents were ready.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.PrebuildInsightsPercentiles"
+                        }
+                    ]
+                },
+                "organization_name": {
+                    "type": "string"
+                },
+                "preset_name": {
+                    "type": "string"
+                },
+                "template_display_name": {
+                    "type": "string"
+                },
+                "template_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "template_name": {
+                    "type": "string"
+                },
+                "workspaces": {
+                    "description": "Workspaces is the number of workspaces created with the preset.",
+                    "type": "integer",
+                    "example": 40
+                }
+            }
+        },
         "codersdk.PreviewParameter": {
             "type": "object",
             "properties": {
@@ -17248,7 +19597,8 @@ const docTemplate = `{
                 "idp_sync_settings_role",
                 "workspace_agent",
                 "workspace_app",
-                "task"
+                "task",
+                "mcp_tool"
             ],
             "x-enum-varnames": [
                 "ResourceTypeTemplate",
@@ -17276,7 +19626,8 @@ const docTemplate = `{
                 "ResourceTypeIdpSyncSettingsRole",
                 "ResourceTypeWorkspaceAgent",
                 "ResourceTypeWorkspaceApp",
-                "ResourceTypeTask"
+                "ResourceTypeTask",
+                "ResourceTypeMCPTool"
             ]
         },
         "codersdk.Response": {
@@ -17299,6 +19650,23 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.RetentionConfig": {
+            "type": "object",
+            "properties": {
+                "aibridge_captures": {
+                    "type": "integer"
+                },
+                "audit_logs": {
+                    "type": "integer"
+                },
+                "connection_logs": {
+                    "type": "integer"
+                },
+                "session_recordings": {
+                    "type": "integer"
+                }
+            }
+        },
         "codersdk.Role": {
             "type": "object",
             "properties": {
@@ -17352,6 +19720,19 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.RotateNotificationWebhookSecretRequest": {
+            "type": "object",
+            "properties": {
+                "grace_period_ms": {
+                    "description": "GracePeriodMillis is how long the current secret continues to sign deliveries alongside the new one, so that\nreceivers can be updated without rejecting deliveries. Defaults to 24 hours.",
+                    "type": "integer"
+                },
+                "secret": {
+                    "description": "Secret is the new shared secret. A random secret is generated if it is omitted.",
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.SSHConfig": {
             "type": "object",
             "properties": {
@@ -17582,6 +19963,12 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.TaskStep"
+                    }
+                },
                 "template_display_name": {
                     "type": "string"
                 },
@@ -17634,6 +20021,56 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.TaskEvent": {
+            "type": "object",
+            "properties": {
+                "log": {
+                    "description": "Log is set for log events.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TaskLogEntry"
+                        }
+                    ]
+                },
+                "task": {
+                    "description": "Task is set for status and state events.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.Task"
+                        }
+                    ]
+                },
+                "task_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "type": {
+                    "enum": [
+                        "status",
+                        "state",
+                        "log"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TaskEventType"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.TaskEventType": {
+            "type": "string",
+            "enum": [
+                "status",
+                "state",
+                "log"
+            ],
+            "x-enum-varnames": [
+                "TaskEventTypeStatus",
+                "TaskEventTypeState",
+                "TaskEventTypeLog"
+            ]
+        },
         "codersdk.TaskLogEntry": {
             "type": "object",
             "properties": {
@@ -17703,18 +20140,75 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
-                "state": {
-                    "$ref": "#/definitions/codersdk.TaskState"
+                "state": {
+                    "$ref": "#/definitions/codersdk.TaskState"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "uri": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.TaskStep": {
+            "type": "object",
+            "properties": {
+                "check_exit_code": {
+                    "description": "CheckExitCode is the exit code of the completion check, if it has run.",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "completion_check": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "prompt": {
+                    "type": "string"
                 },
-                "timestamp": {
+                "started_at": {
                     "type": "string",
                     "format": "date-time"
                 },
-                "uri": {
-                    "type": "string"
+                "status": {
+                    "enum": [
+                        "pending",
+                        "running",
+                        "checking",
+                        "completed",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.TaskStepStatus"
+                        }
+                    ]
                 }
             }
         },
+        "codersdk.TaskStepStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "checking",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "TaskStepStatusPending",
+                "TaskStepStatusRunning",
+                "TaskStepStatusChecking",
+                "TaskStepStatusCompleted",
+                "TaskStepStatusFailed"
+            ]
+        },
         "codersdk.TelemetryConfig": {
             "type": "object",
             "properties": {
@@ -17833,6 +20327,10 @@ const docTemplate = `{
                         "terraform"
                     ]
                 },
+                "record_sessions": {
+                    "description": "RecordSessions records the terminal output of SSH and web terminal\nsessions to workspaces created from this template.",
+                    "type": "boolean"
+                },
                 "require_active_version": {
                     "description": "RequireActiveVersion mandates that workspaces are built with the active\ntemplate version.",
                     "type": "boolean"
@@ -18588,6 +21086,17 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateAIBridgeModelPricesRequest": {
+            "type": "object",
+            "properties": {
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AIBridgeModelPrice"
+                    }
+                }
+            }
+        },
         "codersdk.UpdateActiveTemplateVersion": {
             "type": "object",
             "required": [
@@ -18642,6 +21151,25 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateNotificationTemplateOverrides": {
+            "type": "object",
+            "properties": {
+                "body_template": {
+                    "type": "string"
+                },
+                "title_template": {
+                    "type": "string"
+                }
+            }
+        },
+        "codersdk.UpdateNotificationTemplateUrgency": {
+            "type": "object",
+            "properties": {
+                "urgent": {
+                    "type": "boolean"
+                }
+            }
+        },
         "codersdk.UpdateOrganizationRequest": {
             "type": "object",
             "properties": {
@@ -18756,6 +21284,10 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "record_sessions": {
+                    "description": "RecordSessions records the terminal output of SSH and web terminal\nsessions to workspaces created from this template. Recordings can be\nplayed back from the matching connection log.",
+                    "type": "boolean"
+                },
                 "require_active_version": {
                     "description": "RequireActiveVersion mandates workspaces built using this template\nuse the active version of the template. This option has no\neffect on template admins.",
                     "type": "boolean"
@@ -18798,11 +21330,38 @@ const docTemplate = `{
         "codersdk.UpdateUserNotificationPreferences": {
             "type": "object",
             "properties": {
+                "template_digest_map": {
+                    "description": "TemplateDigestMap maps notification template IDs to whether their notifications should be held and\ndelivered as a single digest, rather than individually.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
                 "template_disabled_map": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "boolean"
                     }
+                },
+                "template_target_map": {
+                    "description": "TemplateTargetMap maps notification template IDs to the ID of one of the user's notification targets.\nAn empty target ID clears the target, reverting to the template's or deployment's notification method.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "codersdk.UpdateUserNotificationQuietHoursRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "snooze_until": {
+                    "description": "SnoozeUntil defers all non-urgent notifications until the given time, which must be within 72 hours. Omit it,\nor give a time in the past, to stop snoozing.",
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
@@ -18873,6 +21432,14 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateWorkspaceAutostartExceptionsRequest": {
+            "type": "object",
+            "properties": {
+                "ignore": {
+                    "type": "boolean"
+                }
+            }
+        },
         "codersdk.UpdateWorkspaceAutostartRequest": {
             "type": "object",
             "properties": {
@@ -18898,6 +21465,17 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpdateWorkspaceScheduleWindowsRequest": {
+            "type": "object",
+            "properties": {
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceScheduleWindow"
+                    }
+                }
+            }
+        },
         "codersdk.UpdateWorkspaceTTLRequest": {
             "type": "object",
             "properties": {
@@ -18915,6 +21493,34 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UpsertAIBridgeLimitRequest": {
+            "type": "object",
+            "properties": {
+                "daily_token_budget": {
+                    "type": "integer"
+                },
+                "monthly_token_budget": {
+                    "type": "integer"
+                },
+                "requests_per_minute": {
+                    "type": "integer"
+                }
+            }
+        },
+        "codersdk.UpsertAutostartExceptionCalendarRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AutostartException"
+                    }
+                }
+            }
+        },
         "codersdk.UpsertWorkspaceAgentPortShareRequest": {
             "type": "object",
             "properties": {
@@ -19194,6 +21800,29 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.UserNotificationQuietHours": {
+            "type": "object",
+            "properties": {
+                "duration_ms": {
+                    "description": "DurationMillis is how long quiet hours last from the start of each window.",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "enabled": {
+                    "description": "Enabled defers non-urgent notifications during the user's quiet hours.",
+                    "type": "boolean"
+                },
+                "schedule": {
+                    "description": "Schedule is the start of the user's quiet hours, as a cron expression. It is empty when quiet hours schedules\nare not available, in which case Enabled has no effect.",
+                    "type": "string"
+                },
+                "snooze_until": {
+                    "description": "SnoozeUntil defers all non-urgent notifications until the given time.",
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
         "codersdk.UserParameter": {
             "type": "object",
             "properties": {
@@ -20238,6 +22867,22 @@ const docTemplate = `{
                 "WorkspaceAppStatusStateFailure"
             ]
         },
+        "codersdk.WorkspaceAutostartExceptions": {
+            "type": "object",
+            "properties": {
+                "ignore": {
+                    "description": "Ignore is true if the workspace is autostarted even on the days\nexcluded by its organization's exception calendars.",
+                    "type": "boolean"
+                },
+                "upcoming": {
+                    "description": "Upcoming are the organization's exceptions that have not yet ended.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.AutostartException"
+                    }
+                }
+            }
+        },
         "codersdk.WorkspaceBuild": {
             "type": "object",
             "properties": {
@@ -20519,6 +23164,149 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.WorkspaceLifecycle": {
+            "type": "object",
+            "properties": {
+                "decisions": {
+                    "description": "Decisions made recently, most recent first. Decisions are only kept\nfor a few days.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceLifecycleDecision"
+                    }
+                },
+                "dry_run": {
+                    "description": "DryRun is true if the deployment computes transitions without executing\nthem.",
+                    "type": "boolean"
+                },
+                "upcoming": {
+                    "description": "Upcoming transitions, ordered by time.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceLifecycleTransition"
+                    }
+                }
+            }
+        },
+        "codersdk.WorkspaceLifecycleCheck": {
+            "type": "object",
+            "properties": {
+                "check": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "passed": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "codersdk.WorkspaceLifecycleDecision": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "description": "Checks are the eligibility checks evaluated, in order.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.WorkspaceLifecycleCheck"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "detail": {
+                    "description": "Detail explains why the decision was skipped or failed.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "outcome": {
+                    "enum": [
+                        "transitioned",
+                        "dry_run",
+                        "skipped",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceLifecycleOutcome"
+                        }
+                    ]
+                },
+                "reason": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.BuildReason"
+                        }
+                    ]
+                },
+                "transition": {
+                    "description": "Transition is empty if the workspace was not eligible for a\ntransition, or was only marked dormant.",
+                    "enum": [
+                        "start",
+                        "stop",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceTransition"
+                        }
+                    ]
+                }
+            }
+        },
+        "codersdk.WorkspaceLifecycleOutcome": {
+            "type": "string",
+            "enum": [
+                "transitioned",
+                "dry_run",
+                "skipped",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceLifecycleOutcomeTransitioned",
+                "WorkspaceLifecycleOutcomeDryRun",
+                "WorkspaceLifecycleOutcomeSkipped",
+                "WorkspaceLifecycleOutcomeFailed"
+            ]
+        },
+        "codersdk.WorkspaceLifecycleTransition": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "reason": {
+                    "enum": [
+                        "autostart",
+                        "autostop",
+                        "dormancy",
+                        "autodelete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.BuildReason"
+                        }
+                    ]
+                },
+                "transition": {
+                    "enum": [
+                        "start",
+                        "stop",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/codersdk.WorkspaceTransition"
+                        }
+                    ]
+                }
+            }
+        },
         "codersdk.WorkspaceProxy": {
             "type": "object",
             "properties": {
@@ -20690,6 +23478,19 @@ const docTemplate = `{
                 "WorkspaceRoleDeleted"
             ]
         },
+        "codersdk.WorkspaceScheduleWindow": {
+            "type": "object",
+            "properties": {
+                "schedule": {
+                    "description": "Schedule is expected to be of the form ` + "`" + `CRON_TZ=\u003cIANA Timezone\u003e \u003cmin\u003e \u003chour\u003e * * \u003cdow\u003e` + "`" + `\nExample: ` + "`" + `CRON_TZ=US/Central 30 9 * * 1-5` + "`" + ` represents 0930 in the timezone US/Central\non weekdays (Mon-Fri). ` + "`" + `CRON_TZ` + "`" + ` defaults to UTC if not present.",
+                    "type": "string"
+                },
+                "ttl_ms": {
+                    "description": "TTLMillis is how long workspaces started by the window stay running.\nIf nil, autostop is disabled for them.",
+                    "type": "integer"
+                }
+            }
+        },
         "codersdk.WorkspaceStatus": {
             "type": "string",
             "enum": [
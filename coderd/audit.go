@@ -3,6 +3,7 @@ package coderd
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -101,6 +102,50 @@ func (api *API) auditLogs(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// @Summary Verify audit log hash chain
+// @ID verify-audit-log-hash-chain
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Audit
+// @Success 200 {object} codersdk.AuditLogVerifyResponse
+// @Router /audit/verify [get]
+func (api *API) verifyAuditLogs(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	res, err := audit.VerifyHashChain(ctx, api.Database)
+	if dbauthz.IsNotAuthorizedError(err) {
+		httpapi.Forbidden(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	resp := codersdk.AuditLogVerifyResponse{
+		Verified: res.BrokenLink == nil,
+		Entries:  res.Entries,
+		FirstSeq: res.FirstSeq,
+		LastSeq:  res.LastSeq,
+		LastHash: hex.EncodeToString(res.LastHash),
+		Purged:   make([]codersdk.AuditLogPurgedRange, 0, len(res.Purged)),
+	}
+	for _, purged := range res.Purged {
+		resp.Purged = append(resp.Purged, codersdk.AuditLogPurgedRange{
+			FirstSeq: purged.FirstSeq,
+			LastSeq:  purged.LastSeq,
+		})
+	}
+	if res.BrokenLink != nil {
+		resp.BrokenLink = &codersdk.AuditLogBrokenLink{
+			Seq:        res.BrokenLink.Seq,
+			AuditLogID: res.BrokenLink.AuditLogID,
+			Reason:     res.BrokenLink.Reason,
+		}
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
 // @Summary Generate fake audit log
 // @ID generate-fake-audit-log
 // @Security CoderSessionToken
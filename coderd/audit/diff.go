@@ -11,15 +11,16 @@ import (
 type Auditable interface {
 	database.APIKey |
 		database.Template |
-		database.TemplateVersion |
+		database.AuditableTemplateVersion |
 		database.User |
 		database.WorkspaceTable |
 		database.GitSSHKey |
-		database.WorkspaceBuild |
+		database.AuditableWorkspaceBuild |
 		database.AuditableGroup |
 		database.License |
 		database.WorkspaceProxy |
 		database.AuditOAuthConvertState |
+		database.AuditMCPToolCall |
 		database.HealthSettings |
 		database.NotificationsSettings |
 		database.OAuth2ProviderApp |
@@ -0,0 +1,270 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+// hashChainPageSize is the number of hash chain entries read at a time when
+// verifying the chain.
+const hashChainPageSize = 1000
+
+// hashedAuditLog is the canonical representation of an audit log that is
+// hashed. Fields must never be reordered or removed, or existing chains will
+// fail to verify.
+type hashedAuditLog struct {
+	ID               uuid.UUID       `json:"id"`
+	Time             string          `json:"time"`
+	UserID           uuid.UUID       `json:"user_id"`
+	OrganizationID   uuid.UUID       `json:"organization_id"`
+	IP               string          `json:"ip"`
+	UserAgent        string          `json:"user_agent"`
+	ResourceType     string          `json:"resource_type"`
+	ResourceID       uuid.UUID       `json:"resource_id"`
+	ResourceTarget   string          `json:"resource_target"`
+	Action           string          `json:"action"`
+	Diff             json.RawMessage `json:"diff"`
+	StatusCode       int32           `json:"status_code"`
+	AdditionalFields json.RawMessage `json:"additional_fields"`
+	RequestID        uuid.UUID       `json:"request_id"`
+	ResourceIcon     string          `json:"resource_icon"`
+}
+
+// HashAuditLog returns the hash of an audit log at position seq in the hash
+// chain, where prev is the hash of the entry before it. The audit log should
+// be the row as stored in the database, so that the hash can be recomputed
+// from the same row later on.
+func HashAuditLog(prev []byte, seq int64, alog database.AuditLog) []byte {
+	hashed := hashedAuditLog{
+		ID:               alog.ID,
+		Time:             alog.Time.UTC().Format(time.RFC3339Nano),
+		UserID:           alog.UserID,
+		OrganizationID:   alog.OrganizationID,
+		UserAgent:        alog.UserAgent.String,
+		ResourceType:     string(alog.ResourceType),
+		ResourceID:       alog.ResourceID,
+		ResourceTarget:   alog.ResourceTarget,
+		Action:           string(alog.Action),
+		Diff:             compactJSON(alog.Diff),
+		StatusCode:       alog.StatusCode,
+		AdditionalFields: compactJSON(alog.AdditionalFields),
+		RequestID:        alog.RequestID,
+		ResourceIcon:     alog.ResourceIcon,
+	}
+	if alog.Ip.Valid {
+		hashed.IP = alog.Ip.IPNet.String()
+	}
+	// Marshaling cannot fail: every field is either a primitive or valid
+	// JSON.
+	content, _ := json.Marshal(hashed)
+
+	h := sha256.New()
+	_, _ = h.Write(prev)
+	_ = binary.Write(h, binary.BigEndian, seq)
+	_, _ = h.Write(content)
+	return h.Sum(nil)
+}
+
+func compactJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		// Invalid JSON is hashed as a string so that it is still covered by
+		// the hash.
+		b, _ := json.Marshal(string(raw))
+		return b
+	}
+	return buf.Bytes()
+}
+
+// HashChainVerification is the result of walking the audit log hash chain.
+type HashChainVerification struct {
+	// Entries is the number of entries that were verified, including the
+	// broken one, if any.
+	Entries  int64
+	FirstSeq int64
+	LastSeq  int64
+	// LastHash is the hash of the last entry that was verified successfully.
+	LastHash []byte
+	// BrokenLink is the first entry that failed verification, or nil if the
+	// chain is intact.
+	BrokenLink *HashChainBrokenLink
+	// Purged lists the entries whose audit logs were purged, which were
+	// verified from their checkpoints rather than their audit logs. Anyone
+	// with access to the database can replace an entry with a checkpoint, so
+	// purges that retention does not account for should be investigated.
+	Purged []HashChainRange
+}
+
+// HashChainRange is a range of positions in the hash chain, inclusive.
+type HashChainRange struct {
+	FirstSeq int64
+	LastSeq  int64
+}
+
+type HashChainBrokenLink struct {
+	Seq        int64
+	AuditLogID uuid.UUID
+	Reason     string
+}
+
+// hashChainLink is the part of a hash chain entry or checkpoint that links it
+// to the entry before it.
+type hashChainLink struct {
+	Seq          int64
+	PreviousHash []byte
+	Hash         []byte
+}
+
+// VerifyHashChain walks the audit log hash chain from the oldest entry and
+// stops at the first broken link. Entries whose audit logs have been purged
+// by retention are covered by checkpoints, which the chain may also start
+// from.
+func VerifyHashChain(ctx context.Context, db database.Store) (HashChainVerification, error) {
+	var (
+		res      HashChainVerification
+		prev     *hashChainLink
+		afterSeq int64
+	)
+	for {
+		entries, err := db.GetAuditLogHashChainEntries(ctx, database.GetAuditLogHashChainEntriesParams{
+			AfterSeq:   afterSeq,
+			LimitCount: hashChainPageSize,
+		})
+		if err != nil {
+			return HashChainVerification{}, xerrors.Errorf("get hash chain entries: %w", err)
+		}
+		if len(entries) == 0 {
+			return res, nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.AuditLogID)
+		}
+		alogs, err := db.GetAuditLogsByIDs(ctx, ids)
+		if err != nil {
+			return HashChainVerification{}, xerrors.Errorf("get audit logs: %w", err)
+		}
+		byID := make(map[uuid.UUID]database.AuditLog, len(alogs))
+		for _, alog := range alogs {
+			byID[alog.ID] = alog
+		}
+
+		for _, entry := range entries {
+			res.Entries++
+			if res.FirstSeq == 0 {
+				res.FirstSeq = entry.Seq
+			}
+			res.LastSeq = entry.Seq
+
+			var prevSeq int64
+			if prev != nil {
+				prevSeq = prev.Seq
+			}
+			reason := ""
+			if entry.Seq != prevSeq+1 {
+				// Fill the gap with the checkpoints of purged entries.
+				var purged *HashChainRange
+				prev, purged, reason, err = walkHashChainCheckpoints(ctx, db, prev, entry.Seq)
+				if err != nil {
+					return HashChainVerification{}, err
+				}
+				if purged != nil && reason == "" {
+					res.Purged = append(res.Purged, *purged)
+				}
+			}
+			if reason == "" {
+				reason = verifyHashChainEntry(prev, entry, byID)
+			}
+			if reason != "" {
+				res.BrokenLink = &HashChainBrokenLink{
+					Seq:        entry.Seq,
+					AuditLogID: entry.AuditLogID,
+					Reason:     reason,
+				}
+				return res, nil
+			}
+			res.LastHash = entry.Hash
+			prev = &hashChainLink{Seq: entry.Seq, PreviousHash: entry.PreviousHash, Hash: entry.Hash}
+		}
+		afterSeq = entries[len(entries)-1].Seq
+	}
+}
+
+// walkHashChainCheckpoints verifies the checkpoints between prev and the entry
+// at seq, and returns the last of them along with the range they cover. If prev
+// is nil, the chain starts from the first checkpoint, since older ones are
+// deleted once they are no longer needed.
+func walkHashChainCheckpoints(ctx context.Context, db database.Store, prev *hashChainLink, seq int64) (*hashChainLink, *HashChainRange, string, error) {
+	var afterSeq int64
+	if prev != nil {
+		afterSeq = prev.Seq
+	}
+	checkpoints, err := db.GetAuditLogHashChainCheckpoints(ctx, database.GetAuditLogHashChainCheckpointsParams{
+		AfterSeq:  afterSeq,
+		BeforeSeq: seq,
+	})
+	if err != nil {
+		return nil, nil, "", xerrors.Errorf("get hash chain checkpoints: %w", err)
+	}
+	if len(checkpoints) == 0 {
+		return prev, nil, "", nil
+	}
+	for _, checkpoint := range checkpoints {
+		link := &hashChainLink{Seq: checkpoint.Seq, PreviousHash: checkpoint.PreviousHash, Hash: checkpoint.Hash}
+		if prev != nil {
+			if reason := verifyHashChainLink(prev, *link); reason != "" {
+				return nil, nil, fmt.Sprintf("purged entry %d: %s", checkpoint.Seq, reason), nil
+			}
+		}
+		prev = link
+	}
+	return prev, &HashChainRange{FirstSeq: checkpoints[0].Seq, LastSeq: prev.Seq}, "", nil
+}
+
+// verifyHashChainLink returns the reason the link does not follow prev, or an
+// empty string if it does.
+func verifyHashChainLink(prev *hashChainLink, link hashChainLink) string {
+	switch {
+	case prev == nil && link.Seq != 1:
+		return fmt.Sprintf("hash chain entries 1 to %d are missing", link.Seq-1)
+	case prev != nil && link.Seq != prev.Seq+1:
+		return fmt.Sprintf("hash chain entries %d to %d are missing", prev.Seq+1, link.Seq-1)
+	case prev != nil && !bytes.Equal(link.PreviousHash, prev.Hash):
+		return "previous hash does not match the hash of the previous entry"
+	case link.Seq == 1 && len(link.PreviousHash) != 0:
+		return "first entry has a previous hash"
+	}
+	return ""
+}
+
+// verifyHashChainEntry returns the reason the entry breaks the chain, or an
+// empty string if it is intact.
+func verifyHashChainEntry(prev *hashChainLink, entry database.AuditLogHashChain, alogs map[uuid.UUID]database.AuditLog) string {
+	reason := verifyHashChainLink(prev, hashChainLink{Seq: entry.Seq, PreviousHash: entry.PreviousHash, Hash: entry.Hash})
+	if reason != "" {
+		return reason
+	}
+
+	alog, ok := alogs[entry.AuditLogID]
+	if !ok {
+		return "audit log has been deleted"
+	}
+	if !bytes.Equal(HashAuditLog(entry.PreviousHash, entry.Seq, alog), entry.Hash) {
+		return "audit log has been modified"
+	}
+	return ""
+}
@@ -0,0 +1,258 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbmock"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestHashAuditLog(t *testing.T) {
+	t.Parallel()
+
+	alog := hashChainAuditLog()
+	hash := audit.HashAuditLog(nil, 1, alog)
+	require.Len(t, hash, 32)
+
+	// Formatting of JSON columns does not affect the hash, since Postgres may
+	// normalize them.
+	reformatted := alog
+	reformatted.Diff = json.RawMessage("{\n  \"name\": {\"old\": \"a\", \"new\": \"b\"}\n}")
+	require.Equal(t, hash, audit.HashAuditLog(nil, 1, reformatted))
+
+	// The position in the chain and the previous hash are covered.
+	require.NotEqual(t, hash, audit.HashAuditLog(nil, 2, alog))
+	require.NotEqual(t, hash, audit.HashAuditLog([]byte{1}, 1, alog))
+
+	modified := alog
+	modified.StatusCode = 500
+	require.NotEqual(t, hash, audit.HashAuditLog(nil, 1, modified))
+}
+
+func TestVerifyHashChain(t *testing.T) {
+	t.Parallel()
+
+	// buildChain returns a valid chain of n entries starting at seq.
+	buildChain := func(seq int64, n int) ([]database.AuditLogHashChain, []database.AuditLog) {
+		var (
+			entries []database.AuditLogHashChain
+			alogs   []database.AuditLog
+			prev    = []byte{}
+		)
+		if seq > 1 {
+			prev = []byte("purged")
+		}
+		for i := range n {
+			alog := hashChainAuditLog()
+			s := seq + int64(i)
+			hash := audit.HashAuditLog(prev, s, alog)
+			entries = append(entries, database.AuditLogHashChain{
+				Seq:          s,
+				AuditLogID:   alog.ID,
+				PreviousHash: prev,
+				Hash:         hash,
+			})
+			alogs = append(alogs, alog)
+			prev = hash
+		}
+		return entries, alogs
+	}
+
+	// checkpoint returns the checkpoint left behind when the entry is purged.
+	checkpoint := func(entry database.AuditLogHashChain) database.AuditLogHashChainCheckpoint {
+		return database.AuditLogHashChainCheckpoint{
+			Seq:          entry.Seq,
+			PreviousHash: entry.PreviousHash,
+			Hash:         entry.Hash,
+		}
+	}
+
+	verify := func(t *testing.T, entries []database.AuditLogHashChain, alogs []database.AuditLog, checkpoints ...database.AuditLogHashChainCheckpoint) audit.HashChainVerification {
+		t.Helper()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		db := dbmock.NewMockStore(gomock.NewController(t))
+		db.EXPECT().GetAuditLogHashChainCheckpoints(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, arg database.GetAuditLogHashChainCheckpointsParams) ([]database.AuditLogHashChainCheckpoint, error) {
+				var res []database.AuditLogHashChainCheckpoint
+				for _, c := range checkpoints {
+					if c.Seq > arg.AfterSeq && c.Seq < arg.BeforeSeq {
+						res = append(res, c)
+					}
+				}
+				return res, nil
+			}).AnyTimes()
+		db.EXPECT().GetAuditLogHashChainEntries(gomock.Any(), database.GetAuditLogHashChainEntriesParams{
+			AfterSeq:   0,
+			LimitCount: 1000,
+		}).Return(entries, nil)
+		db.EXPECT().GetAuditLogsByIDs(gomock.Any(), gomock.Any()).Return(alogs, nil)
+		if len(entries) > 0 {
+			db.EXPECT().GetAuditLogHashChainEntries(gomock.Any(), database.GetAuditLogHashChainEntriesParams{
+				AfterSeq:   entries[len(entries)-1].Seq,
+				LimitCount: 1000,
+			}).Return(nil, nil).MaxTimes(1)
+		}
+
+		res, err := audit.VerifyHashChain(ctx, db)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("Intact", func(t *testing.T) {
+		t.Parallel()
+
+		entries, alogs := buildChain(1, 3)
+		res := verify(t, entries, alogs)
+		require.Nil(t, res.BrokenLink)
+		require.EqualValues(t, 3, res.Entries)
+		require.EqualValues(t, 1, res.FirstSeq)
+		require.EqualValues(t, 3, res.LastSeq)
+		require.Equal(t, entries[2].Hash, res.LastHash)
+		require.Empty(t, res.Purged)
+	})
+
+	t.Run("Purged", func(t *testing.T) {
+		t.Parallel()
+
+		// Older entries have been removed by retention, leaving a checkpoint
+		// behind.
+		entries, alogs := buildChain(10, 2)
+		res := verify(t, entries, alogs, database.AuditLogHashChainCheckpoint{
+			Seq:          9,
+			PreviousHash: []byte("older"),
+			Hash:         []byte("purged"),
+		})
+		require.Nil(t, res.BrokenLink)
+		require.EqualValues(t, 10, res.FirstSeq)
+		require.EqualValues(t, 2, res.Entries)
+		require.Equal(t, []audit.HashChainRange{{FirstSeq: 9, LastSeq: 9}}, res.Purged)
+	})
+
+	t.Run("PurgedWithoutCheckpoint", func(t *testing.T) {
+		t.Parallel()
+
+		entries, alogs := buildChain(10, 2)
+		res := verify(t, entries, alogs)
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 10, res.BrokenLink.Seq)
+		require.Contains(t, res.BrokenLink.Reason, "missing")
+	})
+
+	t.Run("PurgedOutOfOrder", func(t *testing.T) {
+		t.Parallel()
+
+		// The audit log of the second entry was older than the others, so
+		// only its entry was purged.
+		entries, alogs := buildChain(1, 3)
+		res := verify(t, append(entries[:1:1], entries[2]), append(alogs[:1:1], alogs[2]), checkpoint(entries[1]))
+		require.Nil(t, res.BrokenLink)
+		require.EqualValues(t, 2, res.Entries)
+		require.EqualValues(t, 1, res.FirstSeq)
+		require.EqualValues(t, 3, res.LastSeq)
+		// The purge in the middle of the chain is reported, so that an audit
+		// log deleted and replaced by a checkpoint cannot go unnoticed.
+		require.Equal(t, []audit.HashChainRange{{FirstSeq: 2, LastSeq: 2}}, res.Purged)
+	})
+
+	t.Run("ForgedCheckpoint", func(t *testing.T) {
+		t.Parallel()
+
+		// An entry was deleted and replaced by a checkpoint that does not
+		// link to the entry before it.
+		entries, alogs := buildChain(1, 3)
+		forged := checkpoint(entries[1])
+		forged.PreviousHash = []byte("forged")
+		res := verify(t, append(entries[:1:1], entries[2]), append(alogs[:1:1], alogs[2]), forged)
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 3, res.BrokenLink.Seq)
+		require.Contains(t, res.BrokenLink.Reason, "purged entry 2")
+		require.Empty(t, res.Purged)
+	})
+
+	t.Run("Modified", func(t *testing.T) {
+		t.Parallel()
+
+		entries, alogs := buildChain(1, 3)
+		alogs[1].ResourceTarget = "something-else"
+		res := verify(t, entries, alogs)
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 2, res.BrokenLink.Seq)
+		require.Equal(t, alogs[1].ID, res.BrokenLink.AuditLogID)
+		require.Contains(t, res.BrokenLink.Reason, "modified")
+		require.Equal(t, entries[0].Hash, res.LastHash)
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		t.Parallel()
+
+		entries, alogs := buildChain(1, 3)
+		res := verify(t, entries, append(alogs[:1], alogs[2]))
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 2, res.BrokenLink.Seq)
+		require.Contains(t, res.BrokenLink.Reason, "deleted")
+	})
+
+	t.Run("MissingEntry", func(t *testing.T) {
+		t.Parallel()
+
+		entries, alogs := buildChain(1, 3)
+		res := verify(t, append(entries[:1], entries[2]), alogs)
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 3, res.BrokenLink.Seq)
+		require.Contains(t, res.BrokenLink.Reason, "missing")
+	})
+
+	t.Run("Rehashed", func(t *testing.T) {
+		t.Parallel()
+
+		// An audit log was modified and its own hash recomputed, but the
+		// next entry still refers to the original hash.
+		entries, alogs := buildChain(1, 3)
+		alogs[1].ResourceTarget = "something-else"
+		entries[1].Hash = audit.HashAuditLog(entries[1].PreviousHash, 2, alogs[1])
+		res := verify(t, entries, alogs)
+		require.NotNil(t, res.BrokenLink)
+		require.EqualValues(t, 3, res.BrokenLink.Seq)
+		require.Contains(t, res.BrokenLink.Reason, "previous hash")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		db := dbmock.NewMockStore(gomock.NewController(t))
+		db.EXPECT().GetAuditLogHashChainEntries(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		res, err := audit.VerifyHashChain(ctx, db)
+		require.NoError(t, err)
+		require.Nil(t, res.BrokenLink)
+		require.Zero(t, res.Entries)
+	})
+}
+
+func hashChainAuditLog() database.AuditLog {
+	return database.AuditLog{
+		ID:               uuid.New(),
+		Time:             time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC),
+		UserID:           uuid.New(),
+		OrganizationID:   uuid.New(),
+		ResourceType:     database.ResourceTypeTemplate,
+		ResourceID:       uuid.New(),
+		ResourceTarget:   "my-template",
+		Action:           database.AuditActionWrite,
+		Diff:             json.RawMessage(`{"name":{"old":"a","new":"b"}}`),
+		StatusCode:       200,
+		AdditionalFields: json.RawMessage(`{}`),
+		RequestID:        uuid.New(),
+	}
+}
@@ -81,13 +81,13 @@ func ResourceTarget[T Auditable](tgt T) string {
 	switch typed := any(tgt).(type) {
 	case database.Template:
 		return typed.Name
-	case database.TemplateVersion:
+	case database.AuditableTemplateVersion:
 		return typed.Name
 	case database.User:
 		return typed.Username
 	case database.WorkspaceTable:
 		return typed.Name
-	case database.WorkspaceBuild:
+	case database.AuditableWorkspaceBuild:
 		// this isn't used
 		return ""
 	case database.GitSSHKey:
@@ -107,6 +107,8 @@ func ResourceTarget[T Auditable](tgt T) string {
 		return typed.Name
 	case database.AuditOAuthConvertState:
 		return string(typed.ToLoginType)
+	case database.AuditMCPToolCall:
+		return typed.ToolName
 	case database.HealthSettings:
 		return "" // no target?
 	case database.NotificationsSettings:
@@ -147,13 +149,13 @@ func ResourceID[T Auditable](tgt T) uuid.UUID {
 	switch typed := any(tgt).(type) {
 	case database.Template:
 		return typed.ID
-	case database.TemplateVersion:
+	case database.AuditableTemplateVersion:
 		return typed.ID
 	case database.User:
 		return typed.ID
 	case database.WorkspaceTable:
 		return typed.ID
-	case database.WorkspaceBuild:
+	case database.AuditableWorkspaceBuild:
 		return typed.ID
 	case database.GitSSHKey:
 		return typed.UserID
@@ -168,6 +170,9 @@ func ResourceID[T Auditable](tgt T) uuid.UUID {
 	case database.AuditOAuthConvertState:
 		// The merge state is for the given user
 		return typed.UserID
+	case database.AuditMCPToolCall:
+		// The tool is invoked on behalf of the given user
+		return typed.UserID
 	case database.HealthSettings:
 		// Artificial ID for auditing purposes
 		return typed.ID
@@ -206,13 +211,13 @@ func ResourceType[T Auditable](tgt T) database.ResourceType {
 	switch typed := any(tgt).(type) {
 	case database.Template:
 		return database.ResourceTypeTemplate
-	case database.TemplateVersion:
+	case database.AuditableTemplateVersion:
 		return database.ResourceTypeTemplateVersion
 	case database.User:
 		return database.ResourceTypeUser
 	case database.WorkspaceTable:
 		return database.ResourceTypeWorkspace
-	case database.WorkspaceBuild:
+	case database.AuditableWorkspaceBuild:
 		return database.ResourceTypeWorkspaceBuild
 	case database.GitSSHKey:
 		return database.ResourceTypeGitSshKey
@@ -226,6 +231,8 @@ func ResourceType[T Auditable](tgt T) database.ResourceType {
 		return database.ResourceTypeWorkspaceProxy
 	case database.AuditOAuthConvertState:
 		return database.ResourceTypeConvertLogin
+	case database.AuditMCPToolCall:
+		return database.ResourceTypeMcpTool
 	case database.HealthSettings:
 		return database.ResourceTypeHealthSettings
 	case database.NotificationsSettings:
@@ -262,9 +269,9 @@ func ResourceType[T Auditable](tgt T) database.ResourceType {
 func ResourceRequiresOrgID[T Auditable]() bool {
 	var tgt T
 	switch any(tgt).(type) {
-	case database.Template, database.TemplateVersion:
+	case database.Template, database.AuditableTemplateVersion:
 		return true
-	case database.WorkspaceTable, database.WorkspaceBuild:
+	case database.WorkspaceTable, database.AuditableWorkspaceBuild:
 		return true
 	case database.AuditableGroup:
 		return true
@@ -281,6 +288,8 @@ func ResourceRequiresOrgID[T Auditable]() bool {
 	case database.AuditOAuthConvertState:
 		// The merge state is for the given user
 		return false
+	case database.AuditMCPToolCall:
+		return false
 	case database.HealthSettings:
 		// Artificial ID for auditing purposes
 		return false
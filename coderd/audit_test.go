@@ -2,8 +2,10 @@ package coderd_test
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/provisioner/echo"
 	"github.com/coder/coder/v2/provisionersdk/proto"
+	"github.com/coder/coder/v2/testutil"
 )
 
 func TestAuditLogs(t *testing.T) {
@@ -641,3 +644,52 @@ func TestDeprecatedConnEvents(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, appFields, appOutFields)
 }
+
+func TestVerifyAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	user := coderdtest.CreateFirstUser(t, client)
+	memberClient, _ := coderdtest.CreateAnotherUser(t, client, user.OrganizationID)
+
+	appendEntry := func(seq int64, prev []byte, alog database.AuditLog) []byte {
+		hash := audit.HashAuditLog(prev, seq, alog)
+		err := db.InsertAuditLogHashChainEntry(ctx, database.InsertAuditLogHashChainEntryParams{
+			Seq:          seq,
+			AuditLogID:   alog.ID,
+			PreviousHash: prev,
+			Hash:         hash,
+		})
+		require.NoError(t, err)
+		return hash
+	}
+
+	hash := appendEntry(1, []byte{}, dbgen.AuditLog(t, db, database.AuditLog{}))
+	hash = appendEntry(2, hash, dbgen.AuditLog(t, db, database.AuditLog{}))
+
+	res, err := client.VerifyAuditLogs(ctx)
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+	require.EqualValues(t, 2, res.Entries)
+	require.Equal(t, hex.EncodeToString(hash), res.LastHash)
+	require.Nil(t, res.BrokenLink)
+	require.Empty(t, res.Purged)
+
+	// An entry whose audit log no longer exists breaks the chain.
+	deletedID := uuid.New()
+	appendEntry(3, hash, database.AuditLog{ID: deletedID})
+
+	res, err = client.VerifyAuditLogs(ctx)
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	require.NotNil(t, res.BrokenLink)
+	require.EqualValues(t, 3, res.BrokenLink.Seq)
+	require.Equal(t, deletedID, res.BrokenLink.AuditLogID)
+
+	// Members cannot read audit logs.
+	_, err = memberClient.VerifyAuditLogs(ctx)
+	var sdkErr *codersdk.Error
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, http.StatusForbidden, sdkErr.StatusCode())
+}
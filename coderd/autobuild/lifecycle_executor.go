@@ -3,6 +3,7 @@ package autobuild
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"slices"
@@ -52,6 +53,10 @@ type Executor struct {
 	notificationsEnqueuer notifications.Enqueuer
 	reg                   prometheus.Registerer
 	experiments           codersdk.Experiments
+	taskPolicy            codersdk.AITasksConfig
+	// dryRun causes transitions to be computed and recorded without
+	// building any workspaces.
+	dryRun bool
 
 	metrics executorMetrics
 }
@@ -60,7 +65,8 @@ type executorMetrics struct {
 	autobuildExecutionDuration prometheus.Histogram
 }
 
-// Stats contains information about one run of Executor.
+// Stats contains information about one run of Executor. Transitions only
+// holds transitions which were executed, so it is empty in dry-run mode.
 type Stats struct {
 	Transitions map[uuid.UUID]database.WorkspaceTransition
 	Elapsed     time.Duration
@@ -105,6 +111,13 @@ func (e *Executor) WithStatsChannel(ch chan<- Stats) *Executor {
 	return e
 }
 
+// WithDryRun will cause Executor to compute and record the transitions of
+// workspaces without building them or marking them dormant.
+func (e *Executor) WithDryRun(dryRun bool) *Executor {
+	e.dryRun = dryRun
+	return e
+}
+
 // Run will cause executor to start or stop workspaces on every
 // tick from its channel. It will stop when its context is Done, or when
 // its channel is closed.
@@ -235,10 +248,12 @@ func (e *Executor) runOnce(t time.Time) Stats {
 					ws                    database.Workspace
 					tmpl                  database.Template
 					didAutoUpdate         bool
+					decision              *lifecycleDecision
 				)
 				err := e.db.InTx(func(tx database.Store) error {
 					var err error
 
+					decision = nil
 					ok, err := tx.TryAcquireLock(e.ctx, database.GenLockID(fmt.Sprintf("lifecycle-executor:%s", wsID)))
 					if err != nil {
 						return xerrors.Errorf("try acquire lifecycle executor lock: %w", err)
@@ -247,6 +262,8 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						log.Debug(e.ctx, "unable to acquire lock for workspace, skipping")
 						return nil
 					}
+					// Only the replica holding the lock records a decision.
+					decision = &lifecycleDecision{Outcome: database.WorkspaceLifecycleOutcomeSkipped}
 
 					// Re-check eligibility since the first check was outside the
 					// transaction and the workspace settings may have changed.
@@ -276,9 +293,24 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						return xerrors.Errorf("get template scheduling options: %w", err)
 					}
 
-					// If next start at is not valid we need to re-compute it
-					if !ws.NextStartAt.Valid && ws.AutostartSchedule.Valid {
-						next, err := schedule.NextAllowedAutostart(currentTick, ws.AutostartSchedule.String, templateSchedule)
+					windows, err := schedule.GetWorkspaceWindows(e.ctx, tx, ws.WorkspaceTable())
+					if err != nil {
+						return xerrors.Errorf("get workspace schedule windows: %w", err)
+					}
+
+					// Holidays and shutdowns from the organization's exception
+					// calendars are skipped by autostart.
+					if windows.HasAutostart() {
+						templateSchedule.AutostartExceptions, err = schedule.GetAutostartExceptions(e.ctx, tx, ws.OrganizationID, ws.ID)
+						if err != nil {
+							return xerrors.Errorf("get autostart exceptions: %w", err)
+						}
+					}
+
+					// If next start at is not valid, or falls on an exception
+					// that was added after it was computed, we need to re-compute it
+					if windows.HasAutostart() && (!ws.NextStartAt.Valid || windows.Excepted(ws.NextStartAt.Time, templateSchedule.AutostartExceptions)) {
+						next, _, err := windows.NextAllowedAutostart(currentTick, templateSchedule)
 						if err == nil {
 							nextStartAt := sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
 							if err = tx.UpdateWorkspaceNextStartAt(e.ctx, database.UpdateWorkspaceNextStartAtParams{
@@ -305,9 +337,13 @@ func (e *Executor) runOnce(t time.Time) Stats {
 
 					accessControl := (*(e.accessControlStore.Load())).GetTemplateAccessControl(tmpl)
 
-					nextTransition, reason, err := getNextTransition(user, ws, latestBuild, latestJob, templateSchedule, currentTick)
+					nextTransition, reason, checks, err := getNextTransition(user, ws, windows, latestBuild, latestJob, templateSchedule, currentTick)
+					decision.Transition = nextTransition
+					decision.Reason = reason
+					decision.Checks = checks
 					if err != nil {
 						log.Debug(e.ctx, "skipping workspace", slog.Error(err))
+						decision.Detail = err.Error()
 						// err is used to indicate that a workspace is not eligible
 						// so returning nil here is ok although ultimately the distinction
 						// doesn't matter since the transaction is  read-only up to
@@ -328,9 +364,24 @@ func (e *Executor) runOnce(t time.Time) Stats {
 					}
 					if !hasProvisioners {
 						log.Warn(e.ctx, "skipping autostart - no available provisioners")
+						decision.Checks = append(decision.Checks, codersdk.WorkspaceLifecycleCheck{
+							Check:  checkProvisioners,
+							Passed: false,
+							Detail: "no provisioner matching the template version's tags has been seen recently",
+						})
+						decision.Detail = "no available provisioners"
 						return nil // Skip this workspace
 					}
 
+					if e.dryRun {
+						log.Info(e.ctx, "dry run, not transitioning workspace",
+							slog.F("transition", nextTransition),
+							slog.F("reason", reason),
+						)
+						decision.Outcome = database.WorkspaceLifecycleOutcomeDryRun
+						return nil
+					}
+
 					if nextTransition != "" {
 						builder := wsbuilder.New(ws, nextTransition, *e.buildUsageChecker.Load()).
 							SetLastWorkspaceBuildInTx(&latestBuild).
@@ -394,6 +445,7 @@ func (e *Executor) runOnce(t time.Time) Stats {
 						)
 					}
 
+					decision.Outcome = database.WorkspaceLifecycleOutcomeTransitioned
 					if nextTransition == "" {
 						return nil
 					}
@@ -415,6 +467,13 @@ func (e *Executor) runOnce(t time.Time) Stats {
 					Isolation:    sql.LevelRepeatableRead,
 					TxIdentifier: "lifecycle",
 				})
+				if decision != nil && !xerrors.Is(err, context.Canceled) {
+					if err != nil {
+						decision.Outcome = database.WorkspaceLifecycleOutcomeFailed
+						decision.Detail = err.Error()
+					}
+					e.recordDecision(log, wsID, *decision)
+				}
 				if auditLog != nil {
 					// If the transition didn't succeed then updating the workspace
 					// to indicate dormant didn't either.
@@ -500,18 +559,35 @@ func (e *Executor) runOnce(t time.Time) Stats {
 		e.log.Error(e.ctx, "workspace scheduling errgroup failed", slog.Error(err))
 	}
 
+	e.runTaskLifecyclePolicies(t, &stats, &statsMu)
+
 	return stats
 }
 
+// Names of the eligibility checks recorded in workspace lifecycle decisions.
+const (
+	checkAutostop     = "autostop"
+	checkAutostart    = "autostart"
+	checkFailedStop   = "failed_stop"
+	checkDormancy     = "dormancy"
+	checkAutodelete   = "autodelete"
+	checkProvisioners = "provisioners"
+	checkTaskPolicy   = "task_policy"
+)
+
 // getNextTransition returns the next eligible transition for the workspace
 // as well as the reason for why it is transitioning. It is possible
 // for this function to return a nil error as well as an empty transition.
 // In such cases it means no provisioning should occur but the workspace
 // may be "transitioning" to a new state (such as an inactive, stopped
 // workspace transitioning to the dormant state).
+//
+// The eligibility checks evaluated to reach the decision are returned in
+// order, including when no transition is eligible.
 func getNextTransition(
 	user database.User,
 	ws database.Workspace,
+	windows schedule.Windows,
 	latestBuild database.WorkspaceBuild,
 	latestJob database.ProvisionerJob,
 	templateSchedule schedule.TemplateScheduleOptions,
@@ -519,132 +595,266 @@ func getNextTransition(
 ) (
 	database.WorkspaceTransition,
 	database.BuildReason,
+	[]codersdk.WorkspaceLifecycleCheck,
 	error,
 ) {
+	var checks []codersdk.WorkspaceLifecycleCheck
+	check := func(name string) func(bool, string) bool {
+		return func(passed bool, detail string) bool {
+			checks = append(checks, codersdk.WorkspaceLifecycleCheck{
+				Check:  name,
+				Passed: passed,
+				Detail: detail,
+			})
+			return passed
+		}
+	}
+
 	switch {
-	case isEligibleForAutostop(user, ws, latestBuild, latestJob, currentTick):
-		return database.WorkspaceTransitionStop, database.BuildReasonAutostop, nil
-	case isEligibleForAutostart(user, ws, latestBuild, latestJob, templateSchedule, currentTick):
-		return database.WorkspaceTransitionStart, database.BuildReasonAutostart, nil
-	case isEligibleForFailedStop(latestBuild, latestJob, templateSchedule, currentTick):
-		return database.WorkspaceTransitionStop, database.BuildReasonAutostop, nil
-	case isEligibleForDormantStop(ws, templateSchedule, currentTick):
+	case check(checkAutostop)(isEligibleForAutostop(user, ws, latestBuild, latestJob, currentTick)):
+		return database.WorkspaceTransitionStop, database.BuildReasonAutostop, checks, nil
+	case check(checkAutostart)(isEligibleForAutostart(user, ws, windows, latestBuild, latestJob, templateSchedule, currentTick)):
+		return database.WorkspaceTransitionStart, database.BuildReasonAutostart, checks, nil
+	case check(checkFailedStop)(isEligibleForFailedStop(latestBuild, latestJob, templateSchedule, currentTick)):
+		return database.WorkspaceTransitionStop, database.BuildReasonAutostop, checks, nil
+	case check(checkDormancy)(isEligibleForDormantStop(ws, templateSchedule, currentTick)):
 		// Only stop started workspaces.
 		if latestBuild.Transition == database.WorkspaceTransitionStart {
-			return database.WorkspaceTransitionStop, database.BuildReasonDormancy, nil
+			return database.WorkspaceTransitionStop, database.BuildReasonDormancy, checks, nil
 		}
 		// We shouldn't transition the workspace but we should still
 		// make it dormant.
-		return "", database.BuildReasonDormancy, nil
+		return "", database.BuildReasonDormancy, checks, nil
 
-	case isEligibleForDelete(ws, templateSchedule, latestBuild, latestJob, currentTick):
-		return database.WorkspaceTransitionDelete, database.BuildReasonAutodelete, nil
+	case check(checkAutodelete)(isEligibleForDelete(ws, templateSchedule, latestBuild, latestJob, currentTick)):
+		return database.WorkspaceTransitionDelete, database.BuildReasonAutodelete, checks, nil
 	default:
-		return "", "", xerrors.Errorf("last transition not valid for autostart or autostop")
+		return "", "", checks, xerrors.Errorf("last transition not valid for autostart or autostop")
 	}
 }
 
-// isEligibleForAutostart returns true if the workspace should be autostarted.
-func isEligibleForAutostart(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) bool {
+// isEligibleForAutostart returns true if the workspace should be autostarted,
+// and why or why not.
+func isEligibleForAutostart(user database.User, ws database.Workspace, windows schedule.Windows, build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) (bool, string) {
 	// Don't attempt to autostart workspaces for suspended users.
 	if user.Status != database.UserStatusActive {
-		return false
+		return false, fmt.Sprintf("owner is %s", user.Status)
 	}
 
 	// Don't attempt to autostart failed workspaces.
 	if job.JobStatus == database.ProvisionerJobStatusFailed {
-		return false
+		return false, "last build failed"
 	}
 
 	// If the workspace is dormant we should not autostart it.
 	if ws.DormantAt.Valid {
-		return false
+		return false, "workspace is dormant"
 	}
 
 	// If the last transition for the workspace was not 'stop' then the workspace
 	// cannot be started.
 	if build.Transition != database.WorkspaceTransitionStop {
-		return false
+		return false, "workspace is not stopped"
 	}
 
-	// If autostart isn't enabled, or the schedule isn't valid/populated we can't
-	// autostart the workspace.
-	if !templateSchedule.UserAutostartEnabled || !ws.AutostartSchedule.Valid || ws.AutostartSchedule.String == "" {
-		return false
+	// If autostart isn't enabled, or none of the workspace's windows has an
+	// autostart schedule, we can't autostart the workspace.
+	if !templateSchedule.UserAutostartEnabled {
+		return false, "template does not allow autostart"
+	}
+	if !windows.HasAutostart() {
+		return false, "workspace has no autostart schedule"
 	}
 
 	// Get the next allowed autostart time after the build's creation time,
-	// based on the workspace's schedule and the template's allowed days.
-	nextTransition, err := schedule.NextAllowedAutostart(build.CreatedAt, ws.AutostartSchedule.String, templateSchedule)
+	// based on the earliest of the workspace's windows and the template's
+	// allowed days.
+	nextTransition, _, err := windows.NextAllowedAutostart(build.CreatedAt, templateSchedule)
 	if err != nil {
-		return false
+		return false, fmt.Sprintf("no allowed autostart time: %s", err)
 	}
 
 	// Must use '.Before' vs '.After' so equal times are considered "valid for autostart".
-	return !currentTick.Before(nextTransition)
+	if currentTick.Before(nextTransition) {
+		return false, fmt.Sprintf("next autostart is at %s", nextTransition.Format(time.RFC3339))
+	}
+	return true, fmt.Sprintf("autostart was due at %s", nextTransition.Format(time.RFC3339))
 }
 
-// isEligibleForAutostop returns true if the workspace should be autostopped.
-func isEligibleForAutostop(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, currentTick time.Time) bool {
+// isEligibleForAutostop returns true if the workspace should be autostopped,
+// and why or why not.
+func isEligibleForAutostop(user database.User, ws database.Workspace, build database.WorkspaceBuild, job database.ProvisionerJob, currentTick time.Time) (bool, string) {
 	if job.JobStatus == database.ProvisionerJobStatusFailed {
-		return false
+		return false, "last build failed"
 	}
 
 	// If the workspace is dormant we should not autostop it.
 	if ws.DormantAt.Valid {
-		return false
+		return false, "workspace is dormant"
 	}
 
 	if build.Transition == database.WorkspaceTransitionStart && user.Status == database.UserStatusSuspended {
-		return true
+		return true, "owner is suspended"
 	}
 
 	// A workspace must be started in order for it to be auto-stopped.
-	return build.Transition == database.WorkspaceTransitionStart &&
-		!build.Deadline.IsZero() &&
-		// We do not want to stop a workspace prior to it breaching its deadline.
-		!currentTick.Before(build.Deadline)
+	if build.Transition != database.WorkspaceTransitionStart {
+		return false, "workspace is not running"
+	}
+	if build.Deadline.IsZero() {
+		return false, "workspace has no deadline"
+	}
+	// We do not want to stop a workspace prior to it breaching its deadline.
+	if currentTick.Before(build.Deadline) {
+		return false, fmt.Sprintf("deadline is %s", build.Deadline.Format(time.RFC3339))
+	}
+	return true, fmt.Sprintf("deadline passed at %s", build.Deadline.Format(time.RFC3339))
 }
 
 // isEligibleForDormantStop returns true if the workspace should be dormant
-// for breaching the inactivity threshold of the template.
-func isEligibleForDormantStop(ws database.Workspace, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) bool {
+// for breaching the inactivity threshold of the template, and why or why not.
+func isEligibleForDormantStop(ws database.Workspace, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) (bool, string) {
 	// Only attempt against workspaces not already dormant.
-	return !ws.DormantAt.Valid &&
-		// The template must specify an time_til_dormant value.
-		templateSchedule.TimeTilDormant > 0 &&
-		// The workspace must breach the time_til_dormant value.
-		currentTick.Sub(ws.LastUsedAt) > templateSchedule.TimeTilDormant
+	if ws.DormantAt.Valid {
+		return false, "workspace is already dormant"
+	}
+	// The template must specify an time_til_dormant value.
+	if templateSchedule.TimeTilDormant <= 0 {
+		return false, "template has no dormancy threshold"
+	}
+	// The workspace must breach the time_til_dormant value.
+	dormantAt := ws.LastUsedAt.Add(templateSchedule.TimeTilDormant)
+	if currentTick.Sub(ws.LastUsedAt) <= templateSchedule.TimeTilDormant {
+		return false, fmt.Sprintf("workspace becomes dormant after %s", dormantAt.Format(time.RFC3339))
+	}
+	return true, fmt.Sprintf("workspace was last used at %s", ws.LastUsedAt.Format(time.RFC3339))
 }
 
-func isEligibleForDelete(ws database.Workspace, templateSchedule schedule.TemplateScheduleOptions, lastBuild database.WorkspaceBuild, lastJob database.ProvisionerJob, currentTick time.Time) bool {
-	eligible := ws.DormantAt.Valid && ws.DeletingAt.Valid &&
-		// Dormant workspaces should only be deleted if a time_til_dormant_autodelete value is specified.
-		templateSchedule.TimeTilDormantAutoDelete > 0 &&
-		// The workspace must breach the time_til_dormant_autodelete value.
-		currentTick.After(ws.DeletingAt.Time)
+// isEligibleForDelete returns true if the dormant workspace should be deleted,
+// and why or why not.
+func isEligibleForDelete(ws database.Workspace, templateSchedule schedule.TemplateScheduleOptions, lastBuild database.WorkspaceBuild, lastJob database.ProvisionerJob, currentTick time.Time) (bool, string) {
+	if !ws.DormantAt.Valid {
+		return false, "workspace is not dormant"
+	}
+	// Dormant workspaces should only be deleted if a time_til_dormant_autodelete value is specified.
+	if !ws.DeletingAt.Valid || templateSchedule.TimeTilDormantAutoDelete <= 0 {
+		return false, "template does not delete dormant workspaces"
+	}
+	// The workspace must breach the time_til_dormant_autodelete value.
+	if !currentTick.After(ws.DeletingAt.Time) {
+		return false, fmt.Sprintf("workspace is deleted after %s", ws.DeletingAt.Time.Format(time.RFC3339))
+	}
 
 	// If the last delete job failed we should wait 24 hours before trying again.
 	// Builds are resource-intensive so retrying every minute is not productive
 	// and will hold compute hostage.
 	if lastBuild.Transition == database.WorkspaceTransitionDelete && lastJob.JobStatus == database.ProvisionerJobStatusFailed {
-		return eligible && lastJob.Finished() && currentTick.Sub(lastJob.FinishedAt()) > time.Hour*24
+		if !lastJob.Finished() {
+			return false, "last delete failed"
+		}
+		retryAt := lastJob.FinishedAt().Add(time.Hour * 24)
+		if currentTick.Sub(lastJob.FinishedAt()) <= time.Hour*24 {
+			return false, fmt.Sprintf("last delete failed, retrying after %s", retryAt.Format(time.RFC3339))
+		}
 	}
 
-	return eligible
+	return true, fmt.Sprintf("deletion was due at %s", ws.DeletingAt.Time.Format(time.RFC3339))
 }
 
 // isEligibleForFailedStop returns true if the workspace is eligible to be stopped
-// due to a failed build.
-func isEligibleForFailedStop(build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) bool {
+// due to a failed build, and why or why not.
+func isEligibleForFailedStop(build database.WorkspaceBuild, job database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions, currentTick time.Time) (bool, string) {
 	// If the template has specified a failure TLL.
-	return templateSchedule.FailureTTL > 0 &&
-		// And the job resulted in failure.
-		job.JobStatus == database.ProvisionerJobStatusFailed &&
-		build.Transition == database.WorkspaceTransitionStart &&
-		// And sufficient time has elapsed since the job has completed.
-		job.CompletedAt.Valid &&
-		currentTick.Sub(job.CompletedAt.Time) > templateSchedule.FailureTTL
+	if templateSchedule.FailureTTL <= 0 {
+		return false, "template has no failure cleanup"
+	}
+	// And the job resulted in failure.
+	if job.JobStatus != database.ProvisionerJobStatusFailed || build.Transition != database.WorkspaceTransitionStart {
+		return false, "last start did not fail"
+	}
+	if !job.CompletedAt.Valid {
+		return false, "failed build has not completed"
+	}
+	// And sufficient time has elapsed since the job has completed.
+	stopAt := job.CompletedAt.Time.Add(templateSchedule.FailureTTL)
+	if currentTick.Sub(job.CompletedAt.Time) <= templateSchedule.FailureTTL {
+		return false, fmt.Sprintf("failed workspace is stopped after %s", stopAt.Format(time.RFC3339))
+	}
+	return true, fmt.Sprintf("build failed at %s", job.CompletedAt.Time.Format(time.RFC3339))
+}
+
+// lifecycleDecision is the outcome of evaluating a workspace on a tick. It is
+// recorded so that users can find out why a workspace did or did not
+// transition.
+type lifecycleDecision struct {
+	Outcome    database.WorkspaceLifecycleOutcome
+	Transition database.WorkspaceTransition
+	Reason     database.BuildReason
+	Checks     []codersdk.WorkspaceLifecycleCheck
+	Detail     string
+}
+
+// equal returns true if the recorded decision has the same outcome,
+// transition, reason, checks and detail as d.
+func (d lifecycleDecision) equal(recorded database.WorkspaceLifecycleDecision) bool {
+	if recorded.Outcome != d.Outcome ||
+		recorded.Transition.WorkspaceTransition != d.Transition ||
+		recorded.BuildReason.BuildReason != d.Reason ||
+		recorded.Detail != d.Detail {
+		return false
+	}
+	var checks []codersdk.WorkspaceLifecycleCheck
+	if err := json.Unmarshal(recorded.Checks, &checks); err != nil {
+		return false
+	}
+	return slices.Equal(checks, d.Checks)
+}
+
+// recordDecision inserts a lifecycle decision for the workspace, unless it is
+// the same as the last decision recorded. Workspaces which remain eligible are
+// evaluated on every tick, such as in dry-run mode, and would otherwise fill the
+// log with repeats. Failing to record a decision does not affect the transition
+// itself.
+func (e *Executor) recordDecision(log slog.Logger, workspaceID uuid.UUID, d lifecycleDecision) {
+	if d.Checks == nil {
+		d.Checks = []codersdk.WorkspaceLifecycleCheck{}
+	}
+
+	latest, err := e.db.GetWorkspaceLifecycleDecisionsByWorkspaceID(e.ctx, database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams{
+		WorkspaceID: workspaceID,
+		LimitCount:  1,
+	})
+	if err != nil && !xerrors.Is(err, context.Canceled) {
+		log.Warn(e.ctx, "failed to get last lifecycle decision", slog.Error(err))
+	}
+	if len(latest) > 0 && d.equal(latest[0]) {
+		return
+	}
+
+	checks, err := json.Marshal(d.Checks)
+	if err != nil {
+		log.Warn(e.ctx, "failed to marshal lifecycle decision checks", slog.Error(err))
+		return
+	}
+	_, err = e.db.InsertWorkspaceLifecycleDecision(e.ctx, database.InsertWorkspaceLifecycleDecisionParams{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		CreatedAt:   dbtime.Now(),
+		Outcome:     d.Outcome,
+		Transition: database.NullWorkspaceTransition{
+			WorkspaceTransition: d.Transition,
+			Valid:               d.Transition != "",
+		},
+		BuildReason: database.NullBuildReason{
+			BuildReason: d.Reason,
+			Valid:       d.Reason != "",
+		},
+		Checks: checks,
+		Detail: d.Detail,
+	})
+	if err != nil && !xerrors.Is(err, context.Canceled) {
+		log.Warn(e.ctx, "failed to record lifecycle decision", slog.Error(err))
+	}
 }
 
 type auditParams struct {
@@ -5,10 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/serpent"
 )
 
 func Test_isEligibleForAutostart(t *testing.T) {
@@ -61,6 +64,7 @@ func Test_isEligibleForAutostart(t *testing.T) {
 		Name             string
 		User             database.User
 		Workspace        database.Workspace
+		Windows          []database.WorkspaceScheduleWindow
 		Build            database.WorkspaceBuild
 		Job              database.ProvisionerJob
 		TemplateSchedule schedule.TemplateScheduleOptions
@@ -136,6 +140,90 @@ func Test_isEligibleForAutostart(t *testing.T) {
 			Tick:             okTick,
 			ExpectedResponse: false,
 		},
+		{
+			Name:      "AutostartDayExcepted",
+			User:      okUser,
+			Workspace: okWorkspace,
+			Build:     okBuild,
+			Job:       okJob,
+			TemplateSchedule: func(ts schedule.TemplateScheduleOptions) schedule.TemplateScheduleOptions {
+				cpy := ts
+				// The exception is on the local date of the tick, which is the
+				// day after in UTC.
+				cpy.AutostartExceptions = schedule.AutostartExceptions{
+					{Name: "New Year's Day", StartDate: "2021-01-01", EndDate: "2021-01-01"},
+				}
+				return cpy
+			}(okTemplateSchedule),
+			Tick:             okTick,
+			ExpectedResponse: false,
+		},
+		{
+			Name:      "AutostartDayNotExcepted",
+			User:      okUser,
+			Workspace: okWorkspace,
+			Build:     okBuild,
+			Job:       okJob,
+			TemplateSchedule: func(ts schedule.TemplateScheduleOptions) schedule.TemplateScheduleOptions {
+				cpy := ts
+				cpy.AutostartExceptions = schedule.AutostartExceptions{
+					{Name: "Tomorrow", StartDate: "2021-01-02", EndDate: "2021-01-02"},
+				}
+				return cpy
+			}(okTemplateSchedule),
+			Tick:             okTick,
+			ExpectedResponse: true,
+		},
+		{
+			Name: "AdditionalWindowOnly",
+			User: okUser,
+			Workspace: func(ws database.Workspace) database.Workspace {
+				cpy := ws
+				cpy.AutostartSchedule = sql.NullString{}
+				return cpy
+			}(okWorkspace),
+			Windows:          []database.WorkspaceScheduleWindow{{Position: 1, AutostartSchedule: okWorkspace.AutostartSchedule.String}},
+			Build:            okBuild,
+			Job:              okJob,
+			TemplateSchedule: okTemplateSchedule,
+			Tick:             okTick,
+			ExpectedResponse: true,
+		},
+		{
+			Name: "EarliestWindowDue",
+			User: okUser,
+			Workspace: func(ws database.Workspace) database.Workspace {
+				cpy := ws
+				cpy.AutostartSchedule = sql.NullString{Valid: true, String: "CRON_TZ=America/Chicago 0 21 * * *"}
+				return cpy
+			}(okWorkspace),
+			Windows:          []database.WorkspaceScheduleWindow{{Position: 1, AutostartSchedule: okWorkspace.AutostartSchedule.String}},
+			Build:            okBuild,
+			Job:              okJob,
+			TemplateSchedule: okTemplateSchedule,
+			Tick:             okTick,
+			ExpectedResponse: true,
+		},
+		{
+			Name: "NoWindowDue",
+			User: okUser,
+			Workspace: func(ws database.Workspace) database.Workspace {
+				cpy := ws
+				cpy.AutostartSchedule = sql.NullString{}
+				return cpy
+			}(okWorkspace),
+			Windows: []database.WorkspaceScheduleWindow{{Position: 1, AutostartSchedule: "CRON_TZ=America/Chicago 0 21 * * *"}},
+			Build: func(b database.WorkspaceBuild) database.WorkspaceBuild {
+				cpy := b
+				// Stopped an hour before the tick, so the next window is at 9pm.
+				cpy.CreatedAt = okTick.Add(-time.Hour)
+				return cpy
+			}(okBuild),
+			Job:              okJob,
+			TemplateSchedule: okTemplateSchedule,
+			Tick:             okTick,
+			ExpectedResponse: false,
+		},
 		{
 			Name:      "BuildTransitionNotStop",
 			User:      okUser,
@@ -156,8 +244,142 @@ func Test_isEligibleForAutostart(t *testing.T) {
 		t.Run(c.Name, func(t *testing.T) {
 			t.Parallel()
 
-			autostart := isEligibleForAutostart(c.User, c.Workspace, c.Build, c.Job, c.TemplateSchedule, c.Tick)
+			autostart, _ := isEligibleForAutostart(c.User, c.Workspace, schedule.WorkspaceWindows(c.Workspace.WorkspaceTable(), c.Windows), c.Build, c.Job, c.TemplateSchedule, c.Tick)
 			require.Equal(t, c.ExpectedResponse, autostart, "autostart not expected")
 		})
 	}
 }
+
+func Test_getTaskLifecycleActions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	ownerA, ownerB := uuid.New(), uuid.New()
+	running := func(owner uuid.UUID, state database.WorkspaceAppStatusState, since time.Duration) database.GetTaskWorkspacesForLifecyclePolicyRow {
+		return database.GetTaskWorkspacesForLifecyclePolicyRow{
+			WorkspaceID: uuid.New(),
+			OwnerID:     owner,
+			Transition:  database.WorkspaceTransitionStart,
+			JobStatus:   database.ProvisionerJobStatusSucceeded,
+			AppState:    database.NullWorkspaceAppStatusState{WorkspaceAppStatusState: state, Valid: true},
+			AppStateAt:  sql.NullTime{Time: now.Add(-since), Valid: true},
+		}
+	}
+	stopped := func(owner uuid.UUID, since time.Duration) database.GetTaskWorkspacesForLifecyclePolicyRow {
+		return database.GetTaskWorkspacesForLifecyclePolicyRow{
+			WorkspaceID:    uuid.New(),
+			OwnerID:        owner,
+			Transition:     database.WorkspaceTransitionStop,
+			JobStatus:      database.ProvisionerJobStatusSucceeded,
+			JobCompletedAt: sql.NullTime{Time: now.Add(-since), Valid: true},
+		}
+	}
+
+	var (
+		idleLong     = running(ownerA, database.WorkspaceAppStatusStateIdle, 2*time.Hour)
+		completeLong = running(ownerA, database.WorkspaceAppStatusStateComplete, time.Hour)
+		idleShort    = running(ownerA, database.WorkspaceAppStatusStateIdle, 5*time.Minute)
+		working      = running(ownerA, database.WorkspaceAppStatusStateWorking, 2*time.Hour)
+		otherOwner   = running(ownerB, database.WorkspaceAppStatusStateIdle, 5*time.Minute)
+		stoppedLong  = stopped(ownerA, 8*24*time.Hour)
+		stoppedShort = stopped(ownerA, time.Hour)
+		tasks        = []database.GetTaskWorkspacesForLifecyclePolicyRow{
+			idleLong, completeLong, idleShort, working, otherOwner, stoppedLong, stoppedShort,
+		}
+	)
+
+	type transition struct {
+		ID         uuid.UUID
+		Transition database.WorkspaceTransition
+		Reason     database.BuildReason
+	}
+	testCases := []struct {
+		Name     string
+		Config   codersdk.AITasksConfig
+		Expected []transition
+	}{
+		{
+			Name: "Disabled",
+		},
+		{
+			Name:   "IdleStop",
+			Config: codersdk.AITasksConfig{IdleStopAfter: serpent.Duration(30 * time.Minute)},
+			Expected: []transition{
+				{idleLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+				{completeLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+			},
+		},
+		{
+			Name:   "Retention",
+			Config: codersdk.AITasksConfig{RetentionPeriod: serpent.Duration(7 * 24 * time.Hour)},
+			Expected: []transition{
+				{stoppedLong.WorkspaceID, database.WorkspaceTransitionDelete, database.BuildReasonTaskAutodelete},
+			},
+		},
+		{
+			// Owner A has four running tasks, so the two which have been idle
+			// the longest are stopped. The working task is never stopped.
+			Name:   "MaxRunningPerUser",
+			Config: codersdk.AITasksConfig{MaxRunningPerUser: 2},
+			Expected: []transition{
+				{idleLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+				{completeLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+			},
+		},
+		{
+			// Tasks stopped for being idle no longer count as running.
+			Name: "IdleStopAndMaxRunningPerUser",
+			Config: codersdk.AITasksConfig{
+				IdleStopAfter:     serpent.Duration(90 * time.Minute),
+				MaxRunningPerUser: 2,
+			},
+			Expected: []transition{
+				{idleLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+				{completeLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+			},
+		},
+		{
+			// Only idle tasks may be stopped, so owner A stays over the limit.
+			Name:   "MaxRunningPerUserAllWorking",
+			Config: codersdk.AITasksConfig{MaxRunningPerUser: 1},
+			Expected: []transition{
+				{idleLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+				{completeLong.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+				{idleShort.WorkspaceID, database.WorkspaceTransitionStop, database.BuildReasonTaskAutostop},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []transition
+			for _, action := range getTaskLifecycleActions(c.Config, tasks, now) {
+				got = append(got, transition{action.Task.WorkspaceID, action.Transition, action.Reason})
+			}
+			require.ElementsMatch(t, c.Expected, got)
+		})
+	}
+}
+
+func Test_humanDuration(t *testing.T) {
+	t.Parallel()
+
+	for d, expected := range map[time.Duration]string{
+		0:                                  "0 seconds",
+		time.Second:                        "1 second",
+		30 * time.Minute:                   "30 minutes",
+		time.Hour:                          "1 hour",
+		90*time.Minute + 30*time.Second:    "1 hour 30 minutes",
+		36 * time.Hour:                     "1 day 12 hours",
+		47*time.Hour + 59*time.Minute:      "1 day 23 hours",
+		48*time.Hour + 30*time.Minute:      "2 days",
+		7 * 24 * time.Hour:                 "1 week",
+		8 * 24 * time.Hour:                 "1 week 1 day",
+		7*24*time.Hour + 3*time.Hour:       "1 week",
+		30*24*time.Hour + 23*time.Hour + 1: "4 weeks 2 days",
+	} {
+		require.Equal(t, expected, humanDuration(d), d.String())
+	}
+}
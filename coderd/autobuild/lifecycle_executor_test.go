@@ -26,6 +26,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/notifications/notificationstest"
 	"github.com/coder/coder/v2/coderd/schedule"
@@ -35,6 +36,7 @@ import (
 	"github.com/coder/coder/v2/provisioner/echo"
 	"github.com/coder/coder/v2/provisionersdk/proto"
 	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/serpent"
 )
 
 func TestMain(m *testing.M) {
@@ -466,6 +468,69 @@ func TestExecutorAutostopOK(t *testing.T) {
 	assert.Equal(t, codersdk.BuildReasonAutostop, workspace.LatestBuild.Reason)
 }
 
+func TestExecutorAutostopDryRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tickCh  = make(chan time.Time)
+		statsCh = make(chan autobuild.Stats)
+		dv      = coderdtest.DeploymentValues(t)
+	)
+	dv.AutobuildDryRun = true
+	var (
+		client, db = coderdtest.NewWithDatabase(t, &coderdtest.Options{
+			AutobuildTicker:          tickCh,
+			IncludeProvisionerDaemon: true,
+			AutobuildStats:           statsCh,
+			DeploymentValues:         dv,
+		})
+		// Given: we have a user with a workspace
+		workspace = mustProvisionWorkspace(t, client)
+	)
+	// Given: workspace is running
+	require.Equal(t, codersdk.WorkspaceTransitionStart, workspace.LatestBuild.Transition)
+	require.NotZero(t, workspace.LatestBuild.Deadline)
+
+	p, err := coderdtest.GetProvisionerForTags(db, time.Now(), workspace.OrganizationID, nil)
+	require.NoError(t, err)
+
+	// When: the autobuild executor ticks twice *after* the deadline:
+	go func() {
+		tickTime := workspace.LatestBuild.Deadline.Time.Add(time.Minute)
+		coderdtest.UpdateProvisionerLastSeenAt(t, db, p.ID, tickTime)
+		tickCh <- tickTime
+		tickTime = tickTime.Add(time.Minute)
+		coderdtest.UpdateProvisionerLastSeenAt(t, db, p.ID, tickTime)
+		tickCh <- tickTime
+		close(tickCh)
+	}()
+
+	// Then: the workspace should not be stopped
+	for range 2 {
+		stats := <-statsCh
+		assert.Len(t, stats.Errors, 0)
+		assert.Len(t, stats.Transitions, 0)
+	}
+
+	ws := coderdtest.MustWorkspace(t, client, workspace.ID)
+	assert.Equal(t, workspace.LatestBuild.ID, ws.LatestBuild.ID)
+
+	// Then: the stop should be recorded as a dry run, once since the decision
+	// did not change between ticks
+	ctx := testutil.Context(t, testutil.WaitShort)
+	lifecycle, err := client.WorkspaceLifecycle(ctx, workspace.ID)
+	require.NoError(t, err)
+	assert.True(t, lifecycle.DryRun)
+	require.Len(t, lifecycle.Decisions, 1)
+	decision := lifecycle.Decisions[0]
+	assert.Equal(t, codersdk.WorkspaceLifecycleOutcomeDryRun, decision.Outcome)
+	assert.Equal(t, codersdk.WorkspaceTransitionStop, decision.Transition)
+	assert.Equal(t, codersdk.BuildReasonAutostop, decision.Reason)
+	require.NotEmpty(t, decision.Checks)
+	assert.Equal(t, "autostop", decision.Checks[0].Check)
+	assert.True(t, decision.Checks[0].Passed)
+}
+
 func TestExecutorAutostopExtend(t *testing.T) {
 	t.Parallel()
 
@@ -1251,6 +1316,124 @@ func TestNotifications(t *testing.T) {
 	})
 }
 
+func TestExecutorTaskLifecyclePolicies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IdleStop", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh    = make(chan time.Time)
+			statsCh   = make(chan autobuild.Stats)
+			notifyEnq = notificationstest.FakeEnqueuer{}
+			dv        = coderdtest.DeploymentValues(t)
+		)
+		dv.AI.TasksConfig.IdleStopAfter = serpent.Duration(30 * time.Minute)
+		client, db := coderdtest.NewWithDatabase(t, &coderdtest.Options{
+			AutobuildTicker:          tickCh,
+			IncludeProvisionerDaemon: true,
+			AutobuildStats:           statsCh,
+			NotificationsEnqueuer:    &notifyEnq,
+			DeploymentValues:         dv,
+		})
+
+		// Given: a running task whose agent has reported that it is idle
+		workspace := mustProvisionTaskWorkspace(t, client)
+		build, err := db.GetLatestWorkspaceBuildByWorkspaceID(dbauthz.AsSystemRestricted(context.Background()), workspace.ID)
+		require.NoError(t, err)
+		require.True(t, build.AITaskSidebarAppID.Valid)
+		idleAt := dbtime.Now()
+		dbgen.WorkspaceAppStatus(t, db, database.WorkspaceAppStatus{
+			CreatedAt:   idleAt,
+			WorkspaceID: workspace.ID,
+			AgentID:     workspace.LatestBuild.Resources[0].Agents[0].ID,
+			AppID:       build.AITaskSidebarAppID.UUID,
+			State:       database.WorkspaceAppStatusStateIdle,
+		})
+
+		p, err := coderdtest.GetProvisionerForTags(db, time.Now(), workspace.OrganizationID, nil)
+		require.NoError(t, err)
+
+		// When: the autobuild executor ticks once the task has been idle for
+		// longer than the policy allows
+		go func() {
+			tickTime := idleAt.Add(31 * time.Minute)
+			coderdtest.UpdateProvisionerLastSeenAt(t, db, p.ID, tickTime)
+			tickCh <- tickTime
+			close(tickCh)
+		}()
+
+		// Then: the task's workspace should be stopped
+		stats := <-statsCh
+		require.Len(t, stats.Errors, 0)
+		require.Len(t, stats.Transitions, 1)
+		require.Equal(t, database.WorkspaceTransitionStop, stats.Transitions[workspace.ID])
+
+		workspace = coderdtest.MustWorkspace(t, client, workspace.ID)
+		require.Equal(t, codersdk.BuildReasonTaskAutostop, workspace.LatestBuild.Reason)
+
+		// And: the task's owner should be notified
+		sent := notifyEnq.Sent(notificationstest.WithTemplateID(notifications.TemplateTaskStopped))
+		require.Len(t, sent, 1)
+		require.Equal(t, workspace.OwnerID, sent[0].UserID)
+		require.Equal(t, "it was idle for 30 minutes", sent[0].Labels["reason"])
+		require.Contains(t, sent[0].Targets, workspace.ID)
+	})
+
+	t.Run("RetentionDelete", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tickCh  = make(chan time.Time)
+			statsCh = make(chan autobuild.Stats)
+			dv      = coderdtest.DeploymentValues(t)
+		)
+		dv.AI.TasksConfig.RetentionPeriod = serpent.Duration(24 * time.Hour)
+		client, db := coderdtest.NewWithDatabase(t, &coderdtest.Options{
+			AutobuildTicker:          tickCh,
+			IncludeProvisionerDaemon: true,
+			AutobuildStats:           statsCh,
+			DeploymentValues:         dv,
+		})
+
+		// Given: a stopped task
+		workspace := mustProvisionTaskWorkspace(t, client)
+		workspace = coderdtest.MustTransitionWorkspace(t, client, workspace.ID, codersdk.WorkspaceTransitionStart, codersdk.WorkspaceTransitionStop)
+		build := coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, workspace.LatestBuild.ID)
+
+		p, err := coderdtest.GetProvisionerForTags(db, time.Now(), workspace.OrganizationID, nil)
+		require.NoError(t, err)
+
+		// When: the autobuild executor ticks before the retention period has
+		// elapsed
+		tickTime := build.Job.CompletedAt.Add(time.Hour)
+		coderdtest.UpdateProvisionerLastSeenAt(t, db, p.ID, tickTime)
+		tickCh <- tickTime
+
+		// Then: the task's workspace should be left alone
+		stats := <-statsCh
+		require.Len(t, stats.Errors, 0)
+		require.Len(t, stats.Transitions, 0)
+
+		// When: the autobuild executor ticks after the retention period
+		go func() {
+			tickTime := build.Job.CompletedAt.Add(25 * time.Hour)
+			coderdtest.UpdateProvisionerLastSeenAt(t, db, p.ID, tickTime)
+			tickCh <- tickTime
+			close(tickCh)
+		}()
+
+		// Then: the task's workspace should be deleted
+		stats = <-statsCh
+		require.Len(t, stats.Errors, 0)
+		require.Len(t, stats.Transitions, 1)
+		require.Equal(t, database.WorkspaceTransitionDelete, stats.Transitions[workspace.ID])
+
+		workspace = coderdtest.MustWorkspace(t, client, workspace.ID)
+		require.Equal(t, codersdk.BuildReasonTaskAutodelete, workspace.LatestBuild.Reason)
+	})
+}
+
 // TestExecutorPrebuilds verifies AGPL behavior for prebuilt workspaces.
 // It ensures that workspace schedules do not trigger while the workspace
 // is still in a prebuilt state. Scheduling behavior only applies after the
@@ -1670,6 +1853,70 @@ func mustProvisionWorkspaceWithParameters(t *testing.T, client *codersdk.Client,
 	return coderdtest.MustWorkspace(t, client, ws.ID)
 }
 
+// mustProvisionTaskWorkspace creates a workspace from a template with an AI
+// task.
+func mustProvisionTaskWorkspace(t *testing.T, client *codersdk.Client) codersdk.Workspace {
+	t.Helper()
+	user := coderdtest.CreateFirstUser(t, client)
+	taskAppID := uuid.New()
+	version := coderdtest.CreateTemplateVersion(t, client, user.OrganizationID, &echo.Responses{
+		Parse: echo.ParseComplete,
+		ProvisionPlan: []*proto.Response{
+			{
+				Type: &proto.Response_Plan{
+					Plan: &proto.PlanComplete{
+						Parameters: []*proto.RichParameter{{Name: codersdk.AITaskPromptParameterName, Type: "string"}},
+						HasAiTasks: true,
+					},
+				},
+			},
+		},
+		ProvisionApply: []*proto.Response{
+			{
+				Type: &proto.Response_Apply{
+					Apply: &proto.ApplyComplete{
+						Resources: []*proto.Resource{
+							{
+								Name: "example",
+								Type: "aws_instance",
+								Agents: []*proto.Agent{
+									{
+										Id:   uuid.NewString(),
+										Name: "example",
+										Apps: []*proto.App{
+											{
+												Id:          taskAppID.String(),
+												Slug:        "task-sidebar",
+												DisplayName: "Task Sidebar",
+											},
+										},
+									},
+								},
+							},
+						},
+						AiTasks: []*proto.AITask{
+							{
+								SidebarApp: &proto.AITaskSidebarApp{
+									Id: taskAppID.String(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	coderdtest.AwaitTemplateVersionJobCompleted(t, client, version.ID)
+	template := coderdtest.CreateTemplate(t, client, user.OrganizationID, version.ID)
+	ws := coderdtest.CreateWorkspace(t, client, template.ID, func(req *codersdk.CreateWorkspaceRequest) {
+		req.RichParameterValues = []codersdk.WorkspaceBuildParameter{
+			{Name: codersdk.AITaskPromptParameterName, Value: "upgrade the logging dependency"},
+		}
+	})
+	coderdtest.AwaitWorkspaceBuildJobCompleted(t, client, ws.LatestBuild.ID)
+	return coderdtest.MustWorkspace(t, client, ws.ID)
+}
+
 func mustSchedule(t *testing.T, s string) *cron.Schedule {
 	t.Helper()
 	sched, err := cron.Weekly(s)
@@ -0,0 +1,346 @@
+package autobuild
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/provisionerjobs"
+	"github.com/coder/coder/v2/coderd/notifications"
+	strutil "github.com/coder/coder/v2/coderd/util/strings"
+	"github.com/coder/coder/v2/coderd/wsbuilder"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// WithTaskLifecyclePolicy will cause Executor to stop and delete the
+// workspaces of AI tasks according to the given policies on every tick.
+func (e *Executor) WithTaskLifecyclePolicy(cfg codersdk.AITasksConfig) *Executor {
+	e.taskPolicy = cfg
+	return e
+}
+
+// taskLifecycleAction is a transition of a task's workspace required by a
+// task lifecycle policy.
+type taskLifecycleAction struct {
+	Task       database.GetTaskWorkspacesForLifecyclePolicyRow
+	Transition database.WorkspaceTransition
+	Reason     database.BuildReason
+	// Because completes the sentence "The task was stopped automatically
+	// because ..." in the notification sent to the task's owner, and
+	// explains the action in the workspace's lifecycle decisions.
+	Because string
+}
+
+// getTaskLifecycleActions returns the transitions which the task lifecycle
+// policies require of the given task workspaces.
+func getTaskLifecycleActions(cfg codersdk.AITasksConfig, tasks []database.GetTaskWorkspacesForLifecyclePolicyRow, currentTick time.Time) []taskLifecycleAction {
+	var (
+		actions []taskLifecycleAction
+		// Tasks which are stopped or deleted by the other policies do not
+		// count towards their owner's running tasks.
+		running = make(map[uuid.UUID][]database.GetTaskWorkspacesForLifecyclePolicyRow)
+	)
+	idleStopAfter := cfg.IdleStopAfter.Value()
+	retentionPeriod := cfg.RetentionPeriod.Value()
+	for _, task := range tasks {
+		if idleSince, ok := task.IdleSince(); ok && idleStopAfter > 0 && !currentTick.Before(idleSince.Add(idleStopAfter)) {
+			actions = append(actions, taskLifecycleAction{
+				Task:       task,
+				Transition: database.WorkspaceTransitionStop,
+				Reason:     database.BuildReasonTaskAutostop,
+				Because:    fmt.Sprintf("it was idle for %s", humanDuration(idleStopAfter)),
+			})
+			continue
+		}
+
+		if task.Transition == database.WorkspaceTransitionStop &&
+			task.JobStatus == database.ProvisionerJobStatusSucceeded &&
+			task.JobCompletedAt.Valid &&
+			retentionPeriod > 0 &&
+			!currentTick.Before(task.JobCompletedAt.Time.Add(retentionPeriod)) {
+			actions = append(actions, taskLifecycleAction{
+				Task:       task,
+				Transition: database.WorkspaceTransitionDelete,
+				Reason:     database.BuildReasonTaskAutodelete,
+				Because:    fmt.Sprintf("it was stopped for %s", humanDuration(retentionPeriod)),
+			})
+			continue
+		}
+
+		if task.Running() {
+			running[task.OwnerID] = append(running[task.OwnerID], task)
+		}
+	}
+
+	maxRunning := int(cfg.MaxRunningPerUser.Value())
+	if maxRunning <= 0 {
+		return actions
+	}
+	for _, tasks := range running {
+		if len(tasks) <= maxRunning {
+			continue
+		}
+		// Stop the tasks which have been idle the longest. Tasks which are
+		// still working are never stopped, so a user may stay over the limit
+		// until their tasks finish.
+		idle := slices.DeleteFunc(slices.Clone(tasks), func(task database.GetTaskWorkspacesForLifecyclePolicyRow) bool {
+			_, ok := task.IdleSince()
+			return !ok
+		})
+		slices.SortFunc(idle, func(a, b database.GetTaskWorkspacesForLifecyclePolicyRow) int {
+			return cmp.Compare(a.AppStateAt.Time.UnixNano(), b.AppStateAt.Time.UnixNano())
+		})
+		excess := min(len(tasks)-maxRunning, len(idle))
+		for _, task := range idle[:excess] {
+			actions = append(actions, taskLifecycleAction{
+				Task:       task,
+				Transition: database.WorkspaceTransitionStop,
+				Reason:     database.BuildReasonTaskAutostop,
+				Because:    fmt.Sprintf("you had more than %d running tasks", maxRunning),
+			})
+		}
+	}
+	return actions
+}
+
+// runTaskLifecyclePolicies stops and deletes the workspaces of AI tasks as
+// required by the task lifecycle policies.
+func (e *Executor) runTaskLifecyclePolicies(currentTick time.Time, stats *Stats, statsMu *sync.Mutex) {
+	cfg := e.taskPolicy
+	if cfg.IdleStopAfter.Value() <= 0 && cfg.RetentionPeriod.Value() <= 0 && cfg.MaxRunningPerUser.Value() <= 0 {
+		return
+	}
+
+	tasks, err := e.db.GetTaskWorkspacesForLifecyclePolicy(e.ctx, uuid.Nil)
+	if err != nil {
+		e.log.Error(e.ctx, "get task workspaces for lifecycle policy", slog.Error(err))
+		return
+	}
+
+	eg := errgroup.Group{}
+	// Limit the concurrency to avoid overloading the database.
+	eg.SetLimit(10)
+	for _, action := range getTaskLifecycleActions(cfg, tasks, currentTick) {
+		log := e.log.With(
+			slog.F("workspace_id", action.Task.WorkspaceID),
+			slog.F("workspace_name", action.Task.WorkspaceName),
+			slog.F("transition", action.Transition),
+			slog.F("reason", action.Reason),
+		)
+		decision := lifecycleDecision{
+			Transition: action.Transition,
+			Reason:     action.Reason,
+			Checks: []codersdk.WorkspaceLifecycleCheck{{
+				Check:  checkTaskPolicy,
+				Passed: true,
+				Detail: action.Because,
+			}},
+		}
+		eg.Go(func() error {
+			if e.dryRun {
+				log.Info(e.ctx, "dry run, not transitioning task workspace")
+				decision.Outcome = database.WorkspaceLifecycleOutcomeDryRun
+				e.recordDecision(log, action.Task.WorkspaceID, decision)
+				return nil
+			}
+
+			transitioned, err := e.transitionTask(currentTick, log, action)
+			switch {
+			case err != nil && !xerrors.Is(err, context.Canceled):
+				decision.Outcome = database.WorkspaceLifecycleOutcomeFailed
+				decision.Detail = err.Error()
+				e.recordDecision(log, action.Task.WorkspaceID, decision)
+			case transitioned:
+				decision.Outcome = database.WorkspaceLifecycleOutcomeTransitioned
+				e.recordDecision(log, action.Task.WorkspaceID, decision)
+			}
+
+			statsMu.Lock()
+			defer statsMu.Unlock()
+			if err != nil && !xerrors.Is(err, context.Canceled) {
+				log.Error(e.ctx, "failed to transition task workspace", slog.Error(err))
+				stats.Errors[action.Task.WorkspaceID] = err
+			}
+			if transitioned {
+				stats.Transitions[action.Task.WorkspaceID] = action.Transition
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+// transitionTask builds the workspace of a task as required by a task
+// lifecycle policy, and notifies the task's owner when it is stopped. The
+// resulting build is audited once it completes, with the policy's build
+// reason.
+func (e *Executor) transitionTask(t time.Time, log slog.Logger, action taskLifecycleAction) (bool, error) {
+	var (
+		ws          database.Workspace
+		latestBuild database.WorkspaceBuild
+		job         *database.ProvisionerJob
+	)
+	err := e.db.InTx(func(tx database.Store) error {
+		ok, err := tx.TryAcquireLock(e.ctx, database.GenLockID(fmt.Sprintf("lifecycle-executor:%s", action.Task.WorkspaceID)))
+		if err != nil {
+			return xerrors.Errorf("try acquire lifecycle executor lock: %w", err)
+		}
+		if !ok {
+			log.Debug(e.ctx, "unable to acquire lock for workspace, skipping")
+			return nil
+		}
+
+		ws, err = tx.GetWorkspaceByID(e.ctx, action.Task.WorkspaceID)
+		if err != nil {
+			return xerrors.Errorf("get workspace by id: %w", err)
+		}
+		latestBuild, err = tx.GetLatestWorkspaceBuildByWorkspaceID(e.ctx, ws.ID)
+		if err != nil {
+			return xerrors.Errorf("get latest workspace build: %w", err)
+		}
+		// The workspace may have been built since it was found to break a
+		// policy, either by its owner or by the autobuild loop.
+		if latestBuild.BuildNumber != action.Task.BuildNumber {
+			log.Debug(e.ctx, "workspace was built since the policy was evaluated, skipping")
+			return nil
+		}
+		latestJob, err := tx.GetProvisionerJobByID(e.ctx, latestBuild.JobID)
+		if err != nil {
+			return xerrors.Errorf("get latest provisioner job: %w", err)
+		}
+		if latestJob.JobStatus != action.Task.JobStatus {
+			log.Debug(e.ctx, "workspace build changed status since the policy was evaluated, skipping")
+			return nil
+		}
+
+		templateVersion, err := tx.GetTemplateVersionByID(e.ctx, latestBuild.TemplateVersionID)
+		if err != nil {
+			return xerrors.Errorf("get template version by ID: %w", err)
+		}
+		templateVersionJob, err := tx.GetProvisionerJobByID(e.ctx, templateVersion.JobID)
+		if err != nil {
+			return xerrors.Errorf("get template version job: %w", err)
+		}
+		hasProvisioners, err := e.hasValidProvisioner(e.ctx, tx, t, ws, templateVersionJob.Tags)
+		if err != nil {
+			return xerrors.Errorf("check provisioner availability: %w", err)
+		}
+		if !hasProvisioners {
+			log.Warn(e.ctx, "skipping task lifecycle policy - no available provisioners")
+			return nil
+		}
+
+		builder := wsbuilder.New(ws, action.Transition, *e.buildUsageChecker.Load()).
+			SetLastWorkspaceBuildInTx(&latestBuild).
+			SetLastWorkspaceBuildJobInTx(&latestJob).
+			Experiments(e.experiments).
+			Reason(action.Reason)
+		_, job, _, err = builder.Build(e.ctx, tx, e.fileCache, nil, audit.WorkspaceBuildBaggage{IP: "127.0.0.1"})
+		if err != nil {
+			return xerrors.Errorf("build workspace with transition %q: %w", action.Transition, err)
+		}
+		log.Info(e.ctx, "scheduling task workspace transition")
+		return nil
+	}, &database.TxOptions{
+		Isolation:    sql.LevelRepeatableRead,
+		TxIdentifier: "task_lifecycle",
+	})
+	if err != nil {
+		return false, xerrors.Errorf("transition task workspace: %w", err)
+	}
+	if job == nil {
+		return false, nil
+	}
+	// As in the autobuild loop, the job must only be posted once the
+	// transaction has committed.
+	if err := provisionerjobs.PostJob(e.ps, *job); err != nil {
+		return true, xerrors.Errorf("post provisioner job to pubsub: %w", err)
+	}
+
+	// Deleted workspaces are already notified about once the build completes.
+	if action.Transition == database.WorkspaceTransitionStop {
+		if _, err := e.notificationsEnqueuer.Enqueue(e.ctx, ws.OwnerID, notifications.TemplateTaskStopped,
+			map[string]string{
+				"task":      e.taskName(ws, latestBuild),
+				"workspace": ws.Name,
+				"reason":    action.Because,
+			}, "lifecycle_executor",
+			// Associate this notification with all the related entities.
+			ws.ID, ws.OwnerID, ws.TemplateID, ws.OrganizationID,
+		); err != nil {
+			log.Warn(e.ctx, "failed to notify of stopped task", slog.Error(err))
+		}
+	}
+	return true, nil
+}
+
+// taskName returns the prompt of a task for use in notifications, falling
+// back to the name of its workspace.
+func (e *Executor) taskName(ws database.Workspace, build database.WorkspaceBuild) string {
+	parameters, err := e.db.GetWorkspaceBuildParameters(e.ctx, build.ID)
+	if err != nil {
+		e.log.Warn(e.ctx, "failed to get workspace build parameters", slog.F("workspace_id", ws.ID), slog.Error(err))
+		return ws.Name
+	}
+	for _, param := range parameters {
+		if param.Name == codersdk.AITaskPromptParameterName && param.Value != "" {
+			// As the prompt may be particularly long, truncate it.
+			return strutil.Truncate(param.Value, 160, strutil.TruncateWithEllipsis, strutil.TruncateWithFullWords)
+		}
+	}
+	return ws.Name
+}
+
+// humanDurationUnits are the units used by humanDuration, largest first.
+var humanDurationUnits = []struct {
+	name string
+	d    time.Duration
+}{
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+// humanDuration describes a duration in words by its largest whole unit,
+// followed by the next smaller unit if any remains, e.g. "1 day 12 hours".
+// Anything smaller is truncated rather than rounded, so the description never
+// overstates the duration.
+func humanDuration(d time.Duration) string {
+	var parts []string
+	for _, u := range humanDurationUnits {
+		n := d / u.d
+		if n == 0 {
+			if len(parts) > 0 {
+				break
+			}
+			continue
+		}
+		name := u.name
+		if n != 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, name))
+		if len(parts) == 2 {
+			break
+		}
+		d -= n * u.d
+	}
+	if len(parts) == 0 {
+		return "0 seconds"
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,81 @@
+package autobuild
+
+import (
+	"slices"
+	"time"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// UpcomingTransitions returns the automatic transitions the executor will make
+// to the workspace if nothing about it changes, ordered by time. The times are
+// those the eligibility checks compare against, so a transition which is
+// already due has a time before now.
+func UpcomingTransitions(user database.User, ws database.Workspace, windows schedule.Windows, latestBuild database.WorkspaceBuild, latestJob database.ProvisionerJob, templateSchedule schedule.TemplateScheduleOptions) []codersdk.WorkspaceLifecycleTransition {
+	var upcoming []codersdk.WorkspaceLifecycleTransition
+	add := func(transition database.WorkspaceTransition, reason database.BuildReason, at time.Time) {
+		upcoming = append(upcoming, codersdk.WorkspaceLifecycleTransition{
+			Transition: codersdk.WorkspaceTransition(transition),
+			Reason:     codersdk.BuildReason(reason),
+			At:         at,
+		})
+	}
+
+	// Dormant workspaces are only ever deleted.
+	if ws.DormantAt.Valid {
+		if ws.DeletingAt.Valid && templateSchedule.TimeTilDormantAutoDelete > 0 {
+			at := ws.DeletingAt.Time
+			// Failed deletions are retried after a day.
+			if latestBuild.Transition == database.WorkspaceTransitionDelete &&
+				latestJob.JobStatus == database.ProvisionerJobStatusFailed &&
+				latestJob.Finished() {
+				at = maxTime(at, latestJob.FinishedAt().Add(24*time.Hour))
+			}
+			add(database.WorkspaceTransitionDelete, database.BuildReasonAutodelete, at)
+		}
+		return upcoming
+	}
+
+	switch {
+	case latestJob.JobStatus == database.ProvisionerJobStatusFailed:
+		if latestBuild.Transition == database.WorkspaceTransitionStart &&
+			templateSchedule.FailureTTL > 0 && latestJob.CompletedAt.Valid {
+			add(database.WorkspaceTransitionStop, database.BuildReasonAutostop, latestJob.CompletedAt.Time.Add(templateSchedule.FailureTTL))
+		}
+	case latestBuild.Transition == database.WorkspaceTransitionStart:
+		if user.Status == database.UserStatusSuspended {
+			add(database.WorkspaceTransitionStop, database.BuildReasonAutostop, latestBuild.CreatedAt)
+		} else if !latestBuild.Deadline.IsZero() {
+			add(database.WorkspaceTransitionStop, database.BuildReasonAutostop, latestBuild.Deadline)
+		}
+	case latestBuild.Transition == database.WorkspaceTransitionStop:
+		if user.Status == database.UserStatusActive && templateSchedule.UserAutostartEnabled && windows.HasAutostart() {
+			if next, _, err := windows.NextAllowedAutostart(latestBuild.CreatedAt, templateSchedule); err == nil {
+				add(database.WorkspaceTransitionStart, database.BuildReasonAutostart, next)
+			}
+		}
+	}
+
+	if templateSchedule.TimeTilDormant > 0 {
+		// Stopped workspaces are only marked dormant.
+		var transition database.WorkspaceTransition
+		if latestBuild.Transition == database.WorkspaceTransitionStart {
+			transition = database.WorkspaceTransitionStop
+		}
+		add(transition, database.BuildReasonDormancy, ws.LastUsedAt.Add(templateSchedule.TimeTilDormant))
+	}
+
+	slices.SortStableFunc(upcoming, func(a, b codersdk.WorkspaceLifecycleTransition) int {
+		return a.At.Compare(b.At)
+	})
+	return upcoming
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
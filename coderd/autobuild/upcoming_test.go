@@ -0,0 +1,113 @@
+package autobuild_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/autobuild"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func TestUpcomingTransitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 10, 6, 12, 0, 0, 0, time.UTC)
+	activeUser := database.User{Status: database.UserStatusActive}
+
+	testCases := []struct {
+		Name             string
+		Workspace        database.Workspace
+		Build            database.WorkspaceBuild
+		Job              database.ProvisionerJob
+		TemplateSchedule schedule.TemplateScheduleOptions
+		Expected         []codersdk.WorkspaceLifecycleTransition
+	}{
+		{
+			Name:      "RunningWithDeadlineAndDormancy",
+			Workspace: database.Workspace{LastUsedAt: now},
+			Build: database.WorkspaceBuild{
+				Transition: database.WorkspaceTransitionStart,
+				Deadline:   now.Add(8 * time.Hour),
+			},
+			Job: database.ProvisionerJob{JobStatus: database.ProvisionerJobStatusSucceeded},
+			TemplateSchedule: schedule.TemplateScheduleOptions{
+				TimeTilDormant: time.Hour,
+			},
+			Expected: []codersdk.WorkspaceLifecycleTransition{
+				{Transition: codersdk.WorkspaceTransitionStop, Reason: codersdk.BuildReasonDormancy, At: now.Add(time.Hour)},
+				{Transition: codersdk.WorkspaceTransitionStop, Reason: codersdk.BuildReasonAutostop, At: now.Add(8 * time.Hour)},
+			},
+		},
+		{
+			Name: "StoppedWithAutostart",
+			Workspace: database.Workspace{
+				AutostartSchedule: sql.NullString{Valid: true, String: "CRON_TZ=UTC 0 9 * * *"},
+			},
+			Build: database.WorkspaceBuild{
+				Transition: database.WorkspaceTransitionStop,
+				CreatedAt:  now,
+			},
+			Job: database.ProvisionerJob{JobStatus: database.ProvisionerJobStatusSucceeded},
+			TemplateSchedule: schedule.TemplateScheduleOptions{
+				UserAutostartEnabled: true,
+				AutostartRequirement: schedule.TemplateAutostartRequirement{DaysOfWeek: 0b01111111},
+			},
+			Expected: []codersdk.WorkspaceLifecycleTransition{
+				{Transition: codersdk.WorkspaceTransitionStart, Reason: codersdk.BuildReasonAutostart, At: time.Date(2025, 10, 7, 9, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Name: "FailedStart",
+			Build: database.WorkspaceBuild{
+				Transition: database.WorkspaceTransitionStart,
+				Deadline:   now.Add(8 * time.Hour),
+			},
+			Job: database.ProvisionerJob{
+				JobStatus:   database.ProvisionerJobStatusFailed,
+				CompletedAt: sql.NullTime{Valid: true, Time: now},
+			},
+			TemplateSchedule: schedule.TemplateScheduleOptions{
+				FailureTTL: time.Hour,
+			},
+			Expected: []codersdk.WorkspaceLifecycleTransition{
+				{Transition: codersdk.WorkspaceTransitionStop, Reason: codersdk.BuildReasonAutostop, At: now.Add(time.Hour)},
+			},
+		},
+		{
+			Name: "Dormant",
+			Workspace: database.Workspace{
+				DormantAt:  sql.NullTime{Valid: true, Time: now},
+				DeletingAt: sql.NullTime{Valid: true, Time: now.Add(24 * time.Hour)},
+			},
+			Build: database.WorkspaceBuild{Transition: database.WorkspaceTransitionStop},
+			Job:   database.ProvisionerJob{JobStatus: database.ProvisionerJobStatusSucceeded},
+			TemplateSchedule: schedule.TemplateScheduleOptions{
+				TimeTilDormant:           time.Hour,
+				TimeTilDormantAutoDelete: 24 * time.Hour,
+			},
+			Expected: []codersdk.WorkspaceLifecycleTransition{
+				{Transition: codersdk.WorkspaceTransitionDelete, Reason: codersdk.BuildReasonAutodelete, At: now.Add(24 * time.Hour)},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			windows := schedule.WorkspaceWindows(c.Workspace.WorkspaceTable(), nil)
+			upcoming := autobuild.UpcomingTransitions(activeUser, c.Workspace, windows, c.Build, c.Job, c.TemplateSchedule)
+			require.Len(t, upcoming, len(c.Expected))
+			for i, expected := range c.Expected {
+				require.Equal(t, expected.Transition, upcoming[i].Transition)
+				require.Equal(t, expected.Reason, upcoming[i].Reason)
+				require.True(t, expected.At.Equal(upcoming[i].At), "expected %s, got %s", expected.At, upcoming[i].At)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package coderd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get autostart exception calendars by organization
+// @ID get-autostart-exception-calendars-by-organization
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Success 200 {array} codersdk.AutostartExceptionCalendar
+// @Router /organizations/{organization}/autostart-calendars [get]
+func (api *API) autostartExceptionCalendars(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx          = r.Context()
+		organization = httpmw.OrganizationParam(r)
+	)
+
+	calendars, err := api.Database.GetAutostartExceptionCalendarsByOrganizationID(ctx, organization.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching autostart exception calendars.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := make([]codersdk.AutostartExceptionCalendar, 0, len(calendars))
+	for _, calendar := range calendars {
+		converted, err := convertAutostartExceptionCalendar(calendar)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error converting autostart exception calendar.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		resp = append(resp, converted)
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Upsert autostart exception calendar
+// @ID upsert-autostart-exception-calendar
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param calendar path string true "Calendar name"
+// @Param request body codersdk.UpsertAutostartExceptionCalendarRequest true "Upsert calendar request"
+// @Success 200 {object} codersdk.AutostartExceptionCalendar
+// @Router /organizations/{organization}/autostart-calendars/{calendar} [put]
+func (api *API) putAutostartExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx          = r.Context()
+		organization = httpmw.OrganizationParam(r)
+		name         = chi.URLParam(r, "calendar")
+	)
+
+	if err := codersdk.NameValid(name); err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid calendar name.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	var req codersdk.UpsertAutostartExceptionCalendarRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	exceptions := make(schedule.AutostartExceptions, 0, len(req.Exceptions))
+	var validations []codersdk.ValidationError
+	for i, e := range req.Exceptions {
+		exception := schedule.AutostartException(e)
+		if err := exception.Validate(); err != nil {
+			validations = append(validations, codersdk.ValidationError{
+				Field:  fmt.Sprintf("exceptions[%d]", i),
+				Detail: err.Error(),
+			})
+			continue
+		}
+		exceptions = append(exceptions, exception)
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid autostart exceptions.",
+			Validations: validations,
+		})
+		return
+	}
+	rawExceptions, err := json.Marshal(exceptions)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error encoding autostart exceptions.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	now := dbtime.Time(api.Clock.Now())
+	calendar, err := api.Database.UpsertAutostartExceptionCalendar(ctx, database.UpsertAutostartExceptionCalendarParams{
+		ID:             uuid.New(),
+		OrganizationID: organization.ID,
+		Name:           name,
+		Description:    req.Description,
+		Exceptions:     rawExceptions,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating autostart exception calendar.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	if err := api.resetOrganizationNextStartAt(ctx, organization.ID); err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error rescheduling workspace autostarts.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp, err := convertAutostartExceptionCalendar(calendar)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error converting autostart exception calendar.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Delete autostart exception calendar
+// @ID delete-autostart-exception-calendar
+// @Security CoderSessionToken
+// @Tags Organizations
+// @Param organization path string true "Organization ID" format(uuid)
+// @Param calendar path string true "Calendar name"
+// @Success 204
+// @Router /organizations/{organization}/autostart-calendars/{calendar} [delete]
+func (api *API) deleteAutostartExceptionCalendar(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx          = r.Context()
+		organization = httpmw.OrganizationParam(r)
+		name         = chi.URLParam(r, "calendar")
+	)
+
+	_, err := api.Database.GetAutostartExceptionCalendarByOrganizationIDAndName(ctx, database.GetAutostartExceptionCalendarByOrganizationIDAndNameParams{
+		OrganizationID: organization.ID,
+		Name:           name,
+	})
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching autostart exception calendar.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	err = api.Database.DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx, database.DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams{
+		OrganizationID: organization.ID,
+		Name:           name,
+	})
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error deleting autostart exception calendar.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	if err := api.resetOrganizationNextStartAt(ctx, organization.ID); err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error rescheduling workspace autostarts.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Get workspace autostart exceptions
+// @ID get-workspace-autostart-exceptions
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceAutostartExceptions
+// @Router /workspaces/{workspace}/autostart-exceptions [get]
+func (api *API) workspaceAutostartExceptions(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	optedOut, err := api.Database.GetWorkspaceAutostartExceptionOptOut(ctx, workspace.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace autostart exception settings.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	// The organization's exceptions are listed even if the workspace ignores
+	// them, so that users can see what they have opted out of.
+	exceptions, err := schedule.GetAutostartExceptions(ctx, api.Database, workspace.OrganizationID, uuid.Nil)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching autostart exceptions.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := codersdk.WorkspaceAutostartExceptions{
+		Ignore:   optedOut,
+		Upcoming: []codersdk.AutostartException{},
+	}
+	for _, exception := range exceptions.Upcoming(api.Clock.Now()) {
+		resp.Upcoming = append(resp.Upcoming, codersdk.AutostartException(exception))
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Update workspace autostart exceptions
+// @ID update-workspace-autostart-exceptions
+// @Security CoderSessionToken
+// @Accept json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Param request body codersdk.UpdateWorkspaceAutostartExceptionsRequest true "Update request"
+// @Success 204
+// @Router /workspaces/{workspace}/autostart-exceptions [put]
+func (api *API) putWorkspaceAutostartExceptions(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	var req codersdk.UpdateWorkspaceAutostartExceptionsRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	err := api.Database.InTx(func(tx database.Store) error {
+		if req.Ignore {
+			err := tx.InsertWorkspaceAutostartExceptionOptOut(ctx, database.InsertWorkspaceAutostartExceptionOptOutParams{
+				WorkspaceID: workspace.ID,
+				CreatedAt:   dbtime.Time(api.Clock.Now()),
+			})
+			if err != nil {
+				return xerrors.Errorf("insert opt out: %w", err)
+			}
+		} else {
+			if err := tx.DeleteWorkspaceAutostartExceptionOptOut(ctx, workspace.ID); err != nil {
+				return xerrors.Errorf("delete opt out: %w", err)
+			}
+		}
+
+		// Clear the cached next autostart time so the lifecycle executor
+		// recomputes it with or without the exceptions.
+		if !workspace.IsPrebuild() {
+			err := tx.UpdateWorkspaceNextStartAt(ctx, database.UpdateWorkspaceNextStartAtParams{
+				ID:          workspace.ID,
+				NextStartAt: sql.NullTime{},
+			})
+			if err != nil {
+				return xerrors.Errorf("reset next start at: %w", err)
+			}
+		}
+		return nil
+	}, nil)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating workspace autostart exception settings.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// resetOrganizationNextStartAt clears the cached next autostart time of the
+// organization's workspaces after its exception calendars change, so that the
+// lifecycle executor recomputes them.
+func (api *API) resetOrganizationNextStartAt(ctx context.Context, organizationID uuid.UUID) error {
+	// The caller may manage the organization's calendars without being
+	// able to update every workspace in it.
+	//nolint:gocritic // Rescheduling autostarts is a system operation.
+	return api.Database.ResetWorkspacesNextStartAtByOrganizationID(dbauthz.AsSystemRestricted(ctx), organizationID)
+}
+
+func convertAutostartExceptionCalendar(calendar database.AutostartExceptionCalendar) (codersdk.AutostartExceptionCalendar, error) {
+	exceptions, err := schedule.ParseAutostartExceptions(calendar.Exceptions)
+	if err != nil {
+		return codersdk.AutostartExceptionCalendar{}, err
+	}
+	converted := make([]codersdk.AutostartException, 0, len(exceptions))
+	for _, exception := range exceptions {
+		converted = append(converted, codersdk.AutostartException(exception))
+	}
+	return codersdk.AutostartExceptionCalendar{
+		ID:             calendar.ID,
+		OrganizationID: calendar.OrganizationID,
+		Name:           calendar.Name,
+		Description:    calendar.Description,
+		Exceptions:     converted,
+		CreatedAt:      calendar.CreatedAt,
+		UpdatedAt:      calendar.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package coderd_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestAutostartExceptionCalendars(t *testing.T) {
+	t.Parallel()
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	memberClient, member := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	// Given: a workspace with autostart and a cached next start time
+	r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:           member.ID,
+		OrganizationID:    owner.OrganizationID,
+		AutostartSchedule: sql.NullString{Valid: true, String: "CRON_TZ=UTC 0 9 * * 1-5"},
+	}).Do()
+	//nolint:gocritic // Seeding the cached next start time.
+	err := db.UpdateWorkspaceNextStartAt(dbauthz.AsSystemRestricted(ctx), database.UpdateWorkspaceNextStartAtParams{
+		ID:          r.Workspace.ID,
+		NextStartAt: sql.NullTime{Valid: true, Time: time.Now().Add(time.Hour)},
+	})
+	require.NoError(t, err)
+
+	// When: an invalid calendar is created
+	_, err = client.UpsertAutostartExceptionCalendar(ctx, owner.OrganizationID, "holidays", codersdk.UpsertAutostartExceptionCalendarRequest{
+		Exceptions: []codersdk.AutostartException{{Name: "Backwards", StartDate: "2099-12-26", EndDate: "2099-12-25"}},
+	})
+	// Then: it is rejected
+	var sdkErr *codersdk.Error
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+
+	// When: a valid calendar is created
+	exceptions := []codersdk.AutostartException{
+		{Name: "Christmas", StartDate: "2099-12-25", EndDate: "2099-12-26"},
+	}
+	calendar, err := client.UpsertAutostartExceptionCalendar(ctx, owner.OrganizationID, "holidays", codersdk.UpsertAutostartExceptionCalendarRequest{
+		Description: "Public holidays",
+		Exceptions:  exceptions,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "holidays", calendar.Name)
+	require.Equal(t, "Public holidays", calendar.Description)
+	require.Equal(t, exceptions, calendar.Exceptions)
+
+	// Then: the cached next start time is cleared for recomputation
+	ws, err := client.Workspace(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.Nil(t, ws.NextStartAt)
+
+	// Then: members can list the calendars but not change them
+	calendars, err := memberClient.AutostartExceptionCalendars(ctx, owner.OrganizationID)
+	require.NoError(t, err)
+	require.Len(t, calendars, 1)
+	require.Equal(t, calendar.ID, calendars[0].ID)
+	_, err = memberClient.UpsertAutostartExceptionCalendar(ctx, owner.OrganizationID, "holidays", codersdk.UpsertAutostartExceptionCalendarRequest{})
+	require.Error(t, err)
+
+	// Then: the workspace owner sees the upcoming exceptions
+	wsExceptions, err := memberClient.WorkspaceAutostartExceptions(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.False(t, wsExceptions.Ignore)
+	require.Equal(t, exceptions, wsExceptions.Upcoming)
+
+	// When: the workspace owner opts out
+	err = memberClient.UpdateWorkspaceAutostartExceptions(ctx, r.Workspace.ID, codersdk.UpdateWorkspaceAutostartExceptionsRequest{Ignore: true})
+	require.NoError(t, err)
+	wsExceptions, err = memberClient.WorkspaceAutostartExceptions(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.True(t, wsExceptions.Ignore)
+
+	// When: the calendar is deleted
+	err = client.DeleteAutostartExceptionCalendar(ctx, owner.OrganizationID, "holidays")
+	require.NoError(t, err)
+	calendars, err = client.AutostartExceptionCalendars(ctx, owner.OrganizationID)
+	require.NoError(t, err)
+	require.Empty(t, calendars)
+
+	// Then: deleting it again is not found
+	err = client.DeleteAutostartExceptionCalendar(ctx, owner.OrganizationID, "holidays")
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+}
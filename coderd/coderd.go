@@ -734,6 +734,9 @@ func New(options *Options) *API {
 		panic("failed to setup server tailnet: " + err.Error())
 	}
 	api.agentProvider = stn
+	// Recover the steps of multi-step tasks which were abandoned, e.g. by a
+	// replica which stopped while advancing them.
+	api.Clock.TickerFunc(api.ctx, taskStepStaleTimeout, api.recoverTaskSteps, "tasksteps", "recover")
 	if options.DeploymentValues.Prometheus.Enable {
 		options.PrometheusRegistry.MustRegister(stn)
 	}
@@ -1020,10 +1023,14 @@ func New(options *Options) *API {
 
 			r.Route("/{user}", func(r chi.Router) {
 				r.Use(httpmw.ExtractOrganizationMembersParam(options.Database, api.HTTPAuth.Authorize))
+				r.Get("/watch", api.tasksWatchSSE)
+				r.Get("/watch-ws", api.tasksWatchWS)
 				r.Get("/{id}", api.taskGet)
 				r.Delete("/{id}", api.taskDelete)
 				r.Post("/{id}/send", api.taskSend)
 				r.Get("/{id}/logs", api.taskLogs)
+				r.Get("/{id}/watch", api.taskWatchSSE)
+				r.Get("/{id}/watch-ws", api.taskWatchWS)
 				r.Post("/", api.tasksCreate)
 			})
 		})
@@ -1100,6 +1107,7 @@ func New(options *Options) *API {
 			)
 
 			r.Get("/", api.auditLogs)
+			r.Get("/verify", api.verifyAuditLogs)
 			r.Post("/testgenerate", api.generateFakeAuditLog)
 		})
 		r.Route("/files", func(r chi.Router) {
@@ -1149,6 +1157,11 @@ func New(options *Options) *API {
 						})
 					})
 				})
+				r.Route("/autostart-calendars", func(r chi.Router) {
+					r.Get("/", api.autostartExceptionCalendars)
+					r.Put("/{calendar}", api.putAutostartExceptionCalendar)
+					r.Delete("/{calendar}", api.deleteAutostartExceptionCalendar)
+				})
 				r.Get("/paginated-members", api.paginatedMembers)
 				r.Route("/members", func(r chi.Router) {
 					r.Get("/", api.listMembers)
@@ -1353,6 +1366,15 @@ func New(options *Options) *API {
 								r.Get("/", api.userNotificationPreferences)
 								r.Put("/", api.putUserNotificationPreferences)
 							})
+							r.Route("/quiet-hours", func(r chi.Router) {
+								r.Get("/", api.userNotificationQuietHours)
+								r.Put("/", api.putUserNotificationQuietHours)
+							})
+							r.Route("/targets", func(r chi.Router) {
+								r.Get("/", api.userNotificationTargets)
+								r.Post("/", api.postUserNotificationTarget)
+								r.Delete("/{target}", api.deleteUserNotificationTarget)
+							})
 						})
 						r.Route("/webpush", func(r chi.Router) {
 							r.Post("/subscription", api.postUserWebpushSubscription)
@@ -1439,6 +1461,15 @@ func New(options *Options) *API {
 				r.Route("/autostart", func(r chi.Router) {
 					r.Put("/", api.putWorkspaceAutostart)
 				})
+				r.Route("/autostart-exceptions", func(r chi.Router) {
+					r.Get("/", api.workspaceAutostartExceptions)
+					r.Put("/", api.putWorkspaceAutostartExceptions)
+				})
+				r.Get("/lifecycle", api.workspaceLifecycle)
+				r.Route("/schedule-windows", func(r chi.Router) {
+					r.Get("/", api.workspaceScheduleWindows)
+					r.Put("/", api.putWorkspaceScheduleWindows)
+				})
 				r.Route("/ttl", func(r chi.Router) {
 					r.Put("/", api.putWorkspaceTTL)
 				})
@@ -1508,6 +1539,7 @@ func New(options *Options) *API {
 			r.Get("/user-status-counts", api.insightsUserStatusCounts)
 			r.Get("/user-latency", api.insightsUserLatency)
 			r.Get("/templates", api.insightsTemplates)
+			r.Get("/prebuilds", api.insightsPrebuilds)
 		})
 		r.Route("/debug", func(r chi.Router) {
 			r.Use(
@@ -1587,8 +1619,20 @@ func New(options *Options) *API {
 			r.Route("/templates", func(r chi.Router) {
 				r.Get("/system", api.systemNotificationTemplates)
 				r.Get("/custom", api.customNotificationTemplates)
+				r.Route("/{notification_template}", func(r chi.Router) {
+					r.Use(httpmw.ExtractNotificationTemplateParam(options.Database))
+					r.Put("/overrides", api.putNotificationTemplateOverrides)
+					r.Delete("/overrides", api.deleteNotificationTemplateOverrides)
+					r.Post("/preview", api.postNotificationTemplatePreview)
+					r.Post("/test", api.postNotificationTemplateTest)
+					r.Put("/urgency", api.putNotificationTemplateUrgency)
+				})
 			})
 			r.Get("/dispatch-methods", api.notificationDispatchMethods)
+			r.Route("/webhook-secrets", func(r chi.Router) {
+				r.Get("/", api.notificationWebhookSecrets)
+				r.Post("/", api.postNotificationWebhookSecret)
+			})
 			r.Post("/test", api.postTestNotification)
 			r.Post("/custom", api.postCustomNotification)
 		})
@@ -388,7 +388,9 @@ func NewOptions(t testing.TB, options *Options) (func(http.Handler), context.Can
 		options.AutobuildTicker,
 		options.NotificationsEnqueuer,
 		experiments,
-	).WithStatsChannel(options.AutobuildStats)
+	).WithStatsChannel(options.AutobuildStats).
+		WithTaskLifecyclePolicy(options.DeploymentValues.AI.TasksConfig).
+		WithDryRun(options.DeploymentValues.AutobuildDryRun.Value())
 
 	lifecycleExecutor.Run()
 
@@ -614,6 +614,29 @@ func WorkspaceAppStatus(status database.WorkspaceAppStatus) codersdk.WorkspaceAp
 	}
 }
 
+func TaskSteps(steps []database.TaskStep) []codersdk.TaskStep {
+	return List(steps, TaskStep)
+}
+
+func TaskStep(step database.TaskStep) codersdk.TaskStep {
+	sdkStep := codersdk.TaskStep{
+		Index:           step.StepIndex,
+		Prompt:          step.Prompt,
+		CompletionCheck: step.CompletionCheck,
+		Status:          codersdk.TaskStepStatus(step.Status),
+	}
+	if step.CheckExitCode.Valid {
+		sdkStep.CheckExitCode = ptr.Ref(step.CheckExitCode.Int32)
+	}
+	if step.StartedAt.Valid {
+		sdkStep.StartedAt = ptr.Ref(step.StartedAt.Time)
+	}
+	if step.CompletedAt.Valid {
+		sdkStep.CompletedAt = ptr.Ref(step.CompletedAt.Time)
+	}
+	return sdkStep
+}
+
 func ProvisionerDaemon(dbDaemon database.ProvisionerDaemon) codersdk.ProvisionerDaemon {
 	result := codersdk.ProvisionerDaemon{
 		ID:             dbDaemon.ID,
@@ -927,7 +950,7 @@ func PreviewParameterValidation(v *previewtypes.ParameterValidation) codersdk.Pr
 	}
 }
 
-func AIBridgeInterception(interception database.AIBridgeInterception, tokenUsages []database.AIBridgeTokenUsage, userPrompts []database.AIBridgeUserPrompt, toolUsages []database.AIBridgeToolUsage) codersdk.AIBridgeInterception {
+func AIBridgeInterception(interception database.AIBridgeInterception, tokenUsages []database.AIBridgeTokenUsage, userPrompts []database.AIBridgeUserPrompt, toolUsages []database.AIBridgeToolUsage, policyDecisions []database.AIBridgePolicyDecision) codersdk.AIBridgeInterception {
 	sdkTokenUsages := List(tokenUsages, AIBridgeTokenUsage)
 	sort.Slice(sdkTokenUsages, func(i, j int) bool {
 		// created_at ASC
@@ -943,16 +966,22 @@ func AIBridgeInterception(interception database.AIBridgeInterception, tokenUsage
 		// created_at ASC
 		return sdkToolUsages[i].CreatedAt.Before(sdkToolUsages[j].CreatedAt)
 	})
+	sdkPolicyDecisions := List(policyDecisions, AIBridgePolicyDecision)
+	sort.Slice(sdkPolicyDecisions, func(i, j int) bool {
+		// created_at ASC
+		return sdkPolicyDecisions[i].CreatedAt.Before(sdkPolicyDecisions[j].CreatedAt)
+	})
 	return codersdk.AIBridgeInterception{
-		ID:          interception.ID,
-		InitiatorID: interception.InitiatorID,
-		Provider:    interception.Provider,
-		Model:       interception.Model,
-		Metadata:    jsonOrEmptyMap(interception.Metadata),
-		StartedAt:   interception.StartedAt,
-		TokenUsages: sdkTokenUsages,
-		UserPrompts: sdkUserPrompts,
-		ToolUsages:  sdkToolUsages,
+		ID:              interception.ID,
+		InitiatorID:     interception.InitiatorID,
+		Provider:        interception.Provider,
+		Model:           interception.Model,
+		Metadata:        jsonOrEmptyMap(interception.Metadata),
+		StartedAt:       interception.StartedAt,
+		TokenUsages:     sdkTokenUsages,
+		UserPrompts:     sdkUserPrompts,
+		ToolUsages:      sdkToolUsages,
+		PolicyDecisions: sdkPolicyDecisions,
 	}
 }
 
@@ -994,6 +1023,45 @@ func AIBridgeToolUsage(usage database.AIBridgeToolUsage) codersdk.AIBridgeToolUs
 	}
 }
 
+func AIBridgePolicyDecision(decision database.AIBridgePolicyDecision) codersdk.AIBridgePolicyDecision {
+	return codersdk.AIBridgePolicyDecision{
+		ID:             decision.ID,
+		InterceptionID: decision.InterceptionID,
+		Policy:         decision.Policy,
+		Action:         codersdk.AIBridgePolicyAction(decision.Action),
+		Reason:         decision.Reason,
+		CreatedAt:      decision.CreatedAt,
+	}
+}
+
+func AIBridgeLimit(limit database.AIBridgeLimit) codersdk.AIBridgeLimit {
+	sdkLimit := codersdk.AIBridgeLimit{
+		Scope:     codersdk.AIBridgeLimitScope(limit.Scope),
+		ScopeID:   limit.ScopeID,
+		CreatedAt: limit.CreatedAt,
+		UpdatedAt: limit.UpdatedAt,
+	}
+	if limit.DailyTokenBudget.Valid {
+		sdkLimit.DailyTokenBudget = ptr.Ref(limit.DailyTokenBudget.Int64)
+	}
+	if limit.MonthlyTokenBudget.Valid {
+		sdkLimit.MonthlyTokenBudget = ptr.Ref(limit.MonthlyTokenBudget.Int64)
+	}
+	if limit.RequestsPerMinute.Valid {
+		sdkLimit.RequestsPerMinute = ptr.Ref(limit.RequestsPerMinute.Int32)
+	}
+	return sdkLimit
+}
+
+func AIBridgeModelPrice(price database.AIBridgeModelPrice) codersdk.AIBridgeModelPrice {
+	return codersdk.AIBridgeModelPrice{
+		Provider:                    price.Provider,
+		Model:                       price.Model,
+		InputPricePerMillionTokens:  price.InputPricePerMillionTokens,
+		OutputPricePerMillionTokens: price.OutputPricePerMillionTokens,
+	}
+}
+
 func jsonOrEmptyMap(rawMessage pqtype.NullRawMessage) map[string]any {
 	var m map[string]any
 	if !rawMessage.Valid {
@@ -250,6 +250,7 @@ var (
 				Identifier:  rbac.RoleIdentifier{Name: "autostart"},
 				DisplayName: "Autostart Daemon",
 				Site: rbac.Permissions(map[string][]policy.Action{
+					rbac.ResourceOrganization.Type:        {policy.ActionRead}, // Required to read autostart exception calendars
 					rbac.ResourceOrganizationMember.Type:  {policy.ActionRead},
 					rbac.ResourceFile.Type:                {policy.ActionRead}, // Required to read terraform files
 					rbac.ResourceNotificationMessage.Type: {policy.ActionCreate, policy.ActionRead},
@@ -354,10 +355,11 @@ var (
 				Identifier:  rbac.RoleIdentifier{Name: "notifier"},
 				DisplayName: "Notifier",
 				Site: rbac.Permissions(map[string][]policy.Action{
-					rbac.ResourceNotificationMessage.Type: {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate, policy.ActionDelete},
-					rbac.ResourceInboxNotification.Type:   {policy.ActionCreate},
-					rbac.ResourceWebpushSubscription.Type: {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate, policy.ActionDelete},
-					rbac.ResourceDeploymentConfig.Type:    {policy.ActionRead, policy.ActionUpdate}, // To read and upsert VAPID keys
+					rbac.ResourceNotificationMessage.Type:    {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate, policy.ActionDelete},
+					rbac.ResourceInboxNotification.Type:      {policy.ActionCreate},
+					rbac.ResourceWebpushSubscription.Type:    {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate, policy.ActionDelete},
+					rbac.ResourceDeploymentConfig.Type:       {policy.ActionRead, policy.ActionUpdate}, // To read and upsert VAPID keys
+					rbac.ResourceNotificationPreference.Type: {policy.ActionRead},                      // To resolve user-defined targets
 				}),
 				User:    []rbac.Permission{},
 				ByOrgID: map[string]rbac.OrgPermissions{},
@@ -578,6 +580,7 @@ var (
 					},
 					rbac.ResourceApiKey.Type:               {policy.ActionRead}, // Validate API keys.
 					rbac.ResourceAibridgeInterception.Type: {policy.ActionCreate, policy.ActionRead, policy.ActionUpdate},
+					rbac.ResourceWorkspace.Type:            {policy.ActionRead}, // Attribute usage to the template of a workspace session token.
 				}),
 				User:    []rbac.Permission{},
 				ByOrgID: map[string]rbac.OrgPermissions{},
@@ -1498,6 +1501,27 @@ func (q *querier) CustomRoles(ctx context.Context, arg database.CustomRolesParam
 	return q.db.CustomRoles(ctx, arg)
 }
 
+func (q *querier) DeferNotificationMessage(ctx context.Context, arg database.DeferNotificationMessageParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationMessage); err != nil {
+		return err
+	}
+	return q.db.DeferNotificationMessage(ctx, arg)
+}
+
+func (q *querier) DeleteAIBridgeLimit(ctx context.Context, arg database.DeleteAIBridgeLimitParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.DeleteAIBridgeLimit(ctx, arg)
+}
+
+func (q *querier) DeleteAIBridgeModelPrices(ctx context.Context) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.DeleteAIBridgeModelPrices(ctx)
+}
+
 func (q *querier) DeleteAPIKeyByID(ctx context.Context, id string) error {
 	return deleteQ(q.log, q.auth, q.db.GetAPIKeyByID, q.db.DeleteAPIKeyByID)(ctx, id)
 }
@@ -1543,6 +1567,13 @@ func (q *querier) DeleteApplicationConnectAPIKeysByUserID(ctx context.Context, u
 	return q.db.DeleteApplicationConnectAPIKeysByUserID(ctx, userID)
 }
 
+func (q *querier) DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceOrganization.WithID(arg.OrganizationID).InOrg(arg.OrganizationID)); err != nil {
+		return err
+	}
+	return q.db.DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx, arg)
+}
+
 func (q *querier) DeleteCoordinator(ctx context.Context, id uuid.UUID) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceTailnetCoordinator); err != nil {
 		return err
@@ -1568,6 +1599,13 @@ func (q *querier) DeleteCustomRole(ctx context.Context, arg database.DeleteCusto
 	return q.db.DeleteCustomRole(ctx, arg)
 }
 
+func (q *querier) DeleteExpiringNotificationWebhookSecrets(ctx context.Context) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.DeleteExpiringNotificationWebhookSecrets(ctx)
+}
+
 func (q *querier) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	return fetchAndExec(q.log, q.auth, policy.ActionUpdatePersonal, func(ctx context.Context, arg database.DeleteExternalAuthLinkParams) (database.ExternalAuthLink, error) {
 		//nolint:gosimple
@@ -1602,6 +1640,18 @@ func (q *querier) DeleteLicense(ctx context.Context, id int32) (int32, error) {
 	return id, nil
 }
 
+func (q *querier) DeleteNotificationDigestMessages(ctx context.Context, ids []uuid.UUID) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceNotificationMessage); err != nil {
+		return err
+	}
+	return q.db.DeleteNotificationDigestMessages(ctx, ids)
+}
+
+func (q *querier) DeleteNotificationUserTargetByID(ctx context.Context, id uuid.UUID) error {
+	// Notification preferences cannot be deleted, so removing a target is an update of the owner's preferences.
+	return update(q.log, q.auth, q.db.GetNotificationUserTargetByID, q.db.DeleteNotificationUserTargetByID)(ctx, id)
+}
+
 func (q *querier) DeleteOAuth2ProviderAppByClientID(ctx context.Context, id uuid.UUID) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceOauth2App); err != nil {
 		return err
@@ -1650,16 +1700,45 @@ func (q *querier) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx context.Contex
 	return q.db.DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx, arg)
 }
 
+func (q *querier) DeleteOldAIBridgeInterceptionCaptures(ctx context.Context, arg database.DeleteOldAIBridgeInterceptionCapturesParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldAIBridgeInterceptionCaptures(ctx, arg)
+}
+
 func (q *querier) DeleteOldAuditLogConnectionEvents(ctx context.Context, threshold database.DeleteOldAuditLogConnectionEventsParams) error {
 	// `ResourceSystem` is deprecated, but it doesn't make sense to add
-	// `policy.ActionDelete` to `ResourceAuditLog`, since this is the one and
-	// only time we'll be deleting from the audit log.
+	// `policy.ActionDelete` to `ResourceAuditLog`, since audit logs are only
+	// ever deleted by the system.
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
 		return err
 	}
 	return q.db.DeleteOldAuditLogConnectionEvents(ctx, threshold)
 }
 
+func (q *querier) DeleteOldAuditLogHashChainCheckpoints(ctx context.Context) error {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.DeleteOldAuditLogHashChainCheckpoints(ctx)
+}
+
+func (q *querier) DeleteOldAuditLogs(ctx context.Context, arg database.DeleteOldAuditLogsParams) (int64, error) {
+	// Only the system purges audit logs, see DeleteOldAuditLogConnectionEvents.
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldAuditLogs(ctx, arg)
+}
+
+func (q *querier) DeleteOldConnectionLogs(ctx context.Context, arg database.DeleteOldConnectionLogsParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldConnectionLogs(ctx, arg)
+}
+
 func (q *querier) DeleteOldNotificationMessages(ctx context.Context) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceNotificationMessage); err != nil {
 		return err
@@ -1667,6 +1746,13 @@ func (q *querier) DeleteOldNotificationMessages(ctx context.Context) error {
 	return q.db.DeleteOldNotificationMessages(ctx)
 }
 
+func (q *querier) DeleteOldPrebuildClaims(ctx context.Context, arg database.DeleteOldPrebuildClaimsParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldPrebuildClaims(ctx, arg)
+}
+
 func (q *querier) DeleteOldProvisionerDaemons(ctx context.Context) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
 		return err
@@ -1688,6 +1774,20 @@ func (q *querier) DeleteOldWorkspaceAgentStats(ctx context.Context) error {
 	return q.db.DeleteOldWorkspaceAgentStats(ctx)
 }
 
+func (q *querier) DeleteOldWorkspaceLifecycleDecisions(ctx context.Context, arg database.DeleteOldWorkspaceLifecycleDecisionsParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldWorkspaceLifecycleDecisions(ctx, arg)
+}
+
+func (q *querier) DeleteOldWorkspaceSessionRecordings(ctx context.Context, arg database.DeleteOldWorkspaceSessionRecordingsParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceSystem); err != nil {
+		return 0, err
+	}
+	return q.db.DeleteOldWorkspaceSessionRecordings(ctx, arg)
+}
+
 func (q *querier) DeleteOrganizationMember(ctx context.Context, arg database.DeleteOrganizationMemberParams) error {
 	return deleteQ[database.OrganizationMember](q.log, q.auth, func(ctx context.Context, arg database.DeleteOrganizationMemberParams) (database.OrganizationMember, error) {
 		member, err := database.ExpectOne(q.OrganizationMembers(ctx, database.OrganizationMembersParams{
@@ -1822,6 +1922,20 @@ func (q *querier) DeleteWorkspaceAgentPortSharesByTemplate(ctx context.Context,
 	return q.db.DeleteWorkspaceAgentPortSharesByTemplate(ctx, templateID)
 }
 
+func (q *querier) DeleteWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) error {
+	fetch := func(ctx context.Context, workspaceID uuid.UUID) (database.Workspace, error) {
+		return q.db.GetWorkspaceByID(ctx, workspaceID)
+	}
+	return update(q.log, q.auth, fetch, q.db.DeleteWorkspaceAutostartExceptionOptOut)(ctx, workspaceID)
+}
+
+func (q *querier) DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) error {
+	fetch := func(ctx context.Context, workspaceID uuid.UUID) (database.Workspace, error) {
+		return q.db.GetWorkspaceByID(ctx, workspaceID)
+	}
+	return update(q.log, q.auth, fetch, q.db.DeleteWorkspaceScheduleWindowsByWorkspaceID)(ctx, workspaceID)
+}
+
 func (q *querier) DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error {
 	workspace, err := q.db.GetWorkspaceByAgentID(ctx, id)
 	if err != nil {
@@ -1849,6 +1963,13 @@ func (q *querier) EnqueueNotificationMessage(ctx context.Context, arg database.E
 	return q.db.EnqueueNotificationMessage(ctx, arg)
 }
 
+func (q *querier) ExpireActiveNotificationWebhookSecret(ctx context.Context, expiresAt time.Time) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.ExpireActiveNotificationWebhookSecret(ctx, expiresAt)
+}
+
 func (q *querier) ExpirePrebuildsAPIKeys(ctx context.Context, now time.Time) error {
 	if err := q.authorizeContext(ctx, policy.ActionDelete, rbac.ResourceApiKey); err != nil {
 		return err
@@ -1932,6 +2053,21 @@ func (q *querier) GetAIBridgeInterceptionByID(ctx context.Context, id uuid.UUID)
 	return fetch(q.log, q.auth, q.db.GetAIBridgeInterceptionByID)(ctx, id)
 }
 
+func (q *querier) GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (database.AIBridgeInterceptionCapture, error) {
+	// Captures belong to the initiator of their associated interception.
+	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionRead, interceptionID); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	return q.db.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, interceptionID)
+}
+
+func (q *querier) GetAIBridgeInterceptionCaptureIDs(ctx context.Context) ([]uuid.UUID, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetAIBridgeInterceptionCaptureIDs(ctx)
+}
+
 func (q *querier) GetAIBridgeInterceptions(ctx context.Context) ([]database.AIBridgeInterception, error) {
 	fetch := func(ctx context.Context, _ any) ([]database.AIBridgeInterception, error) {
 		return q.db.GetAIBridgeInterceptions(ctx)
@@ -1939,6 +2075,43 @@ func (q *querier) GetAIBridgeInterceptions(ctx context.Context) ([]database.AIBr
 	return fetchWithPostFilter(q.auth, policy.ActionRead, fetch)(ctx, nil)
 }
 
+func (q *querier) GetAIBridgeLimitUsage(ctx context.Context, arg database.GetAIBridgeLimitUsageParams) (database.GetAIBridgeLimitUsageRow, error) {
+	// Usage is derived from the interceptions of everyone the limit applies to.
+	// A user's own usage only requires access to their own interceptions, but
+	// pooled group or organization usage requires access to everyone's.
+	obj := rbac.ResourceAibridgeInterception
+	if arg.Scope == database.AIBridgeLimitScopeUser {
+		obj = obj.WithOwner(arg.ScopeID.String())
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, obj); err != nil {
+		return database.GetAIBridgeLimitUsageRow{}, err
+	}
+	return q.db.GetAIBridgeLimitUsage(ctx, arg)
+}
+
+func (q *querier) GetAIBridgeLimits(ctx context.Context) ([]database.AIBridgeLimit, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceDeploymentConfig); err != nil {
+		return nil, err
+	}
+	return q.db.GetAIBridgeLimits(ctx)
+}
+
+func (q *querier) GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]database.AIBridgeLimit, error) {
+	// The limits which apply to a user are visible to anyone who may see their interceptions.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAibridgeInterception.WithOwner(userID.String())); err != nil {
+		return nil, err
+	}
+	return q.db.GetAIBridgeLimitsByUserID(ctx, userID)
+}
+
+func (q *querier) GetAIBridgeModelPrices(ctx context.Context) ([]database.AIBridgeModelPrice, error) {
+	// Prices are needed by anyone who may read the usage insights they price.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAibridgeInterception); err != nil {
+		return nil, err
+	}
+	return q.db.GetAIBridgeModelPrices(ctx)
+}
+
 func (q *querier) GetAIBridgeTokenUsagesByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	// All aibridge_token_usages records belong to the initiator of their associated interception.
 	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionRead, interceptionID); err != nil {
@@ -1955,6 +2128,14 @@ func (q *querier) GetAIBridgeToolUsagesByInterceptionID(ctx context.Context, int
 	return q.db.GetAIBridgeToolUsagesByInterceptionID(ctx, interceptionID)
 }
 
+func (q *querier) GetAIBridgeUsageStats(ctx context.Context, arg database.GetAIBridgeUsageStatsParams) ([]database.GetAIBridgeUsageStatsRow, error) {
+	// The rollup covers every user's interceptions.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAibridgeInterception); err != nil {
+		return nil, err
+	}
+	return q.db.GetAIBridgeUsageStats(ctx, arg)
+}
+
 func (q *querier) GetAIBridgeUserPromptsByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeUserPrompt, error) {
 	// All aibridge_token_usages records belong to the initiator of their associated interception.
 	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionRead, interceptionID); err != nil {
@@ -2050,6 +2231,29 @@ func (q *querier) GetApplicationName(ctx context.Context) (string, error) {
 	return q.db.GetApplicationName(ctx)
 }
 
+func (q *querier) GetAuditLogHashChainCheckpoints(ctx context.Context, arg database.GetAuditLogHashChainCheckpointsParams) ([]database.AuditLogHashChainCheckpoint, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAuditLog); err != nil {
+		return nil, err
+	}
+	return q.db.GetAuditLogHashChainCheckpoints(ctx, arg)
+}
+
+func (q *querier) GetAuditLogHashChainEntries(ctx context.Context, arg database.GetAuditLogHashChainEntriesParams) ([]database.AuditLogHashChain, error) {
+	// The hash chain spans every organization, so only site-wide auditors may
+	// read it.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAuditLog); err != nil {
+		return nil, err
+	}
+	return q.db.GetAuditLogHashChainEntries(ctx, arg)
+}
+
+func (q *querier) GetAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) ([]database.AuditLog, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAuditLog); err != nil {
+		return nil, err
+	}
+	return q.db.GetAuditLogsByIDs(ctx, ids)
+}
+
 func (q *querier) GetAuditLogsOffset(ctx context.Context, arg database.GetAuditLogsOffsetParams) ([]database.GetAuditLogsOffsetRow, error) {
 	// Shortcut if the user is an owner. The SQL filter is noticeable,
 	// and this is an easy win for owners. Which is the common case.
@@ -2073,6 +2277,17 @@ func (q *querier) GetAuthorizationUserRoles(ctx context.Context, userID uuid.UUI
 	return q.db.GetAuthorizationUserRoles(ctx, userID)
 }
 
+func (q *querier) GetAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.GetAutostartExceptionCalendarByOrganizationIDAndNameParams) (database.AutostartExceptionCalendar, error) {
+	return fetch(q.log, q.auth, q.db.GetAutostartExceptionCalendarByOrganizationIDAndName)(ctx, arg)
+}
+
+func (q *querier) GetAutostartExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.AutostartExceptionCalendar, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceOrganization.WithID(organizationID).InOrg(organizationID)); err != nil {
+		return nil, err
+	}
+	return q.db.GetAutostartExceptionCalendarsByOrganizationID(ctx, organizationID)
+}
+
 func (q *querier) GetConnectionLogsOffset(ctx context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
 	// Just like with the audit logs query, shortcut if the user is an owner.
 	err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceConnectionLog)
@@ -2166,6 +2381,13 @@ func (q *querier) GetDeploymentWorkspaceStats(ctx context.Context) (database.Get
 	return q.db.GetDeploymentWorkspaceStats(ctx)
 }
 
+func (q *querier) GetDueNotificationDigestMessages(ctx context.Context, heldBefore time.Time) ([]database.NotificationDigestMessage, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceNotificationMessage); err != nil {
+		return nil, err
+	}
+	return q.db.GetDueNotificationDigestMessages(ctx, heldBefore)
+}
+
 func (q *querier) GetEligibleProvisionerDaemonsByProvisionerJobIDs(ctx context.Context, provisionerJobIDs []uuid.UUID) ([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow, error) {
 	return fetchWithPostFilter(q.auth, policy.ActionRead, q.db.GetEligibleProvisionerDaemonsByProvisionerJobIDs)(ctx, provisionerJobIDs)
 }
@@ -2310,6 +2532,20 @@ func (q *querier) GetLastUpdateCheck(ctx context.Context) (string, error) {
 	return q.db.GetLastUpdateCheck(ctx)
 }
 
+func (q *querier) GetLatestAuditLogHashChainCheckpoint(ctx context.Context) (database.AuditLogHashChainCheckpoint, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAuditLog); err != nil {
+		return database.AuditLogHashChainCheckpoint{}, err
+	}
+	return q.db.GetLatestAuditLogHashChainCheckpoint(ctx)
+}
+
+func (q *querier) GetLatestAuditLogHashChainEntry(ctx context.Context) (database.AuditLogHashChain, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceAuditLog); err != nil {
+		return database.AuditLogHashChain{}, err
+	}
+	return q.db.GetLatestAuditLogHashChainEntry(ctx)
+}
+
 func (q *querier) GetLatestCryptoKeyByFeature(ctx context.Context, feature database.CryptoKeyFeature) (database.CryptoKey, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceCryptoKey); err != nil {
 		return database.CryptoKey{}, err
@@ -2394,6 +2630,28 @@ func (q *querier) GetNotificationTemplatesByKind(ctx context.Context, kind datab
 	return nil, sql.ErrNoRows
 }
 
+func (q *querier) GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (database.NotificationUserTarget, error) {
+	return fetch(q.log, q.auth, q.db.GetNotificationUserTargetByID)(ctx, id)
+}
+
+func (q *querier) GetNotificationUserTargetByPreference(ctx context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error) {
+	return fetch(q.log, q.auth, q.db.GetNotificationUserTargetByPreference)(ctx, arg)
+}
+
+func (q *querier) GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]database.NotificationUserTarget, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceNotificationPreference.WithOwner(userID.String())); err != nil {
+		return nil, err
+	}
+	return q.db.GetNotificationUserTargetsByUserID(ctx, userID)
+}
+
+func (q *querier) GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceDeploymentConfig); err != nil {
+		return nil, err
+	}
+	return q.db.GetNotificationWebhookSecrets(ctx)
+}
+
 func (q *querier) GetNotificationsSettings(ctx context.Context) (string, error) {
 	// No authz checks
 	return q.db.GetNotificationsSettings(ctx)
@@ -2577,13 +2835,43 @@ func (q *querier) GetParameterSchemasByJobID(ctx context.Context, jobID uuid.UUI
 	return q.db.GetParameterSchemasByJobID(ctx, jobID)
 }
 
-func (q *querier) GetPrebuildMetrics(ctx context.Context) ([]database.GetPrebuildMetricsRow, error) {
+func (q *querier) GetPrebuildClaimDemand(ctx context.Context, since time.Time) ([]database.GetPrebuildClaimDemandRow, error) {
+	// GetPrebuildClaimDemand aggregates claims of prebuilt workspaces across all templates.
+	if err := q.authorizeContext(ctx, policy.ActionViewInsights, rbac.ResourceTemplate.All()); err != nil {
+		return nil, err
+	}
+	return q.db.GetPrebuildClaimDemand(ctx, since)
+}
+
+func (q *querier) GetPrebuildInsights(ctx context.Context, arg database.GetPrebuildInsightsParams) ([]database.GetPrebuildInsightsRow, error) {
+	// Used by insights endpoints. Need to check both for auditors and for regular users with template acl perms.
+	if err := q.authorizeContext(ctx, policy.ActionViewInsights, rbac.ResourceTemplate); err != nil {
+		for _, templateID := range arg.TemplateIDs {
+			template, err := q.db.GetTemplateByID(ctx, templateID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := q.authorizeContext(ctx, policy.ActionViewInsights, template); err != nil {
+				return nil, err
+			}
+		}
+		if len(arg.TemplateIDs) == 0 {
+			if err := q.authorizeContext(ctx, policy.ActionViewInsights, rbac.ResourceTemplate.All()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return q.db.GetPrebuildInsights(ctx, arg)
+}
+
+func (q *querier) GetPrebuildMetrics(ctx context.Context, autoscalingSince time.Time) ([]database.GetPrebuildMetricsRow, error) {
 	// GetPrebuildMetrics returns metrics related to prebuilt workspaces,
 	// such as the number of created and failed prebuilt workspaces.
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceWorkspace.All()); err != nil {
 		return nil, err
 	}
-	return q.db.GetPrebuildMetrics(ctx)
+	return q.db.GetPrebuildMetrics(ctx, autoscalingSince)
 }
 
 func (q *querier) GetPrebuildsSettings(ctx context.Context) (string, error) {
@@ -2850,6 +3138,13 @@ func (q *querier) GetRuntimeConfig(ctx context.Context, key string) (string, err
 	return q.db.GetRuntimeConfig(ctx, key)
 }
 
+func (q *querier) GetStaleTaskSteps(ctx context.Context, updatedBefore time.Time) ([]database.TaskStep, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetStaleTaskSteps(ctx, updatedBefore)
+}
+
 func (q *querier) GetTailnetAgents(ctx context.Context, id uuid.UUID) ([]database.TailnetAgent, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceTailnetCoordinator); err != nil {
 		return nil, err
@@ -2893,6 +3188,28 @@ func (q *querier) GetTaskByWorkspaceID(ctx context.Context, workspaceID uuid.UUI
 	return fetch(q.log, q.auth, q.db.GetTaskByWorkspaceID)(ctx, workspaceID)
 }
 
+func (q *querier) GetTaskStepsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.TaskStep, error) {
+	// If we can read the workspace, we can read its task steps.
+	if _, err := q.GetWorkspaceByID(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	return q.db.GetTaskStepsByWorkspaceID(ctx, workspaceID)
+}
+
+func (q *querier) GetTaskStepsByWorkspaceIDs(ctx context.Context, workspaceIds []uuid.UUID) ([]database.TaskStep, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetTaskStepsByWorkspaceIDs(ctx, workspaceIds)
+}
+
+func (q *querier) GetTaskWorkspacesForLifecyclePolicy(ctx context.Context, ownerID uuid.UUID) ([]database.GetTaskWorkspacesForLifecyclePolicyRow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetTaskWorkspacesForLifecyclePolicy(ctx, ownerID)
+}
+
 func (q *querier) GetTelemetryItem(ctx context.Context, key string) (database.TelemetryItem, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return database.TelemetryItem{}, err
@@ -3195,6 +3512,17 @@ func (q *querier) GetUnexpiredLicenses(ctx context.Context) ([]database.License,
 	return q.db.GetUnexpiredLicenses(ctx)
 }
 
+func (q *querier) GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := q.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionReadPersonal, u); err != nil {
+		return "", err
+	}
+	return q.db.GetUserAIBridgeCapture(ctx, userID)
+}
+
 func (q *querier) GetUserActivityInsights(ctx context.Context, arg database.GetUserActivityInsightsParams) ([]database.GetUserActivityInsightsRow, error) {
 	// Used by insights endpoints. Need to check both for auditors and for regular users with template acl perms.
 	if err := q.authorizeContext(ctx, policy.ActionViewInsights, rbac.ResourceTemplate); err != nil {
@@ -3282,6 +3610,28 @@ func (q *querier) GetUserNotificationPreferences(ctx context.Context, userID uui
 	return q.db.GetUserNotificationPreferences(ctx, userID)
 }
 
+func (q *querier) GetUserNotificationQuietHours(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := q.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionReadPersonal, u); err != nil {
+		return "", err
+	}
+	return q.db.GetUserNotificationQuietHours(ctx, userID)
+}
+
+func (q *querier) GetUserNotificationSnoozeUntil(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := q.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionReadPersonal, u); err != nil {
+		return "", err
+	}
+	return q.db.GetUserNotificationSnoozeUntil(ctx, userID)
+}
+
 func (q *querier) GetUserSecret(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	// First get the secret to check ownership
 	secret, err := q.db.GetUserSecret(ctx, id)
@@ -3607,6 +3957,17 @@ func (q *querier) GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt ti
 	return q.db.GetWorkspaceAppsCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) (bool, error) {
+	w, err := q.db.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return false, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, w.RBACObject()); err != nil {
+		return false, err
+	}
+	return q.db.GetWorkspaceAutostartExceptionOptOut(ctx, workspaceID)
+}
+
 func (q *querier) GetWorkspaceBuildByID(ctx context.Context, buildID uuid.UUID) (database.WorkspaceBuild, error) {
 	build, err := q.db.GetWorkspaceBuildByID(ctx, buildID)
 	if err != nil {
@@ -3702,6 +4063,17 @@ func (q *querier) GetWorkspaceByWorkspaceAppID(ctx context.Context, workspaceApp
 	return fetch(q.log, q.auth, q.db.GetWorkspaceByWorkspaceAppID)(ctx, workspaceAppID)
 }
 
+func (q *querier) GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx context.Context, arg database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams) ([]database.WorkspaceLifecycleDecision, error) {
+	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, w.RBACObject()); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx, arg)
+}
+
 func (q *querier) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -3830,6 +4202,38 @@ func (q *querier) GetWorkspaceResourcesCreatedAfter(ctx context.Context, created
 	return q.db.GetWorkspaceResourcesCreatedAfter(ctx, createdAt)
 }
 
+func (q *querier) GetWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	w, err := q.db.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionRead, w.RBACObject()); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceScheduleWindowsByWorkspaceID(ctx, workspaceID)
+}
+
+func (q *querier) GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceWorkspace.All()); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx, ids)
+}
+
+func (q *querier) GetWorkspaceSessionRecordingByConnectionLogID(ctx context.Context, connectionLogID uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	return fetch(q.log, q.auth, q.db.GetWorkspaceSessionRecordingByConnectionLogID)(ctx, connectionLogID)
+}
+
+// GetWorkspaceSessionRecordingChunks is only used by the system after the
+// recording itself has been authorized with
+// GetWorkspaceSessionRecordingByConnectionLogID.
+func (q *querier) GetWorkspaceSessionRecordingChunks(ctx context.Context, arg database.GetWorkspaceSessionRecordingChunksParams) ([]database.WorkspaceSessionRecordingChunk, error) {
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+	return q.db.GetWorkspaceSessionRecordingChunks(ctx, arg)
+}
+
 func (q *querier) GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx context.Context, templateIDs []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
 		return nil, err
@@ -3875,6 +4279,29 @@ func (q *querier) InsertAIBridgeInterception(ctx context.Context, arg database.I
 	return insert(q.log, q.auth, rbac.ResourceAibridgeInterception.WithOwner(arg.InitiatorID.String()), q.db.InsertAIBridgeInterception)(ctx, arg)
 }
 
+func (q *querier) InsertAIBridgeInterceptionCapture(ctx context.Context, arg database.InsertAIBridgeInterceptionCaptureParams) (database.AIBridgeInterceptionCapture, error) {
+	// All aibridge_interception_captures records belong to the initiator of their associated interception.
+	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionUpdate, arg.InterceptionID); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	return q.db.InsertAIBridgeInterceptionCapture(ctx, arg)
+}
+
+func (q *querier) InsertAIBridgeModelPrices(ctx context.Context, arg database.InsertAIBridgeModelPricesParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.InsertAIBridgeModelPrices(ctx, arg)
+}
+
+func (q *querier) InsertAIBridgePolicyDecision(ctx context.Context, arg database.InsertAIBridgePolicyDecisionParams) (database.AIBridgePolicyDecision, error) {
+	// All aibridge_policy_decisions records belong to the initiator of their associated interception.
+	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionUpdate, arg.InterceptionID); err != nil {
+		return database.AIBridgePolicyDecision{}, err
+	}
+	return q.db.InsertAIBridgePolicyDecision(ctx, arg)
+}
+
 func (q *querier) InsertAIBridgeTokenUsage(ctx context.Context, arg database.InsertAIBridgeTokenUsageParams) (database.AIBridgeTokenUsage, error) {
 	// All aibridge_token_usages records belong to the initiator of their associated interception.
 	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionUpdate, arg.InterceptionID); err != nil {
@@ -3922,6 +4349,13 @@ func (q *querier) InsertAuditLog(ctx context.Context, arg database.InsertAuditLo
 	return insert(q.log, q.auth, rbac.ResourceAuditLog, q.db.InsertAuditLog)(ctx, arg)
 }
 
+func (q *querier) InsertAuditLogHashChainEntry(ctx context.Context, arg database.InsertAuditLogHashChainEntryParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceAuditLog); err != nil {
+		return err
+	}
+	return q.db.InsertAuditLogHashChainEntry(ctx, arg)
+}
+
 func (q *querier) InsertCryptoKey(ctx context.Context, arg database.InsertCryptoKeyParams) (database.CryptoKey, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceCryptoKey); err != nil {
 		return database.CryptoKey{}, err
@@ -4024,6 +4458,27 @@ func (q *querier) InsertMissingGroups(ctx context.Context, arg database.InsertMi
 	return q.db.InsertMissingGroups(ctx, arg)
 }
 
+func (q *querier) InsertNotificationDigestMessage(ctx context.Context, arg database.InsertNotificationDigestMessageParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceNotificationMessage); err != nil {
+		return err
+	}
+	return q.db.InsertNotificationDigestMessage(ctx, arg)
+}
+
+func (q *querier) InsertNotificationUserTarget(ctx context.Context, arg database.InsertNotificationUserTargetParams) (database.NotificationUserTarget, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationPreference.WithOwner(arg.UserID.String())); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	return q.db.InsertNotificationUserTarget(ctx, arg)
+}
+
+func (q *querier) InsertNotificationWebhookSecret(ctx context.Context, arg database.InsertNotificationWebhookSecretParams) (database.NotificationWebhookSecret, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return database.NotificationWebhookSecret{}, err
+	}
+	return q.db.InsertNotificationWebhookSecret(ctx, arg)
+}
+
 func (q *querier) InsertOAuth2ProviderApp(ctx context.Context, arg database.InsertOAuth2ProviderAppParams) (database.OAuth2ProviderApp, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceOauth2App); err != nil {
 		return database.OAuth2ProviderApp{}, err
@@ -4075,6 +4530,13 @@ func (q *querier) InsertOrganizationMember(ctx context.Context, arg database.Ins
 	return insert(q.log, q.auth, obj, q.db.InsertOrganizationMember)(ctx, arg)
 }
 
+func (q *querier) InsertPrebuildClaim(ctx context.Context, arg database.InsertPrebuildClaimParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.InsertPrebuildClaim(ctx, arg)
+}
+
 func (q *querier) InsertPreset(ctx context.Context, arg database.InsertPresetParams) (database.TemplateVersionPreset, error) {
 	err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceTemplate)
 	if err != nil {
@@ -4143,6 +4605,17 @@ func (q *querier) InsertTask(ctx context.Context, arg database.InsertTaskParams)
 	return insert(q.log, q.auth, obj, q.db.InsertTask)(ctx, arg)
 }
 
+func (q *querier) InsertTaskStep(ctx context.Context, arg database.InsertTaskStepParams) (database.TaskStep, error) {
+	workspace, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return database.TaskStep{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace); err != nil {
+		return database.TaskStep{}, err
+	}
+	return q.db.InsertTaskStep(ctx, arg)
+}
+
 func (q *querier) InsertTelemetryItemIfNotExists(ctx context.Context, arg database.InsertTelemetryItemIfNotExistsParams) error {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return err
@@ -4366,6 +4839,13 @@ func (q *querier) InsertWorkspaceAppStatus(ctx context.Context, arg database.Ins
 	return q.db.InsertWorkspaceAppStatus(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceAutostartExceptionOptOut(ctx context.Context, arg database.InsertWorkspaceAutostartExceptionOptOutParams) error {
+	fetch := func(ctx context.Context, arg database.InsertWorkspaceAutostartExceptionOptOutParams) (database.Workspace, error) {
+		return q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	}
+	return update(q.log, q.auth, fetch, q.db.InsertWorkspaceAutostartExceptionOptOut)(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceBuild(ctx context.Context, arg database.InsertWorkspaceBuildParams) error {
 	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
 	if err != nil {
@@ -4428,6 +4908,13 @@ func (q *querier) InsertWorkspaceBuildParameters(ctx context.Context, arg databa
 	return q.db.InsertWorkspaceBuildParameters(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceLifecycleDecision(ctx context.Context, arg database.InsertWorkspaceLifecycleDecisionParams) (database.WorkspaceLifecycleDecision, error) {
+	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
+		return database.WorkspaceLifecycleDecision{}, err
+	}
+	return q.db.InsertWorkspaceLifecycleDecision(ctx, arg)
+}
+
 func (q *querier) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	if err := q.authorizeContext(ctx, policy.ActionCreate, rbac.ResourceSystem); err != nil {
 		return database.WorkspaceModule{}, err
@@ -4453,6 +4940,24 @@ func (q *querier) InsertWorkspaceResourceMetadata(ctx context.Context, arg datab
 	return q.db.InsertWorkspaceResourceMetadata(ctx, arg)
 }
 
+func (q *querier) InsertWorkspaceScheduleWindow(ctx context.Context, arg database.InsertWorkspaceScheduleWindowParams) (database.WorkspaceScheduleWindow, error) {
+	w, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return database.WorkspaceScheduleWindow{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, w.RBACObject()); err != nil {
+		return database.WorkspaceScheduleWindow{}, err
+	}
+	return q.db.InsertWorkspaceScheduleWindow(ctx, arg)
+}
+
+func (q *querier) InsertWorkspaceSessionRecordingChunk(ctx context.Context, arg database.InsertWorkspaceSessionRecordingChunkParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceConnectionLog); err != nil {
+		return err
+	}
+	return q.db.InsertWorkspaceSessionRecordingChunk(ctx, arg)
+}
+
 func (q *querier) ListAIBridgeInterceptions(ctx context.Context, arg database.ListAIBridgeInterceptionsParams) ([]database.AIBridgeInterception, error) {
 	prep, err := prepareSQLFilter(ctx, q.auth, policy.ActionRead, rbac.ResourceAibridgeInterception.Type)
 	if err != nil {
@@ -4461,6 +4966,16 @@ func (q *querier) ListAIBridgeInterceptions(ctx context.Context, arg database.Li
 	return q.db.ListAuthorizedAIBridgeInterceptions(ctx, arg, prep)
 }
 
+func (q *querier) ListAIBridgePolicyDecisionsByInterceptionIDs(ctx context.Context, interceptionIDs []uuid.UUID) ([]database.AIBridgePolicyDecision, error) {
+	// This function is a system function until we implement a join for aibridge interceptions.
+	// Matches the behavior of the workspaces listing endpoint.
+	if err := q.authorizeContext(ctx, policy.ActionRead, rbac.ResourceSystem); err != nil {
+		return nil, err
+	}
+
+	return q.db.ListAIBridgePolicyDecisionsByInterceptionIDs(ctx, interceptionIDs)
+}
+
 func (q *querier) ListAIBridgeTokenUsagesByInterceptionIDs(ctx context.Context, interceptionIDs []uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	// This function is a system function until we implement a join for aibridge interceptions.
 	// Matches the behavior of the workspaces listing endpoint.
@@ -4607,6 +5122,13 @@ func (q *querier) RemoveUserFromGroups(ctx context.Context, arg database.RemoveU
 	return q.db.RemoveUserFromGroups(ctx, arg)
 }
 
+func (q *querier) ResetWorkspacesNextStartAtByOrganizationID(ctx context.Context, organizationID uuid.UUID) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceWorkspace.InOrg(organizationID)); err != nil {
+		return err
+	}
+	return q.db.ResetWorkspacesNextStartAtByOrganizationID(ctx, organizationID)
+}
+
 func (q *querier) RevokeDBCryptKey(ctx context.Context, activeKeyDigest string) error {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
 		return err
@@ -4649,6 +5171,13 @@ func (q *querier) UnfavoriteWorkspace(ctx context.Context, id uuid.UUID) error {
 	return update(q.log, q.auth, fetch, q.db.UnfavoriteWorkspace)(ctx, id)
 }
 
+func (q *querier) UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, arg database.UpdateAIBridgeInterceptionCaptureBodiesParams) error {
+	if err := q.authorizeAIBridgeInterceptionAction(ctx, policy.ActionUpdate, arg.InterceptionID); err != nil {
+		return err
+	}
+	return q.db.UpdateAIBridgeInterceptionCaptureBodies(ctx, arg)
+}
+
 func (q *querier) UpdateAPIKeyByID(ctx context.Context, arg database.UpdateAPIKeyByIDParams) error {
 	fetch := func(ctx context.Context, arg database.UpdateAPIKeyByIDParams) (database.APIKey, error) {
 		return q.db.GetAPIKeyByID(ctx, arg.ID)
@@ -4782,6 +5311,34 @@ func (q *querier) UpdateNotificationTemplateMethodByID(ctx context.Context, arg
 	return q.db.UpdateNotificationTemplateMethodByID(ctx, arg)
 }
 
+func (q *querier) UpdateNotificationTemplateOverridesByID(ctx context.Context, arg database.UpdateNotificationTemplateOverridesByIDParams) (database.NotificationTemplate, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationTemplate); err != nil {
+		return database.NotificationTemplate{}, err
+	}
+	return q.db.UpdateNotificationTemplateOverridesByID(ctx, arg)
+}
+
+func (q *querier) UpdateNotificationTemplateUrgencyByID(ctx context.Context, arg database.UpdateNotificationTemplateUrgencyByIDParams) (database.NotificationTemplate, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationTemplate); err != nil {
+		return database.NotificationTemplate{}, err
+	}
+	return q.db.UpdateNotificationTemplateUrgencyByID(ctx, arg)
+}
+
+func (q *querier) UpdateNotificationUserTargetSecret(ctx context.Context, arg database.UpdateNotificationUserTargetSecretParams) (database.NotificationUserTarget, error) {
+	fetchFunc := func(ctx context.Context, arg database.UpdateNotificationUserTargetSecretParams) (database.NotificationUserTarget, error) {
+		return q.db.GetNotificationUserTargetByID(ctx, arg.ID)
+	}
+	return updateWithReturn(q.log, q.auth, fetchFunc, q.db.UpdateNotificationUserTargetSecret)(ctx, arg)
+}
+
+func (q *querier) UpdateNotificationWebhookSecret(ctx context.Context, arg database.UpdateNotificationWebhookSecretParams) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return err
+	}
+	return q.db.UpdateNotificationWebhookSecret(ctx, arg)
+}
+
 func (q *querier) UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg database.UpdateOAuth2ProviderAppByClientIDParams) (database.OAuth2ProviderApp, error) {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceOauth2App); err != nil {
 		return database.OAuth2ProviderApp{}, err
@@ -4967,6 +5524,17 @@ func (q *querier) UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg
 	return q.db.UpdateTailnetPeerStatusByCoordinator(ctx, arg)
 }
 
+func (q *querier) UpdateTaskStepStatus(ctx context.Context, arg database.UpdateTaskStepStatusParams) (database.TaskStep, error) {
+	workspace, err := q.db.GetWorkspaceByID(ctx, arg.WorkspaceID)
+	if err != nil {
+		return database.TaskStep{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, workspace); err != nil {
+		return database.TaskStep{}, err
+	}
+	return q.db.UpdateTaskStepStatus(ctx, arg)
+}
+
 func (q *querier) UpdateTemplateACLByID(ctx context.Context, arg database.UpdateTemplateACLByIDParams) error {
 	fetch := func(ctx context.Context, arg database.UpdateTemplateACLByIDParams) (database.Template, error) {
 		return q.db.GetTemplateByID(ctx, arg.ID)
@@ -5112,6 +5680,17 @@ func (q *querier) UpdateUsageEventsPostPublish(ctx context.Context, arg database
 	return q.db.UpdateUsageEventsPostPublish(ctx, arg)
 }
 
+func (q *querier) UpdateUserAIBridgeCapture(ctx context.Context, arg database.UpdateUserAIBridgeCaptureParams) (database.UserConfig, error) {
+	u, err := q.db.GetUserByID(ctx, arg.UserID)
+	if err != nil {
+		return database.UserConfig{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, u); err != nil {
+		return database.UserConfig{}, err
+	}
+	return q.db.UpdateUserAIBridgeCapture(ctx, arg)
+}
+
 func (q *querier) UpdateUserDeletedByID(ctx context.Context, id uuid.UUID) error {
 	return deleteQ(q.log, q.auth, q.db.GetUserByID, q.db.UpdateUserDeletedByID)(ctx, id)
 }
@@ -5190,6 +5769,20 @@ func (q *querier) UpdateUserLoginType(ctx context.Context, arg database.UpdateUs
 	return q.db.UpdateUserLoginType(ctx, arg)
 }
 
+func (q *querier) UpdateUserNotificationPreferenceDigest(ctx context.Context, arg database.UpdateUserNotificationPreferenceDigestParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationPreference.WithOwner(arg.UserID.String())); err != nil {
+		return -1, err
+	}
+	return q.db.UpdateUserNotificationPreferenceDigest(ctx, arg)
+}
+
+func (q *querier) UpdateUserNotificationPreferenceTarget(ctx context.Context, arg database.UpdateUserNotificationPreferenceTargetParams) (int64, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationPreference.WithOwner(arg.UserID.String())); err != nil {
+		return -1, err
+	}
+	return q.db.UpdateUserNotificationPreferenceTarget(ctx, arg)
+}
+
 func (q *querier) UpdateUserNotificationPreferences(ctx context.Context, arg database.UpdateUserNotificationPreferencesParams) (int64, error) {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceNotificationPreference.WithOwner(arg.UserID.String())); err != nil {
 		return -1, err
@@ -5197,6 +5790,28 @@ func (q *querier) UpdateUserNotificationPreferences(ctx context.Context, arg dat
 	return q.db.UpdateUserNotificationPreferences(ctx, arg)
 }
 
+func (q *querier) UpdateUserNotificationQuietHours(ctx context.Context, arg database.UpdateUserNotificationQuietHoursParams) (database.UserConfig, error) {
+	u, err := q.db.GetUserByID(ctx, arg.UserID)
+	if err != nil {
+		return database.UserConfig{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, u); err != nil {
+		return database.UserConfig{}, err
+	}
+	return q.db.UpdateUserNotificationQuietHours(ctx, arg)
+}
+
+func (q *querier) UpdateUserNotificationSnoozeUntil(ctx context.Context, arg database.UpdateUserNotificationSnoozeUntilParams) (database.UserConfig, error) {
+	u, err := q.db.GetUserByID(ctx, arg.UserID)
+	if err != nil {
+		return database.UserConfig{}, err
+	}
+	if err := q.authorizeContext(ctx, policy.ActionUpdatePersonal, u); err != nil {
+		return database.UserConfig{}, err
+	}
+	return q.db.UpdateUserNotificationSnoozeUntil(ctx, arg)
+}
+
 func (q *querier) UpdateUserProfile(ctx context.Context, arg database.UpdateUserProfileParams) (database.User, error) {
 	u, err := q.db.GetUserByID(ctx, arg.ID)
 	if err != nil {
@@ -5548,6 +6163,20 @@ func (q *querier) UpdateWorkspacesTTLByTemplateID(ctx context.Context, arg datab
 	return q.db.UpdateWorkspacesTTLByTemplateID(ctx, arg)
 }
 
+func (q *querier) UpsertAIBridgeLimit(ctx context.Context, arg database.UpsertAIBridgeLimitParams) (database.AIBridgeLimit, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
+		return database.AIBridgeLimit{}, err
+	}
+	return q.db.UpsertAIBridgeLimit(ctx, arg)
+}
+
+func (q *querier) UpsertAIBridgeUsageStats(ctx context.Context) error {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceSystem); err != nil {
+		return err
+	}
+	return q.db.UpsertAIBridgeUsageStats(ctx)
+}
+
 func (q *querier) UpsertAnnouncementBanners(ctx context.Context, value string) error {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceDeploymentConfig); err != nil {
 		return err
@@ -5569,6 +6198,13 @@ func (q *querier) UpsertApplicationName(ctx context.Context, value string) error
 	return q.db.UpsertApplicationName(ctx, value)
 }
 
+func (q *querier) UpsertAutostartExceptionCalendar(ctx context.Context, arg database.UpsertAutostartExceptionCalendarParams) (database.AutostartExceptionCalendar, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceOrganization.WithID(arg.OrganizationID).InOrg(arg.OrganizationID)); err != nil {
+		return database.AutostartExceptionCalendar{}, err
+	}
+	return q.db.UpsertAutostartExceptionCalendar(ctx, arg)
+}
+
 func (q *querier) UpsertConnectionLog(ctx context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
 	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceConnectionLog); err != nil {
 		return database.ConnectionLog{}, err
@@ -5777,6 +6413,13 @@ func (q *querier) UpsertWorkspaceAppAuditSession(ctx context.Context, arg databa
 	return q.db.UpsertWorkspaceAppAuditSession(ctx, arg)
 }
 
+func (q *querier) UpsertWorkspaceSessionRecording(ctx context.Context, arg database.UpsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	if err := q.authorizeContext(ctx, policy.ActionUpdate, rbac.ResourceConnectionLog); err != nil {
+		return database.WorkspaceSessionRecording{}, err
+	}
+	return q.db.UpsertWorkspaceSessionRecording(ctx, arg)
+}
+
 func (q *querier) ValidateGroupIDs(ctx context.Context, groupIDs []uuid.UUID) (database.ValidateGroupIDsRow, error) {
 	// This check is probably overly restrictive, but the "correct" check isn't
 	// necessarily obvious. It's only used as a verification check for ACLs right
@@ -315,6 +315,42 @@ func (s *MethodTestSuite) TestAuditLogs() {
 		dbm.EXPECT().DeleteOldAuditLogConnectionEvents(gomock.Any(), database.DeleteOldAuditLogConnectionEventsParams{}).Return(nil).AnyTimes()
 		check.Args(database.DeleteOldAuditLogConnectionEventsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
 	}))
+	s.Run("DeleteOldAuditLogs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldAuditLogs(gomock.Any(), database.DeleteOldAuditLogsParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldAuditLogsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("DeleteOldAuditLogHashChainCheckpoints", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldAuditLogHashChainCheckpoints(gomock.Any()).Return(nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("GetAuditLogsByIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().GetAuditLogsByIDs(gomock.Any(), ids).Return([]database.AuditLog{}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("GetLatestAuditLogHashChainEntry", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetLatestAuditLogHashChainEntry(gomock.Any()).Return(database.AuditLogHashChain{}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("GetAuditLogHashChainEntries", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetAuditLogHashChainEntriesParams{AfterSeq: 10, LimitCount: 100}
+		dbm.EXPECT().GetAuditLogHashChainEntries(gomock.Any(), arg).Return([]database.AuditLogHashChain{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("GetLatestAuditLogHashChainCheckpoint", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().GetLatestAuditLogHashChainCheckpoint(gomock.Any()).Return(database.AuditLogHashChainCheckpoint{}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("GetAuditLogHashChainCheckpoints", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetAuditLogHashChainCheckpointsParams{AfterSeq: 10, BeforeSeq: 20}
+		dbm.EXPECT().GetAuditLogHashChainCheckpoints(gomock.Any(), arg).Return([]database.AuditLogHashChainCheckpoint{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceAuditLog, policy.ActionRead)
+	}))
+	s.Run("InsertAuditLogHashChainEntry", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertAuditLogHashChainEntryParams{Seq: 1, AuditLogID: uuid.New(), PreviousHash: []byte{}, Hash: []byte{1}}
+		dbm.EXPECT().InsertAuditLogHashChainEntry(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceAuditLog, policy.ActionCreate)
+	}))
 }
 
 func (s *MethodTestSuite) TestConnectionLogs() {
@@ -346,6 +382,43 @@ func (s *MethodTestSuite) TestConnectionLogs() {
 		dbm.EXPECT().CountConnectionLogs(gomock.Any(), database.CountConnectionLogsParams{}).Return(int64(0), nil).AnyTimes()
 		check.Args(database.CountConnectionLogsParams{}, emptyPreparedAuthorized{}).Asserts(rbac.ResourceConnectionLog, policy.ActionRead)
 	}))
+	s.Run("DeleteOldConnectionLogs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldConnectionLogs(gomock.Any(), database.DeleteOldConnectionLogsParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldConnectionLogsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+}
+
+func (s *MethodTestSuite) TestWorkspaceSessionRecordings() {
+	s.Run("UpsertWorkspaceSessionRecording", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		rec := testutil.Fake(s.T(), faker, database.WorkspaceSessionRecording{})
+		arg := database.UpsertWorkspaceSessionRecordingParams{ID: rec.ID, OrganizationID: rec.OrganizationID, WorkspaceID: rec.WorkspaceID, ConnectionID: rec.ConnectionID}
+		dbm.EXPECT().UpsertWorkspaceSessionRecording(gomock.Any(), arg).Return(rec, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceConnectionLog, policy.ActionUpdate).Returns(rec)
+	}))
+	s.Run("InsertWorkspaceSessionRecordingChunk", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertWorkspaceSessionRecordingChunkParams{RecordingID: uuid.New(), Seq: 1, Data: []byte("[0.1,\"o\",\"hi\"]\n")}
+		dbm.EXPECT().InsertWorkspaceSessionRecordingChunk(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceConnectionLog, policy.ActionUpdate)
+	}))
+	s.Run("GetWorkspaceSessionRecordingByConnectionLogID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		rec := testutil.Fake(s.T(), faker, database.WorkspaceSessionRecording{})
+		logID := uuid.New()
+		dbm.EXPECT().GetWorkspaceSessionRecordingByConnectionLogID(gomock.Any(), logID).Return(rec, nil).AnyTimes()
+		check.Args(logID).Asserts(rec, policy.ActionRead).Returns(rec)
+	}))
+	s.Run("GetWorkspaceSessionRecordingChunks", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetWorkspaceSessionRecordingChunksParams{RecordingID: uuid.New(), LimitCount: 100}
+		dbm.EXPECT().GetWorkspaceSessionRecordingChunks(gomock.Any(), arg).Return([]database.WorkspaceSessionRecordingChunk{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.WorkspaceSessionRecordingChunk{})
+	}))
+	s.Run("DeleteOldWorkspaceSessionRecordings", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldWorkspaceSessionRecordings(gomock.Any(), database.DeleteOldWorkspaceSessionRecordingsParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldWorkspaceSessionRecordingsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
+	s.Run("DeleteOldAIBridgeInterceptionCaptures", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldAIBridgeInterceptionCaptures(gomock.Any(), database.DeleteOldAIBridgeInterceptionCapturesParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldAIBridgeInterceptionCapturesParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 }
 
 func (s *MethodTestSuite) TestFile() {
@@ -1263,6 +1336,11 @@ func (s *MethodTestSuite) TestTemplate() {
 		dbm.EXPECT().GetUserLatencyInsights(gomock.Any(), arg).Return([]database.GetUserLatencyInsightsRow{}, nil).AnyTimes()
 		check.Args(arg).Asserts(rbac.ResourceTemplate, policy.ActionViewInsights)
 	}))
+	s.Run("GetPrebuildInsights", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.GetPrebuildInsightsParams{}
+		dbm.EXPECT().GetPrebuildInsights(gomock.Any(), arg).Return([]database.GetPrebuildInsightsRow{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceTemplate, policy.ActionViewInsights)
+	}))
 	s.Run("GetUserActivityInsights", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		arg := database.GetUserActivityInsightsParams{}
 		dbm.EXPECT().GetUserActivityInsights(gomock.Any(), arg).Return([]database.GetUserActivityInsightsRow{}, nil).AnyTimes()
@@ -1435,6 +1513,48 @@ func (s *MethodTestSuite) TestUser() {
 		dbm.EXPECT().UpdateUserThemePreference(gomock.Any(), arg).Return(uc, nil).AnyTimes()
 		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns(uc)
 	}))
+	s.Run("GetUserNotificationQuietHours", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().GetUserNotificationQuietHours(gomock.Any(), u.ID).Return("true", nil).AnyTimes()
+		check.Args(u.ID).Asserts(u, policy.ActionReadPersonal).Returns("true")
+	}))
+	s.Run("UpdateUserNotificationQuietHours", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		uc := database.UserConfig{UserID: u.ID, Key: "notification_quiet_hours", Value: "true"}
+		arg := database.UpdateUserNotificationQuietHoursParams{UserID: u.ID, NotificationQuietHours: uc.Value}
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().UpdateUserNotificationQuietHours(gomock.Any(), arg).Return(uc, nil).AnyTimes()
+		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns(uc)
+	}))
+	s.Run("GetUserAIBridgeCapture", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().GetUserAIBridgeCapture(gomock.Any(), u.ID).Return("true", nil).AnyTimes()
+		check.Args(u.ID).Asserts(u, policy.ActionReadPersonal).Returns("true")
+	}))
+	s.Run("UpdateUserAIBridgeCapture", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		uc := database.UserConfig{UserID: u.ID, Key: "aibridge_capture", Value: "true"}
+		arg := database.UpdateUserAIBridgeCaptureParams{UserID: u.ID, AibridgeCapture: uc.Value}
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().UpdateUserAIBridgeCapture(gomock.Any(), arg).Return(uc, nil).AnyTimes()
+		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns(uc)
+	}))
+	s.Run("GetUserNotificationSnoozeUntil", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().GetUserNotificationSnoozeUntil(gomock.Any(), u.ID).Return("", nil).AnyTimes()
+		check.Args(u.ID).Asserts(u, policy.ActionReadPersonal).Returns("")
+	}))
+	s.Run("UpdateUserNotificationSnoozeUntil", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		u := testutil.Fake(s.T(), faker, database.User{})
+		uc := database.UserConfig{UserID: u.ID, Key: "notification_snooze_until", Value: "2024-01-01T00:00:00Z"}
+		arg := database.UpdateUserNotificationSnoozeUntilParams{UserID: u.ID, NotificationSnoozeUntil: uc.Value}
+		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
+		dbm.EXPECT().UpdateUserNotificationSnoozeUntil(gomock.Any(), arg).Return(uc, nil).AnyTimes()
+		check.Args(arg).Asserts(u, policy.ActionUpdatePersonal).Returns(uc)
+	}))
 	s.Run("GetUserTerminalFont", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		u := testutil.Fake(s.T(), faker, database.User{})
 		dbm.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).AnyTimes()
@@ -2413,6 +2533,50 @@ func (s *MethodTestSuite) TestTasks() {
 		dbm.EXPECT().ListTasks(gomock.Any(), gomock.Any()).Return([]database.Task{t1, t2}, nil).AnyTimes()
 		check.Args(database.ListTasksParams{}).Asserts(t1, policy.ActionRead, t2, policy.ActionRead).Returns([]database.Task{t1, t2})
 	}))
+	s.Run("GetStaleTaskSteps", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		before := dbtime.Now()
+		dbm.EXPECT().GetStaleTaskSteps(gomock.Any(), before).Return([]database.TaskStep{}, nil).AnyTimes()
+		check.Args(before).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.TaskStep{})
+	}))
+	s.Run("GetTaskStepsByWorkspaceID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		step := testutil.Fake(s.T(), faker, database.TaskStep{WorkspaceID: ws.ID})
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().GetTaskStepsByWorkspaceID(gomock.Any(), ws.ID).Return([]database.TaskStep{step}, nil).AnyTimes()
+		check.Args(ws.ID).Asserts(ws, policy.ActionRead).Returns([]database.TaskStep{step})
+	}))
+	s.Run("GetTaskStepsByWorkspaceIDs", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().GetTaskStepsByWorkspaceIDs(gomock.Any(), ids).Return([]database.TaskStep{}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.TaskStep{})
+	}))
+	s.Run("GetTaskWorkspacesForLifecyclePolicy", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ownerID := uuid.New()
+		dbm.EXPECT().GetTaskWorkspacesForLifecyclePolicy(gomock.Any(), ownerID).Return([]database.GetTaskWorkspacesForLifecyclePolicyRow{}, nil).AnyTimes()
+		check.Args(ownerID).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.GetTaskWorkspacesForLifecyclePolicyRow{})
+	}))
+	s.Run("InsertTaskStep", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		arg := database.InsertTaskStepParams{
+			WorkspaceID: ws.ID,
+			Prompt:      "Run the tests.",
+			Status:      database.TaskStepStatusPending,
+		}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().InsertTaskStep(gomock.Any(), arg).Return(database.TaskStep{}, nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionUpdate).Returns(database.TaskStep{})
+	}))
+	s.Run("UpdateTaskStepStatus", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ws := testutil.Fake(s.T(), faker, database.Workspace{})
+		arg := database.UpdateTaskStepStatusParams{
+			WorkspaceID: ws.ID,
+			Status:      database.TaskStepStatusChecking,
+			FromStatus:  database.TaskStepStatusRunning,
+		}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), ws.ID).Return(ws, nil).AnyTimes()
+		dbm.EXPECT().UpdateTaskStepStatus(gomock.Any(), arg).Return(database.TaskStep{}, nil).AnyTimes()
+		check.Args(arg).Asserts(ws, policy.ActionUpdate).Returns(database.TaskStep{})
+	}))
 }
 
 func (s *MethodTestSuite) TestProvisionerKeys() {
@@ -3511,6 +3675,11 @@ func (s *MethodTestSuite) TestNotifications() {
 		dbm.EXPECT().BulkMarkNotificationMessagesSent(gomock.Any(), database.BulkMarkNotificationMessagesSentParams{}).Return(int64(0), nil).AnyTimes()
 		check.Args(database.BulkMarkNotificationMessagesSentParams{}).Asserts(rbac.ResourceNotificationMessage, policy.ActionUpdate)
 	}))
+	s.Run("DeferNotificationMessage", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.DeferNotificationMessageParams{ID: uuid.New()}
+		dbm.EXPECT().DeferNotificationMessage(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationMessage, policy.ActionUpdate)
+	}))
 	s.Run("DeleteOldNotificationMessages", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		dbm.EXPECT().DeleteOldNotificationMessages(gomock.Any()).Return(nil).AnyTimes()
 		check.Args().Asserts(rbac.ResourceNotificationMessage, policy.ActionDelete)
@@ -3527,6 +3696,21 @@ func (s *MethodTestSuite) TestNotifications() {
 		check.Args(database.FetchNewMessageMetadataParams{UserID: u.ID}).
 			Asserts(rbac.ResourceNotificationMessage, policy.ActionRead)
 	}))
+	s.Run("InsertNotificationDigestMessage", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertNotificationDigestMessageParams{Method: database.NotificationMethodSmtp, Payload: []byte("{}")}
+		dbm.EXPECT().InsertNotificationDigestMessage(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationMessage, policy.ActionCreate)
+	}))
+	s.Run("GetDueNotificationDigestMessages", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		heldBefore := dbtime.Now()
+		dbm.EXPECT().GetDueNotificationDigestMessages(gomock.Any(), heldBefore).Return([]database.NotificationDigestMessage{}, nil).AnyTimes()
+		check.Args(heldBefore).Asserts(rbac.ResourceNotificationMessage, policy.ActionRead)
+	}))
+	s.Run("DeleteNotificationDigestMessages", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{uuid.New()}
+		dbm.EXPECT().DeleteNotificationDigestMessages(gomock.Any(), ids).Return(nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceNotificationMessage, policy.ActionDelete)
+	}))
 	s.Run("GetNotificationMessagesByStatus", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		arg := database.GetNotificationMessagesByStatusParams{Status: database.NotificationMessageStatusLeased, Limit: 10}
 		dbm.EXPECT().GetNotificationMessagesByStatus(gomock.Any(), arg).Return([]database.NotificationMessage{}, nil).AnyTimes()
@@ -3579,6 +3763,16 @@ func (s *MethodTestSuite) TestNotifications() {
 		dbm.EXPECT().UpdateNotificationTemplateMethodByID(gomock.Any(), arg).Return(database.NotificationTemplate{}, nil).AnyTimes()
 		check.Args(arg).Asserts(rbac.ResourceNotificationTemplate, policy.ActionUpdate)
 	}))
+	s.Run("UpdateNotificationTemplateOverridesByID", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.UpdateNotificationTemplateOverridesByIDParams{TitleTemplateOverride: sql.NullString{String: "title", Valid: true}, ID: notifications.TemplateWorkspaceDormant}
+		dbm.EXPECT().UpdateNotificationTemplateOverridesByID(gomock.Any(), arg).Return(database.NotificationTemplate{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationTemplate, policy.ActionUpdate)
+	}))
+	s.Run("UpdateNotificationTemplateUrgencyByID", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.UpdateNotificationTemplateUrgencyByIDParams{Urgent: true, ID: notifications.TemplateWorkspaceDormant}
+		dbm.EXPECT().UpdateNotificationTemplateUrgencyByID(gomock.Any(), arg).Return(database.NotificationTemplate{}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationTemplate, policy.ActionUpdate)
+	}))
 
 	// Notification preferences
 	s.Run("GetUserNotificationPreferences", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
@@ -3592,6 +3786,79 @@ func (s *MethodTestSuite) TestNotifications() {
 		dbm.EXPECT().UpdateUserNotificationPreferences(gomock.Any(), arg).Return(int64(2), nil).AnyTimes()
 		check.Args(arg).Asserts(rbac.ResourceNotificationPreference.WithOwner(user.ID.String()), policy.ActionUpdate)
 	}))
+	s.Run("UpdateUserNotificationPreferenceTarget", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		arg := database.UpdateUserNotificationPreferenceTargetParams{UserID: user.ID, UserTargetID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, NotificationTemplateID: notifications.TemplateWorkspaceDeleted}
+		dbm.EXPECT().UpdateUserNotificationPreferenceTarget(gomock.Any(), arg).Return(int64(1), nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationPreference.WithOwner(user.ID.String()), policy.ActionUpdate)
+	}))
+	s.Run("UpdateUserNotificationPreferenceDigest", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		arg := database.UpdateUserNotificationPreferenceDigestParams{UserID: user.ID, Digest: true, NotificationTemplateID: notifications.TemplateWorkspaceDeleted}
+		dbm.EXPECT().UpdateUserNotificationPreferenceDigest(gomock.Any(), arg).Return(int64(1), nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationPreference.WithOwner(user.ID.String()), policy.ActionUpdate)
+	}))
+	s.Run("GetNotificationUserTargetsByUserID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		target := testutil.Fake(s.T(), faker, database.NotificationUserTarget{UserID: user.ID})
+		dbm.EXPECT().GetNotificationUserTargetsByUserID(gomock.Any(), user.ID).Return([]database.NotificationUserTarget{target}, nil).AnyTimes()
+		check.Args(user.ID).Asserts(rbac.ResourceNotificationPreference.WithOwner(user.ID.String()), policy.ActionRead).Returns([]database.NotificationUserTarget{target})
+	}))
+	s.Run("GetNotificationUserTargetByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		target := testutil.Fake(s.T(), faker, database.NotificationUserTarget{})
+		dbm.EXPECT().GetNotificationUserTargetByID(gomock.Any(), target.ID).Return(target, nil).AnyTimes()
+		check.Args(target.ID).Asserts(target, policy.ActionRead).Returns(target)
+	}))
+	s.Run("GetNotificationUserTargetByPreference", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		target := testutil.Fake(s.T(), faker, database.NotificationUserTarget{})
+		arg := database.GetNotificationUserTargetByPreferenceParams{UserID: target.UserID, NotificationTemplateID: notifications.TemplateWorkspaceDeleted}
+		dbm.EXPECT().GetNotificationUserTargetByPreference(gomock.Any(), arg).Return(target, nil).AnyTimes()
+		check.Args(arg).Asserts(target, policy.ActionRead).Returns(target)
+	}))
+	s.Run("InsertNotificationUserTarget", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		user := testutil.Fake(s.T(), faker, database.User{})
+		arg := database.InsertNotificationUserTargetParams{ID: uuid.New(), UserID: user.ID, Name: "personal", Type: database.NotificationUserTargetTypeWebhook, Endpoint: "https://example.com"}
+		dbm.EXPECT().InsertNotificationUserTarget(gomock.Any(), arg).Return(database.NotificationUserTarget{ID: arg.ID, UserID: user.ID}, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceNotificationPreference.WithOwner(user.ID.String()), policy.ActionUpdate)
+	}))
+	s.Run("UpdateNotificationUserTargetSecret", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		target := testutil.Fake(s.T(), faker, database.NotificationUserTarget{})
+		arg := database.UpdateNotificationUserTargetSecretParams{ID: target.ID, Secret: "secret"}
+		dbm.EXPECT().GetNotificationUserTargetByID(gomock.Any(), target.ID).Return(target, nil).AnyTimes()
+		dbm.EXPECT().UpdateNotificationUserTargetSecret(gomock.Any(), arg).Return(target, nil).AnyTimes()
+		check.Args(arg).Asserts(target, policy.ActionUpdate).Returns(target)
+	}))
+	s.Run("DeleteNotificationUserTargetByID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		target := testutil.Fake(s.T(), faker, database.NotificationUserTarget{})
+		dbm.EXPECT().GetNotificationUserTargetByID(gomock.Any(), target.ID).Return(target, nil).AnyTimes()
+		dbm.EXPECT().DeleteNotificationUserTargetByID(gomock.Any(), target.ID).Return(nil).AnyTimes()
+		check.Args(target.ID).Asserts(target, policy.ActionUpdate).Returns()
+	}))
+	s.Run("GetNotificationWebhookSecrets", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		secret := testutil.Fake(s.T(), faker, database.NotificationWebhookSecret{})
+		dbm.EXPECT().GetNotificationWebhookSecrets(gomock.Any()).Return([]database.NotificationWebhookSecret{secret}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceDeploymentConfig, policy.ActionRead).Returns([]database.NotificationWebhookSecret{secret})
+	}))
+	s.Run("InsertNotificationWebhookSecret", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		secret := testutil.Fake(s.T(), faker, database.NotificationWebhookSecret{})
+		arg := database.InsertNotificationWebhookSecretParams{ID: secret.ID, Secret: secret.Secret, CreatedAt: secret.CreatedAt}
+		dbm.EXPECT().InsertNotificationWebhookSecret(gomock.Any(), arg).Return(secret, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate).Returns(secret)
+	}))
+	s.Run("ExpireActiveNotificationWebhookSecret", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		expiresAt := dbtime.Now()
+		dbm.EXPECT().ExpireActiveNotificationWebhookSecret(gomock.Any(), expiresAt).Return(nil).AnyTimes()
+		check.Args(expiresAt).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate).Returns()
+	}))
+	s.Run("DeleteExpiringNotificationWebhookSecrets", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteExpiringNotificationWebhookSecrets(gomock.Any()).Return(nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate).Returns()
+	}))
+	s.Run("UpdateNotificationWebhookSecret", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.UpdateNotificationWebhookSecretParams{ID: uuid.New(), Secret: "secret"}
+		dbm.EXPECT().UpdateNotificationWebhookSecret(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate).Returns()
+	}))
 
 	s.Run("GetInboxNotificationsByUserID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		u := testutil.Fake(s.T(), faker, database.User{})
@@ -3719,9 +3986,23 @@ func (s *MethodTestSuite) TestPrebuilds() {
 			ParameterValues:   []string{"test"},
 		}).Asserts(tv.RBACObject(t1), policy.ActionRead).Returns(uuid.Nil)
 	}))
+	s.Run("GetPrebuildClaimDemand", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		t0 := time.Time{}
+		dbm.EXPECT().GetPrebuildClaimDemand(gomock.Any(), t0).Return([]database.GetPrebuildClaimDemandRow{}, nil).AnyTimes()
+		check.Args(t0).Asserts(rbac.ResourceTemplate.All(), policy.ActionViewInsights)
+	}))
+	s.Run("InsertPrebuildClaim", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		arg := database.InsertPrebuildClaimParams{ID: uuid.New(), PresetID: uuid.New()}
+		dbm.EXPECT().InsertPrebuildClaim(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate)
+	}))
+	s.Run("DeleteOldPrebuildClaims", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldPrebuildClaims(gomock.Any(), database.DeleteOldPrebuildClaimsParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldPrebuildClaimsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 	s.Run("GetPrebuildMetrics", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
-		dbm.EXPECT().GetPrebuildMetrics(gomock.Any()).Return([]database.GetPrebuildMetricsRow{}, nil).AnyTimes()
-		check.Args().Asserts(rbac.ResourceWorkspace.All(), policy.ActionRead)
+		dbm.EXPECT().GetPrebuildMetrics(gomock.Any(), gomock.Any()).Return([]database.GetPrebuildMetricsRow{}, nil).AnyTimes()
+		check.Args(dbtime.Now()).Asserts(rbac.ResourceWorkspace.All(), policy.ActionRead)
 	}))
 	s.Run("GetPrebuildsSettings", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
 		dbm.EXPECT().GetPrebuildsSettings(gomock.Any()).Return("{}", nil).AnyTimes()
@@ -4491,6 +4772,17 @@ func (s *MethodTestSuite) TestAIBridge() {
 		check.Args(params).Asserts(intc, policy.ActionUpdate)
 	}))
 
+	s.Run("InsertAIBridgePolicyDecision", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		intID := uuid.UUID{2}
+		intc := testutil.Fake(s.T(), faker, database.AIBridgeInterception{ID: intID})
+		db.EXPECT().GetAIBridgeInterceptionByID(gomock.Any(), intID).Return(intc, nil).AnyTimes() // Validation.
+
+		params := database.InsertAIBridgePolicyDecisionParams{InterceptionID: intc.ID, Action: database.AIBridgePolicyActionBlock}
+		expected := testutil.Fake(s.T(), faker, database.AIBridgePolicyDecision{InterceptionID: intc.ID, Action: params.Action})
+		db.EXPECT().InsertAIBridgePolicyDecision(gomock.Any(), params).Return(expected, nil).AnyTimes()
+		check.Args(params).Asserts(intc, policy.ActionUpdate)
+	}))
+
 	s.Run("GetAIBridgeInterceptionByID", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		intID := uuid.UUID{2}
 		intc := testutil.Fake(s.T(), faker, database.AIBridgeInterception{ID: intID})
@@ -4498,6 +4790,42 @@ func (s *MethodTestSuite) TestAIBridge() {
 		check.Args(intID).Asserts(intc, policy.ActionRead).Returns(intc)
 	}))
 
+	s.Run("InsertAIBridgeInterceptionCapture", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		intID := uuid.UUID{2}
+		intc := testutil.Fake(s.T(), faker, database.AIBridgeInterception{ID: intID})
+		db.EXPECT().GetAIBridgeInterceptionByID(gomock.Any(), intID).Return(intc, nil).AnyTimes() // Validation.
+
+		params := database.InsertAIBridgeInterceptionCaptureParams{InterceptionID: intc.ID, Method: "POST"}
+		expected := testutil.Fake(s.T(), faker, database.AIBridgeInterceptionCapture{InterceptionID: intc.ID})
+		db.EXPECT().InsertAIBridgeInterceptionCapture(gomock.Any(), params).Return(expected, nil).AnyTimes()
+		check.Args(params).Asserts(intc, policy.ActionUpdate)
+	}))
+
+	s.Run("GetAIBridgeInterceptionCaptureByInterceptionID", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		intID := uuid.UUID{2}
+		intc := testutil.Fake(s.T(), faker, database.AIBridgeInterception{ID: intID})
+		capture := testutil.Fake(s.T(), faker, database.AIBridgeInterceptionCapture{InterceptionID: intID})
+		db.EXPECT().GetAIBridgeInterceptionByID(gomock.Any(), intID).Return(intc, nil).AnyTimes() // Validation.
+		db.EXPECT().GetAIBridgeInterceptionCaptureByInterceptionID(gomock.Any(), intID).Return(capture, nil).AnyTimes()
+		check.Args(intID).Asserts(intc, policy.ActionRead).Returns(capture)
+	}))
+
+	s.Run("GetAIBridgeInterceptionCaptureIDs", s.Mocked(func(db *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{{1}}
+		db.EXPECT().GetAIBridgeInterceptionCaptureIDs(gomock.Any()).Return(ids, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionRead).Returns(ids)
+	}))
+
+	s.Run("UpdateAIBridgeInterceptionCaptureBodies", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		intID := uuid.UUID{2}
+		intc := testutil.Fake(s.T(), faker, database.AIBridgeInterception{ID: intID})
+		db.EXPECT().GetAIBridgeInterceptionByID(gomock.Any(), intID).Return(intc, nil).AnyTimes() // Validation.
+
+		params := database.UpdateAIBridgeInterceptionCaptureBodiesParams{InterceptionID: intID, RequestBody: "{}"}
+		db.EXPECT().UpdateAIBridgeInterceptionCaptureBodies(gomock.Any(), params).Return(nil).AnyTimes()
+		check.Args(params).Asserts(intc, policy.ActionUpdate)
+	}))
+
 	s.Run("GetAIBridgeInterceptions", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
 		a := testutil.Fake(s.T(), faker, database.AIBridgeInterception{})
 		b := testutil.Fake(s.T(), faker, database.AIBridgeInterception{})
@@ -4566,4 +4894,195 @@ func (s *MethodTestSuite) TestAIBridge() {
 		db.EXPECT().ListAIBridgeToolUsagesByInterceptionIDs(gomock.Any(), ids).Return([]database.AIBridgeToolUsage{}, nil).AnyTimes()
 		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.AIBridgeToolUsage{})
 	}))
+
+	s.Run("ListAIBridgePolicyDecisionsByInterceptionIDs", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		ids := []uuid.UUID{{1}}
+		db.EXPECT().ListAIBridgePolicyDecisionsByInterceptionIDs(gomock.Any(), ids).Return([]database.AIBridgePolicyDecision{}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceSystem, policy.ActionRead).Returns([]database.AIBridgePolicyDecision{})
+	}))
+
+	s.Run("UpsertAIBridgeLimit", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.UpsertAIBridgeLimitParams{Scope: database.AIBridgeLimitScopeUser, ScopeID: uuid.UUID{1}}
+		limit := testutil.Fake(s.T(), faker, database.AIBridgeLimit{Scope: params.Scope, ScopeID: params.ScopeID})
+		db.EXPECT().UpsertAIBridgeLimit(gomock.Any(), params).Return(limit, nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate).Returns(limit)
+	}))
+
+	s.Run("DeleteAIBridgeLimit", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.DeleteAIBridgeLimitParams{Scope: database.AIBridgeLimitScopeGroup, ScopeID: uuid.UUID{1}}
+		db.EXPECT().DeleteAIBridgeLimit(gomock.Any(), params).Return(nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate)
+	}))
+
+	s.Run("GetAIBridgeLimits", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		limit := testutil.Fake(s.T(), faker, database.AIBridgeLimit{})
+		db.EXPECT().GetAIBridgeLimits(gomock.Any()).Return([]database.AIBridgeLimit{limit}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceDeploymentConfig, policy.ActionRead).Returns([]database.AIBridgeLimit{limit})
+	}))
+
+	s.Run("GetAIBridgeLimitsByUserID", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		userID := uuid.UUID{3}
+		db.EXPECT().GetAIBridgeLimitsByUserID(gomock.Any(), userID).Return([]database.AIBridgeLimit{}, nil).AnyTimes()
+		check.Args(userID).Asserts(rbac.ResourceAibridgeInterception.WithOwner(userID.String()), policy.ActionRead).Returns([]database.AIBridgeLimit{})
+	}))
+
+	s.Run("UserScope/GetAIBridgeLimitUsage", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.GetAIBridgeLimitUsageParams{Scope: database.AIBridgeLimitScopeUser, ScopeID: uuid.UUID{3}}
+		db.EXPECT().GetAIBridgeLimitUsage(gomock.Any(), params).Return(database.GetAIBridgeLimitUsageRow{}, nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceAibridgeInterception.WithOwner(params.ScopeID.String()), policy.ActionRead)
+	}))
+
+	s.Run("GroupScope/GetAIBridgeLimitUsage", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.GetAIBridgeLimitUsageParams{Scope: database.AIBridgeLimitScopeGroup, ScopeID: uuid.UUID{4}}
+		db.EXPECT().GetAIBridgeLimitUsage(gomock.Any(), params).Return(database.GetAIBridgeLimitUsageRow{}, nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceAibridgeInterception, policy.ActionRead)
+	}))
+
+	s.Run("GetAIBridgeUsageStats", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.GetAIBridgeUsageStatsParams{StartTime: dbtime.Now().Add(-time.Hour), EndTime: dbtime.Now()}
+		db.EXPECT().GetAIBridgeUsageStats(gomock.Any(), params).Return([]database.GetAIBridgeUsageStatsRow{}, nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceAibridgeInterception, policy.ActionRead).Returns([]database.GetAIBridgeUsageStatsRow{})
+	}))
+
+	s.Run("UpsertAIBridgeUsageStats", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		db.EXPECT().UpsertAIBridgeUsageStats(gomock.Any()).Return(nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceSystem, policy.ActionUpdate)
+	}))
+
+	s.Run("GetAIBridgeModelPrices", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		price := testutil.Fake(s.T(), faker, database.AIBridgeModelPrice{})
+		db.EXPECT().GetAIBridgeModelPrices(gomock.Any()).Return([]database.AIBridgeModelPrice{price}, nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceAibridgeInterception, policy.ActionRead).Returns([]database.AIBridgeModelPrice{price})
+	}))
+
+	s.Run("DeleteAIBridgeModelPrices", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		db.EXPECT().DeleteAIBridgeModelPrices(gomock.Any()).Return(nil).AnyTimes()
+		check.Args().Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate)
+	}))
+
+	s.Run("InsertAIBridgeModelPrices", s.Mocked(func(db *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		params := database.InsertAIBridgeModelPricesParams{
+			Provider:                    []string{"openai"},
+			Model:                       []string{"gpt-4.1"},
+			InputPricePerMillionTokens:  []float64{2},
+			OutputPricePerMillionTokens: []float64{8},
+		}
+		db.EXPECT().InsertAIBridgeModelPrices(gomock.Any(), params).Return(nil).AnyTimes()
+		check.Args(params).Asserts(rbac.ResourceDeploymentConfig, policy.ActionUpdate)
+	}))
+}
+
+func (s *MethodTestSuite) TestAutostartExceptions() {
+	s.Run("GetAutostartExceptionCalendarsByOrganizationID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		o := testutil.Fake(s.T(), faker, database.Organization{})
+		c := testutil.Fake(s.T(), faker, database.AutostartExceptionCalendar{OrganizationID: o.ID, Exceptions: json.RawMessage("[]")})
+		dbm.EXPECT().GetAutostartExceptionCalendarsByOrganizationID(gomock.Any(), o.ID).Return([]database.AutostartExceptionCalendar{c}, nil).AnyTimes()
+		check.Args(o.ID).Asserts(o, policy.ActionRead).Returns([]database.AutostartExceptionCalendar{c})
+	}))
+	s.Run("GetAutostartExceptionCalendarByOrganizationIDAndName", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		o := testutil.Fake(s.T(), faker, database.Organization{})
+		c := testutil.Fake(s.T(), faker, database.AutostartExceptionCalendar{OrganizationID: o.ID, Exceptions: json.RawMessage("[]")})
+		arg := database.GetAutostartExceptionCalendarByOrganizationIDAndNameParams{OrganizationID: o.ID, Name: c.Name}
+		dbm.EXPECT().GetAutostartExceptionCalendarByOrganizationIDAndName(gomock.Any(), arg).Return(c, nil).AnyTimes()
+		check.Args(arg).Asserts(o, policy.ActionRead).Returns(c)
+	}))
+	s.Run("UpsertAutostartExceptionCalendar", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		o := testutil.Fake(s.T(), faker, database.Organization{})
+		c := testutil.Fake(s.T(), faker, database.AutostartExceptionCalendar{OrganizationID: o.ID, Exceptions: json.RawMessage("[]")})
+		arg := database.UpsertAutostartExceptionCalendarParams{ID: c.ID, OrganizationID: o.ID, Name: c.Name, Exceptions: c.Exceptions}
+		dbm.EXPECT().UpsertAutostartExceptionCalendar(gomock.Any(), arg).Return(c, nil).AnyTimes()
+		check.Args(arg).Asserts(o, policy.ActionUpdate).Returns(c)
+	}))
+	s.Run("DeleteAutostartExceptionCalendarByOrganizationIDAndName", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		o := testutil.Fake(s.T(), faker, database.Organization{})
+		arg := database.DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams{OrganizationID: o.ID, Name: "holidays"}
+		dbm.EXPECT().DeleteAutostartExceptionCalendarByOrganizationIDAndName(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(o, policy.ActionUpdate).Returns()
+	}))
+	s.Run("GetWorkspaceAutostartExceptionOptOut", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceAutostartExceptionOptOut(gomock.Any(), w.ID).Return(true, nil).AnyTimes()
+		check.Args(w.ID).Asserts(w, policy.ActionRead).Returns(true)
+	}))
+	s.Run("InsertWorkspaceAutostartExceptionOptOut", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		arg := database.InsertWorkspaceAutostartExceptionOptOutParams{WorkspaceID: w.ID, CreatedAt: dbtime.Now()}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().InsertWorkspaceAutostartExceptionOptOut(gomock.Any(), arg).Return(nil).AnyTimes()
+		check.Args(arg).Asserts(w, policy.ActionUpdate).Returns()
+	}))
+	s.Run("DeleteWorkspaceAutostartExceptionOptOut", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().DeleteWorkspaceAutostartExceptionOptOut(gomock.Any(), w.ID).Return(nil).AnyTimes()
+		check.Args(w.ID).Asserts(w, policy.ActionUpdate).Returns()
+	}))
+	s.Run("ResetWorkspacesNextStartAtByOrganizationID", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		orgID := uuid.New()
+		dbm.EXPECT().ResetWorkspacesNextStartAtByOrganizationID(gomock.Any(), orgID).Return(nil).AnyTimes()
+		check.Args(orgID).Asserts(rbac.ResourceWorkspace.InOrg(orgID), policy.ActionUpdate).Returns()
+	}))
+}
+
+func (s *MethodTestSuite) TestWorkspaceScheduleWindows() {
+	s.Run("GetWorkspaceScheduleWindowsByWorkspaceID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		win := testutil.Fake(s.T(), faker, database.WorkspaceScheduleWindow{WorkspaceID: w.ID})
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceScheduleWindowsByWorkspaceID(gomock.Any(), w.ID).Return([]database.WorkspaceScheduleWindow{win}, nil).AnyTimes()
+		check.Args(w.ID).Asserts(w, policy.ActionRead).Returns([]database.WorkspaceScheduleWindow{win})
+	}))
+	s.Run("GetWorkspaceScheduleWindowsByWorkspaceIDs", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		win := testutil.Fake(s.T(), faker, database.WorkspaceScheduleWindow{})
+		ids := []uuid.UUID{win.WorkspaceID}
+		dbm.EXPECT().GetWorkspaceScheduleWindowsByWorkspaceIDs(gomock.Any(), ids).Return([]database.WorkspaceScheduleWindow{win}, nil).AnyTimes()
+		check.Args(ids).Asserts(rbac.ResourceWorkspace.All(), policy.ActionRead).Returns([]database.WorkspaceScheduleWindow{win})
+	}))
+	s.Run("InsertWorkspaceScheduleWindow", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		win := testutil.Fake(s.T(), faker, database.WorkspaceScheduleWindow{WorkspaceID: w.ID})
+		arg := database.InsertWorkspaceScheduleWindowParams{
+			WorkspaceID:       w.ID,
+			Position:          win.Position,
+			AutostartSchedule: win.AutostartSchedule,
+			Ttl:               win.Ttl,
+			CreatedAt:         win.CreatedAt,
+		}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().InsertWorkspaceScheduleWindow(gomock.Any(), arg).Return(win, nil).AnyTimes()
+		check.Args(arg).Asserts(w, policy.ActionUpdate).Returns(win)
+	}))
+	s.Run("DeleteWorkspaceScheduleWindowsByWorkspaceID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().DeleteWorkspaceScheduleWindowsByWorkspaceID(gomock.Any(), w.ID).Return(nil).AnyTimes()
+		check.Args(w.ID).Asserts(w, policy.ActionUpdate).Returns()
+	}))
+}
+
+func (s *MethodTestSuite) TestWorkspaceLifecycleDecisions() {
+	s.Run("InsertWorkspaceLifecycleDecision", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		d := testutil.Fake(s.T(), faker, database.WorkspaceLifecycleDecision{Outcome: database.WorkspaceLifecycleOutcomeSkipped})
+		arg := database.InsertWorkspaceLifecycleDecisionParams{
+			ID:          d.ID,
+			WorkspaceID: d.WorkspaceID,
+			CreatedAt:   d.CreatedAt,
+			Outcome:     d.Outcome,
+		}
+		dbm.EXPECT().InsertWorkspaceLifecycleDecision(gomock.Any(), arg).Return(d, nil).AnyTimes()
+		check.Args(arg).Asserts(rbac.ResourceSystem, policy.ActionCreate).Returns(d)
+	}))
+	s.Run("GetWorkspaceLifecycleDecisionsByWorkspaceID", s.Mocked(func(dbm *dbmock.MockStore, faker *gofakeit.Faker, check *expects) {
+		w := testutil.Fake(s.T(), faker, database.Workspace{})
+		d := testutil.Fake(s.T(), faker, database.WorkspaceLifecycleDecision{WorkspaceID: w.ID, Outcome: database.WorkspaceLifecycleOutcomeSkipped})
+		arg := database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams{WorkspaceID: w.ID, LimitCount: 10}
+		dbm.EXPECT().GetWorkspaceByID(gomock.Any(), w.ID).Return(w, nil).AnyTimes()
+		dbm.EXPECT().GetWorkspaceLifecycleDecisionsByWorkspaceID(gomock.Any(), arg).Return([]database.WorkspaceLifecycleDecision{d}, nil).AnyTimes()
+		check.Args(arg).Asserts(w, policy.ActionRead).Returns([]database.WorkspaceLifecycleDecision{d})
+	}))
+	s.Run("DeleteOldWorkspaceLifecycleDecisions", s.Mocked(func(dbm *dbmock.MockStore, _ *gofakeit.Faker, check *expects) {
+		dbm.EXPECT().DeleteOldWorkspaceLifecycleDecisions(gomock.Any(), database.DeleteOldWorkspaceLifecycleDecisionsParams{}).Return(int64(0), nil).AnyTimes()
+		check.Args(database.DeleteOldWorkspaceLifecycleDecisionsParams{}).Asserts(rbac.ResourceSystem, policy.ActionDelete)
+	}))
 }
@@ -122,6 +122,32 @@ func ConnectionLog(t testing.TB, db database.Store, seed database.UpsertConnecti
 	return log
 }
 
+// WorkspaceSessionRecording inserts a session recording made up of the given
+// chunks.
+func WorkspaceSessionRecording(t testing.TB, db database.Store, seed database.UpsertWorkspaceSessionRecordingParams, chunks ...[]byte) database.WorkspaceSessionRecording {
+	rec, err := db.UpsertWorkspaceSessionRecording(genCtx, database.UpsertWorkspaceSessionRecordingParams{
+		ID:             takeFirst(seed.ID, uuid.New()),
+		CreatedAt:      takeFirst(seed.CreatedAt, dbtime.Now()),
+		OrganizationID: takeFirst(seed.OrganizationID, uuid.New()),
+		WorkspaceID:    takeFirst(seed.WorkspaceID, uuid.New()),
+		AgentName:      takeFirst(seed.AgentName, testutil.GetRandomName(t)),
+		ConnectionID:   takeFirst(seed.ConnectionID, uuid.New()),
+		Truncated:      seed.Truncated,
+		Completed:      seed.Completed,
+	})
+	require.NoError(t, err, "insert session recording")
+	for i, chunk := range chunks {
+		err = db.InsertWorkspaceSessionRecordingChunk(genCtx, database.InsertWorkspaceSessionRecordingChunkParams{
+			RecordingID: rec.ID,
+			Seq:         int32(i), //nolint:gosec // Test data.
+			Data:        chunk,
+		})
+		require.NoError(t, err, "insert session recording chunk")
+		rec.SizeBytes += int64(len(chunk))
+	}
+	return rec
+}
+
 func Template(t testing.TB, db database.Store, seed database.Template) database.Template {
 	id := takeFirst(seed.ID, uuid.New())
 	if seed.GroupACL == nil {
@@ -603,6 +629,20 @@ func Organization(t testing.TB, db database.Store, orig database.Organization) d
 	return org
 }
 
+func AutostartExceptionCalendar(t testing.TB, db database.Store, orig database.AutostartExceptionCalendar) database.AutostartExceptionCalendar {
+	calendar, err := db.UpsertAutostartExceptionCalendar(genCtx, database.UpsertAutostartExceptionCalendarParams{
+		ID:             takeFirst(orig.ID, uuid.New()),
+		OrganizationID: takeFirst(orig.OrganizationID, uuid.New()),
+		Name:           takeFirst(orig.Name, testutil.GetRandomName(t)),
+		Description:    orig.Description,
+		Exceptions:     takeFirstSlice(orig.Exceptions, json.RawMessage(`[]`)),
+		CreatedAt:      takeFirst(orig.CreatedAt, dbtime.Now()),
+		UpdatedAt:      takeFirst(orig.UpdatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "upsert autostart exception calendar")
+	return calendar
+}
+
 func OrganizationMember(t testing.TB, db database.Store, orig database.OrganizationMember) database.OrganizationMember {
 	mem, err := db.InsertOrganizationMember(genCtx, database.InsertOrganizationMemberParams{
 		OrganizationID: takeFirst(orig.OrganizationID, uuid.New()),
@@ -643,6 +683,33 @@ func WebpushSubscription(t testing.TB, db database.Store, orig database.InsertWe
 	return subscription
 }
 
+func NotificationUserTarget(t testing.TB, db database.Store, orig database.NotificationUserTarget) database.NotificationUserTarget {
+	target, err := db.InsertNotificationUserTarget(genCtx, database.InsertNotificationUserTargetParams{
+		ID:          takeFirst(orig.ID, uuid.New()),
+		UserID:      takeFirst(orig.UserID, uuid.New()),
+		Name:        takeFirst(orig.Name, testutil.GetRandomName(t)),
+		Type:        takeFirst(orig.Type, database.NotificationUserTargetTypeWebhook),
+		Endpoint:    takeFirst(orig.Endpoint, "https://example.com/"+testutil.GetRandomName(t)),
+		Secret:      takeFirst(orig.Secret, uuid.NewString()),
+		SecretKeyID: takeFirst(orig.SecretKeyID, sql.NullString{}),
+		CreatedAt:   takeFirst(orig.CreatedAt, dbtime.Now()),
+		UpdatedAt:   takeFirst(orig.UpdatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert notification user target")
+	return target
+}
+
+func NotificationWebhookSecret(t testing.TB, db database.Store, orig database.NotificationWebhookSecret) database.NotificationWebhookSecret {
+	secret, err := db.InsertNotificationWebhookSecret(genCtx, database.InsertNotificationWebhookSecretParams{
+		ID:          takeFirst(orig.ID, uuid.New()),
+		Secret:      takeFirst(orig.Secret, uuid.NewString()),
+		SecretKeyID: takeFirst(orig.SecretKeyID, sql.NullString{}),
+		CreatedAt:   takeFirst(orig.CreatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert notification webhook secret")
+	return secret
+}
+
 func Group(t testing.TB, db database.Store, orig database.Group) database.Group {
 	t.Helper()
 
@@ -1291,6 +1358,7 @@ func OAuth2ProviderAppCode(t testing.TB, db database.Store, seed database.OAuth2
 		ResourceUri:         seed.ResourceUri,
 		CodeChallenge:       seed.CodeChallenge,
 		CodeChallengeMethod: seed.CodeChallengeMethod,
+		Scopes:              takeFirstSlice(seed.Scopes, database.APIKeyScopes{}),
 	})
 	require.NoError(t, err, "insert oauth2 app code")
 	return code
@@ -1561,6 +1629,39 @@ func AIBridgeToolUsage(t testing.TB, db database.Store, seed database.InsertAIBr
 	return toolUsage
 }
 
+func AIBridgePolicyDecision(t testing.TB, db database.Store, seed database.InsertAIBridgePolicyDecisionParams) database.AIBridgePolicyDecision {
+	decision, err := db.InsertAIBridgePolicyDecision(genCtx, database.InsertAIBridgePolicyDecisionParams{
+		ID:             takeFirst(seed.ID, uuid.New()),
+		InterceptionID: takeFirst(seed.InterceptionID, uuid.New()),
+		Policy:         takeFirst(seed.Policy, "policy"),
+		Action:         takeFirst(seed.Action, database.AIBridgePolicyActionRedact),
+		Reason:         takeFirst(seed.Reason, "reason"),
+		CreatedAt:      takeFirst(seed.CreatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert aibridge policy decision")
+	return decision
+}
+
+func AIBridgeInterceptionCapture(t testing.TB, db database.Store, seed database.InsertAIBridgeInterceptionCaptureParams) database.AIBridgeInterceptionCapture {
+	capture, err := db.InsertAIBridgeInterceptionCapture(genCtx, database.InsertAIBridgeInterceptionCaptureParams{
+		InterceptionID:        takeFirst(seed.InterceptionID, uuid.New()),
+		Method:                takeFirst(seed.Method, "POST"),
+		Path:                  takeFirst(seed.Path, "/openai/v1/chat/completions"),
+		RequestHeaders:        takeFirstSlice(seed.RequestHeaders, json.RawMessage(`{"Content-Type":["application/json"]}`)),
+		RequestBody:           takeFirst(seed.RequestBody, `{"model":"gpt-4o","messages":[]}`),
+		RequestBodyKeyID:      seed.RequestBodyKeyID,
+		RequestBodyTruncated:  seed.RequestBodyTruncated,
+		ResponseStatus:        takeFirst(seed.ResponseStatus, 200),
+		ResponseHeaders:       takeFirstSlice(seed.ResponseHeaders, json.RawMessage(`{"Content-Type":["application/json"]}`)),
+		ResponseBody:          takeFirst(seed.ResponseBody, `{"choices":[]}`),
+		ResponseBodyKeyID:     seed.ResponseBodyKeyID,
+		ResponseBodyTruncated: seed.ResponseBodyTruncated,
+		CreatedAt:             takeFirst(seed.CreatedAt, dbtime.Now()),
+	})
+	require.NoError(t, err, "insert aibridge interception capture")
+	return capture
+}
+
 func Task(t testing.TB, db database.Store, orig database.TaskTable) database.Task {
 	t.Helper()
 
@@ -1603,6 +1704,22 @@ func TaskWorkspaceApp(t testing.TB, db database.Store, orig database.TaskWorkspa
 	return app
 }
 
+func TaskStep(t testing.TB, db database.Store, orig database.TaskStep) database.TaskStep {
+	t.Helper()
+
+	step, err := db.InsertTaskStep(genCtx, database.InsertTaskStepParams{
+		WorkspaceID:     orig.WorkspaceID,
+		StepIndex:       orig.StepIndex,
+		Prompt:          takeFirst(orig.Prompt, testutil.GetRandomName(t)),
+		CompletionCheck: orig.CompletionCheck,
+		Status:          takeFirst(orig.Status, database.TaskStepStatusPending),
+		StartedAt:       orig.StartedAt,
+	})
+	require.NoError(t, err, "failed to insert task step")
+
+	return step
+}
+
 func provisionerJobTiming(t testing.TB, db database.Store, seed database.ProvisionerJobTiming) database.ProvisionerJobTiming {
 	timing, err := db.InsertProvisionerJobTimings(genCtx, database.InsertProvisionerJobTimingsParams{
 		JobID:     takeFirst(seed.JobID, uuid.New()),
@@ -229,6 +229,27 @@ func (m queryMetricsStore) CustomRoles(ctx context.Context, arg database.CustomR
 	return r0, r1
 }
 
+func (m queryMetricsStore) DeferNotificationMessage(ctx context.Context, arg database.DeferNotificationMessageParams) error {
+	start := time.Now()
+	r0 := m.s.DeferNotificationMessage(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeferNotificationMessage").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) DeleteAIBridgeLimit(ctx context.Context, arg database.DeleteAIBridgeLimitParams) error {
+	start := time.Now()
+	r0 := m.s.DeleteAIBridgeLimit(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteAIBridgeLimit").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) DeleteAIBridgeModelPrices(ctx context.Context) error {
+	start := time.Now()
+	r0 := m.s.DeleteAIBridgeModelPrices(ctx)
+	m.queryLatencies.WithLabelValues("DeleteAIBridgeModelPrices").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) DeleteAPIKeyByID(ctx context.Context, id string) error {
 	start := time.Now()
 	err := m.s.DeleteAPIKeyByID(ctx, id)
@@ -271,6 +292,13 @@ func (m queryMetricsStore) DeleteApplicationConnectAPIKeysByUserID(ctx context.C
 	return err
 }
 
+func (m queryMetricsStore) DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams) error {
+	start := time.Now()
+	r0 := m.s.DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteAutostartExceptionCalendarByOrganizationIDAndName").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) DeleteCoordinator(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteCoordinator(ctx, id)
@@ -292,6 +320,13 @@ func (m queryMetricsStore) DeleteCustomRole(ctx context.Context, arg database.De
 	return r0
 }
 
+func (m queryMetricsStore) DeleteExpiringNotificationWebhookSecrets(ctx context.Context) error {
+	start := time.Now()
+	r0 := m.s.DeleteExpiringNotificationWebhookSecrets(ctx)
+	m.queryLatencies.WithLabelValues("DeleteExpiringNotificationWebhookSecrets").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteExternalAuthLink(ctx, arg)
@@ -327,6 +362,20 @@ func (m queryMetricsStore) DeleteLicense(ctx context.Context, id int32) (int32,
 	return licenseID, err
 }
 
+func (m queryMetricsStore) DeleteNotificationDigestMessages(ctx context.Context, ids []uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteNotificationDigestMessages(ctx, ids)
+	m.queryLatencies.WithLabelValues("DeleteNotificationDigestMessages").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) DeleteNotificationUserTargetByID(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteNotificationUserTargetByID(ctx, id)
+	m.queryLatencies.WithLabelValues("DeleteNotificationUserTargetByID").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) DeleteOAuth2ProviderAppByClientID(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteOAuth2ProviderAppByClientID(ctx, id)
@@ -369,6 +418,13 @@ func (m queryMetricsStore) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx conte
 	return r0
 }
 
+func (m queryMetricsStore) DeleteOldAIBridgeInterceptionCaptures(ctx context.Context, arg database.DeleteOldAIBridgeInterceptionCapturesParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldAIBridgeInterceptionCaptures(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldAIBridgeInterceptionCaptures").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteOldAuditLogConnectionEvents(ctx context.Context, threshold database.DeleteOldAuditLogConnectionEventsParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteOldAuditLogConnectionEvents(ctx, threshold)
@@ -376,6 +432,27 @@ func (m queryMetricsStore) DeleteOldAuditLogConnectionEvents(ctx context.Context
 	return r0
 }
 
+func (m queryMetricsStore) DeleteOldAuditLogHashChainCheckpoints(ctx context.Context) error {
+	start := time.Now()
+	r0 := m.s.DeleteOldAuditLogHashChainCheckpoints(ctx)
+	m.queryLatencies.WithLabelValues("DeleteOldAuditLogHashChainCheckpoints").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) DeleteOldAuditLogs(ctx context.Context, arg database.DeleteOldAuditLogsParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldAuditLogs(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldAuditLogs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) DeleteOldConnectionLogs(ctx context.Context, arg database.DeleteOldConnectionLogsParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldConnectionLogs(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldConnectionLogs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteOldNotificationMessages(ctx context.Context) error {
 	start := time.Now()
 	r0 := m.s.DeleteOldNotificationMessages(ctx)
@@ -383,6 +460,13 @@ func (m queryMetricsStore) DeleteOldNotificationMessages(ctx context.Context) er
 	return r0
 }
 
+func (m queryMetricsStore) DeleteOldPrebuildClaims(ctx context.Context, arg database.DeleteOldPrebuildClaimsParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldPrebuildClaims(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldPrebuildClaims").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteOldProvisionerDaemons(ctx context.Context) error {
 	start := time.Now()
 	r0 := m.s.DeleteOldProvisionerDaemons(ctx)
@@ -404,6 +488,20 @@ func (m queryMetricsStore) DeleteOldWorkspaceAgentStats(ctx context.Context) err
 	return err
 }
 
+func (m queryMetricsStore) DeleteOldWorkspaceLifecycleDecisions(ctx context.Context, arg database.DeleteOldWorkspaceLifecycleDecisionsParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldWorkspaceLifecycleDecisions(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldWorkspaceLifecycleDecisions").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) DeleteOldWorkspaceSessionRecordings(ctx context.Context, arg database.DeleteOldWorkspaceSessionRecordingsParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.DeleteOldWorkspaceSessionRecordings(ctx, arg)
+	m.queryLatencies.WithLabelValues("DeleteOldWorkspaceSessionRecordings").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) DeleteOrganizationMember(ctx context.Context, arg database.DeleteOrganizationMemberParams) error {
 	start := time.Now()
 	r0 := m.s.DeleteOrganizationMember(ctx, arg)
@@ -509,6 +607,20 @@ func (m queryMetricsStore) DeleteWorkspaceAgentPortSharesByTemplate(ctx context.
 	return r0
 }
 
+func (m queryMetricsStore) DeleteWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteWorkspaceAutostartExceptionOptOut(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("DeleteWorkspaceAutostartExceptionOptOut").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("DeleteWorkspaceScheduleWindowsByWorkspaceID").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.DeleteWorkspaceSubAgentByID(ctx, id)
@@ -530,6 +642,13 @@ func (m queryMetricsStore) EnqueueNotificationMessage(ctx context.Context, arg d
 	return r0
 }
 
+func (m queryMetricsStore) ExpireActiveNotificationWebhookSecret(ctx context.Context, expiresAt time.Time) error {
+	start := time.Now()
+	r0 := m.s.ExpireActiveNotificationWebhookSecret(ctx, expiresAt)
+	m.queryLatencies.WithLabelValues("ExpireActiveNotificationWebhookSecret").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) ExpirePrebuildsAPIKeys(ctx context.Context, now time.Time) error {
 	start := time.Now()
 	r0 := m.s.ExpirePrebuildsAPIKeys(ctx, now)
@@ -593,6 +712,20 @@ func (m queryMetricsStore) GetAIBridgeInterceptionByID(ctx context.Context, id u
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (database.AIBridgeInterceptionCapture, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, interceptionID)
+	m.queryLatencies.WithLabelValues("GetAIBridgeInterceptionCaptureByInterceptionID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAIBridgeInterceptionCaptureIDs(ctx context.Context) ([]uuid.UUID, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeInterceptionCaptureIDs(ctx)
+	m.queryLatencies.WithLabelValues("GetAIBridgeInterceptionCaptureIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetAIBridgeInterceptions(ctx context.Context) ([]database.AIBridgeInterception, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetAIBridgeInterceptions(ctx)
@@ -600,6 +733,34 @@ func (m queryMetricsStore) GetAIBridgeInterceptions(ctx context.Context) ([]data
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetAIBridgeLimitUsage(ctx context.Context, arg database.GetAIBridgeLimitUsageParams) (database.GetAIBridgeLimitUsageRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeLimitUsage(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetAIBridgeLimitUsage").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAIBridgeLimits(ctx context.Context) ([]database.AIBridgeLimit, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeLimits(ctx)
+	m.queryLatencies.WithLabelValues("GetAIBridgeLimits").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]database.AIBridgeLimit, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeLimitsByUserID(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetAIBridgeLimitsByUserID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAIBridgeModelPrices(ctx context.Context) ([]database.AIBridgeModelPrice, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeModelPrices(ctx)
+	m.queryLatencies.WithLabelValues("GetAIBridgeModelPrices").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetAIBridgeTokenUsagesByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetAIBridgeTokenUsagesByInterceptionID(ctx, interceptionID)
@@ -614,6 +775,13 @@ func (m queryMetricsStore) GetAIBridgeToolUsagesByInterceptionID(ctx context.Con
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetAIBridgeUsageStats(ctx context.Context, arg database.GetAIBridgeUsageStatsParams) ([]database.GetAIBridgeUsageStatsRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAIBridgeUsageStats(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetAIBridgeUsageStats").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetAIBridgeUserPromptsByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeUserPrompt, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetAIBridgeUserPromptsByInterceptionID(ctx, interceptionID)
@@ -726,6 +894,27 @@ func (m queryMetricsStore) GetApplicationName(ctx context.Context) (string, erro
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetAuditLogHashChainCheckpoints(ctx context.Context, arg database.GetAuditLogHashChainCheckpointsParams) ([]database.AuditLogHashChainCheckpoint, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAuditLogHashChainCheckpoints(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetAuditLogHashChainCheckpoints").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAuditLogHashChainEntries(ctx context.Context, arg database.GetAuditLogHashChainEntriesParams) ([]database.AuditLogHashChain, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAuditLogHashChainEntries(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetAuditLogHashChainEntries").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) ([]database.AuditLog, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetAuditLogsByIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetAuditLogsByIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetAuditLogsOffset(ctx context.Context, arg database.GetAuditLogsOffsetParams) ([]database.GetAuditLogsOffsetRow, error) {
 	start := time.Now()
 	rows, err := m.s.GetAuditLogsOffset(ctx, arg)
@@ -740,6 +929,20 @@ func (m queryMetricsStore) GetAuthorizationUserRoles(ctx context.Context, userID
 	return row, err
 }
 
+func (m queryMetricsStore) GetAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.GetAutostartExceptionCalendarByOrganizationIDAndNameParams) (database.AutostartExceptionCalendar, error) {
+	start := time.Now()
+	calendar, err := m.s.GetAutostartExceptionCalendarByOrganizationIDAndName(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetAutostartExceptionCalendarByOrganizationIDAndName").Observe(time.Since(start).Seconds())
+	return calendar, err
+}
+
+func (m queryMetricsStore) GetAutostartExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.AutostartExceptionCalendar, error) {
+	start := time.Now()
+	calendars, err := m.s.GetAutostartExceptionCalendarsByOrganizationID(ctx, organizationID)
+	m.queryLatencies.WithLabelValues("GetAutostartExceptionCalendarsByOrganizationID").Observe(time.Since(start).Seconds())
+	return calendars, err
+}
+
 func (m queryMetricsStore) GetConnectionLogsOffset(ctx context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetConnectionLogsOffset(ctx, arg)
@@ -838,6 +1041,13 @@ func (m queryMetricsStore) GetDeploymentWorkspaceStats(ctx context.Context) (dat
 	return row, err
 }
 
+func (m queryMetricsStore) GetDueNotificationDigestMessages(ctx context.Context, heldBefore time.Time) ([]database.NotificationDigestMessage, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetDueNotificationDigestMessages(ctx, heldBefore)
+	m.queryLatencies.WithLabelValues("GetDueNotificationDigestMessages").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetEligibleProvisionerDaemonsByProvisionerJobIDs(ctx context.Context, provisionerJobIds []uuid.UUID) ([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetEligibleProvisionerDaemonsByProvisionerJobIDs(ctx, provisionerJobIds)
@@ -978,6 +1188,20 @@ func (m queryMetricsStore) GetLastUpdateCheck(ctx context.Context) (string, erro
 	return version, err
 }
 
+func (m queryMetricsStore) GetLatestAuditLogHashChainCheckpoint(ctx context.Context) (database.AuditLogHashChainCheckpoint, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetLatestAuditLogHashChainCheckpoint(ctx)
+	m.queryLatencies.WithLabelValues("GetLatestAuditLogHashChainCheckpoint").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetLatestAuditLogHashChainEntry(ctx context.Context) (database.AuditLogHashChain, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetLatestAuditLogHashChainEntry(ctx)
+	m.queryLatencies.WithLabelValues("GetLatestAuditLogHashChainEntry").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetLatestCryptoKeyByFeature(ctx context.Context, feature database.CryptoKeyFeature) (database.CryptoKey, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetLatestCryptoKeyByFeature(ctx, feature)
@@ -1062,6 +1286,34 @@ func (m queryMetricsStore) GetNotificationTemplatesByKind(ctx context.Context, k
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (database.NotificationUserTarget, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetNotificationUserTargetByID(ctx, id)
+	m.queryLatencies.WithLabelValues("GetNotificationUserTargetByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetNotificationUserTargetByPreference(ctx context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetNotificationUserTargetByPreference(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetNotificationUserTargetByPreference").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]database.NotificationUserTarget, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetNotificationUserTargetsByUserID(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetNotificationUserTargetsByUserID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetNotificationWebhookSecrets(ctx)
+	m.queryLatencies.WithLabelValues("GetNotificationWebhookSecrets").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetNotificationsSettings(ctx context.Context) (string, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetNotificationsSettings(ctx)
@@ -1216,9 +1468,23 @@ func (m queryMetricsStore) GetParameterSchemasByJobID(ctx context.Context, jobID
 	return schemas, err
 }
 
-func (m queryMetricsStore) GetPrebuildMetrics(ctx context.Context) ([]database.GetPrebuildMetricsRow, error) {
+func (m queryMetricsStore) GetPrebuildClaimDemand(ctx context.Context, since time.Time) ([]database.GetPrebuildClaimDemandRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetPrebuildClaimDemand(ctx, since)
+	m.queryLatencies.WithLabelValues("GetPrebuildClaimDemand").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetPrebuildInsights(ctx context.Context, arg database.GetPrebuildInsightsParams) ([]database.GetPrebuildInsightsRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetPrebuildInsights(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetPrebuildInsights").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetPrebuildMetrics(ctx context.Context, autoscalingSince time.Time) ([]database.GetPrebuildMetricsRow, error) {
 	start := time.Now()
-	r0, r1 := m.s.GetPrebuildMetrics(ctx)
+	r0, r1 := m.s.GetPrebuildMetrics(ctx, autoscalingSince)
 	m.queryLatencies.WithLabelValues("GetPrebuildMetrics").Observe(time.Since(start).Seconds())
 	return r0, r1
 }
@@ -1447,6 +1713,13 @@ func (m queryMetricsStore) GetRuntimeConfig(ctx context.Context, key string) (st
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetStaleTaskSteps(ctx context.Context, updatedBefore time.Time) ([]database.TaskStep, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetStaleTaskSteps(ctx, updatedBefore)
+	m.queryLatencies.WithLabelValues("GetStaleTaskSteps").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetTailnetAgents(ctx context.Context, id uuid.UUID) ([]database.TailnetAgent, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetTailnetAgents(ctx, id)
@@ -1496,6 +1769,27 @@ func (m queryMetricsStore) GetTaskByWorkspaceID(ctx context.Context, workspaceID
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetTaskStepsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.TaskStep, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTaskStepsByWorkspaceID(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("GetTaskStepsByWorkspaceID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetTaskStepsByWorkspaceIDs(ctx context.Context, workspaceIds []uuid.UUID) ([]database.TaskStep, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTaskStepsByWorkspaceIDs(ctx, workspaceIds)
+	m.queryLatencies.WithLabelValues("GetTaskStepsByWorkspaceIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetTaskWorkspacesForLifecyclePolicy(ctx context.Context, ownerID uuid.UUID) ([]database.GetTaskWorkspacesForLifecyclePolicyRow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetTaskWorkspacesForLifecyclePolicy(ctx, ownerID)
+	m.queryLatencies.WithLabelValues("GetTaskWorkspacesForLifecyclePolicy").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetTelemetryItem(ctx context.Context, key string) (database.TelemetryItem, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetTelemetryItem(ctx, key)
@@ -1699,6 +1993,13 @@ func (m queryMetricsStore) GetUnexpiredLicenses(ctx context.Context) ([]database
 	return licenses, err
 }
 
+func (m queryMetricsStore) GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetUserAIBridgeCapture(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetUserAIBridgeCapture").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetUserActivityInsights(ctx context.Context, arg database.GetUserActivityInsightsParams) ([]database.GetUserActivityInsightsRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetUserActivityInsights(ctx, arg)
@@ -1762,6 +2063,20 @@ func (m queryMetricsStore) GetUserNotificationPreferences(ctx context.Context, u
 	return r0, r1
 }
 
+func (m queryMetricsStore) GetUserNotificationQuietHours(ctx context.Context, userID uuid.UUID) (string, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetUserNotificationQuietHours(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetUserNotificationQuietHours").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetUserNotificationSnoozeUntil(ctx context.Context, userID uuid.UUID) (string, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetUserNotificationSnoozeUntil(ctx, userID)
+	m.queryLatencies.WithLabelValues("GetUserNotificationSnoozeUntil").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetUserSecret(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetUserSecret(ctx, id)
@@ -2021,6 +2336,13 @@ func (m queryMetricsStore) GetWorkspaceAppsCreatedAfter(ctx context.Context, cre
 	return apps, err
 }
 
+func (m queryMetricsStore) GetWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) (bool, error) {
+	start := time.Now()
+	optedOut, err := m.s.GetWorkspaceAutostartExceptionOptOut(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceAutostartExceptionOptOut").Observe(time.Since(start).Seconds())
+	return optedOut, err
+}
+
 func (m queryMetricsStore) GetWorkspaceBuildByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBuild, error) {
 	start := time.Now()
 	build, err := m.s.GetWorkspaceBuildByID(ctx, id)
@@ -2112,6 +2434,13 @@ func (m queryMetricsStore) GetWorkspaceByWorkspaceAppID(ctx context.Context, wor
 	return workspace, err
 }
 
+func (m queryMetricsStore) GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx context.Context, arg database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams) ([]database.WorkspaceLifecycleDecision, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceLifecycleDecisionsByWorkspaceID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceModulesByJobID(ctx, jobID)
@@ -2196,6 +2525,34 @@ func (m queryMetricsStore) GetWorkspaceResourcesCreatedAfter(ctx context.Context
 	return resources, err
 }
 
+func (m queryMetricsStore) GetWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceScheduleWindowsByWorkspaceID(ctx, workspaceID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceScheduleWindowsByWorkspaceID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx, ids)
+	m.queryLatencies.WithLabelValues("GetWorkspaceScheduleWindowsByWorkspaceIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceSessionRecordingByConnectionLogID(ctx context.Context, connectionLogID uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceSessionRecordingByConnectionLogID(ctx, connectionLogID)
+	m.queryLatencies.WithLabelValues("GetWorkspaceSessionRecordingByConnectionLogID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) GetWorkspaceSessionRecordingChunks(ctx context.Context, arg database.GetWorkspaceSessionRecordingChunksParams) ([]database.WorkspaceSessionRecordingChunk, error) {
+	start := time.Now()
+	r0, r1 := m.s.GetWorkspaceSessionRecordingChunks(ctx, arg)
+	m.queryLatencies.WithLabelValues("GetWorkspaceSessionRecordingChunks").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx context.Context, templateIds []uuid.UUID) ([]database.GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx, templateIds)
@@ -2245,6 +2602,27 @@ func (m queryMetricsStore) InsertAIBridgeInterception(ctx context.Context, arg d
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertAIBridgeInterceptionCapture(ctx context.Context, arg database.InsertAIBridgeInterceptionCaptureParams) (database.AIBridgeInterceptionCapture, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertAIBridgeInterceptionCapture(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertAIBridgeInterceptionCapture").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertAIBridgeModelPrices(ctx context.Context, arg database.InsertAIBridgeModelPricesParams) error {
+	start := time.Now()
+	r0 := m.s.InsertAIBridgeModelPrices(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertAIBridgeModelPrices").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) InsertAIBridgePolicyDecision(ctx context.Context, arg database.InsertAIBridgePolicyDecisionParams) (database.AIBridgePolicyDecision, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertAIBridgePolicyDecision(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertAIBridgePolicyDecision").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertAIBridgeTokenUsage(ctx context.Context, arg database.InsertAIBridgeTokenUsageParams) (database.AIBridgeTokenUsage, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertAIBridgeTokenUsage(ctx, arg)
@@ -2287,6 +2665,13 @@ func (m queryMetricsStore) InsertAuditLog(ctx context.Context, arg database.Inse
 	return log, err
 }
 
+func (m queryMetricsStore) InsertAuditLogHashChainEntry(ctx context.Context, arg database.InsertAuditLogHashChainEntryParams) error {
+	start := time.Now()
+	r0 := m.s.InsertAuditLogHashChainEntry(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertAuditLogHashChainEntry").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) InsertCryptoKey(ctx context.Context, arg database.InsertCryptoKeyParams) (database.CryptoKey, error) {
 	start := time.Now()
 	key, err := m.s.InsertCryptoKey(ctx, arg)
@@ -2385,6 +2770,27 @@ func (m queryMetricsStore) InsertMissingGroups(ctx context.Context, arg database
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertNotificationDigestMessage(ctx context.Context, arg database.InsertNotificationDigestMessageParams) error {
+	start := time.Now()
+	r0 := m.s.InsertNotificationDigestMessage(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertNotificationDigestMessage").Observe(time.Since(start).Seconds())
+	return r0
+}
+
+func (m queryMetricsStore) InsertNotificationUserTarget(ctx context.Context, arg database.InsertNotificationUserTargetParams) (database.NotificationUserTarget, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertNotificationUserTarget(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertNotificationUserTarget").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertNotificationWebhookSecret(ctx context.Context, arg database.InsertNotificationWebhookSecretParams) (database.NotificationWebhookSecret, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertNotificationWebhookSecret(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertNotificationWebhookSecret").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertOAuth2ProviderApp(ctx context.Context, arg database.InsertOAuth2ProviderAppParams) (database.OAuth2ProviderApp, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertOAuth2ProviderApp(ctx, arg)
@@ -2427,6 +2833,13 @@ func (m queryMetricsStore) InsertOrganizationMember(ctx context.Context, arg dat
 	return member, err
 }
 
+func (m queryMetricsStore) InsertPrebuildClaim(ctx context.Context, arg database.InsertPrebuildClaimParams) error {
+	start := time.Now()
+	r0 := m.s.InsertPrebuildClaim(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertPrebuildClaim").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) InsertPreset(ctx context.Context, arg database.InsertPresetParams) (database.TemplateVersionPreset, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertPreset(ctx, arg)
@@ -2490,6 +2903,13 @@ func (m queryMetricsStore) InsertTask(ctx context.Context, arg database.InsertTa
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertTaskStep(ctx context.Context, arg database.InsertTaskStepParams) (database.TaskStep, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertTaskStep(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertTaskStep").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertTelemetryItemIfNotExists(ctx context.Context, arg database.InsertTelemetryItemIfNotExistsParams) error {
 	start := time.Now()
 	r0 := m.s.InsertTelemetryItemIfNotExists(ctx, arg)
@@ -2665,6 +3085,13 @@ func (m queryMetricsStore) InsertWorkspaceAppStatus(ctx context.Context, arg dat
 	return r0, r1
 }
 
+func (m queryMetricsStore) InsertWorkspaceAutostartExceptionOptOut(ctx context.Context, arg database.InsertWorkspaceAutostartExceptionOptOutParams) error {
+	start := time.Now()
+	r0 := m.s.InsertWorkspaceAutostartExceptionOptOut(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceAutostartExceptionOptOut").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) InsertWorkspaceBuild(ctx context.Context, arg database.InsertWorkspaceBuildParams) error {
 	start := time.Now()
 	err := m.s.InsertWorkspaceBuild(ctx, arg)
@@ -2679,6 +3106,13 @@ func (m queryMetricsStore) InsertWorkspaceBuildParameters(ctx context.Context, a
 	return err
 }
 
+func (m queryMetricsStore) InsertWorkspaceLifecycleDecision(ctx context.Context, arg database.InsertWorkspaceLifecycleDecisionParams) (database.WorkspaceLifecycleDecision, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceLifecycleDecision(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceLifecycleDecision").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	start := time.Now()
 	r0, r1 := m.s.InsertWorkspaceModule(ctx, arg)
@@ -2707,6 +3141,20 @@ func (m queryMetricsStore) InsertWorkspaceResourceMetadata(ctx context.Context,
 	return metadata, err
 }
 
+func (m queryMetricsStore) InsertWorkspaceScheduleWindow(ctx context.Context, arg database.InsertWorkspaceScheduleWindowParams) (database.WorkspaceScheduleWindow, error) {
+	start := time.Now()
+	r0, r1 := m.s.InsertWorkspaceScheduleWindow(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceScheduleWindow").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) InsertWorkspaceSessionRecordingChunk(ctx context.Context, arg database.InsertWorkspaceSessionRecordingChunkParams) error {
+	start := time.Now()
+	r0 := m.s.InsertWorkspaceSessionRecordingChunk(ctx, arg)
+	m.queryLatencies.WithLabelValues("InsertWorkspaceSessionRecordingChunk").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) ListAIBridgeInterceptions(ctx context.Context, arg database.ListAIBridgeInterceptionsParams) ([]database.AIBridgeInterception, error) {
 	start := time.Now()
 	r0, r1 := m.s.ListAIBridgeInterceptions(ctx, arg)
@@ -2714,6 +3162,13 @@ func (m queryMetricsStore) ListAIBridgeInterceptions(ctx context.Context, arg da
 	return r0, r1
 }
 
+func (m queryMetricsStore) ListAIBridgePolicyDecisionsByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]database.AIBridgePolicyDecision, error) {
+	start := time.Now()
+	r0, r1 := m.s.ListAIBridgePolicyDecisionsByInterceptionIDs(ctx, interceptionIds)
+	m.queryLatencies.WithLabelValues("ListAIBridgePolicyDecisionsByInterceptionIDs").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) ListAIBridgeTokenUsagesByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	start := time.Now()
 	r0, r1 := m.s.ListAIBridgeTokenUsagesByInterceptionIDs(ctx, interceptionIds)
@@ -2833,6 +3288,13 @@ func (m queryMetricsStore) RemoveUserFromGroups(ctx context.Context, arg databas
 	return r0, r1
 }
 
+func (m queryMetricsStore) ResetWorkspacesNextStartAtByOrganizationID(ctx context.Context, organizationID uuid.UUID) error {
+	start := time.Now()
+	r0 := m.s.ResetWorkspacesNextStartAtByOrganizationID(ctx, organizationID)
+	m.queryLatencies.WithLabelValues("ResetWorkspacesNextStartAtByOrganizationID").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) RevokeDBCryptKey(ctx context.Context, activeKeyDigest string) error {
 	start := time.Now()
 	r0 := m.s.RevokeDBCryptKey(ctx, activeKeyDigest)
@@ -2868,6 +3330,13 @@ func (m queryMetricsStore) UnfavoriteWorkspace(ctx context.Context, arg uuid.UUI
 	return r0
 }
 
+func (m queryMetricsStore) UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, arg database.UpdateAIBridgeInterceptionCaptureBodiesParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateAIBridgeInterceptionCaptureBodies(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateAIBridgeInterceptionCaptureBodies").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) UpdateAPIKeyByID(ctx context.Context, arg database.UpdateAPIKeyByIDParams) error {
 	start := time.Now()
 	err := m.s.UpdateAPIKeyByID(ctx, arg)
@@ -2952,6 +3421,34 @@ func (m queryMetricsStore) UpdateNotificationTemplateMethodByID(ctx context.Cont
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateNotificationTemplateOverridesByID(ctx context.Context, arg database.UpdateNotificationTemplateOverridesByIDParams) (database.NotificationTemplate, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateNotificationTemplateOverridesByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateNotificationTemplateOverridesByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateNotificationTemplateUrgencyByID(ctx context.Context, arg database.UpdateNotificationTemplateUrgencyByIDParams) (database.NotificationTemplate, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateNotificationTemplateUrgencyByID(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateNotificationTemplateUrgencyByID").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateNotificationUserTargetSecret(ctx context.Context, arg database.UpdateNotificationUserTargetSecretParams) (database.NotificationUserTarget, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateNotificationUserTargetSecret(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateNotificationUserTargetSecret").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateNotificationWebhookSecret(ctx context.Context, arg database.UpdateNotificationWebhookSecretParams) error {
+	start := time.Now()
+	r0 := m.s.UpdateNotificationWebhookSecret(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateNotificationWebhookSecret").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg database.UpdateOAuth2ProviderAppByClientIDParams) (database.OAuth2ProviderApp, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpdateOAuth2ProviderAppByClientID(ctx, arg)
@@ -3057,6 +3554,13 @@ func (m queryMetricsStore) UpdateTailnetPeerStatusByCoordinator(ctx context.Cont
 	return r0
 }
 
+func (m queryMetricsStore) UpdateTaskStepStatus(ctx context.Context, arg database.UpdateTaskStepStatusParams) (database.TaskStep, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateTaskStepStatus(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateTaskStepStatus").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateTemplateACLByID(ctx context.Context, arg database.UpdateTemplateACLByIDParams) error {
 	start := time.Now()
 	err := m.s.UpdateTemplateACLByID(ctx, arg)
@@ -3141,6 +3645,13 @@ func (m queryMetricsStore) UpdateUsageEventsPostPublish(ctx context.Context, arg
 	return r0
 }
 
+func (m queryMetricsStore) UpdateUserAIBridgeCapture(ctx context.Context, arg database.UpdateUserAIBridgeCaptureParams) (database.UserConfig, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateUserAIBridgeCapture(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateUserAIBridgeCapture").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateUserDeletedByID(ctx context.Context, id uuid.UUID) error {
 	start := time.Now()
 	r0 := m.s.UpdateUserDeletedByID(ctx, id)
@@ -3197,6 +3708,20 @@ func (m queryMetricsStore) UpdateUserLoginType(ctx context.Context, arg database
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateUserNotificationPreferenceDigest(ctx context.Context, arg database.UpdateUserNotificationPreferenceDigestParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateUserNotificationPreferenceDigest(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateUserNotificationPreferenceDigest").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateUserNotificationPreferenceTarget(ctx context.Context, arg database.UpdateUserNotificationPreferenceTargetParams) (int64, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateUserNotificationPreferenceTarget(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateUserNotificationPreferenceTarget").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateUserNotificationPreferences(ctx context.Context, arg database.UpdateUserNotificationPreferencesParams) (int64, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpdateUserNotificationPreferences(ctx, arg)
@@ -3204,6 +3729,20 @@ func (m queryMetricsStore) UpdateUserNotificationPreferences(ctx context.Context
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpdateUserNotificationQuietHours(ctx context.Context, arg database.UpdateUserNotificationQuietHoursParams) (database.UserConfig, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateUserNotificationQuietHours(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateUserNotificationQuietHours").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpdateUserNotificationSnoozeUntil(ctx context.Context, arg database.UpdateUserNotificationSnoozeUntilParams) (database.UserConfig, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpdateUserNotificationSnoozeUntil(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpdateUserNotificationSnoozeUntil").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) UpdateUserProfile(ctx context.Context, arg database.UpdateUserProfileParams) (database.User, error) {
 	start := time.Now()
 	user, err := m.s.UpdateUserProfile(ctx, arg)
@@ -3421,6 +3960,20 @@ func (m queryMetricsStore) UpdateWorkspacesTTLByTemplateID(ctx context.Context,
 	return r0
 }
 
+func (m queryMetricsStore) UpsertAIBridgeLimit(ctx context.Context, arg database.UpsertAIBridgeLimitParams) (database.AIBridgeLimit, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpsertAIBridgeLimit(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertAIBridgeLimit").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
+func (m queryMetricsStore) UpsertAIBridgeUsageStats(ctx context.Context) error {
+	start := time.Now()
+	r0 := m.s.UpsertAIBridgeUsageStats(ctx)
+	m.queryLatencies.WithLabelValues("UpsertAIBridgeUsageStats").Observe(time.Since(start).Seconds())
+	return r0
+}
+
 func (m queryMetricsStore) UpsertAnnouncementBanners(ctx context.Context, value string) error {
 	start := time.Now()
 	r0 := m.s.UpsertAnnouncementBanners(ctx, value)
@@ -3442,6 +3995,13 @@ func (m queryMetricsStore) UpsertApplicationName(ctx context.Context, value stri
 	return r0
 }
 
+func (m queryMetricsStore) UpsertAutostartExceptionCalendar(ctx context.Context, arg database.UpsertAutostartExceptionCalendarParams) (database.AutostartExceptionCalendar, error) {
+	start := time.Now()
+	calendar, err := m.s.UpsertAutostartExceptionCalendar(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertAutostartExceptionCalendar").Observe(time.Since(start).Seconds())
+	return calendar, err
+}
+
 func (m queryMetricsStore) UpsertConnectionLog(ctx context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
 	start := time.Now()
 	r0, r1 := m.s.UpsertConnectionLog(ctx, arg)
@@ -3624,6 +4184,13 @@ func (m queryMetricsStore) UpsertWorkspaceAppAuditSession(ctx context.Context, a
 	return r0, r1
 }
 
+func (m queryMetricsStore) UpsertWorkspaceSessionRecording(ctx context.Context, arg database.UpsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	start := time.Now()
+	r0, r1 := m.s.UpsertWorkspaceSessionRecording(ctx, arg)
+	m.queryLatencies.WithLabelValues("UpsertWorkspaceSessionRecording").Observe(time.Since(start).Seconds())
+	return r0, r1
+}
+
 func (m queryMetricsStore) ValidateGroupIDs(ctx context.Context, groupIds []uuid.UUID) (database.ValidateGroupIDsRow, error) {
 	start := time.Now()
 	r0, r1 := m.s.ValidateGroupIDs(ctx, groupIds)
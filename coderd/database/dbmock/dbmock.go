@@ -368,6 +368,48 @@ func (mr *MockStoreMockRecorder) CustomRoles(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomRoles", reflect.TypeOf((*MockStore)(nil).CustomRoles), ctx, arg)
 }
 
+// DeferNotificationMessage mocks base method.
+func (m *MockStore) DeferNotificationMessage(ctx context.Context, arg database.DeferNotificationMessageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferNotificationMessage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferNotificationMessage indicates an expected call of DeferNotificationMessage.
+func (mr *MockStoreMockRecorder) DeferNotificationMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferNotificationMessage", reflect.TypeOf((*MockStore)(nil).DeferNotificationMessage), ctx, arg)
+}
+
+// DeleteAIBridgeLimit mocks base method.
+func (m *MockStore) DeleteAIBridgeLimit(ctx context.Context, arg database.DeleteAIBridgeLimitParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAIBridgeLimit", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAIBridgeLimit indicates an expected call of DeleteAIBridgeLimit.
+func (mr *MockStoreMockRecorder) DeleteAIBridgeLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIBridgeLimit", reflect.TypeOf((*MockStore)(nil).DeleteAIBridgeLimit), ctx, arg)
+}
+
+// DeleteAIBridgeModelPrices mocks base method.
+func (m *MockStore) DeleteAIBridgeModelPrices(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAIBridgeModelPrices", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAIBridgeModelPrices indicates an expected call of DeleteAIBridgeModelPrices.
+func (mr *MockStoreMockRecorder) DeleteAIBridgeModelPrices(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIBridgeModelPrices", reflect.TypeOf((*MockStore)(nil).DeleteAIBridgeModelPrices), ctx)
+}
+
 // DeleteAPIKeyByID mocks base method.
 func (m *MockStore) DeleteAPIKeyByID(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -452,6 +494,20 @@ func (mr *MockStoreMockRecorder) DeleteApplicationConnectAPIKeysByUserID(ctx, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationConnectAPIKeysByUserID", reflect.TypeOf((*MockStore)(nil).DeleteApplicationConnectAPIKeysByUserID), ctx, userID)
 }
 
+// DeleteAutostartExceptionCalendarByOrganizationIDAndName mocks base method.
+func (m *MockStore) DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutostartExceptionCalendarByOrganizationIDAndName", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutostartExceptionCalendarByOrganizationIDAndName indicates an expected call of DeleteAutostartExceptionCalendarByOrganizationIDAndName.
+func (mr *MockStoreMockRecorder) DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutostartExceptionCalendarByOrganizationIDAndName", reflect.TypeOf((*MockStore)(nil).DeleteAutostartExceptionCalendarByOrganizationIDAndName), ctx, arg)
+}
+
 // DeleteCoordinator mocks base method.
 func (m *MockStore) DeleteCoordinator(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -495,6 +551,20 @@ func (mr *MockStoreMockRecorder) DeleteCustomRole(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*MockStore)(nil).DeleteCustomRole), ctx, arg)
 }
 
+// DeleteExpiringNotificationWebhookSecrets mocks base method.
+func (m *MockStore) DeleteExpiringNotificationWebhookSecrets(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiringNotificationWebhookSecrets", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiringNotificationWebhookSecrets indicates an expected call of DeleteExpiringNotificationWebhookSecrets.
+func (mr *MockStoreMockRecorder) DeleteExpiringNotificationWebhookSecrets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiringNotificationWebhookSecrets", reflect.TypeOf((*MockStore)(nil).DeleteExpiringNotificationWebhookSecrets), ctx)
+}
+
 // DeleteExternalAuthLink mocks base method.
 func (m *MockStore) DeleteExternalAuthLink(ctx context.Context, arg database.DeleteExternalAuthLinkParams) error {
 	m.ctrl.T.Helper()
@@ -566,6 +636,34 @@ func (mr *MockStoreMockRecorder) DeleteLicense(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLicense", reflect.TypeOf((*MockStore)(nil).DeleteLicense), ctx, id)
 }
 
+// DeleteNotificationDigestMessages mocks base method.
+func (m *MockStore) DeleteNotificationDigestMessages(ctx context.Context, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationDigestMessages", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationDigestMessages indicates an expected call of DeleteNotificationDigestMessages.
+func (mr *MockStoreMockRecorder) DeleteNotificationDigestMessages(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationDigestMessages", reflect.TypeOf((*MockStore)(nil).DeleteNotificationDigestMessages), ctx, ids)
+}
+
+// DeleteNotificationUserTargetByID mocks base method.
+func (m *MockStore) DeleteNotificationUserTargetByID(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationUserTargetByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationUserTargetByID indicates an expected call of DeleteNotificationUserTargetByID.
+func (mr *MockStoreMockRecorder) DeleteNotificationUserTargetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationUserTargetByID", reflect.TypeOf((*MockStore)(nil).DeleteNotificationUserTargetByID), ctx, id)
+}
+
 // DeleteOAuth2ProviderAppByClientID mocks base method.
 func (m *MockStore) DeleteOAuth2ProviderAppByClientID(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -650,6 +748,21 @@ func (mr *MockStoreMockRecorder) DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2ProviderAppTokensByAppAndUserID", reflect.TypeOf((*MockStore)(nil).DeleteOAuth2ProviderAppTokensByAppAndUserID), ctx, arg)
 }
 
+// DeleteOldAIBridgeInterceptionCaptures mocks base method.
+func (m *MockStore) DeleteOldAIBridgeInterceptionCaptures(ctx context.Context, arg database.DeleteOldAIBridgeInterceptionCapturesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldAIBridgeInterceptionCaptures", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldAIBridgeInterceptionCaptures indicates an expected call of DeleteOldAIBridgeInterceptionCaptures.
+func (mr *MockStoreMockRecorder) DeleteOldAIBridgeInterceptionCaptures(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldAIBridgeInterceptionCaptures", reflect.TypeOf((*MockStore)(nil).DeleteOldAIBridgeInterceptionCaptures), ctx, arg)
+}
+
 // DeleteOldAuditLogConnectionEvents mocks base method.
 func (m *MockStore) DeleteOldAuditLogConnectionEvents(ctx context.Context, arg database.DeleteOldAuditLogConnectionEventsParams) error {
 	m.ctrl.T.Helper()
@@ -664,6 +777,50 @@ func (mr *MockStoreMockRecorder) DeleteOldAuditLogConnectionEvents(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldAuditLogConnectionEvents", reflect.TypeOf((*MockStore)(nil).DeleteOldAuditLogConnectionEvents), ctx, arg)
 }
 
+// DeleteOldAuditLogHashChainCheckpoints mocks base method.
+func (m *MockStore) DeleteOldAuditLogHashChainCheckpoints(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldAuditLogHashChainCheckpoints", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOldAuditLogHashChainCheckpoints indicates an expected call of DeleteOldAuditLogHashChainCheckpoints.
+func (mr *MockStoreMockRecorder) DeleteOldAuditLogHashChainCheckpoints(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldAuditLogHashChainCheckpoints", reflect.TypeOf((*MockStore)(nil).DeleteOldAuditLogHashChainCheckpoints), ctx)
+}
+
+// DeleteOldAuditLogs mocks base method.
+func (m *MockStore) DeleteOldAuditLogs(ctx context.Context, arg database.DeleteOldAuditLogsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldAuditLogs", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldAuditLogs indicates an expected call of DeleteOldAuditLogs.
+func (mr *MockStoreMockRecorder) DeleteOldAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldAuditLogs", reflect.TypeOf((*MockStore)(nil).DeleteOldAuditLogs), ctx, arg)
+}
+
+// DeleteOldConnectionLogs mocks base method.
+func (m *MockStore) DeleteOldConnectionLogs(ctx context.Context, arg database.DeleteOldConnectionLogsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldConnectionLogs", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldConnectionLogs indicates an expected call of DeleteOldConnectionLogs.
+func (mr *MockStoreMockRecorder) DeleteOldConnectionLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldConnectionLogs", reflect.TypeOf((*MockStore)(nil).DeleteOldConnectionLogs), ctx, arg)
+}
+
 // DeleteOldNotificationMessages mocks base method.
 func (m *MockStore) DeleteOldNotificationMessages(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -678,6 +835,21 @@ func (mr *MockStoreMockRecorder) DeleteOldNotificationMessages(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldNotificationMessages", reflect.TypeOf((*MockStore)(nil).DeleteOldNotificationMessages), ctx)
 }
 
+// DeleteOldPrebuildClaims mocks base method.
+func (m *MockStore) DeleteOldPrebuildClaims(ctx context.Context, arg database.DeleteOldPrebuildClaimsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldPrebuildClaims", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldPrebuildClaims indicates an expected call of DeleteOldPrebuildClaims.
+func (mr *MockStoreMockRecorder) DeleteOldPrebuildClaims(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldPrebuildClaims", reflect.TypeOf((*MockStore)(nil).DeleteOldPrebuildClaims), ctx, arg)
+}
+
 // DeleteOldProvisionerDaemons mocks base method.
 func (m *MockStore) DeleteOldProvisionerDaemons(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -720,6 +892,36 @@ func (mr *MockStoreMockRecorder) DeleteOldWorkspaceAgentStats(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldWorkspaceAgentStats", reflect.TypeOf((*MockStore)(nil).DeleteOldWorkspaceAgentStats), ctx)
 }
 
+// DeleteOldWorkspaceLifecycleDecisions mocks base method.
+func (m *MockStore) DeleteOldWorkspaceLifecycleDecisions(ctx context.Context, arg database.DeleteOldWorkspaceLifecycleDecisionsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldWorkspaceLifecycleDecisions", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldWorkspaceLifecycleDecisions indicates an expected call of DeleteOldWorkspaceLifecycleDecisions.
+func (mr *MockStoreMockRecorder) DeleteOldWorkspaceLifecycleDecisions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldWorkspaceLifecycleDecisions", reflect.TypeOf((*MockStore)(nil).DeleteOldWorkspaceLifecycleDecisions), ctx, arg)
+}
+
+// DeleteOldWorkspaceSessionRecordings mocks base method.
+func (m *MockStore) DeleteOldWorkspaceSessionRecordings(ctx context.Context, arg database.DeleteOldWorkspaceSessionRecordingsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldWorkspaceSessionRecordings", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldWorkspaceSessionRecordings indicates an expected call of DeleteOldWorkspaceSessionRecordings.
+func (mr *MockStoreMockRecorder) DeleteOldWorkspaceSessionRecordings(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldWorkspaceSessionRecordings", reflect.TypeOf((*MockStore)(nil).DeleteOldWorkspaceSessionRecordings), ctx, arg)
+}
+
 // DeleteOrganizationMember mocks base method.
 func (m *MockStore) DeleteOrganizationMember(ctx context.Context, arg database.DeleteOrganizationMemberParams) error {
 	m.ctrl.T.Helper()
@@ -934,6 +1136,34 @@ func (mr *MockStoreMockRecorder) DeleteWorkspaceAgentPortSharesByTemplate(ctx, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAgentPortSharesByTemplate", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceAgentPortSharesByTemplate), ctx, templateID)
 }
 
+// DeleteWorkspaceAutostartExceptionOptOut mocks base method.
+func (m *MockStore) DeleteWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceAutostartExceptionOptOut", ctx, workspaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceAutostartExceptionOptOut indicates an expected call of DeleteWorkspaceAutostartExceptionOptOut.
+func (mr *MockStoreMockRecorder) DeleteWorkspaceAutostartExceptionOptOut(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAutostartExceptionOptOut", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceAutostartExceptionOptOut), ctx, workspaceID)
+}
+
+// DeleteWorkspaceScheduleWindowsByWorkspaceID mocks base method.
+func (m *MockStore) DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceScheduleWindowsByWorkspaceID", ctx, workspaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceScheduleWindowsByWorkspaceID indicates an expected call of DeleteWorkspaceScheduleWindowsByWorkspaceID.
+func (mr *MockStoreMockRecorder) DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceScheduleWindowsByWorkspaceID", reflect.TypeOf((*MockStore)(nil).DeleteWorkspaceScheduleWindowsByWorkspaceID), ctx, workspaceID)
+}
+
 // DeleteWorkspaceSubAgentByID mocks base method.
 func (m *MockStore) DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -976,6 +1206,20 @@ func (mr *MockStoreMockRecorder) EnqueueNotificationMessage(ctx, arg any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueNotificationMessage", reflect.TypeOf((*MockStore)(nil).EnqueueNotificationMessage), ctx, arg)
 }
 
+// ExpireActiveNotificationWebhookSecret mocks base method.
+func (m *MockStore) ExpireActiveNotificationWebhookSecret(ctx context.Context, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireActiveNotificationWebhookSecret", ctx, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireActiveNotificationWebhookSecret indicates an expected call of ExpireActiveNotificationWebhookSecret.
+func (mr *MockStoreMockRecorder) ExpireActiveNotificationWebhookSecret(ctx, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireActiveNotificationWebhookSecret", reflect.TypeOf((*MockStore)(nil).ExpireActiveNotificationWebhookSecret), ctx, expiresAt)
+}
+
 // ExpirePrebuildsAPIKeys mocks base method.
 func (m *MockStore) ExpirePrebuildsAPIKeys(ctx context.Context, now time.Time) error {
 	m.ctrl.T.Helper()
@@ -1109,6 +1353,36 @@ func (mr *MockStoreMockRecorder) GetAIBridgeInterceptionByID(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeInterceptionByID", reflect.TypeOf((*MockStore)(nil).GetAIBridgeInterceptionByID), ctx, id)
 }
 
+// GetAIBridgeInterceptionCaptureByInterceptionID mocks base method.
+func (m *MockStore) GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (database.AIBridgeInterceptionCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeInterceptionCaptureByInterceptionID", ctx, interceptionID)
+	ret0, _ := ret[0].(database.AIBridgeInterceptionCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeInterceptionCaptureByInterceptionID indicates an expected call of GetAIBridgeInterceptionCaptureByInterceptionID.
+func (mr *MockStoreMockRecorder) GetAIBridgeInterceptionCaptureByInterceptionID(ctx, interceptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeInterceptionCaptureByInterceptionID", reflect.TypeOf((*MockStore)(nil).GetAIBridgeInterceptionCaptureByInterceptionID), ctx, interceptionID)
+}
+
+// GetAIBridgeInterceptionCaptureIDs mocks base method.
+func (m *MockStore) GetAIBridgeInterceptionCaptureIDs(ctx context.Context) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeInterceptionCaptureIDs", ctx)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeInterceptionCaptureIDs indicates an expected call of GetAIBridgeInterceptionCaptureIDs.
+func (mr *MockStoreMockRecorder) GetAIBridgeInterceptionCaptureIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeInterceptionCaptureIDs", reflect.TypeOf((*MockStore)(nil).GetAIBridgeInterceptionCaptureIDs), ctx)
+}
+
 // GetAIBridgeInterceptions mocks base method.
 func (m *MockStore) GetAIBridgeInterceptions(ctx context.Context) ([]database.AIBridgeInterception, error) {
 	m.ctrl.T.Helper()
@@ -1124,6 +1398,66 @@ func (mr *MockStoreMockRecorder) GetAIBridgeInterceptions(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeInterceptions", reflect.TypeOf((*MockStore)(nil).GetAIBridgeInterceptions), ctx)
 }
 
+// GetAIBridgeLimitUsage mocks base method.
+func (m *MockStore) GetAIBridgeLimitUsage(ctx context.Context, arg database.GetAIBridgeLimitUsageParams) (database.GetAIBridgeLimitUsageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeLimitUsage", ctx, arg)
+	ret0, _ := ret[0].(database.GetAIBridgeLimitUsageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeLimitUsage indicates an expected call of GetAIBridgeLimitUsage.
+func (mr *MockStoreMockRecorder) GetAIBridgeLimitUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeLimitUsage", reflect.TypeOf((*MockStore)(nil).GetAIBridgeLimitUsage), ctx, arg)
+}
+
+// GetAIBridgeLimits mocks base method.
+func (m *MockStore) GetAIBridgeLimits(ctx context.Context) ([]database.AIBridgeLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeLimits", ctx)
+	ret0, _ := ret[0].([]database.AIBridgeLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeLimits indicates an expected call of GetAIBridgeLimits.
+func (mr *MockStoreMockRecorder) GetAIBridgeLimits(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeLimits", reflect.TypeOf((*MockStore)(nil).GetAIBridgeLimits), ctx)
+}
+
+// GetAIBridgeLimitsByUserID mocks base method.
+func (m *MockStore) GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]database.AIBridgeLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeLimitsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]database.AIBridgeLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeLimitsByUserID indicates an expected call of GetAIBridgeLimitsByUserID.
+func (mr *MockStoreMockRecorder) GetAIBridgeLimitsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeLimitsByUserID", reflect.TypeOf((*MockStore)(nil).GetAIBridgeLimitsByUserID), ctx, userID)
+}
+
+// GetAIBridgeModelPrices mocks base method.
+func (m *MockStore) GetAIBridgeModelPrices(ctx context.Context) ([]database.AIBridgeModelPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeModelPrices", ctx)
+	ret0, _ := ret[0].([]database.AIBridgeModelPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeModelPrices indicates an expected call of GetAIBridgeModelPrices.
+func (mr *MockStoreMockRecorder) GetAIBridgeModelPrices(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeModelPrices", reflect.TypeOf((*MockStore)(nil).GetAIBridgeModelPrices), ctx)
+}
+
 // GetAIBridgeTokenUsagesByInterceptionID mocks base method.
 func (m *MockStore) GetAIBridgeTokenUsagesByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	m.ctrl.T.Helper()
@@ -1154,6 +1488,21 @@ func (mr *MockStoreMockRecorder) GetAIBridgeToolUsagesByInterceptionID(ctx, inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeToolUsagesByInterceptionID", reflect.TypeOf((*MockStore)(nil).GetAIBridgeToolUsagesByInterceptionID), ctx, interceptionID)
 }
 
+// GetAIBridgeUsageStats mocks base method.
+func (m *MockStore) GetAIBridgeUsageStats(ctx context.Context, arg database.GetAIBridgeUsageStatsParams) ([]database.GetAIBridgeUsageStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAIBridgeUsageStats", ctx, arg)
+	ret0, _ := ret[0].([]database.GetAIBridgeUsageStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAIBridgeUsageStats indicates an expected call of GetAIBridgeUsageStats.
+func (mr *MockStoreMockRecorder) GetAIBridgeUsageStats(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAIBridgeUsageStats", reflect.TypeOf((*MockStore)(nil).GetAIBridgeUsageStats), ctx, arg)
+}
+
 // GetAIBridgeUserPromptsByInterceptionID mocks base method.
 func (m *MockStore) GetAIBridgeUserPromptsByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]database.AIBridgeUserPrompt, error) {
 	m.ctrl.T.Helper()
@@ -1394,6 +1743,51 @@ func (mr *MockStoreMockRecorder) GetApplicationName(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationName", reflect.TypeOf((*MockStore)(nil).GetApplicationName), ctx)
 }
 
+// GetAuditLogHashChainCheckpoints mocks base method.
+func (m *MockStore) GetAuditLogHashChainCheckpoints(ctx context.Context, arg database.GetAuditLogHashChainCheckpointsParams) ([]database.AuditLogHashChainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogHashChainCheckpoints", ctx, arg)
+	ret0, _ := ret[0].([]database.AuditLogHashChainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogHashChainCheckpoints indicates an expected call of GetAuditLogHashChainCheckpoints.
+func (mr *MockStoreMockRecorder) GetAuditLogHashChainCheckpoints(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogHashChainCheckpoints", reflect.TypeOf((*MockStore)(nil).GetAuditLogHashChainCheckpoints), ctx, arg)
+}
+
+// GetAuditLogHashChainEntries mocks base method.
+func (m *MockStore) GetAuditLogHashChainEntries(ctx context.Context, arg database.GetAuditLogHashChainEntriesParams) ([]database.AuditLogHashChain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogHashChainEntries", ctx, arg)
+	ret0, _ := ret[0].([]database.AuditLogHashChain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogHashChainEntries indicates an expected call of GetAuditLogHashChainEntries.
+func (mr *MockStoreMockRecorder) GetAuditLogHashChainEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogHashChainEntries", reflect.TypeOf((*MockStore)(nil).GetAuditLogHashChainEntries), ctx, arg)
+}
+
+// GetAuditLogsByIDs mocks base method.
+func (m *MockStore) GetAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) ([]database.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]database.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogsByIDs indicates an expected call of GetAuditLogsByIDs.
+func (mr *MockStoreMockRecorder) GetAuditLogsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogsByIDs", reflect.TypeOf((*MockStore)(nil).GetAuditLogsByIDs), ctx, ids)
+}
+
 // GetAuditLogsOffset mocks base method.
 func (m *MockStore) GetAuditLogsOffset(ctx context.Context, arg database.GetAuditLogsOffsetParams) ([]database.GetAuditLogsOffsetRow, error) {
 	m.ctrl.T.Helper()
@@ -1529,6 +1923,36 @@ func (mr *MockStoreMockRecorder) GetAuthorizedWorkspacesAndAgentsByOwnerID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizedWorkspacesAndAgentsByOwnerID", reflect.TypeOf((*MockStore)(nil).GetAuthorizedWorkspacesAndAgentsByOwnerID), ctx, ownerID, prepared)
 }
 
+// GetAutostartExceptionCalendarByOrganizationIDAndName mocks base method.
+func (m *MockStore) GetAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg database.GetAutostartExceptionCalendarByOrganizationIDAndNameParams) (database.AutostartExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutostartExceptionCalendarByOrganizationIDAndName", ctx, arg)
+	ret0, _ := ret[0].(database.AutostartExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutostartExceptionCalendarByOrganizationIDAndName indicates an expected call of GetAutostartExceptionCalendarByOrganizationIDAndName.
+func (mr *MockStoreMockRecorder) GetAutostartExceptionCalendarByOrganizationIDAndName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutostartExceptionCalendarByOrganizationIDAndName", reflect.TypeOf((*MockStore)(nil).GetAutostartExceptionCalendarByOrganizationIDAndName), ctx, arg)
+}
+
+// GetAutostartExceptionCalendarsByOrganizationID mocks base method.
+func (m *MockStore) GetAutostartExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]database.AutostartExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutostartExceptionCalendarsByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].([]database.AutostartExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutostartExceptionCalendarsByOrganizationID indicates an expected call of GetAutostartExceptionCalendarsByOrganizationID.
+func (mr *MockStoreMockRecorder) GetAutostartExceptionCalendarsByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutostartExceptionCalendarsByOrganizationID", reflect.TypeOf((*MockStore)(nil).GetAutostartExceptionCalendarsByOrganizationID), ctx, organizationID)
+}
+
 // GetConnectionLogsOffset mocks base method.
 func (m *MockStore) GetConnectionLogsOffset(ctx context.Context, arg database.GetConnectionLogsOffsetParams) ([]database.GetConnectionLogsOffsetRow, error) {
 	m.ctrl.T.Helper()
@@ -1739,6 +2163,21 @@ func (mr *MockStoreMockRecorder) GetDeploymentWorkspaceStats(ctx any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentWorkspaceStats", reflect.TypeOf((*MockStore)(nil).GetDeploymentWorkspaceStats), ctx)
 }
 
+// GetDueNotificationDigestMessages mocks base method.
+func (m *MockStore) GetDueNotificationDigestMessages(ctx context.Context, heldBefore time.Time) ([]database.NotificationDigestMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueNotificationDigestMessages", ctx, heldBefore)
+	ret0, _ := ret[0].([]database.NotificationDigestMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueNotificationDigestMessages indicates an expected call of GetDueNotificationDigestMessages.
+func (mr *MockStoreMockRecorder) GetDueNotificationDigestMessages(ctx, heldBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueNotificationDigestMessages", reflect.TypeOf((*MockStore)(nil).GetDueNotificationDigestMessages), ctx, heldBefore)
+}
+
 // GetEligibleProvisionerDaemonsByProvisionerJobIDs mocks base method.
 func (m *MockStore) GetEligibleProvisionerDaemonsByProvisionerJobIDs(ctx context.Context, provisionerJobIds []uuid.UUID) ([]database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow, error) {
 	m.ctrl.T.Helper()
@@ -2039,6 +2478,36 @@ func (mr *MockStoreMockRecorder) GetLastUpdateCheck(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUpdateCheck", reflect.TypeOf((*MockStore)(nil).GetLastUpdateCheck), ctx)
 }
 
+// GetLatestAuditLogHashChainCheckpoint mocks base method.
+func (m *MockStore) GetLatestAuditLogHashChainCheckpoint(ctx context.Context) (database.AuditLogHashChainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestAuditLogHashChainCheckpoint", ctx)
+	ret0, _ := ret[0].(database.AuditLogHashChainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestAuditLogHashChainCheckpoint indicates an expected call of GetLatestAuditLogHashChainCheckpoint.
+func (mr *MockStoreMockRecorder) GetLatestAuditLogHashChainCheckpoint(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAuditLogHashChainCheckpoint", reflect.TypeOf((*MockStore)(nil).GetLatestAuditLogHashChainCheckpoint), ctx)
+}
+
+// GetLatestAuditLogHashChainEntry mocks base method.
+func (m *MockStore) GetLatestAuditLogHashChainEntry(ctx context.Context) (database.AuditLogHashChain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestAuditLogHashChainEntry", ctx)
+	ret0, _ := ret[0].(database.AuditLogHashChain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestAuditLogHashChainEntry indicates an expected call of GetLatestAuditLogHashChainEntry.
+func (mr *MockStoreMockRecorder) GetLatestAuditLogHashChainEntry(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAuditLogHashChainEntry", reflect.TypeOf((*MockStore)(nil).GetLatestAuditLogHashChainEntry), ctx)
+}
+
 // GetLatestCryptoKeyByFeature mocks base method.
 func (m *MockStore) GetLatestCryptoKeyByFeature(ctx context.Context, feature database.CryptoKeyFeature) (database.CryptoKey, error) {
 	m.ctrl.T.Helper()
@@ -2219,6 +2688,66 @@ func (mr *MockStoreMockRecorder) GetNotificationTemplatesByKind(ctx, kind any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationTemplatesByKind", reflect.TypeOf((*MockStore)(nil).GetNotificationTemplatesByKind), ctx, kind)
 }
 
+// GetNotificationUserTargetByID mocks base method.
+func (m *MockStore) GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (database.NotificationUserTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationUserTargetByID", ctx, id)
+	ret0, _ := ret[0].(database.NotificationUserTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationUserTargetByID indicates an expected call of GetNotificationUserTargetByID.
+func (mr *MockStoreMockRecorder) GetNotificationUserTargetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationUserTargetByID", reflect.TypeOf((*MockStore)(nil).GetNotificationUserTargetByID), ctx, id)
+}
+
+// GetNotificationUserTargetByPreference mocks base method.
+func (m *MockStore) GetNotificationUserTargetByPreference(ctx context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationUserTargetByPreference", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationUserTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationUserTargetByPreference indicates an expected call of GetNotificationUserTargetByPreference.
+func (mr *MockStoreMockRecorder) GetNotificationUserTargetByPreference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationUserTargetByPreference", reflect.TypeOf((*MockStore)(nil).GetNotificationUserTargetByPreference), ctx, arg)
+}
+
+// GetNotificationUserTargetsByUserID mocks base method.
+func (m *MockStore) GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]database.NotificationUserTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationUserTargetsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]database.NotificationUserTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationUserTargetsByUserID indicates an expected call of GetNotificationUserTargetsByUserID.
+func (mr *MockStoreMockRecorder) GetNotificationUserTargetsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationUserTargetsByUserID", reflect.TypeOf((*MockStore)(nil).GetNotificationUserTargetsByUserID), ctx, userID)
+}
+
+// GetNotificationWebhookSecrets mocks base method.
+func (m *MockStore) GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationWebhookSecrets", ctx)
+	ret0, _ := ret[0].([]database.NotificationWebhookSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationWebhookSecrets indicates an expected call of GetNotificationWebhookSecrets.
+func (mr *MockStoreMockRecorder) GetNotificationWebhookSecrets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationWebhookSecrets", reflect.TypeOf((*MockStore)(nil).GetNotificationWebhookSecrets), ctx)
+}
+
 // GetNotificationsSettings mocks base method.
 func (m *MockStore) GetNotificationsSettings(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -2549,19 +3078,49 @@ func (mr *MockStoreMockRecorder) GetParameterSchemasByJobID(ctx, jobID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterSchemasByJobID", reflect.TypeOf((*MockStore)(nil).GetParameterSchemasByJobID), ctx, jobID)
 }
 
+// GetPrebuildClaimDemand mocks base method.
+func (m *MockStore) GetPrebuildClaimDemand(ctx context.Context, since time.Time) ([]database.GetPrebuildClaimDemandRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrebuildClaimDemand", ctx, since)
+	ret0, _ := ret[0].([]database.GetPrebuildClaimDemandRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrebuildClaimDemand indicates an expected call of GetPrebuildClaimDemand.
+func (mr *MockStoreMockRecorder) GetPrebuildClaimDemand(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrebuildClaimDemand", reflect.TypeOf((*MockStore)(nil).GetPrebuildClaimDemand), ctx, since)
+}
+
+// GetPrebuildInsights mocks base method.
+func (m *MockStore) GetPrebuildInsights(ctx context.Context, arg database.GetPrebuildInsightsParams) ([]database.GetPrebuildInsightsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrebuildInsights", ctx, arg)
+	ret0, _ := ret[0].([]database.GetPrebuildInsightsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrebuildInsights indicates an expected call of GetPrebuildInsights.
+func (mr *MockStoreMockRecorder) GetPrebuildInsights(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrebuildInsights", reflect.TypeOf((*MockStore)(nil).GetPrebuildInsights), ctx, arg)
+}
+
 // GetPrebuildMetrics mocks base method.
-func (m *MockStore) GetPrebuildMetrics(ctx context.Context) ([]database.GetPrebuildMetricsRow, error) {
+func (m *MockStore) GetPrebuildMetrics(ctx context.Context, autoscalingSince time.Time) ([]database.GetPrebuildMetricsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrebuildMetrics", ctx)
+	ret := m.ctrl.Call(m, "GetPrebuildMetrics", ctx, autoscalingSince)
 	ret0, _ := ret[0].([]database.GetPrebuildMetricsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPrebuildMetrics indicates an expected call of GetPrebuildMetrics.
-func (mr *MockStoreMockRecorder) GetPrebuildMetrics(ctx any) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetPrebuildMetrics(ctx, autoscalingSince any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrebuildMetrics", reflect.TypeOf((*MockStore)(nil).GetPrebuildMetrics), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrebuildMetrics", reflect.TypeOf((*MockStore)(nil).GetPrebuildMetrics), ctx, autoscalingSince)
 }
 
 // GetPrebuildsSettings mocks base method.
@@ -3044,6 +3603,21 @@ func (mr *MockStoreMockRecorder) GetRuntimeConfig(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuntimeConfig", reflect.TypeOf((*MockStore)(nil).GetRuntimeConfig), ctx, key)
 }
 
+// GetStaleTaskSteps mocks base method.
+func (m *MockStore) GetStaleTaskSteps(ctx context.Context, updatedBefore time.Time) ([]database.TaskStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaleTaskSteps", ctx, updatedBefore)
+	ret0, _ := ret[0].([]database.TaskStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaleTaskSteps indicates an expected call of GetStaleTaskSteps.
+func (mr *MockStoreMockRecorder) GetStaleTaskSteps(ctx, updatedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaleTaskSteps", reflect.TypeOf((*MockStore)(nil).GetStaleTaskSteps), ctx, updatedBefore)
+}
+
 // GetTailnetAgents mocks base method.
 func (m *MockStore) GetTailnetAgents(ctx context.Context, id uuid.UUID) ([]database.TailnetAgent, error) {
 	m.ctrl.T.Helper()
@@ -3149,6 +3723,51 @@ func (mr *MockStoreMockRecorder) GetTaskByWorkspaceID(ctx, workspaceID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetTaskByWorkspaceID), ctx, workspaceID)
 }
 
+// GetTaskStepsByWorkspaceID mocks base method.
+func (m *MockStore) GetTaskStepsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.TaskStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskStepsByWorkspaceID", ctx, workspaceID)
+	ret0, _ := ret[0].([]database.TaskStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskStepsByWorkspaceID indicates an expected call of GetTaskStepsByWorkspaceID.
+func (mr *MockStoreMockRecorder) GetTaskStepsByWorkspaceID(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStepsByWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetTaskStepsByWorkspaceID), ctx, workspaceID)
+}
+
+// GetTaskStepsByWorkspaceIDs mocks base method.
+func (m *MockStore) GetTaskStepsByWorkspaceIDs(ctx context.Context, workspaceIds []uuid.UUID) ([]database.TaskStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskStepsByWorkspaceIDs", ctx, workspaceIds)
+	ret0, _ := ret[0].([]database.TaskStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskStepsByWorkspaceIDs indicates an expected call of GetTaskStepsByWorkspaceIDs.
+func (mr *MockStoreMockRecorder) GetTaskStepsByWorkspaceIDs(ctx, workspaceIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStepsByWorkspaceIDs", reflect.TypeOf((*MockStore)(nil).GetTaskStepsByWorkspaceIDs), ctx, workspaceIds)
+}
+
+// GetTaskWorkspacesForLifecyclePolicy mocks base method.
+func (m *MockStore) GetTaskWorkspacesForLifecyclePolicy(ctx context.Context, ownerID uuid.UUID) ([]database.GetTaskWorkspacesForLifecyclePolicyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskWorkspacesForLifecyclePolicy", ctx, ownerID)
+	ret0, _ := ret[0].([]database.GetTaskWorkspacesForLifecyclePolicyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskWorkspacesForLifecyclePolicy indicates an expected call of GetTaskWorkspacesForLifecyclePolicy.
+func (mr *MockStoreMockRecorder) GetTaskWorkspacesForLifecyclePolicy(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskWorkspacesForLifecyclePolicy", reflect.TypeOf((*MockStore)(nil).GetTaskWorkspacesForLifecyclePolicy), ctx, ownerID)
+}
+
 // GetTelemetryItem mocks base method.
 func (m *MockStore) GetTelemetryItem(ctx context.Context, key string) (database.TelemetryItem, error) {
 	m.ctrl.T.Helper()
@@ -3614,6 +4233,21 @@ func (mr *MockStoreMockRecorder) GetUnexpiredLicenses(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnexpiredLicenses", reflect.TypeOf((*MockStore)(nil).GetUnexpiredLicenses), ctx)
 }
 
+// GetUserAIBridgeCapture mocks base method.
+func (m *MockStore) GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAIBridgeCapture", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAIBridgeCapture indicates an expected call of GetUserAIBridgeCapture.
+func (mr *MockStoreMockRecorder) GetUserAIBridgeCapture(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAIBridgeCapture", reflect.TypeOf((*MockStore)(nil).GetUserAIBridgeCapture), ctx, userID)
+}
+
 // GetUserActivityInsights mocks base method.
 func (m *MockStore) GetUserActivityInsights(ctx context.Context, arg database.GetUserActivityInsightsParams) ([]database.GetUserActivityInsightsRow, error) {
 	m.ctrl.T.Helper()
@@ -3749,6 +4383,36 @@ func (mr *MockStoreMockRecorder) GetUserNotificationPreferences(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotificationPreferences", reflect.TypeOf((*MockStore)(nil).GetUserNotificationPreferences), ctx, userID)
 }
 
+// GetUserNotificationQuietHours mocks base method.
+func (m *MockStore) GetUserNotificationQuietHours(ctx context.Context, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNotificationQuietHours", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNotificationQuietHours indicates an expected call of GetUserNotificationQuietHours.
+func (mr *MockStoreMockRecorder) GetUserNotificationQuietHours(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotificationQuietHours", reflect.TypeOf((*MockStore)(nil).GetUserNotificationQuietHours), ctx, userID)
+}
+
+// GetUserNotificationSnoozeUntil mocks base method.
+func (m *MockStore) GetUserNotificationSnoozeUntil(ctx context.Context, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNotificationSnoozeUntil", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNotificationSnoozeUntil indicates an expected call of GetUserNotificationSnoozeUntil.
+func (mr *MockStoreMockRecorder) GetUserNotificationSnoozeUntil(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotificationSnoozeUntil", reflect.TypeOf((*MockStore)(nil).GetUserNotificationSnoozeUntil), ctx, userID)
+}
+
 // GetUserSecret mocks base method.
 func (m *MockStore) GetUserSecret(ctx context.Context, id uuid.UUID) (database.UserSecret, error) {
 	m.ctrl.T.Helper()
@@ -4304,6 +4968,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceAppsCreatedAfter(ctx, createdAt any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAppsCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAppsCreatedAfter), ctx, createdAt)
 }
 
+// GetWorkspaceAutostartExceptionOptOut mocks base method.
+func (m *MockStore) GetWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAutostartExceptionOptOut", ctx, workspaceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAutostartExceptionOptOut indicates an expected call of GetWorkspaceAutostartExceptionOptOut.
+func (mr *MockStoreMockRecorder) GetWorkspaceAutostartExceptionOptOut(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAutostartExceptionOptOut", reflect.TypeOf((*MockStore)(nil).GetWorkspaceAutostartExceptionOptOut), ctx, workspaceID)
+}
+
 // GetWorkspaceBuildByID mocks base method.
 func (m *MockStore) GetWorkspaceBuildByID(ctx context.Context, id uuid.UUID) (database.WorkspaceBuild, error) {
 	m.ctrl.T.Helper()
@@ -4499,6 +5178,21 @@ func (mr *MockStoreMockRecorder) GetWorkspaceByWorkspaceAppID(ctx, workspaceAppI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceByWorkspaceAppID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceByWorkspaceAppID), ctx, workspaceAppID)
 }
 
+// GetWorkspaceLifecycleDecisionsByWorkspaceID mocks base method.
+func (m *MockStore) GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx context.Context, arg database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams) ([]database.WorkspaceLifecycleDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceLifecycleDecisionsByWorkspaceID", ctx, arg)
+	ret0, _ := ret[0].([]database.WorkspaceLifecycleDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceLifecycleDecisionsByWorkspaceID indicates an expected call of GetWorkspaceLifecycleDecisionsByWorkspaceID.
+func (mr *MockStoreMockRecorder) GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceLifecycleDecisionsByWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceLifecycleDecisionsByWorkspaceID), ctx, arg)
+}
+
 // GetWorkspaceModulesByJobID mocks base method.
 func (m *MockStore) GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]database.WorkspaceModule, error) {
 	m.ctrl.T.Helper()
@@ -4661,22 +5355,82 @@ func (m *MockStore) GetWorkspaceResourcesByJobIDs(ctx context.Context, ids []uui
 // GetWorkspaceResourcesByJobIDs indicates an expected call of GetWorkspaceResourcesByJobIDs.
 func (mr *MockStoreMockRecorder) GetWorkspaceResourcesByJobIDs(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourcesByJobIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceResourcesByJobIDs), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourcesByJobIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceResourcesByJobIDs), ctx, ids)
+}
+
+// GetWorkspaceResourcesCreatedAfter mocks base method.
+func (m *MockStore) GetWorkspaceResourcesCreatedAfter(ctx context.Context, createdAt time.Time) ([]database.WorkspaceResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceResourcesCreatedAfter", ctx, createdAt)
+	ret0, _ := ret[0].([]database.WorkspaceResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceResourcesCreatedAfter indicates an expected call of GetWorkspaceResourcesCreatedAfter.
+func (mr *MockStoreMockRecorder) GetWorkspaceResourcesCreatedAfter(ctx, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourcesCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceResourcesCreatedAfter), ctx, createdAt)
+}
+
+// GetWorkspaceScheduleWindowsByWorkspaceID mocks base method.
+func (m *MockStore) GetWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceScheduleWindowsByWorkspaceID", ctx, workspaceID)
+	ret0, _ := ret[0].([]database.WorkspaceScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceScheduleWindowsByWorkspaceID indicates an expected call of GetWorkspaceScheduleWindowsByWorkspaceID.
+func (mr *MockStoreMockRecorder) GetWorkspaceScheduleWindowsByWorkspaceID(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceScheduleWindowsByWorkspaceID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceScheduleWindowsByWorkspaceID), ctx, workspaceID)
+}
+
+// GetWorkspaceScheduleWindowsByWorkspaceIDs mocks base method.
+func (m *MockStore) GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]database.WorkspaceScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceScheduleWindowsByWorkspaceIDs", ctx, ids)
+	ret0, _ := ret[0].([]database.WorkspaceScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceScheduleWindowsByWorkspaceIDs indicates an expected call of GetWorkspaceScheduleWindowsByWorkspaceIDs.
+func (mr *MockStoreMockRecorder) GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceScheduleWindowsByWorkspaceIDs", reflect.TypeOf((*MockStore)(nil).GetWorkspaceScheduleWindowsByWorkspaceIDs), ctx, ids)
+}
+
+// GetWorkspaceSessionRecordingByConnectionLogID mocks base method.
+func (m *MockStore) GetWorkspaceSessionRecordingByConnectionLogID(ctx context.Context, connectionLogID uuid.UUID) (database.WorkspaceSessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceSessionRecordingByConnectionLogID", ctx, connectionLogID)
+	ret0, _ := ret[0].(database.WorkspaceSessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceSessionRecordingByConnectionLogID indicates an expected call of GetWorkspaceSessionRecordingByConnectionLogID.
+func (mr *MockStoreMockRecorder) GetWorkspaceSessionRecordingByConnectionLogID(ctx, connectionLogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceSessionRecordingByConnectionLogID", reflect.TypeOf((*MockStore)(nil).GetWorkspaceSessionRecordingByConnectionLogID), ctx, connectionLogID)
 }
 
-// GetWorkspaceResourcesCreatedAfter mocks base method.
-func (m *MockStore) GetWorkspaceResourcesCreatedAfter(ctx context.Context, createdAt time.Time) ([]database.WorkspaceResource, error) {
+// GetWorkspaceSessionRecordingChunks mocks base method.
+func (m *MockStore) GetWorkspaceSessionRecordingChunks(ctx context.Context, arg database.GetWorkspaceSessionRecordingChunksParams) ([]database.WorkspaceSessionRecordingChunk, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkspaceResourcesCreatedAfter", ctx, createdAt)
-	ret0, _ := ret[0].([]database.WorkspaceResource)
+	ret := m.ctrl.Call(m, "GetWorkspaceSessionRecordingChunks", ctx, arg)
+	ret0, _ := ret[0].([]database.WorkspaceSessionRecordingChunk)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkspaceResourcesCreatedAfter indicates an expected call of GetWorkspaceResourcesCreatedAfter.
-func (mr *MockStoreMockRecorder) GetWorkspaceResourcesCreatedAfter(ctx, createdAt any) *gomock.Call {
+// GetWorkspaceSessionRecordingChunks indicates an expected call of GetWorkspaceSessionRecordingChunks.
+func (mr *MockStoreMockRecorder) GetWorkspaceSessionRecordingChunks(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourcesCreatedAfter", reflect.TypeOf((*MockStore)(nil).GetWorkspaceResourcesCreatedAfter), ctx, createdAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceSessionRecordingChunks", reflect.TypeOf((*MockStore)(nil).GetWorkspaceSessionRecordingChunks), ctx, arg)
 }
 
 // GetWorkspaceUniqueOwnerCountByTemplateIDs mocks base method.
@@ -4798,6 +5552,50 @@ func (mr *MockStoreMockRecorder) InsertAIBridgeInterception(ctx, arg any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAIBridgeInterception", reflect.TypeOf((*MockStore)(nil).InsertAIBridgeInterception), ctx, arg)
 }
 
+// InsertAIBridgeInterceptionCapture mocks base method.
+func (m *MockStore) InsertAIBridgeInterceptionCapture(ctx context.Context, arg database.InsertAIBridgeInterceptionCaptureParams) (database.AIBridgeInterceptionCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAIBridgeInterceptionCapture", ctx, arg)
+	ret0, _ := ret[0].(database.AIBridgeInterceptionCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAIBridgeInterceptionCapture indicates an expected call of InsertAIBridgeInterceptionCapture.
+func (mr *MockStoreMockRecorder) InsertAIBridgeInterceptionCapture(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAIBridgeInterceptionCapture", reflect.TypeOf((*MockStore)(nil).InsertAIBridgeInterceptionCapture), ctx, arg)
+}
+
+// InsertAIBridgeModelPrices mocks base method.
+func (m *MockStore) InsertAIBridgeModelPrices(ctx context.Context, arg database.InsertAIBridgeModelPricesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAIBridgeModelPrices", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAIBridgeModelPrices indicates an expected call of InsertAIBridgeModelPrices.
+func (mr *MockStoreMockRecorder) InsertAIBridgeModelPrices(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAIBridgeModelPrices", reflect.TypeOf((*MockStore)(nil).InsertAIBridgeModelPrices), ctx, arg)
+}
+
+// InsertAIBridgePolicyDecision mocks base method.
+func (m *MockStore) InsertAIBridgePolicyDecision(ctx context.Context, arg database.InsertAIBridgePolicyDecisionParams) (database.AIBridgePolicyDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAIBridgePolicyDecision", ctx, arg)
+	ret0, _ := ret[0].(database.AIBridgePolicyDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAIBridgePolicyDecision indicates an expected call of InsertAIBridgePolicyDecision.
+func (mr *MockStoreMockRecorder) InsertAIBridgePolicyDecision(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAIBridgePolicyDecision", reflect.TypeOf((*MockStore)(nil).InsertAIBridgePolicyDecision), ctx, arg)
+}
+
 // InsertAIBridgeTokenUsage mocks base method.
 func (m *MockStore) InsertAIBridgeTokenUsage(ctx context.Context, arg database.InsertAIBridgeTokenUsageParams) (database.AIBridgeTokenUsage, error) {
 	m.ctrl.T.Helper()
@@ -4888,6 +5686,20 @@ func (mr *MockStoreMockRecorder) InsertAuditLog(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditLog", reflect.TypeOf((*MockStore)(nil).InsertAuditLog), ctx, arg)
 }
 
+// InsertAuditLogHashChainEntry mocks base method.
+func (m *MockStore) InsertAuditLogHashChainEntry(ctx context.Context, arg database.InsertAuditLogHashChainEntryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditLogHashChainEntry", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAuditLogHashChainEntry indicates an expected call of InsertAuditLogHashChainEntry.
+func (mr *MockStoreMockRecorder) InsertAuditLogHashChainEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditLogHashChainEntry", reflect.TypeOf((*MockStore)(nil).InsertAuditLogHashChainEntry), ctx, arg)
+}
+
 // InsertCryptoKey mocks base method.
 func (m *MockStore) InsertCryptoKey(ctx context.Context, arg database.InsertCryptoKeyParams) (database.CryptoKey, error) {
 	m.ctrl.T.Helper()
@@ -5094,6 +5906,50 @@ func (mr *MockStoreMockRecorder) InsertMissingGroups(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMissingGroups", reflect.TypeOf((*MockStore)(nil).InsertMissingGroups), ctx, arg)
 }
 
+// InsertNotificationDigestMessage mocks base method.
+func (m *MockStore) InsertNotificationDigestMessage(ctx context.Context, arg database.InsertNotificationDigestMessageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNotificationDigestMessage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertNotificationDigestMessage indicates an expected call of InsertNotificationDigestMessage.
+func (mr *MockStoreMockRecorder) InsertNotificationDigestMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNotificationDigestMessage", reflect.TypeOf((*MockStore)(nil).InsertNotificationDigestMessage), ctx, arg)
+}
+
+// InsertNotificationUserTarget mocks base method.
+func (m *MockStore) InsertNotificationUserTarget(ctx context.Context, arg database.InsertNotificationUserTargetParams) (database.NotificationUserTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNotificationUserTarget", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationUserTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertNotificationUserTarget indicates an expected call of InsertNotificationUserTarget.
+func (mr *MockStoreMockRecorder) InsertNotificationUserTarget(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNotificationUserTarget", reflect.TypeOf((*MockStore)(nil).InsertNotificationUserTarget), ctx, arg)
+}
+
+// InsertNotificationWebhookSecret mocks base method.
+func (m *MockStore) InsertNotificationWebhookSecret(ctx context.Context, arg database.InsertNotificationWebhookSecretParams) (database.NotificationWebhookSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNotificationWebhookSecret", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationWebhookSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertNotificationWebhookSecret indicates an expected call of InsertNotificationWebhookSecret.
+func (mr *MockStoreMockRecorder) InsertNotificationWebhookSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNotificationWebhookSecret", reflect.TypeOf((*MockStore)(nil).InsertNotificationWebhookSecret), ctx, arg)
+}
+
 // InsertOAuth2ProviderApp mocks base method.
 func (m *MockStore) InsertOAuth2ProviderApp(ctx context.Context, arg database.InsertOAuth2ProviderAppParams) (database.OAuth2ProviderApp, error) {
 	m.ctrl.T.Helper()
@@ -5184,6 +6040,20 @@ func (mr *MockStoreMockRecorder) InsertOrganizationMember(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganizationMember", reflect.TypeOf((*MockStore)(nil).InsertOrganizationMember), ctx, arg)
 }
 
+// InsertPrebuildClaim mocks base method.
+func (m *MockStore) InsertPrebuildClaim(ctx context.Context, arg database.InsertPrebuildClaimParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPrebuildClaim", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPrebuildClaim indicates an expected call of InsertPrebuildClaim.
+func (mr *MockStoreMockRecorder) InsertPrebuildClaim(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPrebuildClaim", reflect.TypeOf((*MockStore)(nil).InsertPrebuildClaim), ctx, arg)
+}
+
 // InsertPreset mocks base method.
 func (m *MockStore) InsertPreset(ctx context.Context, arg database.InsertPresetParams) (database.TemplateVersionPreset, error) {
 	m.ctrl.T.Helper()
@@ -5319,6 +6189,21 @@ func (mr *MockStoreMockRecorder) InsertTask(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTask", reflect.TypeOf((*MockStore)(nil).InsertTask), ctx, arg)
 }
 
+// InsertTaskStep mocks base method.
+func (m *MockStore) InsertTaskStep(ctx context.Context, arg database.InsertTaskStepParams) (database.TaskStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTaskStep", ctx, arg)
+	ret0, _ := ret[0].(database.TaskStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertTaskStep indicates an expected call of InsertTaskStep.
+func (mr *MockStoreMockRecorder) InsertTaskStep(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTaskStep", reflect.TypeOf((*MockStore)(nil).InsertTaskStep), ctx, arg)
+}
+
 // InsertTelemetryItemIfNotExists mocks base method.
 func (m *MockStore) InsertTelemetryItemIfNotExists(ctx context.Context, arg database.InsertTelemetryItemIfNotExistsParams) error {
 	m.ctrl.T.Helper()
@@ -5685,6 +6570,20 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceAppStatus(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAppStatus", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAppStatus), ctx, arg)
 }
 
+// InsertWorkspaceAutostartExceptionOptOut mocks base method.
+func (m *MockStore) InsertWorkspaceAutostartExceptionOptOut(ctx context.Context, arg database.InsertWorkspaceAutostartExceptionOptOutParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceAutostartExceptionOptOut", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWorkspaceAutostartExceptionOptOut indicates an expected call of InsertWorkspaceAutostartExceptionOptOut.
+func (mr *MockStoreMockRecorder) InsertWorkspaceAutostartExceptionOptOut(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceAutostartExceptionOptOut", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceAutostartExceptionOptOut), ctx, arg)
+}
+
 // InsertWorkspaceBuild mocks base method.
 func (m *MockStore) InsertWorkspaceBuild(ctx context.Context, arg database.InsertWorkspaceBuildParams) error {
 	m.ctrl.T.Helper()
@@ -5713,6 +6612,21 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceBuildParameters(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceBuildParameters", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceBuildParameters), ctx, arg)
 }
 
+// InsertWorkspaceLifecycleDecision mocks base method.
+func (m *MockStore) InsertWorkspaceLifecycleDecision(ctx context.Context, arg database.InsertWorkspaceLifecycleDecisionParams) (database.WorkspaceLifecycleDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceLifecycleDecision", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceLifecycleDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceLifecycleDecision indicates an expected call of InsertWorkspaceLifecycleDecision.
+func (mr *MockStoreMockRecorder) InsertWorkspaceLifecycleDecision(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceLifecycleDecision", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceLifecycleDecision), ctx, arg)
+}
+
 // InsertWorkspaceModule mocks base method.
 func (m *MockStore) InsertWorkspaceModule(ctx context.Context, arg database.InsertWorkspaceModuleParams) (database.WorkspaceModule, error) {
 	m.ctrl.T.Helper()
@@ -5773,6 +6687,35 @@ func (mr *MockStoreMockRecorder) InsertWorkspaceResourceMetadata(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceResourceMetadata", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceResourceMetadata), ctx, arg)
 }
 
+// InsertWorkspaceScheduleWindow mocks base method.
+func (m *MockStore) InsertWorkspaceScheduleWindow(ctx context.Context, arg database.InsertWorkspaceScheduleWindowParams) (database.WorkspaceScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceScheduleWindow", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWorkspaceScheduleWindow indicates an expected call of InsertWorkspaceScheduleWindow.
+func (mr *MockStoreMockRecorder) InsertWorkspaceScheduleWindow(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceScheduleWindow", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceScheduleWindow), ctx, arg)
+}
+
+// InsertWorkspaceSessionRecordingChunk mocks base method.
+func (m *MockStore) InsertWorkspaceSessionRecordingChunk(ctx context.Context, arg database.InsertWorkspaceSessionRecordingChunkParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWorkspaceSessionRecordingChunk", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWorkspaceSessionRecordingChunk indicates an expected call of InsertWorkspaceSessionRecordingChunk.
+func (mr *MockStoreMockRecorder) InsertWorkspaceSessionRecordingChunk(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWorkspaceSessionRecordingChunk", reflect.TypeOf((*MockStore)(nil).InsertWorkspaceSessionRecordingChunk), ctx, arg)
+}
+
 // ListAIBridgeInterceptions mocks base method.
 func (m *MockStore) ListAIBridgeInterceptions(ctx context.Context, arg database.ListAIBridgeInterceptionsParams) ([]database.AIBridgeInterception, error) {
 	m.ctrl.T.Helper()
@@ -5788,6 +6731,21 @@ func (mr *MockStoreMockRecorder) ListAIBridgeInterceptions(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIBridgeInterceptions", reflect.TypeOf((*MockStore)(nil).ListAIBridgeInterceptions), ctx, arg)
 }
 
+// ListAIBridgePolicyDecisionsByInterceptionIDs mocks base method.
+func (m *MockStore) ListAIBridgePolicyDecisionsByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]database.AIBridgePolicyDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAIBridgePolicyDecisionsByInterceptionIDs", ctx, interceptionIds)
+	ret0, _ := ret[0].([]database.AIBridgePolicyDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAIBridgePolicyDecisionsByInterceptionIDs indicates an expected call of ListAIBridgePolicyDecisionsByInterceptionIDs.
+func (mr *MockStoreMockRecorder) ListAIBridgePolicyDecisionsByInterceptionIDs(ctx, interceptionIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIBridgePolicyDecisionsByInterceptionIDs", reflect.TypeOf((*MockStore)(nil).ListAIBridgePolicyDecisionsByInterceptionIDs), ctx, interceptionIds)
+}
+
 // ListAIBridgeTokenUsagesByInterceptionIDs mocks base method.
 func (m *MockStore) ListAIBridgeTokenUsagesByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]database.AIBridgeTokenUsage, error) {
 	m.ctrl.T.Helper()
@@ -6085,6 +7043,20 @@ func (mr *MockStoreMockRecorder) RemoveUserFromGroups(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromGroups", reflect.TypeOf((*MockStore)(nil).RemoveUserFromGroups), ctx, arg)
 }
 
+// ResetWorkspacesNextStartAtByOrganizationID mocks base method.
+func (m *MockStore) ResetWorkspacesNextStartAtByOrganizationID(ctx context.Context, organizationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkspacesNextStartAtByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWorkspacesNextStartAtByOrganizationID indicates an expected call of ResetWorkspacesNextStartAtByOrganizationID.
+func (mr *MockStoreMockRecorder) ResetWorkspacesNextStartAtByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkspacesNextStartAtByOrganizationID", reflect.TypeOf((*MockStore)(nil).ResetWorkspacesNextStartAtByOrganizationID), ctx, organizationID)
+}
+
 // RevokeDBCryptKey mocks base method.
 func (m *MockStore) RevokeDBCryptKey(ctx context.Context, activeKeyDigest string) error {
 	m.ctrl.T.Helper()
@@ -6157,6 +7129,20 @@ func (mr *MockStoreMockRecorder) UnfavoriteWorkspace(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfavoriteWorkspace", reflect.TypeOf((*MockStore)(nil).UnfavoriteWorkspace), ctx, id)
 }
 
+// UpdateAIBridgeInterceptionCaptureBodies mocks base method.
+func (m *MockStore) UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, arg database.UpdateAIBridgeInterceptionCaptureBodiesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAIBridgeInterceptionCaptureBodies", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAIBridgeInterceptionCaptureBodies indicates an expected call of UpdateAIBridgeInterceptionCaptureBodies.
+func (mr *MockStoreMockRecorder) UpdateAIBridgeInterceptionCaptureBodies(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAIBridgeInterceptionCaptureBodies", reflect.TypeOf((*MockStore)(nil).UpdateAIBridgeInterceptionCaptureBodies), ctx, arg)
+}
+
 // UpdateAPIKeyByID mocks base method.
 func (m *MockStore) UpdateAPIKeyByID(ctx context.Context, arg database.UpdateAPIKeyByIDParams) error {
 	m.ctrl.T.Helper()
@@ -6333,6 +7319,65 @@ func (mr *MockStoreMockRecorder) UpdateNotificationTemplateMethodByID(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationTemplateMethodByID", reflect.TypeOf((*MockStore)(nil).UpdateNotificationTemplateMethodByID), ctx, arg)
 }
 
+// UpdateNotificationTemplateOverridesByID mocks base method.
+func (m *MockStore) UpdateNotificationTemplateOverridesByID(ctx context.Context, arg database.UpdateNotificationTemplateOverridesByIDParams) (database.NotificationTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationTemplateOverridesByID", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationTemplateOverridesByID indicates an expected call of UpdateNotificationTemplateOverridesByID.
+func (mr *MockStoreMockRecorder) UpdateNotificationTemplateOverridesByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationTemplateOverridesByID", reflect.TypeOf((*MockStore)(nil).UpdateNotificationTemplateOverridesByID), ctx, arg)
+}
+
+// UpdateNotificationTemplateUrgencyByID mocks base method.
+func (m *MockStore) UpdateNotificationTemplateUrgencyByID(ctx context.Context, arg database.UpdateNotificationTemplateUrgencyByIDParams) (database.NotificationTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationTemplateUrgencyByID", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationTemplateUrgencyByID indicates an expected call of UpdateNotificationTemplateUrgencyByID.
+func (mr *MockStoreMockRecorder) UpdateNotificationTemplateUrgencyByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationTemplateUrgencyByID", reflect.TypeOf((*MockStore)(nil).UpdateNotificationTemplateUrgencyByID), ctx, arg)
+}
+
+// UpdateNotificationUserTargetSecret mocks base method.
+func (m *MockStore) UpdateNotificationUserTargetSecret(ctx context.Context, arg database.UpdateNotificationUserTargetSecretParams) (database.NotificationUserTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationUserTargetSecret", ctx, arg)
+	ret0, _ := ret[0].(database.NotificationUserTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationUserTargetSecret indicates an expected call of UpdateNotificationUserTargetSecret.
+func (mr *MockStoreMockRecorder) UpdateNotificationUserTargetSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationUserTargetSecret", reflect.TypeOf((*MockStore)(nil).UpdateNotificationUserTargetSecret), ctx, arg)
+}
+
+// UpdateNotificationWebhookSecret mocks base method.
+func (m *MockStore) UpdateNotificationWebhookSecret(ctx context.Context, arg database.UpdateNotificationWebhookSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationWebhookSecret", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotificationWebhookSecret indicates an expected call of UpdateNotificationWebhookSecret.
+func (mr *MockStoreMockRecorder) UpdateNotificationWebhookSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationWebhookSecret", reflect.TypeOf((*MockStore)(nil).UpdateNotificationWebhookSecret), ctx, arg)
+}
+
 // UpdateOAuth2ProviderAppByClientID mocks base method.
 func (m *MockStore) UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg database.UpdateOAuth2ProviderAppByClientIDParams) (database.OAuth2ProviderApp, error) {
 	m.ctrl.T.Helper()
@@ -6548,6 +7593,21 @@ func (mr *MockStoreMockRecorder) UpdateTailnetPeerStatusByCoordinator(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTailnetPeerStatusByCoordinator", reflect.TypeOf((*MockStore)(nil).UpdateTailnetPeerStatusByCoordinator), ctx, arg)
 }
 
+// UpdateTaskStepStatus mocks base method.
+func (m *MockStore) UpdateTaskStepStatus(ctx context.Context, arg database.UpdateTaskStepStatusParams) (database.TaskStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskStepStatus", ctx, arg)
+	ret0, _ := ret[0].(database.TaskStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskStepStatus indicates an expected call of UpdateTaskStepStatus.
+func (mr *MockStoreMockRecorder) UpdateTaskStepStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStepStatus", reflect.TypeOf((*MockStore)(nil).UpdateTaskStepStatus), ctx, arg)
+}
+
 // UpdateTemplateACLByID mocks base method.
 func (m *MockStore) UpdateTemplateACLByID(ctx context.Context, arg database.UpdateTemplateACLByIDParams) error {
 	m.ctrl.T.Helper()
@@ -6716,6 +7776,21 @@ func (mr *MockStoreMockRecorder) UpdateUsageEventsPostPublish(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsageEventsPostPublish", reflect.TypeOf((*MockStore)(nil).UpdateUsageEventsPostPublish), ctx, arg)
 }
 
+// UpdateUserAIBridgeCapture mocks base method.
+func (m *MockStore) UpdateUserAIBridgeCapture(ctx context.Context, arg database.UpdateUserAIBridgeCaptureParams) (database.UserConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserAIBridgeCapture", ctx, arg)
+	ret0, _ := ret[0].(database.UserConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAIBridgeCapture indicates an expected call of UpdateUserAIBridgeCapture.
+func (mr *MockStoreMockRecorder) UpdateUserAIBridgeCapture(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAIBridgeCapture", reflect.TypeOf((*MockStore)(nil).UpdateUserAIBridgeCapture), ctx, arg)
+}
+
 // UpdateUserDeletedByID mocks base method.
 func (m *MockStore) UpdateUserDeletedByID(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -6832,6 +7907,36 @@ func (mr *MockStoreMockRecorder) UpdateUserLoginType(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLoginType", reflect.TypeOf((*MockStore)(nil).UpdateUserLoginType), ctx, arg)
 }
 
+// UpdateUserNotificationPreferenceDigest mocks base method.
+func (m *MockStore) UpdateUserNotificationPreferenceDigest(ctx context.Context, arg database.UpdateUserNotificationPreferenceDigestParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserNotificationPreferenceDigest", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserNotificationPreferenceDigest indicates an expected call of UpdateUserNotificationPreferenceDigest.
+func (mr *MockStoreMockRecorder) UpdateUserNotificationPreferenceDigest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserNotificationPreferenceDigest", reflect.TypeOf((*MockStore)(nil).UpdateUserNotificationPreferenceDigest), ctx, arg)
+}
+
+// UpdateUserNotificationPreferenceTarget mocks base method.
+func (m *MockStore) UpdateUserNotificationPreferenceTarget(ctx context.Context, arg database.UpdateUserNotificationPreferenceTargetParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserNotificationPreferenceTarget", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserNotificationPreferenceTarget indicates an expected call of UpdateUserNotificationPreferenceTarget.
+func (mr *MockStoreMockRecorder) UpdateUserNotificationPreferenceTarget(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserNotificationPreferenceTarget", reflect.TypeOf((*MockStore)(nil).UpdateUserNotificationPreferenceTarget), ctx, arg)
+}
+
 // UpdateUserNotificationPreferences mocks base method.
 func (m *MockStore) UpdateUserNotificationPreferences(ctx context.Context, arg database.UpdateUserNotificationPreferencesParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -6847,6 +7952,36 @@ func (mr *MockStoreMockRecorder) UpdateUserNotificationPreferences(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserNotificationPreferences", reflect.TypeOf((*MockStore)(nil).UpdateUserNotificationPreferences), ctx, arg)
 }
 
+// UpdateUserNotificationQuietHours mocks base method.
+func (m *MockStore) UpdateUserNotificationQuietHours(ctx context.Context, arg database.UpdateUserNotificationQuietHoursParams) (database.UserConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserNotificationQuietHours", ctx, arg)
+	ret0, _ := ret[0].(database.UserConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserNotificationQuietHours indicates an expected call of UpdateUserNotificationQuietHours.
+func (mr *MockStoreMockRecorder) UpdateUserNotificationQuietHours(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserNotificationQuietHours", reflect.TypeOf((*MockStore)(nil).UpdateUserNotificationQuietHours), ctx, arg)
+}
+
+// UpdateUserNotificationSnoozeUntil mocks base method.
+func (m *MockStore) UpdateUserNotificationSnoozeUntil(ctx context.Context, arg database.UpdateUserNotificationSnoozeUntilParams) (database.UserConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserNotificationSnoozeUntil", ctx, arg)
+	ret0, _ := ret[0].(database.UserConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserNotificationSnoozeUntil indicates an expected call of UpdateUserNotificationSnoozeUntil.
+func (mr *MockStoreMockRecorder) UpdateUserNotificationSnoozeUntil(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserNotificationSnoozeUntil", reflect.TypeOf((*MockStore)(nil).UpdateUserNotificationSnoozeUntil), ctx, arg)
+}
+
 // UpdateUserProfile mocks base method.
 func (m *MockStore) UpdateUserProfile(ctx context.Context, arg database.UpdateUserProfileParams) (database.User, error) {
 	m.ctrl.T.Helper()
@@ -7292,6 +8427,35 @@ func (mr *MockStoreMockRecorder) UpdateWorkspacesTTLByTemplateID(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspacesTTLByTemplateID", reflect.TypeOf((*MockStore)(nil).UpdateWorkspacesTTLByTemplateID), ctx, arg)
 }
 
+// UpsertAIBridgeLimit mocks base method.
+func (m *MockStore) UpsertAIBridgeLimit(ctx context.Context, arg database.UpsertAIBridgeLimitParams) (database.AIBridgeLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAIBridgeLimit", ctx, arg)
+	ret0, _ := ret[0].(database.AIBridgeLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAIBridgeLimit indicates an expected call of UpsertAIBridgeLimit.
+func (mr *MockStoreMockRecorder) UpsertAIBridgeLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAIBridgeLimit", reflect.TypeOf((*MockStore)(nil).UpsertAIBridgeLimit), ctx, arg)
+}
+
+// UpsertAIBridgeUsageStats mocks base method.
+func (m *MockStore) UpsertAIBridgeUsageStats(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAIBridgeUsageStats", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAIBridgeUsageStats indicates an expected call of UpsertAIBridgeUsageStats.
+func (mr *MockStoreMockRecorder) UpsertAIBridgeUsageStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAIBridgeUsageStats", reflect.TypeOf((*MockStore)(nil).UpsertAIBridgeUsageStats), ctx)
+}
+
 // UpsertAnnouncementBanners mocks base method.
 func (m *MockStore) UpsertAnnouncementBanners(ctx context.Context, value string) error {
 	m.ctrl.T.Helper()
@@ -7334,6 +8498,21 @@ func (mr *MockStoreMockRecorder) UpsertApplicationName(ctx, value any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertApplicationName", reflect.TypeOf((*MockStore)(nil).UpsertApplicationName), ctx, value)
 }
 
+// UpsertAutostartExceptionCalendar mocks base method.
+func (m *MockStore) UpsertAutostartExceptionCalendar(ctx context.Context, arg database.UpsertAutostartExceptionCalendarParams) (database.AutostartExceptionCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAutostartExceptionCalendar", ctx, arg)
+	ret0, _ := ret[0].(database.AutostartExceptionCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAutostartExceptionCalendar indicates an expected call of UpsertAutostartExceptionCalendar.
+func (mr *MockStoreMockRecorder) UpsertAutostartExceptionCalendar(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAutostartExceptionCalendar", reflect.TypeOf((*MockStore)(nil).UpsertAutostartExceptionCalendar), ctx, arg)
+}
+
 // UpsertConnectionLog mocks base method.
 func (m *MockStore) UpsertConnectionLog(ctx context.Context, arg database.UpsertConnectionLogParams) (database.ConnectionLog, error) {
 	m.ctrl.T.Helper()
@@ -7709,6 +8888,21 @@ func (mr *MockStoreMockRecorder) UpsertWorkspaceAppAuditSession(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkspaceAppAuditSession", reflect.TypeOf((*MockStore)(nil).UpsertWorkspaceAppAuditSession), ctx, arg)
 }
 
+// UpsertWorkspaceSessionRecording mocks base method.
+func (m *MockStore) UpsertWorkspaceSessionRecording(ctx context.Context, arg database.UpsertWorkspaceSessionRecordingParams) (database.WorkspaceSessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkspaceSessionRecording", ctx, arg)
+	ret0, _ := ret[0].(database.WorkspaceSessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkspaceSessionRecording indicates an expected call of UpsertWorkspaceSessionRecording.
+func (mr *MockStoreMockRecorder) UpsertWorkspaceSessionRecording(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkspaceSessionRecording", reflect.TypeOf((*MockStore)(nil).UpsertWorkspaceSessionRecording), ctx, arg)
+}
+
 // ValidateGroupIDs mocks base method.
 func (m *MockStore) ValidateGroupIDs(ctx context.Context, groupIds []uuid.UUID) (database.ValidateGroupIDsRow, error) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/pproflabel"
+	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/quartz"
 )
 
@@ -24,13 +25,29 @@ const (
 	// but we won't touch the `connection_logs` table.
 	maxAuditLogConnectionEventAge    = 90 * 24 * time.Hour // 90 days
 	auditLogConnectionEventBatchSize = 1000
+	// Lifecycle decisions are written for every workspace the autobuild
+	// executor evaluates on every tick, so they are only kept long enough to
+	// explain recent behavior.
+	maxWorkspaceLifecycleDecisionAge = 3 * 24 * time.Hour
+	// Prebuild claims only feed the demand estimate of prebuild autoscaling,
+	// which looks back over a window of at most a few days.
+	maxPrebuildClaimAge = 30 * 24 * time.Hour
+	// Audit and connection logs past their retention period are deleted in
+	// batches, so that a large backlog is purged over several ticks rather
+	// than holding the purge transaction open for a long time.
+	retentionBatchSize = 10000
+	// Session recordings and AI Bridge captures are much larger than log
+	// entries, so fewer are deleted at a time.
+	sessionRecordingRetentionBatchSize = 1000
 )
 
 // New creates a new periodically purging database instance.
 // It is the caller's responsibility to call Close on the returned instance.
 //
 // This is for cleaning up old, unused resources from the database that take up space.
-func New(ctx context.Context, logger slog.Logger, db database.Store, clk quartz.Clock) io.Closer {
+// Audit logs, connection logs, session recordings and AI Bridge captures are
+// only purged if a retention period is configured in vals.
+func New(ctx context.Context, logger slog.Logger, db database.Store, vals *codersdk.DeploymentValues, clk quartz.Clock) io.Closer {
 	closed := make(chan struct{})
 
 	ctx, cancelFunc := context.WithCancel(ctx)
@@ -80,6 +97,68 @@ func New(ctx context.Context, logger slog.Logger, db database.Store, clk quartz.
 				return xerrors.Errorf("failed to delete old audit log connection events: %w", err)
 			}
 
+			deleted, err := tx.DeleteOldWorkspaceLifecycleDecisions(ctx, database.DeleteOldWorkspaceLifecycleDecisionsParams{
+				BeforeTime: start.Add(-maxWorkspaceLifecycleDecisionAge),
+				LimitCount: retentionBatchSize,
+			})
+			if err != nil {
+				return xerrors.Errorf("failed to delete old workspace lifecycle decisions: %w", err)
+			}
+			logger.Debug(ctx, "purged old workspace lifecycle decisions", slog.F("count", deleted))
+
+			deleted, err = tx.DeleteOldPrebuildClaims(ctx, database.DeleteOldPrebuildClaimsParams{
+				BeforeTime: start.Add(-maxPrebuildClaimAge),
+				LimitCount: retentionBatchSize,
+			})
+			if err != nil {
+				return xerrors.Errorf("failed to delete old prebuild claims: %w", err)
+			}
+			logger.Debug(ctx, "purged old prebuild claims", slog.F("count", deleted))
+
+			if retention := vals.Retention.AuditLogs.Value(); retention > 0 {
+				deleted, err := tx.DeleteOldAuditLogs(ctx, database.DeleteOldAuditLogsParams{
+					BeforeTime: start.Add(-retention),
+					LimitCount: retentionBatchSize,
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to delete old audit logs: %w", err)
+				}
+				logger.Debug(ctx, "purged old audit logs", slog.F("count", deleted))
+				if err := tx.DeleteOldAuditLogHashChainCheckpoints(ctx); err != nil {
+					return xerrors.Errorf("failed to delete old audit log hash chain checkpoints: %w", err)
+				}
+			}
+			if retention := vals.Retention.ConnectionLogs.Value(); retention > 0 {
+				deleted, err := tx.DeleteOldConnectionLogs(ctx, database.DeleteOldConnectionLogsParams{
+					BeforeTime: start.Add(-retention),
+					LimitCount: retentionBatchSize,
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to delete old connection logs: %w", err)
+				}
+				logger.Debug(ctx, "purged old connection logs", slog.F("count", deleted))
+			}
+			if retention := vals.Retention.SessionRecordings.Value(); retention > 0 {
+				deleted, err := tx.DeleteOldWorkspaceSessionRecordings(ctx, database.DeleteOldWorkspaceSessionRecordingsParams{
+					BeforeTime: start.Add(-retention),
+					LimitCount: sessionRecordingRetentionBatchSize,
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to delete old session recordings: %w", err)
+				}
+				logger.Debug(ctx, "purged old session recordings", slog.F("count", deleted))
+			}
+			if retention := vals.Retention.AIBridgeCaptures.Value(); retention > 0 {
+				deleted, err := tx.DeleteOldAIBridgeInterceptionCaptures(ctx, database.DeleteOldAIBridgeInterceptionCapturesParams{
+					BeforeTime: start.Add(-retention),
+					LimitCount: sessionRecordingRetentionBatchSize,
+				})
+				if err != nil {
+					return xerrors.Errorf("failed to delete old aibridge interception captures: %w", err)
+				}
+				logger.Debug(ctx, "purged old aibridge interception captures", slog.F("count", deleted))
+			}
+
 			logger.Debug(ctx, "purged old database entries", slog.F("duration", clk.Since(start)))
 
 			return nil
@@ -20,6 +20,7 @@ import (
 	"cdr.dev/slog"
 	"cdr.dev/slog/sloggers/slogtest"
 
+	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbmock"
@@ -33,6 +34,7 @@ import (
 	"github.com/coder/coder/v2/provisionersdk"
 	"github.com/coder/coder/v2/testutil"
 	"github.com/coder/quartz"
+	"github.com/coder/serpent"
 )
 
 func TestMain(m *testing.M) {
@@ -51,7 +53,7 @@ func TestPurge(t *testing.T) {
 	done := awaitDoTick(ctx, t, clk)
 	mDB := dbmock.NewMockStore(gomock.NewController(t))
 	mDB.EXPECT().InTx(gomock.Any(), database.DefaultTXOptions().WithID("db_purge")).Return(nil).Times(2)
-	purger := dbpurge.New(context.Background(), testutil.Logger(t), mDB, clk)
+	purger := dbpurge.New(context.Background(), testutil.Logger(t), mDB, &codersdk.DeploymentValues{}, clk)
 	<-done // wait for doTick() to run.
 	require.NoError(t, purger.Close())
 }
@@ -129,7 +131,7 @@ func TestDeleteOldWorkspaceAgentStats(t *testing.T) {
 	})
 
 	// when
-	closer := dbpurge.New(ctx, logger, db, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
 	defer closer.Close()
 
 	// then
@@ -154,7 +156,7 @@ func TestDeleteOldWorkspaceAgentStats(t *testing.T) {
 
 	// Start a new purger to immediately trigger delete after rollup.
 	_ = closer.Close()
-	closer = dbpurge.New(ctx, logger, db, clk)
+	closer = dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
 	defer closer.Close()
 
 	// then
@@ -245,7 +247,7 @@ func TestDeleteOldWorkspaceAgentLogs(t *testing.T) {
 	// After dbpurge completes, the ticker is reset. Trap this call.
 
 	done := awaitDoTick(ctx, t, clk)
-	closer := dbpurge.New(ctx, logger, db, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
 	defer closer.Close()
 	<-done // doTick() has now run.
 
@@ -466,7 +468,7 @@ func TestDeleteOldProvisionerDaemons(t *testing.T) {
 	require.NoError(t, err)
 
 	// when
-	closer := dbpurge.New(ctx, logger, db, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
 	defer closer.Close()
 
 	// then
@@ -570,7 +572,7 @@ func TestDeleteOldAuditLogConnectionEvents(t *testing.T) {
 
 	// Run the purge
 	done := awaitDoTick(ctx, t, clk)
-	closer := dbpurge.New(ctx, logger, db, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
 	defer closer.Close()
 	// Wait for tick
 	testutil.TryReceive(ctx, t, done)
@@ -640,6 +642,260 @@ func TestDeleteOldAuditLogConnectionEventsLimit(t *testing.T) {
 	require.Len(t, logs, 0)
 }
 
+//nolint:paralleltest // It uses LockIDDBPurge.
+func TestDeleteOldWorkspaceLifecycleDecisions(t *testing.T) {
+	ctx := testutil.Context(t, testutil.WaitShort)
+	clk := quartz.NewMock(t)
+	now := dbtime.Now()
+	clk.Set(now).MustWait(ctx)
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := dbgen.User(t, db, database.User{})
+	tv := dbgen.TemplateVersion(t, db, database.TemplateVersion{OrganizationID: org.ID, CreatedBy: user.ID})
+	tmpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, ActiveVersionID: tv.ID, CreatedBy: user.ID})
+	ws := dbgen.Workspace(t, db, database.WorkspaceTable{OwnerID: user.ID, OrganizationID: org.ID, TemplateID: tmpl.ID})
+
+	insert := func(createdAt time.Time) database.WorkspaceLifecycleDecision {
+		d, err := db.InsertWorkspaceLifecycleDecision(ctx, database.InsertWorkspaceLifecycleDecisionParams{
+			ID:          uuid.New(),
+			WorkspaceID: ws.ID,
+			CreatedAt:   createdAt,
+			Outcome:     database.WorkspaceLifecycleOutcomeSkipped,
+			Checks:      []byte("[]"),
+		})
+		require.NoError(t, err)
+		return d
+	}
+	_ = insert(now.Add(-4 * 24 * time.Hour))
+	recent := insert(now.Add(-2 * 24 * time.Hour))
+
+	done := awaitDoTick(ctx, t, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
+	defer closer.Close()
+	testutil.TryReceive(ctx, t, done)
+
+	decisions, err := db.GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx, database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams{
+		WorkspaceID: ws.ID,
+		LimitCount:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	require.Equal(t, recent.ID, decisions[0].ID, "decisions older than 3 days should be deleted")
+}
+
+//nolint:paralleltest // It uses LockIDDBPurge.
+func TestDeleteOldPrebuildClaims(t *testing.T) {
+	ctx := testutil.Context(t, testutil.WaitShort)
+	clk := quartz.NewMock(t)
+	now := dbtime.Now()
+	clk.Set(now).MustWait(ctx)
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	org := dbgen.Organization(t, db, database.Organization{})
+	user := dbgen.User(t, db, database.User{})
+	tmpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, CreatedBy: user.ID})
+	tv := dbgen.TemplateVersion(t, db, database.TemplateVersion{
+		TemplateID:     uuid.NullUUID{UUID: tmpl.ID, Valid: true},
+		OrganizationID: org.ID,
+		CreatedBy:      user.ID,
+	})
+	preset := dbgen.Preset(t, db, database.InsertPresetParams{TemplateVersionID: tv.ID})
+
+	insert := func(createdAt time.Time) {
+		err := db.InsertPrebuildClaim(ctx, database.InsertPrebuildClaimParams{
+			ID:        uuid.New(),
+			PresetID:  preset.ID,
+			CreatedAt: createdAt,
+		})
+		require.NoError(t, err)
+	}
+	insert(now.Add(-31 * 24 * time.Hour))
+	insert(now.Add(-29 * 24 * time.Hour))
+
+	done := awaitDoTick(ctx, t, clk)
+	closer := dbpurge.New(ctx, logger, db, &codersdk.DeploymentValues{}, clk)
+	defer closer.Close()
+	testutil.TryReceive(ctx, t, done)
+
+	demand, err := db.GetPrebuildClaimDemand(ctx, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, demand, 1)
+	require.EqualValues(t, 1, demand[0].ClaimAttempts, "claims older than 30 days should be deleted")
+}
+
+//nolint:paralleltest // It uses LockIDDBPurge.
+func TestDeleteOldLogsRetention(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitShort)
+	defer cancel()
+
+	clk := quartz.NewMock(t)
+	now := dbtime.Now()
+	clk.Set(now).MustWait(ctx)
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	user := dbgen.User(t, db, database.User{})
+	org := dbgen.Organization(t, db, database.Organization{})
+	tpl := dbgen.Template(t, db, database.Template{OrganizationID: org.ID, CreatedBy: user.ID})
+	ws := dbgen.Workspace(t, db, database.WorkspaceTable{OwnerID: user.ID, OrganizationID: org.ID, TemplateID: tpl.ID})
+
+	vals := &codersdk.DeploymentValues{}
+	vals.Retention.AuditLogs = serpent.Duration(30 * 24 * time.Hour)
+	vals.Retention.ConnectionLogs = serpent.Duration(7 * 24 * time.Hour)
+	vals.Retention.SessionRecordings = serpent.Duration(3 * 24 * time.Hour)
+	vals.Retention.AIBridgeCaptures = serpent.Duration(24 * time.Hour)
+
+	oldAuditLog := dbgen.AuditLog(t, db, database.AuditLog{Time: now.Add(-31 * 24 * time.Hour)})
+	recentAuditLog := dbgen.AuditLog(t, db, database.AuditLog{Time: now.Add(-29 * 24 * time.Hour)})
+	oldConnectionLog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Time:             now.Add(-8 * 24 * time.Hour),
+		OrganizationID:   org.ID,
+		WorkspaceOwnerID: user.ID,
+		WorkspaceID:      ws.ID,
+	})
+	recentConnectionLog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Time:             now.Add(-6 * 24 * time.Hour),
+		OrganizationID:   org.ID,
+		WorkspaceOwnerID: user.ID,
+		WorkspaceID:      ws.ID,
+	})
+	oldRecording := dbgen.WorkspaceSessionRecording(t, db, database.UpsertWorkspaceSessionRecordingParams{
+		CreatedAt:      now.Add(-4 * 24 * time.Hour),
+		OrganizationID: org.ID,
+		WorkspaceID:    ws.ID,
+	}, []byte("old"))
+	recentRecording := dbgen.WorkspaceSessionRecording(t, db, database.UpsertWorkspaceSessionRecordingParams{
+		CreatedAt:      now.Add(-2 * 24 * time.Hour),
+		OrganizationID: org.ID,
+		WorkspaceID:    ws.ID,
+	}, []byte("recent"))
+	oldCapture := dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+		CreatedAt: now.Add(-25 * time.Hour),
+	})
+	recentCapture := dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+		CreatedAt: now.Add(-23 * time.Hour),
+	})
+
+	done := awaitDoTick(ctx, t, clk)
+	closer := dbpurge.New(ctx, logger, db, vals, clk)
+	defer closer.Close()
+	testutil.TryReceive(ctx, t, done)
+
+	auditLogs, err := db.GetAuditLogsOffset(ctx, database.GetAuditLogsOffsetParams{})
+	require.NoError(t, err)
+	auditLogIDs := make([]uuid.UUID, 0, len(auditLogs))
+	for _, log := range auditLogs {
+		auditLogIDs = append(auditLogIDs, log.AuditLog.ID)
+	}
+	require.NotContains(t, auditLogIDs, oldAuditLog.ID, "old audit log should be deleted")
+	require.Contains(t, auditLogIDs, recentAuditLog.ID, "recent audit log should be kept")
+
+	connectionLogs, err := db.GetConnectionLogsOffset(ctx, database.GetConnectionLogsOffsetParams{})
+	require.NoError(t, err)
+	connectionLogIDs := make([]uuid.UUID, 0, len(connectionLogs))
+	for _, log := range connectionLogs {
+		connectionLogIDs = append(connectionLogIDs, log.ConnectionLog.ID)
+	}
+	require.NotContains(t, connectionLogIDs, oldConnectionLog.ID, "old connection log should be deleted")
+	require.Contains(t, connectionLogIDs, recentConnectionLog.ID, "recent connection log should be kept")
+
+	chunks, err := db.GetWorkspaceSessionRecordingChunks(ctx, database.GetWorkspaceSessionRecordingChunksParams{
+		RecordingID: oldRecording.ID,
+		AfterSeq:    -1,
+		LimitCount:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, chunks, "old session recording should be deleted")
+	chunks, err = db.GetWorkspaceSessionRecordingChunks(ctx, database.GetWorkspaceSessionRecordingChunksParams{
+		RecordingID: recentRecording.ID,
+		AfterSeq:    -1,
+		LimitCount:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1, "recent session recording should be kept")
+
+	_, err = db.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, oldCapture.InterceptionID)
+	require.ErrorIs(t, err, sql.ErrNoRows, "old aibridge capture should be deleted")
+	_, err = db.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, recentCapture.InterceptionID)
+	require.NoError(t, err, "recent aibridge capture should be kept")
+}
+
+func TestDeleteOldAuditLogsHashChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	now := dbtime.Now()
+	threshold := now.Add(-24 * time.Hour)
+
+	// Audit logs are not necessarily chained in the order of their
+	// timestamps, so the second entry is older than the first.
+	times := []time.Time{
+		threshold.Add(time.Minute),
+		threshold.Add(-time.Minute),
+		threshold.Add(time.Hour),
+	}
+	prev := []byte{}
+	for i, tm := range times {
+		alog := dbgen.AuditLog(t, db, database.AuditLog{Time: tm})
+		seq := int64(i + 1)
+		hash := audit.HashAuditLog(prev, seq, alog)
+		err := db.InsertAuditLogHashChainEntry(ctx, database.InsertAuditLogHashChainEntryParams{
+			Seq:          seq,
+			AuditLogID:   alog.ID,
+			PreviousHash: prev,
+			Hash:         hash,
+		})
+		require.NoError(t, err)
+		prev = hash
+	}
+
+	deleted, err := db.DeleteOldAuditLogs(ctx, database.DeleteOldAuditLogsParams{
+		BeforeTime: threshold,
+		LimitCount: 100,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+
+	// Only the entry of the deleted audit log is replaced by a checkpoint,
+	// and the chain still verifies across it.
+	res, err := audit.VerifyHashChain(ctx, db)
+	require.NoError(t, err)
+	require.Nil(t, res.BrokenLink)
+	require.EqualValues(t, 1, res.FirstSeq)
+	require.EqualValues(t, 2, res.Entries)
+	require.Equal(t, []audit.HashChainRange{{FirstSeq: 2, LastSeq: 2}}, res.Purged)
+
+	deleted, err = db.DeleteOldAuditLogs(ctx, database.DeleteOldAuditLogsParams{
+		BeforeTime: threshold.Add(2 * time.Minute),
+		LimitCount: 100,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+	err = db.DeleteOldAuditLogHashChainCheckpoints(ctx)
+	require.NoError(t, err)
+
+	// Only the checkpoint before the first remaining entry is kept, and the
+	// chain starts from it.
+	checkpoints, err := db.GetAuditLogHashChainCheckpoints(ctx, database.GetAuditLogHashChainCheckpointsParams{
+		AfterSeq:  0,
+		BeforeSeq: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	require.EqualValues(t, 2, checkpoints[0].Seq)
+
+	res, err = audit.VerifyHashChain(ctx, db)
+	require.NoError(t, err)
+	require.Nil(t, res.BrokenLink)
+	require.EqualValues(t, 3, res.FirstSeq)
+	require.EqualValues(t, 1, res.Entries)
+	require.Equal(t, []audit.HashChainRange{{FirstSeq: 2, LastSeq: 2}}, res.Purged)
+}
+
 func TestExpireOldAPIKeys(t *testing.T) {
 	t.Parallel()
 
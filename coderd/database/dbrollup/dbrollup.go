@@ -23,6 +23,7 @@ const (
 type Event struct {
 	Init               bool `json:"-"`
 	TemplateUsageStats bool `json:"template_usage_stats"`
+	AIBridgeUsageStats bool `json:"aibridge_usage_stats"`
 }
 
 type Rolluper struct {
@@ -59,7 +60,8 @@ func WithEventChannel(ch chan<- Event) Option {
 // It is the caller's responsibility to call Close on the returned instance.
 //
 // This is for e.g. generating insights data (template_usage_stats) from
-// raw data (workspace_agent_stats, workspace_app_stats).
+// raw data (workspace_agent_stats, workspace_app_stats), and AI Bridge usage
+// (aibridge_usage_stats) from interceptions and their token usages.
 func New(logger slog.Logger, db database.Store, opts ...Option) *Rolluper {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -107,7 +109,12 @@ func (r *Rolluper) start(ctx context.Context) {
 				}
 
 				ev.TemplateUsageStats = true
-				return tx.UpsertTemplateUsageStats(ctx)
+				if err := tx.UpsertTemplateUsageStats(ctx); err != nil {
+					return err
+				}
+
+				ev.AIBridgeUsageStats = true
+				return tx.UpsertAIBridgeUsageStats(ctx)
 			}, database.DefaultTXOptions().WithID("db_rollup"))
 		})
 
@@ -3,6 +3,7 @@ package dbrollup_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -261,3 +262,87 @@ func TestRollupTemplateUsageStats(t *testing.T) {
 		},
 	}, stats[0])
 }
+
+func TestRollupAIBridgeUsageStats(t *testing.T) {
+	t.Parallel()
+
+	db, _ := dbtestutil.NewDB(t, dbtestutil.WithDumpOnFailure())
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+
+	anHourAgo := dbtime.Now().Add(-time.Hour).Truncate(time.Hour).UTC()
+
+	var (
+		org  = dbgen.Organization(t, db, database.Organization{})
+		user = dbgen.User(t, db, database.User{})
+		tpl  = dbgen.Template(t, db, database.Template{OrganizationID: org.ID, CreatedBy: user.ID})
+	)
+
+	// Given: an interception made from a workspace, with two token usages.
+	fromWorkspace := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: user.ID,
+		Provider:    "anthropic",
+		Model:       "claude-sonnet-4",
+		Metadata:    json.RawMessage(`{"template_id":"` + tpl.ID.String() + `"}`),
+		StartedAt:   anHourAgo.Add(time.Minute),
+	})
+	for range 2 {
+		_ = dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+			InterceptionID: fromWorkspace.ID,
+			InputTokens:    100,
+			OutputTokens:   10,
+			CreatedAt:      fromWorkspace.StartedAt.Add(time.Second),
+		})
+	}
+
+	// Given: an interception made from outside a workspace, with no token usages.
+	_ = dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: user.ID,
+		Provider:    "openai",
+		Model:       "gpt-4.1",
+		StartedAt:   anHourAgo.Add(2 * time.Minute),
+	})
+
+	// When: the rollup runs.
+	events := make(chan dbrollup.Event, 1)
+	rolluper := dbrollup.New(logger, db, dbrollup.WithInterval(250*time.Millisecond), dbrollup.WithEventChannel(events))
+	defer rolluper.Close()
+
+	<-events // Deplete init event, resume operation.
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for rollup to occur")
+	case ev := <-events:
+		require.True(t, ev.AIBridgeUsageStats, "expected AI Bridge usage stats to be rolled up")
+	}
+
+	// Then: usage is attributed to the template the request was made from, or
+	// to no template at all.
+	stats, err := db.GetAIBridgeUsageStats(ctx, database.GetAIBridgeUsageStatsParams{
+		StartTime: anHourAgo,
+		EndTime:   anHourAgo.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []database.GetAIBridgeUsageStatsRow{
+		{
+			UserID:       user.ID,
+			Username:     user.Username,
+			TemplateID:   tpl.ID,
+			TemplateName: tpl.Name,
+			Provider:     "anthropic",
+			Model:        "claude-sonnet-4",
+			Requests:     1,
+			InputTokens:  200,
+			OutputTokens: 20,
+		},
+		{
+			UserID:   user.ID,
+			Username: user.Username,
+			Provider: "openai",
+			Model:    "gpt-4.1",
+			Requests: 1,
+		},
+	}, stats)
+}
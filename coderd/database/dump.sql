@@ -10,6 +10,17 @@ CREATE TYPE agent_key_scope_enum AS ENUM (
     'no_user_data'
 );
 
+CREATE TYPE aibridge_limit_scope AS ENUM (
+    'user',
+    'group',
+    'organization'
+);
+
+CREATE TYPE aibridge_policy_action AS ENUM (
+    'redact',
+    'block'
+);
+
 CREATE TYPE api_key_scope AS ENUM (
     'coder:all',
     'coder:application_connect',
@@ -225,7 +236,8 @@ CREATE TYPE audit_action AS ENUM (
     'connect',
     'disconnect',
     'open',
-    'close'
+    'close',
+    'invoke'
 );
 
 COMMENT ON TYPE audit_action IS 'NOTE: `connect`, `disconnect`, `open`, and `close` are deprecated and no longer used - these events are now tracked in the connection_logs table.';
@@ -246,7 +258,9 @@ CREATE TYPE build_reason AS ENUM (
     'cli',
     'ssh_connection',
     'vscode_connection',
-    'jetbrains_connection'
+    'jetbrains_connection',
+    'task_autostop',
+    'task_autodelete'
 );
 
 CREATE TYPE connection_status AS ENUM (
@@ -336,7 +350,8 @@ CREATE TYPE notification_message_status AS ENUM (
 CREATE TYPE notification_method AS ENUM (
     'smtp',
     'webhook',
-    'inbox'
+    'inbox',
+    'user_target'
 );
 
 CREATE TYPE notification_template_kind AS ENUM (
@@ -344,6 +359,13 @@ CREATE TYPE notification_template_kind AS ENUM (
     'custom'
 );
 
+CREATE TYPE notification_user_target_type AS ENUM (
+    'webhook',
+    'slack'
+);
+
+COMMENT ON TYPE notification_user_target_type IS 'The payload format used when delivering to a user-defined notification target. slack is also accepted by Mattermost incoming webhooks.';
+
 CREATE TYPE parameter_destination_scheme AS ENUM (
     'none',
     'environment_variable',
@@ -461,7 +483,8 @@ CREATE TYPE resource_type AS ENUM (
     'workspace_agent',
     'workspace_app',
     'prebuilds_settings',
-    'task'
+    'task',
+    'mcp_tool'
 );
 
 CREATE TYPE startup_script_behavior AS ENUM (
@@ -487,6 +510,14 @@ CREATE TYPE task_status AS ENUM (
     'error'
 );
 
+CREATE TYPE task_step_status AS ENUM (
+    'pending',
+    'running',
+    'checking',
+    'completed',
+    'failed'
+);
+
 CREATE TYPE user_status AS ENUM (
     'active',
     'suspended',
@@ -556,6 +587,13 @@ CREATE TYPE workspace_app_status_state AS ENUM (
     'idle'
 );
 
+CREATE TYPE workspace_lifecycle_outcome AS ENUM (
+    'transitioned',
+    'dry_run',
+    'skipped',
+    'failed'
+);
+
 CREATE TYPE workspace_transition AS ENUM (
     'start',
     'stop',
@@ -1047,6 +1085,36 @@ BEGIN
 END;
 $$;
 
+CREATE TABLE aibridge_interception_captures (
+    interception_id uuid NOT NULL,
+    method text NOT NULL,
+    path text NOT NULL,
+    request_headers jsonb DEFAULT '{}'::jsonb NOT NULL,
+    request_body text NOT NULL,
+    request_body_key_id text,
+    request_body_truncated boolean DEFAULT false NOT NULL,
+    response_status integer NOT NULL,
+    response_headers jsonb DEFAULT '{}'::jsonb NOT NULL,
+    response_body text NOT NULL,
+    response_body_key_id text,
+    response_body_truncated boolean DEFAULT false NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_interception_captures IS 'Full upstream requests and responses of AI Bridge interceptions, captured for users who opted in to help debug agent behaviour.';
+
+COMMENT ON COLUMN aibridge_interception_captures.interception_id IS 'Relates to an aibridge_interceptions record, but FK is elided for performance.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_headers IS 'Request headers, with credentials redacted.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_body IS 'Request body, with secrets redacted. Truncated to the configured maximum capture size.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_body_key_id IS 'The ID of the key used to encrypt the request body. If this is NULL, the request body is not encrypted';
+
+COMMENT ON COLUMN aibridge_interception_captures.response_body IS 'Response body, with secrets redacted. Truncated to the configured maximum capture size.';
+
+COMMENT ON COLUMN aibridge_interception_captures.response_body_key_id IS 'The ID of the key used to encrypt the response body. If this is NULL, the response body is not encrypted';
+
 CREATE TABLE aibridge_interceptions (
     id uuid NOT NULL,
     initiator_id uuid NOT NULL,
@@ -1060,6 +1128,55 @@ COMMENT ON TABLE aibridge_interceptions IS 'Audit log of requests intercepted by
 
 COMMENT ON COLUMN aibridge_interceptions.initiator_id IS 'Relates to a users record, but FK is elided for performance.';
 
+CREATE TABLE aibridge_limits (
+    scope aibridge_limit_scope NOT NULL,
+    scope_id uuid NOT NULL,
+    daily_token_budget bigint,
+    monthly_token_budget bigint,
+    requests_per_minute integer,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_limits IS 'Token budgets and request rate limits enforced by AI Bridge';
+
+COMMENT ON COLUMN aibridge_limits.scope_id IS 'Relates to a users, groups or organizations record depending on the scope, so no FK is declared.';
+
+COMMENT ON COLUMN aibridge_limits.daily_token_budget IS 'The maximum number of input and output tokens which may be used per UTC day. NULL means unlimited.';
+
+COMMENT ON COLUMN aibridge_limits.monthly_token_budget IS 'The maximum number of input and output tokens which may be used per UTC calendar month. NULL means unlimited.';
+
+COMMENT ON COLUMN aibridge_limits.requests_per_minute IS 'The maximum number of requests which may be intercepted in any 60 second window. NULL means unlimited.';
+
+CREATE TABLE aibridge_model_prices (
+    provider text NOT NULL,
+    model text NOT NULL,
+    input_price_per_million_tokens double precision NOT NULL,
+    output_price_per_million_tokens double precision NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_model_prices IS 'Admin-configured prices used to estimate the cost of AI Bridge usage';
+
+COMMENT ON COLUMN aibridge_model_prices.input_price_per_million_tokens IS 'Price of one million input tokens, in the currency of the deployment''s choosing.';
+
+COMMENT ON COLUMN aibridge_model_prices.output_price_per_million_tokens IS 'Price of one million output tokens, in the currency of the deployment''s choosing.';
+
+CREATE TABLE aibridge_policy_decisions (
+    id uuid NOT NULL,
+    interception_id uuid NOT NULL,
+    policy text NOT NULL,
+    action aibridge_policy_action NOT NULL,
+    reason text NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_policy_decisions IS 'Audit log of content policy decisions which redacted or blocked intercepted requests in AI Bridge';
+
+COMMENT ON COLUMN aibridge_policy_decisions.policy IS 'The name of the policy which made the decision.';
+
+COMMENT ON COLUMN aibridge_policy_decisions.reason IS 'Why the policy acted on the request. Never contains the offending content itself.';
+
 CREATE TABLE aibridge_token_usages (
     id uuid NOT NULL,
     interception_id uuid NOT NULL,
@@ -1097,6 +1214,31 @@ COMMENT ON COLUMN aibridge_tool_usages.injected IS 'Whether this tool was inject
 
 COMMENT ON COLUMN aibridge_tool_usages.invocation_error IS 'Only injected tools are invoked.';
 
+CREATE TABLE aibridge_usage_stats (
+    start_time timestamp with time zone NOT NULL,
+    user_id uuid NOT NULL,
+    template_id uuid NOT NULL,
+    provider text NOT NULL,
+    model text NOT NULL,
+    requests bigint NOT NULL,
+    input_tokens bigint NOT NULL,
+    output_tokens bigint NOT NULL
+);
+
+COMMENT ON TABLE aibridge_usage_stats IS 'Hourly rollup of requests and tokens intercepted by AI Bridge, used for usage and cost insights';
+
+COMMENT ON COLUMN aibridge_usage_stats.start_time IS 'Start of the hour the usage is aggregated over, inclusive.';
+
+COMMENT ON COLUMN aibridge_usage_stats.user_id IS 'Relates to a users record, but FK is elided as interceptions are kept for deleted users.';
+
+COMMENT ON COLUMN aibridge_usage_stats.template_id IS 'The template of the workspace the requests were made from, or the nil UUID if they were not made from a workspace.';
+
+COMMENT ON COLUMN aibridge_usage_stats.requests IS 'Number of interceptions started within the hour.';
+
+COMMENT ON COLUMN aibridge_usage_stats.input_tokens IS 'Sum of input tokens recorded within the hour.';
+
+COMMENT ON COLUMN aibridge_usage_stats.output_tokens IS 'Sum of output tokens recorded within the hour.';
+
 CREATE TABLE aibridge_user_prompts (
     id uuid NOT NULL,
     interception_id uuid NOT NULL,
@@ -1128,6 +1270,33 @@ CREATE TABLE api_keys (
 
 COMMENT ON COLUMN api_keys.hashed_secret IS 'hashed_secret contains a SHA256 hash of the key secret. This is considered a secret and MUST NOT be returned from the API as it is used for API key encryption in app proxying code.';
 
+CREATE TABLE audit_log_hash_chain (
+    seq bigint NOT NULL,
+    audit_log_id uuid NOT NULL,
+    previous_hash bytea NOT NULL,
+    hash bytea NOT NULL
+);
+
+COMMENT ON TABLE audit_log_hash_chain IS 'A tamper-evident chain over audit logs. Each entry hashes its audit log together with the hash of the previous entry. Audit logs are not referenced by a foreign key, so that deleting one breaks the chain rather than the entry.';
+
+COMMENT ON COLUMN audit_log_hash_chain.seq IS 'The position of the entry in the chain, starting at 1. Gaps are left by purged entries and are covered by audit_log_hash_chain_checkpoints.';
+
+COMMENT ON COLUMN audit_log_hash_chain.previous_hash IS 'The hash of the previous entry, or empty for the first entry.';
+
+CREATE TABLE audit_log_hash_chain_checkpoints (
+    seq bigint NOT NULL,
+    previous_hash bytea NOT NULL,
+    hash bytea NOT NULL
+);
+
+COMMENT ON TABLE audit_log_hash_chain_checkpoints IS 'The links of hash chain entries whose audit logs were purged by retention, so that the remaining chain can still be verified. Only the latest checkpoint before the first remaining entry is kept.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.seq IS 'The position of the purged entry in the chain.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.previous_hash IS 'The hash of the entry before the purged entry.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.hash IS 'The hash of the purged entry.';
+
 CREATE TABLE audit_logs (
     id uuid NOT NULL,
     "time" timestamp with time zone NOT NULL,
@@ -1146,6 +1315,20 @@ CREATE TABLE audit_logs (
     resource_icon text NOT NULL
 );
 
+CREATE TABLE autostart_exception_calendars (
+    id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    exceptions jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE autostart_exception_calendars IS 'Named calendars of dates on which workspaces in an organization must not be autostarted, such as public holidays or company shutdowns.';
+
+COMMENT ON COLUMN autostart_exception_calendars.exceptions IS 'JSON array of exceptions. Each exception has a name, a start_date and an inclusive end_date, formatted as YYYY-MM-DD.';
+
 CREATE TABLE connection_logs (
     id uuid NOT NULL,
     connect_time timestamp with time zone NOT NULL,
@@ -1406,6 +1589,19 @@ CREATE SEQUENCE licenses_id_seq
 
 ALTER SEQUENCE licenses_id_seq OWNED BY licenses.id;
 
+CREATE TABLE notification_digest_messages (
+    id uuid NOT NULL,
+    notification_template_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    method notification_method NOT NULL,
+    payload jsonb NOT NULL,
+    targets uuid[],
+    created_by text NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+COMMENT ON TABLE notification_digest_messages IS 'Notification messages which are held until they are combined into a digest for their recipient.';
+
 CREATE TABLE notification_messages (
     id uuid NOT NULL,
     notification_template_id uuid NOT NULL,
@@ -1432,9 +1628,15 @@ CREATE TABLE notification_preferences (
     notification_template_id uuid NOT NULL,
     disabled boolean DEFAULT false NOT NULL,
     created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    user_target_id uuid,
+    digest boolean DEFAULT false NOT NULL
 );
 
+COMMENT ON COLUMN notification_preferences.user_target_id IS 'The user-defined target to deliver this notification to instead of the deployment-level method. NULL defers to the deployment-level method.';
+
+COMMENT ON COLUMN notification_preferences.digest IS 'Whether notifications from this template are held and delivered to the user as a single digest, rather than individually.';
+
 CREATE TABLE notification_report_generator_logs (
     notification_template_id uuid NOT NULL,
     last_generated_at timestamp with time zone NOT NULL
@@ -1451,13 +1653,54 @@ CREATE TABLE notification_templates (
     "group" text,
     method notification_method,
     kind notification_template_kind DEFAULT 'system'::notification_template_kind NOT NULL,
-    enabled_by_default boolean DEFAULT true NOT NULL
+    enabled_by_default boolean DEFAULT true NOT NULL,
+    title_template_override text,
+    body_template_override text,
+    urgent boolean DEFAULT false NOT NULL
 );
 
 COMMENT ON TABLE notification_templates IS 'Templates from which to create notification messages.';
 
 COMMENT ON COLUMN notification_templates.method IS 'NULL defers to the deployment-level method';
 
+COMMENT ON COLUMN notification_templates.title_template_override IS 'Deployment-specific title template which is used instead of title_template. NULL falls back to the built-in template.';
+
+COMMENT ON COLUMN notification_templates.body_template_override IS 'Deployment-specific body template which is used instead of body_template. NULL falls back to the built-in template.';
+
+COMMENT ON COLUMN notification_templates.urgent IS 'Urgent notifications are delivered immediately, even during the recipient''s quiet hours or while they have snoozed notifications.';
+
+CREATE TABLE notification_user_targets (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    name text NOT NULL,
+    type notification_user_target_type NOT NULL,
+    endpoint text NOT NULL,
+    secret text DEFAULT ''::text NOT NULL,
+    secret_key_id text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+COMMENT ON TABLE notification_user_targets IS 'Delivery targets registered by users to receive their own notifications.';
+
+COMMENT ON COLUMN notification_user_targets.secret IS 'Shared secret used to sign webhook deliveries with HMAC-SHA256. Empty if deliveries are not signed.';
+
+COMMENT ON COLUMN notification_user_targets.secret_key_id IS 'The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted';
+
+CREATE TABLE notification_webhook_secrets (
+    id uuid NOT NULL,
+    secret text NOT NULL,
+    secret_key_id text,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp with time zone
+);
+
+COMMENT ON TABLE notification_webhook_secrets IS 'Shared secrets used to sign deployment-wide webhook notification deliveries.';
+
+COMMENT ON COLUMN notification_webhook_secrets.secret_key_id IS 'The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted';
+
+COMMENT ON COLUMN notification_webhook_secrets.expires_at IS 'NULL for the active secret. Once rotated out, a secret continues to sign deliveries until this time.';
+
 CREATE TABLE oauth2_provider_app_codes (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -1468,7 +1711,8 @@ CREATE TABLE oauth2_provider_app_codes (
     app_id uuid NOT NULL,
     resource_uri text,
     code_challenge text,
-    code_challenge_method text
+    code_challenge_method text,
+    scopes api_key_scope[] DEFAULT '{}'::api_key_scope[] NOT NULL
 );
 
 COMMENT ON TABLE oauth2_provider_app_codes IS 'Codes are meant to be exchanged for access tokens.';
@@ -1479,6 +1723,8 @@ COMMENT ON COLUMN oauth2_provider_app_codes.code_challenge IS 'PKCE code challen
 
 COMMENT ON COLUMN oauth2_provider_app_codes.code_challenge_method IS 'PKCE challenge method (S256)';
 
+COMMENT ON COLUMN oauth2_provider_app_codes.scopes IS 'API key scopes granted to the access token the code is exchanged for. An empty list grants all scopes.';
+
 CREATE TABLE oauth2_provider_app_secrets (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -1624,6 +1870,26 @@ CREATE TABLE parameter_values (
     destination_scheme parameter_destination_scheme NOT NULL
 );
 
+CREATE TABLE prebuild_claims (
+    id uuid NOT NULL,
+    preset_id uuid NOT NULL,
+    workspace_id uuid,
+    created_at timestamp with time zone NOT NULL,
+    hit boolean NOT NULL,
+    build_id uuid,
+    claim_latency_ms integer NOT NULL
+);
+
+COMMENT ON TABLE prebuild_claims IS 'Attempts to claim a prebuilt workspace when creating a workspace with a preset. Used to size prebuild pools from demand and to report how often and how quickly workspaces are served from prebuilds. Entries are only kept for a limited time.';
+
+COMMENT ON COLUMN prebuild_claims.workspace_id IS 'The workspace that was created, which is the claimed prebuilt workspace on a hit.';
+
+COMMENT ON COLUMN prebuild_claims.hit IS 'Whether a prebuilt workspace was available and claimed.';
+
+COMMENT ON COLUMN prebuild_claims.build_id IS 'The build that started the workspace, used to measure the time until its agents were ready.';
+
+COMMENT ON COLUMN prebuild_claims.claim_latency_ms IS 'Time taken by the attempt to claim a prebuilt workspace, in milliseconds.';
+
 CREATE TABLE provisioner_daemons (
     id uuid NOT NULL,
     created_at timestamp with time zone NOT NULL,
@@ -1804,6 +2070,26 @@ CREATE TABLE tailnet_tunnels (
     updated_at timestamp with time zone NOT NULL
 );
 
+CREATE TABLE task_steps (
+    workspace_id uuid NOT NULL,
+    step_index integer NOT NULL,
+    prompt text NOT NULL,
+    completion_check text DEFAULT ''::text NOT NULL,
+    status task_step_status DEFAULT 'pending'::task_step_status NOT NULL,
+    check_exit_code integer,
+    started_at timestamp with time zone,
+    completed_at timestamp with time zone,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE task_steps IS 'The ordered prompts of multi-step tasks. Tasks are currently identified by their workspace.';
+
+COMMENT ON COLUMN task_steps.completion_check IS 'A command which is run in the workspace once the agent reports the step idle. A non-zero exit code fails the task. Empty if the step has no check.';
+
+COMMENT ON COLUMN task_steps.check_exit_code IS 'The exit code of the completion check, if it has run.';
+
+COMMENT ON COLUMN task_steps.updated_at IS 'When the status of the step last changed. Used to recover steps left running or checking by a replica which stopped.';
+
 CREATE TABLE task_workspace_apps (
     task_id uuid NOT NULL,
     workspace_agent_id uuid,
@@ -2263,7 +2549,8 @@ CREATE TABLE templates (
     activity_bump bigint DEFAULT '3600000000000'::bigint NOT NULL,
     max_port_sharing_level app_sharing_level DEFAULT 'owner'::app_sharing_level NOT NULL,
     use_classic_parameter_flow boolean DEFAULT false NOT NULL,
-    cors_behavior cors_behavior DEFAULT 'simple'::cors_behavior NOT NULL
+    cors_behavior cors_behavior DEFAULT 'simple'::cors_behavior NOT NULL,
+    record_sessions boolean DEFAULT false NOT NULL
 );
 
 COMMENT ON COLUMN templates.default_ttl IS 'The default duration for autostop for workspaces created from this template.';
@@ -2286,6 +2573,8 @@ COMMENT ON COLUMN templates.deprecated IS 'If set to a non empty string, the tem
 
 COMMENT ON COLUMN templates.use_classic_parameter_flow IS 'Determines whether to default to the dynamic parameter creation flow for this template or continue using the legacy classic parameter creation flow.This is a template wide setting, the template admin can revert to the classic flow if there are any issues. An escape hatch is required, as workspace creation is a core workflow and cannot break. This column will be removed when the dynamic parameter creation flow is stable.';
 
+COMMENT ON COLUMN templates.record_sessions IS 'Whether workspace agents record the terminal output of SSH and reconnecting PTY sessions.';
+
 CREATE VIEW template_with_names AS
  SELECT templates.id,
     templates.created_at,
@@ -2317,6 +2606,7 @@ CREATE VIEW template_with_names AS
     templates.max_port_sharing_level,
     templates.use_classic_parameter_flow,
     templates.cors_behavior,
+    templates.record_sessions,
     COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
     COALESCE(visible_users.username, ''::text) AS created_by_username,
     COALESCE(visible_users.name, ''::text) AS created_by_name,
@@ -2652,6 +2942,13 @@ CREATE TABLE workspace_app_statuses (
     uri text
 );
 
+CREATE TABLE workspace_autostart_exception_opt_outs (
+    workspace_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_autostart_exception_opt_outs IS 'Workspaces that ignore their organization''s autostart exception calendars.';
+
 CREATE TABLE workspace_build_parameters (
     workspace_build_id uuid NOT NULL,
     name text NOT NULL,
@@ -2738,6 +3035,25 @@ CREATE VIEW workspace_latest_builds AS
   WHERE (workspaces.deleted = false)
   ORDER BY workspaces.id;
 
+CREATE TABLE workspace_lifecycle_decisions (
+    id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    outcome workspace_lifecycle_outcome NOT NULL,
+    transition workspace_transition,
+    build_reason build_reason,
+    checks jsonb DEFAULT '[]'::jsonb NOT NULL,
+    detail text DEFAULT ''::text NOT NULL
+);
+
+COMMENT ON TABLE workspace_lifecycle_decisions IS 'Log of the decisions made by the autobuild lifecycle executor for each workspace it evaluated. Entries are only kept for a short time.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.transition IS 'The transition the executor decided on, if any. Dormancy may be decided without a transition.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.checks IS 'The eligibility checks evaluated, in order, with whether each passed and why.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.detail IS 'Why the decision was skipped or failed, if it was.';
+
 CREATE TABLE workspace_modules (
     id uuid NOT NULL,
     job_id uuid NOT NULL,
@@ -2869,6 +3185,47 @@ CREATE SEQUENCE workspace_resource_metadata_id_seq
 
 ALTER SEQUENCE workspace_resource_metadata_id_seq OWNED BY workspace_resource_metadata.id;
 
+CREATE TABLE workspace_schedule_windows (
+    workspace_id uuid NOT NULL,
+    "position" integer NOT NULL,
+    autostart_schedule text NOT NULL,
+    ttl bigint,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_schedule_windows IS 'Additional autostart windows of a workspace. The workspace''s own autostart_schedule and ttl form its first window.';
+
+COMMENT ON COLUMN workspace_schedule_windows."position" IS 'Order of the window among the workspace''s additional windows. When windows start at the same time, the earlier window takes precedence.';
+
+COMMENT ON COLUMN workspace_schedule_windows.ttl IS 'Time in nanoseconds that workspaces started by this window stay running. NULL disables autostop.';
+
+CREATE TABLE workspace_session_recording_chunks (
+    recording_id uuid NOT NULL,
+    seq integer NOT NULL,
+    data bytea NOT NULL
+);
+
+COMMENT ON TABLE workspace_session_recording_chunks IS 'Chunks of a session recording, in the order they were recorded. Concatenating the chunks by seq yields the asciicast file.';
+
+CREATE TABLE workspace_session_recordings (
+    id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    organization_id uuid NOT NULL,
+    workspace_id uuid NOT NULL,
+    agent_name text NOT NULL,
+    connection_id uuid NOT NULL,
+    size_bytes bigint DEFAULT 0 NOT NULL,
+    truncated boolean DEFAULT false NOT NULL,
+    completed boolean DEFAULT false NOT NULL
+);
+
+COMMENT ON TABLE workspace_session_recordings IS 'Recordings of terminal sessions in the asciicast v2 format. A recording matches the connection log with the same connection ID, workspace and agent name.';
+
+COMMENT ON COLUMN workspace_session_recordings.truncated IS 'Whether the recording stopped early because it reached the maximum recording size.';
+
+COMMENT ON COLUMN workspace_session_recordings.completed IS 'Whether the agent has uploaded the last chunk of the recording.';
+
 CREATE VIEW workspaces_expanded AS
  SELECT workspaces.id,
     workspaces.created_at,
@@ -2921,24 +3278,54 @@ ALTER TABLE ONLY workspace_resource_metadata ALTER COLUMN id SET DEFAULT nextval
 ALTER TABLE ONLY workspace_agent_stats
     ADD CONSTRAINT agent_stats_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY aibridge_interception_captures
+    ADD CONSTRAINT aibridge_interception_captures_pkey PRIMARY KEY (interception_id);
+
 ALTER TABLE ONLY aibridge_interceptions
     ADD CONSTRAINT aibridge_interceptions_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY aibridge_limits
+    ADD CONSTRAINT aibridge_limits_pkey PRIMARY KEY (scope, scope_id);
+
+ALTER TABLE ONLY aibridge_model_prices
+    ADD CONSTRAINT aibridge_model_prices_pkey PRIMARY KEY (provider, model);
+
+ALTER TABLE ONLY aibridge_policy_decisions
+    ADD CONSTRAINT aibridge_policy_decisions_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY aibridge_token_usages
     ADD CONSTRAINT aibridge_token_usages_pkey PRIMARY KEY (id);
 
 ALTER TABLE ONLY aibridge_tool_usages
     ADD CONSTRAINT aibridge_tool_usages_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY aibridge_usage_stats
+    ADD CONSTRAINT aibridge_usage_stats_pkey PRIMARY KEY (start_time, user_id, template_id, provider, model);
+
 ALTER TABLE ONLY aibridge_user_prompts
     ADD CONSTRAINT aibridge_user_prompts_pkey PRIMARY KEY (id);
 
 ALTER TABLE ONLY api_keys
     ADD CONSTRAINT api_keys_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY audit_log_hash_chain
+    ADD CONSTRAINT audit_log_hash_chain_audit_log_id_key UNIQUE (audit_log_id);
+
+ALTER TABLE ONLY audit_log_hash_chain
+    ADD CONSTRAINT audit_log_hash_chain_pkey PRIMARY KEY (seq);
+
+ALTER TABLE ONLY audit_log_hash_chain_checkpoints
+    ADD CONSTRAINT audit_log_hash_chain_checkpoints_pkey PRIMARY KEY (seq);
+
 ALTER TABLE ONLY audit_logs
     ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY autostart_exception_calendars
+    ADD CONSTRAINT autostart_exception_calendars_organization_id_name_key UNIQUE (organization_id, name);
+
+ALTER TABLE ONLY autostart_exception_calendars
+    ADD CONSTRAINT autostart_exception_calendars_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY connection_logs
     ADD CONSTRAINT connection_logs_pkey PRIMARY KEY (id);
 
@@ -2990,6 +3377,9 @@ ALTER TABLE ONLY licenses
 ALTER TABLE ONLY licenses
     ADD CONSTRAINT licenses_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY notification_digest_messages
+    ADD CONSTRAINT notification_digest_messages_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY notification_messages
     ADD CONSTRAINT notification_messages_pkey PRIMARY KEY (id);
 
@@ -3005,6 +3395,15 @@ ALTER TABLE ONLY notification_templates
 ALTER TABLE ONLY notification_templates
     ADD CONSTRAINT notification_templates_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY notification_user_targets
+    ADD CONSTRAINT notification_user_targets_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY notification_user_targets
+    ADD CONSTRAINT notification_user_targets_user_id_name_key UNIQUE (user_id, name);
+
+ALTER TABLE ONLY notification_webhook_secrets
+    ADD CONSTRAINT notification_webhook_secrets_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY oauth2_provider_app_codes
     ADD CONSTRAINT oauth2_provider_app_codes_pkey PRIMARY KEY (id);
 
@@ -3044,6 +3443,9 @@ ALTER TABLE ONLY parameter_values
 ALTER TABLE ONLY parameter_values
     ADD CONSTRAINT parameter_values_scope_id_name_key UNIQUE (scope_id, name);
 
+ALTER TABLE ONLY prebuild_claims
+    ADD CONSTRAINT prebuild_claims_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY provisioner_daemons
     ADD CONSTRAINT provisioner_daemons_pkey PRIMARY KEY (id);
 
@@ -3077,6 +3479,9 @@ ALTER TABLE ONLY tailnet_peers
 ALTER TABLE ONLY tailnet_tunnels
     ADD CONSTRAINT tailnet_tunnels_pkey PRIMARY KEY (coordinator_id, src_id, dst_id);
 
+ALTER TABLE ONLY task_steps
+    ADD CONSTRAINT task_steps_pkey PRIMARY KEY (workspace_id, step_index);
+
 ALTER TABLE ONLY task_workspace_apps
     ADD CONSTRAINT task_workspace_apps_pkey PRIMARY KEY (task_id, workspace_build_number);
 
@@ -3197,6 +3602,9 @@ ALTER TABLE ONLY workspace_apps
 ALTER TABLE ONLY workspace_apps
     ADD CONSTRAINT workspace_apps_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY workspace_autostart_exception_opt_outs
+    ADD CONSTRAINT workspace_autostart_exception_opt_outs_pkey PRIMARY KEY (workspace_id);
+
 ALTER TABLE ONLY workspace_build_parameters
     ADD CONSTRAINT workspace_build_parameters_workspace_build_id_name_key UNIQUE (workspace_build_id, name);
 
@@ -3209,6 +3617,9 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_build_number_key UNIQUE (workspace_id, build_number);
 
+ALTER TABLE ONLY workspace_lifecycle_decisions
+    ADD CONSTRAINT workspace_lifecycle_decisions_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY workspace_proxies
     ADD CONSTRAINT workspace_proxies_pkey PRIMARY KEY (id);
 
@@ -3224,6 +3635,18 @@ ALTER TABLE ONLY workspace_resource_metadata
 ALTER TABLE ONLY workspace_resources
     ADD CONSTRAINT workspace_resources_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY workspace_schedule_windows
+    ADD CONSTRAINT workspace_schedule_windows_pkey PRIMARY KEY (workspace_id, "position");
+
+ALTER TABLE ONLY workspace_session_recording_chunks
+    ADD CONSTRAINT workspace_session_recording_chunks_pkey PRIMARY KEY (recording_id, seq);
+
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_connection_key UNIQUE (connection_id, workspace_id, agent_name);
+
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY workspaces
     ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
 
@@ -3235,6 +3658,8 @@ CREATE INDEX idx_agent_stats_created_at ON workspace_agent_stats USING btree (cr
 
 CREATE INDEX idx_agent_stats_user_id ON workspace_agent_stats USING btree (user_id);
 
+CREATE INDEX idx_aibridge_interception_captures_created_at ON aibridge_interception_captures USING btree (created_at);
+
 CREATE INDEX idx_aibridge_interceptions_initiator_id ON aibridge_interceptions USING btree (initiator_id);
 
 CREATE INDEX idx_aibridge_interceptions_model ON aibridge_interceptions USING btree (model);
@@ -3243,6 +3668,10 @@ CREATE INDEX idx_aibridge_interceptions_provider ON aibridge_interceptions USING
 
 CREATE INDEX idx_aibridge_interceptions_started_id_desc ON aibridge_interceptions USING btree (started_at DESC, id DESC);
 
+CREATE INDEX idx_aibridge_policy_decisions_interception_id ON aibridge_policy_decisions USING btree (interception_id);
+
+CREATE INDEX idx_aibridge_token_usages_created_at ON aibridge_token_usages USING btree (created_at);
+
 CREATE INDEX idx_aibridge_token_usages_interception_id ON aibridge_token_usages USING btree (interception_id);
 
 CREATE INDEX idx_aibridge_token_usages_provider_response_id ON aibridge_token_usages USING btree (provider_response_id);
@@ -3251,6 +3680,8 @@ CREATE INDEX idx_aibridge_tool_usages_interception_id ON aibridge_tool_usages US
 
 CREATE INDEX idx_aibridge_tool_usagesprovider_response_id ON aibridge_tool_usages USING btree (provider_response_id);
 
+CREATE INDEX idx_aibridge_usage_stats_user_id_start_time ON aibridge_usage_stats USING btree (user_id, start_time);
+
 CREATE INDEX idx_aibridge_user_prompts_interception_id ON aibridge_user_prompts USING btree (interception_id);
 
 CREATE INDEX idx_aibridge_user_prompts_provider_response_id ON aibridge_user_prompts USING btree (provider_response_id);
@@ -3287,14 +3718,20 @@ CREATE INDEX idx_inbox_notifications_user_id_read_at ON inbox_notifications USIN
 
 CREATE INDEX idx_inbox_notifications_user_id_template_id_targets ON inbox_notifications USING btree (user_id, template_id, targets);
 
+CREATE INDEX idx_notification_digest_messages_group ON notification_digest_messages USING btree (user_id, notification_template_id, method, created_at);
+
 CREATE INDEX idx_notification_messages_status ON notification_messages USING btree (status);
 
+CREATE UNIQUE INDEX idx_notification_webhook_secrets_active ON notification_webhook_secrets USING btree (((expires_at IS NULL))) WHERE (expires_at IS NULL);
+
 CREATE INDEX idx_organization_member_organization_id_uuid ON organization_members USING btree (organization_id);
 
 CREATE INDEX idx_organization_member_user_id_uuid ON organization_members USING btree (user_id);
 
 CREATE UNIQUE INDEX idx_organization_name_lower ON organizations USING btree (lower(name)) WHERE (deleted = false);
 
+CREATE INDEX idx_prebuild_claims_created_at ON prebuild_claims USING btree (created_at);
+
 CREATE UNIQUE INDEX idx_provisioner_daemons_org_name_owner_key ON provisioner_daemons USING btree (organization_id, name, lower(COALESCE((tags ->> 'owner'::text), ''::text)));
 
 COMMENT ON INDEX idx_provisioner_daemons_org_name_owner_key IS 'Allow unique provisioner daemon names by organization and user';
@@ -3331,6 +3768,10 @@ CREATE INDEX idx_workspace_app_statuses_workspace_id_created_at ON workspace_app
 
 CREATE INDEX idx_workspace_builds_initiator_id ON workspace_builds USING btree (initiator_id);
 
+CREATE INDEX idx_workspace_lifecycle_decisions_created_at ON workspace_lifecycle_decisions USING btree (created_at);
+
+CREATE INDEX idx_workspace_lifecycle_decisions_workspace_id_created_at ON workspace_lifecycle_decisions USING btree (workspace_id, created_at DESC);
+
 CREATE UNIQUE INDEX notification_messages_dedupe_hash_idx ON notification_messages USING btree (dedupe_hash);
 
 CREATE UNIQUE INDEX organizations_single_default_org ON organizations USING btree (is_default) WHERE (is_default = true);
@@ -3409,6 +3850,8 @@ CREATE UNIQUE INDEX workspace_proxies_lower_name_idx ON workspace_proxies USING
 
 CREATE INDEX workspace_resources_job_id_idx ON workspace_resources USING btree (job_id);
 
+CREATE INDEX workspace_session_recordings_created_at_idx ON workspace_session_recordings USING btree (created_at);
+
 CREATE INDEX workspace_template_id_idx ON workspaces USING btree (template_id) WHERE (deleted = false);
 
 CREATE UNIQUE INDEX workspaces_owner_id_lower_idx ON workspaces USING btree (owner_id, lower((name)::text)) WHERE (deleted = false);
@@ -3509,9 +3952,18 @@ COMMENT ON TRIGGER workspace_agent_name_unique_trigger ON workspace_agents IS 'U
 the uniqueness requirement. A trigger allows us to enforce uniqueness going
 forward without requiring a migration to clean up historical data.';
 
+ALTER TABLE ONLY aibridge_interception_captures
+    ADD CONSTRAINT aibridge_interception_captures_request_body_key_id_fkey FOREIGN KEY (request_body_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+
+ALTER TABLE ONLY aibridge_interception_captures
+    ADD CONSTRAINT aibridge_interception_captures_response_body_key_id_fkey FOREIGN KEY (response_body_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+
 ALTER TABLE ONLY api_keys
     ADD CONSTRAINT api_keys_user_id_uuid_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY autostart_exception_calendars
+    ADD CONSTRAINT autostart_exception_calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY connection_logs
     ADD CONSTRAINT connection_logs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 
@@ -3557,6 +4009,12 @@ ALTER TABLE ONLY jfrog_xray_scans
 ALTER TABLE ONLY jfrog_xray_scans
     ADD CONSTRAINT jfrog_xray_scans_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY notification_digest_messages
+    ADD CONSTRAINT notification_digest_messages_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY notification_digest_messages
+    ADD CONSTRAINT notification_digest_messages_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY notification_messages
     ADD CONSTRAINT notification_messages_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
 
@@ -3569,6 +4027,18 @@ ALTER TABLE ONLY notification_preferences
 ALTER TABLE ONLY notification_preferences
     ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY notification_preferences
+    ADD CONSTRAINT notification_preferences_user_target_id_fkey FOREIGN KEY (user_target_id) REFERENCES notification_user_targets(id) ON DELETE SET NULL;
+
+ALTER TABLE ONLY notification_user_targets
+    ADD CONSTRAINT notification_user_targets_secret_key_id_fkey FOREIGN KEY (secret_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+
+ALTER TABLE ONLY notification_user_targets
+    ADD CONSTRAINT notification_user_targets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY notification_webhook_secrets
+    ADD CONSTRAINT notification_webhook_secrets_secret_key_id_fkey FOREIGN KEY (secret_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+
 ALTER TABLE ONLY oauth2_provider_app_codes
     ADD CONSTRAINT oauth2_provider_app_codes_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 
@@ -3593,6 +4063,15 @@ ALTER TABLE ONLY organization_members
 ALTER TABLE ONLY parameter_schemas
     ADD CONSTRAINT parameter_schemas_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY prebuild_claims
+    ADD CONSTRAINT prebuild_claims_build_id_fkey FOREIGN KEY (build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY prebuild_claims
+    ADD CONSTRAINT prebuild_claims_preset_id_fkey FOREIGN KEY (preset_id) REFERENCES template_version_presets(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY prebuild_claims
+    ADD CONSTRAINT prebuild_claims_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY provisioner_daemons
     ADD CONSTRAINT provisioner_daemons_key_id_fkey FOREIGN KEY (key_id) REFERENCES provisioner_keys(id) ON DELETE CASCADE;
 
@@ -3626,6 +4105,9 @@ ALTER TABLE ONLY tailnet_peers
 ALTER TABLE ONLY tailnet_tunnels
     ADD CONSTRAINT tailnet_tunnels_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY task_steps
+    ADD CONSTRAINT task_steps_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY task_workspace_apps
     ADD CONSTRAINT task_workspace_apps_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
 
@@ -3767,6 +4249,9 @@ ALTER TABLE ONLY workspace_app_statuses
 ALTER TABLE ONLY workspace_apps
     ADD CONSTRAINT workspace_apps_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_autostart_exception_opt_outs
+    ADD CONSTRAINT workspace_autostart_exception_opt_outs_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_build_parameters
     ADD CONSTRAINT workspace_build_parameters_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
 
@@ -3785,6 +4270,9 @@ ALTER TABLE ONLY workspace_builds
 ALTER TABLE ONLY workspace_builds
     ADD CONSTRAINT workspace_builds_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_lifecycle_decisions
+    ADD CONSTRAINT workspace_lifecycle_decisions_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspace_modules
     ADD CONSTRAINT workspace_modules_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
@@ -3794,6 +4282,18 @@ ALTER TABLE ONLY workspace_resource_metadata
 ALTER TABLE ONLY workspace_resources
     ADD CONSTRAINT workspace_resources_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY workspace_schedule_windows
+    ADD CONSTRAINT workspace_schedule_windows_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_session_recording_chunks
+    ADD CONSTRAINT workspace_session_recording_chunks_recording_id_fkey FOREIGN KEY (recording_id) REFERENCES workspace_session_recordings(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY workspace_session_recordings
+    ADD CONSTRAINT workspace_session_recordings_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY workspaces
     ADD CONSTRAINT workspaces_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE RESTRICT;
 
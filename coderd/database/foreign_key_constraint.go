@@ -6,7 +6,10 @@ type ForeignKeyConstraint string
 
 // ForeignKeyConstraint enums.
 const (
+	ForeignKeyAibridgeInterceptionCapturesRequestBodyKeyID        ForeignKeyConstraint = "aibridge_interception_captures_request_body_key_id_fkey"         // ALTER TABLE ONLY aibridge_interception_captures ADD CONSTRAINT aibridge_interception_captures_request_body_key_id_fkey FOREIGN KEY (request_body_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+	ForeignKeyAibridgeInterceptionCapturesResponseBodyKeyID       ForeignKeyConstraint = "aibridge_interception_captures_response_body_key_id_fkey"        // ALTER TABLE ONLY aibridge_interception_captures ADD CONSTRAINT aibridge_interception_captures_response_body_key_id_fkey FOREIGN KEY (response_body_key_id) REFERENCES dbcrypt_keys(active_key_digest);
 	ForeignKeyAPIKeysUserIDUUID                                   ForeignKeyConstraint = "api_keys_user_id_uuid_fkey"                                      // ALTER TABLE ONLY api_keys ADD CONSTRAINT api_keys_user_id_uuid_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyAutostartExceptionCalendarsOrganizationID           ForeignKeyConstraint = "autostart_exception_calendars_organization_id_fkey"              // ALTER TABLE ONLY autostart_exception_calendars ADD CONSTRAINT autostart_exception_calendars_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyConnectionLogsOrganizationID                        ForeignKeyConstraint = "connection_logs_organization_id_fkey"                            // ALTER TABLE ONLY connection_logs ADD CONSTRAINT connection_logs_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyConnectionLogsWorkspaceID                           ForeignKeyConstraint = "connection_logs_workspace_id_fkey"                               // ALTER TABLE ONLY connection_logs ADD CONSTRAINT connection_logs_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyConnectionLogsWorkspaceOwnerID                      ForeignKeyConstraint = "connection_logs_workspace_owner_id_fkey"                         // ALTER TABLE ONLY connection_logs ADD CONSTRAINT connection_logs_workspace_owner_id_fkey FOREIGN KEY (workspace_owner_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -22,10 +25,16 @@ const (
 	ForeignKeyInboxNotificationsUserID                            ForeignKeyConstraint = "inbox_notifications_user_id_fkey"                                // ALTER TABLE ONLY inbox_notifications ADD CONSTRAINT inbox_notifications_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyJfrogXrayScansAgentID                               ForeignKeyConstraint = "jfrog_xray_scans_agent_id_fkey"                                  // ALTER TABLE ONLY jfrog_xray_scans ADD CONSTRAINT jfrog_xray_scans_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyJfrogXrayScansWorkspaceID                           ForeignKeyConstraint = "jfrog_xray_scans_workspace_id_fkey"                              // ALTER TABLE ONLY jfrog_xray_scans ADD CONSTRAINT jfrog_xray_scans_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyNotificationDigestMessagesNotificationTemplateID    ForeignKeyConstraint = "notification_digest_messages_notification_template_id_fkey"      // ALTER TABLE ONLY notification_digest_messages ADD CONSTRAINT notification_digest_messages_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
+	ForeignKeyNotificationDigestMessagesUserID                    ForeignKeyConstraint = "notification_digest_messages_user_id_fkey"                       // ALTER TABLE ONLY notification_digest_messages ADD CONSTRAINT notification_digest_messages_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyNotificationMessagesNotificationTemplateID          ForeignKeyConstraint = "notification_messages_notification_template_id_fkey"             // ALTER TABLE ONLY notification_messages ADD CONSTRAINT notification_messages_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
 	ForeignKeyNotificationMessagesUserID                          ForeignKeyConstraint = "notification_messages_user_id_fkey"                              // ALTER TABLE ONLY notification_messages ADD CONSTRAINT notification_messages_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyNotificationPreferencesNotificationTemplateID       ForeignKeyConstraint = "notification_preferences_notification_template_id_fkey"          // ALTER TABLE ONLY notification_preferences ADD CONSTRAINT notification_preferences_notification_template_id_fkey FOREIGN KEY (notification_template_id) REFERENCES notification_templates(id) ON DELETE CASCADE;
 	ForeignKeyNotificationPreferencesUserID                       ForeignKeyConstraint = "notification_preferences_user_id_fkey"                           // ALTER TABLE ONLY notification_preferences ADD CONSTRAINT notification_preferences_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyNotificationPreferencesUserTargetID                 ForeignKeyConstraint = "notification_preferences_user_target_id_fkey"                    // ALTER TABLE ONLY notification_preferences ADD CONSTRAINT notification_preferences_user_target_id_fkey FOREIGN KEY (user_target_id) REFERENCES notification_user_targets(id) ON DELETE SET NULL;
+	ForeignKeyNotificationUserTargetsSecretKeyID                  ForeignKeyConstraint = "notification_user_targets_secret_key_id_fkey"                    // ALTER TABLE ONLY notification_user_targets ADD CONSTRAINT notification_user_targets_secret_key_id_fkey FOREIGN KEY (secret_key_id) REFERENCES dbcrypt_keys(active_key_digest);
+	ForeignKeyNotificationUserTargetsUserID                       ForeignKeyConstraint = "notification_user_targets_user_id_fkey"                          // ALTER TABLE ONLY notification_user_targets ADD CONSTRAINT notification_user_targets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	ForeignKeyNotificationWebhookSecretsSecretKeyID               ForeignKeyConstraint = "notification_webhook_secrets_secret_key_id_fkey"                 // ALTER TABLE ONLY notification_webhook_secrets ADD CONSTRAINT notification_webhook_secrets_secret_key_id_fkey FOREIGN KEY (secret_key_id) REFERENCES dbcrypt_keys(active_key_digest);
 	ForeignKeyOauth2ProviderAppCodesAppID                         ForeignKeyConstraint = "oauth2_provider_app_codes_app_id_fkey"                           // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppCodesUserID                        ForeignKeyConstraint = "oauth2_provider_app_codes_user_id_fkey"                          // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyOauth2ProviderAppSecretsAppID                       ForeignKeyConstraint = "oauth2_provider_app_secrets_app_id_fkey"                         // ALTER TABLE ONLY oauth2_provider_app_secrets ADD CONSTRAINT oauth2_provider_app_secrets_app_id_fkey FOREIGN KEY (app_id) REFERENCES oauth2_provider_apps(id) ON DELETE CASCADE;
@@ -34,6 +43,9 @@ const (
 	ForeignKeyOrganizationMembersOrganizationIDUUID               ForeignKeyConstraint = "organization_members_organization_id_uuid_fkey"                  // ALTER TABLE ONLY organization_members ADD CONSTRAINT organization_members_organization_id_uuid_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyOrganizationMembersUserIDUUID                       ForeignKeyConstraint = "organization_members_user_id_uuid_fkey"                          // ALTER TABLE ONLY organization_members ADD CONSTRAINT organization_members_user_id_uuid_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
 	ForeignKeyParameterSchemasJobID                               ForeignKeyConstraint = "parameter_schemas_job_id_fkey"                                   // ALTER TABLE ONLY parameter_schemas ADD CONSTRAINT parameter_schemas_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+	ForeignKeyPrebuildClaimsBuildID                               ForeignKeyConstraint = "prebuild_claims_build_id_fkey"                                   // ALTER TABLE ONLY prebuild_claims ADD CONSTRAINT prebuild_claims_build_id_fkey FOREIGN KEY (build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
+	ForeignKeyPrebuildClaimsPresetID                              ForeignKeyConstraint = "prebuild_claims_preset_id_fkey"                                  // ALTER TABLE ONLY prebuild_claims ADD CONSTRAINT prebuild_claims_preset_id_fkey FOREIGN KEY (preset_id) REFERENCES template_version_presets(id) ON DELETE CASCADE;
+	ForeignKeyPrebuildClaimsWorkspaceID                           ForeignKeyConstraint = "prebuild_claims_workspace_id_fkey"                               // ALTER TABLE ONLY prebuild_claims ADD CONSTRAINT prebuild_claims_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerDaemonsKeyID                             ForeignKeyConstraint = "provisioner_daemons_key_id_fkey"                                 // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_key_id_fkey FOREIGN KEY (key_id) REFERENCES provisioner_keys(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerDaemonsOrganizationID                    ForeignKeyConstraint = "provisioner_daemons_organization_id_fkey"                        // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
 	ForeignKeyProvisionerJobLogsJobID                             ForeignKeyConstraint = "provisioner_job_logs_job_id_fkey"                                // ALTER TABLE ONLY provisioner_job_logs ADD CONSTRAINT provisioner_job_logs_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
@@ -45,6 +57,7 @@ const (
 	ForeignKeyTailnetClientsCoordinatorID                         ForeignKeyConstraint = "tailnet_clients_coordinator_id_fkey"                             // ALTER TABLE ONLY tailnet_clients ADD CONSTRAINT tailnet_clients_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 	ForeignKeyTailnetPeersCoordinatorID                           ForeignKeyConstraint = "tailnet_peers_coordinator_id_fkey"                               // ALTER TABLE ONLY tailnet_peers ADD CONSTRAINT tailnet_peers_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
 	ForeignKeyTailnetTunnelsCoordinatorID                         ForeignKeyConstraint = "tailnet_tunnels_coordinator_id_fkey"                             // ALTER TABLE ONLY tailnet_tunnels ADD CONSTRAINT tailnet_tunnels_coordinator_id_fkey FOREIGN KEY (coordinator_id) REFERENCES tailnet_coordinators(id) ON DELETE CASCADE;
+	ForeignKeyTaskStepsWorkspaceID                                ForeignKeyConstraint = "task_steps_workspace_id_fkey"                                    // ALTER TABLE ONLY task_steps ADD CONSTRAINT task_steps_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyTaskWorkspaceAppsTaskID                             ForeignKeyConstraint = "task_workspace_apps_task_id_fkey"                                // ALTER TABLE ONLY task_workspace_apps ADD CONSTRAINT task_workspace_apps_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
 	ForeignKeyTaskWorkspaceAppsWorkspaceAgentID                   ForeignKeyConstraint = "task_workspace_apps_workspace_agent_id_fkey"                     // ALTER TABLE ONLY task_workspace_apps ADD CONSTRAINT task_workspace_apps_workspace_agent_id_fkey FOREIGN KEY (workspace_agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
 	ForeignKeyTaskWorkspaceAppsWorkspaceAppID                     ForeignKeyConstraint = "task_workspace_apps_workspace_app_id_fkey"                       // ALTER TABLE ONLY task_workspace_apps ADD CONSTRAINT task_workspace_apps_workspace_app_id_fkey FOREIGN KEY (workspace_app_id) REFERENCES workspace_apps(id) ON DELETE CASCADE;
@@ -92,15 +105,21 @@ const (
 	ForeignKeyWorkspaceAppStatusesAppID                           ForeignKeyConstraint = "workspace_app_statuses_app_id_fkey"                              // ALTER TABLE ONLY workspace_app_statuses ADD CONSTRAINT workspace_app_statuses_app_id_fkey FOREIGN KEY (app_id) REFERENCES workspace_apps(id);
 	ForeignKeyWorkspaceAppStatusesWorkspaceID                     ForeignKeyConstraint = "workspace_app_statuses_workspace_id_fkey"                        // ALTER TABLE ONLY workspace_app_statuses ADD CONSTRAINT workspace_app_statuses_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id);
 	ForeignKeyWorkspaceAppsAgentID                                ForeignKeyConstraint = "workspace_apps_agent_id_fkey"                                    // ALTER TABLE ONLY workspace_apps ADD CONSTRAINT workspace_apps_agent_id_fkey FOREIGN KEY (agent_id) REFERENCES workspace_agents(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceAutostartExceptionOptOutsWorkspaceID       ForeignKeyConstraint = "workspace_autostart_exception_opt_outs_workspace_id_fkey"        // ALTER TABLE ONLY workspace_autostart_exception_opt_outs ADD CONSTRAINT workspace_autostart_exception_opt_outs_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceBuildParametersWorkspaceBuildID            ForeignKeyConstraint = "workspace_build_parameters_workspace_build_id_fkey"              // ALTER TABLE ONLY workspace_build_parameters ADD CONSTRAINT workspace_build_parameters_workspace_build_id_fkey FOREIGN KEY (workspace_build_id) REFERENCES workspace_builds(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceBuildsAiTaskSidebarAppID                   ForeignKeyConstraint = "workspace_builds_ai_task_sidebar_app_id_fkey"                    // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_ai_task_sidebar_app_id_fkey FOREIGN KEY (ai_task_sidebar_app_id) REFERENCES workspace_apps(id);
 	ForeignKeyWorkspaceBuildsJobID                                ForeignKeyConstraint = "workspace_builds_job_id_fkey"                                    // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceBuildsTemplateVersionID                    ForeignKeyConstraint = "workspace_builds_template_version_id_fkey"                       // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_template_version_id_fkey FOREIGN KEY (template_version_id) REFERENCES template_versions(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceBuildsTemplateVersionPresetID              ForeignKeyConstraint = "workspace_builds_template_version_preset_id_fkey"                // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_template_version_preset_id_fkey FOREIGN KEY (template_version_preset_id) REFERENCES template_version_presets(id) ON DELETE SET NULL;
 	ForeignKeyWorkspaceBuildsWorkspaceID                          ForeignKeyConstraint = "workspace_builds_workspace_id_fkey"                              // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceLifecycleDecisionsWorkspaceID              ForeignKeyConstraint = "workspace_lifecycle_decisions_workspace_id_fkey"                 // ALTER TABLE ONLY workspace_lifecycle_decisions ADD CONSTRAINT workspace_lifecycle_decisions_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceModulesJobID                               ForeignKeyConstraint = "workspace_modules_job_id_fkey"                                   // ALTER TABLE ONLY workspace_modules ADD CONSTRAINT workspace_modules_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceResourceMetadataWorkspaceResourceID        ForeignKeyConstraint = "workspace_resource_metadata_workspace_resource_id_fkey"          // ALTER TABLE ONLY workspace_resource_metadata ADD CONSTRAINT workspace_resource_metadata_workspace_resource_id_fkey FOREIGN KEY (workspace_resource_id) REFERENCES workspace_resources(id) ON DELETE CASCADE;
 	ForeignKeyWorkspaceResourcesJobID                             ForeignKeyConstraint = "workspace_resources_job_id_fkey"                                 // ALTER TABLE ONLY workspace_resources ADD CONSTRAINT workspace_resources_job_id_fkey FOREIGN KEY (job_id) REFERENCES provisioner_jobs(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceScheduleWindowsWorkspaceID                 ForeignKeyConstraint = "workspace_schedule_windows_workspace_id_fkey"                    // ALTER TABLE ONLY workspace_schedule_windows ADD CONSTRAINT workspace_schedule_windows_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceSessionRecordingChunksRecordingID          ForeignKeyConstraint = "workspace_session_recording_chunks_recording_id_fkey"            // ALTER TABLE ONLY workspace_session_recording_chunks ADD CONSTRAINT workspace_session_recording_chunks_recording_id_fkey FOREIGN KEY (recording_id) REFERENCES workspace_session_recordings(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceSessionRecordingsOrganizationID            ForeignKeyConstraint = "workspace_session_recordings_organization_id_fkey"               // ALTER TABLE ONLY workspace_session_recordings ADD CONSTRAINT workspace_session_recordings_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+	ForeignKeyWorkspaceSessionRecordingsWorkspaceID               ForeignKeyConstraint = "workspace_session_recordings_workspace_id_fkey"                  // ALTER TABLE ONLY workspace_session_recordings ADD CONSTRAINT workspace_session_recordings_workspace_id_fkey FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE;
 	ForeignKeyWorkspacesOrganizationID                            ForeignKeyConstraint = "workspaces_organization_id_fkey"                                 // ALTER TABLE ONLY workspaces ADD CONSTRAINT workspaces_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE RESTRICT;
 	ForeignKeyWorkspacesOwnerID                                   ForeignKeyConstraint = "workspaces_owner_id_fkey"                                        // ALTER TABLE ONLY workspaces ADD CONSTRAINT workspaces_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE RESTRICT;
 	ForeignKeyWorkspacesTemplateID                                ForeignKeyConstraint = "workspaces_template_id_fkey"                                     // ALTER TABLE ONLY workspaces ADD CONSTRAINT workspaces_template_id_fkey FOREIGN KEY (template_id) REFERENCES templates(id) ON DELETE RESTRICT;
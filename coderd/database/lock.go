@@ -13,6 +13,8 @@ const (
 	LockIDNotificationsReportGenerator
 	LockIDCryptoKeyRotation
 	LockIDReconcilePrebuilds
+	LockIDNotificationsDigestGenerator
+	LockIDAuditLogHashChain
 )
 
 // GenLockID generates a unique and consistent lock ID from a given string.
@@ -0,0 +1,5 @@
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS user_target_id;
+
+DROP TABLE IF EXISTS notification_user_targets;
+
+DROP TYPE IF EXISTS notification_user_target_type;
@@ -0,0 +1,31 @@
+-- As we can not remove a value from an enum, the down migration leaves it in place.
+ALTER TYPE notification_method ADD VALUE IF NOT EXISTS 'user_target';
+
+CREATE TYPE notification_user_target_type AS ENUM (
+    'webhook',
+    'slack'
+);
+
+COMMENT ON TYPE notification_user_target_type IS 'The payload format used when delivering to a user-defined notification target. slack is also accepted by Mattermost incoming webhooks.';
+
+CREATE TABLE notification_user_targets (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type notification_user_target_type NOT NULL,
+    endpoint TEXT NOT NULL,
+    secret TEXT NOT NULL DEFAULT '',
+    secret_key_id TEXT REFERENCES dbcrypt_keys (active_key_digest),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+COMMENT ON TABLE notification_user_targets IS 'Delivery targets registered by users to receive their own notifications.';
+COMMENT ON COLUMN notification_user_targets.secret IS 'Shared secret used to sign webhook deliveries with HMAC-SHA256. Empty if deliveries are not signed.';
+COMMENT ON COLUMN notification_user_targets.secret_key_id IS 'The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted';
+
+ALTER TABLE notification_preferences
+    ADD COLUMN user_target_id UUID REFERENCES notification_user_targets (id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN notification_preferences.user_target_id IS 'The user-defined target to deliver this notification to instead of the deployment-level method. NULL defers to the deployment-level method.';
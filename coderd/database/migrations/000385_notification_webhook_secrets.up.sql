@@ -0,0 +1,14 @@
+CREATE TABLE notification_webhook_secrets (
+    id UUID PRIMARY KEY,
+    secret TEXT NOT NULL,
+    secret_key_id TEXT REFERENCES dbcrypt_keys (active_key_digest),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE notification_webhook_secrets IS 'Shared secrets used to sign deployment-wide webhook notification deliveries.';
+COMMENT ON COLUMN notification_webhook_secrets.secret_key_id IS 'The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted';
+COMMENT ON COLUMN notification_webhook_secrets.expires_at IS 'NULL for the active secret. Once rotated out, a secret continues to sign deliveries until this time.';
+
+-- At most one secret can be active at any time.
+CREATE UNIQUE INDEX idx_notification_webhook_secrets_active ON notification_webhook_secrets ((expires_at IS NULL)) WHERE expires_at IS NULL;
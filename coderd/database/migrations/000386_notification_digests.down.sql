@@ -0,0 +1,5 @@
+DELETE FROM notification_templates WHERE id = 'b4f8a2d6-3c1e-4f7a-9d5b-8e2c6a4f1d93';
+
+DROP TABLE IF EXISTS notification_digest_messages;
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS digest;
@@ -0,0 +1,49 @@
+ALTER TABLE notification_preferences
+    ADD COLUMN digest BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN notification_preferences.digest IS 'Whether notifications from this template are held and delivered to the user as a single digest, rather than individually.';
+
+CREATE TABLE notification_digest_messages (
+    id UUID PRIMARY KEY,
+    notification_template_id UUID NOT NULL REFERENCES notification_templates (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    method notification_method NOT NULL,
+    payload JSONB NOT NULL,
+    targets UUID[],
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE notification_digest_messages IS 'Notification messages which are held until they are combined into a digest for their recipient.';
+
+CREATE INDEX idx_notification_digest_messages_group ON notification_digest_messages (user_id, notification_template_id, method, created_at);
+
+INSERT INTO notification_templates (
+	id,
+	name,
+	title_template,
+	body_template,
+	actions,
+	"group",
+	method,
+	kind,
+	enabled_by_default
+) VALUES (
+			 'b4f8a2d6-3c1e-4f7a-9d5b-8e2c6a4f1d93',
+			 'Notification Digest',
+			 E'{{.Data.notification_name}}: {{.Data.count}} notifications',
+			 E'You have received {{.Data.count}} "{{.Data.notification_name}}" notifications over the last {{.Data.window}}:
+{{range $message := .Data.messages}}
+**{{$message.title}}**
+
+{{$message.body}}
+{{range $action := $message.actions}}
+- [{{$action.label}}]({{$action.url}})
+{{end}}
+{{end}}',
+			 '[]'::jsonb,
+			 'Notification Events',
+			 NULL,
+			 'system'::notification_template_kind,
+			 true
+		 );
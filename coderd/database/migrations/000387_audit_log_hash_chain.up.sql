@@ -0,0 +1,12 @@
+CREATE TABLE audit_log_hash_chain (
+    seq BIGINT PRIMARY KEY,
+    audit_log_id UUID NOT NULL UNIQUE,
+    previous_hash BYTEA NOT NULL,
+    hash BYTEA NOT NULL
+);
+
+COMMENT ON TABLE audit_log_hash_chain IS 'A tamper-evident chain over audit logs. Each entry hashes its audit log together with the hash of the previous entry. Audit logs are not referenced by a foreign key, so that deleting one breaks the chain rather than the entry.';
+
+COMMENT ON COLUMN audit_log_hash_chain.seq IS 'The position of the entry in the chain, starting at 1 and without gaps.';
+
+COMMENT ON COLUMN audit_log_hash_chain.previous_hash IS 'The hash of the previous entry, or empty for the first entry.';
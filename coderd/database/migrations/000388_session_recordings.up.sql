@@ -0,0 +1,81 @@
+ALTER TABLE templates
+ADD COLUMN record_sessions boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN templates.record_sessions IS 'Whether workspace agents record the terminal output of SSH and reconnecting PTY sessions.';
+
+-- Recreate the template_with_names view to include record_sessions.
+DROP VIEW IF EXISTS template_with_names;
+CREATE VIEW template_with_names AS
+ SELECT templates.id,
+    templates.created_at,
+    templates.updated_at,
+    templates.organization_id,
+    templates.deleted,
+    templates.name,
+    templates.provisioner,
+    templates.active_version_id,
+    templates.description,
+    templates.default_ttl,
+    templates.created_by,
+    templates.icon,
+    templates.user_acl,
+    templates.group_acl,
+    templates.display_name,
+    templates.allow_user_cancel_workspace_jobs,
+    templates.allow_user_autostart,
+    templates.allow_user_autostop,
+    templates.failure_ttl,
+    templates.time_til_dormant,
+    templates.time_til_dormant_autodelete,
+    templates.autostop_requirement_days_of_week,
+    templates.autostop_requirement_weeks,
+    templates.autostart_block_days_of_week,
+    templates.require_active_version,
+    templates.deprecated,
+    templates.activity_bump,
+    templates.max_port_sharing_level,
+	templates.use_classic_parameter_flow,
+    templates.cors_behavior,
+    templates.record_sessions,
+    COALESCE(visible_users.avatar_url, ''::text) AS created_by_avatar_url,
+    COALESCE(visible_users.username, ''::text) AS created_by_username,
+	COALESCE(visible_users.name, ''::text) AS created_by_name,
+    COALESCE(organizations.name, ''::text) AS organization_name,
+    COALESCE(organizations.display_name, ''::text) AS organization_display_name,
+    COALESCE(organizations.icon, ''::text) AS organization_icon
+   FROM ((templates
+     LEFT JOIN visible_users ON ((templates.created_by = visible_users.id)))
+     LEFT JOIN organizations ON ((templates.organization_id = organizations.id)));
+
+COMMENT ON VIEW template_with_names IS 'Joins in the display name information such as username, avatar, and organization name.';
+
+CREATE TABLE workspace_session_recordings (
+    id uuid PRIMARY KEY,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    agent_name text NOT NULL,
+    connection_id uuid NOT NULL,
+    size_bytes bigint NOT NULL DEFAULT 0,
+    truncated boolean NOT NULL DEFAULT false,
+    completed boolean NOT NULL DEFAULT false,
+    CONSTRAINT workspace_session_recordings_connection_key UNIQUE (connection_id, workspace_id, agent_name)
+);
+
+COMMENT ON TABLE workspace_session_recordings IS 'Recordings of terminal sessions in the asciicast v2 format. A recording matches the connection log with the same connection ID, workspace and agent name.';
+
+COMMENT ON COLUMN workspace_session_recordings.truncated IS 'Whether the recording stopped early because it reached the maximum recording size.';
+
+COMMENT ON COLUMN workspace_session_recordings.completed IS 'Whether the agent has uploaded the last chunk of the recording.';
+
+CREATE INDEX workspace_session_recordings_created_at_idx ON workspace_session_recordings (created_at);
+
+CREATE TABLE workspace_session_recording_chunks (
+    recording_id uuid NOT NULL REFERENCES workspace_session_recordings (id) ON DELETE CASCADE,
+    seq integer NOT NULL,
+    data bytea NOT NULL,
+    PRIMARY KEY (recording_id, seq)
+);
+
+COMMENT ON TABLE workspace_session_recording_chunks IS 'Chunks of a session recording, in the order they were recorded. Concatenating the chunks by seq yields the asciicast file.';
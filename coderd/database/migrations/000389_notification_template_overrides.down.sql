@@ -0,0 +1,3 @@
+ALTER TABLE notification_templates
+    DROP COLUMN title_template_override,
+    DROP COLUMN body_template_override;
@@ -0,0 +1,7 @@
+ALTER TABLE notification_templates
+    ADD COLUMN title_template_override TEXT,
+    ADD COLUMN body_template_override TEXT;
+
+COMMENT ON COLUMN notification_templates.title_template_override IS 'Deployment-specific title template which is used instead of title_template. NULL falls back to the built-in template.';
+
+COMMENT ON COLUMN notification_templates.body_template_override IS 'Deployment-specific body template which is used instead of body_template. NULL falls back to the built-in template.';
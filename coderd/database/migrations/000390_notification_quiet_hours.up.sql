@@ -0,0 +1,15 @@
+ALTER TABLE notification_templates
+    ADD COLUMN urgent BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN notification_templates.urgent IS 'Urgent notifications are delivered immediately, even during the recipient''s quiet hours or while they have snoozed notifications.';
+
+-- One-time passcodes, tests and resource alerts are only useful if they are delivered straight away.
+UPDATE notification_templates
+SET urgent = TRUE
+WHERE id IN (
+    '62f86a30-2330-4b61-a26d-311ff3b608cf', -- One-Time Passcode
+    'c425f63e-716a-4bf4-ae24-78348f706c3f', -- Test Notification
+    '39b1e189-c857-4b0c-877a-511144c18516', -- Custom Notification
+    'a9d027b4-ac49-4fb1-9f6d-45af15f64e7a', -- Workspace Out Of Memory
+    'f047f6a3-5713-40f7-85aa-0394cce9fa3a'  -- Workspace Out Of Disk
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_aibridge_token_usages_created_at;
+
+DROP TABLE IF EXISTS aibridge_limits;
+
+DROP TYPE IF EXISTS aibridge_limit_scope;
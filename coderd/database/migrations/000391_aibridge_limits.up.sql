@@ -0,0 +1,29 @@
+CREATE TYPE aibridge_limit_scope AS ENUM (
+    'user',
+    'group',
+    'organization'
+);
+
+CREATE TABLE aibridge_limits (
+    scope aibridge_limit_scope NOT NULL,
+    scope_id uuid NOT NULL,
+    daily_token_budget bigint,
+    monthly_token_budget bigint,
+    requests_per_minute integer,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (scope, scope_id)
+);
+
+COMMENT ON TABLE aibridge_limits IS 'Token budgets and request rate limits enforced by AI Bridge';
+
+COMMENT ON COLUMN aibridge_limits.scope_id IS 'Relates to a users, groups or organizations record depending on the scope, so no FK is declared.';
+
+COMMENT ON COLUMN aibridge_limits.daily_token_budget IS 'The maximum number of input and output tokens which may be used per UTC day. NULL means unlimited.';
+
+COMMENT ON COLUMN aibridge_limits.monthly_token_budget IS 'The maximum number of input and output tokens which may be used per UTC calendar month. NULL means unlimited.';
+
+COMMENT ON COLUMN aibridge_limits.requests_per_minute IS 'The maximum number of requests which may be intercepted in any 60 second window. NULL means unlimited.';
+
+-- Usage is summed over a window of token usages, so index their creation time.
+CREATE INDEX idx_aibridge_token_usages_created_at ON aibridge_token_usages USING btree (created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS aibridge_model_prices;
+
+DROP TABLE IF EXISTS aibridge_usage_stats;
@@ -0,0 +1,40 @@
+CREATE TABLE aibridge_usage_stats (
+    start_time timestamp with time zone NOT NULL,
+    user_id uuid NOT NULL,
+    template_id uuid NOT NULL,
+    provider text NOT NULL,
+    model text NOT NULL,
+    requests bigint NOT NULL,
+    input_tokens bigint NOT NULL,
+    output_tokens bigint NOT NULL,
+    PRIMARY KEY (start_time, user_id, template_id, provider, model)
+);
+
+COMMENT ON TABLE aibridge_usage_stats IS 'Hourly rollup of requests and tokens intercepted by AI Bridge, used for usage and cost insights';
+
+COMMENT ON COLUMN aibridge_usage_stats.start_time IS 'Start of the hour the usage is aggregated over, inclusive.';
+
+COMMENT ON COLUMN aibridge_usage_stats.user_id IS 'Relates to a users record, but FK is elided as interceptions are kept for deleted users.';
+
+COMMENT ON COLUMN aibridge_usage_stats.template_id IS 'The template of the workspace the requests were made from, or the nil UUID if they were not made from a workspace.';
+
+COMMENT ON COLUMN aibridge_usage_stats.requests IS 'Number of interceptions started within the hour.';
+
+COMMENT ON COLUMN aibridge_usage_stats.input_tokens IS 'Sum of input tokens recorded within the hour.';
+
+COMMENT ON COLUMN aibridge_usage_stats.output_tokens IS 'Sum of output tokens recorded within the hour.';
+
+CREATE TABLE aibridge_model_prices (
+    provider text NOT NULL,
+    model text NOT NULL,
+    input_price_per_million_tokens double precision NOT NULL,
+    output_price_per_million_tokens double precision NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (provider, model)
+);
+
+COMMENT ON TABLE aibridge_model_prices IS 'Admin-configured prices used to estimate the cost of AI Bridge usage';
+
+COMMENT ON COLUMN aibridge_model_prices.input_price_per_million_tokens IS 'Price of one million input tokens, in the currency of the deployment''s choosing.';
+
+COMMENT ON COLUMN aibridge_model_prices.output_price_per_million_tokens IS 'Price of one million output tokens, in the currency of the deployment''s choosing.';
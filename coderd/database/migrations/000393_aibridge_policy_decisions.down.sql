@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS aibridge_policy_decisions;
+
+DROP TYPE IF EXISTS aibridge_policy_action;
@@ -0,0 +1,21 @@
+CREATE TYPE aibridge_policy_action AS ENUM (
+    'redact',
+    'block'
+);
+
+CREATE TABLE aibridge_policy_decisions (
+    id uuid PRIMARY KEY,
+    interception_id uuid NOT NULL,
+    policy text NOT NULL,
+    action aibridge_policy_action NOT NULL,
+    reason text NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_policy_decisions IS 'Audit log of content policy decisions which redacted or blocked intercepted requests in AI Bridge';
+
+COMMENT ON COLUMN aibridge_policy_decisions.policy IS 'The name of the policy which made the decision.';
+
+COMMENT ON COLUMN aibridge_policy_decisions.reason IS 'Why the policy acted on the request. Never contains the offending content itself.';
+
+CREATE INDEX idx_aibridge_policy_decisions_interception_id ON aibridge_policy_decisions (interception_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_steps;
+
+DROP TYPE IF EXISTS task_step_status;
@@ -0,0 +1,25 @@
+CREATE TYPE task_step_status AS ENUM (
+    'pending',
+    'running',
+    'checking',
+    'completed',
+    'failed'
+);
+
+CREATE TABLE task_steps (
+    workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    step_index integer NOT NULL,
+    prompt text NOT NULL,
+    completion_check text DEFAULT ''::text NOT NULL,
+    status task_step_status DEFAULT 'pending'::task_step_status NOT NULL,
+    check_exit_code integer,
+    started_at timestamp with time zone,
+    completed_at timestamp with time zone,
+    PRIMARY KEY (workspace_id, step_index)
+);
+
+COMMENT ON TABLE task_steps IS 'The ordered prompts of multi-step tasks. Tasks are currently identified by their workspace.';
+
+COMMENT ON COLUMN task_steps.completion_check IS 'A command which is run in the workspace once the agent reports the step idle. A non-zero exit code fails the task. Empty if the step has no check.';
+
+COMMENT ON COLUMN task_steps.check_exit_code IS 'The exit code of the completion check, if it has run.';
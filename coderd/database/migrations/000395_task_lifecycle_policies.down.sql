@@ -0,0 +1,4 @@
+-- Remove Task 'stopped' notification template
+DELETE FROM notification_templates WHERE id = '3a0e6837-1fab-4752-88e8-949b2033ba80';
+
+-- It's not possible to delete enum values.
@@ -0,0 +1,34 @@
+ALTER TYPE build_reason ADD VALUE IF NOT EXISTS 'task_autostop';
+ALTER TYPE build_reason ADD VALUE IF NOT EXISTS 'task_autodelete';
+
+-- Task stopped by a lifecycle policy
+INSERT INTO notification_templates (
+	id,
+	name,
+	title_template,
+	body_template,
+	actions,
+	"group",
+	method,
+	kind,
+	enabled_by_default
+) VALUES (
+			 '3a0e6837-1fab-4752-88e8-949b2033ba80',
+			 'Task Stopped',
+			 E'Task ''{{.Labels.workspace}}'' stopped',
+			 E'The task ''{{.Labels.task}}'' was stopped automatically because {{.Labels.reason}}.',
+			 '[
+				 {
+					 "label": "View task",
+					 "url": "{{base_url}}/tasks/{{.UserUsername}}/{{.Labels.workspace}}"
+				 },
+				 {
+					 "label": "View workspace",
+					 "url": "{{base_url}}/@{{.UserUsername}}/{{.Labels.workspace}}"
+				 }
+			 ]'::jsonb,
+			 'Task Events',
+			 NULL,
+			 'system'::notification_template_kind,
+			 true
+		 );
@@ -0,0 +1,3 @@
+ALTER TABLE oauth2_provider_app_codes DROP COLUMN scopes;
+
+-- It's not possible to delete enum values.
@@ -0,0 +1,7 @@
+ALTER TABLE oauth2_provider_app_codes
+	ADD COLUMN scopes api_key_scope[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN oauth2_provider_app_codes.scopes IS 'API key scopes granted to the access token the code is exchanged for. An empty list grants all scopes.';
+
+ALTER TYPE resource_type ADD VALUE IF NOT EXISTS 'mcp_tool';
+ALTER TYPE audit_action ADD VALUE IF NOT EXISTS 'invoke';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS aibridge_interception_captures;
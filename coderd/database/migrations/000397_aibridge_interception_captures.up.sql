@@ -0,0 +1,31 @@
+CREATE TABLE aibridge_interception_captures (
+    interception_id uuid PRIMARY KEY,
+    method text NOT NULL,
+    path text NOT NULL,
+    request_headers jsonb NOT NULL DEFAULT '{}'::jsonb,
+    request_body text NOT NULL,
+    request_body_key_id text REFERENCES dbcrypt_keys (active_key_digest),
+    request_body_truncated boolean NOT NULL DEFAULT false,
+    response_status integer NOT NULL,
+    response_headers jsonb NOT NULL DEFAULT '{}'::jsonb,
+    response_body text NOT NULL,
+    response_body_key_id text REFERENCES dbcrypt_keys (active_key_digest),
+    response_body_truncated boolean NOT NULL DEFAULT false,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE aibridge_interception_captures IS 'Full upstream requests and responses of AI Bridge interceptions, captured for users who opted in to help debug agent behaviour.';
+
+COMMENT ON COLUMN aibridge_interception_captures.interception_id IS 'Relates to an aibridge_interceptions record, but FK is elided for performance.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_headers IS 'Request headers, with credentials redacted.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_body IS 'Request body, with secrets redacted. Truncated to the configured maximum capture size.';
+
+COMMENT ON COLUMN aibridge_interception_captures.request_body_key_id IS 'The ID of the key used to encrypt the request body. If this is NULL, the request body is not encrypted';
+
+COMMENT ON COLUMN aibridge_interception_captures.response_body IS 'Response body, with secrets redacted. Truncated to the configured maximum capture size.';
+
+COMMENT ON COLUMN aibridge_interception_captures.response_body_key_id IS 'The ID of the key used to encrypt the response body. If this is NULL, the response body is not encrypted';
+
+CREATE INDEX idx_aibridge_interception_captures_created_at ON aibridge_interception_captures (created_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS workspace_autostart_exception_opt_outs;
+DROP TABLE IF EXISTS autostart_exception_calendars;
@@ -0,0 +1,21 @@
+CREATE TABLE autostart_exception_calendars (
+    id uuid PRIMARY KEY,
+    organization_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    exceptions jsonb NOT NULL DEFAULT '[]'::jsonb,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    UNIQUE (organization_id, name)
+);
+
+COMMENT ON TABLE autostart_exception_calendars IS 'Named calendars of dates on which workspaces in an organization must not be autostarted, such as public holidays or company shutdowns.';
+
+COMMENT ON COLUMN autostart_exception_calendars.exceptions IS 'JSON array of exceptions. Each exception has a name, a start_date and an inclusive end_date, formatted as YYYY-MM-DD.';
+
+CREATE TABLE workspace_autostart_exception_opt_outs (
+    workspace_id uuid PRIMARY KEY REFERENCES workspaces (id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE workspace_autostart_exception_opt_outs IS 'Workspaces that ignore their organization''s autostart exception calendars.';
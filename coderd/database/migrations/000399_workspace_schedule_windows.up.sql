@@ -0,0 +1,14 @@
+CREATE TABLE workspace_schedule_windows (
+    workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    autostart_schedule text NOT NULL,
+    ttl bigint,
+    created_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (workspace_id, position)
+);
+
+COMMENT ON TABLE workspace_schedule_windows IS 'Additional autostart windows of a workspace. The workspace''s own autostart_schedule and ttl form its first window.';
+
+COMMENT ON COLUMN workspace_schedule_windows.position IS 'Order of the window among the workspace''s additional windows. When windows start at the same time, the earlier window takes precedence.';
+
+COMMENT ON COLUMN workspace_schedule_windows.ttl IS 'Time in nanoseconds that workspaces started by this window stay running. NULL disables autostop.';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS workspace_lifecycle_decisions;
+
+DROP TYPE IF EXISTS workspace_lifecycle_outcome;
@@ -0,0 +1,29 @@
+CREATE TYPE workspace_lifecycle_outcome AS ENUM (
+    'transitioned',
+    'dry_run',
+    'skipped',
+    'failed'
+);
+
+CREATE TABLE workspace_lifecycle_decisions (
+    id uuid PRIMARY KEY,
+    workspace_id uuid NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL,
+    outcome workspace_lifecycle_outcome NOT NULL,
+    transition workspace_transition,
+    build_reason build_reason,
+    checks jsonb NOT NULL DEFAULT '[]'::jsonb,
+    detail text NOT NULL DEFAULT ''
+);
+
+COMMENT ON TABLE workspace_lifecycle_decisions IS 'Log of the decisions made by the autobuild lifecycle executor for each workspace it evaluated. Entries are only kept for a short time.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.transition IS 'The transition the executor decided on, if any. Dormancy may be decided without a transition.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.checks IS 'The eligibility checks evaluated, in order, with whether each passed and why.';
+
+COMMENT ON COLUMN workspace_lifecycle_decisions.detail IS 'Why the decision was skipped or failed, if it was.';
+
+CREATE INDEX idx_workspace_lifecycle_decisions_workspace_id_created_at ON workspace_lifecycle_decisions (workspace_id, created_at DESC);
+
+CREATE INDEX idx_workspace_lifecycle_decisions_created_at ON workspace_lifecycle_decisions (created_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS prebuild_claims;
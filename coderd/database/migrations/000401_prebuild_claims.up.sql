@@ -0,0 +1,12 @@
+CREATE TABLE prebuild_claims (
+    id uuid PRIMARY KEY,
+    preset_id uuid NOT NULL REFERENCES template_version_presets (id) ON DELETE CASCADE,
+    workspace_id uuid REFERENCES workspaces (id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE prebuild_claims IS 'Attempts to claim a prebuilt workspace when creating a workspace with a preset. Used to size prebuild pools from demand. Entries are only kept for a limited time.';
+
+COMMENT ON COLUMN prebuild_claims.workspace_id IS 'The prebuilt workspace that was claimed, or NULL if none was available.';
+
+CREATE INDEX idx_prebuild_claims_created_at ON prebuild_claims (created_at);
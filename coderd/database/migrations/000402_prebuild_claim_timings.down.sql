@@ -0,0 +1,10 @@
+COMMENT ON TABLE prebuild_claims IS 'Attempts to claim a prebuilt workspace when creating a workspace with a preset. Used to size prebuild pools from demand. Entries are only kept for a limited time.';
+
+COMMENT ON COLUMN prebuild_claims.workspace_id IS 'The prebuilt workspace that was claimed, or NULL if none was available.';
+
+UPDATE prebuild_claims SET workspace_id = NULL WHERE NOT hit;
+
+ALTER TABLE prebuild_claims
+    DROP COLUMN IF EXISTS claim_latency_ms,
+    DROP COLUMN IF EXISTS build_id,
+    DROP COLUMN IF EXISTS hit;
@@ -0,0 +1,21 @@
+ALTER TABLE prebuild_claims
+    ADD COLUMN hit boolean NOT NULL DEFAULT false,
+    ADD COLUMN build_id uuid REFERENCES workspace_builds (id) ON DELETE CASCADE,
+    ADD COLUMN claim_latency_ms integer NOT NULL DEFAULT 0;
+
+-- Claims which found no prebuilt workspace used to be recorded without a workspace.
+UPDATE prebuild_claims SET hit = workspace_id IS NOT NULL;
+
+ALTER TABLE prebuild_claims
+    ALTER COLUMN hit DROP DEFAULT,
+    ALTER COLUMN claim_latency_ms DROP DEFAULT;
+
+COMMENT ON TABLE prebuild_claims IS 'Attempts to claim a prebuilt workspace when creating a workspace with a preset. Used to size prebuild pools from demand and to report how often and how quickly workspaces are served from prebuilds. Entries are only kept for a limited time.';
+
+COMMENT ON COLUMN prebuild_claims.workspace_id IS 'The workspace that was created, which is the claimed prebuilt workspace on a hit.';
+
+COMMENT ON COLUMN prebuild_claims.hit IS 'Whether a prebuilt workspace was available and claimed.';
+
+COMMENT ON COLUMN prebuild_claims.build_id IS 'The build that started the workspace, used to measure the time until its agents were ready.';
+
+COMMENT ON COLUMN prebuild_claims.claim_latency_ms IS 'Time taken by the attempt to claim a prebuilt workspace, in milliseconds.';
@@ -0,0 +1,3 @@
+COMMENT ON COLUMN audit_log_hash_chain.seq IS 'The position of the entry in the chain, starting at 1 and without gaps.';
+
+DROP TABLE IF EXISTS audit_log_hash_chain_checkpoints;
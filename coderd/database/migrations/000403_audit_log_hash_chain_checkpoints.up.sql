@@ -0,0 +1,15 @@
+CREATE TABLE audit_log_hash_chain_checkpoints (
+    seq BIGINT PRIMARY KEY,
+    previous_hash BYTEA NOT NULL,
+    hash BYTEA NOT NULL
+);
+
+COMMENT ON TABLE audit_log_hash_chain_checkpoints IS 'The links of hash chain entries whose audit logs were purged by retention, so that the remaining chain can still be verified. Only the latest checkpoint before the first remaining entry is kept.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.seq IS 'The position of the purged entry in the chain.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.previous_hash IS 'The hash of the entry before the purged entry.';
+
+COMMENT ON COLUMN audit_log_hash_chain_checkpoints.hash IS 'The hash of the purged entry.';
+
+COMMENT ON COLUMN audit_log_hash_chain.seq IS 'The position of the entry in the chain, starting at 1. Gaps are left by purged entries and are covered by audit_log_hash_chain_checkpoints.';
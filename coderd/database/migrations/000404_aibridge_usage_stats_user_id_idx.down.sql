@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_aibridge_usage_stats_user_id_start_time;
@@ -0,0 +1,2 @@
+-- Limit checks sum the rollup of the users a limit applies to.
+CREATE INDEX idx_aibridge_usage_stats_user_id_start_time ON aibridge_usage_stats (user_id, start_time);
@@ -0,0 +1 @@
+ALTER TABLE task_steps DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,3 @@
+ALTER TABLE task_steps ADD COLUMN updated_at timestamp with time zone DEFAULT NOW() NOT NULL;
+
+COMMENT ON COLUMN task_steps.updated_at IS 'When the status of the step last changed. Used to recover steps left running or checking by a replica which stopped.';
@@ -0,0 +1,2 @@
+INSERT INTO notification_user_targets (id, user_id, name, type, endpoint, secret, created_at, updated_at)
+VALUES ('7d6cbd2c-4a43-4a8a-8d0b-2f4a27d5e4c1', (SELECT id FROM users LIMIT 1), 'personal', 'webhook', 'https://example.com/hooks/coder', 'hunter2', '2025-10-01 10:30:00+00', '2025-10-01 10:30:00+00');
@@ -0,0 +1,4 @@
+INSERT INTO notification_webhook_secrets (id, secret, created_at, expires_at)
+VALUES
+    ('0f2a5e7c-9d3b-4c1e-8a6f-3b7d9e1c5a20', 'rotated-secret', '2025-10-01 10:30:00+00', '2025-10-02 10:30:00+00'),
+    ('6c8e1b4d-2f7a-4e9c-b5d3-1a9f7c3e8b62', 'active-secret', '2025-10-02 10:30:00+00', NULL);
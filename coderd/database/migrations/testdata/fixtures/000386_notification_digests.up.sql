@@ -0,0 +1,2 @@
+INSERT INTO notification_digest_messages (id, notification_template_id, user_id, method, payload, targets, created_by, created_at)
+VALUES ('2f1c7b5e-8d4a-4e3b-9a6f-0c5d7e9b1a24', 'f517da0b-cdc9-410f-ab89-a86107c420ed', (SELECT id FROM users LIMIT 1), 'smtp', '{}'::jsonb, '{}', 'fixture', '2025-10-01 10:30:00+00');
@@ -0,0 +1,2 @@
+INSERT INTO audit_log_hash_chain (seq, audit_log_id, previous_hash, hash)
+VALUES (1, '8a4b2c6d-1e3f-4a5b-9c7d-2e4f6a8b0c1d', '\x'::bytea, '\x5c1f3e7a9b2d4c6e8f0a1b3c5d7e9f0a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e'::bytea);
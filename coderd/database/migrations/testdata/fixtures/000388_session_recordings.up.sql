@@ -0,0 +1,26 @@
+INSERT INTO workspace_session_recordings (
+	id,
+	created_at,
+	updated_at,
+	organization_id,
+	workspace_id,
+	agent_name,
+	connection_id,
+	size_bytes,
+	truncated,
+	completed
+) VALUES (
+	'5d3c8a2e-7b14-4f0a-9e6d-1c2b3a4d5e6f',
+	'2023-10-01 12:00:00+00',
+	'2023-10-01 12:00:10+00',
+	'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1',
+	'3a9a1feb-e89d-457c-9d53-ac751b198ebe',
+	'test-agent',
+	'00000000-0000-0000-0000-000000000003',
+	55,
+	false,
+	true
+);
+
+INSERT INTO workspace_session_recording_chunks (recording_id, seq, data)
+VALUES ('5d3c8a2e-7b14-4f0a-9e6d-1c2b3a4d5e6f', 0, convert_to(E'{"version":2,"width":80,"height":24}\n[0.1,"o","hello"]\n', 'UTF8'));
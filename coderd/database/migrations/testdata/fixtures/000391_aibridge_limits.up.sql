@@ -0,0 +1,4 @@
+INSERT INTO aibridge_limits (scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at)
+VALUES
+    ('user', '30095c71-380b-457a-8995-97b8ee6e5307', 100000, NULL, 60, '2025-10-01 00:00:00+00', '2025-10-01 00:00:00+00'),
+    ('organization', 'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1', NULL, 10000000, NULL, '2025-10-01 00:00:00+00', '2025-10-01 00:00:00+00');
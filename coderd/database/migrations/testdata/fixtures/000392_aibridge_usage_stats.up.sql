@@ -0,0 +1,9 @@
+INSERT INTO aibridge_usage_stats (start_time, user_id, template_id, provider, model, requests, input_tokens, output_tokens)
+VALUES
+    ('2025-10-01 10:00:00+00', '30095c71-380b-457a-8995-97b8ee6e5307', '4cc1f466-f326-477e-8762-9d0c6781fc56', 'anthropic', 'claude-sonnet-4', 12, 48000, 9600),
+    ('2025-10-01 10:00:00+00', '30095c71-380b-457a-8995-97b8ee6e5307', '00000000-0000-0000-0000-000000000000', 'openai', 'gpt-4.1', 3, 1500, 600);
+
+INSERT INTO aibridge_model_prices (provider, model, input_price_per_million_tokens, output_price_per_million_tokens, updated_at)
+VALUES
+    ('anthropic', 'claude-sonnet-4', 3, 15, '2025-10-01 00:00:00+00'),
+    ('openai', 'gpt-4.1', 2, 8, '2025-10-01 00:00:00+00');
@@ -0,0 +1,4 @@
+INSERT INTO aibridge_policy_decisions (id, interception_id, policy, action, reason, created_at)
+VALUES
+    ('8d3e0a4f-5b7c-4f0e-9b1a-2c6d8e4f1a3b', 'be003e1e-b38f-43bf-847d-928074dd0aa8', 'secret_detection', 'redact', 'Redacted 1 match of "aws_access_key_id".', '2025-10-01 10:00:00+00'),
+    ('1f2a3b4c-5d6e-4f70-8a9b-0c1d2e3f4a5b', 'be003e1e-b38f-43bf-847d-928074dd0aa8', 'max_prompt_size', 'block', 'Prompt of 204800 bytes exceeds the maximum of 102400 bytes.', '2025-10-01 10:00:01+00');
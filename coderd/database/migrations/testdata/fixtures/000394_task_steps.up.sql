@@ -0,0 +1,5 @@
+INSERT INTO task_steps (workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at)
+VALUES
+    ('3a9a1feb-e89d-457c-9d53-ac751b198ebe', 0, 'Upgrade the logging dependency.', '', 'completed', NULL, '2025-10-01 10:00:00+00', '2025-10-01 10:05:00+00'),
+    ('3a9a1feb-e89d-457c-9d53-ac751b198ebe', 1, 'Run the tests and fix any failures.', 'make test', 'running', NULL, '2025-10-01 10:05:01+00', NULL),
+    ('3a9a1feb-e89d-457c-9d53-ac751b198ebe', 2, 'Open a pull request.', '', 'pending', NULL, NULL, NULL);
@@ -0,0 +1,3 @@
+INSERT INTO aibridge_interception_captures (interception_id, method, path, request_headers, request_body, request_body_truncated, response_status, response_headers, response_body, response_body_truncated, created_at)
+VALUES
+    ('be003e1e-b38f-43bf-847d-928074dd0aa8', 'POST', '/openai/v1/chat/completions', '{"Content-Type": ["application/json"], "Authorization": ["<redacted>"]}', '{"model":"gpt-4o","messages":[{"role":"user","content":"Hello"}]}', false, 200, '{"Content-Type": ["application/json"]}', '{"id":"chatcmpl-1","choices":[{"message":{"role":"assistant","content":"Hi!"}}]}', false, '2025-10-01 10:00:02+00');
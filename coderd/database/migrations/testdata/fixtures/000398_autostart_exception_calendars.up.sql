@@ -0,0 +1,7 @@
+INSERT INTO autostart_exception_calendars (id, organization_id, name, description, exceptions, created_at, updated_at)
+VALUES
+    ('5d1a3f2e-8c4b-4e6a-9f7d-2b1c0e9a8d76', 'bb640d07-ca8a-4869-b6bc-ae61ebb2fda1', 'holidays', 'Public holidays', '[{"name": "Christmas", "start_date": "2025-12-25", "end_date": "2025-12-26"}]', '2025-10-01 10:00:00+00', '2025-10-01 10:00:00+00');
+
+INSERT INTO workspace_autostart_exception_opt_outs (workspace_id, created_at)
+VALUES
+    ('3a9a1feb-e89d-457c-9d53-ac751b198ebe', '2025-10-01 10:00:00+00');
@@ -0,0 +1,3 @@
+INSERT INTO workspace_schedule_windows (workspace_id, position, autostart_schedule, ttl, created_at)
+VALUES
+    ('3a9a1feb-e89d-457c-9d53-ac751b198ebe', 1, 'CRON_TZ=UTC 0 14 * * 4-5', 28800000000000, '2025-10-01 10:00:00+00');
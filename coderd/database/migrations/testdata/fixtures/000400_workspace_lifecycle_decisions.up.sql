@@ -0,0 +1,4 @@
+INSERT INTO workspace_lifecycle_decisions (id, workspace_id, created_at, outcome, transition, build_reason, checks, detail)
+VALUES
+    ('6b0f3c2e-9a4d-4e7b-8c1f-2d5e7a9b0c31', '3a9a1feb-e89d-457c-9d53-ac751b198ebe', '2025-10-01 10:00:00+00', 'transitioned', 'stop', 'autostop', '[{"check": "autostop", "passed": true, "detail": "deadline passed at 2025-10-01T10:00:00Z"}]', ''),
+    ('0e7d5a1b-3c8f-4a2e-9b6d-4f1c8e2a7d53', '3a9a1feb-e89d-457c-9d53-ac751b198ebe', '2025-10-01 10:01:00+00', 'skipped', NULL, NULL, '[{"check": "autostop", "passed": false, "detail": "workspace is not running"}]', 'last transition not valid for autostart or autostop');
@@ -0,0 +1,4 @@
+INSERT INTO prebuild_claims (id, preset_id, workspace_id, created_at)
+VALUES
+    ('4d6a2f1e-8b3c-4e9a-a7d5-1c2b3e4f5a61', '28b42cc0-c4fe-4907-a0fe-e4d20f1e9bfe', '3a9a1feb-e89d-457c-9d53-ac751b198ebe', '2025-10-01 10:00:00+00'),
+    ('9e1b7c3d-2a4f-4b8e-b6c1-5d7e9f0a2b84', '28b42cc0-c4fe-4907-a0fe-e4d20f1e9bfe', NULL, '2025-10-01 10:05:00+00');
@@ -0,0 +1,3 @@
+INSERT INTO prebuild_claims (id, preset_id, workspace_id, created_at, hit, build_id, claim_latency_ms)
+VALUES
+    ('c2f5e8a1-7d3b-4c9e-8a6f-0b1d2e3f4a75', '28b42cc0-c4fe-4907-a0fe-e4d20f1e9bfe', '3a9a1feb-e89d-457c-9d53-ac751b198ebe', '2025-10-02 10:00:00+00', true, 'a8c0b8c5-c9a8-4f33-93a4-8142e6858244', 12);
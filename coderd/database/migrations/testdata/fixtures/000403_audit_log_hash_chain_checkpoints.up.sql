@@ -0,0 +1,2 @@
+INSERT INTO audit_log_hash_chain_checkpoints (seq, previous_hash, hash)
+VALUES (2, '\x5c1f3e7a9b2d4c6e8f0a1b3c5d7e9f0a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e'::bytea, '\x7d2e4f6a8b0c1d3e5f7a9b1c3d5e7f9a0b2c4d6e8f0a1b3c5d7e9f1a2b4c6d8e'::bytea);
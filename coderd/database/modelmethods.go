@@ -104,6 +104,70 @@ func (g Group) Auditable(members []GroupMember) AuditableGroup {
 	}
 }
 
+// AuditableParameter is a named value that is diffed by name in audit logs.
+// Values of sensitive parameters are redacted.
+type AuditableParameter struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Sensitive bool   `json:"sensitive"`
+}
+
+type AuditableParameters []AuditableParameter
+
+type AuditableWorkspaceBuild struct {
+	WorkspaceBuild
+	Parameters AuditableParameters `json:"parameters"`
+}
+
+// Auditable returns an object that can be used in audit logs.
+// Covers both the build and the parameters it was created with. Ephemeral
+// parameters often carry one-off secrets, so their values are redacted, as are
+// those of parameters missing from templateParameters, in case they could not
+// be loaded.
+func (b WorkspaceBuild) Auditable(parameters []WorkspaceBuildParameter, templateParameters []TemplateVersionParameter) AuditableWorkspaceBuild {
+	ephemeral := make(map[string]bool, len(templateParameters))
+	for _, tp := range templateParameters {
+		ephemeral[tp.Name] = tp.Ephemeral
+	}
+	params := make(AuditableParameters, 0, len(parameters))
+	for _, p := range parameters {
+		isEphemeral, ok := ephemeral[p.Name]
+		params = append(params, AuditableParameter{
+			Name:      p.Name,
+			Value:     p.Value,
+			Sensitive: !ok || isEphemeral,
+		})
+	}
+
+	return AuditableWorkspaceBuild{
+		WorkspaceBuild: b,
+		Parameters:     params,
+	}
+}
+
+type AuditableTemplateVersion struct {
+	TemplateVersion
+	Variables AuditableParameters `json:"variables"`
+}
+
+// Auditable returns an object that can be used in audit logs.
+// Covers both the template version and its variables.
+func (v TemplateVersion) Auditable(variables []TemplateVersionVariable) AuditableTemplateVersion {
+	vars := make(AuditableParameters, 0, len(variables))
+	for _, variable := range variables {
+		vars = append(vars, AuditableParameter{
+			Name:      variable.Name,
+			Value:     variable.Value,
+			Sensitive: variable.Sensitive,
+		})
+	}
+
+	return AuditableTemplateVersion{
+		TemplateVersion: v,
+		Variables:       vars,
+	}
+}
+
 const EveryoneGroup = "Everyone"
 
 func (w GetAuditLogsOffsetRow) RBACObject() rbac.Object {
@@ -132,6 +196,12 @@ func (w ConnectionLog) RBACObject() rbac.Object {
 	return obj
 }
 
+// RBACObject returns the connection log object that the recording belongs to,
+// since recordings are only readable by those who can read connection logs.
+func (r WorkspaceSessionRecording) RBACObject() rbac.Object {
+	return rbac.ResourceConnectionLog.WithID(r.ID).InOrg(r.OrganizationID)
+}
+
 func (t Task) RBACObject() rbac.Object {
 	return rbac.ResourceTask.
 		WithID(t.ID).
@@ -323,6 +393,32 @@ func (i InboxNotification) RBACObject() rbac.Object {
 		WithOwner(i.UserID.String())
 }
 
+// RBACObject returns the owner's notification preferences, since user-defined
+// targets are only ever selected through those preferences.
+func (t NotificationUserTarget) RBACObject() rbac.Object {
+	return rbac.ResourceNotificationPreference.
+		WithID(t.ID).
+		WithOwner(t.UserID.String())
+}
+
+// EffectiveTitleTemplate returns the deployment's override of the title
+// template, falling back to the built-in template.
+func (t NotificationTemplate) EffectiveTitleTemplate() string {
+	if t.TitleTemplateOverride.Valid {
+		return t.TitleTemplateOverride.String
+	}
+	return t.TitleTemplate
+}
+
+// EffectiveBodyTemplate returns the deployment's override of the body
+// template, falling back to the built-in template.
+func (t NotificationTemplate) EffectiveBodyTemplate() string {
+	if t.BodyTemplateOverride.Valid {
+		return t.BodyTemplateOverride.String
+	}
+	return t.BodyTemplate
+}
+
 // RBACObjectNoTemplate is for orphaned template versions.
 func (v TemplateVersion) RBACObjectNoTemplate() rbac.Object {
 	return rbac.ResourceTemplate.InOrg(v.OrganizationID)
@@ -463,6 +559,14 @@ func (m GetOrganizationIDsByMemberIDsRow) RBACObject() rbac.Object {
 	return rbac.ResourceUserObject(m.UserID)
 }
 
+// RBACObject of an autostart exception calendar is its organization, as
+// calendars are part of the organization's settings.
+func (c AutostartExceptionCalendar) RBACObject() rbac.Object {
+	return rbac.ResourceOrganization.
+		WithID(c.OrganizationID).
+		InOrg(c.OrganizationID)
+}
+
 func (o Organization) RBACObject() rbac.Object {
 	return rbac.ResourceOrganization.
 		WithID(o.ID).
@@ -698,6 +802,35 @@ func (p ProvisionerJob) FinishedAt() time.Time {
 	return time.Time{}
 }
 
+// Running returns true if the task's workspace is running, or is being
+// started.
+func (r GetTaskWorkspacesForLifecyclePolicyRow) Running() bool {
+	if r.Transition != WorkspaceTransitionStart {
+		return false
+	}
+	switch r.JobStatus {
+	case ProvisionerJobStatusPending, ProvisionerJobStatusRunning, ProvisionerJobStatusSucceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// IdleSince returns when the task's agent last reported that it is idle or
+// complete, if it is still in that state and its workspace is running.
+func (r GetTaskWorkspacesForLifecyclePolicyRow) IdleSince() (time.Time, bool) {
+	if r.Transition != WorkspaceTransitionStart || r.JobStatus != ProvisionerJobStatusSucceeded ||
+		!r.AppState.Valid || !r.AppStateAt.Valid {
+		return time.Time{}, false
+	}
+	switch r.AppState.WorkspaceAppStatusState {
+	case WorkspaceAppStatusStateIdle, WorkspaceAppStatusStateComplete:
+		return r.AppStateAt.Time, true
+	default:
+		return time.Time{}, false
+	}
+}
+
 func (r CustomRole) RoleIdentifier() rbac.RoleIdentifier {
 	return rbac.RoleIdentifier{
 		Name:           r.Name,
@@ -16,6 +16,125 @@ import (
 	"github.com/sqlc-dev/pqtype"
 )
 
+type AIBridgeLimitScope string
+
+const (
+	AIBridgeLimitScopeUser         AIBridgeLimitScope = "user"
+	AIBridgeLimitScopeGroup        AIBridgeLimitScope = "group"
+	AIBridgeLimitScopeOrganization AIBridgeLimitScope = "organization"
+)
+
+func (e *AIBridgeLimitScope) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AIBridgeLimitScope(s)
+	case string:
+		*e = AIBridgeLimitScope(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AIBridgeLimitScope: %T", src)
+	}
+	return nil
+}
+
+type NullAIBridgeLimitScope struct {
+	AIBridgeLimitScope AIBridgeLimitScope `json:"aibridge_limit_scope"`
+	Valid              bool               `json:"valid"` // Valid is true if AIBridgeLimitScope is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAIBridgeLimitScope) Scan(value interface{}) error {
+	if value == nil {
+		ns.AIBridgeLimitScope, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AIBridgeLimitScope.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAIBridgeLimitScope) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AIBridgeLimitScope), nil
+}
+
+func (e AIBridgeLimitScope) Valid() bool {
+	switch e {
+	case AIBridgeLimitScopeUser,
+		AIBridgeLimitScopeGroup,
+		AIBridgeLimitScopeOrganization:
+		return true
+	}
+	return false
+}
+
+func AllAIBridgeLimitScopeValues() []AIBridgeLimitScope {
+	return []AIBridgeLimitScope{
+		AIBridgeLimitScopeUser,
+		AIBridgeLimitScopeGroup,
+		AIBridgeLimitScopeOrganization,
+	}
+}
+
+type AIBridgePolicyAction string
+
+const (
+	AIBridgePolicyActionRedact AIBridgePolicyAction = "redact"
+	AIBridgePolicyActionBlock  AIBridgePolicyAction = "block"
+)
+
+func (e *AIBridgePolicyAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AIBridgePolicyAction(s)
+	case string:
+		*e = AIBridgePolicyAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AIBridgePolicyAction: %T", src)
+	}
+	return nil
+}
+
+type NullAIBridgePolicyAction struct {
+	AIBridgePolicyAction AIBridgePolicyAction `json:"aibridge_policy_action"`
+	Valid                bool                 `json:"valid"` // Valid is true if AIBridgePolicyAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAIBridgePolicyAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.AIBridgePolicyAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AIBridgePolicyAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAIBridgePolicyAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AIBridgePolicyAction), nil
+}
+
+func (e AIBridgePolicyAction) Valid() bool {
+	switch e {
+	case AIBridgePolicyActionRedact,
+		AIBridgePolicyActionBlock:
+		return true
+	}
+	return false
+}
+
+func AllAIBridgePolicyActionValues() []AIBridgePolicyAction {
+	return []AIBridgePolicyAction{
+		AIBridgePolicyActionRedact,
+		AIBridgePolicyActionBlock,
+	}
+}
+
 type APIKeyScope string
 
 const (
@@ -783,6 +902,7 @@ const (
 	AuditActionDisconnect           AuditAction = "disconnect"
 	AuditActionOpen                 AuditAction = "open"
 	AuditActionClose                AuditAction = "close"
+	AuditActionInvoke               AuditAction = "invoke"
 )
 
 func (e *AuditAction) Scan(src interface{}) error {
@@ -834,7 +954,8 @@ func (e AuditAction) Valid() bool {
 		AuditActionConnect,
 		AuditActionDisconnect,
 		AuditActionOpen,
-		AuditActionClose:
+		AuditActionClose,
+		AuditActionInvoke:
 		return true
 	}
 	return false
@@ -855,6 +976,7 @@ func AllAuditActionValues() []AuditAction {
 		AuditActionDisconnect,
 		AuditActionOpen,
 		AuditActionClose,
+		AuditActionInvoke,
 	}
 }
 
@@ -930,6 +1052,8 @@ const (
 	BuildReasonSshConnection       BuildReason = "ssh_connection"
 	BuildReasonVscodeConnection    BuildReason = "vscode_connection"
 	BuildReasonJetbrainsConnection BuildReason = "jetbrains_connection"
+	BuildReasonTaskAutostop        BuildReason = "task_autostop"
+	BuildReasonTaskAutodelete      BuildReason = "task_autodelete"
 )
 
 func (e *BuildReason) Scan(src interface{}) error {
@@ -979,7 +1103,9 @@ func (e BuildReason) Valid() bool {
 		BuildReasonCli,
 		BuildReasonSshConnection,
 		BuildReasonVscodeConnection,
-		BuildReasonJetbrainsConnection:
+		BuildReasonJetbrainsConnection,
+		BuildReasonTaskAutostop,
+		BuildReasonTaskAutodelete:
 		return true
 	}
 	return false
@@ -998,6 +1124,8 @@ func AllBuildReasonValues() []BuildReason {
 		BuildReasonSshConnection,
 		BuildReasonVscodeConnection,
 		BuildReasonJetbrainsConnection,
+		BuildReasonTaskAutostop,
+		BuildReasonTaskAutodelete,
 	}
 }
 
@@ -1709,9 +1837,10 @@ func AllNotificationMessageStatusValues() []NotificationMessageStatus {
 type NotificationMethod string
 
 const (
-	NotificationMethodSmtp    NotificationMethod = "smtp"
-	NotificationMethodWebhook NotificationMethod = "webhook"
-	NotificationMethodInbox   NotificationMethod = "inbox"
+	NotificationMethodSmtp       NotificationMethod = "smtp"
+	NotificationMethodWebhook    NotificationMethod = "webhook"
+	NotificationMethodInbox      NotificationMethod = "inbox"
+	NotificationMethodUserTarget NotificationMethod = "user_target"
 )
 
 func (e *NotificationMethod) Scan(src interface{}) error {
@@ -1753,7 +1882,8 @@ func (e NotificationMethod) Valid() bool {
 	switch e {
 	case NotificationMethodSmtp,
 		NotificationMethodWebhook,
-		NotificationMethodInbox:
+		NotificationMethodInbox,
+		NotificationMethodUserTarget:
 		return true
 	}
 	return false
@@ -1764,6 +1894,7 @@ func AllNotificationMethodValues() []NotificationMethod {
 		NotificationMethodSmtp,
 		NotificationMethodWebhook,
 		NotificationMethodInbox,
+		NotificationMethodUserTarget,
 	}
 }
 
@@ -1825,6 +1956,65 @@ func AllNotificationTemplateKindValues() []NotificationTemplateKind {
 	}
 }
 
+// The payload format used when delivering to a user-defined notification target. slack is also accepted by Mattermost incoming webhooks.
+type NotificationUserTargetType string
+
+const (
+	NotificationUserTargetTypeWebhook NotificationUserTargetType = "webhook"
+	NotificationUserTargetTypeSlack   NotificationUserTargetType = "slack"
+)
+
+func (e *NotificationUserTargetType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationUserTargetType(s)
+	case string:
+		*e = NotificationUserTargetType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationUserTargetType: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationUserTargetType struct {
+	NotificationUserTargetType NotificationUserTargetType `json:"notification_user_target_type"`
+	Valid                      bool                       `json:"valid"` // Valid is true if NotificationUserTargetType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationUserTargetType) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationUserTargetType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationUserTargetType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationUserTargetType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationUserTargetType), nil
+}
+
+func (e NotificationUserTargetType) Valid() bool {
+	switch e {
+	case NotificationUserTargetTypeWebhook,
+		NotificationUserTargetTypeSlack:
+		return true
+	}
+	return false
+}
+
+func AllNotificationUserTargetTypeValues() []NotificationUserTargetType {
+	return []NotificationUserTargetType{
+		NotificationUserTargetTypeWebhook,
+		NotificationUserTargetTypeSlack,
+	}
+}
+
 type ParameterDestinationScheme string
 
 const (
@@ -2671,6 +2861,7 @@ const (
 	ResourceTypeWorkspaceApp                ResourceType = "workspace_app"
 	ResourceTypePrebuildsSettings           ResourceType = "prebuilds_settings"
 	ResourceTypeTask                        ResourceType = "task"
+	ResourceTypeMcpTool                     ResourceType = "mcp_tool"
 )
 
 func (e *ResourceType) Scan(src interface{}) error {
@@ -2735,7 +2926,8 @@ func (e ResourceType) Valid() bool {
 		ResourceTypeWorkspaceAgent,
 		ResourceTypeWorkspaceApp,
 		ResourceTypePrebuildsSettings,
-		ResourceTypeTask:
+		ResourceTypeTask,
+		ResourceTypeMcpTool:
 		return true
 	}
 	return false
@@ -2769,6 +2961,7 @@ func AllResourceTypeValues() []ResourceType {
 		ResourceTypeWorkspaceApp,
 		ResourceTypePrebuildsSettings,
 		ResourceTypeTask,
+		ResourceTypeMcpTool,
 	}
 }
 
@@ -2958,6 +3151,73 @@ func AllTaskStatusValues() []TaskStatus {
 	}
 }
 
+type TaskStepStatus string
+
+const (
+	TaskStepStatusPending   TaskStepStatus = "pending"
+	TaskStepStatusRunning   TaskStepStatus = "running"
+	TaskStepStatusChecking  TaskStepStatus = "checking"
+	TaskStepStatusCompleted TaskStepStatus = "completed"
+	TaskStepStatusFailed    TaskStepStatus = "failed"
+)
+
+func (e *TaskStepStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskStepStatus(s)
+	case string:
+		*e = TaskStepStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskStepStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTaskStepStatus struct {
+	TaskStepStatus TaskStepStatus `json:"task_step_status"`
+	Valid          bool           `json:"valid"` // Valid is true if TaskStepStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskStepStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskStepStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskStepStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskStepStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskStepStatus), nil
+}
+
+func (e TaskStepStatus) Valid() bool {
+	switch e {
+	case TaskStepStatusPending,
+		TaskStepStatusRunning,
+		TaskStepStatusChecking,
+		TaskStepStatusCompleted,
+		TaskStepStatusFailed:
+		return true
+	}
+	return false
+}
+
+func AllTaskStepStatusValues() []TaskStepStatus {
+	return []TaskStepStatus{
+		TaskStepStatusPending,
+		TaskStepStatusRunning,
+		TaskStepStatusChecking,
+		TaskStepStatusCompleted,
+		TaskStepStatusFailed,
+	}
+}
+
 // Defines the users status: active, dormant, or suspended.
 type UserStatus string
 
@@ -3537,6 +3797,70 @@ func AllWorkspaceAppStatusStateValues() []WorkspaceAppStatusState {
 	}
 }
 
+type WorkspaceLifecycleOutcome string
+
+const (
+	WorkspaceLifecycleOutcomeTransitioned WorkspaceLifecycleOutcome = "transitioned"
+	WorkspaceLifecycleOutcomeDryRun       WorkspaceLifecycleOutcome = "dry_run"
+	WorkspaceLifecycleOutcomeSkipped      WorkspaceLifecycleOutcome = "skipped"
+	WorkspaceLifecycleOutcomeFailed       WorkspaceLifecycleOutcome = "failed"
+)
+
+func (e *WorkspaceLifecycleOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkspaceLifecycleOutcome(s)
+	case string:
+		*e = WorkspaceLifecycleOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkspaceLifecycleOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullWorkspaceLifecycleOutcome struct {
+	WorkspaceLifecycleOutcome WorkspaceLifecycleOutcome `json:"workspace_lifecycle_outcome"`
+	Valid                     bool                      `json:"valid"` // Valid is true if WorkspaceLifecycleOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkspaceLifecycleOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkspaceLifecycleOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkspaceLifecycleOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkspaceLifecycleOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkspaceLifecycleOutcome), nil
+}
+
+func (e WorkspaceLifecycleOutcome) Valid() bool {
+	switch e {
+	case WorkspaceLifecycleOutcomeTransitioned,
+		WorkspaceLifecycleOutcomeDryRun,
+		WorkspaceLifecycleOutcomeSkipped,
+		WorkspaceLifecycleOutcomeFailed:
+		return true
+	}
+	return false
+}
+
+func AllWorkspaceLifecycleOutcomeValues() []WorkspaceLifecycleOutcome {
+	return []WorkspaceLifecycleOutcome{
+		WorkspaceLifecycleOutcomeTransitioned,
+		WorkspaceLifecycleOutcomeDryRun,
+		WorkspaceLifecycleOutcomeSkipped,
+		WorkspaceLifecycleOutcomeFailed,
+	}
+}
+
 type WorkspaceTransition string
 
 const (
@@ -3609,6 +3933,67 @@ type AIBridgeInterception struct {
 	Metadata    pqtype.NullRawMessage `db:"metadata" json:"metadata"`
 }
 
+// Full upstream requests and responses of AI Bridge interceptions, captured for users who opted in to help debug agent behaviour.
+type AIBridgeInterceptionCapture struct {
+	// Relates to an aibridge_interceptions record, but FK is elided for performance.
+	InterceptionID uuid.UUID `db:"interception_id" json:"interception_id"`
+	Method         string    `db:"method" json:"method"`
+	Path           string    `db:"path" json:"path"`
+	// Request headers, with credentials redacted.
+	RequestHeaders json.RawMessage `db:"request_headers" json:"request_headers"`
+	// Request body, with secrets redacted. Truncated to the configured maximum capture size.
+	RequestBody string `db:"request_body" json:"request_body"`
+	// The ID of the key used to encrypt the request body. If this is NULL, the request body is not encrypted
+	RequestBodyKeyID     sql.NullString  `db:"request_body_key_id" json:"request_body_key_id"`
+	RequestBodyTruncated bool            `db:"request_body_truncated" json:"request_body_truncated"`
+	ResponseStatus       int32           `db:"response_status" json:"response_status"`
+	ResponseHeaders      json.RawMessage `db:"response_headers" json:"response_headers"`
+	// Response body, with secrets redacted. Truncated to the configured maximum capture size.
+	ResponseBody string `db:"response_body" json:"response_body"`
+	// The ID of the key used to encrypt the response body. If this is NULL, the response body is not encrypted
+	ResponseBodyKeyID     sql.NullString `db:"response_body_key_id" json:"response_body_key_id"`
+	ResponseBodyTruncated bool           `db:"response_body_truncated" json:"response_body_truncated"`
+	CreatedAt             time.Time      `db:"created_at" json:"created_at"`
+}
+
+// Token budgets and request rate limits enforced by AI Bridge
+type AIBridgeLimit struct {
+	Scope AIBridgeLimitScope `db:"scope" json:"scope"`
+	// Relates to a users, groups or organizations record depending on the scope, so no FK is declared.
+	ScopeID uuid.UUID `db:"scope_id" json:"scope_id"`
+	// The maximum number of input and output tokens which may be used per UTC day. NULL means unlimited.
+	DailyTokenBudget sql.NullInt64 `db:"daily_token_budget" json:"daily_token_budget"`
+	// The maximum number of input and output tokens which may be used per UTC calendar month. NULL means unlimited.
+	MonthlyTokenBudget sql.NullInt64 `db:"monthly_token_budget" json:"monthly_token_budget"`
+	// The maximum number of requests which may be intercepted in any 60 second window. NULL means unlimited.
+	RequestsPerMinute sql.NullInt32 `db:"requests_per_minute" json:"requests_per_minute"`
+	CreatedAt         time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time     `db:"updated_at" json:"updated_at"`
+}
+
+// Admin-configured prices used to estimate the cost of AI Bridge usage
+type AIBridgeModelPrice struct {
+	Provider string `db:"provider" json:"provider"`
+	Model    string `db:"model" json:"model"`
+	// Price of one million input tokens, in the currency of the deployment's choosing.
+	InputPricePerMillionTokens float64 `db:"input_price_per_million_tokens" json:"input_price_per_million_tokens"`
+	// Price of one million output tokens, in the currency of the deployment's choosing.
+	OutputPricePerMillionTokens float64   `db:"output_price_per_million_tokens" json:"output_price_per_million_tokens"`
+	UpdatedAt                   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// Audit log of content policy decisions which redacted or blocked intercepted requests in AI Bridge
+type AIBridgePolicyDecision struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	InterceptionID uuid.UUID `db:"interception_id" json:"interception_id"`
+	// The name of the policy which made the decision.
+	Policy string               `db:"policy" json:"policy"`
+	Action AIBridgePolicyAction `db:"action" json:"action"`
+	// Why the policy acted on the request. Never contains the offending content itself.
+	Reason    string    `db:"reason" json:"reason"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
 // Audit log of tokens used by intercepted requests in AI Bridge
 type AIBridgeTokenUsage struct {
 	ID             uuid.UUID `db:"id" json:"id"`
@@ -3639,6 +4024,24 @@ type AIBridgeToolUsage struct {
 	CreatedAt       time.Time             `db:"created_at" json:"created_at"`
 }
 
+// Hourly rollup of requests and tokens intercepted by AI Bridge, used for usage and cost insights
+type AIBridgeUsageStat struct {
+	// Start of the hour the usage is aggregated over, inclusive.
+	StartTime time.Time `db:"start_time" json:"start_time"`
+	// Relates to a users record, but FK is elided as interceptions are kept for deleted users.
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+	// The template of the workspace the requests were made from, or the nil UUID if they were not made from a workspace.
+	TemplateID uuid.UUID `db:"template_id" json:"template_id"`
+	Provider   string    `db:"provider" json:"provider"`
+	Model      string    `db:"model" json:"model"`
+	// Number of interceptions started within the hour.
+	Requests int64 `db:"requests" json:"requests"`
+	// Sum of input tokens recorded within the hour.
+	InputTokens int64 `db:"input_tokens" json:"input_tokens"`
+	// Sum of output tokens recorded within the hour.
+	OutputTokens int64 `db:"output_tokens" json:"output_tokens"`
+}
+
 // Audit log of prompts used by intercepted requests in AI Bridge
 type AIBridgeUserPrompt struct {
 	ID             uuid.UUID `db:"id" json:"id"`
@@ -3667,6 +4070,26 @@ type APIKey struct {
 	AllowList       AllowList    `db:"allow_list" json:"allow_list"`
 }
 
+// A tamper-evident chain over audit logs. Each entry hashes its audit log together with the hash of the previous entry. Audit logs are not referenced by a foreign key, so that deleting one breaks the chain rather than the entry.
+type AuditLogHashChain struct {
+	// The position of the entry in the chain, starting at 1. Gaps are left by purged entries and are covered by audit_log_hash_chain_checkpoints.
+	Seq        int64     `db:"seq" json:"seq"`
+	AuditLogID uuid.UUID `db:"audit_log_id" json:"audit_log_id"`
+	// The hash of the previous entry, or empty for the first entry.
+	PreviousHash []byte `db:"previous_hash" json:"previous_hash"`
+	Hash         []byte `db:"hash" json:"hash"`
+}
+
+// The links of hash chain entries whose audit logs were purged by retention, so that the remaining chain can still be verified. Only the latest checkpoint before the first remaining entry is kept.
+type AuditLogHashChainCheckpoint struct {
+	// The position of the purged entry in the chain.
+	Seq int64 `db:"seq" json:"seq"`
+	// The hash of the entry before the purged entry.
+	PreviousHash []byte `db:"previous_hash" json:"previous_hash"`
+	// The hash of the purged entry.
+	Hash []byte `db:"hash" json:"hash"`
+}
+
 type AuditLog struct {
 	ID               uuid.UUID       `db:"id" json:"id"`
 	Time             time.Time       `db:"time" json:"time"`
@@ -3685,6 +4108,18 @@ type AuditLog struct {
 	ResourceIcon     string          `db:"resource_icon" json:"resource_icon"`
 }
 
+// Named calendars of dates on which workspaces in an organization must not be autostarted, such as public holidays or company shutdowns.
+type AutostartExceptionCalendar struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+	Description    string    `db:"description" json:"description"`
+	// JSON array of exceptions. Each exception has a name, a start_date and an inclusive end_date, formatted as YYYY-MM-DD.
+	Exceptions json.RawMessage `db:"exceptions" json:"exceptions"`
+	CreatedAt  time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at" json:"updated_at"`
+}
+
 type ConnectionLog struct {
 	ID               uuid.UUID      `db:"id" json:"id"`
 	ConnectTime      time.Time      `db:"connect_time" json:"connect_time"`
@@ -3856,6 +4291,18 @@ type License struct {
 	UUID uuid.UUID `db:"uuid" json:"uuid"`
 }
 
+// Notification messages which are held until they are combined into a digest for their recipient.
+type NotificationDigestMessage struct {
+	ID                     uuid.UUID          `db:"id" json:"id"`
+	NotificationTemplateID uuid.UUID          `db:"notification_template_id" json:"notification_template_id"`
+	UserID                 uuid.UUID          `db:"user_id" json:"user_id"`
+	Method                 NotificationMethod `db:"method" json:"method"`
+	Payload                []byte             `db:"payload" json:"payload"`
+	Targets                []uuid.UUID        `db:"targets" json:"targets"`
+	CreatedBy              string             `db:"created_by" json:"created_by"`
+	CreatedAt              time.Time          `db:"created_at" json:"created_at"`
+}
+
 type NotificationMessage struct {
 	ID                     uuid.UUID                 `db:"id" json:"id"`
 	NotificationTemplateID uuid.UUID                 `db:"notification_template_id" json:"notification_template_id"`
@@ -3882,6 +4329,10 @@ type NotificationPreference struct {
 	Disabled               bool      `db:"disabled" json:"disabled"`
 	CreatedAt              time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt              time.Time `db:"updated_at" json:"updated_at"`
+	// The user-defined target to deliver this notification to instead of the deployment-level method. NULL defers to the deployment-level method.
+	UserTargetID uuid.NullUUID `db:"user_target_id" json:"user_target_id"`
+	// Whether notifications from this template are held and delivered to the user as a single digest, rather than individually.
+	Digest bool `db:"digest" json:"digest"`
 }
 
 // Log of generated reports for users.
@@ -3902,6 +4353,38 @@ type NotificationTemplate struct {
 	Method           NullNotificationMethod   `db:"method" json:"method"`
 	Kind             NotificationTemplateKind `db:"kind" json:"kind"`
 	EnabledByDefault bool                     `db:"enabled_by_default" json:"enabled_by_default"`
+	// Deployment-specific title template which is used instead of title_template. NULL falls back to the built-in template.
+	TitleTemplateOverride sql.NullString `db:"title_template_override" json:"title_template_override"`
+	// Deployment-specific body template which is used instead of body_template. NULL falls back to the built-in template.
+	BodyTemplateOverride sql.NullString `db:"body_template_override" json:"body_template_override"`
+	// Urgent notifications are delivered immediately, even during the recipient's quiet hours or while they have snoozed notifications.
+	Urgent bool `db:"urgent" json:"urgent"`
+}
+
+// Delivery targets registered by users to receive their own notifications.
+type NotificationUserTarget struct {
+	ID       uuid.UUID                  `db:"id" json:"id"`
+	UserID   uuid.UUID                  `db:"user_id" json:"user_id"`
+	Name     string                     `db:"name" json:"name"`
+	Type     NotificationUserTargetType `db:"type" json:"type"`
+	Endpoint string                     `db:"endpoint" json:"endpoint"`
+	// Shared secret used to sign webhook deliveries with HMAC-SHA256. Empty if deliveries are not signed.
+	Secret string `db:"secret" json:"secret"`
+	// The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted
+	SecretKeyID sql.NullString `db:"secret_key_id" json:"secret_key_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+// Shared secrets used to sign deployment-wide webhook notification deliveries.
+type NotificationWebhookSecret struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	Secret string    `db:"secret" json:"secret"`
+	// The ID of the key used to encrypt the secret. If this is NULL, the secret is not encrypted
+	SecretKeyID sql.NullString `db:"secret_key_id" json:"secret_key_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	// NULL for the active secret. Once rotated out, a secret continues to sign deliveries until this time.
+	ExpiresAt sql.NullTime `db:"expires_at" json:"expires_at"`
 }
 
 // A table used to configure apps that can use Coder as an OAuth2 provider, the reverse of what we are calling external authentication.
@@ -3969,6 +4452,8 @@ type OAuth2ProviderAppCode struct {
 	CodeChallenge sql.NullString `db:"code_challenge" json:"code_challenge"`
 	// PKCE challenge method (S256)
 	CodeChallengeMethod sql.NullString `db:"code_challenge_method" json:"code_challenge_method"`
+	// API key scopes granted to the access token the code is exchanged for. An empty list grants all scopes.
+	Scopes APIKeyScopes `db:"scopes" json:"scopes"`
 }
 
 type OAuth2ProviderAppSecret struct {
@@ -4049,6 +4534,21 @@ type ParameterValue struct {
 	DestinationScheme ParameterDestinationScheme `db:"destination_scheme" json:"destination_scheme"`
 }
 
+// Attempts to claim a prebuilt workspace when creating a workspace with a preset. Used to size prebuild pools from demand. Entries are only kept for a limited time.
+type PrebuildClaim struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	PresetID uuid.UUID `db:"preset_id" json:"preset_id"`
+	// The workspace that was created, which is the claimed prebuilt workspace on a hit.
+	WorkspaceID uuid.NullUUID `db:"workspace_id" json:"workspace_id"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	// Whether a prebuilt workspace was available and claimed.
+	Hit bool `db:"hit" json:"hit"`
+	// The build that started the workspace, used to measure the time until its agents were ready.
+	BuildID uuid.NullUUID `db:"build_id" json:"build_id"`
+	// Time taken by the attempt to claim a prebuilt workspace, in milliseconds.
+	ClaimLatencyMs int32 `db:"claim_latency_ms" json:"claim_latency_ms"`
+}
+
 type ProvisionerDaemon struct {
 	ID           uuid.UUID         `db:"id" json:"id"`
 	CreatedAt    time.Time         `db:"created_at" json:"created_at"`
@@ -4216,6 +4716,22 @@ type Task struct {
 	WorkspaceAppID       uuid.NullUUID   `db:"workspace_app_id" json:"workspace_app_id"`
 }
 
+// The ordered prompts of multi-step tasks. Tasks are currently identified by their workspace.
+type TaskStep struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	StepIndex   int32     `db:"step_index" json:"step_index"`
+	Prompt      string    `db:"prompt" json:"prompt"`
+	// A command which is run in the workspace once the agent reports the step idle. A non-zero exit code fails the task. Empty if the step has no check.
+	CompletionCheck string         `db:"completion_check" json:"completion_check"`
+	Status          TaskStepStatus `db:"status" json:"status"`
+	// The exit code of the completion check, if it has run.
+	CheckExitCode sql.NullInt32 `db:"check_exit_code" json:"check_exit_code"`
+	StartedAt     sql.NullTime  `db:"started_at" json:"started_at"`
+	CompletedAt   sql.NullTime  `db:"completed_at" json:"completed_at"`
+	// When the status of the step last changed. Used to recover steps left running or checking by a replica which stopped.
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type TaskTable struct {
 	ID                 uuid.UUID       `db:"id" json:"id"`
 	OrganizationID     uuid.UUID       `db:"organization_id" json:"organization_id"`
@@ -4275,6 +4791,7 @@ type Template struct {
 	MaxPortSharingLevel           AppSharingLevel `db:"max_port_sharing_level" json:"max_port_sharing_level"`
 	UseClassicParameterFlow       bool            `db:"use_classic_parameter_flow" json:"use_classic_parameter_flow"`
 	CorsBehavior                  CorsBehavior    `db:"cors_behavior" json:"cors_behavior"`
+	RecordSessions                bool            `db:"record_sessions" json:"record_sessions"`
 	CreatedByAvatarURL            string          `db:"created_by_avatar_url" json:"created_by_avatar_url"`
 	CreatedByUsername             string          `db:"created_by_username" json:"created_by_username"`
 	CreatedByName                 string          `db:"created_by_name" json:"created_by_name"`
@@ -4324,6 +4841,8 @@ type TemplateTable struct {
 	// Determines whether to default to the dynamic parameter creation flow for this template or continue using the legacy classic parameter creation flow.This is a template wide setting, the template admin can revert to the classic flow if there are any issues. An escape hatch is required, as workspace creation is a core workflow and cannot break. This column will be removed when the dynamic parameter creation flow is stable.
 	UseClassicParameterFlow bool         `db:"use_classic_parameter_flow" json:"use_classic_parameter_flow"`
 	CorsBehavior            CorsBehavior `db:"cors_behavior" json:"cors_behavior"`
+	// Whether workspace agents record the terminal output of SSH and reconnecting PTY sessions.
+	RecordSessions bool `db:"record_sessions" json:"record_sessions"`
 }
 
 // Records aggregated usage statistics for templates/users. All usage is rounded up to the nearest minute.
@@ -4902,6 +5421,12 @@ type WorkspaceAppStatus struct {
 	Uri         sql.NullString          `db:"uri" json:"uri"`
 }
 
+// Workspaces that ignore their organization's autostart exception calendars.
+type WorkspaceAutostartExceptionOptOut struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
 // Joins in the username + avatar url of the initiated by user.
 type WorkspaceBuild struct {
 	ID                      uuid.UUID           `db:"id" json:"id"`
@@ -4967,6 +5492,21 @@ type WorkspaceLatestBuild struct {
 	JobStatus               ProvisionerJobStatus `db:"job_status" json:"job_status"`
 }
 
+// Log of the decisions made by the autobuild lifecycle executor for each workspace it evaluated. Entries are only kept for a short time.
+type WorkspaceLifecycleDecision struct {
+	ID          uuid.UUID                 `db:"id" json:"id"`
+	WorkspaceID uuid.UUID                 `db:"workspace_id" json:"workspace_id"`
+	CreatedAt   time.Time                 `db:"created_at" json:"created_at"`
+	Outcome     WorkspaceLifecycleOutcome `db:"outcome" json:"outcome"`
+	// The transition the executor decided on, if any. Dormancy may be decided without a transition.
+	Transition  NullWorkspaceTransition `db:"transition" json:"transition"`
+	BuildReason NullBuildReason         `db:"build_reason" json:"build_reason"`
+	// The eligibility checks evaluated, in order, with whether each passed and why.
+	Checks json.RawMessage `db:"checks" json:"checks"`
+	// Why the decision was skipped or failed, if it was.
+	Detail string `db:"detail" json:"detail"`
+}
+
 type WorkspaceModule struct {
 	ID         uuid.UUID           `db:"id" json:"id"`
 	JobID      uuid.UUID           `db:"job_id" json:"job_id"`
@@ -5041,6 +5581,40 @@ type WorkspaceResourceMetadatum struct {
 	ID                  int64          `db:"id" json:"id"`
 }
 
+// Additional autostart windows of a workspace. The workspace's own autostart_schedule and ttl form its first window.
+type WorkspaceScheduleWindow struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	// Order of the window among the workspace's additional windows. When windows start at the same time, the earlier window takes precedence.
+	Position          int32  `db:"position" json:"position"`
+	AutostartSchedule string `db:"autostart_schedule" json:"autostart_schedule"`
+	// Time in nanoseconds that workspaces started by this window stay running. NULL disables autostop.
+	Ttl       sql.NullInt64 `db:"ttl" json:"ttl"`
+	CreatedAt time.Time     `db:"created_at" json:"created_at"`
+}
+
+// Recordings of terminal sessions in the asciicast v2 format. A recording matches the connection log with the same connection ID, workspace and agent name.
+type WorkspaceSessionRecording struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	WorkspaceID    uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	AgentName      string    `db:"agent_name" json:"agent_name"`
+	ConnectionID   uuid.UUID `db:"connection_id" json:"connection_id"`
+	SizeBytes      int64     `db:"size_bytes" json:"size_bytes"`
+	// Whether the recording stopped early because it reached the maximum recording size.
+	Truncated bool `db:"truncated" json:"truncated"`
+	// Whether the agent has uploaded the last chunk of the recording.
+	Completed bool `db:"completed" json:"completed"`
+}
+
+// Chunks of a session recording, in the order they were recorded. Concatenating the chunks by seq yields the asciicast file.
+type WorkspaceSessionRecordingChunk struct {
+	RecordingID uuid.UUID `db:"recording_id" json:"recording_id"`
+	Seq         int32     `db:"seq" json:"seq"`
+	Data        []byte    `db:"data" json:"data"`
+}
+
 type WorkspaceTable struct {
 	ID                uuid.UUID        `db:"id" json:"id"`
 	CreatedAt         time.Time        `db:"created_at" json:"created_at"`
@@ -73,6 +73,11 @@ type sqlcQuerier interface {
 	CountUnreadInboxNotificationsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	CreateUserSecret(ctx context.Context, arg CreateUserSecretParams) (UserSecret, error)
 	CustomRoles(ctx context.Context, arg CustomRolesParams) ([]CustomRole, error)
+	// Returns a leased message to the queue without counting a delivery attempt, so that it is not acquired again until
+	// next_retry_after has passed.
+	DeferNotificationMessage(ctx context.Context, arg DeferNotificationMessageParams) error
+	DeleteAIBridgeLimit(ctx context.Context, arg DeleteAIBridgeLimitParams) error
+	DeleteAIBridgeModelPrices(ctx context.Context) error
 	DeleteAPIKeyByID(ctx context.Context, id string) error
 	DeleteAPIKeysByUserID(ctx context.Context, userID uuid.UUID) error
 	DeleteAllTailnetClientSubscriptions(ctx context.Context, arg DeleteAllTailnetClientSubscriptionsParams) error
@@ -83,23 +88,41 @@ type sqlcQuerier interface {
 	// be recreated.
 	DeleteAllWebpushSubscriptions(ctx context.Context) error
 	DeleteApplicationConnectAPIKeysByUserID(ctx context.Context, userID uuid.UUID) error
+	DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams) error
 	DeleteCoordinator(ctx context.Context, id uuid.UUID) error
 	DeleteCryptoKey(ctx context.Context, arg DeleteCryptoKeyParams) (CryptoKey, error)
 	DeleteCustomRole(ctx context.Context, arg DeleteCustomRoleParams) error
+	// Delete all secrets which have been rotated out, regardless of whether their grace period has ended.
+	// This is done before rotating so that no more than two secrets are ever accepted at once.
+	DeleteExpiringNotificationWebhookSecrets(ctx context.Context) error
 	DeleteExternalAuthLink(ctx context.Context, arg DeleteExternalAuthLinkParams) error
 	DeleteGitSSHKey(ctx context.Context, userID uuid.UUID) error
 	DeleteGroupByID(ctx context.Context, id uuid.UUID) error
 	DeleteGroupMemberFromGroup(ctx context.Context, arg DeleteGroupMemberFromGroupParams) error
 	DeleteLicense(ctx context.Context, id int32) (int32, error)
+	DeleteNotificationDigestMessages(ctx context.Context, ids []uuid.UUID) error
+	DeleteNotificationUserTargetByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppByClientID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppCodeByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppCodesByAppAndUserID(ctx context.Context, arg DeleteOAuth2ProviderAppCodesByAppAndUserIDParams) error
 	DeleteOAuth2ProviderAppSecretByID(ctx context.Context, id uuid.UUID) error
 	DeleteOAuth2ProviderAppTokensByAppAndUserID(ctx context.Context, arg DeleteOAuth2ProviderAppTokensByAppAndUserIDParams) error
+	DeleteOldAIBridgeInterceptionCaptures(ctx context.Context, arg DeleteOldAIBridgeInterceptionCapturesParams) (int64, error)
 	DeleteOldAuditLogConnectionEvents(ctx context.Context, arg DeleteOldAuditLogConnectionEventsParams) error
+	// Deletes the checkpoints that are no longer needed to verify the hash chain.
+	// The latest checkpoint before the first remaining entry is kept, since the
+	// chain continues from it.
+	DeleteOldAuditLogHashChainCheckpoints(ctx context.Context) error
+	// Deletes the oldest audit logs recorded before the given time, together with
+	// their hash chain entries. The links of the deleted entries are kept as
+	// checkpoints, so that the remaining entries can still be verified even if
+	// audit logs were not chained in the order of their timestamps.
+	DeleteOldAuditLogs(ctx context.Context, arg DeleteOldAuditLogsParams) (int64, error)
+	DeleteOldConnectionLogs(ctx context.Context, arg DeleteOldConnectionLogsParams) (int64, error)
 	// Delete all notification messages which have not been updated for over a week.
 	DeleteOldNotificationMessages(ctx context.Context) error
+	DeleteOldPrebuildClaims(ctx context.Context, arg DeleteOldPrebuildClaimsParams) (int64, error)
 	// Delete provisioner daemons that have been created at least a week ago
 	// and have not connected to coderd since a week.
 	// A provisioner daemon with "zeroed" last_seen_at column indicates possible
@@ -110,6 +133,8 @@ type sqlcQuerier interface {
 	// Logs can take up a lot of space, so it's important we clean up frequently.
 	DeleteOldWorkspaceAgentLogs(ctx context.Context, threshold time.Time) error
 	DeleteOldWorkspaceAgentStats(ctx context.Context) error
+	DeleteOldWorkspaceLifecycleDecisions(ctx context.Context, arg DeleteOldWorkspaceLifecycleDecisionsParams) (int64, error)
+	DeleteOldWorkspaceSessionRecordings(ctx context.Context, arg DeleteOldWorkspaceSessionRecordingsParams) (int64, error)
 	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
 	DeleteProvisionerKey(ctx context.Context, id uuid.UUID) error
 	DeleteReplicasUpdatedBefore(ctx context.Context, updatedAt time.Time) error
@@ -125,12 +150,16 @@ type sqlcQuerier interface {
 	DeleteWorkspaceACLByID(ctx context.Context, id uuid.UUID) error
 	DeleteWorkspaceAgentPortShare(ctx context.Context, arg DeleteWorkspaceAgentPortShareParams) error
 	DeleteWorkspaceAgentPortSharesByTemplate(ctx context.Context, templateID uuid.UUID) error
+	DeleteWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) error
+	DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) error
 	DeleteWorkspaceSubAgentByID(ctx context.Context, id uuid.UUID) error
 	// Disable foreign keys and triggers for all tables.
 	// Deprecated: disable foreign keys was created to aid in migrating off
 	// of the test-only in-memory database. Do not use this in new code.
 	DisableForeignKeysAndTriggers(ctx context.Context) error
 	EnqueueNotificationMessage(ctx context.Context, arg EnqueueNotificationMessageParams) error
+	// Start the grace period of the active secret, which continues to sign deliveries until expires_at.
+	ExpireActiveNotificationWebhookSecret(ctx context.Context, expiresAt time.Time) error
 	// Firstly, collect api_keys owned by the prebuilds user that correlate
 	// to workspaces no longer owned by the prebuilds user.
 	// Next, collect api_keys that belong to the prebuilds user but have no token name.
@@ -149,9 +178,27 @@ type sqlcQuerier interface {
 	// and returns the preset with the most parameters (largest subset).
 	FindMatchingPresetID(ctx context.Context, arg FindMatchingPresetIDParams) (uuid.UUID, error)
 	GetAIBridgeInterceptionByID(ctx context.Context, id uuid.UUID) (AIBridgeInterception, error)
+	GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (AIBridgeInterceptionCapture, error)
+	// Used by dbcrypt to re-encrypt or decrypt the bodies of all captures.
+	GetAIBridgeInterceptionCaptureIDs(ctx context.Context) ([]uuid.UUID, error)
 	GetAIBridgeInterceptions(ctx context.Context) ([]AIBridgeInterception, error)
+	// Sums the tokens used since the start of the current day and month, and counts
+	// the requests intercepted since minute_start, for everyone a limit applies to.
+	// Usage is pooled across all members of a group or organization. Hours which
+	// the aibridge_usage_stats rollup has completed are read from it, so that only
+	// the most recent hours are summed from individual token usages. Both
+	// day_start and month_start must be on the hour.
+	GetAIBridgeLimitUsage(ctx context.Context, arg GetAIBridgeLimitUsageParams) (GetAIBridgeLimitUsageRow, error)
+	GetAIBridgeLimits(ctx context.Context) ([]AIBridgeLimit, error)
+	// Returns the limits which apply to the given user: their own, and those of
+	// every group and organization they are a member of.
+	GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]AIBridgeLimit, error)
+	GetAIBridgeModelPrices(ctx context.Context) ([]AIBridgeModelPrice, error)
 	GetAIBridgeTokenUsagesByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]AIBridgeTokenUsage, error)
 	GetAIBridgeToolUsagesByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]AIBridgeToolUsage, error)
+	// Sums the hourly AI Bridge usage rollup over [start_time, end_time) for each
+	// user, template, provider and model. Both times must be on the hour.
+	GetAIBridgeUsageStats(ctx context.Context, arg GetAIBridgeUsageStatsParams) ([]GetAIBridgeUsageStatsRow, error)
 	GetAIBridgeUserPromptsByInterceptionID(ctx context.Context, interceptionID uuid.UUID) ([]AIBridgeUserPrompt, error)
 	GetAPIKeyByID(ctx context.Context, id string) (APIKey, error)
 	// there is no unique constraint on empty token names
@@ -170,12 +217,21 @@ type sqlcQuerier interface {
 	GetAnnouncementBanners(ctx context.Context) (string, error)
 	GetAppSecurityKey(ctx context.Context) (string, error)
 	GetApplicationName(ctx context.Context) (string, error)
+	// Returns the checkpoints of purged hash chain entries between the given
+	// positions, in order.
+	GetAuditLogHashChainCheckpoints(ctx context.Context, arg GetAuditLogHashChainCheckpointsParams) ([]AuditLogHashChainCheckpoint, error)
+	// Returns the entries of the audit log hash chain after the given position,
+	// in order.
+	GetAuditLogHashChainEntries(ctx context.Context, arg GetAuditLogHashChainEntriesParams) ([]AuditLogHashChain, error)
+	GetAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) ([]AuditLog, error)
 	// GetAuditLogsBefore retrieves `row_limit` number of audit logs before the provided
 	// ID.
 	GetAuditLogsOffset(ctx context.Context, arg GetAuditLogsOffsetParams) ([]GetAuditLogsOffsetRow, error)
 	// This function returns roles for authorization purposes. Implied member roles
 	// are included.
 	GetAuthorizationUserRoles(ctx context.Context, userID uuid.UUID) (GetAuthorizationUserRolesRow, error)
+	GetAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg GetAutostartExceptionCalendarByOrganizationIDAndNameParams) (AutostartExceptionCalendar, error)
+	GetAutostartExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]AutostartExceptionCalendar, error)
 	GetConnectionLogsOffset(ctx context.Context, arg GetConnectionLogsOffsetParams) ([]GetConnectionLogsOffsetRow, error)
 	GetCoordinatorResumeTokenSigningKey(ctx context.Context) (string, error)
 	GetCryptoKeyByFeatureAndSequence(ctx context.Context, arg GetCryptoKeyByFeatureAndSequenceParams) (CryptoKey, error)
@@ -190,6 +246,9 @@ type sqlcQuerier interface {
 	GetDeploymentWorkspaceAgentStats(ctx context.Context, createdAt time.Time) (GetDeploymentWorkspaceAgentStatsRow, error)
 	GetDeploymentWorkspaceAgentUsageStats(ctx context.Context, createdAt time.Time) (GetDeploymentWorkspaceAgentUsageStatsRow, error)
 	GetDeploymentWorkspaceStats(ctx context.Context) (GetDeploymentWorkspaceStatsRow, error)
+	// Returns all held messages which belong to a digest whose oldest message was held at or before the given time.
+	// Messages are ordered so that each digest's messages are contiguous, oldest first.
+	GetDueNotificationDigestMessages(ctx context.Context, heldBefore time.Time) ([]NotificationDigestMessage, error)
 	GetEligibleProvisionerDaemonsByProvisionerJobIDs(ctx context.Context, provisionerJobIds []uuid.UUID) ([]GetEligibleProvisionerDaemonsByProvisionerJobIDsRow, error)
 	GetExternalAuthLink(ctx context.Context, arg GetExternalAuthLinkParams) (ExternalAuthLink, error)
 	GetExternalAuthLinksByUserID(ctx context.Context, userID uuid.UUID) ([]ExternalAuthLink, error)
@@ -226,6 +285,8 @@ type sqlcQuerier interface {
 	// param limit_opt: The limit of notifications to fetch. If the limit is not specified, it defaults to 25
 	GetInboxNotificationsByUserID(ctx context.Context, arg GetInboxNotificationsByUserIDParams) ([]InboxNotification, error)
 	GetLastUpdateCheck(ctx context.Context) (string, error)
+	GetLatestAuditLogHashChainCheckpoint(ctx context.Context) (AuditLogHashChainCheckpoint, error)
+	GetLatestAuditLogHashChainEntry(ctx context.Context) (AuditLogHashChain, error)
 	GetLatestCryptoKeyByFeature(ctx context.Context, feature CryptoKeyFeature) (CryptoKey, error)
 	GetLatestWorkspaceAppStatusesByAppID(ctx context.Context, appID uuid.UUID) ([]WorkspaceAppStatus, error)
 	GetLatestWorkspaceAppStatusesByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceAppStatus, error)
@@ -239,6 +300,12 @@ type sqlcQuerier interface {
 	GetNotificationReportGeneratorLogByTemplate(ctx context.Context, templateID uuid.UUID) (NotificationReportGeneratorLog, error)
 	GetNotificationTemplateByID(ctx context.Context, id uuid.UUID) (NotificationTemplate, error)
 	GetNotificationTemplatesByKind(ctx context.Context, kind NotificationTemplateKind) ([]NotificationTemplate, error)
+	GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (NotificationUserTarget, error)
+	// Fetch the user-defined target which the user has chosen for the given notification template.
+	GetNotificationUserTargetByPreference(ctx context.Context, arg GetNotificationUserTargetByPreferenceParams) (NotificationUserTarget, error)
+	GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]NotificationUserTarget, error)
+	// Fetch all webhook signing secrets, newest first. This includes secrets whose grace period has ended.
+	GetNotificationWebhookSecrets(ctx context.Context) ([]NotificationWebhookSecret, error)
 	GetNotificationsSettings(ctx context.Context) (string, error)
 	GetOAuth2GithubDefaultEligible(ctx context.Context) (bool, error)
 	// RFC 7591/7592 Dynamic Client Registration queries
@@ -262,7 +329,23 @@ type sqlcQuerier interface {
 	GetOrganizations(ctx context.Context, arg GetOrganizationsParams) ([]Organization, error)
 	GetOrganizationsByUserID(ctx context.Context, arg GetOrganizationsByUserIDParams) ([]Organization, error)
 	GetParameterSchemasByJobID(ctx context.Context, jobID uuid.UUID) ([]ParameterSchema, error)
-	GetPrebuildMetrics(ctx context.Context) ([]GetPrebuildMetricsRow, error)
+	// GetPrebuildClaimDemand returns the demand for the prebuilt workspaces of each preset since the given time:
+	// the number of attempts to claim one, how many of those found none to claim, and the average time taken
+	// to build a prebuilt workspace, or 0 if the preset was never built. Presets are matched across template versions by name, so that the demand
+	// observed for a preset carries over to new versions of its template.
+	GetPrebuildClaimDemand(ctx context.Context, since time.Time) ([]GetPrebuildClaimDemandRow, error)
+	// GetPrebuildInsights returns, for each preset, how many of the workspaces
+	// created with it in the given timeframe were served from a prebuilt workspace,
+	// along with the median and 95th percentile time taken to claim a prebuilt
+	// workspace and until the workspace's agents were ready. Presets are matched
+	// across template versions by name. The result can be filtered on
+	// template_ids, meaning only workspaces based on those templates will be
+	// included.
+	GetPrebuildInsights(ctx context.Context, arg GetPrebuildInsightsParams) ([]GetPrebuildInsightsRow, error)
+	// GetPrebuildMetrics returns the number of prebuilt workspaces created, failed and claimed for each preset,
+	// along with the demand since autoscaling_since from which prebuild pools are sized when autoscaling is
+	// enabled, as returned by GetPrebuildClaimDemand.
+	GetPrebuildMetrics(ctx context.Context, autoscalingSince time.Time) ([]GetPrebuildMetricsRow, error)
 	GetPrebuildsSettings(ctx context.Context) (string, error)
 	GetPresetByID(ctx context.Context, presetID uuid.UUID) (GetPresetByIDRow, error)
 	GetPresetByWorkspaceBuildID(ctx context.Context, workspaceBuildID uuid.UUID) (TemplateVersionPreset, error)
@@ -326,6 +409,11 @@ type sqlcQuerier interface {
 	GetReplicasUpdatedAfter(ctx context.Context, updatedAt time.Time) ([]Replica, error)
 	GetRunningPrebuiltWorkspaces(ctx context.Context) ([]GetRunningPrebuiltWorkspacesRow, error)
 	GetRuntimeConfig(ctx context.Context, key string) (string, error)
+	// Returns the next step of each task if it has not changed status since before
+	// the given time, so that steps abandoned by a replica which stopped, or whose
+	// prompt could not be sent, can be recovered. Tasks with a failed step are
+	// skipped.
+	GetStaleTaskSteps(ctx context.Context, updatedBefore time.Time) ([]TaskStep, error)
 	GetTailnetAgents(ctx context.Context, id uuid.UUID) ([]TailnetAgent, error)
 	GetTailnetClientsForAgent(ctx context.Context, agentID uuid.UUID) ([]TailnetClient, error)
 	GetTailnetPeers(ctx context.Context, id uuid.UUID) ([]TailnetPeer, error)
@@ -333,6 +421,13 @@ type sqlcQuerier interface {
 	GetTailnetTunnelPeerIDs(ctx context.Context, srcID uuid.UUID) ([]GetTailnetTunnelPeerIDsRow, error)
 	GetTaskByID(ctx context.Context, id uuid.UUID) (Task, error)
 	GetTaskByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (Task, error)
+	GetTaskStepsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]TaskStep, error)
+	GetTaskStepsByWorkspaceIDs(ctx context.Context, workspaceIds []uuid.UUID) ([]TaskStep, error)
+	// Returns the workspaces whose latest build runs an AI task, along with the
+	// outcome of that build and the latest state reported by the task's app, so
+	// that task lifecycle policies can be enforced. An owner ID of uuid.Nil returns
+	// the task workspaces of every user.
+	GetTaskWorkspacesForLifecyclePolicy(ctx context.Context, ownerID uuid.UUID) ([]GetTaskWorkspacesForLifecyclePolicyRow, error)
 	GetTelemetryItem(ctx context.Context, key string) (TelemetryItem, error)
 	GetTelemetryItems(ctx context.Context) ([]TelemetryItem, error)
 	// GetTemplateAppInsights returns the aggregate usage of each app in a given
@@ -397,6 +492,7 @@ type sqlcQuerier interface {
 	// inclusive.
 	GetTotalUsageDCManagedAgentsV1(ctx context.Context, arg GetTotalUsageDCManagedAgentsV1Params) (int64, error)
 	GetUnexpiredLicenses(ctx context.Context) ([]License, error)
+	GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error)
 	// GetUserActivityInsights returns the ranking with top active users.
 	// The result can be filtered on template_ids, meaning only user data
 	// from workspaces based on those templates will be included.
@@ -417,6 +513,8 @@ type sqlcQuerier interface {
 	GetUserLinkByUserIDLoginType(ctx context.Context, arg GetUserLinkByUserIDLoginTypeParams) (UserLink, error)
 	GetUserLinksByUserID(ctx context.Context, userID uuid.UUID) ([]UserLink, error)
 	GetUserNotificationPreferences(ctx context.Context, userID uuid.UUID) ([]NotificationPreference, error)
+	GetUserNotificationQuietHours(ctx context.Context, userID uuid.UUID) (string, error)
+	GetUserNotificationSnoozeUntil(ctx context.Context, userID uuid.UUID) (string, error)
 	GetUserSecret(ctx context.Context, id uuid.UUID) (UserSecret, error)
 	GetUserSecretByUserIDAndName(ctx context.Context, arg GetUserSecretByUserIDAndNameParams) (UserSecret, error)
 	// GetUserStatusCounts returns the count of users in each status over time.
@@ -471,6 +569,7 @@ type sqlcQuerier interface {
 	GetWorkspaceAppsByAgentID(ctx context.Context, agentID uuid.UUID) ([]WorkspaceApp, error)
 	GetWorkspaceAppsByAgentIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceApp, error)
 	GetWorkspaceAppsCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceApp, error)
+	GetWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) (bool, error)
 	GetWorkspaceBuildByID(ctx context.Context, id uuid.UUID) (WorkspaceBuild, error)
 	GetWorkspaceBuildByJobID(ctx context.Context, jobID uuid.UUID) (WorkspaceBuild, error)
 	GetWorkspaceBuildByWorkspaceIDAndBuildNumber(ctx context.Context, arg GetWorkspaceBuildByWorkspaceIDAndBuildNumberParams) (WorkspaceBuild, error)
@@ -484,6 +583,7 @@ type sqlcQuerier interface {
 	GetWorkspaceByOwnerIDAndName(ctx context.Context, arg GetWorkspaceByOwnerIDAndNameParams) (Workspace, error)
 	GetWorkspaceByResourceID(ctx context.Context, resourceID uuid.UUID) (Workspace, error)
 	GetWorkspaceByWorkspaceAppID(ctx context.Context, workspaceAppID uuid.UUID) (Workspace, error)
+	GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx context.Context, arg GetWorkspaceLifecycleDecisionsByWorkspaceIDParams) ([]WorkspaceLifecycleDecision, error)
 	GetWorkspaceModulesByJobID(ctx context.Context, jobID uuid.UUID) ([]WorkspaceModule, error)
 	GetWorkspaceModulesCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceModule, error)
 	GetWorkspaceProxies(ctx context.Context) ([]WorkspaceProxy, error)
@@ -503,6 +603,10 @@ type sqlcQuerier interface {
 	GetWorkspaceResourcesByJobID(ctx context.Context, jobID uuid.UUID) ([]WorkspaceResource, error)
 	GetWorkspaceResourcesByJobIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceResource, error)
 	GetWorkspaceResourcesCreatedAfter(ctx context.Context, createdAt time.Time) ([]WorkspaceResource, error)
+	GetWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]WorkspaceScheduleWindow, error)
+	GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceScheduleWindow, error)
+	GetWorkspaceSessionRecordingByConnectionLogID(ctx context.Context, connectionLogID uuid.UUID) (WorkspaceSessionRecording, error)
+	GetWorkspaceSessionRecordingChunks(ctx context.Context, arg GetWorkspaceSessionRecordingChunksParams) ([]WorkspaceSessionRecordingChunk, error)
 	GetWorkspaceUniqueOwnerCountByTemplateIDs(ctx context.Context, templateIds []uuid.UUID) ([]GetWorkspaceUniqueOwnerCountByTemplateIDsRow, error)
 	// build_params is used to filter by build parameters if present.
 	// It has to be a CTE because the set returning function 'unnest' cannot
@@ -513,6 +617,9 @@ type sqlcQuerier interface {
 	GetWorkspacesEligibleForTransition(ctx context.Context, now time.Time) ([]GetWorkspacesEligibleForTransitionRow, error)
 	GetWorkspacesForWorkspaceMetrics(ctx context.Context) ([]GetWorkspacesForWorkspaceMetricsRow, error)
 	InsertAIBridgeInterception(ctx context.Context, arg InsertAIBridgeInterceptionParams) (AIBridgeInterception, error)
+	InsertAIBridgeInterceptionCapture(ctx context.Context, arg InsertAIBridgeInterceptionCaptureParams) (AIBridgeInterceptionCapture, error)
+	InsertAIBridgeModelPrices(ctx context.Context, arg InsertAIBridgeModelPricesParams) error
+	InsertAIBridgePolicyDecision(ctx context.Context, arg InsertAIBridgePolicyDecisionParams) (AIBridgePolicyDecision, error)
 	InsertAIBridgeTokenUsage(ctx context.Context, arg InsertAIBridgeTokenUsageParams) (AIBridgeTokenUsage, error)
 	InsertAIBridgeToolUsage(ctx context.Context, arg InsertAIBridgeToolUsageParams) (AIBridgeToolUsage, error)
 	InsertAIBridgeUserPrompt(ctx context.Context, arg InsertAIBridgeUserPromptParams) (AIBridgeUserPrompt, error)
@@ -522,6 +629,7 @@ type sqlcQuerier interface {
 	// every member of the org.
 	InsertAllUsersGroup(ctx context.Context, organizationID uuid.UUID) (Group, error)
 	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) (AuditLog, error)
+	InsertAuditLogHashChainEntry(ctx context.Context, arg InsertAuditLogHashChainEntryParams) error
 	InsertCryptoKey(ctx context.Context, arg InsertCryptoKeyParams) (CryptoKey, error)
 	InsertCustomRole(ctx context.Context, arg InsertCustomRoleParams) (CustomRole, error)
 	InsertDBCryptKey(ctx context.Context, arg InsertDBCryptKeyParams) error
@@ -540,12 +648,16 @@ type sqlcQuerier interface {
 	// values for avatar, display name, and quota allowance (all zero values).
 	// If the name conflicts, do nothing.
 	InsertMissingGroups(ctx context.Context, arg InsertMissingGroupsParams) ([]Group, error)
+	InsertNotificationDigestMessage(ctx context.Context, arg InsertNotificationDigestMessageParams) error
+	InsertNotificationUserTarget(ctx context.Context, arg InsertNotificationUserTargetParams) (NotificationUserTarget, error)
+	InsertNotificationWebhookSecret(ctx context.Context, arg InsertNotificationWebhookSecretParams) (NotificationWebhookSecret, error)
 	InsertOAuth2ProviderApp(ctx context.Context, arg InsertOAuth2ProviderAppParams) (OAuth2ProviderApp, error)
 	InsertOAuth2ProviderAppCode(ctx context.Context, arg InsertOAuth2ProviderAppCodeParams) (OAuth2ProviderAppCode, error)
 	InsertOAuth2ProviderAppSecret(ctx context.Context, arg InsertOAuth2ProviderAppSecretParams) (OAuth2ProviderAppSecret, error)
 	InsertOAuth2ProviderAppToken(ctx context.Context, arg InsertOAuth2ProviderAppTokenParams) (OAuth2ProviderAppToken, error)
 	InsertOrganization(ctx context.Context, arg InsertOrganizationParams) (Organization, error)
 	InsertOrganizationMember(ctx context.Context, arg InsertOrganizationMemberParams) (OrganizationMember, error)
+	InsertPrebuildClaim(ctx context.Context, arg InsertPrebuildClaimParams) error
 	InsertPreset(ctx context.Context, arg InsertPresetParams) (TemplateVersionPreset, error)
 	InsertPresetParameters(ctx context.Context, arg InsertPresetParametersParams) ([]TemplateVersionPresetParameter, error)
 	InsertPresetPrebuildSchedule(ctx context.Context, arg InsertPresetPrebuildScheduleParams) (TemplateVersionPresetPrebuildSchedule, error)
@@ -555,6 +667,7 @@ type sqlcQuerier interface {
 	InsertProvisionerKey(ctx context.Context, arg InsertProvisionerKeyParams) (ProvisionerKey, error)
 	InsertReplica(ctx context.Context, arg InsertReplicaParams) (Replica, error)
 	InsertTask(ctx context.Context, arg InsertTaskParams) (TaskTable, error)
+	InsertTaskStep(ctx context.Context, arg InsertTaskStepParams) (TaskStep, error)
 	InsertTelemetryItemIfNotExists(ctx context.Context, arg InsertTelemetryItemIfNotExistsParams) error
 	InsertTemplate(ctx context.Context, arg InsertTemplateParams) error
 	InsertTemplateVersion(ctx context.Context, arg InsertTemplateVersionParams) error
@@ -585,13 +698,18 @@ type sqlcQuerier interface {
 	InsertWorkspaceAgentStats(ctx context.Context, arg InsertWorkspaceAgentStatsParams) error
 	InsertWorkspaceAppStats(ctx context.Context, arg InsertWorkspaceAppStatsParams) error
 	InsertWorkspaceAppStatus(ctx context.Context, arg InsertWorkspaceAppStatusParams) (WorkspaceAppStatus, error)
+	InsertWorkspaceAutostartExceptionOptOut(ctx context.Context, arg InsertWorkspaceAutostartExceptionOptOutParams) error
 	InsertWorkspaceBuild(ctx context.Context, arg InsertWorkspaceBuildParams) error
 	InsertWorkspaceBuildParameters(ctx context.Context, arg InsertWorkspaceBuildParametersParams) error
+	InsertWorkspaceLifecycleDecision(ctx context.Context, arg InsertWorkspaceLifecycleDecisionParams) (WorkspaceLifecycleDecision, error)
 	InsertWorkspaceModule(ctx context.Context, arg InsertWorkspaceModuleParams) (WorkspaceModule, error)
 	InsertWorkspaceProxy(ctx context.Context, arg InsertWorkspaceProxyParams) (WorkspaceProxy, error)
 	InsertWorkspaceResource(ctx context.Context, arg InsertWorkspaceResourceParams) (WorkspaceResource, error)
 	InsertWorkspaceResourceMetadata(ctx context.Context, arg InsertWorkspaceResourceMetadataParams) ([]WorkspaceResourceMetadatum, error)
+	InsertWorkspaceScheduleWindow(ctx context.Context, arg InsertWorkspaceScheduleWindowParams) (WorkspaceScheduleWindow, error)
+	InsertWorkspaceSessionRecordingChunk(ctx context.Context, arg InsertWorkspaceSessionRecordingChunkParams) error
 	ListAIBridgeInterceptions(ctx context.Context, arg ListAIBridgeInterceptionsParams) ([]AIBridgeInterception, error)
+	ListAIBridgePolicyDecisionsByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]AIBridgePolicyDecision, error)
 	ListAIBridgeTokenUsagesByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]AIBridgeTokenUsage, error)
 	ListAIBridgeToolUsagesByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]AIBridgeToolUsage, error)
 	ListAIBridgeUserPromptsByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]AIBridgeUserPrompt, error)
@@ -615,6 +733,10 @@ type sqlcQuerier interface {
 	RegisterWorkspaceProxy(ctx context.Context, arg RegisterWorkspaceProxyParams) (WorkspaceProxy, error)
 	RemoveUserFromAllGroups(ctx context.Context, userID uuid.UUID) error
 	RemoveUserFromGroups(ctx context.Context, arg RemoveUserFromGroupsParams) ([]uuid.UUID, error)
+	// Clears the cached next autostart time of every workspace in the organization
+	// with an autostart schedule, so that the lifecycle executor recomputes it
+	// against the organization's current autostart exception calendars.
+	ResetWorkspacesNextStartAtByOrganizationID(ctx context.Context, organizationID uuid.UUID) error
 	RevokeDBCryptKey(ctx context.Context, activeKeyDigest string) error
 	// Note that this selects from the CTE, not the original table. The CTE is named
 	// the same as the original table to trick sqlc into reusing the existing struct
@@ -629,6 +751,7 @@ type sqlcQuerier interface {
 	// This will always work regardless of the current state of the template version.
 	UnarchiveTemplateVersion(ctx context.Context, arg UnarchiveTemplateVersionParams) error
 	UnfavoriteWorkspace(ctx context.Context, id uuid.UUID) error
+	UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, arg UpdateAIBridgeInterceptionCaptureBodiesParams) error
 	UpdateAPIKeyByID(ctx context.Context, arg UpdateAPIKeyByIDParams) error
 	UpdateCryptoKeyDeletesAt(ctx context.Context, arg UpdateCryptoKeyDeletesAtParams) (CryptoKey, error)
 	UpdateCustomRole(ctx context.Context, arg UpdateCustomRoleParams) (CustomRole, error)
@@ -641,6 +764,11 @@ type sqlcQuerier interface {
 	UpdateMemberRoles(ctx context.Context, arg UpdateMemberRolesParams) (OrganizationMember, error)
 	UpdateMemoryResourceMonitor(ctx context.Context, arg UpdateMemoryResourceMonitorParams) error
 	UpdateNotificationTemplateMethodByID(ctx context.Context, arg UpdateNotificationTemplateMethodByIDParams) (NotificationTemplate, error)
+	// Overrides the title and body templates for this deployment. NULL values fall back to the built-in templates.
+	UpdateNotificationTemplateOverridesByID(ctx context.Context, arg UpdateNotificationTemplateOverridesByIDParams) (NotificationTemplate, error)
+	UpdateNotificationTemplateUrgencyByID(ctx context.Context, arg UpdateNotificationTemplateUrgencyByIDParams) (NotificationTemplate, error)
+	UpdateNotificationUserTargetSecret(ctx context.Context, arg UpdateNotificationUserTargetSecretParams) (NotificationUserTarget, error)
+	UpdateNotificationWebhookSecret(ctx context.Context, arg UpdateNotificationWebhookSecretParams) error
 	UpdateOAuth2ProviderAppByClientID(ctx context.Context, arg UpdateOAuth2ProviderAppByClientIDParams) (OAuth2ProviderApp, error)
 	UpdateOAuth2ProviderAppByID(ctx context.Context, arg UpdateOAuth2ProviderAppByIDParams) (OAuth2ProviderApp, error)
 	UpdateOAuth2ProviderAppSecretByID(ctx context.Context, arg UpdateOAuth2ProviderAppSecretByIDParams) (OAuth2ProviderAppSecret, error)
@@ -656,6 +784,10 @@ type sqlcQuerier interface {
 	UpdateProvisionerJobWithCompleteWithStartedAtByID(ctx context.Context, arg UpdateProvisionerJobWithCompleteWithStartedAtByIDParams) error
 	UpdateReplica(ctx context.Context, arg UpdateReplicaParams) (Replica, error)
 	UpdateTailnetPeerStatusByCoordinator(ctx context.Context, arg UpdateTailnetPeerStatusByCoordinatorParams) error
+	// Moves a step from one status to another. No row is returned if the step is
+	// not in the expected status, so concurrent callers cannot both advance the
+	// same step.
+	UpdateTaskStepStatus(ctx context.Context, arg UpdateTaskStepStatusParams) (TaskStep, error)
 	UpdateTemplateACLByID(ctx context.Context, arg UpdateTemplateACLByIDParams) error
 	UpdateTemplateAccessControlByID(ctx context.Context, arg UpdateTemplateAccessControlByIDParams) error
 	UpdateTemplateActiveVersionByID(ctx context.Context, arg UpdateTemplateActiveVersionByIDParams) error
@@ -668,6 +800,7 @@ type sqlcQuerier interface {
 	UpdateTemplateVersionFlagsByJobID(ctx context.Context, arg UpdateTemplateVersionFlagsByJobIDParams) error
 	UpdateTemplateWorkspacesLastUsedAt(ctx context.Context, arg UpdateTemplateWorkspacesLastUsedAtParams) error
 	UpdateUsageEventsPostPublish(ctx context.Context, arg UpdateUsageEventsPostPublishParams) error
+	UpdateUserAIBridgeCapture(ctx context.Context, arg UpdateUserAIBridgeCaptureParams) (UserConfig, error)
 	UpdateUserDeletedByID(ctx context.Context, id uuid.UUID) error
 	UpdateUserGithubComUserID(ctx context.Context, arg UpdateUserGithubComUserIDParams) error
 	UpdateUserHashedOneTimePasscode(ctx context.Context, arg UpdateUserHashedOneTimePasscodeParams) error
@@ -676,7 +809,17 @@ type sqlcQuerier interface {
 	UpdateUserLink(ctx context.Context, arg UpdateUserLinkParams) (UserLink, error)
 	UpdateUserLinkedID(ctx context.Context, arg UpdateUserLinkedIDParams) (UserLink, error)
 	UpdateUserLoginType(ctx context.Context, arg UpdateUserLoginTypeParams) (User, error)
+	// Sets whether notifications from a single template are delivered to the user as a digest.
+	// As with targets, a new preference row inherits the template's default enablement.
+	UpdateUserNotificationPreferenceDigest(ctx context.Context, arg UpdateUserNotificationPreferenceDigestParams) (int64, error)
+	// Sets the user-defined target for a single notification template, or clears it when NULL.
+	// A new preference row inherits the template's default enablement so that choosing a target
+	// does not implicitly opt the user in to a notification which is disabled by default.
+	UpdateUserNotificationPreferenceTarget(ctx context.Context, arg UpdateUserNotificationPreferenceTargetParams) (int64, error)
 	UpdateUserNotificationPreferences(ctx context.Context, arg UpdateUserNotificationPreferencesParams) (int64, error)
+	UpdateUserNotificationQuietHours(ctx context.Context, arg UpdateUserNotificationQuietHoursParams) (UserConfig, error)
+	// The value is an RFC 3339 timestamp, or empty when notifications are not snoozed.
+	UpdateUserNotificationSnoozeUntil(ctx context.Context, arg UpdateUserNotificationSnoozeUntilParams) (UserConfig, error)
 	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (User, error)
 	UpdateUserQuietHoursSchedule(ctx context.Context, arg UpdateUserQuietHoursScheduleParams) (User, error)
 	UpdateUserRoles(ctx context.Context, arg UpdateUserRolesParams) (User, error)
@@ -709,9 +852,18 @@ type sqlcQuerier interface {
 	UpdateWorkspaceTTL(ctx context.Context, arg UpdateWorkspaceTTLParams) error
 	UpdateWorkspacesDormantDeletingAtByTemplateID(ctx context.Context, arg UpdateWorkspacesDormantDeletingAtByTemplateIDParams) ([]WorkspaceTable, error)
 	UpdateWorkspacesTTLByTemplateID(ctx context.Context, arg UpdateWorkspacesTTLByTemplateIDParams) error
+	UpsertAIBridgeLimit(ctx context.Context, arg UpsertAIBridgeLimitParams) (AIBridgeLimit, error)
+	// This query aggregates AI Bridge interceptions and token usages into hourly
+	// buckets per user, template, provider and model, and stores the result in the
+	// aibridge_usage_stats table. The template is read from the interception
+	// metadata, which is only set for requests authenticated with a workspace
+	// session token. The latest hour and the one before it are always recomputed
+	// so that tokens recorded after an interception started are included.
+	UpsertAIBridgeUsageStats(ctx context.Context) error
 	UpsertAnnouncementBanners(ctx context.Context, value string) error
 	UpsertAppSecurityKey(ctx context.Context, value string) error
 	UpsertApplicationName(ctx context.Context, value string) error
+	UpsertAutostartExceptionCalendar(ctx context.Context, arg UpsertAutostartExceptionCalendarParams) (AutostartExceptionCalendar, error)
 	UpsertConnectionLog(ctx context.Context, arg UpsertConnectionLogParams) (ConnectionLog, error)
 	UpsertCoordinatorResumeTokenSigningKey(ctx context.Context, value string) error
 	// The default proxy is implied and not actually stored in the database.
@@ -750,6 +902,7 @@ type sqlcQuerier interface {
 	// was started. This means that a new row was inserted (no previous session) or
 	// the updated_at is older than stale interval.
 	UpsertWorkspaceAppAuditSession(ctx context.Context, arg UpsertWorkspaceAppAuditSessionParams) (bool, error)
+	UpsertWorkspaceSessionRecording(ctx context.Context, arg UpsertWorkspaceSessionRecordingParams) (WorkspaceSessionRecording, error)
 	ValidateGroupIDs(ctx context.Context, groupIds []uuid.UUID) (ValidateGroupIDsRow, error)
 	ValidateUserIDs(ctx context.Context, userIds []uuid.UUID) (ValidateUserIDsRow, error)
 }
@@ -7568,3 +7568,48 @@ func TestListTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAIBridgeLimitUsage(t *testing.T) {
+	t.Parallel()
+
+	db, _ := dbtestutil.NewDB(t)
+	ctx := testutil.Context(t, testutil.WaitShort)
+	user := dbgen.User(t, db, database.User{})
+	now := dbtime.Now()
+
+	use := func(at time.Time, input, output int64) {
+		intc := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+			InitiatorID: user.ID,
+			StartedAt:   at,
+		})
+		dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+			InterceptionID: intc.ID,
+			InputTokens:    input,
+			OutputTokens:   output,
+			CreatedAt:      at,
+		})
+	}
+
+	// Given: usage in an hour the rollup has completed, usage in the hours
+	// it recomputes, and usage it has not seen yet.
+	use(now.Add(-5*time.Hour), 60, 40)
+	use(now.Add(-time.Minute), 5, 5)
+	require.NoError(t, db.UpsertAIBridgeUsageStats(ctx))
+	use(now.Add(-30*time.Second), 2, 3)
+
+	// When: the usage of the user is counted.
+	hour := now.Truncate(time.Hour)
+	usage, err := db.GetAIBridgeLimitUsage(ctx, database.GetAIBridgeLimitUsageParams{
+		ScopeID:     user.ID,
+		Scope:       database.AIBridgeLimitScopeUser,
+		DayStart:    hour.Add(-2 * time.Hour),
+		MonthStart:  hour.Add(-24 * time.Hour),
+		MinuteStart: now.Add(-2 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	// Then: every token is counted exactly once.
+	require.EqualValues(t, 15, usage.DailyTokens)
+	require.EqualValues(t, 115, usage.MonthlyTokens)
+	require.EqualValues(t, 2, usage.RecentRequests)
+}
@@ -111,6 +111,57 @@ func (q *sqlQuerier) ActivityBumpWorkspace(ctx context.Context, arg ActivityBump
 	return err
 }
 
+const deleteAIBridgeLimit = `-- name: DeleteAIBridgeLimit :exec
+DELETE FROM
+	aibridge_limits
+WHERE
+	scope = $1
+	AND scope_id = $2
+`
+
+type DeleteAIBridgeLimitParams struct {
+	Scope   AIBridgeLimitScope `db:"scope" json:"scope"`
+	ScopeID uuid.UUID          `db:"scope_id" json:"scope_id"`
+}
+
+func (q *sqlQuerier) DeleteAIBridgeLimit(ctx context.Context, arg DeleteAIBridgeLimitParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAIBridgeLimit, arg.Scope, arg.ScopeID)
+	return err
+}
+
+const deleteAIBridgeModelPrices = `-- name: DeleteAIBridgeModelPrices :exec
+DELETE FROM
+	aibridge_model_prices
+`
+
+func (q *sqlQuerier) DeleteAIBridgeModelPrices(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteAIBridgeModelPrices)
+	return err
+}
+
+const deleteOldAIBridgeInterceptionCaptures = `-- name: DeleteOldAIBridgeInterceptionCaptures :execrows
+DELETE FROM aibridge_interception_captures
+WHERE interception_id IN (
+	SELECT interception_id FROM aibridge_interception_captures
+	WHERE created_at < $1::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT $2
+)
+`
+
+type DeleteOldAIBridgeInterceptionCapturesParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) DeleteOldAIBridgeInterceptionCaptures(ctx context.Context, arg DeleteOldAIBridgeInterceptionCapturesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldAIBridgeInterceptionCaptures, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getAIBridgeInterceptionByID = `-- name: GetAIBridgeInterceptionByID :one
 SELECT
 	id, initiator_id, provider, model, started_at, metadata
@@ -134,6 +185,69 @@ func (q *sqlQuerier) GetAIBridgeInterceptionByID(ctx context.Context, id uuid.UU
 	return i, err
 }
 
+const getAIBridgeInterceptionCaptureByInterceptionID = `-- name: GetAIBridgeInterceptionCaptureByInterceptionID :one
+SELECT
+	interception_id, method, path, request_headers, request_body, request_body_key_id, request_body_truncated, response_status, response_headers, response_body, response_body_key_id, response_body_truncated, created_at
+FROM
+	aibridge_interception_captures
+WHERE
+	interception_id = $1::uuid
+`
+
+func (q *sqlQuerier) GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (AIBridgeInterceptionCapture, error) {
+	row := q.db.QueryRowContext(ctx, getAIBridgeInterceptionCaptureByInterceptionID, interceptionID)
+	var i AIBridgeInterceptionCapture
+	err := row.Scan(
+		&i.InterceptionID,
+		&i.Method,
+		&i.Path,
+		&i.RequestHeaders,
+		&i.RequestBody,
+		&i.RequestBodyKeyID,
+		&i.RequestBodyTruncated,
+		&i.ResponseStatus,
+		&i.ResponseHeaders,
+		&i.ResponseBody,
+		&i.ResponseBodyKeyID,
+		&i.ResponseBodyTruncated,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAIBridgeInterceptionCaptureIDs = `-- name: GetAIBridgeInterceptionCaptureIDs :many
+SELECT
+	interception_id
+FROM
+	aibridge_interception_captures
+ORDER BY
+	created_at ASC
+`
+
+// Used by dbcrypt to re-encrypt or decrypt the bodies of all captures.
+func (q *sqlQuerier) GetAIBridgeInterceptionCaptureIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, getAIBridgeInterceptionCaptureIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var interception_id uuid.UUID
+		if err := rows.Scan(&interception_id); err != nil {
+			return nil, err
+		}
+		items = append(items, interception_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAIBridgeInterceptions = `-- name: GetAIBridgeInterceptions :many
 SELECT
 	id, initiator_id, provider, model, started_at, metadata
@@ -171,6 +285,228 @@ func (q *sqlQuerier) GetAIBridgeInterceptions(ctx context.Context) ([]AIBridgeIn
 	return items, nil
 }
 
+const getAIBridgeLimitUsage = `-- name: GetAIBridgeLimitUsage :one
+WITH initiators AS (
+	SELECT $1::uuid AS user_id
+	WHERE $2::aibridge_limit_scope = 'user'
+	UNION
+	SELECT user_id FROM group_members_expanded
+	WHERE $2::aibridge_limit_scope = 'group' AND group_id = $1::uuid
+	UNION
+	SELECT user_id FROM organization_members
+	WHERE $2::aibridge_limit_scope = 'organization' AND organization_id = $1::uuid
+),
+rollup_end AS (
+	-- The rollup recomputes its latest hour and the one before it, so only
+	-- the hours before them are final.
+	SELECT
+		COALESCE(MAX(start_time) - '1 hour'::interval, '-infinity'::timestamptz) AS t
+	FROM
+		aibridge_usage_stats
+),
+rolled_up_tokens AS (
+	SELECT
+		COALESCE(SUM(s.input_tokens + s.output_tokens) FILTER (WHERE s.start_time >= $3::timestamptz), 0) AS daily_tokens,
+		COALESCE(SUM(s.input_tokens + s.output_tokens), 0) AS monthly_tokens
+	FROM
+		aibridge_usage_stats s
+	WHERE
+		s.start_time >= $4::timestamptz
+		AND s.start_time < (SELECT t FROM rollup_end)
+		AND s.user_id IN (SELECT user_id FROM initiators)
+),
+recent_tokens AS (
+	SELECT
+		COALESCE(SUM(tu.input_tokens + tu.output_tokens) FILTER (WHERE tu.created_at >= $3::timestamptz), 0) AS daily_tokens,
+		COALESCE(SUM(tu.input_tokens + tu.output_tokens), 0) AS monthly_tokens
+	FROM
+		aibridge_token_usages tu
+	JOIN
+		aibridge_interceptions i ON i.id = tu.interception_id
+	WHERE
+		tu.created_at >= GREATEST($4::timestamptz, (SELECT t FROM rollup_end))
+		AND i.initiator_id IN (SELECT user_id FROM initiators)
+)
+SELECT
+	(rolled_up_tokens.daily_tokens + recent_tokens.daily_tokens)::bigint AS daily_tokens,
+	(rolled_up_tokens.monthly_tokens + recent_tokens.monthly_tokens)::bigint AS monthly_tokens,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			aibridge_interceptions ai
+		WHERE
+			ai.started_at >= $5::timestamptz
+			AND ai.initiator_id IN (SELECT user_id FROM initiators)
+	)::bigint AS recent_requests
+FROM
+	rolled_up_tokens, recent_tokens
+`
+
+type GetAIBridgeLimitUsageParams struct {
+	ScopeID     uuid.UUID          `db:"scope_id" json:"scope_id"`
+	Scope       AIBridgeLimitScope `db:"scope" json:"scope"`
+	DayStart    time.Time          `db:"day_start" json:"day_start"`
+	MonthStart  time.Time          `db:"month_start" json:"month_start"`
+	MinuteStart time.Time          `db:"minute_start" json:"minute_start"`
+}
+
+type GetAIBridgeLimitUsageRow struct {
+	DailyTokens    int64 `db:"daily_tokens" json:"daily_tokens"`
+	MonthlyTokens  int64 `db:"monthly_tokens" json:"monthly_tokens"`
+	RecentRequests int64 `db:"recent_requests" json:"recent_requests"`
+}
+
+// Sums the tokens used since the start of the current day and month, and counts
+// the requests intercepted since minute_start, for everyone a limit applies to.
+// Usage is pooled across all members of a group or organization. Hours which
+// the aibridge_usage_stats rollup has completed are read from it, so that only
+// the most recent hours are summed from individual token usages. Both
+// day_start and month_start must be on the hour.
+func (q *sqlQuerier) GetAIBridgeLimitUsage(ctx context.Context, arg GetAIBridgeLimitUsageParams) (GetAIBridgeLimitUsageRow, error) {
+	row := q.db.QueryRowContext(ctx, getAIBridgeLimitUsage,
+		arg.ScopeID,
+		arg.Scope,
+		arg.DayStart,
+		arg.MonthStart,
+		arg.MinuteStart,
+	)
+	var i GetAIBridgeLimitUsageRow
+	err := row.Scan(&i.DailyTokens, &i.MonthlyTokens, &i.RecentRequests)
+	return i, err
+}
+
+const getAIBridgeLimits = `-- name: GetAIBridgeLimits :many
+SELECT
+	scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at
+FROM
+	aibridge_limits
+ORDER BY
+	scope ASC,
+	scope_id ASC
+`
+
+func (q *sqlQuerier) GetAIBridgeLimits(ctx context.Context) ([]AIBridgeLimit, error) {
+	rows, err := q.db.QueryContext(ctx, getAIBridgeLimits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AIBridgeLimit
+	for rows.Next() {
+		var i AIBridgeLimit
+		if err := rows.Scan(
+			&i.Scope,
+			&i.ScopeID,
+			&i.DailyTokenBudget,
+			&i.MonthlyTokenBudget,
+			&i.RequestsPerMinute,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAIBridgeLimitsByUserID = `-- name: GetAIBridgeLimitsByUserID :many
+SELECT
+	scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at
+FROM
+	aibridge_limits
+WHERE
+	(scope = 'user' AND scope_id = $1::uuid)
+	OR (scope = 'group' AND scope_id IN (
+		SELECT group_id FROM group_members_expanded WHERE user_id = $1::uuid
+	))
+	OR (scope = 'organization' AND scope_id IN (
+		SELECT organization_id FROM organization_members WHERE user_id = $1::uuid
+	))
+ORDER BY
+	scope ASC,
+	scope_id ASC
+`
+
+// Returns the limits which apply to the given user: their own, and those of
+// every group and organization they are a member of.
+func (q *sqlQuerier) GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]AIBridgeLimit, error) {
+	rows, err := q.db.QueryContext(ctx, getAIBridgeLimitsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AIBridgeLimit
+	for rows.Next() {
+		var i AIBridgeLimit
+		if err := rows.Scan(
+			&i.Scope,
+			&i.ScopeID,
+			&i.DailyTokenBudget,
+			&i.MonthlyTokenBudget,
+			&i.RequestsPerMinute,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAIBridgeModelPrices = `-- name: GetAIBridgeModelPrices :many
+SELECT
+	provider, model, input_price_per_million_tokens, output_price_per_million_tokens, updated_at
+FROM
+	aibridge_model_prices
+ORDER BY
+	provider ASC,
+	model ASC
+`
+
+func (q *sqlQuerier) GetAIBridgeModelPrices(ctx context.Context) ([]AIBridgeModelPrice, error) {
+	rows, err := q.db.QueryContext(ctx, getAIBridgeModelPrices)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AIBridgeModelPrice
+	for rows.Next() {
+		var i AIBridgeModelPrice
+		if err := rows.Scan(
+			&i.Provider,
+			&i.Model,
+			&i.InputPricePerMillionTokens,
+			&i.OutputPricePerMillionTokens,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAIBridgeTokenUsagesByInterceptionID = `-- name: GetAIBridgeTokenUsagesByInterceptionID :many
 SELECT
 	id, interception_id, provider_response_id, input_tokens, output_tokens, metadata, created_at
@@ -258,6 +594,87 @@ func (q *sqlQuerier) GetAIBridgeToolUsagesByInterceptionID(ctx context.Context,
 	return items, nil
 }
 
+const getAIBridgeUsageStats = `-- name: GetAIBridgeUsageStats :many
+SELECT
+	s.user_id,
+	COALESCE(u.username, '')::text AS username,
+	s.template_id,
+	COALESCE(t.name, '')::text AS template_name,
+	s.provider,
+	s.model,
+	SUM(s.requests)::bigint AS requests,
+	SUM(s.input_tokens)::bigint AS input_tokens,
+	SUM(s.output_tokens)::bigint AS output_tokens
+FROM
+	aibridge_usage_stats s
+LEFT JOIN
+	users u ON u.id = s.user_id
+LEFT JOIN
+	templates t ON t.id = s.template_id
+WHERE
+	s.start_time >= $1::timestamptz
+	AND s.start_time < $2::timestamptz
+GROUP BY
+	s.user_id, u.username, s.template_id, t.name, s.provider, s.model
+ORDER BY
+	u.username ASC,
+	t.name ASC,
+	s.provider ASC,
+	s.model ASC
+`
+
+type GetAIBridgeUsageStatsParams struct {
+	StartTime time.Time `db:"start_time" json:"start_time"`
+	EndTime   time.Time `db:"end_time" json:"end_time"`
+}
+
+type GetAIBridgeUsageStatsRow struct {
+	UserID       uuid.UUID `db:"user_id" json:"user_id"`
+	Username     string    `db:"username" json:"username"`
+	TemplateID   uuid.UUID `db:"template_id" json:"template_id"`
+	TemplateName string    `db:"template_name" json:"template_name"`
+	Provider     string    `db:"provider" json:"provider"`
+	Model        string    `db:"model" json:"model"`
+	Requests     int64     `db:"requests" json:"requests"`
+	InputTokens  int64     `db:"input_tokens" json:"input_tokens"`
+	OutputTokens int64     `db:"output_tokens" json:"output_tokens"`
+}
+
+// Sums the hourly AI Bridge usage rollup over [start_time, end_time) for each
+// user, template, provider and model. Both times must be on the hour.
+func (q *sqlQuerier) GetAIBridgeUsageStats(ctx context.Context, arg GetAIBridgeUsageStatsParams) ([]GetAIBridgeUsageStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAIBridgeUsageStats, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAIBridgeUsageStatsRow
+	for rows.Next() {
+		var i GetAIBridgeUsageStatsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TemplateID,
+			&i.TemplateName,
+			&i.Provider,
+			&i.Model,
+			&i.Requests,
+			&i.InputTokens,
+			&i.OutputTokens,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAIBridgeUserPromptsByInterceptionID = `-- name: GetAIBridgeUserPromptsByInterceptionID :many
 SELECT
 	id, interception_id, provider_response_id, prompt, metadata, created_at
@@ -339,6 +756,142 @@ func (q *sqlQuerier) InsertAIBridgeInterception(ctx context.Context, arg InsertA
 	return i, err
 }
 
+const insertAIBridgeInterceptionCapture = `-- name: InsertAIBridgeInterceptionCapture :one
+INSERT INTO aibridge_interception_captures (
+	interception_id, method, path,
+	request_headers, request_body, request_body_key_id, request_body_truncated,
+	response_status, response_headers, response_body, response_body_key_id, response_body_truncated,
+	created_at
+) VALUES (
+	$1, $2, $3,
+	$4, $5, $6, $7,
+	$8, $9, $10, $11, $12,
+	$13
+)
+RETURNING interception_id, method, path, request_headers, request_body, request_body_key_id, request_body_truncated, response_status, response_headers, response_body, response_body_key_id, response_body_truncated, created_at
+`
+
+type InsertAIBridgeInterceptionCaptureParams struct {
+	InterceptionID        uuid.UUID       `db:"interception_id" json:"interception_id"`
+	Method                string          `db:"method" json:"method"`
+	Path                  string          `db:"path" json:"path"`
+	RequestHeaders        json.RawMessage `db:"request_headers" json:"request_headers"`
+	RequestBody           string          `db:"request_body" json:"request_body"`
+	RequestBodyKeyID      sql.NullString  `db:"request_body_key_id" json:"request_body_key_id"`
+	RequestBodyTruncated  bool            `db:"request_body_truncated" json:"request_body_truncated"`
+	ResponseStatus        int32           `db:"response_status" json:"response_status"`
+	ResponseHeaders       json.RawMessage `db:"response_headers" json:"response_headers"`
+	ResponseBody          string          `db:"response_body" json:"response_body"`
+	ResponseBodyKeyID     sql.NullString  `db:"response_body_key_id" json:"response_body_key_id"`
+	ResponseBodyTruncated bool            `db:"response_body_truncated" json:"response_body_truncated"`
+	CreatedAt             time.Time       `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertAIBridgeInterceptionCapture(ctx context.Context, arg InsertAIBridgeInterceptionCaptureParams) (AIBridgeInterceptionCapture, error) {
+	row := q.db.QueryRowContext(ctx, insertAIBridgeInterceptionCapture,
+		arg.InterceptionID,
+		arg.Method,
+		arg.Path,
+		arg.RequestHeaders,
+		arg.RequestBody,
+		arg.RequestBodyKeyID,
+		arg.RequestBodyTruncated,
+		arg.ResponseStatus,
+		arg.ResponseHeaders,
+		arg.ResponseBody,
+		arg.ResponseBodyKeyID,
+		arg.ResponseBodyTruncated,
+		arg.CreatedAt,
+	)
+	var i AIBridgeInterceptionCapture
+	err := row.Scan(
+		&i.InterceptionID,
+		&i.Method,
+		&i.Path,
+		&i.RequestHeaders,
+		&i.RequestBody,
+		&i.RequestBodyKeyID,
+		&i.RequestBodyTruncated,
+		&i.ResponseStatus,
+		&i.ResponseHeaders,
+		&i.ResponseBody,
+		&i.ResponseBodyKeyID,
+		&i.ResponseBodyTruncated,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertAIBridgeModelPrices = `-- name: InsertAIBridgeModelPrices :exec
+INSERT INTO aibridge_model_prices (
+	provider, model, input_price_per_million_tokens, output_price_per_million_tokens, updated_at
+)
+SELECT
+	unnest($1::text[]),
+	unnest($2::text[]),
+	unnest($3::float8[]),
+	unnest($4::float8[]),
+	$5::timestamptz
+`
+
+type InsertAIBridgeModelPricesParams struct {
+	Provider                    []string  `db:"provider" json:"provider"`
+	Model                       []string  `db:"model" json:"model"`
+	InputPricePerMillionTokens  []float64 `db:"input_price_per_million_tokens" json:"input_price_per_million_tokens"`
+	OutputPricePerMillionTokens []float64 `db:"output_price_per_million_tokens" json:"output_price_per_million_tokens"`
+	UpdatedAt                   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertAIBridgeModelPrices(ctx context.Context, arg InsertAIBridgeModelPricesParams) error {
+	_, err := q.db.ExecContext(ctx, insertAIBridgeModelPrices,
+		pq.Array(arg.Provider),
+		pq.Array(arg.Model),
+		pq.Array(arg.InputPricePerMillionTokens),
+		pq.Array(arg.OutputPricePerMillionTokens),
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const insertAIBridgePolicyDecision = `-- name: InsertAIBridgePolicyDecision :one
+INSERT INTO aibridge_policy_decisions (
+  id, interception_id, policy, action, reason, created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, interception_id, policy, action, reason, created_at
+`
+
+type InsertAIBridgePolicyDecisionParams struct {
+	ID             uuid.UUID            `db:"id" json:"id"`
+	InterceptionID uuid.UUID            `db:"interception_id" json:"interception_id"`
+	Policy         string               `db:"policy" json:"policy"`
+	Action         AIBridgePolicyAction `db:"action" json:"action"`
+	Reason         string               `db:"reason" json:"reason"`
+	CreatedAt      time.Time            `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertAIBridgePolicyDecision(ctx context.Context, arg InsertAIBridgePolicyDecisionParams) (AIBridgePolicyDecision, error) {
+	row := q.db.QueryRowContext(ctx, insertAIBridgePolicyDecision,
+		arg.ID,
+		arg.InterceptionID,
+		arg.Policy,
+		arg.Action,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	var i AIBridgePolicyDecision
+	err := row.Scan(
+		&i.ID,
+		&i.InterceptionID,
+		&i.Policy,
+		&i.Action,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const insertAIBridgeTokenUsage = `-- name: InsertAIBridgeTokenUsage :one
 INSERT INTO aibridge_token_usages (
   id, interception_id, provider_response_id, input_tokens, output_tokens, metadata, created_at
@@ -573,6 +1126,48 @@ func (q *sqlQuerier) ListAIBridgeInterceptions(ctx context.Context, arg ListAIBr
 	return items, nil
 }
 
+const listAIBridgePolicyDecisionsByInterceptionIDs = `-- name: ListAIBridgePolicyDecisionsByInterceptionIDs :many
+SELECT
+	id, interception_id, policy, action, reason, created_at
+FROM
+	aibridge_policy_decisions
+WHERE
+	interception_id = ANY($1::uuid[])
+ORDER BY
+	created_at ASC,
+	id ASC
+`
+
+func (q *sqlQuerier) ListAIBridgePolicyDecisionsByInterceptionIDs(ctx context.Context, interceptionIds []uuid.UUID) ([]AIBridgePolicyDecision, error) {
+	rows, err := q.db.QueryContext(ctx, listAIBridgePolicyDecisionsByInterceptionIDs, pq.Array(interceptionIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AIBridgePolicyDecision
+	for rows.Next() {
+		var i AIBridgePolicyDecision
+		if err := rows.Scan(
+			&i.ID,
+			&i.InterceptionID,
+			&i.Policy,
+			&i.Action,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAIBridgeTokenUsagesByInterceptionIDs = `-- name: ListAIBridgeTokenUsagesByInterceptionIDs :many
 SELECT
 	id, interception_id, provider_response_id, input_tokens, output_tokens, metadata, created_at
@@ -704,6 +1299,173 @@ func (q *sqlQuerier) ListAIBridgeUserPromptsByInterceptionIDs(ctx context.Contex
 	return items, nil
 }
 
+const updateAIBridgeInterceptionCaptureBodies = `-- name: UpdateAIBridgeInterceptionCaptureBodies :exec
+UPDATE
+	aibridge_interception_captures
+SET
+	request_body = $1,
+	request_body_key_id = $2,
+	response_body = $3,
+	response_body_key_id = $4
+WHERE
+	interception_id = $5
+`
+
+type UpdateAIBridgeInterceptionCaptureBodiesParams struct {
+	RequestBody       string         `db:"request_body" json:"request_body"`
+	RequestBodyKeyID  sql.NullString `db:"request_body_key_id" json:"request_body_key_id"`
+	ResponseBody      string         `db:"response_body" json:"response_body"`
+	ResponseBodyKeyID sql.NullString `db:"response_body_key_id" json:"response_body_key_id"`
+	InterceptionID    uuid.UUID      `db:"interception_id" json:"interception_id"`
+}
+
+func (q *sqlQuerier) UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, arg UpdateAIBridgeInterceptionCaptureBodiesParams) error {
+	_, err := q.db.ExecContext(ctx, updateAIBridgeInterceptionCaptureBodies,
+		arg.RequestBody,
+		arg.RequestBodyKeyID,
+		arg.ResponseBody,
+		arg.ResponseBodyKeyID,
+		arg.InterceptionID,
+	)
+	return err
+}
+
+const upsertAIBridgeLimit = `-- name: UpsertAIBridgeLimit :one
+INSERT INTO aibridge_limits (
+	scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at
+) VALUES (
+	$1, $2, $3, $4, $5, $6, $6
+)
+ON CONFLICT (scope, scope_id) DO UPDATE SET
+	daily_token_budget = EXCLUDED.daily_token_budget,
+	monthly_token_budget = EXCLUDED.monthly_token_budget,
+	requests_per_minute = EXCLUDED.requests_per_minute,
+	updated_at = EXCLUDED.updated_at
+RETURNING scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at
+`
+
+type UpsertAIBridgeLimitParams struct {
+	Scope              AIBridgeLimitScope `db:"scope" json:"scope"`
+	ScopeID            uuid.UUID          `db:"scope_id" json:"scope_id"`
+	DailyTokenBudget   sql.NullInt64      `db:"daily_token_budget" json:"daily_token_budget"`
+	MonthlyTokenBudget sql.NullInt64      `db:"monthly_token_budget" json:"monthly_token_budget"`
+	RequestsPerMinute  sql.NullInt32      `db:"requests_per_minute" json:"requests_per_minute"`
+	UpdatedAt          time.Time          `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) UpsertAIBridgeLimit(ctx context.Context, arg UpsertAIBridgeLimitParams) (AIBridgeLimit, error) {
+	row := q.db.QueryRowContext(ctx, upsertAIBridgeLimit,
+		arg.Scope,
+		arg.ScopeID,
+		arg.DailyTokenBudget,
+		arg.MonthlyTokenBudget,
+		arg.RequestsPerMinute,
+		arg.UpdatedAt,
+	)
+	var i AIBridgeLimit
+	err := row.Scan(
+		&i.Scope,
+		&i.ScopeID,
+		&i.DailyTokenBudget,
+		&i.MonthlyTokenBudget,
+		&i.RequestsPerMinute,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertAIBridgeUsageStats = `-- name: UpsertAIBridgeUsageStats :exec
+WITH
+	latest_start AS (
+		SELECT
+			-- Truncate to hour so that we always look at even ranges of data.
+			date_trunc('hour', COALESCE(
+				MAX(start_time) - '1 hour'::interval,
+				-- Fallback when there are no AI Bridge usage stats yet.
+				(SELECT MIN(started_at) FROM aibridge_interceptions)
+			)) AS t
+		FROM
+			aibridge_usage_stats
+	),
+	request_buckets AS (
+		SELECT
+			date_trunc('hour', i.started_at) AS time_bucket,
+			i.initiator_id AS user_id,
+			CASE
+				WHEN i.metadata->>'template_id' ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+				THEN (i.metadata->>'template_id')::uuid
+				ELSE '00000000-0000-0000-0000-000000000000'::uuid
+			END AS template_id,
+			i.provider,
+			i.model,
+			COUNT(*) AS requests
+		FROM
+			aibridge_interceptions i
+		WHERE
+			i.started_at >= (SELECT t FROM latest_start)
+			AND i.started_at < NOW()
+		GROUP BY
+			time_bucket, user_id, template_id, i.provider, i.model
+	),
+	token_buckets AS (
+		SELECT
+			date_trunc('hour', tu.created_at) AS time_bucket,
+			i.initiator_id AS user_id,
+			CASE
+				WHEN i.metadata->>'template_id' ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+				THEN (i.metadata->>'template_id')::uuid
+				ELSE '00000000-0000-0000-0000-000000000000'::uuid
+			END AS template_id,
+			i.provider,
+			i.model,
+			SUM(tu.input_tokens) AS input_tokens,
+			SUM(tu.output_tokens) AS output_tokens
+		FROM
+			aibridge_token_usages tu
+		JOIN
+			aibridge_interceptions i ON i.id = tu.interception_id
+		WHERE
+			tu.created_at >= (SELECT t FROM latest_start)
+			AND tu.created_at < NOW()
+		GROUP BY
+			time_bucket, user_id, template_id, i.provider, i.model
+	)
+INSERT INTO aibridge_usage_stats (
+	start_time, user_id, template_id, provider, model, requests, input_tokens, output_tokens
+)
+SELECT
+	time_bucket,
+	user_id,
+	template_id,
+	provider,
+	model,
+	COALESCE(rb.requests, 0),
+	COALESCE(tb.input_tokens, 0),
+	COALESCE(tb.output_tokens, 0)
+FROM
+	request_buckets rb
+FULL OUTER JOIN
+	token_buckets tb USING (time_bucket, user_id, template_id, provider, model)
+ON CONFLICT
+	(start_time, user_id, template_id, provider, model)
+DO UPDATE SET
+	requests = EXCLUDED.requests,
+	input_tokens = EXCLUDED.input_tokens,
+	output_tokens = EXCLUDED.output_tokens
+`
+
+// This query aggregates AI Bridge interceptions and token usages into hourly
+// buckets per user, template, provider and model, and stores the result in the
+// aibridge_usage_stats table. The template is read from the interception
+// metadata, which is only set for requests authenticated with a workspace
+// session token. The latest hour and the one before it are always recomputed
+// so that tokens recorded after an interception started are included.
+func (q *sqlQuerier) UpsertAIBridgeUsageStats(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, upsertAIBridgeUsageStats)
+	return err
+}
+
 const deleteAPIKeyByID = `-- name: DeleteAPIKeyByID :exec
 DELETE FROM
 	api_keys
@@ -1234,9 +1996,183 @@ type DeleteOldAuditLogConnectionEventsParams struct {
 	LimitCount int32     `db:"limit_count" json:"limit_count"`
 }
 
-func (q *sqlQuerier) DeleteOldAuditLogConnectionEvents(ctx context.Context, arg DeleteOldAuditLogConnectionEventsParams) error {
-	_, err := q.db.ExecContext(ctx, deleteOldAuditLogConnectionEvents, arg.BeforeTime, arg.LimitCount)
-	return err
+func (q *sqlQuerier) DeleteOldAuditLogConnectionEvents(ctx context.Context, arg DeleteOldAuditLogConnectionEventsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOldAuditLogConnectionEvents, arg.BeforeTime, arg.LimitCount)
+	return err
+}
+
+const deleteOldAuditLogHashChainCheckpoints = `-- name: DeleteOldAuditLogHashChainCheckpoints :exec
+DELETE FROM audit_log_hash_chain_checkpoints
+WHERE seq < (
+    SELECT MAX(seq) FROM audit_log_hash_chain_checkpoints
+    WHERE seq < COALESCE((SELECT MIN(seq) FROM audit_log_hash_chain), 9223372036854775807)
+)
+`
+
+// Deletes the checkpoints that are no longer needed to verify the hash chain.
+// The latest checkpoint before the first remaining entry is kept, since the
+// chain continues from it.
+func (q *sqlQuerier) DeleteOldAuditLogHashChainCheckpoints(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteOldAuditLogHashChainCheckpoints)
+	return err
+}
+
+const deleteOldAuditLogs = `-- name: DeleteOldAuditLogs :execrows
+WITH old_audit_logs AS (
+    SELECT id FROM audit_logs
+    WHERE "time" < $1::timestamp with time zone
+    ORDER BY "time" ASC
+    LIMIT $2
+),
+deleted_hash_chain_entries AS (
+    DELETE FROM audit_log_hash_chain
+    WHERE audit_log_id IN (SELECT id FROM old_audit_logs)
+    RETURNING seq, previous_hash, hash
+),
+inserted_hash_chain_checkpoints AS (
+    INSERT INTO audit_log_hash_chain_checkpoints (seq, previous_hash, hash)
+    SELECT seq, previous_hash, hash FROM deleted_hash_chain_entries
+)
+DELETE FROM audit_logs
+WHERE id IN (SELECT id FROM old_audit_logs)
+`
+
+type DeleteOldAuditLogsParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+// Deletes the oldest audit logs recorded before the given time, together with
+// their hash chain entries. The links of the deleted entries are kept as
+// checkpoints, so that the remaining entries can still be verified even if
+// audit logs were not chained in the order of their timestamps.
+func (q *sqlQuerier) DeleteOldAuditLogs(ctx context.Context, arg DeleteOldAuditLogsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldAuditLogs, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAuditLogHashChainCheckpoints = `-- name: GetAuditLogHashChainCheckpoints :many
+SELECT seq, previous_hash, hash FROM audit_log_hash_chain_checkpoints
+WHERE seq > $1::bigint AND seq < $2::bigint
+ORDER BY seq ASC
+`
+
+type GetAuditLogHashChainCheckpointsParams struct {
+	AfterSeq  int64 `db:"after_seq" json:"after_seq"`
+	BeforeSeq int64 `db:"before_seq" json:"before_seq"`
+}
+
+// Returns the checkpoints of purged hash chain entries between the given
+// positions, in order.
+func (q *sqlQuerier) GetAuditLogHashChainCheckpoints(ctx context.Context, arg GetAuditLogHashChainCheckpointsParams) ([]AuditLogHashChainCheckpoint, error) {
+	rows, err := q.db.QueryContext(ctx, getAuditLogHashChainCheckpoints, arg.AfterSeq, arg.BeforeSeq)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLogHashChainCheckpoint
+	for rows.Next() {
+		var i AuditLogHashChainCheckpoint
+		if err := rows.Scan(&i.Seq, &i.PreviousHash, &i.Hash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditLogHashChainEntries = `-- name: GetAuditLogHashChainEntries :many
+SELECT seq, audit_log_id, previous_hash, hash FROM audit_log_hash_chain
+WHERE seq > $1::bigint
+ORDER BY seq ASC
+LIMIT $2::int
+`
+
+type GetAuditLogHashChainEntriesParams struct {
+	AfterSeq   int64 `db:"after_seq" json:"after_seq"`
+	LimitCount int32 `db:"limit_count" json:"limit_count"`
+}
+
+// Returns the entries of the audit log hash chain after the given position,
+// in order.
+func (q *sqlQuerier) GetAuditLogHashChainEntries(ctx context.Context, arg GetAuditLogHashChainEntriesParams) ([]AuditLogHashChain, error) {
+	rows, err := q.db.QueryContext(ctx, getAuditLogHashChainEntries, arg.AfterSeq, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLogHashChain
+	for rows.Next() {
+		var i AuditLogHashChain
+		if err := rows.Scan(
+			&i.Seq,
+			&i.AuditLogID,
+			&i.PreviousHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditLogsByIDs = `-- name: GetAuditLogsByIDs :many
+SELECT id, time, user_id, organization_id, ip, user_agent, resource_type, resource_id, resource_target, action, diff, status_code, additional_fields, request_id, resource_icon FROM audit_logs WHERE id = ANY($1::uuid[])
+`
+
+func (q *sqlQuerier) GetAuditLogsByIDs(ctx context.Context, ids []uuid.UUID) ([]AuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, getAuditLogsByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Time,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Ip,
+			&i.UserAgent,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.ResourceTarget,
+			&i.Action,
+			&i.Diff,
+			&i.StatusCode,
+			&i.AdditionalFields,
+			&i.RequestID,
+			&i.ResourceIcon,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getAuditLogsOffset = `-- name: GetAuditLogsOffset :many
@@ -1458,6 +2394,33 @@ func (q *sqlQuerier) GetAuditLogsOffset(ctx context.Context, arg GetAuditLogsOff
 	return items, nil
 }
 
+const getLatestAuditLogHashChainCheckpoint = `-- name: GetLatestAuditLogHashChainCheckpoint :one
+SELECT seq, previous_hash, hash FROM audit_log_hash_chain_checkpoints ORDER BY seq DESC LIMIT 1
+`
+
+func (q *sqlQuerier) GetLatestAuditLogHashChainCheckpoint(ctx context.Context) (AuditLogHashChainCheckpoint, error) {
+	row := q.db.QueryRowContext(ctx, getLatestAuditLogHashChainCheckpoint)
+	var i AuditLogHashChainCheckpoint
+	err := row.Scan(&i.Seq, &i.PreviousHash, &i.Hash)
+	return i, err
+}
+
+const getLatestAuditLogHashChainEntry = `-- name: GetLatestAuditLogHashChainEntry :one
+SELECT seq, audit_log_id, previous_hash, hash FROM audit_log_hash_chain ORDER BY seq DESC LIMIT 1
+`
+
+func (q *sqlQuerier) GetLatestAuditLogHashChainEntry(ctx context.Context) (AuditLogHashChain, error) {
+	row := q.db.QueryRowContext(ctx, getLatestAuditLogHashChainEntry)
+	var i AuditLogHashChain
+	err := row.Scan(
+		&i.Seq,
+		&i.AuditLogID,
+		&i.PreviousHash,
+		&i.Hash,
+	)
+	return i, err
+}
+
 const insertAuditLog = `-- name: InsertAuditLog :one
 INSERT INTO audit_logs (
 		id,
@@ -1553,6 +2516,257 @@ func (q *sqlQuerier) InsertAuditLog(ctx context.Context, arg InsertAuditLogParam
 	return i, err
 }
 
+const insertAuditLogHashChainEntry = `-- name: InsertAuditLogHashChainEntry :exec
+INSERT INTO audit_log_hash_chain (seq, audit_log_id, previous_hash, hash)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertAuditLogHashChainEntryParams struct {
+	Seq          int64     `db:"seq" json:"seq"`
+	AuditLogID   uuid.UUID `db:"audit_log_id" json:"audit_log_id"`
+	PreviousHash []byte    `db:"previous_hash" json:"previous_hash"`
+	Hash         []byte    `db:"hash" json:"hash"`
+}
+
+func (q *sqlQuerier) InsertAuditLogHashChainEntry(ctx context.Context, arg InsertAuditLogHashChainEntryParams) error {
+	_, err := q.db.ExecContext(ctx, insertAuditLogHashChainEntry,
+		arg.Seq,
+		arg.AuditLogID,
+		arg.PreviousHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const deleteAutostartExceptionCalendarByOrganizationIDAndName = `-- name: DeleteAutostartExceptionCalendarByOrganizationIDAndName :exec
+DELETE FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = $1
+	AND name = $2
+`
+
+type DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams struct {
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+}
+
+func (q *sqlQuerier) DeleteAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg DeleteAutostartExceptionCalendarByOrganizationIDAndNameParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAutostartExceptionCalendarByOrganizationIDAndName, arg.OrganizationID, arg.Name)
+	return err
+}
+
+const deleteWorkspaceAutostartExceptionOptOut = `-- name: DeleteWorkspaceAutostartExceptionOptOut :exec
+DELETE FROM
+	workspace_autostart_exception_opt_outs
+WHERE
+	workspace_id = $1
+`
+
+func (q *sqlQuerier) DeleteWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteWorkspaceAutostartExceptionOptOut, workspaceID)
+	return err
+}
+
+const getAutostartExceptionCalendarByOrganizationIDAndName = `-- name: GetAutostartExceptionCalendarByOrganizationIDAndName :one
+SELECT
+	id, organization_id, name, description, exceptions, created_at, updated_at
+FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = $1
+	AND name = $2
+`
+
+type GetAutostartExceptionCalendarByOrganizationIDAndNameParams struct {
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+}
+
+func (q *sqlQuerier) GetAutostartExceptionCalendarByOrganizationIDAndName(ctx context.Context, arg GetAutostartExceptionCalendarByOrganizationIDAndNameParams) (AutostartExceptionCalendar, error) {
+	row := q.db.QueryRowContext(ctx, getAutostartExceptionCalendarByOrganizationIDAndName, arg.OrganizationID, arg.Name)
+	var i AutostartExceptionCalendar
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.Exceptions,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAutostartExceptionCalendarsByOrganizationID = `-- name: GetAutostartExceptionCalendarsByOrganizationID :many
+SELECT
+	id, organization_id, name, description, exceptions, created_at, updated_at
+FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = $1
+ORDER BY
+	name ASC
+`
+
+func (q *sqlQuerier) GetAutostartExceptionCalendarsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]AutostartExceptionCalendar, error) {
+	rows, err := q.db.QueryContext(ctx, getAutostartExceptionCalendarsByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AutostartExceptionCalendar
+	for rows.Next() {
+		var i AutostartExceptionCalendar
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Description,
+			&i.Exceptions,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceAutostartExceptionOptOut = `-- name: GetWorkspaceAutostartExceptionOptOut :one
+SELECT EXISTS (
+	SELECT
+		1
+	FROM
+		workspace_autostart_exception_opt_outs
+	WHERE
+		workspace_id = $1
+) AS opted_out
+`
+
+func (q *sqlQuerier) GetWorkspaceAutostartExceptionOptOut(ctx context.Context, workspaceID uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceAutostartExceptionOptOut, workspaceID)
+	var opted_out bool
+	err := row.Scan(&opted_out)
+	return opted_out, err
+}
+
+const insertWorkspaceAutostartExceptionOptOut = `-- name: InsertWorkspaceAutostartExceptionOptOut :exec
+INSERT INTO workspace_autostart_exception_opt_outs (
+	workspace_id,
+	created_at
+) VALUES (
+	$1,
+	$2
+)
+ON CONFLICT (workspace_id) DO NOTHING
+`
+
+type InsertWorkspaceAutostartExceptionOptOutParams struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceAutostartExceptionOptOut(ctx context.Context, arg InsertWorkspaceAutostartExceptionOptOutParams) error {
+	_, err := q.db.ExecContext(ctx, insertWorkspaceAutostartExceptionOptOut, arg.WorkspaceID, arg.CreatedAt)
+	return err
+}
+
+const resetWorkspacesNextStartAtByOrganizationID = `-- name: ResetWorkspacesNextStartAtByOrganizationID :exec
+UPDATE
+	workspaces
+SET
+	next_start_at = NULL
+WHERE
+	organization_id = $1
+	AND deleted = false
+	AND (
+		autostart_schedule IS NOT NULL
+		OR EXISTS (
+			SELECT 1 FROM workspace_schedule_windows
+			WHERE workspace_schedule_windows.workspace_id = workspaces.id
+		)
+	)
+	AND next_start_at IS NOT NULL
+	-- Prebuilt workspaces (identified by having the prebuilds system user as owner_id)
+	-- are managed by the reconciliation loop, not the lifecycle executor which handles
+	-- next_start_at
+	AND owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::UUID
+`
+
+// Clears the cached next autostart time of every workspace in the organization
+// with an autostart schedule, so that the lifecycle executor recomputes it
+// against the organization's current autostart exception calendars.
+func (q *sqlQuerier) ResetWorkspacesNextStartAtByOrganizationID(ctx context.Context, organizationID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, resetWorkspacesNextStartAtByOrganizationID, organizationID)
+	return err
+}
+
+const upsertAutostartExceptionCalendar = `-- name: UpsertAutostartExceptionCalendar :one
+INSERT INTO autostart_exception_calendars (
+	id,
+	organization_id,
+	name,
+	description,
+	exceptions,
+	created_at,
+	updated_at
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7
+)
+ON CONFLICT (organization_id, name) DO UPDATE SET
+	description = EXCLUDED.description,
+	exceptions = EXCLUDED.exceptions,
+	updated_at = EXCLUDED.updated_at
+RETURNING id, organization_id, name, description, exceptions, created_at, updated_at
+`
+
+type UpsertAutostartExceptionCalendarParams struct {
+	ID             uuid.UUID       `db:"id" json:"id"`
+	OrganizationID uuid.UUID       `db:"organization_id" json:"organization_id"`
+	Name           string          `db:"name" json:"name"`
+	Description    string          `db:"description" json:"description"`
+	Exceptions     json.RawMessage `db:"exceptions" json:"exceptions"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) UpsertAutostartExceptionCalendar(ctx context.Context, arg UpsertAutostartExceptionCalendarParams) (AutostartExceptionCalendar, error) {
+	row := q.db.QueryRowContext(ctx, upsertAutostartExceptionCalendar,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Description,
+		arg.Exceptions,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i AutostartExceptionCalendar
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.Exceptions,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const countConnectionLogs = `-- name: CountConnectionLogs :one
 SELECT
 	COUNT(*) AS count
@@ -1697,6 +2911,29 @@ func (q *sqlQuerier) CountConnectionLogs(ctx context.Context, arg CountConnectio
 	return count, err
 }
 
+const deleteOldConnectionLogs = `-- name: DeleteOldConnectionLogs :execrows
+DELETE FROM connection_logs
+WHERE id IN (
+    SELECT id FROM connection_logs
+    WHERE connect_time < $1::timestamp with time zone
+    ORDER BY connect_time ASC
+    LIMIT $2
+)
+`
+
+type DeleteOldConnectionLogsParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) DeleteOldConnectionLogs(ctx context.Context, arg DeleteOldConnectionLogsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldConnectionLogs, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getConnectionLogsOffset = `-- name: GetConnectionLogsOffset :many
 SELECT
 	connection_logs.id, connection_logs.connect_time, connection_logs.organization_id, connection_logs.workspace_owner_id, connection_logs.workspace_id, connection_logs.workspace_name, connection_logs.agent_name, connection_logs.type, connection_logs.ip, connection_logs.code, connection_logs.user_agent, connection_logs.user_id, connection_logs.slug_or_port, connection_logs.connection_id, connection_logs.disconnect_time, connection_logs.disconnect_reason,
@@ -3559,6 +4796,123 @@ func (q *sqlQuerier) ValidateGroupIDs(ctx context.Context, groupIds []uuid.UUID)
 	return i, err
 }
 
+const getPrebuildInsights = `-- name: GetPrebuildInsights :many
+WITH claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		pc.hit,
+		pc.claim_latency_ms,
+		-- A workspace is ready once all of its agents are, so workspaces with
+		-- agents which are not ready yet have no time to ready.
+		(
+			SELECT
+				CASE WHEN bool_and(wa.ready_at IS NOT NULL) THEN EXTRACT(EPOCH FROM (MAX(wa.ready_at) - pc.created_at)) END
+			FROM workspace_builds wb
+			JOIN workspace_resources wr ON wr.job_id = wb.job_id
+			JOIN workspace_agents wa ON wa.resource_id = wr.id
+			WHERE
+				wb.id = pc.build_id
+				AND wa.parent_id IS NULL
+				AND NOT wa.deleted
+		)::float AS time_to_ready_secs
+	FROM prebuild_claims pc
+	JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE
+		pc.created_at >= $1::timestamptz
+		AND pc.created_at < $2::timestamptz
+		AND CASE WHEN COALESCE(array_length($3::uuid[], 1), 0) > 0 THEN tv.template_id = ANY($3::uuid[]) ELSE TRUE END
+)
+SELECT
+	c.template_id,
+	t.name AS template_name,
+	t.display_name AS template_display_name,
+	o.name AS organization_name,
+	c.preset_name,
+	COUNT(*)::int AS claim_attempts,
+	COUNT(*) FILTER (WHERE c.hit)::int AS claim_hits,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.claim_latency_ms)), -1)::float AS claim_latency_ms_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.claim_latency_ms)), -1)::float AS claim_latency_ms_95,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE c.hit)), -1)::float AS hit_time_to_ready_secs_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE c.hit)), -1)::float AS hit_time_to_ready_secs_95,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE NOT c.hit)), -1)::float AS miss_time_to_ready_secs_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE NOT c.hit)), -1)::float AS miss_time_to_ready_secs_95
+FROM claims c
+JOIN templates t ON t.id = c.template_id
+JOIN organizations o ON o.id = t.organization_id
+GROUP BY
+	c.template_id, t.name, t.display_name, o.name, c.preset_name
+ORDER BY
+	t.name ASC, c.preset_name ASC
+`
+
+type GetPrebuildInsightsParams struct {
+	StartTime   time.Time   `db:"start_time" json:"start_time"`
+	EndTime     time.Time   `db:"end_time" json:"end_time"`
+	TemplateIDs []uuid.UUID `db:"template_ids" json:"template_ids"`
+}
+
+type GetPrebuildInsightsRow struct {
+	TemplateID            uuid.UUID `db:"template_id" json:"template_id"`
+	TemplateName          string    `db:"template_name" json:"template_name"`
+	TemplateDisplayName   string    `db:"template_display_name" json:"template_display_name"`
+	OrganizationName      string    `db:"organization_name" json:"organization_name"`
+	PresetName            string    `db:"preset_name" json:"preset_name"`
+	ClaimAttempts         int32     `db:"claim_attempts" json:"claim_attempts"`
+	ClaimHits             int32     `db:"claim_hits" json:"claim_hits"`
+	ClaimLatencyMs50      float64   `db:"claim_latency_ms_50" json:"claim_latency_ms_50"`
+	ClaimLatencyMs95      float64   `db:"claim_latency_ms_95" json:"claim_latency_ms_95"`
+	HitTimeToReadySecs50  float64   `db:"hit_time_to_ready_secs_50" json:"hit_time_to_ready_secs_50"`
+	HitTimeToReadySecs95  float64   `db:"hit_time_to_ready_secs_95" json:"hit_time_to_ready_secs_95"`
+	MissTimeToReadySecs50 float64   `db:"miss_time_to_ready_secs_50" json:"miss_time_to_ready_secs_50"`
+	MissTimeToReadySecs95 float64   `db:"miss_time_to_ready_secs_95" json:"miss_time_to_ready_secs_95"`
+}
+
+// GetPrebuildInsights returns, for each preset, how many of the workspaces
+// created with it in the given timeframe were served from a prebuilt workspace,
+// along with the median and 95th percentile time taken to claim a prebuilt
+// workspace and until the workspace's agents were ready. Presets are matched
+// across template versions by name. The result can be filtered on
+// template_ids, meaning only workspaces based on those templates will be
+// included.
+func (q *sqlQuerier) GetPrebuildInsights(ctx context.Context, arg GetPrebuildInsightsParams) ([]GetPrebuildInsightsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPrebuildInsights, arg.StartTime, arg.EndTime, pq.Array(arg.TemplateIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPrebuildInsightsRow
+	for rows.Next() {
+		var i GetPrebuildInsightsRow
+		if err := rows.Scan(
+			&i.TemplateID,
+			&i.TemplateName,
+			&i.TemplateDisplayName,
+			&i.OrganizationName,
+			&i.PresetName,
+			&i.ClaimAttempts,
+			&i.ClaimHits,
+			&i.ClaimLatencyMs50,
+			&i.ClaimLatencyMs95,
+			&i.HitTimeToReadySecs50,
+			&i.HitTimeToReadySecs95,
+			&i.MissTimeToReadySecs50,
+			&i.MissTimeToReadySecs95,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTemplateAppInsights = `-- name: GetTemplateAppInsights :many
 WITH
 	-- Create a list of all unique apps by template, this is used to
@@ -5113,6 +6467,20 @@ WITH acquired AS (
                                  ELSE true
                                  END
                              )
+                           -- hold messages for users who have snoozed notifications until the snooze ends, unless
+                           -- they are urgent or destined for the inbox
+                           AND (
+                             nm.method = 'inbox'::notification_method
+                                 OR EXISTS (SELECT 1
+                                            FROM notification_templates AS t
+                                            WHERE t.id = nm.notification_template_id
+                                              AND t.urgent)
+                                 OR NOT EXISTS (SELECT 1
+                                                FROM user_configs AS uc
+                                                WHERE uc.user_id = nm.user_id
+                                                  AND uc.key = 'notification_snooze_until'
+                                                  AND NULLIF(uc.value, '')::timestamptz > NOW())
+                             )
                          ORDER BY nm.created_at ASC
                                   -- Ensure that multiple concurrent readers cannot retrieve the same rows
                              FOR UPDATE OF nm
@@ -5124,14 +6492,21 @@ SELECT
     nm.id,
     nm.payload,
     nm.method,
+    nm.user_id,
     nm.attempt_count::int                                                 AS attempt_count,
     nm.queued_seconds::float                                              AS queued_seconds,
     -- template
     nt.id                                                                 AS template_id,
-    nt.title_template,
-    nt.body_template,
+    COALESCE(nt.title_template_override, nt.title_template)::text         AS title_template,
+    COALESCE(nt.body_template_override, nt.body_template)::text           AS body_template,
+    nt.urgent                                                             AS urgent,
     -- preferences
-    (CASE WHEN np.disabled IS NULL THEN false ELSE np.disabled END)::bool AS disabled
+    (CASE WHEN np.disabled IS NULL THEN false ELSE np.disabled END)::bool AS disabled,
+    EXISTS (SELECT 1
+            FROM user_configs AS uc
+            WHERE uc.user_id = nm.user_id
+              AND uc.key = 'notification_quiet_hours'
+              AND uc.value = 'true')::bool                                AS quiet_hours
 FROM acquired nm
          JOIN notification_templates nt ON nm.notification_template_id = nt.id
          LEFT JOIN notification_preferences AS np
@@ -5149,12 +6524,15 @@ type AcquireNotificationMessagesRow struct {
 	ID            uuid.UUID          `db:"id" json:"id"`
 	Payload       json.RawMessage    `db:"payload" json:"payload"`
 	Method        NotificationMethod `db:"method" json:"method"`
+	UserID        uuid.UUID          `db:"user_id" json:"user_id"`
 	AttemptCount  int32              `db:"attempt_count" json:"attempt_count"`
 	QueuedSeconds float64            `db:"queued_seconds" json:"queued_seconds"`
 	TemplateID    uuid.UUID          `db:"template_id" json:"template_id"`
 	TitleTemplate string             `db:"title_template" json:"title_template"`
 	BodyTemplate  string             `db:"body_template" json:"body_template"`
+	Urgent        bool               `db:"urgent" json:"urgent"`
 	Disabled      bool               `db:"disabled" json:"disabled"`
+	QuietHours    bool               `db:"quiet_hours" json:"quiet_hours"`
 }
 
 // Acquires the lease for a given count of notification messages, to enable concurrent dequeuing and subsequent sending.
@@ -5185,12 +6563,15 @@ func (q *sqlQuerier) AcquireNotificationMessages(ctx context.Context, arg Acquir
 			&i.ID,
 			&i.Payload,
 			&i.Method,
+			&i.UserID,
 			&i.AttemptCount,
 			&i.QueuedSeconds,
 			&i.TemplateID,
 			&i.TitleTemplate,
 			&i.BodyTemplate,
+			&i.Urgent,
 			&i.Disabled,
+			&i.QuietHours,
 		); err != nil {
 			return nil, err
 		}
@@ -5277,6 +6658,29 @@ func (q *sqlQuerier) BulkMarkNotificationMessagesSent(ctx context.Context, arg B
 	return result.RowsAffected()
 }
 
+const deferNotificationMessage = `-- name: DeferNotificationMessage :exec
+UPDATE notification_messages
+SET updated_at       = NOW(),
+    status           = 'pending'::notification_message_status,
+    status_reason    = $1,
+    leased_until     = NULL,
+    next_retry_after = $2
+WHERE id = $3
+`
+
+type DeferNotificationMessageParams struct {
+	StatusReason   sql.NullString `db:"status_reason" json:"status_reason"`
+	NextRetryAfter sql.NullTime   `db:"next_retry_after" json:"next_retry_after"`
+	ID             uuid.UUID      `db:"id" json:"id"`
+}
+
+// Returns a leased message to the queue without counting a delivery attempt, so that it is not acquired again until
+// next_retry_after has passed.
+func (q *sqlQuerier) DeferNotificationMessage(ctx context.Context, arg DeferNotificationMessageParams) error {
+	_, err := q.db.ExecContext(ctx, deferNotificationMessage, arg.StatusReason, arg.NextRetryAfter, arg.ID)
+	return err
+}
+
 const deleteAllWebpushSubscriptions = `-- name: DeleteAllWebpushSubscriptions :exec
 TRUNCATE TABLE webpush_subscriptions
 `
@@ -5290,6 +6694,40 @@ func (q *sqlQuerier) DeleteAllWebpushSubscriptions(ctx context.Context) error {
 	return err
 }
 
+const deleteExpiringNotificationWebhookSecrets = `-- name: DeleteExpiringNotificationWebhookSecrets :exec
+DELETE
+FROM notification_webhook_secrets
+WHERE expires_at IS NOT NULL
+`
+
+// Delete all secrets which have been rotated out, regardless of whether their grace period has ended.
+// This is done before rotating so that no more than two secrets are ever accepted at once.
+func (q *sqlQuerier) DeleteExpiringNotificationWebhookSecrets(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiringNotificationWebhookSecrets)
+	return err
+}
+
+const deleteNotificationDigestMessages = `-- name: DeleteNotificationDigestMessages :exec
+DELETE FROM notification_digest_messages
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *sqlQuerier) DeleteNotificationDigestMessages(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteNotificationDigestMessages, pq.Array(ids))
+	return err
+}
+
+const deleteNotificationUserTargetByID = `-- name: DeleteNotificationUserTargetByID :exec
+DELETE
+FROM notification_user_targets
+WHERE id = $1
+`
+
+func (q *sqlQuerier) DeleteNotificationUserTargetByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteNotificationUserTargetByID, id)
+	return err
+}
+
 const deleteOldNotificationMessages = `-- name: DeleteOldNotificationMessages :exec
 DELETE
 FROM notification_messages
@@ -5367,6 +6805,18 @@ func (q *sqlQuerier) EnqueueNotificationMessage(ctx context.Context, arg Enqueue
 	return err
 }
 
+const expireActiveNotificationWebhookSecret = `-- name: ExpireActiveNotificationWebhookSecret :exec
+UPDATE notification_webhook_secrets
+SET expires_at = $1::timestamptz
+WHERE expires_at IS NULL
+`
+
+// Start the grace period of the active secret, which continues to sign deliveries until expires_at.
+func (q *sqlQuerier) ExpireActiveNotificationWebhookSecret(ctx context.Context, expiresAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, expireActiveNotificationWebhookSecret, expiresAt)
+	return err
+}
+
 const fetchNewMessageMetadata = `-- name: FetchNewMessageMetadata :one
 SELECT nt.name                                                    AS notification_name,
        nt.id                                                      AS notification_template_id,
@@ -5375,16 +6825,20 @@ SELECT nt.name                                                    AS notificatio
        u.id                                                       AS user_id,
        u.email                                                    AS user_email,
        COALESCE(NULLIF(u.name, ''), NULLIF(u.username, ''))::text AS user_name,
-       u.username                                                 AS user_username
-FROM notification_templates nt,
-     users u
-WHERE nt.id = $1
-  AND u.id = $2
+       u.username                                                 AS user_username,
+       np.user_target_id                                          AS user_target_id,
+       -- Messages which the user has disabled are never held for a digest, so that they are inhibited as usual.
+       (COALESCE(np.digest, FALSE) AND NOT COALESCE(np.disabled, FALSE))::bool AS digest
+FROM notification_templates nt
+         JOIN users u ON u.id = $1
+         LEFT JOIN notification_preferences np
+                   ON (np.user_id = u.id AND np.notification_template_id = nt.id)
+WHERE nt.id = $2
 `
 
 type FetchNewMessageMetadataParams struct {
-	NotificationTemplateID uuid.UUID `db:"notification_template_id" json:"notification_template_id"`
 	UserID                 uuid.UUID `db:"user_id" json:"user_id"`
+	NotificationTemplateID uuid.UUID `db:"notification_template_id" json:"notification_template_id"`
 }
 
 type FetchNewMessageMetadataRow struct {
@@ -5396,11 +6850,13 @@ type FetchNewMessageMetadataRow struct {
 	UserEmail              string                 `db:"user_email" json:"user_email"`
 	UserName               string                 `db:"user_name" json:"user_name"`
 	UserUsername           string                 `db:"user_username" json:"user_username"`
+	UserTargetID           uuid.NullUUID          `db:"user_target_id" json:"user_target_id"`
+	Digest                 bool                   `db:"digest" json:"digest"`
 }
 
 // This is used to build up the notification_message's JSON payload.
 func (q *sqlQuerier) FetchNewMessageMetadata(ctx context.Context, arg FetchNewMessageMetadataParams) (FetchNewMessageMetadataRow, error) {
-	row := q.db.QueryRowContext(ctx, fetchNewMessageMetadata, arg.NotificationTemplateID, arg.UserID)
+	row := q.db.QueryRowContext(ctx, fetchNewMessageMetadata, arg.UserID, arg.NotificationTemplateID)
 	var i FetchNewMessageMetadataRow
 	err := row.Scan(
 		&i.NotificationName,
@@ -5411,10 +6867,56 @@ func (q *sqlQuerier) FetchNewMessageMetadata(ctx context.Context, arg FetchNewMe
 		&i.UserEmail,
 		&i.UserName,
 		&i.UserUsername,
+		&i.UserTargetID,
+		&i.Digest,
 	)
 	return i, err
 }
 
+const getDueNotificationDigestMessages = `-- name: GetDueNotificationDigestMessages :many
+SELECT id, notification_template_id, user_id, method, payload, targets, created_by, created_at
+FROM notification_digest_messages
+WHERE (user_id, notification_template_id, method) IN (SELECT user_id, notification_template_id, method
+                                                      FROM notification_digest_messages
+                                                      GROUP BY user_id, notification_template_id, method
+                                                      HAVING MIN(created_at) <= $1::timestamptz)
+ORDER BY user_id, notification_template_id, method, created_at
+`
+
+// Returns all held messages which belong to a digest whose oldest message was held at or before the given time.
+// Messages are ordered so that each digest's messages are contiguous, oldest first.
+func (q *sqlQuerier) GetDueNotificationDigestMessages(ctx context.Context, heldBefore time.Time) ([]NotificationDigestMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getDueNotificationDigestMessages, heldBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationDigestMessage
+	for rows.Next() {
+		var i NotificationDigestMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.NotificationTemplateID,
+			&i.UserID,
+			&i.Method,
+			&i.Payload,
+			pq.Array(&i.Targets),
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getNotificationMessagesByStatus = `-- name: GetNotificationMessagesByStatus :many
 SELECT id, notification_template_id, user_id, method, status, status_reason, created_by, payload, attempt_count, targets, created_at, updated_at, leased_until, next_retry_after, queued_seconds, dedupe_hash
 FROM notification_messages
@@ -5485,7 +6987,7 @@ func (q *sqlQuerier) GetNotificationReportGeneratorLogByTemplate(ctx context.Con
 }
 
 const getNotificationTemplateByID = `-- name: GetNotificationTemplateByID :one
-SELECT id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default
+SELECT id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default, title_template_override, body_template_override, urgent
 FROM notification_templates
 WHERE id = $1::uuid
 `
@@ -5503,12 +7005,15 @@ func (q *sqlQuerier) GetNotificationTemplateByID(ctx context.Context, id uuid.UU
 		&i.Method,
 		&i.Kind,
 		&i.EnabledByDefault,
+		&i.TitleTemplateOverride,
+		&i.BodyTemplateOverride,
+		&i.Urgent,
 	)
 	return i, err
 }
 
 const getNotificationTemplatesByKind = `-- name: GetNotificationTemplatesByKind :many
-SELECT id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default
+SELECT id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default, title_template_override, body_template_override, urgent
 FROM notification_templates
 WHERE kind = $1::notification_template_kind
 ORDER BY name ASC
@@ -5533,6 +7038,140 @@ func (q *sqlQuerier) GetNotificationTemplatesByKind(ctx context.Context, kind No
 			&i.Method,
 			&i.Kind,
 			&i.EnabledByDefault,
+			&i.TitleTemplateOverride,
+			&i.BodyTemplateOverride,
+			&i.Urgent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationUserTargetByID = `-- name: GetNotificationUserTargetByID :one
+SELECT id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at
+FROM notification_user_targets
+WHERE id = $1::uuid
+`
+
+func (q *sqlQuerier) GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (NotificationUserTarget, error) {
+	row := q.db.QueryRowContext(ctx, getNotificationUserTargetByID, id)
+	var i NotificationUserTarget
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.Endpoint,
+		&i.Secret,
+		&i.SecretKeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getNotificationUserTargetByPreference = `-- name: GetNotificationUserTargetByPreference :one
+SELECT nut.id, nut.user_id, nut.name, nut.type, nut.endpoint, nut.secret, nut.secret_key_id, nut.created_at, nut.updated_at
+FROM notification_user_targets nut
+         JOIN notification_preferences np
+              ON (np.user_target_id = nut.id AND np.user_id = nut.user_id)
+WHERE np.user_id = $1::uuid
+  AND np.notification_template_id = $2::uuid
+`
+
+type GetNotificationUserTargetByPreferenceParams struct {
+	UserID                 uuid.UUID `db:"user_id" json:"user_id"`
+	NotificationTemplateID uuid.UUID `db:"notification_template_id" json:"notification_template_id"`
+}
+
+// Fetch the user-defined target which the user has chosen for the given notification template.
+func (q *sqlQuerier) GetNotificationUserTargetByPreference(ctx context.Context, arg GetNotificationUserTargetByPreferenceParams) (NotificationUserTarget, error) {
+	row := q.db.QueryRowContext(ctx, getNotificationUserTargetByPreference, arg.UserID, arg.NotificationTemplateID)
+	var i NotificationUserTarget
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.Endpoint,
+		&i.Secret,
+		&i.SecretKeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getNotificationUserTargetsByUserID = `-- name: GetNotificationUserTargetsByUserID :many
+SELECT id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at
+FROM notification_user_targets
+WHERE user_id = $1::uuid
+ORDER BY name ASC
+`
+
+func (q *sqlQuerier) GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]NotificationUserTarget, error) {
+	rows, err := q.db.QueryContext(ctx, getNotificationUserTargetsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationUserTarget
+	for rows.Next() {
+		var i NotificationUserTarget
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Type,
+			&i.Endpoint,
+			&i.Secret,
+			&i.SecretKeyID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationWebhookSecrets = `-- name: GetNotificationWebhookSecrets :many
+SELECT id, secret, secret_key_id, created_at, expires_at
+FROM notification_webhook_secrets
+ORDER BY created_at DESC
+`
+
+// Fetch all webhook signing secrets, newest first. This includes secrets whose grace period has ended.
+func (q *sqlQuerier) GetNotificationWebhookSecrets(ctx context.Context) ([]NotificationWebhookSecret, error) {
+	rows, err := q.db.QueryContext(ctx, getNotificationWebhookSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationWebhookSecret
+	for rows.Next() {
+		var i NotificationWebhookSecret
+		if err := rows.Scan(
+			&i.ID,
+			&i.Secret,
+			&i.SecretKeyID,
+			&i.CreatedAt,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -5548,7 +7187,7 @@ func (q *sqlQuerier) GetNotificationTemplatesByKind(ctx context.Context, kind No
 }
 
 const getUserNotificationPreferences = `-- name: GetUserNotificationPreferences :many
-SELECT user_id, notification_template_id, disabled, created_at, updated_at
+SELECT user_id, notification_template_id, disabled, created_at, updated_at, user_target_id, digest
 FROM notification_preferences
 WHERE user_id = $1::uuid
 `
@@ -5568,6 +7207,8 @@ func (q *sqlQuerier) GetUserNotificationPreferences(ctx context.Context, userID
 			&i.Disabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UserTargetID,
+			&i.Digest,
 		); err != nil {
 			return nil, err
 		}
@@ -5618,6 +7259,119 @@ func (q *sqlQuerier) GetWebpushSubscriptionsByUserID(ctx context.Context, userID
 	return items, nil
 }
 
+const insertNotificationDigestMessage = `-- name: InsertNotificationDigestMessage :exec
+INSERT INTO notification_digest_messages (id, notification_template_id, user_id, method, payload, targets, created_by, created_at)
+VALUES ($1,
+        $2,
+        $3,
+        $4::notification_method,
+        $5::jsonb,
+        $6,
+        $7,
+        $8)
+`
+
+type InsertNotificationDigestMessageParams struct {
+	ID                     uuid.UUID          `db:"id" json:"id"`
+	NotificationTemplateID uuid.UUID          `db:"notification_template_id" json:"notification_template_id"`
+	UserID                 uuid.UUID          `db:"user_id" json:"user_id"`
+	Method                 NotificationMethod `db:"method" json:"method"`
+	Payload                json.RawMessage    `db:"payload" json:"payload"`
+	Targets                []uuid.UUID        `db:"targets" json:"targets"`
+	CreatedBy              string             `db:"created_by" json:"created_by"`
+	CreatedAt              time.Time          `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertNotificationDigestMessage(ctx context.Context, arg InsertNotificationDigestMessageParams) error {
+	_, err := q.db.ExecContext(ctx, insertNotificationDigestMessage,
+		arg.ID,
+		arg.NotificationTemplateID,
+		arg.UserID,
+		arg.Method,
+		arg.Payload,
+		pq.Array(arg.Targets),
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertNotificationUserTarget = `-- name: InsertNotificationUserTarget :one
+INSERT INTO notification_user_targets (id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at
+`
+
+type InsertNotificationUserTargetParams struct {
+	ID          uuid.UUID                  `db:"id" json:"id"`
+	UserID      uuid.UUID                  `db:"user_id" json:"user_id"`
+	Name        string                     `db:"name" json:"name"`
+	Type        NotificationUserTargetType `db:"type" json:"type"`
+	Endpoint    string                     `db:"endpoint" json:"endpoint"`
+	Secret      string                     `db:"secret" json:"secret"`
+	SecretKeyID sql.NullString             `db:"secret_key_id" json:"secret_key_id"`
+	CreatedAt   time.Time                  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time                  `db:"updated_at" json:"updated_at"`
+}
+
+func (q *sqlQuerier) InsertNotificationUserTarget(ctx context.Context, arg InsertNotificationUserTargetParams) (NotificationUserTarget, error) {
+	row := q.db.QueryRowContext(ctx, insertNotificationUserTarget,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Type,
+		arg.Endpoint,
+		arg.Secret,
+		arg.SecretKeyID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i NotificationUserTarget
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.Endpoint,
+		&i.Secret,
+		&i.SecretKeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const insertNotificationWebhookSecret = `-- name: InsertNotificationWebhookSecret :one
+INSERT INTO notification_webhook_secrets (id, secret, secret_key_id, created_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, secret, secret_key_id, created_at, expires_at
+`
+
+type InsertNotificationWebhookSecretParams struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	Secret      string         `db:"secret" json:"secret"`
+	SecretKeyID sql.NullString `db:"secret_key_id" json:"secret_key_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertNotificationWebhookSecret(ctx context.Context, arg InsertNotificationWebhookSecretParams) (NotificationWebhookSecret, error) {
+	row := q.db.QueryRowContext(ctx, insertNotificationWebhookSecret,
+		arg.ID,
+		arg.Secret,
+		arg.SecretKeyID,
+		arg.CreatedAt,
+	)
+	var i NotificationWebhookSecret
+	err := row.Scan(
+		&i.ID,
+		&i.Secret,
+		&i.SecretKeyID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const insertWebpushSubscription = `-- name: InsertWebpushSubscription :one
 INSERT INTO webpush_subscriptions (user_id, created_at, endpoint, endpoint_p256dh_key, endpoint_auth_key)
 VALUES ($1, $2, $3, $4, $5)
@@ -5656,7 +7410,7 @@ const updateNotificationTemplateMethodByID = `-- name: UpdateNotificationTemplat
 UPDATE notification_templates
 SET method = $1::notification_method
 WHERE id = $2::uuid
-RETURNING id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default
+RETURNING id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default, title_template_override, body_template_override, urgent
 `
 
 type UpdateNotificationTemplateMethodByIDParams struct {
@@ -5677,10 +7431,191 @@ func (q *sqlQuerier) UpdateNotificationTemplateMethodByID(ctx context.Context, a
 		&i.Method,
 		&i.Kind,
 		&i.EnabledByDefault,
+		&i.TitleTemplateOverride,
+		&i.BodyTemplateOverride,
+		&i.Urgent,
+	)
+	return i, err
+}
+
+const updateNotificationTemplateOverridesByID = `-- name: UpdateNotificationTemplateOverridesByID :one
+UPDATE notification_templates
+SET title_template_override = $1,
+    body_template_override  = $2
+WHERE id = $3::uuid
+RETURNING id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default, title_template_override, body_template_override, urgent
+`
+
+type UpdateNotificationTemplateOverridesByIDParams struct {
+	TitleTemplateOverride sql.NullString `db:"title_template_override" json:"title_template_override"`
+	BodyTemplateOverride  sql.NullString `db:"body_template_override" json:"body_template_override"`
+	ID                    uuid.UUID      `db:"id" json:"id"`
+}
+
+// Overrides the title and body templates for this deployment. NULL values fall back to the built-in templates.
+func (q *sqlQuerier) UpdateNotificationTemplateOverridesByID(ctx context.Context, arg UpdateNotificationTemplateOverridesByIDParams) (NotificationTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updateNotificationTemplateOverridesByID, arg.TitleTemplateOverride, arg.BodyTemplateOverride, arg.ID)
+	var i NotificationTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.TitleTemplate,
+		&i.BodyTemplate,
+		&i.Actions,
+		&i.Group,
+		&i.Method,
+		&i.Kind,
+		&i.EnabledByDefault,
+		&i.TitleTemplateOverride,
+		&i.BodyTemplateOverride,
+		&i.Urgent,
+	)
+	return i, err
+}
+
+const updateNotificationTemplateUrgencyByID = `-- name: UpdateNotificationTemplateUrgencyByID :one
+UPDATE notification_templates
+SET urgent = $1::bool
+WHERE id = $2::uuid
+RETURNING id, name, title_template, body_template, actions, "group", method, kind, enabled_by_default, title_template_override, body_template_override, urgent
+`
+
+type UpdateNotificationTemplateUrgencyByIDParams struct {
+	Urgent bool      `db:"urgent" json:"urgent"`
+	ID     uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateNotificationTemplateUrgencyByID(ctx context.Context, arg UpdateNotificationTemplateUrgencyByIDParams) (NotificationTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updateNotificationTemplateUrgencyByID, arg.Urgent, arg.ID)
+	var i NotificationTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.TitleTemplate,
+		&i.BodyTemplate,
+		&i.Actions,
+		&i.Group,
+		&i.Method,
+		&i.Kind,
+		&i.EnabledByDefault,
+		&i.TitleTemplateOverride,
+		&i.BodyTemplateOverride,
+		&i.Urgent,
+	)
+	return i, err
+}
+
+const updateNotificationUserTargetSecret = `-- name: UpdateNotificationUserTargetSecret :one
+UPDATE notification_user_targets
+SET secret        = $1,
+    secret_key_id = $2,
+    updated_at    = $3
+WHERE id = $4
+RETURNING id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at
+`
+
+type UpdateNotificationUserTargetSecretParams struct {
+	Secret      string         `db:"secret" json:"secret"`
+	SecretKeyID sql.NullString `db:"secret_key_id" json:"secret_key_id"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateNotificationUserTargetSecret(ctx context.Context, arg UpdateNotificationUserTargetSecretParams) (NotificationUserTarget, error) {
+	row := q.db.QueryRowContext(ctx, updateNotificationUserTargetSecret,
+		arg.Secret,
+		arg.SecretKeyID,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i NotificationUserTarget
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.Endpoint,
+		&i.Secret,
+		&i.SecretKeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const updateNotificationWebhookSecret = `-- name: UpdateNotificationWebhookSecret :exec
+UPDATE notification_webhook_secrets
+SET secret        = $1,
+    secret_key_id = $2
+WHERE id = $3
+`
+
+type UpdateNotificationWebhookSecretParams struct {
+	Secret      string         `db:"secret" json:"secret"`
+	SecretKeyID sql.NullString `db:"secret_key_id" json:"secret_key_id"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateNotificationWebhookSecret(ctx context.Context, arg UpdateNotificationWebhookSecretParams) error {
+	_, err := q.db.ExecContext(ctx, updateNotificationWebhookSecret, arg.Secret, arg.SecretKeyID, arg.ID)
+	return err
+}
+
+const updateUserNotificationPreferenceDigest = `-- name: UpdateUserNotificationPreferenceDigest :execrows
+INSERT
+INTO notification_preferences (user_id, notification_template_id, disabled, digest)
+SELECT $1::uuid, nt.id, NOT nt.enabled_by_default, $2::bool
+FROM notification_templates nt
+WHERE nt.id = $3::uuid
+ON CONFLICT (user_id, notification_template_id) DO UPDATE
+    SET digest     = EXCLUDED.digest,
+        updated_at = CURRENT_TIMESTAMP
+`
+
+type UpdateUserNotificationPreferenceDigestParams struct {
+	UserID                 uuid.UUID `db:"user_id" json:"user_id"`
+	Digest                 bool      `db:"digest" json:"digest"`
+	NotificationTemplateID uuid.UUID `db:"notification_template_id" json:"notification_template_id"`
+}
+
+// Sets whether notifications from a single template are delivered to the user as a digest.
+// As with targets, a new preference row inherits the template's default enablement.
+func (q *sqlQuerier) UpdateUserNotificationPreferenceDigest(ctx context.Context, arg UpdateUserNotificationPreferenceDigestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserNotificationPreferenceDigest, arg.UserID, arg.Digest, arg.NotificationTemplateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateUserNotificationPreferenceTarget = `-- name: UpdateUserNotificationPreferenceTarget :execrows
+INSERT
+INTO notification_preferences (user_id, notification_template_id, disabled, user_target_id)
+SELECT $1::uuid, nt.id, NOT nt.enabled_by_default, $2::uuid
+FROM notification_templates nt
+WHERE nt.id = $3::uuid
+ON CONFLICT (user_id, notification_template_id) DO UPDATE
+    SET user_target_id = EXCLUDED.user_target_id,
+        updated_at     = CURRENT_TIMESTAMP
+`
+
+type UpdateUserNotificationPreferenceTargetParams struct {
+	UserID                 uuid.UUID     `db:"user_id" json:"user_id"`
+	UserTargetID           uuid.NullUUID `db:"user_target_id" json:"user_target_id"`
+	NotificationTemplateID uuid.UUID     `db:"notification_template_id" json:"notification_template_id"`
+}
+
+// Sets the user-defined target for a single notification template, or clears it when NULL.
+// A new preference row inherits the template's default enablement so that choosing a target
+// does not implicitly opt the user in to a notification which is disabled by default.
+func (q *sqlQuerier) UpdateUserNotificationPreferenceTarget(ctx context.Context, arg UpdateUserNotificationPreferenceTargetParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserNotificationPreferenceTarget, arg.UserID, arg.UserTargetID, arg.NotificationTemplateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateUserNotificationPreferences = `-- name: UpdateUserNotificationPreferences :execrows
 INSERT
 INTO notification_preferences (user_id, notification_template_id, disabled)
@@ -6167,7 +8102,7 @@ func (q *sqlQuerier) GetOAuth2ProviderAppByRegistrationToken(ctx context.Context
 }
 
 const getOAuth2ProviderAppCodeByID = `-- name: GetOAuth2ProviderAppCodeByID :one
-SELECT id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method FROM oauth2_provider_app_codes WHERE id = $1
+SELECT id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method, scopes FROM oauth2_provider_app_codes WHERE id = $1
 `
 
 func (q *sqlQuerier) GetOAuth2ProviderAppCodeByID(ctx context.Context, id uuid.UUID) (OAuth2ProviderAppCode, error) {
@@ -6184,12 +8119,13 @@ func (q *sqlQuerier) GetOAuth2ProviderAppCodeByID(ctx context.Context, id uuid.U
 		&i.ResourceUri,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
+		&i.Scopes,
 	)
 	return i, err
 }
 
 const getOAuth2ProviderAppCodeByPrefix = `-- name: GetOAuth2ProviderAppCodeByPrefix :one
-SELECT id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method FROM oauth2_provider_app_codes WHERE secret_prefix = $1
+SELECT id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method, scopes FROM oauth2_provider_app_codes WHERE secret_prefix = $1
 `
 
 func (q *sqlQuerier) GetOAuth2ProviderAppCodeByPrefix(ctx context.Context, secretPrefix []byte) (OAuth2ProviderAppCode, error) {
@@ -6206,6 +8142,7 @@ func (q *sqlQuerier) GetOAuth2ProviderAppCodeByPrefix(ctx context.Context, secre
 		&i.ResourceUri,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
+		&i.Scopes,
 	)
 	return i, err
 }
@@ -6609,7 +8546,8 @@ INSERT INTO oauth2_provider_app_codes (
     user_id,
     resource_uri,
     code_challenge,
-    code_challenge_method
+    code_challenge_method,
+    scopes
 ) VALUES(
     $1,
     $2,
@@ -6620,8 +8558,9 @@ INSERT INTO oauth2_provider_app_codes (
     $7,
     $8,
     $9,
-    $10
-) RETURNING id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method
+    $10,
+    $11
+) RETURNING id, created_at, expires_at, secret_prefix, hashed_secret, user_id, app_id, resource_uri, code_challenge, code_challenge_method, scopes
 `
 
 type InsertOAuth2ProviderAppCodeParams struct {
@@ -6635,6 +8574,7 @@ type InsertOAuth2ProviderAppCodeParams struct {
 	ResourceUri         sql.NullString `db:"resource_uri" json:"resource_uri"`
 	CodeChallenge       sql.NullString `db:"code_challenge" json:"code_challenge"`
 	CodeChallengeMethod sql.NullString `db:"code_challenge_method" json:"code_challenge_method"`
+	Scopes              APIKeyScopes   `db:"scopes" json:"scopes"`
 }
 
 func (q *sqlQuerier) InsertOAuth2ProviderAppCode(ctx context.Context, arg InsertOAuth2ProviderAppCodeParams) (OAuth2ProviderAppCode, error) {
@@ -6649,6 +8589,7 @@ func (q *sqlQuerier) InsertOAuth2ProviderAppCode(ctx context.Context, arg Insert
 		arg.ResourceUri,
 		arg.CodeChallenge,
 		arg.CodeChallengeMethod,
+		arg.Scopes,
 	)
 	var i OAuth2ProviderAppCode
 	err := row.Scan(
@@ -6662,6 +8603,7 @@ func (q *sqlQuerier) InsertOAuth2ProviderAppCode(ctx context.Context, arg Insert
 		&i.ResourceUri,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
+		&i.Scopes,
 	)
 	return i, err
 }
@@ -7880,6 +9822,29 @@ func (q *sqlQuerier) CountInProgressPrebuilds(ctx context.Context) ([]CountInPro
 	return items, nil
 }
 
+const deleteOldPrebuildClaims = `-- name: DeleteOldPrebuildClaims :execrows
+DELETE FROM prebuild_claims
+WHERE id IN (
+	SELECT id FROM prebuild_claims
+	WHERE created_at < $1::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT $2
+)
+`
+
+type DeleteOldPrebuildClaimsParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) DeleteOldPrebuildClaims(ctx context.Context, arg DeleteOldPrebuildClaimsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldPrebuildClaims, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const findMatchingPresetID = `-- name: FindMatchingPresetID :one
 WITH provided_params AS (
 	SELECT
@@ -7921,38 +9886,169 @@ func (q *sqlQuerier) FindMatchingPresetID(ctx context.Context, arg FindMatchingP
 	return template_version_preset_id, err
 }
 
+const getPrebuildClaimDemand = `-- name: GetPrebuildClaimDemand :many
+WITH claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		COUNT(*) AS claim_attempts,
+		COUNT(*) FILTER (WHERE NOT pc.hit) AS claim_misses
+	FROM prebuild_claims pc
+	INNER JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE pc.created_at >= $1::timestamptz
+	GROUP BY tv.template_id, tvp.name
+),
+build_times AS (
+	-- Only successful builds of prebuilt workspaces tell us how long it takes to replace a claimed one.
+	-- Presets without a successful build since the given time fall back to their earlier builds.
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) FILTER (WHERE pj.completed_at >= $1::timestamptz) AS recent_build_secs,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) AS build_secs
+	FROM workspace_prebuild_builds wpb
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE wpb.transition = 'start'::workspace_transition
+		AND pj.job_status = 'succeeded'::provisioner_job_status
+	GROUP BY tv.template_id, tvp.name
+)
+SELECT
+	claims.template_id,
+	claims.preset_name,
+	claims.claim_attempts::int AS claim_attempts,
+	claims.claim_misses::int AS claim_misses,
+	COALESCE(build_times.recent_build_secs, build_times.build_secs, 0)::float8 AS average_build_secs
+FROM claims
+LEFT JOIN build_times ON build_times.template_id = claims.template_id
+	AND build_times.preset_name = claims.preset_name
+`
+
+type GetPrebuildClaimDemandRow struct {
+	TemplateID       uuid.UUID `db:"template_id" json:"template_id"`
+	PresetName       string    `db:"preset_name" json:"preset_name"`
+	ClaimAttempts    int32     `db:"claim_attempts" json:"claim_attempts"`
+	ClaimMisses      int32     `db:"claim_misses" json:"claim_misses"`
+	AverageBuildSecs float64   `db:"average_build_secs" json:"average_build_secs"`
+}
+
+// GetPrebuildClaimDemand returns the demand for the prebuilt workspaces of each preset since the given time:
+// the number of attempts to claim one, how many of those found none to claim, and the average time taken
+// to build a prebuilt workspace, or 0 if the preset was never built. Presets are matched across template versions by name, so that the demand
+// observed for a preset carries over to new versions of its template.
+func (q *sqlQuerier) GetPrebuildClaimDemand(ctx context.Context, since time.Time) ([]GetPrebuildClaimDemandRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPrebuildClaimDemand, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPrebuildClaimDemandRow
+	for rows.Next() {
+		var i GetPrebuildClaimDemandRow
+		if err := rows.Scan(
+			&i.TemplateID,
+			&i.PresetName,
+			&i.ClaimAttempts,
+			&i.ClaimMisses,
+			&i.AverageBuildSecs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPrebuildMetrics = `-- name: GetPrebuildMetrics :many
+WITH prebuild_counts AS (
+	SELECT
+		t.id as template_id,
+		t.name as template_name,
+		tvp.name as preset_name,
+		o.name as organization_name,
+		COUNT(*) as created_count,
+		COUNT(*) FILTER (WHERE pj.job_status = 'failed'::provisioner_job_status) as failed_count,
+		COUNT(*) FILTER (
+				WHERE w.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The system user responsible for prebuilds.
+			) as claimed_count
+	FROM workspaces w
+	INNER JOIN workspace_prebuild_builds wpb ON wpb.workspace_id = w.id
+	INNER JOIN templates t ON t.id = w.template_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN organizations o ON o.id = w.organization_id
+	WHERE NOT t.deleted AND wpb.build_number = 1
+	GROUP BY t.id, t.name, tvp.name, o.name
+),
+claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		COUNT(*) AS claim_attempts,
+		COUNT(*) FILTER (WHERE NOT pc.hit) AS claim_misses
+	FROM prebuild_claims pc
+	INNER JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE pc.created_at >= $1::timestamptz
+	GROUP BY tv.template_id, tvp.name
+),
+build_times AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) FILTER (WHERE pj.completed_at >= $1::timestamptz) AS recent_build_secs,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) AS build_secs
+	FROM workspace_prebuild_builds wpb
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE wpb.transition = 'start'::workspace_transition
+		AND pj.job_status = 'succeeded'::provisioner_job_status
+	GROUP BY tv.template_id, tvp.name
+)
 SELECT
-	t.name as template_name,
-	tvp.name as preset_name,
-	o.name as organization_name,
-	COUNT(*) as created_count,
-	COUNT(*) FILTER (WHERE pj.job_status = 'failed'::provisioner_job_status) as failed_count,
-	COUNT(*) FILTER (
-			WHERE w.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The system user responsible for prebuilds.
-		) as claimed_count
-FROM workspaces w
-INNER JOIN workspace_prebuild_builds wpb ON wpb.workspace_id = w.id
-INNER JOIN templates t ON t.id = w.template_id
-INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
-INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
-INNER JOIN organizations o ON o.id = w.organization_id
-WHERE NOT t.deleted AND wpb.build_number = 1
-GROUP BY t.name, tvp.name, o.name
-ORDER BY t.name, tvp.name, o.name
+	prebuild_counts.template_name,
+	prebuild_counts.preset_name,
+	prebuild_counts.organization_name,
+	prebuild_counts.created_count,
+	prebuild_counts.failed_count,
+	prebuild_counts.claimed_count,
+	COALESCE(claims.claim_attempts, 0)::int AS claim_attempts,
+	COALESCE(claims.claim_misses, 0)::int AS claim_misses,
+	COALESCE(build_times.recent_build_secs, build_times.build_secs, 0)::float8 AS average_build_secs
+FROM prebuild_counts
+LEFT JOIN claims ON claims.template_id = prebuild_counts.template_id
+	AND claims.preset_name = prebuild_counts.preset_name
+LEFT JOIN build_times ON build_times.template_id = prebuild_counts.template_id
+	AND build_times.preset_name = prebuild_counts.preset_name
+ORDER BY prebuild_counts.template_name, prebuild_counts.preset_name, prebuild_counts.organization_name
 `
 
 type GetPrebuildMetricsRow struct {
-	TemplateName     string `db:"template_name" json:"template_name"`
-	PresetName       string `db:"preset_name" json:"preset_name"`
-	OrganizationName string `db:"organization_name" json:"organization_name"`
-	CreatedCount     int64  `db:"created_count" json:"created_count"`
-	FailedCount      int64  `db:"failed_count" json:"failed_count"`
-	ClaimedCount     int64  `db:"claimed_count" json:"claimed_count"`
-}
-
-func (q *sqlQuerier) GetPrebuildMetrics(ctx context.Context) ([]GetPrebuildMetricsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPrebuildMetrics)
+	TemplateName     string  `db:"template_name" json:"template_name"`
+	PresetName       string  `db:"preset_name" json:"preset_name"`
+	OrganizationName string  `db:"organization_name" json:"organization_name"`
+	CreatedCount     int64   `db:"created_count" json:"created_count"`
+	FailedCount      int64   `db:"failed_count" json:"failed_count"`
+	ClaimedCount     int64   `db:"claimed_count" json:"claimed_count"`
+	ClaimAttempts    int32   `db:"claim_attempts" json:"claim_attempts"`
+	ClaimMisses      int32   `db:"claim_misses" json:"claim_misses"`
+	AverageBuildSecs float64 `db:"average_build_secs" json:"average_build_secs"`
+}
+
+// GetPrebuildMetrics returns the number of prebuilt workspaces created, failed and claimed for each preset,
+// along with the demand since autoscaling_since from which prebuild pools are sized when autoscaling is
+// enabled, as returned by GetPrebuildClaimDemand.
+func (q *sqlQuerier) GetPrebuildMetrics(ctx context.Context, autoscalingSince time.Time) ([]GetPrebuildMetricsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPrebuildMetrics, autoscalingSince)
 	if err != nil {
 		return nil, err
 	}
@@ -7967,6 +10063,9 @@ func (q *sqlQuerier) GetPrebuildMetrics(ctx context.Context) ([]GetPrebuildMetri
 			&i.CreatedCount,
 			&i.FailedCount,
 			&i.ClaimedCount,
+			&i.ClaimAttempts,
+			&i.ClaimMisses,
+			&i.AverageBuildSecs,
 		); err != nil {
 			return nil, err
 		}
@@ -8322,6 +10421,34 @@ func (q *sqlQuerier) GetTemplatePresetsWithPrebuilds(ctx context.Context, templa
 	return items, nil
 }
 
+const insertPrebuildClaim = `-- name: InsertPrebuildClaim :exec
+INSERT INTO prebuild_claims (id, preset_id, workspace_id, build_id, hit, claim_latency_ms, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertPrebuildClaimParams struct {
+	ID             uuid.UUID     `db:"id" json:"id"`
+	PresetID       uuid.UUID     `db:"preset_id" json:"preset_id"`
+	WorkspaceID    uuid.NullUUID `db:"workspace_id" json:"workspace_id"`
+	BuildID        uuid.NullUUID `db:"build_id" json:"build_id"`
+	Hit            bool          `db:"hit" json:"hit"`
+	ClaimLatencyMs int32         `db:"claim_latency_ms" json:"claim_latency_ms"`
+	CreatedAt      time.Time     `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertPrebuildClaim(ctx context.Context, arg InsertPrebuildClaimParams) error {
+	_, err := q.db.ExecContext(ctx, insertPrebuildClaim,
+		arg.ID,
+		arg.PresetID,
+		arg.WorkspaceID,
+		arg.BuildID,
+		arg.Hit,
+		arg.ClaimLatencyMs,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const getActivePresetPrebuildSchedules = `-- name: GetActivePresetPrebuildSchedules :many
 SELECT
 	tvpps.id, tvpps.preset_id, tvpps.cron_expression, tvpps.desired_instances
@@ -12507,6 +14634,58 @@ func (q *sqlQuerier) UpsertTailnetTunnel(ctx context.Context, arg UpsertTailnetT
 	return i, err
 }
 
+const getStaleTaskSteps = `-- name: GetStaleTaskSteps :many
+SELECT workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at, updated_at FROM task_steps
+WHERE
+	status IN ('pending', 'running', 'checking')
+	AND updated_at < $1::timestamptz
+	AND NOT EXISTS (
+		SELECT 1 FROM task_steps earlier
+		WHERE
+			earlier.workspace_id = task_steps.workspace_id
+			AND earlier.step_index < task_steps.step_index
+			AND earlier.status != 'completed'
+	)
+ORDER BY workspace_id
+`
+
+// Returns the next step of each task if it has not changed status since before
+// the given time, so that steps abandoned by a replica which stopped, or whose
+// prompt could not be sent, can be recovered. Tasks with a failed step are
+// skipped.
+func (q *sqlQuerier) GetStaleTaskSteps(ctx context.Context, updatedBefore time.Time) ([]TaskStep, error) {
+	rows, err := q.db.QueryContext(ctx, getStaleTaskSteps, updatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskStep
+	for rows.Next() {
+		var i TaskStep
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.StepIndex,
+			&i.Prompt,
+			&i.CompletionCheck,
+			&i.Status,
+			&i.CheckExitCode,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTaskByID = `-- name: GetTaskByID :one
 SELECT id, organization_id, owner_id, name, workspace_id, template_version_id, template_parameters, prompt, created_at, deleted_at, status, workspace_build_number, workspace_agent_id, workspace_app_id FROM tasks_with_status WHERE id = $1::uuid
 `
@@ -12556,7 +14735,205 @@ func (q *sqlQuerier) GetTaskByWorkspaceID(ctx context.Context, workspaceID uuid.
 		&i.WorkspaceAgentID,
 		&i.WorkspaceAppID,
 	)
-	return i, err
+	return i, err
+}
+
+const getTaskStepsByWorkspaceID = `-- name: GetTaskStepsByWorkspaceID :many
+SELECT workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at, updated_at FROM task_steps WHERE workspace_id = $1::uuid ORDER BY step_index ASC
+`
+
+func (q *sqlQuerier) GetTaskStepsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]TaskStep, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskStepsByWorkspaceID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskStep
+	for rows.Next() {
+		var i TaskStep
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.StepIndex,
+			&i.Prompt,
+			&i.CompletionCheck,
+			&i.Status,
+			&i.CheckExitCode,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskStepsByWorkspaceIDs = `-- name: GetTaskStepsByWorkspaceIDs :many
+SELECT workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at, updated_at FROM task_steps WHERE workspace_id = ANY($1::uuid[]) ORDER BY workspace_id, step_index ASC
+`
+
+func (q *sqlQuerier) GetTaskStepsByWorkspaceIDs(ctx context.Context, workspaceIds []uuid.UUID) ([]TaskStep, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskStepsByWorkspaceIDs, pq.Array(workspaceIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaskStep
+	for rows.Next() {
+		var i TaskStep
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.StepIndex,
+			&i.Prompt,
+			&i.CompletionCheck,
+			&i.Status,
+			&i.CheckExitCode,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskWorkspacesForLifecyclePolicy = `-- name: GetTaskWorkspacesForLifecyclePolicy :many
+SELECT
+	workspaces.id AS workspace_id,
+	workspaces.name AS workspace_name,
+	workspaces.owner_id,
+	workspaces.organization_id,
+	workspaces.template_id,
+	latest_build.build_number,
+	latest_build.transition,
+	provisioner_jobs.job_status,
+	provisioner_jobs.completed_at AS job_completed_at,
+	app_status.state AS app_state,
+	app_status.created_at AS app_state_at
+FROM
+	workspaces
+JOIN LATERAL (
+	SELECT
+		workspace_builds.id,
+		workspace_builds.build_number,
+		workspace_builds.transition,
+		workspace_builds.job_id,
+		workspace_builds.has_ai_task,
+		workspace_builds.ai_task_sidebar_app_id
+	FROM
+		workspace_builds
+	WHERE
+		workspace_builds.workspace_id = workspaces.id
+	ORDER BY
+		workspace_builds.build_number DESC
+	LIMIT 1
+) latest_build ON TRUE
+JOIN
+	provisioner_jobs ON provisioner_jobs.id = latest_build.job_id
+LEFT JOIN LATERAL (
+	SELECT
+		workspace_app_statuses.state,
+		workspace_app_statuses.created_at
+	FROM
+		workspace_app_statuses
+	WHERE
+		workspace_app_statuses.workspace_id = workspaces.id
+		AND workspace_app_statuses.app_id = latest_build.ai_task_sidebar_app_id
+	ORDER BY
+		workspace_app_statuses.created_at DESC
+	LIMIT 1
+) app_status ON TRUE
+WHERE
+	workspaces.deleted = false
+	AND (
+		latest_build.has_ai_task
+		-- A build which is in progress does not know whether it has an AI task
+		-- yet, so assume that it does if it was given a prompt.
+		OR (
+			latest_build.has_ai_task IS NULL
+			AND provisioner_jobs.completed_at IS NULL
+			AND EXISTS (
+				SELECT 1
+				FROM workspace_build_parameters
+				WHERE workspace_build_parameters.workspace_build_id = latest_build.id
+				AND workspace_build_parameters.name = 'AI Prompt'
+				AND workspace_build_parameters.value != ''
+			)
+		)
+	)
+	-- Prebuilt workspaces are not tasks until they are claimed.
+	AND workspaces.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid
+	AND CASE WHEN $1::uuid != '00000000-0000-0000-0000-000000000000' THEN workspaces.owner_id = $1::uuid ELSE TRUE END
+ORDER BY
+	workspaces.owner_id, workspaces.id
+`
+
+type GetTaskWorkspacesForLifecyclePolicyRow struct {
+	WorkspaceID    uuid.UUID                   `db:"workspace_id" json:"workspace_id"`
+	WorkspaceName  string                      `db:"workspace_name" json:"workspace_name"`
+	OwnerID        uuid.UUID                   `db:"owner_id" json:"owner_id"`
+	OrganizationID uuid.UUID                   `db:"organization_id" json:"organization_id"`
+	TemplateID     uuid.UUID                   `db:"template_id" json:"template_id"`
+	BuildNumber    int32                       `db:"build_number" json:"build_number"`
+	Transition     WorkspaceTransition         `db:"transition" json:"transition"`
+	JobStatus      ProvisionerJobStatus        `db:"job_status" json:"job_status"`
+	JobCompletedAt sql.NullTime                `db:"job_completed_at" json:"job_completed_at"`
+	AppState       NullWorkspaceAppStatusState `db:"app_state" json:"app_state"`
+	AppStateAt     sql.NullTime                `db:"app_state_at" json:"app_state_at"`
+}
+
+// Returns the workspaces whose latest build runs an AI task, along with the
+// outcome of that build and the latest state reported by the task's app, so
+// that task lifecycle policies can be enforced. An owner ID of uuid.Nil returns
+// the task workspaces of every user.
+func (q *sqlQuerier) GetTaskWorkspacesForLifecyclePolicy(ctx context.Context, ownerID uuid.UUID) ([]GetTaskWorkspacesForLifecyclePolicyRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskWorkspacesForLifecyclePolicy, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTaskWorkspacesForLifecyclePolicyRow
+	for rows.Next() {
+		var i GetTaskWorkspacesForLifecyclePolicyRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.WorkspaceName,
+			&i.OwnerID,
+			&i.OrganizationID,
+			&i.TemplateID,
+			&i.BuildNumber,
+			&i.Transition,
+			&i.JobStatus,
+			&i.JobCompletedAt,
+			&i.AppState,
+			&i.AppStateAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const insertTask = `-- name: InsertTask :one
@@ -12605,6 +14982,47 @@ func (q *sqlQuerier) InsertTask(ctx context.Context, arg InsertTaskParams) (Task
 	return i, err
 }
 
+const insertTaskStep = `-- name: InsertTaskStep :one
+INSERT INTO task_steps
+	(workspace_id, step_index, prompt, completion_check, status, started_at)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at, updated_at
+`
+
+type InsertTaskStepParams struct {
+	WorkspaceID     uuid.UUID      `db:"workspace_id" json:"workspace_id"`
+	StepIndex       int32          `db:"step_index" json:"step_index"`
+	Prompt          string         `db:"prompt" json:"prompt"`
+	CompletionCheck string         `db:"completion_check" json:"completion_check"`
+	Status          TaskStepStatus `db:"status" json:"status"`
+	StartedAt       sql.NullTime   `db:"started_at" json:"started_at"`
+}
+
+func (q *sqlQuerier) InsertTaskStep(ctx context.Context, arg InsertTaskStepParams) (TaskStep, error) {
+	row := q.db.QueryRowContext(ctx, insertTaskStep,
+		arg.WorkspaceID,
+		arg.StepIndex,
+		arg.Prompt,
+		arg.CompletionCheck,
+		arg.Status,
+		arg.StartedAt,
+	)
+	var i TaskStep
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.StepIndex,
+		&i.Prompt,
+		&i.CompletionCheck,
+		&i.Status,
+		&i.CheckExitCode,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const listTasks = `-- name: ListTasks :many
 SELECT id, organization_id, owner_id, name, workspace_id, template_version_id, template_parameters, prompt, created_at, deleted_at, status, workspace_build_number, workspace_agent_id, workspace_app_id FROM tasks_with_status tws
 WHERE tws.deleted_at IS NULL
@@ -12656,6 +15074,60 @@ func (q *sqlQuerier) ListTasks(ctx context.Context, arg ListTasksParams) ([]Task
 	return items, nil
 }
 
+const updateTaskStepStatus = `-- name: UpdateTaskStepStatus :one
+UPDATE task_steps
+SET
+	status = $1::task_step_status,
+	check_exit_code = COALESCE($2::integer, check_exit_code),
+	started_at = CASE WHEN $1::task_step_status = 'running' THEN $3::timestamptz ELSE started_at END,
+	completed_at = CASE WHEN $1::task_step_status IN ('completed', 'failed') THEN $3::timestamptz ELSE completed_at END,
+	updated_at = $3::timestamptz
+WHERE
+	workspace_id = $4::uuid
+	AND step_index = $5::integer
+	AND status = $6::task_step_status
+	AND ($7::timestamptz IS NULL OR updated_at = $7::timestamptz)
+RETURNING workspace_id, step_index, prompt, completion_check, status, check_exit_code, started_at, completed_at, updated_at
+`
+
+type UpdateTaskStepStatusParams struct {
+	Status        TaskStepStatus `db:"status" json:"status"`
+	CheckExitCode sql.NullInt32  `db:"check_exit_code" json:"check_exit_code"`
+	UpdatedAt     time.Time      `db:"updated_at" json:"updated_at"`
+	WorkspaceID   uuid.UUID      `db:"workspace_id" json:"workspace_id"`
+	StepIndex     int32          `db:"step_index" json:"step_index"`
+	FromStatus    TaskStepStatus `db:"from_status" json:"from_status"`
+	FromUpdatedAt sql.NullTime   `db:"from_updated_at" json:"from_updated_at"`
+}
+
+// Moves a step from one status to another. No row is returned if the step is
+// not in the expected status, or was updated since from_updated_at if given, so
+// concurrent callers cannot both advance the same step.
+func (q *sqlQuerier) UpdateTaskStepStatus(ctx context.Context, arg UpdateTaskStepStatusParams) (TaskStep, error) {
+	row := q.db.QueryRowContext(ctx, updateTaskStepStatus,
+		arg.Status,
+		arg.CheckExitCode,
+		arg.UpdatedAt,
+		arg.WorkspaceID,
+		arg.StepIndex,
+		arg.FromStatus,
+		arg.FromUpdatedAt,
+	)
+	var i TaskStep
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.StepIndex,
+		&i.Prompt,
+		&i.CompletionCheck,
+		&i.Status,
+		&i.CheckExitCode,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const upsertTaskWorkspaceApp = `-- name: UpsertTaskWorkspaceApp :one
 INSERT INTO task_workspace_apps
 	(task_id, workspace_build_number, workspace_agent_id, workspace_app_id)
@@ -12829,7 +15301,7 @@ func (q *sqlQuerier) GetTemplateAverageBuildTime(ctx context.Context, templateID
 
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, record_sessions, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names
 WHERE
@@ -12872,6 +15344,7 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 		&i.MaxPortSharingLevel,
 		&i.UseClassicParameterFlow,
 		&i.CorsBehavior,
+		&i.RecordSessions,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.CreatedByName,
@@ -12884,7 +15357,7 @@ func (q *sqlQuerier) GetTemplateByID(ctx context.Context, id uuid.UUID) (Templat
 
 const getTemplateByOrganizationAndName = `-- name: GetTemplateByOrganizationAndName :one
 SELECT
-	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
+	id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, record_sessions, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon
 FROM
 	template_with_names AS templates
 WHERE
@@ -12935,6 +15408,7 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 		&i.MaxPortSharingLevel,
 		&i.UseClassicParameterFlow,
 		&i.CorsBehavior,
+		&i.RecordSessions,
 		&i.CreatedByAvatarURL,
 		&i.CreatedByUsername,
 		&i.CreatedByName,
@@ -12946,7 +15420,7 @@ func (q *sqlQuerier) GetTemplateByOrganizationAndName(ctx context.Context, arg G
 }
 
 const getTemplates = `-- name: GetTemplates :many
-SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
+SELECT id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, record_sessions, created_by_avatar_url, created_by_username, created_by_name, organization_name, organization_display_name, organization_icon FROM template_with_names AS templates
 ORDER BY (name, id) ASC
 `
 
@@ -12990,6 +15464,7 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 			&i.MaxPortSharingLevel,
 			&i.UseClassicParameterFlow,
 			&i.CorsBehavior,
+			&i.RecordSessions,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.CreatedByName,
@@ -13012,7 +15487,7 @@ func (q *sqlQuerier) GetTemplates(ctx context.Context) ([]Template, error) {
 
 const getTemplatesWithFilter = `-- name: GetTemplatesWithFilter :many
 SELECT
-	t.id, t.created_at, t.updated_at, t.organization_id, t.deleted, t.name, t.provisioner, t.active_version_id, t.description, t.default_ttl, t.created_by, t.icon, t.user_acl, t.group_acl, t.display_name, t.allow_user_cancel_workspace_jobs, t.allow_user_autostart, t.allow_user_autostop, t.failure_ttl, t.time_til_dormant, t.time_til_dormant_autodelete, t.autostop_requirement_days_of_week, t.autostop_requirement_weeks, t.autostart_block_days_of_week, t.require_active_version, t.deprecated, t.activity_bump, t.max_port_sharing_level, t.use_classic_parameter_flow, t.cors_behavior, t.created_by_avatar_url, t.created_by_username, t.created_by_name, t.organization_name, t.organization_display_name, t.organization_icon
+	t.id, t.created_at, t.updated_at, t.organization_id, t.deleted, t.name, t.provisioner, t.active_version_id, t.description, t.default_ttl, t.created_by, t.icon, t.user_acl, t.group_acl, t.display_name, t.allow_user_cancel_workspace_jobs, t.allow_user_autostart, t.allow_user_autostop, t.failure_ttl, t.time_til_dormant, t.time_til_dormant_autodelete, t.autostop_requirement_days_of_week, t.autostop_requirement_weeks, t.autostart_block_days_of_week, t.require_active_version, t.deprecated, t.activity_bump, t.max_port_sharing_level, t.use_classic_parameter_flow, t.cors_behavior, t.record_sessions, t.created_by_avatar_url, t.created_by_username, t.created_by_name, t.organization_name, t.organization_display_name, t.organization_icon
 FROM
 	template_with_names AS t
 LEFT JOIN
@@ -13171,6 +15646,7 @@ func (q *sqlQuerier) GetTemplatesWithFilter(ctx context.Context, arg GetTemplate
 			&i.MaxPortSharingLevel,
 			&i.UseClassicParameterFlow,
 			&i.CorsBehavior,
+			&i.RecordSessions,
 			&i.CreatedByAvatarURL,
 			&i.CreatedByUsername,
 			&i.CreatedByName,
@@ -13356,7 +15832,8 @@ SET
 	group_acl = $8,
 	max_port_sharing_level = $9,
 	use_classic_parameter_flow = $10,
-	cors_behavior = $11
+	cors_behavior = $11,
+	record_sessions = $12
 WHERE
 	id = $1
 `
@@ -13373,6 +15850,7 @@ type UpdateTemplateMetaByIDParams struct {
 	MaxPortSharingLevel          AppSharingLevel `db:"max_port_sharing_level" json:"max_port_sharing_level"`
 	UseClassicParameterFlow      bool            `db:"use_classic_parameter_flow" json:"use_classic_parameter_flow"`
 	CorsBehavior                 CorsBehavior    `db:"cors_behavior" json:"cors_behavior"`
+	RecordSessions               bool            `db:"record_sessions" json:"record_sessions"`
 }
 
 func (q *sqlQuerier) UpdateTemplateMetaByID(ctx context.Context, arg UpdateTemplateMetaByIDParams) error {
@@ -13388,6 +15866,7 @@ func (q *sqlQuerier) UpdateTemplateMetaByID(ctx context.Context, arg UpdateTempl
 		arg.MaxPortSharingLevel,
 		arg.UseClassicParameterFlow,
 		arg.CorsBehavior,
+		arg.RecordSessions,
 	)
 	return err
 }
@@ -15329,6 +17808,23 @@ func (q *sqlQuerier) GetAuthorizationUserRoles(ctx context.Context, userID uuid.
 	return i, err
 }
 
+const getUserAIBridgeCapture = `-- name: GetUserAIBridgeCapture :one
+SELECT
+	value as aibridge_capture
+FROM
+	user_configs
+WHERE
+	user_id = $1
+	AND key = 'aibridge_capture'
+`
+
+func (q *sqlQuerier) GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserAIBridgeCapture, userID)
+	var aibridge_capture string
+	err := row.Scan(&aibridge_capture)
+	return aibridge_capture, err
+}
+
 const getUserByEmailOrUsername = `-- name: GetUserByEmailOrUsername :one
 SELECT
 	id, email, username, hashed_password, created_at, updated_at, status, rbac_roles, login_type, avatar_url, deleted, last_seen_at, quiet_hours_schedule, name, github_com_user_id, hashed_one_time_passcode, one_time_passcode_expires_at, is_system
@@ -15426,6 +17922,40 @@ func (q *sqlQuerier) GetUserCount(ctx context.Context, includeSystem bool) (int6
 	return count, err
 }
 
+const getUserNotificationQuietHours = `-- name: GetUserNotificationQuietHours :one
+SELECT
+	value as notification_quiet_hours
+FROM
+	user_configs
+WHERE
+	user_id = $1
+	AND key = 'notification_quiet_hours'
+`
+
+func (q *sqlQuerier) GetUserNotificationQuietHours(ctx context.Context, userID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserNotificationQuietHours, userID)
+	var notification_quiet_hours string
+	err := row.Scan(&notification_quiet_hours)
+	return notification_quiet_hours, err
+}
+
+const getUserNotificationSnoozeUntil = `-- name: GetUserNotificationSnoozeUntil :one
+SELECT
+	value as notification_snooze_until
+FROM
+	user_configs
+WHERE
+	user_id = $1
+	AND key = 'notification_snooze_until'
+`
+
+func (q *sqlQuerier) GetUserNotificationSnoozeUntil(ctx context.Context, userID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserNotificationSnoozeUntil, userID)
+	var notification_snooze_until string
+	err := row.Scan(&notification_snooze_until)
+	return notification_snooze_until, err
+}
+
 const getUserTerminalFont = `-- name: GetUserTerminalFont :one
 SELECT
 	value as terminal_font
@@ -15831,6 +18361,33 @@ func (q *sqlQuerier) UpdateInactiveUsersToDormant(ctx context.Context, arg Updat
 	return items, nil
 }
 
+const updateUserAIBridgeCapture = `-- name: UpdateUserAIBridgeCapture :one
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	($1, 'aibridge_capture', $2)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = $2
+WHERE user_configs.user_id = $1
+	AND user_configs.key = 'aibridge_capture'
+RETURNING user_id, key, value
+`
+
+type UpdateUserAIBridgeCaptureParams struct {
+	UserID          uuid.UUID `db:"user_id" json:"user_id"`
+	AibridgeCapture string    `db:"aibridge_capture" json:"aibridge_capture"`
+}
+
+func (q *sqlQuerier) UpdateUserAIBridgeCapture(ctx context.Context, arg UpdateUserAIBridgeCaptureParams) (UserConfig, error) {
+	row := q.db.QueryRowContext(ctx, updateUserAIBridgeCapture, arg.UserID, arg.AibridgeCapture)
+	var i UserConfig
+	err := row.Scan(&i.UserID, &i.Key, &i.Value)
+	return i, err
+}
+
 const updateUserDeletedByID = `-- name: UpdateUserDeletedByID :exec
 UPDATE
 	users
@@ -15997,6 +18554,61 @@ func (q *sqlQuerier) UpdateUserLoginType(ctx context.Context, arg UpdateUserLogi
 	return i, err
 }
 
+const updateUserNotificationQuietHours = `-- name: UpdateUserNotificationQuietHours :one
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	($1, 'notification_quiet_hours', $2)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = $2
+WHERE user_configs.user_id = $1
+	AND user_configs.key = 'notification_quiet_hours'
+RETURNING user_id, key, value
+`
+
+type UpdateUserNotificationQuietHoursParams struct {
+	UserID                 uuid.UUID `db:"user_id" json:"user_id"`
+	NotificationQuietHours string    `db:"notification_quiet_hours" json:"notification_quiet_hours"`
+}
+
+func (q *sqlQuerier) UpdateUserNotificationQuietHours(ctx context.Context, arg UpdateUserNotificationQuietHoursParams) (UserConfig, error) {
+	row := q.db.QueryRowContext(ctx, updateUserNotificationQuietHours, arg.UserID, arg.NotificationQuietHours)
+	var i UserConfig
+	err := row.Scan(&i.UserID, &i.Key, &i.Value)
+	return i, err
+}
+
+const updateUserNotificationSnoozeUntil = `-- name: UpdateUserNotificationSnoozeUntil :one
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	($1, 'notification_snooze_until', $2)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = $2
+WHERE user_configs.user_id = $1
+	AND user_configs.key = 'notification_snooze_until'
+RETURNING user_id, key, value
+`
+
+type UpdateUserNotificationSnoozeUntilParams struct {
+	UserID                  uuid.UUID `db:"user_id" json:"user_id"`
+	NotificationSnoozeUntil string    `db:"notification_snooze_until" json:"notification_snooze_until"`
+}
+
+// The value is an RFC 3339 timestamp, or empty when notifications are not snoozed.
+func (q *sqlQuerier) UpdateUserNotificationSnoozeUntil(ctx context.Context, arg UpdateUserNotificationSnoozeUntilParams) (UserConfig, error) {
+	row := q.db.QueryRowContext(ctx, updateUserNotificationSnoozeUntil, arg.UserID, arg.NotificationSnoozeUntil)
+	var i UserConfig
+	err := row.Scan(&i.UserID, &i.Key, &i.Value)
+	return i, err
+}
+
 const updateUserProfile = `-- name: UpdateUserProfile :one
 UPDATE
 	users
@@ -20563,24 +23175,156 @@ func (q *sqlQuerier) UpdateWorkspaceBuildFlagsByID(ctx context.Context, arg Upda
 	return err
 }
 
-const updateWorkspaceBuildProvisionerStateByID = `-- name: UpdateWorkspaceBuildProvisionerStateByID :exec
-UPDATE
-	workspace_builds
-SET
-	provisioner_state = $1::bytea,
-	updated_at = $2::timestamptz
-WHERE id = $3::uuid
+const updateWorkspaceBuildProvisionerStateByID = `-- name: UpdateWorkspaceBuildProvisionerStateByID :exec
+UPDATE
+	workspace_builds
+SET
+	provisioner_state = $1::bytea,
+	updated_at = $2::timestamptz
+WHERE id = $3::uuid
+`
+
+type UpdateWorkspaceBuildProvisionerStateByIDParams struct {
+	ProvisionerState []byte    `db:"provisioner_state" json:"provisioner_state"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+	ID               uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *sqlQuerier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context, arg UpdateWorkspaceBuildProvisionerStateByIDParams) error {
+	_, err := q.db.ExecContext(ctx, updateWorkspaceBuildProvisionerStateByID, arg.ProvisionerState, arg.UpdatedAt, arg.ID)
+	return err
+}
+
+const insertWorkspaceLifecycleDecision = `-- name: InsertWorkspaceLifecycleDecision :one
+INSERT INTO workspace_lifecycle_decisions (
+	id,
+	workspace_id,
+	created_at,
+	outcome,
+	transition,
+	build_reason,
+	checks,
+	detail
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8
+)
+RETURNING id, workspace_id, created_at, outcome, transition, build_reason, checks, detail
+`
+
+type InsertWorkspaceLifecycleDecisionParams struct {
+	ID          uuid.UUID                 `db:"id" json:"id"`
+	WorkspaceID uuid.UUID                 `db:"workspace_id" json:"workspace_id"`
+	CreatedAt   time.Time                 `db:"created_at" json:"created_at"`
+	Outcome     WorkspaceLifecycleOutcome `db:"outcome" json:"outcome"`
+	Transition  NullWorkspaceTransition   `db:"transition" json:"transition"`
+	BuildReason NullBuildReason           `db:"build_reason" json:"build_reason"`
+	Checks      json.RawMessage           `db:"checks" json:"checks"`
+	Detail      string                    `db:"detail" json:"detail"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceLifecycleDecision(ctx context.Context, arg InsertWorkspaceLifecycleDecisionParams) (WorkspaceLifecycleDecision, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceLifecycleDecision,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.CreatedAt,
+		arg.Outcome,
+		arg.Transition,
+		arg.BuildReason,
+		arg.Checks,
+		arg.Detail,
+	)
+	var i WorkspaceLifecycleDecision
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.CreatedAt,
+		&i.Outcome,
+		&i.Transition,
+		&i.BuildReason,
+		&i.Checks,
+		&i.Detail,
+	)
+	return i, err
+}
+
+const getWorkspaceLifecycleDecisionsByWorkspaceID = `-- name: GetWorkspaceLifecycleDecisionsByWorkspaceID :many
+SELECT
+	id, workspace_id, created_at, outcome, transition, build_reason, checks, detail
+FROM
+	workspace_lifecycle_decisions
+WHERE
+	workspace_id = $1
+ORDER BY
+	created_at DESC
+LIMIT
+	$2
+`
+
+type GetWorkspaceLifecycleDecisionsByWorkspaceIDParams struct {
+	WorkspaceID uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	LimitCount  int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx context.Context, arg GetWorkspaceLifecycleDecisionsByWorkspaceIDParams) ([]WorkspaceLifecycleDecision, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceLifecycleDecisionsByWorkspaceID, arg.WorkspaceID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceLifecycleDecision
+	for rows.Next() {
+		var i WorkspaceLifecycleDecision
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.CreatedAt,
+			&i.Outcome,
+			&i.Transition,
+			&i.BuildReason,
+			&i.Checks,
+			&i.Detail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteOldWorkspaceLifecycleDecisions = `-- name: DeleteOldWorkspaceLifecycleDecisions :execrows
+DELETE FROM workspace_lifecycle_decisions
+WHERE id IN (
+	SELECT id FROM workspace_lifecycle_decisions
+	WHERE created_at < $1::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT $2
+)
 `
 
-type UpdateWorkspaceBuildProvisionerStateByIDParams struct {
-	ProvisionerState []byte    `db:"provisioner_state" json:"provisioner_state"`
-	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
-	ID               uuid.UUID `db:"id" json:"id"`
+type DeleteOldWorkspaceLifecycleDecisionsParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
 }
 
-func (q *sqlQuerier) UpdateWorkspaceBuildProvisionerStateByID(ctx context.Context, arg UpdateWorkspaceBuildProvisionerStateByIDParams) error {
-	_, err := q.db.ExecContext(ctx, updateWorkspaceBuildProvisionerStateByID, arg.ProvisionerState, arg.UpdatedAt, arg.ID)
-	return err
+func (q *sqlQuerier) DeleteOldWorkspaceLifecycleDecisions(ctx context.Context, arg DeleteOldWorkspaceLifecycleDecisionsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldWorkspaceLifecycleDecisions, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getWorkspaceModulesByJobID = `-- name: GetWorkspaceModulesByJobID :many
@@ -21678,7 +24422,7 @@ LEFT JOIN LATERAL (
 ) latest_build ON TRUE
 LEFT JOIN LATERAL (
 	SELECT
-		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior
+		id, created_at, updated_at, organization_id, deleted, name, provisioner, active_version_id, description, default_ttl, created_by, icon, user_acl, group_acl, display_name, allow_user_cancel_workspace_jobs, allow_user_autostart, allow_user_autostop, failure_ttl, time_til_dormant, time_til_dormant_autodelete, autostop_requirement_days_of_week, autostop_requirement_weeks, autostart_block_days_of_week, require_active_version, deprecated, activity_bump, max_port_sharing_level, use_classic_parameter_flow, cors_behavior, record_sessions
 	FROM
 		templates
 	WHERE
@@ -22341,14 +25085,20 @@ WHERE
 		--   * The provisioner job did not fail.
 		--   * The workspace build was a stop transition.
 		--   * The workspace is not dormant
-		--   * The workspace has an autostart schedule.
+		--   * The workspace has an autostart schedule or additional schedule windows.
 		--   * It is after the workspace's next start time.
 		(
 			users.status = 'active'::user_status AND
 			provisioner_jobs.job_status != 'failed'::provisioner_job_status AND
 			workspace_builds.transition = 'stop'::workspace_transition AND
 			workspaces.dormant_at IS NULL AND
-			workspaces.autostart_schedule IS NOT NULL AND
+			(
+				workspaces.autostart_schedule IS NOT NULL OR
+				EXISTS (
+					SELECT 1 FROM workspace_schedule_windows
+					WHERE workspace_schedule_windows.workspace_id = workspaces.id
+				)
+			) AND
 			(
 				-- next_start_at might be null in these two scenarios:
 				--   * A coder instance was updated and we haven't updated next_start_at yet.
@@ -22953,6 +25703,143 @@ func (q *sqlQuerier) UpdateWorkspacesTTLByTemplateID(ctx context.Context, arg Up
 	return err
 }
 
+const deleteWorkspaceScheduleWindowsByWorkspaceID = `-- name: DeleteWorkspaceScheduleWindowsByWorkspaceID :exec
+DELETE FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = $1
+`
+
+func (q *sqlQuerier) DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteWorkspaceScheduleWindowsByWorkspaceID, workspaceID)
+	return err
+}
+
+const getWorkspaceScheduleWindowsByWorkspaceID = `-- name: GetWorkspaceScheduleWindowsByWorkspaceID :many
+SELECT
+	workspace_id, position, autostart_schedule, ttl, created_at
+FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = $1
+ORDER BY
+	position ASC
+`
+
+func (q *sqlQuerier) GetWorkspaceScheduleWindowsByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]WorkspaceScheduleWindow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceScheduleWindowsByWorkspaceID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceScheduleWindow
+	for rows.Next() {
+		var i WorkspaceScheduleWindow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.Position,
+			&i.AutostartSchedule,
+			&i.Ttl,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceScheduleWindowsByWorkspaceIDs = `-- name: GetWorkspaceScheduleWindowsByWorkspaceIDs :many
+SELECT
+	workspace_id, position, autostart_schedule, ttl, created_at
+FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = ANY($1 :: uuid [ ])
+ORDER BY
+	workspace_id ASC,
+	position ASC
+`
+
+func (q *sqlQuerier) GetWorkspaceScheduleWindowsByWorkspaceIDs(ctx context.Context, ids []uuid.UUID) ([]WorkspaceScheduleWindow, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceScheduleWindowsByWorkspaceIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceScheduleWindow
+	for rows.Next() {
+		var i WorkspaceScheduleWindow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.Position,
+			&i.AutostartSchedule,
+			&i.Ttl,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceScheduleWindow = `-- name: InsertWorkspaceScheduleWindow :one
+INSERT INTO workspace_schedule_windows (
+	workspace_id,
+	position,
+	autostart_schedule,
+	ttl,
+	created_at
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5
+)
+RETURNING workspace_id, position, autostart_schedule, ttl, created_at
+`
+
+type InsertWorkspaceScheduleWindowParams struct {
+	WorkspaceID       uuid.UUID     `db:"workspace_id" json:"workspace_id"`
+	Position          int32         `db:"position" json:"position"`
+	AutostartSchedule string        `db:"autostart_schedule" json:"autostart_schedule"`
+	Ttl               sql.NullInt64 `db:"ttl" json:"ttl"`
+	CreatedAt         time.Time     `db:"created_at" json:"created_at"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceScheduleWindow(ctx context.Context, arg InsertWorkspaceScheduleWindowParams) (WorkspaceScheduleWindow, error) {
+	row := q.db.QueryRowContext(ctx, insertWorkspaceScheduleWindow,
+		arg.WorkspaceID,
+		arg.Position,
+		arg.AutostartSchedule,
+		arg.Ttl,
+		arg.CreatedAt,
+	)
+	var i WorkspaceScheduleWindow
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.Position,
+		&i.AutostartSchedule,
+		&i.Ttl,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getWorkspaceAgentScriptsByAgentIDs = `-- name: GetWorkspaceAgentScriptsByAgentIDs :many
 SELECT workspace_agent_id, log_source_id, log_path, created_at, script, cron, start_blocks_login, run_on_start, run_on_stop, timeout_seconds, display_name, id FROM workspace_agent_scripts WHERE workspace_agent_id = ANY($1 :: uuid [ ])
 `
@@ -23075,3 +25962,196 @@ func (q *sqlQuerier) InsertWorkspaceAgentScripts(ctx context.Context, arg Insert
 	}
 	return items, nil
 }
+
+const deleteOldWorkspaceSessionRecordings = `-- name: DeleteOldWorkspaceSessionRecordings :execrows
+DELETE FROM workspace_session_recordings
+WHERE id IN (
+	SELECT id FROM workspace_session_recordings
+	WHERE created_at < $1::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT $2
+)
+`
+
+type DeleteOldWorkspaceSessionRecordingsParams struct {
+	BeforeTime time.Time `db:"before_time" json:"before_time"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) DeleteOldWorkspaceSessionRecordings(ctx context.Context, arg DeleteOldWorkspaceSessionRecordingsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOldWorkspaceSessionRecordings, arg.BeforeTime, arg.LimitCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getWorkspaceSessionRecordingByConnectionLogID = `-- name: GetWorkspaceSessionRecordingByConnectionLogID :one
+SELECT
+	workspace_session_recordings.id, workspace_session_recordings.created_at, workspace_session_recordings.updated_at, workspace_session_recordings.organization_id, workspace_session_recordings.workspace_id, workspace_session_recordings.agent_name, workspace_session_recordings.connection_id, workspace_session_recordings.size_bytes, workspace_session_recordings.truncated, workspace_session_recordings.completed
+FROM
+	workspace_session_recordings
+JOIN
+	connection_logs
+ON
+	connection_logs.connection_id = workspace_session_recordings.connection_id
+	AND connection_logs.workspace_id = workspace_session_recordings.workspace_id
+	AND connection_logs.agent_name = workspace_session_recordings.agent_name
+WHERE
+	connection_logs.id = $1
+`
+
+func (q *sqlQuerier) GetWorkspaceSessionRecordingByConnectionLogID(ctx context.Context, connectionLogID uuid.UUID) (WorkspaceSessionRecording, error) {
+	row := q.db.QueryRowContext(ctx, getWorkspaceSessionRecordingByConnectionLogID, connectionLogID)
+	var i WorkspaceSessionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+		&i.WorkspaceID,
+		&i.AgentName,
+		&i.ConnectionID,
+		&i.SizeBytes,
+		&i.Truncated,
+		&i.Completed,
+	)
+	return i, err
+}
+
+const getWorkspaceSessionRecordingChunks = `-- name: GetWorkspaceSessionRecordingChunks :many
+SELECT
+	recording_id, seq, data
+FROM
+	workspace_session_recording_chunks
+WHERE
+	recording_id = $1
+	AND seq > $2
+ORDER BY
+	seq ASC
+LIMIT
+	$3
+`
+
+type GetWorkspaceSessionRecordingChunksParams struct {
+	RecordingID uuid.UUID `db:"recording_id" json:"recording_id"`
+	AfterSeq    int32     `db:"after_seq" json:"after_seq"`
+	LimitCount  int32     `db:"limit_count" json:"limit_count"`
+}
+
+func (q *sqlQuerier) GetWorkspaceSessionRecordingChunks(ctx context.Context, arg GetWorkspaceSessionRecordingChunksParams) ([]WorkspaceSessionRecordingChunk, error) {
+	rows, err := q.db.QueryContext(ctx, getWorkspaceSessionRecordingChunks, arg.RecordingID, arg.AfterSeq, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceSessionRecordingChunk
+	for rows.Next() {
+		var i WorkspaceSessionRecordingChunk
+		if err := rows.Scan(&i.RecordingID, &i.Seq, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertWorkspaceSessionRecordingChunk = `-- name: InsertWorkspaceSessionRecordingChunk :exec
+WITH inserted_chunk AS (
+	INSERT INTO workspace_session_recording_chunks (recording_id, seq, data)
+	VALUES ($1, $2, $3)
+	-- Chunks are retried by the agent if the upload fails.
+	ON CONFLICT (recording_id, seq) DO NOTHING
+	RETURNING recording_id, octet_length(data) AS size
+)
+UPDATE workspace_session_recordings
+SET size_bytes = workspace_session_recordings.size_bytes + inserted_chunk.size
+FROM inserted_chunk
+WHERE workspace_session_recordings.id = inserted_chunk.recording_id
+`
+
+type InsertWorkspaceSessionRecordingChunkParams struct {
+	RecordingID uuid.UUID `db:"recording_id" json:"recording_id"`
+	Seq         int32     `db:"seq" json:"seq"`
+	Data        []byte    `db:"data" json:"data"`
+}
+
+func (q *sqlQuerier) InsertWorkspaceSessionRecordingChunk(ctx context.Context, arg InsertWorkspaceSessionRecordingChunkParams) error {
+	_, err := q.db.ExecContext(ctx, insertWorkspaceSessionRecordingChunk, arg.RecordingID, arg.Seq, arg.Data)
+	return err
+}
+
+const upsertWorkspaceSessionRecording = `-- name: UpsertWorkspaceSessionRecording :one
+INSERT INTO workspace_session_recordings (
+	id,
+	created_at,
+	updated_at,
+	organization_id,
+	workspace_id,
+	agent_name,
+	connection_id,
+	truncated,
+	completed
+) VALUES (
+	$1,
+	$2,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8
+)
+ON CONFLICT (connection_id, workspace_id, agent_name)
+DO UPDATE SET
+	updated_at = EXCLUDED.updated_at,
+	-- Once set, these can never be unset.
+	truncated = workspace_session_recordings.truncated OR EXCLUDED.truncated,
+	completed = workspace_session_recordings.completed OR EXCLUDED.completed
+RETURNING id, created_at, updated_at, organization_id, workspace_id, agent_name, connection_id, size_bytes, truncated, completed
+`
+
+type UpsertWorkspaceSessionRecordingParams struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	OrganizationID uuid.UUID `db:"organization_id" json:"organization_id"`
+	WorkspaceID    uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	AgentName      string    `db:"agent_name" json:"agent_name"`
+	ConnectionID   uuid.UUID `db:"connection_id" json:"connection_id"`
+	Truncated      bool      `db:"truncated" json:"truncated"`
+	Completed      bool      `db:"completed" json:"completed"`
+}
+
+func (q *sqlQuerier) UpsertWorkspaceSessionRecording(ctx context.Context, arg UpsertWorkspaceSessionRecordingParams) (WorkspaceSessionRecording, error) {
+	row := q.db.QueryRowContext(ctx, upsertWorkspaceSessionRecording,
+		arg.ID,
+		arg.CreatedAt,
+		arg.OrganizationID,
+		arg.WorkspaceID,
+		arg.AgentName,
+		arg.ConnectionID,
+		arg.Truncated,
+		arg.Completed,
+	)
+	var i WorkspaceSessionRecording
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+		&i.WorkspaceID,
+		&i.AgentName,
+		&i.ConnectionID,
+		&i.SizeBytes,
+		&i.Truncated,
+		&i.Completed,
+	)
+	return i, err
+}
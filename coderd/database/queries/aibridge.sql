@@ -30,6 +30,14 @@ INSERT INTO aibridge_tool_usages (
 )
 RETURNING *;
 
+-- name: InsertAIBridgePolicyDecision :one
+INSERT INTO aibridge_policy_decisions (
+  id, interception_id, policy, action, reason, created_at
+) VALUES (
+  @id, @interception_id, @policy, @action, @reason, @created_at
+)
+RETURNING *;
+
 -- name: GetAIBridgeInterceptionByID :one
 SELECT
 	*
@@ -161,3 +169,315 @@ WHERE
 ORDER BY
 	created_at ASC,
 	id ASC;
+
+-- name: ListAIBridgePolicyDecisionsByInterceptionIDs :many
+SELECT
+	*
+FROM
+	aibridge_policy_decisions
+WHERE
+	interception_id = ANY(@interception_ids::uuid[])
+ORDER BY
+	created_at ASC,
+	id ASC;
+
+-- name: UpsertAIBridgeLimit :one
+INSERT INTO aibridge_limits (
+	scope, scope_id, daily_token_budget, monthly_token_budget, requests_per_minute, created_at, updated_at
+) VALUES (
+	@scope, @scope_id, @daily_token_budget, @monthly_token_budget, @requests_per_minute, @updated_at, @updated_at
+)
+ON CONFLICT (scope, scope_id) DO UPDATE SET
+	daily_token_budget = EXCLUDED.daily_token_budget,
+	monthly_token_budget = EXCLUDED.monthly_token_budget,
+	requests_per_minute = EXCLUDED.requests_per_minute,
+	updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: DeleteAIBridgeLimit :exec
+DELETE FROM
+	aibridge_limits
+WHERE
+	scope = @scope
+	AND scope_id = @scope_id;
+
+-- name: GetAIBridgeLimits :many
+SELECT
+	*
+FROM
+	aibridge_limits
+ORDER BY
+	scope ASC,
+	scope_id ASC;
+
+-- name: GetAIBridgeLimitsByUserID :many
+-- Returns the limits which apply to the given user: their own, and those of
+-- every group and organization they are a member of.
+SELECT
+	*
+FROM
+	aibridge_limits
+WHERE
+	(scope = 'user' AND scope_id = @user_id::uuid)
+	OR (scope = 'group' AND scope_id IN (
+		SELECT group_id FROM group_members_expanded WHERE user_id = @user_id::uuid
+	))
+	OR (scope = 'organization' AND scope_id IN (
+		SELECT organization_id FROM organization_members WHERE user_id = @user_id::uuid
+	))
+ORDER BY
+	scope ASC,
+	scope_id ASC;
+
+-- name: GetAIBridgeLimitUsage :one
+-- Sums the tokens used since the start of the current day and month, and counts
+-- the requests intercepted since minute_start, for everyone a limit applies to.
+-- Usage is pooled across all members of a group or organization. Hours which
+-- the aibridge_usage_stats rollup has completed are read from it, so that only
+-- the most recent hours are summed from individual token usages. Both
+-- day_start and month_start must be on the hour.
+WITH initiators AS (
+	SELECT @scope_id::uuid AS user_id
+	WHERE @scope::aibridge_limit_scope = 'user'
+	UNION
+	SELECT user_id FROM group_members_expanded
+	WHERE @scope::aibridge_limit_scope = 'group' AND group_id = @scope_id::uuid
+	UNION
+	SELECT user_id FROM organization_members
+	WHERE @scope::aibridge_limit_scope = 'organization' AND organization_id = @scope_id::uuid
+),
+rollup_end AS (
+	-- The rollup recomputes its latest hour and the one before it, so only
+	-- the hours before them are final.
+	SELECT
+		COALESCE(MAX(start_time) - '1 hour'::interval, '-infinity'::timestamptz) AS t
+	FROM
+		aibridge_usage_stats
+),
+rolled_up_tokens AS (
+	SELECT
+		COALESCE(SUM(s.input_tokens + s.output_tokens) FILTER (WHERE s.start_time >= @day_start::timestamptz), 0) AS daily_tokens,
+		COALESCE(SUM(s.input_tokens + s.output_tokens), 0) AS monthly_tokens
+	FROM
+		aibridge_usage_stats s
+	WHERE
+		s.start_time >= @month_start::timestamptz
+		AND s.start_time < (SELECT t FROM rollup_end)
+		AND s.user_id IN (SELECT user_id FROM initiators)
+),
+recent_tokens AS (
+	SELECT
+		COALESCE(SUM(tu.input_tokens + tu.output_tokens) FILTER (WHERE tu.created_at >= @day_start::timestamptz), 0) AS daily_tokens,
+		COALESCE(SUM(tu.input_tokens + tu.output_tokens), 0) AS monthly_tokens
+	FROM
+		aibridge_token_usages tu
+	JOIN
+		aibridge_interceptions i ON i.id = tu.interception_id
+	WHERE
+		tu.created_at >= GREATEST(@month_start::timestamptz, (SELECT t FROM rollup_end))
+		AND i.initiator_id IN (SELECT user_id FROM initiators)
+)
+SELECT
+	(rolled_up_tokens.daily_tokens + recent_tokens.daily_tokens)::bigint AS daily_tokens,
+	(rolled_up_tokens.monthly_tokens + recent_tokens.monthly_tokens)::bigint AS monthly_tokens,
+	(
+		SELECT
+			COUNT(*)
+		FROM
+			aibridge_interceptions ai
+		WHERE
+			ai.started_at >= @minute_start::timestamptz
+			AND ai.initiator_id IN (SELECT user_id FROM initiators)
+	)::bigint AS recent_requests
+FROM
+	rolled_up_tokens, recent_tokens;
+
+-- name: UpsertAIBridgeUsageStats :exec
+-- This query aggregates AI Bridge interceptions and token usages into hourly
+-- buckets per user, template, provider and model, and stores the result in the
+-- aibridge_usage_stats table. The template is read from the interception
+-- metadata, which is only set for requests authenticated with a workspace
+-- session token. The latest hour and the one before it are always recomputed
+-- so that tokens recorded after an interception started are included.
+WITH
+	latest_start AS (
+		SELECT
+			-- Truncate to hour so that we always look at even ranges of data.
+			date_trunc('hour', COALESCE(
+				MAX(start_time) - '1 hour'::interval,
+				-- Fallback when there are no AI Bridge usage stats yet.
+				(SELECT MIN(started_at) FROM aibridge_interceptions)
+			)) AS t
+		FROM
+			aibridge_usage_stats
+	),
+	request_buckets AS (
+		SELECT
+			date_trunc('hour', i.started_at) AS time_bucket,
+			i.initiator_id AS user_id,
+			CASE
+				WHEN i.metadata->>'template_id' ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+				THEN (i.metadata->>'template_id')::uuid
+				ELSE '00000000-0000-0000-0000-000000000000'::uuid
+			END AS template_id,
+			i.provider,
+			i.model,
+			COUNT(*) AS requests
+		FROM
+			aibridge_interceptions i
+		WHERE
+			i.started_at >= (SELECT t FROM latest_start)
+			AND i.started_at < NOW()
+		GROUP BY
+			time_bucket, user_id, template_id, i.provider, i.model
+	),
+	token_buckets AS (
+		SELECT
+			date_trunc('hour', tu.created_at) AS time_bucket,
+			i.initiator_id AS user_id,
+			CASE
+				WHEN i.metadata->>'template_id' ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+				THEN (i.metadata->>'template_id')::uuid
+				ELSE '00000000-0000-0000-0000-000000000000'::uuid
+			END AS template_id,
+			i.provider,
+			i.model,
+			SUM(tu.input_tokens) AS input_tokens,
+			SUM(tu.output_tokens) AS output_tokens
+		FROM
+			aibridge_token_usages tu
+		JOIN
+			aibridge_interceptions i ON i.id = tu.interception_id
+		WHERE
+			tu.created_at >= (SELECT t FROM latest_start)
+			AND tu.created_at < NOW()
+		GROUP BY
+			time_bucket, user_id, template_id, i.provider, i.model
+	)
+INSERT INTO aibridge_usage_stats (
+	start_time, user_id, template_id, provider, model, requests, input_tokens, output_tokens
+)
+SELECT
+	time_bucket,
+	user_id,
+	template_id,
+	provider,
+	model,
+	COALESCE(rb.requests, 0),
+	COALESCE(tb.input_tokens, 0),
+	COALESCE(tb.output_tokens, 0)
+FROM
+	request_buckets rb
+FULL OUTER JOIN
+	token_buckets tb USING (time_bucket, user_id, template_id, provider, model)
+ON CONFLICT
+	(start_time, user_id, template_id, provider, model)
+DO UPDATE SET
+	requests = EXCLUDED.requests,
+	input_tokens = EXCLUDED.input_tokens,
+	output_tokens = EXCLUDED.output_tokens;
+
+-- name: GetAIBridgeUsageStats :many
+-- Sums the hourly AI Bridge usage rollup over [start_time, end_time) for each
+-- user, template, provider and model. Both times must be on the hour.
+SELECT
+	s.user_id,
+	COALESCE(u.username, '')::text AS username,
+	s.template_id,
+	COALESCE(t.name, '')::text AS template_name,
+	s.provider,
+	s.model,
+	SUM(s.requests)::bigint AS requests,
+	SUM(s.input_tokens)::bigint AS input_tokens,
+	SUM(s.output_tokens)::bigint AS output_tokens
+FROM
+	aibridge_usage_stats s
+LEFT JOIN
+	users u ON u.id = s.user_id
+LEFT JOIN
+	templates t ON t.id = s.template_id
+WHERE
+	s.start_time >= @start_time::timestamptz
+	AND s.start_time < @end_time::timestamptz
+GROUP BY
+	s.user_id, u.username, s.template_id, t.name, s.provider, s.model
+ORDER BY
+	u.username ASC,
+	t.name ASC,
+	s.provider ASC,
+	s.model ASC;
+
+-- name: GetAIBridgeModelPrices :many
+SELECT
+	*
+FROM
+	aibridge_model_prices
+ORDER BY
+	provider ASC,
+	model ASC;
+
+-- name: DeleteAIBridgeModelPrices :exec
+DELETE FROM
+	aibridge_model_prices;
+
+-- name: InsertAIBridgeModelPrices :exec
+INSERT INTO aibridge_model_prices (
+	provider, model, input_price_per_million_tokens, output_price_per_million_tokens, updated_at
+)
+SELECT
+	unnest(@provider::text[]),
+	unnest(@model::text[]),
+	unnest(@input_price_per_million_tokens::float8[]),
+	unnest(@output_price_per_million_tokens::float8[]),
+	@updated_at::timestamptz;
+
+-- name: InsertAIBridgeInterceptionCapture :one
+INSERT INTO aibridge_interception_captures (
+	interception_id, method, path,
+	request_headers, request_body, request_body_key_id, request_body_truncated,
+	response_status, response_headers, response_body, response_body_key_id, response_body_truncated,
+	created_at
+) VALUES (
+	@interception_id, @method, @path,
+	@request_headers, @request_body, @request_body_key_id, @request_body_truncated,
+	@response_status, @response_headers, @response_body, @response_body_key_id, @response_body_truncated,
+	@created_at
+)
+RETURNING *;
+
+-- name: GetAIBridgeInterceptionCaptureByInterceptionID :one
+SELECT
+	*
+FROM
+	aibridge_interception_captures
+WHERE
+	interception_id = @interception_id::uuid;
+
+-- name: GetAIBridgeInterceptionCaptureIDs :many
+-- Used by dbcrypt to re-encrypt or decrypt the bodies of all captures.
+SELECT
+	interception_id
+FROM
+	aibridge_interception_captures
+ORDER BY
+	created_at ASC;
+
+-- name: UpdateAIBridgeInterceptionCaptureBodies :exec
+UPDATE
+	aibridge_interception_captures
+SET
+	request_body = @request_body,
+	request_body_key_id = @request_body_key_id,
+	response_body = @response_body,
+	response_body_key_id = @response_body_key_id
+WHERE
+	interception_id = @interception_id;
+
+-- name: DeleteOldAIBridgeInterceptionCaptures :execrows
+DELETE FROM aibridge_interception_captures
+WHERE interception_id IN (
+	SELECT interception_id FROM aibridge_interception_captures
+	WHERE created_at < @before_time::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT @limit_count
+);
@@ -253,3 +253,64 @@ WHERE id IN (
     ORDER BY "time" ASC
     LIMIT @limit_count
 );
+
+-- name: DeleteOldAuditLogs :execrows
+-- Deletes the oldest audit logs recorded before the given time, together with
+-- their hash chain entries. The links of the deleted entries are kept as
+-- checkpoints, so that the remaining entries can still be verified even if
+-- audit logs were not chained in the order of their timestamps.
+WITH old_audit_logs AS (
+    SELECT id FROM audit_logs
+    WHERE "time" < @before_time::timestamp with time zone
+    ORDER BY "time" ASC
+    LIMIT @limit_count
+),
+deleted_hash_chain_entries AS (
+    DELETE FROM audit_log_hash_chain
+    WHERE audit_log_id IN (SELECT id FROM old_audit_logs)
+    RETURNING seq, previous_hash, hash
+),
+inserted_hash_chain_checkpoints AS (
+    INSERT INTO audit_log_hash_chain_checkpoints (seq, previous_hash, hash)
+    SELECT seq, previous_hash, hash FROM deleted_hash_chain_entries
+)
+DELETE FROM audit_logs
+WHERE id IN (SELECT id FROM old_audit_logs);
+
+-- name: DeleteOldAuditLogHashChainCheckpoints :exec
+-- Deletes the checkpoints that are no longer needed to verify the hash chain.
+-- The latest checkpoint before the first remaining entry is kept, since the
+-- chain continues from it.
+DELETE FROM audit_log_hash_chain_checkpoints
+WHERE seq < (
+    SELECT MAX(seq) FROM audit_log_hash_chain_checkpoints
+    WHERE seq < COALESCE((SELECT MIN(seq) FROM audit_log_hash_chain), 9223372036854775807)
+);
+
+-- name: GetAuditLogsByIDs :many
+SELECT * FROM audit_logs WHERE id = ANY(@ids::uuid[]);
+
+-- name: GetLatestAuditLogHashChainEntry :one
+SELECT * FROM audit_log_hash_chain ORDER BY seq DESC LIMIT 1;
+
+-- name: GetAuditLogHashChainEntries :many
+-- Returns the entries of the audit log hash chain after the given position,
+-- in order.
+SELECT * FROM audit_log_hash_chain
+WHERE seq > @after_seq::bigint
+ORDER BY seq ASC
+LIMIT @limit_count::int;
+
+-- name: InsertAuditLogHashChainEntry :exec
+INSERT INTO audit_log_hash_chain (seq, audit_log_id, previous_hash, hash)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetAuditLogHashChainCheckpoints :many
+-- Returns the checkpoints of purged hash chain entries between the given
+-- positions, in order.
+SELECT * FROM audit_log_hash_chain_checkpoints
+WHERE seq > @after_seq::bigint AND seq < @before_seq::bigint
+ORDER BY seq ASC;
+
+-- name: GetLatestAuditLogHashChainCheckpoint :one
+SELECT * FROM audit_log_hash_chain_checkpoints ORDER BY seq DESC LIMIT 1;
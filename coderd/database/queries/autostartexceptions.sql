@@ -0,0 +1,99 @@
+-- name: GetAutostartExceptionCalendarsByOrganizationID :many
+SELECT
+	*
+FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = @organization_id
+ORDER BY
+	name ASC;
+
+-- name: GetAutostartExceptionCalendarByOrganizationIDAndName :one
+SELECT
+	*
+FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = @organization_id
+	AND name = @name;
+
+-- name: UpsertAutostartExceptionCalendar :one
+INSERT INTO autostart_exception_calendars (
+	id,
+	organization_id,
+	name,
+	description,
+	exceptions,
+	created_at,
+	updated_at
+) VALUES (
+	@id,
+	@organization_id,
+	@name,
+	@description,
+	@exceptions,
+	@created_at,
+	@updated_at
+)
+ON CONFLICT (organization_id, name) DO UPDATE SET
+	description = EXCLUDED.description,
+	exceptions = EXCLUDED.exceptions,
+	updated_at = EXCLUDED.updated_at
+RETURNING *;
+
+-- name: DeleteAutostartExceptionCalendarByOrganizationIDAndName :exec
+DELETE FROM
+	autostart_exception_calendars
+WHERE
+	organization_id = @organization_id
+	AND name = @name;
+
+-- name: GetWorkspaceAutostartExceptionOptOut :one
+SELECT EXISTS (
+	SELECT
+		1
+	FROM
+		workspace_autostart_exception_opt_outs
+	WHERE
+		workspace_id = @workspace_id
+) AS opted_out;
+
+-- name: InsertWorkspaceAutostartExceptionOptOut :exec
+INSERT INTO workspace_autostart_exception_opt_outs (
+	workspace_id,
+	created_at
+) VALUES (
+	@workspace_id,
+	@created_at
+)
+ON CONFLICT (workspace_id) DO NOTHING;
+
+-- name: DeleteWorkspaceAutostartExceptionOptOut :exec
+DELETE FROM
+	workspace_autostart_exception_opt_outs
+WHERE
+	workspace_id = @workspace_id;
+
+-- name: ResetWorkspacesNextStartAtByOrganizationID :exec
+-- Clears the cached next autostart time of every workspace in the organization
+-- with an autostart schedule, so that the lifecycle executor recomputes it
+-- against the organization's current autostart exception calendars.
+UPDATE
+	workspaces
+SET
+	next_start_at = NULL
+WHERE
+	organization_id = @organization_id
+	AND deleted = false
+	AND (
+		autostart_schedule IS NOT NULL
+		OR EXISTS (
+			SELECT 1 FROM workspace_schedule_windows
+			WHERE workspace_schedule_windows.workspace_id = workspaces.id
+		)
+	)
+	AND next_start_at IS NOT NULL
+	-- Prebuilt workspaces (identified by having the prebuilds system user as owner_id)
+	-- are managed by the reconciliation loop, not the lifecycle executor which handles
+	-- next_start_at
+	AND owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::UUID;
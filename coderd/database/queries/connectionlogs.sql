@@ -291,3 +291,12 @@ DO UPDATE SET
 		ELSE connection_logs.code
 	END
 RETURNING *;
+
+-- name: DeleteOldConnectionLogs :execrows
+DELETE FROM connection_logs
+WHERE id IN (
+    SELECT id FROM connection_logs
+    WHERE connect_time < @before_time::timestamp with time zone
+    ORDER BY connect_time ASC
+    LIMIT @limit_count
+);
@@ -772,6 +772,63 @@ FROM unique_template_params utp
 JOIN workspace_build_parameters wbp ON (utp.workspace_build_ids @> ARRAY[wbp.workspace_build_id] AND utp.name = wbp.name)
 GROUP BY utp.num, utp.template_ids, utp.name, utp.type, utp.display_name, utp.description, utp.options, wbp.value;
 
+-- name: GetPrebuildInsights :many
+-- GetPrebuildInsights returns, for each preset, how many of the workspaces
+-- created with it in the given timeframe were served from a prebuilt workspace,
+-- along with the median and 95th percentile time taken to claim a prebuilt
+-- workspace and until the workspace's agents were ready. Presets are matched
+-- across template versions by name. The result can be filtered on
+-- template_ids, meaning only workspaces based on those templates will be
+-- included.
+WITH claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		pc.hit,
+		pc.claim_latency_ms,
+		-- A workspace is ready once all of its agents are, so workspaces with
+		-- agents which are not ready yet have no time to ready.
+		(
+			SELECT
+				CASE WHEN bool_and(wa.ready_at IS NOT NULL) THEN EXTRACT(EPOCH FROM (MAX(wa.ready_at) - pc.created_at)) END
+			FROM workspace_builds wb
+			JOIN workspace_resources wr ON wr.job_id = wb.job_id
+			JOIN workspace_agents wa ON wa.resource_id = wr.id
+			WHERE
+				wb.id = pc.build_id
+				AND wa.parent_id IS NULL
+				AND NOT wa.deleted
+		)::float AS time_to_ready_secs
+	FROM prebuild_claims pc
+	JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE
+		pc.created_at >= @start_time::timestamptz
+		AND pc.created_at < @end_time::timestamptz
+		AND CASE WHEN COALESCE(array_length(@template_ids::uuid[], 1), 0) > 0 THEN tv.template_id = ANY(@template_ids::uuid[]) ELSE TRUE END
+)
+SELECT
+	c.template_id,
+	t.name AS template_name,
+	t.display_name AS template_display_name,
+	o.name AS organization_name,
+	c.preset_name,
+	COUNT(*)::int AS claim_attempts,
+	COUNT(*) FILTER (WHERE c.hit)::int AS claim_hits,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.claim_latency_ms)), -1)::float AS claim_latency_ms_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.claim_latency_ms)), -1)::float AS claim_latency_ms_95,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE c.hit)), -1)::float AS hit_time_to_ready_secs_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE c.hit)), -1)::float AS hit_time_to_ready_secs_95,
+	COALESCE((PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE NOT c.hit)), -1)::float AS miss_time_to_ready_secs_50,
+	COALESCE((PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY c.time_to_ready_secs) FILTER (WHERE NOT c.hit)), -1)::float AS miss_time_to_ready_secs_95
+FROM claims c
+JOIN templates t ON t.id = c.template_id
+JOIN organizations o ON o.id = t.organization_id
+GROUP BY
+	c.template_id, t.name, t.display_name, o.name, c.preset_name
+ORDER BY
+	t.name ASC, c.preset_name ASC;
+
 -- name: GetUserStatusCounts :many
 -- GetUserStatusCounts returns the count of users in each status over time.
 -- The time range is inclusively defined by the start_time and end_time parameters.
@@ -7,11 +7,15 @@ SELECT nt.name                                                    AS notificatio
        u.id                                                       AS user_id,
        u.email                                                    AS user_email,
        COALESCE(NULLIF(u.name, ''), NULLIF(u.username, ''))::text AS user_name,
-       u.username                                                 AS user_username
-FROM notification_templates nt,
-     users u
-WHERE nt.id = @notification_template_id
-  AND u.id = @user_id;
+       u.username                                                 AS user_username,
+       np.user_target_id                                          AS user_target_id,
+       -- Messages which the user has disabled are never held for a digest, so that they are inhibited as usual.
+       (COALESCE(np.digest, FALSE) AND NOT COALESCE(np.disabled, FALSE))::bool AS digest
+FROM notification_templates nt
+         JOIN users u ON u.id = @user_id
+         LEFT JOIN notification_preferences np
+                   ON (np.user_id = u.id AND np.notification_template_id = nt.id)
+WHERE nt.id = @notification_template_id;
 
 -- name: EnqueueNotificationMessage :exec
 INSERT INTO notification_messages (id, notification_template_id, user_id, method, payload, targets, created_by, created_at)
@@ -71,6 +75,20 @@ WITH acquired AS (
                                  ELSE true
                                  END
                              )
+                           -- hold messages for users who have snoozed notifications until the snooze ends, unless
+                           -- they are urgent or destined for the inbox
+                           AND (
+                             nm.method = 'inbox'::notification_method
+                                 OR EXISTS (SELECT 1
+                                            FROM notification_templates AS t
+                                            WHERE t.id = nm.notification_template_id
+                                              AND t.urgent)
+                                 OR NOT EXISTS (SELECT 1
+                                                FROM user_configs AS uc
+                                                WHERE uc.user_id = nm.user_id
+                                                  AND uc.key = 'notification_snooze_until'
+                                                  AND NULLIF(uc.value, '')::timestamptz > NOW())
+                             )
                          ORDER BY nm.created_at ASC
                                   -- Ensure that multiple concurrent readers cannot retrieve the same rows
                              FOR UPDATE OF nm
@@ -82,14 +100,21 @@ SELECT
     nm.id,
     nm.payload,
     nm.method,
+    nm.user_id,
     nm.attempt_count::int                                                 AS attempt_count,
     nm.queued_seconds::float                                              AS queued_seconds,
     -- template
     nt.id                                                                 AS template_id,
-    nt.title_template,
-    nt.body_template,
+    COALESCE(nt.title_template_override, nt.title_template)::text         AS title_template,
+    COALESCE(nt.body_template_override, nt.body_template)::text           AS body_template,
+    nt.urgent                                                             AS urgent,
     -- preferences
-    (CASE WHEN np.disabled IS NULL THEN false ELSE np.disabled END)::bool AS disabled
+    (CASE WHEN np.disabled IS NULL THEN false ELSE np.disabled END)::bool AS disabled,
+    EXISTS (SELECT 1
+            FROM user_configs AS uc
+            WHERE uc.user_id = nm.user_id
+              AND uc.key = 'notification_quiet_hours'
+              AND uc.value = 'true')::bool                                AS quiet_hours
 FROM acquired nm
          JOIN notification_templates nt ON nm.notification_template_id = nt.id
          LEFT JOIN notification_preferences AS np
@@ -128,6 +153,17 @@ FROM (SELECT UNNEST(@ids::uuid[])             AS id,
          AS new_values
 WHERE notification_messages.id = new_values.id;
 
+-- Returns a leased message to the queue without counting a delivery attempt, so that it is not acquired again until
+-- next_retry_after has passed.
+-- name: DeferNotificationMessage :exec
+UPDATE notification_messages
+SET updated_at       = NOW(),
+    status           = 'pending'::notification_message_status,
+    status_reason    = @status_reason,
+    leased_until     = NULL,
+    next_retry_after = @next_retry_after
+WHERE id = @id;
+
 -- Delete all notification messages which have not been updated for over a week.
 -- name: DeleteOldNotificationMessages :exec
 DELETE
@@ -158,12 +194,119 @@ ON CONFLICT (user_id, notification_template_id) DO UPDATE
     SET disabled   = EXCLUDED.disabled,
         updated_at = CURRENT_TIMESTAMP;
 
+-- name: UpdateUserNotificationPreferenceTarget :execrows
+-- Sets the user-defined target for a single notification template, or clears it when NULL.
+-- A new preference row inherits the template's default enablement so that choosing a target
+-- does not implicitly opt the user in to a notification which is disabled by default.
+INSERT
+INTO notification_preferences (user_id, notification_template_id, disabled, user_target_id)
+SELECT @user_id::uuid, nt.id, NOT nt.enabled_by_default, sqlc.narg('user_target_id')::uuid
+FROM notification_templates nt
+WHERE nt.id = @notification_template_id::uuid
+ON CONFLICT (user_id, notification_template_id) DO UPDATE
+    SET user_target_id = EXCLUDED.user_target_id,
+        updated_at     = CURRENT_TIMESTAMP;
+
+-- name: UpdateUserNotificationPreferenceDigest :execrows
+-- Sets whether notifications from a single template are delivered to the user as a digest.
+-- As with targets, a new preference row inherits the template's default enablement.
+INSERT
+INTO notification_preferences (user_id, notification_template_id, disabled, digest)
+SELECT @user_id::uuid, nt.id, NOT nt.enabled_by_default, @digest::bool
+FROM notification_templates nt
+WHERE nt.id = @notification_template_id::uuid
+ON CONFLICT (user_id, notification_template_id) DO UPDATE
+    SET digest     = EXCLUDED.digest,
+        updated_at = CURRENT_TIMESTAMP;
+
+-- name: GetNotificationUserTargetsByUserID :many
+SELECT *
+FROM notification_user_targets
+WHERE user_id = @user_id::uuid
+ORDER BY name ASC;
+
+-- name: GetNotificationUserTargetByID :one
+SELECT *
+FROM notification_user_targets
+WHERE id = @id::uuid;
+
+-- name: GetNotificationUserTargetByPreference :one
+-- Fetch the user-defined target which the user has chosen for the given notification template.
+SELECT nut.*
+FROM notification_user_targets nut
+         JOIN notification_preferences np
+              ON (np.user_target_id = nut.id AND np.user_id = nut.user_id)
+WHERE np.user_id = @user_id::uuid
+  AND np.notification_template_id = @notification_template_id::uuid;
+
+-- name: InsertNotificationUserTarget :one
+INSERT INTO notification_user_targets (id, user_id, name, type, endpoint, secret, secret_key_id, created_at, updated_at)
+VALUES (@id, @user_id, @name, @type, @endpoint, @secret, @secret_key_id, @created_at, @updated_at)
+RETURNING *;
+
+-- name: UpdateNotificationUserTargetSecret :one
+UPDATE notification_user_targets
+SET secret        = @secret,
+    secret_key_id = @secret_key_id,
+    updated_at    = @updated_at
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteNotificationUserTargetByID :exec
+DELETE
+FROM notification_user_targets
+WHERE id = @id;
+
+-- name: GetNotificationWebhookSecrets :many
+-- Fetch all webhook signing secrets, newest first. This includes secrets whose grace period has ended.
+SELECT *
+FROM notification_webhook_secrets
+ORDER BY created_at DESC;
+
+-- name: InsertNotificationWebhookSecret :one
+INSERT INTO notification_webhook_secrets (id, secret, secret_key_id, created_at)
+VALUES (@id, @secret, @secret_key_id, @created_at)
+RETURNING *;
+
+-- name: ExpireActiveNotificationWebhookSecret :exec
+-- Start the grace period of the active secret, which continues to sign deliveries until expires_at.
+UPDATE notification_webhook_secrets
+SET expires_at = @expires_at::timestamptz
+WHERE expires_at IS NULL;
+
+-- name: DeleteExpiringNotificationWebhookSecrets :exec
+-- Delete all secrets which have been rotated out, regardless of whether their grace period has ended.
+-- This is done before rotating so that no more than two secrets are ever accepted at once.
+DELETE
+FROM notification_webhook_secrets
+WHERE expires_at IS NOT NULL;
+
+-- name: UpdateNotificationWebhookSecret :exec
+UPDATE notification_webhook_secrets
+SET secret        = @secret,
+    secret_key_id = @secret_key_id
+WHERE id = @id;
+
 -- name: UpdateNotificationTemplateMethodByID :one
 UPDATE notification_templates
 SET method = sqlc.narg('method')::notification_method
 WHERE id = @id::uuid
 RETURNING *;
 
+-- name: UpdateNotificationTemplateOverridesByID :one
+-- Overrides the title and body templates for this deployment. NULL values fall back to the built-in templates.
+UPDATE notification_templates
+SET title_template_override = sqlc.narg('title_template_override'),
+    body_template_override  = sqlc.narg('body_template_override')
+WHERE id = @id::uuid
+RETURNING *;
+
+-- name: UpdateNotificationTemplateUrgencyByID :one
+UPDATE notification_templates
+SET urgent = @urgent::bool
+WHERE id = @id::uuid
+RETURNING *;
+
 -- name: GetNotificationTemplateByID :one
 SELECT *
 FROM notification_templates
@@ -214,3 +357,29 @@ WHERE user_id = @user_id AND endpoint = @endpoint;
 -- keypair will no longer be valid and all existing subscriptions will need to
 -- be recreated.
 TRUNCATE TABLE webpush_subscriptions;
+
+-- name: InsertNotificationDigestMessage :exec
+INSERT INTO notification_digest_messages (id, notification_template_id, user_id, method, payload, targets, created_by, created_at)
+VALUES (@id,
+        @notification_template_id,
+        @user_id,
+        @method::notification_method,
+        @payload::jsonb,
+        @targets,
+        @created_by,
+        @created_at);
+
+-- name: GetDueNotificationDigestMessages :many
+-- Returns all held messages which belong to a digest whose oldest message was held at or before the given time.
+-- Messages are ordered so that each digest's messages are contiguous, oldest first.
+SELECT *
+FROM notification_digest_messages
+WHERE (user_id, notification_template_id, method) IN (SELECT user_id, notification_template_id, method
+                                                      FROM notification_digest_messages
+                                                      GROUP BY user_id, notification_template_id, method
+                                                      HAVING MIN(created_at) <= @held_before::timestamptz)
+ORDER BY user_id, notification_template_id, method, created_at;
+
+-- name: DeleteNotificationDigestMessages :exec
+DELETE FROM notification_digest_messages
+WHERE id = ANY(@ids::uuid[]);
@@ -140,7 +140,8 @@ INSERT INTO oauth2_provider_app_codes (
     user_id,
     resource_uri,
     code_challenge,
-    code_challenge_method
+    code_challenge_method,
+    scopes
 ) VALUES(
     $1,
     $2,
@@ -151,7 +152,8 @@ INSERT INTO oauth2_provider_app_codes (
     $7,
     $8,
     $9,
-    $10
+    $10,
+    $11
 ) RETURNING *;
 
 -- name: DeleteOAuth2ProviderAppCodeByID :exec
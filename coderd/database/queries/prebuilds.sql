@@ -227,24 +227,71 @@ GROUP BY tsb.template_version_id, tsb.preset_id
 HAVING COUNT(*) = @hard_limit::bigint;
 
 -- name: GetPrebuildMetrics :many
+-- GetPrebuildMetrics returns the number of prebuilt workspaces created, failed and claimed for each preset,
+-- along with the demand since autoscaling_since from which prebuild pools are sized when autoscaling is
+-- enabled, as returned by GetPrebuildClaimDemand.
+WITH prebuild_counts AS (
+	SELECT
+		t.id as template_id,
+		t.name as template_name,
+		tvp.name as preset_name,
+		o.name as organization_name,
+		COUNT(*) as created_count,
+		COUNT(*) FILTER (WHERE pj.job_status = 'failed'::provisioner_job_status) as failed_count,
+		COUNT(*) FILTER (
+				WHERE w.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The system user responsible for prebuilds.
+			) as claimed_count
+	FROM workspaces w
+	INNER JOIN workspace_prebuild_builds wpb ON wpb.workspace_id = w.id
+	INNER JOIN templates t ON t.id = w.template_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN organizations o ON o.id = w.organization_id
+	WHERE NOT t.deleted AND wpb.build_number = 1
+	GROUP BY t.id, t.name, tvp.name, o.name
+),
+claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		COUNT(*) AS claim_attempts,
+		COUNT(*) FILTER (WHERE NOT pc.hit) AS claim_misses
+	FROM prebuild_claims pc
+	INNER JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE pc.created_at >= @autoscaling_since::timestamptz
+	GROUP BY tv.template_id, tvp.name
+),
+build_times AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) FILTER (WHERE pj.completed_at >= @autoscaling_since::timestamptz) AS recent_build_secs,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) AS build_secs
+	FROM workspace_prebuild_builds wpb
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE wpb.transition = 'start'::workspace_transition
+		AND pj.job_status = 'succeeded'::provisioner_job_status
+	GROUP BY tv.template_id, tvp.name
+)
 SELECT
-	t.name as template_name,
-	tvp.name as preset_name,
-	o.name as organization_name,
-	COUNT(*) as created_count,
-	COUNT(*) FILTER (WHERE pj.job_status = 'failed'::provisioner_job_status) as failed_count,
-	COUNT(*) FILTER (
-			WHERE w.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid -- The system user responsible for prebuilds.
-		) as claimed_count
-FROM workspaces w
-INNER JOIN workspace_prebuild_builds wpb ON wpb.workspace_id = w.id
-INNER JOIN templates t ON t.id = w.template_id
-INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
-INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
-INNER JOIN organizations o ON o.id = w.organization_id
-WHERE NOT t.deleted AND wpb.build_number = 1
-GROUP BY t.name, tvp.name, o.name
-ORDER BY t.name, tvp.name, o.name;
+	prebuild_counts.template_name,
+	prebuild_counts.preset_name,
+	prebuild_counts.organization_name,
+	prebuild_counts.created_count,
+	prebuild_counts.failed_count,
+	prebuild_counts.claimed_count,
+	COALESCE(claims.claim_attempts, 0)::int AS claim_attempts,
+	COALESCE(claims.claim_misses, 0)::int AS claim_misses,
+	COALESCE(build_times.recent_build_secs, build_times.build_secs, 0)::float8 AS average_build_secs
+FROM prebuild_counts
+LEFT JOIN claims ON claims.template_id = prebuild_counts.template_id
+	AND claims.preset_name = prebuild_counts.preset_name
+LEFT JOIN build_times ON build_times.template_id = prebuild_counts.template_id
+	AND build_times.preset_name = prebuild_counts.preset_name
+ORDER BY prebuild_counts.template_name, prebuild_counts.preset_name, prebuild_counts.organization_name;
 
 -- name: FindMatchingPresetID :one
 -- FindMatchingPresetID finds a preset ID that is the largest exact subset of the provided parameters.
@@ -272,3 +319,59 @@ FROM preset_matches pm
 WHERE pm.total_preset_params = pm.matching_params  -- All preset parameters must match
 ORDER BY pm.total_preset_params DESC               -- Return the preset with the most parameters
 LIMIT 1;
+
+-- name: InsertPrebuildClaim :exec
+INSERT INTO prebuild_claims (id, preset_id, workspace_id, build_id, hit, claim_latency_ms, created_at)
+VALUES (@id, @preset_id, @workspace_id, @build_id, @hit, @claim_latency_ms, @created_at);
+
+-- name: GetPrebuildClaimDemand :many
+-- GetPrebuildClaimDemand returns the demand for the prebuilt workspaces of each preset since the given time:
+-- the number of attempts to claim one, how many of those found none to claim, and the average time taken
+-- to build a prebuilt workspace, or 0 if the preset was never built. Presets are matched across template versions by name, so that the demand
+-- observed for a preset carries over to new versions of its template.
+WITH claims AS (
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		COUNT(*) AS claim_attempts,
+		COUNT(*) FILTER (WHERE NOT pc.hit) AS claim_misses
+	FROM prebuild_claims pc
+	INNER JOIN template_version_presets tvp ON tvp.id = pc.preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE pc.created_at >= @since::timestamptz
+	GROUP BY tv.template_id, tvp.name
+),
+build_times AS (
+	-- Only successful builds of prebuilt workspaces tell us how long it takes to replace a claimed one.
+	-- Presets without a successful build since the given time fall back to their earlier builds.
+	SELECT
+		tv.template_id,
+		tvp.name AS preset_name,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) FILTER (WHERE pj.completed_at >= @since::timestamptz) AS recent_build_secs,
+		AVG(EXTRACT(EPOCH FROM (pj.completed_at - pj.started_at))) AS build_secs
+	FROM workspace_prebuild_builds wpb
+	INNER JOIN provisioner_jobs pj ON pj.id = wpb.job_id
+	INNER JOIN template_version_presets tvp ON tvp.id = wpb.template_version_preset_id
+	INNER JOIN template_versions tv ON tv.id = tvp.template_version_id
+	WHERE wpb.transition = 'start'::workspace_transition
+		AND pj.job_status = 'succeeded'::provisioner_job_status
+	GROUP BY tv.template_id, tvp.name
+)
+SELECT
+	claims.template_id,
+	claims.preset_name,
+	claims.claim_attempts::int AS claim_attempts,
+	claims.claim_misses::int AS claim_misses,
+	COALESCE(build_times.recent_build_secs, build_times.build_secs, 0)::float8 AS average_build_secs
+FROM claims
+LEFT JOIN build_times ON build_times.template_id = claims.template_id
+	AND build_times.preset_name = claims.preset_name;
+
+-- name: DeleteOldPrebuildClaims :execrows
+DELETE FROM prebuild_claims
+WHERE id IN (
+	SELECT id FROM prebuild_claims
+	WHERE created_at < @before_time::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT @limit_count
+);
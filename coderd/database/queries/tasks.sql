@@ -28,3 +28,126 @@ WHERE tws.deleted_at IS NULL
 AND CASE WHEN @owner_id::UUID != '00000000-0000-0000-0000-000000000000' THEN tws.owner_id = @owner_id::UUID ELSE TRUE END
 AND CASE WHEN @organization_id::UUID != '00000000-0000-0000-0000-000000000000' THEN tws.organization_id = @organization_id::UUID ELSE TRUE END
 ORDER BY tws.created_at DESC;
+
+-- name: InsertTaskStep :one
+INSERT INTO task_steps
+	(workspace_id, step_index, prompt, completion_check, status, started_at)
+VALUES
+	($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetTaskStepsByWorkspaceID :many
+SELECT * FROM task_steps WHERE workspace_id = @workspace_id::uuid ORDER BY step_index ASC;
+
+-- name: GetTaskStepsByWorkspaceIDs :many
+SELECT * FROM task_steps WHERE workspace_id = ANY(@workspace_ids::uuid[]) ORDER BY workspace_id, step_index ASC;
+
+-- name: GetStaleTaskSteps :many
+-- Returns the next step of each task if it has not changed status since before
+-- the given time, so that steps abandoned by a replica which stopped, or whose
+-- prompt could not be sent, can be recovered. Tasks with a failed step are
+-- skipped.
+SELECT * FROM task_steps
+WHERE
+	status IN ('pending', 'running', 'checking')
+	AND updated_at < @updated_before::timestamptz
+	AND NOT EXISTS (
+		SELECT 1 FROM task_steps earlier
+		WHERE
+			earlier.workspace_id = task_steps.workspace_id
+			AND earlier.step_index < task_steps.step_index
+			AND earlier.status != 'completed'
+	)
+ORDER BY workspace_id;
+
+-- name: UpdateTaskStepStatus :one
+-- Moves a step from one status to another. No row is returned if the step is
+-- not in the expected status, or was updated since from_updated_at if given, so
+-- concurrent callers cannot both advance the same step.
+UPDATE task_steps
+SET
+	status = @status::task_step_status,
+	check_exit_code = COALESCE(sqlc.narg('check_exit_code')::integer, check_exit_code),
+	started_at = CASE WHEN @status::task_step_status = 'running' THEN @updated_at::timestamptz ELSE started_at END,
+	completed_at = CASE WHEN @status::task_step_status IN ('completed', 'failed') THEN @updated_at::timestamptz ELSE completed_at END,
+	updated_at = @updated_at::timestamptz
+WHERE
+	workspace_id = @workspace_id::uuid
+	AND step_index = @step_index::integer
+	AND status = @from_status::task_step_status
+	AND (sqlc.narg('from_updated_at')::timestamptz IS NULL OR updated_at = sqlc.narg('from_updated_at')::timestamptz)
+RETURNING *;
+
+-- name: GetTaskWorkspacesForLifecyclePolicy :many
+-- Returns the workspaces whose latest build runs an AI task, along with the
+-- outcome of that build and the latest state reported by the task's app, so
+-- that task lifecycle policies can be enforced. An owner ID of uuid.Nil returns
+-- the task workspaces of every user.
+SELECT
+	workspaces.id AS workspace_id,
+	workspaces.name AS workspace_name,
+	workspaces.owner_id,
+	workspaces.organization_id,
+	workspaces.template_id,
+	latest_build.build_number,
+	latest_build.transition,
+	provisioner_jobs.job_status,
+	provisioner_jobs.completed_at AS job_completed_at,
+	app_status.state AS app_state,
+	app_status.created_at AS app_state_at
+FROM
+	workspaces
+JOIN LATERAL (
+	SELECT
+		workspace_builds.id,
+		workspace_builds.build_number,
+		workspace_builds.transition,
+		workspace_builds.job_id,
+		workspace_builds.has_ai_task,
+		workspace_builds.ai_task_sidebar_app_id
+	FROM
+		workspace_builds
+	WHERE
+		workspace_builds.workspace_id = workspaces.id
+	ORDER BY
+		workspace_builds.build_number DESC
+	LIMIT 1
+) latest_build ON TRUE
+JOIN
+	provisioner_jobs ON provisioner_jobs.id = latest_build.job_id
+LEFT JOIN LATERAL (
+	SELECT
+		workspace_app_statuses.state,
+		workspace_app_statuses.created_at
+	FROM
+		workspace_app_statuses
+	WHERE
+		workspace_app_statuses.workspace_id = workspaces.id
+		AND workspace_app_statuses.app_id = latest_build.ai_task_sidebar_app_id
+	ORDER BY
+		workspace_app_statuses.created_at DESC
+	LIMIT 1
+) app_status ON TRUE
+WHERE
+	workspaces.deleted = false
+	AND (
+		latest_build.has_ai_task
+		-- A build which is in progress does not know whether it has an AI task
+		-- yet, so assume that it does if it was given a prompt.
+		OR (
+			latest_build.has_ai_task IS NULL
+			AND provisioner_jobs.completed_at IS NULL
+			AND EXISTS (
+				SELECT 1
+				FROM workspace_build_parameters
+				WHERE workspace_build_parameters.workspace_build_id = latest_build.id
+				AND workspace_build_parameters.name = 'AI Prompt'
+				AND workspace_build_parameters.value != ''
+			)
+		)
+	)
+	-- Prebuilt workspaces are not tasks until they are claimed.
+	AND workspaces.owner_id != 'c42fdf75-3097-471c-8c33-fb52454d81c0'::uuid
+	AND CASE WHEN @owner_id::uuid != '00000000-0000-0000-0000-000000000000' THEN workspaces.owner_id = @owner_id::uuid ELSE TRUE END
+ORDER BY
+	workspaces.owner_id, workspaces.id;
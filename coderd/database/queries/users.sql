@@ -168,6 +168,76 @@ WHERE user_configs.user_id = @user_id
 	AND user_configs.key = 'terminal_font'
 RETURNING *;
 
+-- name: GetUserNotificationQuietHours :one
+SELECT
+	value as notification_quiet_hours
+FROM
+	user_configs
+WHERE
+	user_id = @user_id
+	AND key = 'notification_quiet_hours';
+
+-- name: UpdateUserNotificationQuietHours :one
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	(@user_id, 'notification_quiet_hours', @notification_quiet_hours)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = @notification_quiet_hours
+WHERE user_configs.user_id = @user_id
+	AND user_configs.key = 'notification_quiet_hours'
+RETURNING *;
+
+-- name: GetUserAIBridgeCapture :one
+SELECT
+	value as aibridge_capture
+FROM
+	user_configs
+WHERE
+	user_id = @user_id
+	AND key = 'aibridge_capture';
+
+-- name: UpdateUserAIBridgeCapture :one
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	(@user_id, 'aibridge_capture', @aibridge_capture)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = @aibridge_capture
+WHERE user_configs.user_id = @user_id
+	AND user_configs.key = 'aibridge_capture'
+RETURNING *;
+
+-- name: GetUserNotificationSnoozeUntil :one
+SELECT
+	value as notification_snooze_until
+FROM
+	user_configs
+WHERE
+	user_id = @user_id
+	AND key = 'notification_snooze_until';
+
+-- name: UpdateUserNotificationSnoozeUntil :one
+-- The value is an RFC 3339 timestamp, or empty when notifications are not snoozed.
+INSERT INTO
+	user_configs (user_id, key, value)
+VALUES
+	(@user_id, 'notification_snooze_until', @notification_snooze_until)
+ON CONFLICT
+	ON CONSTRAINT user_configs_pkey
+DO UPDATE
+SET
+	value = @notification_snooze_until
+WHERE user_configs.user_id = @user_id
+	AND user_configs.key = 'notification_snooze_until'
+RETURNING *;
+
 -- name: UpdateUserRoles :one
 UPDATE
 	users
@@ -0,0 +1,42 @@
+-- name: InsertWorkspaceLifecycleDecision :one
+INSERT INTO workspace_lifecycle_decisions (
+	id,
+	workspace_id,
+	created_at,
+	outcome,
+	transition,
+	build_reason,
+	checks,
+	detail
+) VALUES (
+	@id,
+	@workspace_id,
+	@created_at,
+	@outcome,
+	@transition,
+	@build_reason,
+	@checks,
+	@detail
+)
+RETURNING *;
+
+-- name: GetWorkspaceLifecycleDecisionsByWorkspaceID :many
+SELECT
+	*
+FROM
+	workspace_lifecycle_decisions
+WHERE
+	workspace_id = @workspace_id
+ORDER BY
+	created_at DESC
+LIMIT
+	@limit_count;
+
+-- name: DeleteOldWorkspaceLifecycleDecisions :execrows
+DELETE FROM workspace_lifecycle_decisions
+WHERE id IN (
+	SELECT id FROM workspace_lifecycle_decisions
+	WHERE created_at < @before_time::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT @limit_count
+);
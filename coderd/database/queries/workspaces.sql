@@ -731,14 +731,20 @@ WHERE
 		--   * The provisioner job did not fail.
 		--   * The workspace build was a stop transition.
 		--   * The workspace is not dormant
-		--   * The workspace has an autostart schedule.
+		--   * The workspace has an autostart schedule or additional schedule windows.
 		--   * It is after the workspace's next start time.
 		(
 			users.status = 'active'::user_status AND
 			provisioner_jobs.job_status != 'failed'::provisioner_job_status AND
 			workspace_builds.transition = 'stop'::workspace_transition AND
 			workspaces.dormant_at IS NULL AND
-			workspaces.autostart_schedule IS NOT NULL AND
+			(
+				workspaces.autostart_schedule IS NOT NULL OR
+				EXISTS (
+					SELECT 1 FROM workspace_schedule_windows
+					WHERE workspace_schedule_windows.workspace_id = workspaces.id
+				)
+			) AND
 			(
 				-- next_start_at might be null in these two scenarios:
 				--   * A coder instance was updated and we haven't updated next_start_at yet.
@@ -0,0 +1,42 @@
+-- name: GetWorkspaceScheduleWindowsByWorkspaceID :many
+SELECT
+	*
+FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = @workspace_id
+ORDER BY
+	position ASC;
+
+-- name: GetWorkspaceScheduleWindowsByWorkspaceIDs :many
+SELECT
+	*
+FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = ANY(@ids :: uuid [ ])
+ORDER BY
+	workspace_id ASC,
+	position ASC;
+
+-- name: InsertWorkspaceScheduleWindow :one
+INSERT INTO workspace_schedule_windows (
+	workspace_id,
+	position,
+	autostart_schedule,
+	ttl,
+	created_at
+) VALUES (
+	@workspace_id,
+	@position,
+	@autostart_schedule,
+	@ttl,
+	@created_at
+)
+RETURNING *;
+
+-- name: DeleteWorkspaceScheduleWindowsByWorkspaceID :exec
+DELETE FROM
+	workspace_schedule_windows
+WHERE
+	workspace_id = @workspace_id;
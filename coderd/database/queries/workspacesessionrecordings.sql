@@ -0,0 +1,78 @@
+-- name: UpsertWorkspaceSessionRecording :one
+INSERT INTO workspace_session_recordings (
+	id,
+	created_at,
+	updated_at,
+	organization_id,
+	workspace_id,
+	agent_name,
+	connection_id,
+	truncated,
+	completed
+) VALUES (
+	@id,
+	@created_at,
+	@created_at,
+	@organization_id,
+	@workspace_id,
+	@agent_name,
+	@connection_id,
+	@truncated,
+	@completed
+)
+ON CONFLICT (connection_id, workspace_id, agent_name)
+DO UPDATE SET
+	updated_at = EXCLUDED.updated_at,
+	-- Once set, these can never be unset.
+	truncated = workspace_session_recordings.truncated OR EXCLUDED.truncated,
+	completed = workspace_session_recordings.completed OR EXCLUDED.completed
+RETURNING *;
+
+-- name: InsertWorkspaceSessionRecordingChunk :exec
+WITH inserted_chunk AS (
+	INSERT INTO workspace_session_recording_chunks (recording_id, seq, data)
+	VALUES (@recording_id, @seq, @data)
+	-- Chunks are retried by the agent if the upload fails.
+	ON CONFLICT (recording_id, seq) DO NOTHING
+	RETURNING recording_id, octet_length(data) AS size
+)
+UPDATE workspace_session_recordings
+SET size_bytes = workspace_session_recordings.size_bytes + inserted_chunk.size
+FROM inserted_chunk
+WHERE workspace_session_recordings.id = inserted_chunk.recording_id;
+
+-- name: GetWorkspaceSessionRecordingByConnectionLogID :one
+SELECT
+	workspace_session_recordings.*
+FROM
+	workspace_session_recordings
+JOIN
+	connection_logs
+ON
+	connection_logs.connection_id = workspace_session_recordings.connection_id
+	AND connection_logs.workspace_id = workspace_session_recordings.workspace_id
+	AND connection_logs.agent_name = workspace_session_recordings.agent_name
+WHERE
+	connection_logs.id = @connection_log_id;
+
+-- name: GetWorkspaceSessionRecordingChunks :many
+SELECT
+	*
+FROM
+	workspace_session_recording_chunks
+WHERE
+	recording_id = @recording_id
+	AND seq > @after_seq
+ORDER BY
+	seq ASC
+LIMIT
+	@limit_count;
+
+-- name: DeleteOldWorkspaceSessionRecordings :execrows
+DELETE FROM workspace_session_recordings
+WHERE id IN (
+	SELECT id FROM workspace_session_recordings
+	WHERE created_at < @before_time::timestamp with time zone
+	ORDER BY created_at ASC
+	LIMIT @limit_count
+);
@@ -29,6 +29,19 @@ type AuditOAuthConvertState struct {
 	UserID      uuid.UUID `db:"user_id" json:"user_id"`
 }
 
+// AuditMCPToolCall is never stored in the database. It describes a tool
+// invocation through the MCP server and is provided for audit logging purposes.
+type AuditMCPToolCall struct {
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+	ToolName string    `db:"tool_name" json:"tool_name"`
+	// The set of tools the client connected with, e.g. standard or chatgpt.
+	Toolset string `db:"toolset" json:"toolset"`
+	// The error returned by the tool, empty if the invocation succeeded.
+	Error string `db:"error" json:"error"`
+	// The scopes of the API key the tool was invoked with.
+	Scopes APIKeyScopes `db:"scopes" json:"scopes"`
+}
+
 type HealthSettings struct {
 	ID                    uuid.UUID `db:"id" json:"id"`
 	DismissedHealthchecks []string  `db:"dismissed_healthchecks" json:"dismissed_healthchecks"`
@@ -7,12 +7,22 @@ type UniqueConstraint string
 // UniqueConstraint enums.
 const (
 	UniqueAgentStatsPkey                                      UniqueConstraint = "agent_stats_pkey"                                                // ALTER TABLE ONLY workspace_agent_stats ADD CONSTRAINT agent_stats_pkey PRIMARY KEY (id);
+	UniqueAibridgeInterceptionCapturesPkey                    UniqueConstraint = "aibridge_interception_captures_pkey"                             // ALTER TABLE ONLY aibridge_interception_captures ADD CONSTRAINT aibridge_interception_captures_pkey PRIMARY KEY (interception_id);
 	UniqueAibridgeInterceptionsPkey                           UniqueConstraint = "aibridge_interceptions_pkey"                                     // ALTER TABLE ONLY aibridge_interceptions ADD CONSTRAINT aibridge_interceptions_pkey PRIMARY KEY (id);
+	UniqueAibridgeLimitsPkey                                  UniqueConstraint = "aibridge_limits_pkey"                                            // ALTER TABLE ONLY aibridge_limits ADD CONSTRAINT aibridge_limits_pkey PRIMARY KEY (scope, scope_id);
+	UniqueAibridgeModelPricesPkey                             UniqueConstraint = "aibridge_model_prices_pkey"                                      // ALTER TABLE ONLY aibridge_model_prices ADD CONSTRAINT aibridge_model_prices_pkey PRIMARY KEY (provider, model);
+	UniqueAibridgePolicyDecisionsPkey                         UniqueConstraint = "aibridge_policy_decisions_pkey"                                  // ALTER TABLE ONLY aibridge_policy_decisions ADD CONSTRAINT aibridge_policy_decisions_pkey PRIMARY KEY (id);
 	UniqueAibridgeTokenUsagesPkey                             UniqueConstraint = "aibridge_token_usages_pkey"                                      // ALTER TABLE ONLY aibridge_token_usages ADD CONSTRAINT aibridge_token_usages_pkey PRIMARY KEY (id);
 	UniqueAibridgeToolUsagesPkey                              UniqueConstraint = "aibridge_tool_usages_pkey"                                       // ALTER TABLE ONLY aibridge_tool_usages ADD CONSTRAINT aibridge_tool_usages_pkey PRIMARY KEY (id);
+	UniqueAibridgeUsageStatsPkey                              UniqueConstraint = "aibridge_usage_stats_pkey"                                       // ALTER TABLE ONLY aibridge_usage_stats ADD CONSTRAINT aibridge_usage_stats_pkey PRIMARY KEY (start_time, user_id, template_id, provider, model);
 	UniqueAibridgeUserPromptsPkey                             UniqueConstraint = "aibridge_user_prompts_pkey"                                      // ALTER TABLE ONLY aibridge_user_prompts ADD CONSTRAINT aibridge_user_prompts_pkey PRIMARY KEY (id);
 	UniqueAPIKeysPkey                                         UniqueConstraint = "api_keys_pkey"                                                   // ALTER TABLE ONLY api_keys ADD CONSTRAINT api_keys_pkey PRIMARY KEY (id);
+	UniqueAuditLogHashChainAuditLogIDKey                      UniqueConstraint = "audit_log_hash_chain_audit_log_id_key"                           // ALTER TABLE ONLY audit_log_hash_chain ADD CONSTRAINT audit_log_hash_chain_audit_log_id_key UNIQUE (audit_log_id);
+	UniqueAuditLogHashChainCheckpointsPkey                    UniqueConstraint = "audit_log_hash_chain_checkpoints_pkey"                           // ALTER TABLE ONLY audit_log_hash_chain_checkpoints ADD CONSTRAINT audit_log_hash_chain_checkpoints_pkey PRIMARY KEY (seq);
+	UniqueAuditLogHashChainPkey                               UniqueConstraint = "audit_log_hash_chain_pkey"                                       // ALTER TABLE ONLY audit_log_hash_chain ADD CONSTRAINT audit_log_hash_chain_pkey PRIMARY KEY (seq);
 	UniqueAuditLogsPkey                                       UniqueConstraint = "audit_logs_pkey"                                                 // ALTER TABLE ONLY audit_logs ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
+	UniqueAutostartExceptionCalendarsOrganizationIDNameKey    UniqueConstraint = "autostart_exception_calendars_organization_id_name_key"          // ALTER TABLE ONLY autostart_exception_calendars ADD CONSTRAINT autostart_exception_calendars_organization_id_name_key UNIQUE (organization_id, name);
+	UniqueAutostartExceptionCalendarsPkey                     UniqueConstraint = "autostart_exception_calendars_pkey"                              // ALTER TABLE ONLY autostart_exception_calendars ADD CONSTRAINT autostart_exception_calendars_pkey PRIMARY KEY (id);
 	UniqueConnectionLogsPkey                                  UniqueConstraint = "connection_logs_pkey"                                            // ALTER TABLE ONLY connection_logs ADD CONSTRAINT connection_logs_pkey PRIMARY KEY (id);
 	UniqueCryptoKeysPkey                                      UniqueConstraint = "crypto_keys_pkey"                                                // ALTER TABLE ONLY crypto_keys ADD CONSTRAINT crypto_keys_pkey PRIMARY KEY (feature, sequence);
 	UniqueCustomRolesUniqueKey                                UniqueConstraint = "custom_roles_unique_key"                                         // ALTER TABLE ONLY custom_roles ADD CONSTRAINT custom_roles_unique_key UNIQUE (name, organization_id);
@@ -30,11 +40,15 @@ const (
 	UniqueJfrogXrayScansPkey                                  UniqueConstraint = "jfrog_xray_scans_pkey"                                           // ALTER TABLE ONLY jfrog_xray_scans ADD CONSTRAINT jfrog_xray_scans_pkey PRIMARY KEY (agent_id, workspace_id);
 	UniqueLicensesJWTKey                                      UniqueConstraint = "licenses_jwt_key"                                                // ALTER TABLE ONLY licenses ADD CONSTRAINT licenses_jwt_key UNIQUE (jwt);
 	UniqueLicensesPkey                                        UniqueConstraint = "licenses_pkey"                                                   // ALTER TABLE ONLY licenses ADD CONSTRAINT licenses_pkey PRIMARY KEY (id);
+	UniqueNotificationDigestMessagesPkey                      UniqueConstraint = "notification_digest_messages_pkey"                               // ALTER TABLE ONLY notification_digest_messages ADD CONSTRAINT notification_digest_messages_pkey PRIMARY KEY (id);
 	UniqueNotificationMessagesPkey                            UniqueConstraint = "notification_messages_pkey"                                      // ALTER TABLE ONLY notification_messages ADD CONSTRAINT notification_messages_pkey PRIMARY KEY (id);
 	UniqueNotificationPreferencesPkey                         UniqueConstraint = "notification_preferences_pkey"                                   // ALTER TABLE ONLY notification_preferences ADD CONSTRAINT notification_preferences_pkey PRIMARY KEY (user_id, notification_template_id);
 	UniqueNotificationReportGeneratorLogsPkey                 UniqueConstraint = "notification_report_generator_logs_pkey"                         // ALTER TABLE ONLY notification_report_generator_logs ADD CONSTRAINT notification_report_generator_logs_pkey PRIMARY KEY (notification_template_id);
 	UniqueNotificationTemplatesNameKey                        UniqueConstraint = "notification_templates_name_key"                                 // ALTER TABLE ONLY notification_templates ADD CONSTRAINT notification_templates_name_key UNIQUE (name);
 	UniqueNotificationTemplatesPkey                           UniqueConstraint = "notification_templates_pkey"                                     // ALTER TABLE ONLY notification_templates ADD CONSTRAINT notification_templates_pkey PRIMARY KEY (id);
+	UniqueNotificationUserTargetsPkey                         UniqueConstraint = "notification_user_targets_pkey"                                  // ALTER TABLE ONLY notification_user_targets ADD CONSTRAINT notification_user_targets_pkey PRIMARY KEY (id);
+	UniqueNotificationUserTargetsUserIDNameKey                UniqueConstraint = "notification_user_targets_user_id_name_key"                      // ALTER TABLE ONLY notification_user_targets ADD CONSTRAINT notification_user_targets_user_id_name_key UNIQUE (user_id, name);
+	UniqueNotificationWebhookSecretsPkey                      UniqueConstraint = "notification_webhook_secrets_pkey"                               // ALTER TABLE ONLY notification_webhook_secrets ADD CONSTRAINT notification_webhook_secrets_pkey PRIMARY KEY (id);
 	UniqueOauth2ProviderAppCodesPkey                          UniqueConstraint = "oauth2_provider_app_codes_pkey"                                  // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_pkey PRIMARY KEY (id);
 	UniqueOauth2ProviderAppCodesSecretPrefixKey               UniqueConstraint = "oauth2_provider_app_codes_secret_prefix_key"                     // ALTER TABLE ONLY oauth2_provider_app_codes ADD CONSTRAINT oauth2_provider_app_codes_secret_prefix_key UNIQUE (secret_prefix);
 	UniqueOauth2ProviderAppSecretsPkey                        UniqueConstraint = "oauth2_provider_app_secrets_pkey"                                // ALTER TABLE ONLY oauth2_provider_app_secrets ADD CONSTRAINT oauth2_provider_app_secrets_pkey PRIMARY KEY (id);
@@ -48,6 +62,7 @@ const (
 	UniqueParameterSchemasPkey                                UniqueConstraint = "parameter_schemas_pkey"                                          // ALTER TABLE ONLY parameter_schemas ADD CONSTRAINT parameter_schemas_pkey PRIMARY KEY (id);
 	UniqueParameterValuesPkey                                 UniqueConstraint = "parameter_values_pkey"                                           // ALTER TABLE ONLY parameter_values ADD CONSTRAINT parameter_values_pkey PRIMARY KEY (id);
 	UniqueParameterValuesScopeIDNameKey                       UniqueConstraint = "parameter_values_scope_id_name_key"                              // ALTER TABLE ONLY parameter_values ADD CONSTRAINT parameter_values_scope_id_name_key UNIQUE (scope_id, name);
+	UniquePrebuildClaimsPkey                                  UniqueConstraint = "prebuild_claims_pkey"                                            // ALTER TABLE ONLY prebuild_claims ADD CONSTRAINT prebuild_claims_pkey PRIMARY KEY (id);
 	UniqueProvisionerDaemonsPkey                              UniqueConstraint = "provisioner_daemons_pkey"                                        // ALTER TABLE ONLY provisioner_daemons ADD CONSTRAINT provisioner_daemons_pkey PRIMARY KEY (id);
 	UniqueProvisionerJobLogsPkey                              UniqueConstraint = "provisioner_job_logs_pkey"                                       // ALTER TABLE ONLY provisioner_job_logs ADD CONSTRAINT provisioner_job_logs_pkey PRIMARY KEY (id);
 	UniqueProvisionerJobsPkey                                 UniqueConstraint = "provisioner_jobs_pkey"                                           // ALTER TABLE ONLY provisioner_jobs ADD CONSTRAINT provisioner_jobs_pkey PRIMARY KEY (id);
@@ -59,6 +74,7 @@ const (
 	UniqueTailnetCoordinatorsPkey                             UniqueConstraint = "tailnet_coordinators_pkey"                                       // ALTER TABLE ONLY tailnet_coordinators ADD CONSTRAINT tailnet_coordinators_pkey PRIMARY KEY (id);
 	UniqueTailnetPeersPkey                                    UniqueConstraint = "tailnet_peers_pkey"                                              // ALTER TABLE ONLY tailnet_peers ADD CONSTRAINT tailnet_peers_pkey PRIMARY KEY (id, coordinator_id);
 	UniqueTailnetTunnelsPkey                                  UniqueConstraint = "tailnet_tunnels_pkey"                                            // ALTER TABLE ONLY tailnet_tunnels ADD CONSTRAINT tailnet_tunnels_pkey PRIMARY KEY (coordinator_id, src_id, dst_id);
+	UniqueTaskStepsPkey                                       UniqueConstraint = "task_steps_pkey"                                                 // ALTER TABLE ONLY task_steps ADD CONSTRAINT task_steps_pkey PRIMARY KEY (workspace_id, step_index);
 	UniqueTaskWorkspaceAppsPkey                               UniqueConstraint = "task_workspace_apps_pkey"                                        // ALTER TABLE ONLY task_workspace_apps ADD CONSTRAINT task_workspace_apps_pkey PRIMARY KEY (task_id, workspace_build_number);
 	UniqueTasksPkey                                           UniqueConstraint = "tasks_pkey"                                                      // ALTER TABLE ONLY tasks ADD CONSTRAINT tasks_pkey PRIMARY KEY (id);
 	UniqueTelemetryItemsPkey                                  UniqueConstraint = "telemetry_items_pkey"                                            // ALTER TABLE ONLY telemetry_items ADD CONSTRAINT telemetry_items_pkey PRIMARY KEY (key);
@@ -99,19 +115,26 @@ const (
 	UniqueWorkspaceAppStatusesPkey                            UniqueConstraint = "workspace_app_statuses_pkey"                                     // ALTER TABLE ONLY workspace_app_statuses ADD CONSTRAINT workspace_app_statuses_pkey PRIMARY KEY (id);
 	UniqueWorkspaceAppsAgentIDSlugIndex                       UniqueConstraint = "workspace_apps_agent_id_slug_idx"                                // ALTER TABLE ONLY workspace_apps ADD CONSTRAINT workspace_apps_agent_id_slug_idx UNIQUE (agent_id, slug);
 	UniqueWorkspaceAppsPkey                                   UniqueConstraint = "workspace_apps_pkey"                                             // ALTER TABLE ONLY workspace_apps ADD CONSTRAINT workspace_apps_pkey PRIMARY KEY (id);
+	UniqueWorkspaceAutostartExceptionOptOutsPkey              UniqueConstraint = "workspace_autostart_exception_opt_outs_pkey"                     // ALTER TABLE ONLY workspace_autostart_exception_opt_outs ADD CONSTRAINT workspace_autostart_exception_opt_outs_pkey PRIMARY KEY (workspace_id);
 	UniqueWorkspaceBuildParametersWorkspaceBuildIDNameKey     UniqueConstraint = "workspace_build_parameters_workspace_build_id_name_key"          // ALTER TABLE ONLY workspace_build_parameters ADD CONSTRAINT workspace_build_parameters_workspace_build_id_name_key UNIQUE (workspace_build_id, name);
 	UniqueWorkspaceBuildsJobIDKey                             UniqueConstraint = "workspace_builds_job_id_key"                                     // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_job_id_key UNIQUE (job_id);
 	UniqueWorkspaceBuildsPkey                                 UniqueConstraint = "workspace_builds_pkey"                                           // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_pkey PRIMARY KEY (id);
 	UniqueWorkspaceBuildsWorkspaceIDBuildNumberKey            UniqueConstraint = "workspace_builds_workspace_id_build_number_key"                  // ALTER TABLE ONLY workspace_builds ADD CONSTRAINT workspace_builds_workspace_id_build_number_key UNIQUE (workspace_id, build_number);
+	UniqueWorkspaceLifecycleDecisionsPkey                     UniqueConstraint = "workspace_lifecycle_decisions_pkey"                              // ALTER TABLE ONLY workspace_lifecycle_decisions ADD CONSTRAINT workspace_lifecycle_decisions_pkey PRIMARY KEY (id);
 	UniqueWorkspaceProxiesPkey                                UniqueConstraint = "workspace_proxies_pkey"                                          // ALTER TABLE ONLY workspace_proxies ADD CONSTRAINT workspace_proxies_pkey PRIMARY KEY (id);
 	UniqueWorkspaceProxiesRegionIDUnique                      UniqueConstraint = "workspace_proxies_region_id_unique"                              // ALTER TABLE ONLY workspace_proxies ADD CONSTRAINT workspace_proxies_region_id_unique UNIQUE (region_id);
 	UniqueWorkspaceResourceMetadataName                       UniqueConstraint = "workspace_resource_metadata_name"                                // ALTER TABLE ONLY workspace_resource_metadata ADD CONSTRAINT workspace_resource_metadata_name UNIQUE (workspace_resource_id, key);
 	UniqueWorkspaceResourceMetadataPkey                       UniqueConstraint = "workspace_resource_metadata_pkey"                                // ALTER TABLE ONLY workspace_resource_metadata ADD CONSTRAINT workspace_resource_metadata_pkey PRIMARY KEY (id);
 	UniqueWorkspaceResourcesPkey                              UniqueConstraint = "workspace_resources_pkey"                                        // ALTER TABLE ONLY workspace_resources ADD CONSTRAINT workspace_resources_pkey PRIMARY KEY (id);
+	UniqueWorkspaceScheduleWindowsPkey                        UniqueConstraint = "workspace_schedule_windows_pkey"                                 // ALTER TABLE ONLY workspace_schedule_windows ADD CONSTRAINT workspace_schedule_windows_pkey PRIMARY KEY (workspace_id, "position");
+	UniqueWorkspaceSessionRecordingChunksPkey                 UniqueConstraint = "workspace_session_recording_chunks_pkey"                         // ALTER TABLE ONLY workspace_session_recording_chunks ADD CONSTRAINT workspace_session_recording_chunks_pkey PRIMARY KEY (recording_id, seq);
+	UniqueWorkspaceSessionRecordingsConnectionKey             UniqueConstraint = "workspace_session_recordings_connection_key"                     // ALTER TABLE ONLY workspace_session_recordings ADD CONSTRAINT workspace_session_recordings_connection_key UNIQUE (connection_id, workspace_id, agent_name);
+	UniqueWorkspaceSessionRecordingsPkey                      UniqueConstraint = "workspace_session_recordings_pkey"                               // ALTER TABLE ONLY workspace_session_recordings ADD CONSTRAINT workspace_session_recordings_pkey PRIMARY KEY (id);
 	UniqueWorkspacesPkey                                      UniqueConstraint = "workspaces_pkey"                                                 // ALTER TABLE ONLY workspaces ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
 	UniqueIndexAPIKeyName                                     UniqueConstraint = "idx_api_key_name"                                                // CREATE UNIQUE INDEX idx_api_key_name ON api_keys USING btree (user_id, token_name) WHERE (login_type = 'token'::login_type);
 	UniqueIndexConnectionLogsConnectionIDWorkspaceIDAgentName UniqueConstraint = "idx_connection_logs_connection_id_workspace_id_agent_name"       // CREATE UNIQUE INDEX idx_connection_logs_connection_id_workspace_id_agent_name ON connection_logs USING btree (connection_id, workspace_id, agent_name);
 	UniqueIndexCustomRolesNameLower                           UniqueConstraint = "idx_custom_roles_name_lower"                                     // CREATE UNIQUE INDEX idx_custom_roles_name_lower ON custom_roles USING btree (lower(name));
+	UniqueIndexNotificationWebhookSecretsActive               UniqueConstraint = "idx_notification_webhook_secrets_active"                         // CREATE UNIQUE INDEX idx_notification_webhook_secrets_active ON notification_webhook_secrets USING btree (((expires_at IS NULL))) WHERE (expires_at IS NULL);
 	UniqueIndexOrganizationNameLower                          UniqueConstraint = "idx_organization_name_lower"                                     // CREATE UNIQUE INDEX idx_organization_name_lower ON organizations USING btree (lower(name)) WHERE (deleted = false);
 	UniqueIndexProvisionerDaemonsOrgNameOwnerKey              UniqueConstraint = "idx_provisioner_daemons_org_name_owner_key"                      // CREATE UNIQUE INDEX idx_provisioner_daemons_org_name_owner_key ON provisioner_daemons USING btree (organization_id, name, lower(COALESCE((tags ->> 'owner'::text), ''::text)));
 	UniqueIndexTemplateVersionPresetsDefault                  UniqueConstraint = "idx_template_version_presets_default"                            // CREATE UNIQUE INDEX idx_template_version_presets_default ON template_version_presets USING btree (template_version_id) WHERE (is_default = true);
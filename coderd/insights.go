@@ -533,6 +533,113 @@ func (api *API) insightsTemplates(rw http.ResponseWriter, r *http.Request) {
 	httpapi.Write(ctx, rw, http.StatusOK, resp)
 }
 
+// @Summary Get insights about prebuilt workspaces
+// @ID get-insights-about-prebuilt-workspaces
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Insights
+// @Param start_time query string true "Start time" format(date-time)
+// @Param end_time query string true "End time" format(date-time)
+// @Param template_ids query []string false "Template IDs" collectionFormat(csv)
+// @Success 200 {object} codersdk.PrebuildsInsightsResponse
+// @Router /insights/prebuilds [get]
+func (api *API) insightsPrebuilds(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	p := httpapi.NewQueryParamParser().
+		RequiredNotEmpty("start_time").
+		RequiredNotEmpty("end_time")
+	vals := r.URL.Query()
+	var (
+		// The QueryParamParser does not preserve timezone, so we need
+		// to parse the time ourselves.
+		startTimeString = p.String(vals, "", "start_time")
+		endTimeString   = p.String(vals, "", "end_time")
+		templateIDs     = p.UUIDs(vals, []uuid.UUID{}, "template_ids")
+	)
+	p.ErrorExcessParams(vals)
+	if len(p.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: p.Errors,
+		})
+		return
+	}
+
+	startTime, endTime, ok := parseInsightsStartAndEndTime(ctx, rw, time.Now(), startTimeString, endTimeString)
+	if !ok {
+		return
+	}
+
+	rows, err := api.Database.GetPrebuildInsights(ctx, database.GetPrebuildInsightsParams{
+		StartTime:   startTime,
+		EndTime:     endTime,
+		TemplateIDs: templateIDs,
+	})
+	if err != nil {
+		if httpapi.Is404Error(err) {
+			httpapi.ResourceNotFound(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching prebuild insights.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	templateIDSet := make(map[uuid.UUID]struct{})
+	presets := make([]codersdk.PresetPrebuildInsights, 0, len(rows))
+	for _, row := range rows {
+		templateIDSet[row.TemplateID] = struct{}{}
+		var hitRate float64
+		if row.ClaimAttempts > 0 {
+			hitRate = float64(row.ClaimHits) / float64(row.ClaimAttempts)
+		}
+		presets = append(presets, codersdk.PresetPrebuildInsights{
+			TemplateID:          row.TemplateID,
+			TemplateName:        row.TemplateName,
+			TemplateDisplayName: row.TemplateDisplayName,
+			OrganizationName:    row.OrganizationName,
+			PresetName:          row.PresetName,
+			Workspaces:          int64(row.ClaimAttempts),
+			Hits:                int64(row.ClaimHits),
+			HitRate:             hitRate,
+			ClaimLatencyMS: codersdk.PrebuildInsightsPercentiles{
+				P50: row.ClaimLatencyMs50,
+				P95: row.ClaimLatencyMs95,
+			},
+			HitTimeToReadySeconds: codersdk.PrebuildInsightsPercentiles{
+				P50: row.HitTimeToReadySecs50,
+				P95: row.HitTimeToReadySecs95,
+			},
+			MissTimeToReadySeconds: codersdk.PrebuildInsightsPercentiles{
+				P50: row.MissTimeToReadySecs50,
+				P95: row.MissTimeToReadySecs95,
+			},
+		})
+	}
+
+	// TemplateIDs that contributed to the data.
+	seenTemplateIDs := make([]uuid.UUID, 0, len(templateIDSet))
+	for templateID := range templateIDSet {
+		seenTemplateIDs = append(seenTemplateIDs, templateID)
+	}
+	slices.SortFunc(seenTemplateIDs, func(a, b uuid.UUID) int {
+		return slice.Ascending(a.String(), b.String())
+	})
+
+	resp := codersdk.PrebuildsInsightsResponse{
+		Report: codersdk.PrebuildsInsightsReport{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			TemplateIDs: seenTemplateIDs,
+			Presets:     presets,
+		},
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
 // convertTemplateInsightsApps builds the list of builtin apps and template apps
 // from the provided database rows, builtin apps are implicitly a part of all
 // templates.
@@ -2,6 +2,7 @@ package coderd_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,9 +26,11 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbrollup"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/workspaceapps"
 	"github.com/coder/coder/v2/coderd/workspacestats"
@@ -2346,3 +2349,85 @@ func TestGenericInsights_RBAC(t *testing.T) {
 		})
 	}
 }
+
+func TestPrebuildsInsights(t *testing.T) {
+	t.Parallel()
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+
+	y, m, d := time.Now().UTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	claimedAt := dbtime.Now().Add(-time.Hour)
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	// Given: a workspace served from a prebuilt workspace and one which was not,
+	// both created with the same preset.
+	hit := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        owner.UserID,
+		OrganizationID: owner.OrganizationID,
+	}).WithAgent().Do()
+	miss := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        owner.UserID,
+		OrganizationID: owner.OrganizationID,
+		TemplateID:     hit.Template.ID,
+	}).WithAgent().Do()
+	preset := dbgen.Preset(t, db, database.InsertPresetParams{
+		TemplateVersionID: hit.TemplateVersion.ID,
+	})
+
+	for _, c := range []struct {
+		build          database.WorkspaceBuild
+		hit            bool
+		claimLatencyMs int32
+		timeToReady    time.Duration
+	}{
+		{build: hit.Build, hit: true, claimLatencyMs: 5, timeToReady: 10 * time.Second},
+		{build: miss.Build, hit: false, claimLatencyMs: 15, timeToReady: 5 * time.Minute},
+	} {
+		err := db.InsertPrebuildClaim(ctx, database.InsertPrebuildClaimParams{
+			ID:             uuid.New(),
+			PresetID:       preset.ID,
+			WorkspaceID:    uuid.NullUUID{UUID: c.build.WorkspaceID, Valid: true},
+			BuildID:        uuid.NullUUID{UUID: c.build.ID, Valid: true},
+			Hit:            c.hit,
+			ClaimLatencyMs: c.claimLatencyMs,
+			CreatedAt:      claimedAt,
+		})
+		require.NoError(t, err)
+
+		agents, err := db.GetWorkspaceAgentsByWorkspaceAndBuildNumber(ctx, database.GetWorkspaceAgentsByWorkspaceAndBuildNumberParams{
+			WorkspaceID: c.build.WorkspaceID,
+			BuildNumber: c.build.BuildNumber,
+		})
+		require.NoError(t, err)
+		require.Len(t, agents, 1)
+		err = db.UpdateWorkspaceAgentLifecycleStateByID(ctx, database.UpdateWorkspaceAgentLifecycleStateByIDParams{
+			ID:             agents[0].ID,
+			LifecycleState: database.WorkspaceAgentLifecycleStateReady,
+			StartedAt:      sql.NullTime{Time: claimedAt, Valid: true},
+			ReadyAt:        sql.NullTime{Time: claimedAt.Add(c.timeToReady), Valid: true},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.PrebuildsInsights(ctx, codersdk.PrebuildsInsightsRequest{
+		StartTime: today.AddDate(0, 0, -1),
+		EndTime:   time.Now().UTC().Truncate(time.Hour).Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Then: the preset reports one hit out of two workspaces.
+	require.Equal(t, []uuid.UUID{hit.Template.ID}, resp.Report.TemplateIDs)
+	require.Len(t, resp.Report.Presets, 1)
+	insights := resp.Report.Presets[0]
+	require.Equal(t, hit.Template.ID, insights.TemplateID)
+	require.Equal(t, preset.Name, insights.PresetName)
+	require.EqualValues(t, 2, insights.Workspaces)
+	require.EqualValues(t, 1, insights.Hits)
+	require.InDelta(t, 0.5, insights.HitRate, 0.001)
+	require.InDelta(t, 10, insights.ClaimLatencyMS.P50, 0.001)
+	require.InDelta(t, 10, insights.HitTimeToReadySeconds.P50, 0.001)
+	require.InDelta(t, 300, insights.MissTimeToReadySeconds.P50, 0.001)
+}
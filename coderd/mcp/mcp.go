@@ -15,6 +15,8 @@ import (
 	"cdr.dev/slog"
 
 	"github.com/coder/coder/v2/buildinfo"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/toolsdk"
 )
@@ -38,10 +40,39 @@ type Server struct {
 
 	// streamableServer handles HTTP transport
 	streamableServer *server.StreamableHTTPServer
+
+	// scope is the API key scope of the client. Only the tools it permits are
+	// registered. Nil permits all tools.
+	scope *rbac.Scope
+
+	// onToolCall is called after each tool invocation.
+	onToolCall func(ctx context.Context, call ToolCall)
+}
+
+// ToolCall describes a single tool invocation.
+type ToolCall struct {
+	Name string
+	// Err is the error returned by the tool, if any.
+	Err error
+}
+
+// WithScope only registers the tools permitted by the API key scope of the
+// client. Tools which are not permitted are neither listed nor callable.
+func WithScope(scope rbac.Scope) func(*Server) {
+	return func(s *Server) {
+		s.scope = &scope
+	}
+}
+
+// WithToolCallHook calls fn after each tool invocation, e.g. to audit it.
+func WithToolCallHook(fn func(ctx context.Context, call ToolCall)) func(*Server) {
+	return func(s *Server) {
+		s.onToolCall = fn
+	}
 }
 
 // NewServer creates a new MCP HTTP server
-func NewServer(logger slog.Logger) (*Server, error) {
+func NewServer(logger slog.Logger, opts ...func(*Server)) (*Server, error) {
 	// Create the core MCP server
 	mcpSrv := server.NewMCPServer(
 		MCPServerName,
@@ -58,11 +89,15 @@ func NewServer(logger slog.Logger) (*Server, error) {
 		server.WithLogger(mcpLogger),
 	)
 
-	return &Server{
+	s := &Server{
 		Logger:           logger,
 		mcpServer:        mcpSrv,
 		streamableServer: streamableServer,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
 }
 
 // ServeHTTP implements http.Handler interface
@@ -91,8 +126,11 @@ func (s *Server) RegisterTools(client *codersdk.Client) error {
 			tool.Name == toolsdk.ToolNameChatGPTSearch || tool.Name == toolsdk.ToolNameChatGPTFetch {
 			continue
 		}
+		if !s.toolPermitted(tool) {
+			continue
+		}
 
-		s.mcpServer.AddTools(mcpFromSDK(tool, toolDeps))
+		s.mcpServer.AddTools(s.mcpFromSDK(tool, toolDeps))
 	}
 	return nil
 }
@@ -116,14 +154,43 @@ func (s *Server) RegisterChatGPTTools(client *codersdk.Client) error {
 		if tool.Name != toolsdk.ToolNameChatGPTSearch && tool.Name != toolsdk.ToolNameChatGPTFetch {
 			continue
 		}
+		if !s.toolPermitted(tool) {
+			continue
+		}
 
-		s.mcpServer.AddTools(mcpFromSDK(tool, toolDeps))
+		s.mcpServer.AddTools(s.mcpFromSDK(tool, toolDeps))
 	}
 	return nil
 }
 
+func (s *Server) toolPermitted(tool toolsdk.GenericTool) bool {
+	if s.scope == nil {
+		return true
+	}
+	return ToolPermitted(*s.scope, tool)
+}
+
+// ToolPermitted returns true if an API key with the given scope may use the
+// tool, i.e. the scope grants every scope required by the tool. Tools which
+// do not declare their scopes are only permitted for unrestricted keys.
+func ToolPermitted(scope rbac.Scope, tool toolsdk.GenericTool) bool {
+	if len(tool.Scopes) == 0 {
+		return scope.Grants(policy.WildcardSymbol, policy.WildcardSymbol)
+	}
+	for _, required := range tool.Scopes {
+		resource, action, ok := rbac.ParseResourceAction(string(required))
+		if !ok {
+			return false
+		}
+		if !scope.Grants(resource, policy.Action(action)) {
+			return false
+		}
+	}
+	return true
+}
+
 // mcpFromSDK adapts a toolsdk.Tool to go-mcp's server.ServerTool
-func mcpFromSDK(sdkTool toolsdk.GenericTool, tb toolsdk.Deps) server.ServerTool {
+func (s *Server) mcpFromSDK(sdkTool toolsdk.GenericTool, tb toolsdk.Deps) server.ServerTool {
 	if sdkTool.Schema.Properties == nil {
 		panic("developer error: schema properties cannot be nil")
 	}
@@ -144,6 +211,9 @@ func mcpFromSDK(sdkTool toolsdk.GenericTool, tb toolsdk.Deps) server.ServerTool
 				return nil, xerrors.Errorf("failed to encode request arguments: %w", err)
 			}
 			result, err := sdkTool.Handler(ctx, tb, buf.Bytes())
+			if s.onToolCall != nil {
+				s.onToolCall(ctx, ToolCall{Name: sdkTool.Name, Err: err})
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -11,7 +11,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/coder/coder/v2/coderd/database"
 	mcpserver "github.com/coder/coder/v2/coderd/mcp"
+	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/codersdk/toolsdk"
 	"github.com/coder/coder/v2/testutil"
@@ -131,3 +133,140 @@ func TestMCPHTTP_ToolRegistration(t *testing.T) {
 	require.Contains(t, toolNames, toolsdk.ToolNameReportTask, "Should include ReportTask (UserClientOptional)")
 	require.Contains(t, toolNames, toolsdk.ToolNameGetAuthenticatedUser, "Should include GetAuthenticatedUser (requires auth)")
 }
+
+func TestMCPHTTP_ToolRegistrationScoped(t *testing.T) {
+	t.Parallel()
+
+	logger := testutil.Logger(t)
+
+	// Given: a server for a client whose API key can only read workspaces and
+	// templates.
+	scope, err := database.APIKeyScopes{
+		database.ApiKeyScopeWorkspaceRead,
+		database.ApiKeyScopeTemplateRead,
+	}.WithAllowList(database.AllowList{rbac.AllowListAll()}).Expand()
+	require.NoError(t, err)
+	server, err := mcpserver.NewServer(logger, mcpserver.WithScope(scope))
+	require.NoError(t, err)
+	client := codersdk.New(testutil.MustURL(t, "http://not-used"))
+	require.NoError(t, server.RegisterTools(client))
+
+	// When: the client lists the tools.
+	sessionID := mcpRequest(t, server, "", "initialize", map[string]any{
+		"protocolVersion": mcp.LATEST_PROTOCOL_VERSION,
+		"capabilities":    map[string]any{},
+		"clientInfo": map[string]any{
+			"name":    "test-client",
+			"version": "1.0.0",
+		},
+	}, nil)
+	var result mcp.ListToolsResult
+	mcpRequest(t, server, sessionID, "tools/list", map[string]any{}, &result)
+
+	// Then: only the read-only tools are listed.
+	toolNames := make([]string, 0, len(result.Tools))
+	for _, tool := range result.Tools {
+		toolNames = append(toolNames, tool.Name)
+	}
+	require.Contains(t, toolNames, toolsdk.ToolNameListWorkspaces)
+	require.Contains(t, toolNames, toolsdk.ToolNameGetWorkspace)
+	require.Contains(t, toolNames, toolsdk.ToolNameListTemplates)
+	require.NotContains(t, toolNames, toolsdk.ToolNameWorkspaceBash)
+	require.NotContains(t, toolNames, toolsdk.ToolNameDeleteTemplate)
+	require.NotContains(t, toolNames, toolsdk.ToolNameCreateWorkspace)
+}
+
+func TestToolPermitted(t *testing.T) {
+	t.Parallel()
+
+	expand := func(t *testing.T, scopes ...database.APIKeyScope) rbac.Scope {
+		t.Helper()
+		scope, err := database.APIKeyScopes(scopes).WithAllowList(database.AllowList{rbac.AllowListAll()}).Expand()
+		require.NoError(t, err)
+		return scope
+	}
+
+	t.Run("AllToolsDeclareScopes", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tool := range toolsdk.All {
+			if tool.Name == toolsdk.ToolNameReportTask {
+				// Not exposed by the remote MCP server.
+				continue
+			}
+			require.NotEmpty(t, tool.Scopes, "tool %s must declare its scopes", tool.Name)
+			for _, scope := range tool.Scopes {
+				require.True(t, rbac.IsExternalScope(rbac.ScopeName(scope)), "tool %s requires non-public scope %s", tool.Name, scope)
+			}
+		}
+	})
+
+	t.Run("All", func(t *testing.T) {
+		t.Parallel()
+
+		scope := expand(t, database.ApiKeyScopeCoderAll)
+		for _, tool := range toolsdk.All {
+			require.True(t, mcpserver.ToolPermitted(scope, tool), "tool %s", tool.Name)
+		}
+	})
+
+	t.Run("Composite", func(t *testing.T) {
+		t.Parallel()
+
+		// coder:workspaces.access grants workspace:ssh.
+		scope := expand(t, database.ApiKeyScopeCoderWorkspacesaccess)
+		require.True(t, mcpserver.ToolPermitted(scope, toolsdk.WorkspaceBash.Generic()))
+		require.False(t, mcpserver.ToolPermitted(scope, toolsdk.DeleteTemplate.Generic()))
+	})
+
+	t.Run("RequiresAllScopes", func(t *testing.T) {
+		t.Parallel()
+
+		// Creating a workspace also requires template:use.
+		scope := expand(t, database.ApiKeyScopeWorkspaceCreate)
+		require.False(t, mcpserver.ToolPermitted(scope, toolsdk.CreateWorkspace.Generic()))
+		scope = expand(t, database.ApiKeyScopeWorkspaceCreate, database.ApiKeyScopeTemplateUse)
+		require.True(t, mcpserver.ToolPermitted(scope, toolsdk.CreateWorkspace.Generic()))
+	})
+
+	t.Run("UndeclaredScopes", func(t *testing.T) {
+		t.Parallel()
+
+		tool := toolsdk.ReportTask.Generic()
+		require.False(t, mcpserver.ToolPermitted(expand(t, database.ApiKeyScopeWorkspaceRead), tool))
+		require.True(t, mcpserver.ToolPermitted(expand(t, database.ApiKeyScopeCoderAll), tool))
+	})
+}
+
+// mcpRequest sends a JSON-RPC request to the server and decodes its result
+// into res. It returns the session ID of the response.
+func mcpRequest(t *testing.T, handler http.Handler, sessionID string, method string, params any, res any) string {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json,text/event-stream")
+	if sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", sessionID)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	if res != nil {
+		var response struct {
+			Result json.RawMessage `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.NoError(t, json.Unmarshal(response.Result, res))
+	}
+	return recorder.Header().Get("Mcp-Session-Id")
+}
@@ -1,11 +1,14 @@
 package coderd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"cdr.dev/slog"
 
+	"github.com/coder/coder/v2/coderd/audit"
+	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
 	"github.com/coder/coder/v2/coderd/mcp"
@@ -23,8 +26,35 @@ const (
 // It supports a "toolset" query parameter to select the set of tools to register.
 func (api *API) mcpHTTPHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			ctx    = r.Context()
+			apiKey = httpmw.APIKey(r)
+			logger = api.Logger.Named("mcp")
+		)
+
+		toolset := MCPToolset(r.URL.Query().Get("toolset"))
+		// Default to standard toolset if no toolset is specified.
+		if toolset == "" {
+			toolset = MCPToolsetStandard
+		}
+
+		// Only the tools permitted by the scopes of the API key are exposed.
+		scope, err := apiKey.ScopeSet().Expand()
+		if err != nil {
+			httpapi.Write(ctx, w, http.StatusInternalServerError, codersdk.Response{
+				Message: "Failed to expand API key scopes.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+
 		// Create MCP server instance for each request
-		mcpServer, err := mcp.NewServer(api.Logger.Named("mcp"))
+		mcpServer, err := mcp.NewServer(logger,
+			mcp.WithScope(scope),
+			mcp.WithToolCallHook(func(_ context.Context, call mcp.ToolCall) {
+				api.auditMCPToolCall(ctx, r, apiKey, toolset, call)
+			}),
+		)
 		if err != nil {
 			api.Logger.Error(r.Context(), "failed to create MCP server", slog.Error(err))
 			httpapi.Write(r.Context(), w, http.StatusInternalServerError, codersdk.Response{
@@ -35,11 +65,6 @@ func (api *API) mcpHTTPHandler() http.Handler {
 		// Extract the original session token from the request
 		authenticatedClient := codersdk.New(api.AccessURL,
 			codersdk.WithSessionToken(httpmw.APITokenFromRequest(r)))
-		toolset := MCPToolset(r.URL.Query().Get("toolset"))
-		// Default to standard toolset if no toolset is specified.
-		if toolset == "" {
-			toolset = MCPToolsetStandard
-		}
 
 		switch toolset {
 		case MCPToolsetStandard:
@@ -61,3 +86,31 @@ func (api *API) mcpHTTPHandler() http.Handler {
 		mcpServer.ServeHTTP(w, r)
 	})
 }
+
+// auditMCPToolCall records a tool invocation through the MCP server in the
+// audit log.
+func (api *API) auditMCPToolCall(ctx context.Context, r *http.Request, apiKey database.APIKey, toolset MCPToolset, call mcp.ToolCall) {
+	status := http.StatusOK
+	toolCall := database.AuditMCPToolCall{
+		UserID:   apiKey.UserID,
+		ToolName: call.Name,
+		Toolset:  string(toolset),
+		Scopes:   apiKey.Scopes,
+	}
+	if call.Err != nil {
+		status = http.StatusInternalServerError
+		toolCall.Error = call.Err.Error()
+	}
+
+	audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.AuditMCPToolCall]{
+		Audit:     *api.Auditor.Load(),
+		Log:       api.Logger,
+		UserID:    apiKey.UserID,
+		RequestID: httpmw.RequestID(r),
+		Status:    status,
+		Action:    database.AuditActionInvoke,
+		IP:        r.RemoteAddr,
+		UserAgent: r.UserAgent(),
+		New:       toolCall,
+	})
+}
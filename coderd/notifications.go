@@ -2,24 +2,41 @@ package coderd
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"golang.org/x/xerrors"
 
 	"cdr.dev/slog"
 
 	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
 	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/coderd/notifications/render"
+	"github.com/coder/coder/v2/coderd/notifications/types"
 	"github.com/coder/coder/v2/coderd/rbac"
 	"github.com/coder/coder/v2/coderd/rbac/policy"
+	markdown "github.com/coder/coder/v2/coderd/render"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/cryptorand"
+	"github.com/coder/serpent"
 )
 
 // @Summary Get notifications settings
@@ -167,6 +184,395 @@ func (api *API) customNotificationTemplates(rw http.ResponseWriter, r *http.Requ
 	api.notificationTemplatesByKind(rw, r, database.NotificationTemplateKindCustom)
 }
 
+// @Summary Update notification template overrides
+// @ID update-notification-template-overrides
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param notification_template path string true "Notification template UUID" format(uuid)
+// @Param request body codersdk.UpdateNotificationTemplateOverrides true "Overrides"
+// @Success 200 {object} codersdk.NotificationTemplate
+// @Router /notifications/templates/{notification_template}/overrides [put]
+func (api *API) putNotificationTemplateOverrides(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.NotificationTemplateParam(r)
+		apiKey   = httpmw.APIKey(r)
+	)
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceNotificationTemplate) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.UpdateNotificationTemplateOverrides
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	updated := template
+	updated.TitleTemplateOverride = sql.NullString{String: req.TitleTemplate, Valid: strings.TrimSpace(req.TitleTemplate) != ""}
+	updated.BodyTemplateOverride = sql.NullString{String: req.BodyTemplate, Valid: strings.TrimSpace(req.BodyTemplate) != ""}
+
+	// Render the overrides against sample data so that broken templates are rejected here, rather than failing every
+	// message which uses them.
+	user, err := api.Database.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+	if _, _, err := api.renderNotificationTemplate(ctx, updated, user, nil, nil); err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid notification template overrides.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	api.updateNotificationTemplateOverrides(rw, r, template, updated.TitleTemplateOverride, updated.BodyTemplateOverride)
+}
+
+// @Summary Delete notification template overrides
+// @ID delete-notification-template-overrides
+// @Security CoderSessionToken
+// @Tags Notifications
+// @Param notification_template path string true "Notification template UUID" format(uuid)
+// @Success 204
+// @Router /notifications/templates/{notification_template}/overrides [delete]
+func (api *API) deleteNotificationTemplateOverrides(rw http.ResponseWriter, r *http.Request) {
+	template := httpmw.NotificationTemplateParam(r)
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceNotificationTemplate) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	api.updateNotificationTemplateOverrides(rw, r, template, sql.NullString{}, sql.NullString{})
+}
+
+// updateNotificationTemplateOverrides stores the given overrides and audits the change. A 200 with the updated
+// template is written when an override is set, and a 204 when both are cleared.
+func (api *API) updateNotificationTemplateOverrides(rw http.ResponseWriter, r *http.Request, template database.NotificationTemplate, title, body sql.NullString) {
+	ctx := r.Context()
+
+	if template.TitleTemplateOverride == title && template.BodyTemplateOverride == body {
+		writeNotificationTemplateOverrides(ctx, rw, template)
+		return
+	}
+
+	auditor := api.Auditor.Load()
+	aReq, commitAudit := audit.InitRequest[database.NotificationTemplate](rw, &audit.RequestParams{
+		Audit:   *auditor,
+		Log:     api.Logger,
+		Request: r,
+		Action:  database.AuditActionWrite,
+	})
+	defer commitAudit()
+	aReq.Old = template
+
+	template, err := api.Database.UpdateNotificationTemplateOverridesByID(ctx, database.UpdateNotificationTemplateOverridesByIDParams{
+		ID:                    template.ID,
+		TitleTemplateOverride: title,
+		BodyTemplateOverride:  body,
+	})
+	if err != nil {
+		if dbauthz.IsNotAuthorizedError(err) {
+			httpapi.Forbidden(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to update notification template overrides.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	aReq.New = template
+
+	writeNotificationTemplateOverrides(ctx, rw, template)
+}
+
+func writeNotificationTemplateOverrides(ctx context.Context, rw http.ResponseWriter, template database.NotificationTemplate) {
+	if !template.TitleTemplateOverride.Valid && !template.BodyTemplateOverride.Valid {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, convertNotificationTemplates([]database.NotificationTemplate{template})[0])
+}
+
+// @Summary Update notification template urgency
+// @ID update-notification-template-urgency
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param notification_template path string true "Notification template UUID" format(uuid)
+// @Param request body codersdk.UpdateNotificationTemplateUrgency true "Urgency"
+// @Success 200 {object} codersdk.NotificationTemplate
+// @Router /notifications/templates/{notification_template}/urgency [put]
+func (api *API) putNotificationTemplateUrgency(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.NotificationTemplateParam(r)
+	)
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceNotificationTemplate) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.UpdateNotificationTemplateUrgency
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	if template.Urgent == req.Urgent {
+		httpapi.Write(ctx, rw, http.StatusOK, convertNotificationTemplates([]database.NotificationTemplate{template})[0])
+		return
+	}
+
+	auditor := api.Auditor.Load()
+	aReq, commitAudit := audit.InitRequest[database.NotificationTemplate](rw, &audit.RequestParams{
+		Audit:   *auditor,
+		Log:     api.Logger,
+		Request: r,
+		Action:  database.AuditActionWrite,
+	})
+	defer commitAudit()
+	aReq.Old = template
+
+	template, err := api.Database.UpdateNotificationTemplateUrgencyByID(ctx, database.UpdateNotificationTemplateUrgencyByIDParams{
+		ID:     template.ID,
+		Urgent: req.Urgent,
+	})
+	if err != nil {
+		if dbauthz.IsNotAuthorizedError(err) {
+			httpapi.Forbidden(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to update notification template urgency.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	aReq.New = template
+
+	httpapi.Write(ctx, rw, http.StatusOK, convertNotificationTemplates([]database.NotificationTemplate{template})[0])
+}
+
+// @Summary Preview notification template
+// @ID preview-notification-template
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param notification_template path string true "Notification template UUID" format(uuid)
+// @Param request body codersdk.NotificationTemplatePreviewRequest true "Preview request"
+// @Success 200 {object} codersdk.NotificationTemplatePreview
+// @Router /notifications/templates/{notification_template}/preview [post]
+func (api *API) postNotificationTemplatePreview(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.NotificationTemplateParam(r)
+		apiKey   = httpmw.APIKey(r)
+	)
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceNotificationTemplate) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.NotificationTemplatePreviewRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.TitleTemplate) != "" {
+		template.TitleTemplateOverride = sql.NullString{String: req.TitleTemplate, Valid: true}
+	}
+	if strings.TrimSpace(req.BodyTemplate) != "" {
+		template.BodyTemplateOverride = sql.NullString{String: req.BodyTemplate, Valid: true}
+	}
+
+	user, err := api.Database.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	_, preview, err := api.renderNotificationTemplate(ctx, template, user, req.Labels, req.Data)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Failed to render notification template.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, preview)
+}
+
+// renderNotificationTemplate renders the effective title and body of the given template for the given user against
+// sample data, the same way they are rendered for delivery.
+func (api *API) renderNotificationTemplate(ctx context.Context, template database.NotificationTemplate, user database.User, labels map[string]string, data map[string]any) (types.MessagePayload, codersdk.NotificationTemplatePreview, error) {
+	helpers, err := notifications.FetchHelpers(ctx, api.Database, api.notificationTemplateHelpers())
+	if err != nil {
+		return types.MessagePayload{}, codersdk.NotificationTemplatePreview{}, xerrors.Errorf("fetch helpers: %w", err)
+	}
+	payload, err := notifications.SamplePayload(template, user, labels, data, helpers)
+	if err != nil {
+		return types.MessagePayload{}, codersdk.NotificationTemplatePreview{}, xerrors.Errorf("build sample payload: %w", err)
+	}
+	title, err := render.GoTemplate(template.EffectiveTitleTemplate(), payload, helpers)
+	if err != nil {
+		return types.MessagePayload{}, codersdk.NotificationTemplatePreview{}, xerrors.Errorf("render title: %w", err)
+	}
+	body, err := render.GoTemplate(template.EffectiveBodyTemplate(), payload, helpers)
+	if err != nil {
+		return types.MessagePayload{}, codersdk.NotificationTemplatePreview{}, xerrors.Errorf("render body: %w", err)
+	}
+	titlePlaintext, err := markdown.PlaintextFromMarkdown(title)
+	if err != nil {
+		return types.MessagePayload{}, codersdk.NotificationTemplatePreview{}, xerrors.Errorf("convert title to plaintext: %w", err)
+	}
+
+	return payload, codersdk.NotificationTemplatePreview{
+		Title:    titlePlaintext,
+		Body:     body,
+		BodyHTML: markdown.HTMLFromMarkdown(body),
+	}, nil
+}
+
+// notificationTemplateHelpers returns the template functions which are available to notification templates, as they
+// are configured for the notifications manager.
+func (api *API) notificationTemplateHelpers() template.FuncMap {
+	return template.FuncMap{
+		"base_url":     func() string { return api.AccessURL.String() },
+		"current_year": func() string { return strconv.Itoa(api.Clock.Now().Year()) },
+	}
+}
+
+// @Summary Send a test notification from a template
+// @ID send-a-test-notification-from-a-template
+// @Security CoderSessionToken
+// @Accept json
+// @Tags Notifications
+// @Param notification_template path string true "Notification template UUID" format(uuid)
+// @Param request body codersdk.NotificationTemplateTestRequest true "Test request"
+// @Success 204
+// @Router /notifications/templates/{notification_template}/test [post]
+func (api *API) postNotificationTemplateTest(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx      = r.Context()
+		template = httpmw.NotificationTemplateParam(r)
+		apiKey   = httpmw.APIKey(r)
+	)
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceNotificationTemplate) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.NotificationTemplateTestRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.Method == "" {
+		req.Method = api.DeploymentValues.Notifications.Method.Value()
+	}
+
+	method := database.NotificationMethod(req.Method)
+	if enabled := api.enabledNotificationMethods(); !slices.Contains(enabled, method) {
+		acceptable := db2sdk.List(enabled, func(m database.NotificationMethod) string { return string(m) })
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid request to send a test notification.",
+			Validations: []codersdk.ValidationError{
+				{
+					Field:  "method",
+					Detail: fmt.Sprintf("%q is not an enabled method; the enabled methods are: %s", req.Method, strings.Join(acceptable, ", ")),
+				},
+			},
+		})
+		return
+	}
+
+	user, err := api.Database.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	data := maps.Clone(req.Data)
+	if data == nil {
+		data = map[string]any{}
+	}
+	// Messages are deduplicated per day, so a timestamp is included to allow a template to be tested repeatedly.
+	data["timestamp"] = api.Clock.Now()
+
+	// Render the template before it is sent, so that the caller is told about any errors.
+	payload, _, err := api.renderNotificationTemplate(ctx, template, user, req.Labels, data)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Failed to render notification template.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	input, err := json.Marshal(payload)
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	err = api.Database.EnqueueNotificationMessage(
+		//nolint:gocritic // We need to be notifier to send the notification.
+		dbauthz.AsNotifier(ctx),
+		database.EnqueueNotificationMessageParams{
+			ID:                     uuid.New(),
+			UserID:                 user.ID,
+			NotificationTemplateID: template.ID,
+			Method:                 method,
+			Payload:                input,
+			CreatedBy:              "send-test-notification-template",
+			CreatedAt:              dbtime.Time(api.Clock.Now().UTC()),
+		},
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), notifications.ErrCannotEnqueueDisabledNotification.Error()) {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "You have disabled this notification.",
+				Detail:  "Enable it in your notification preferences to send a test.",
+			})
+			return
+		}
+		api.Logger.Error(ctx, "send notification template test", slog.Error(err))
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to send test notification.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// enabledNotificationMethods returns the dispatch methods which are configured for this deployment.
+func (api *API) enabledNotificationMethods() []database.NotificationMethod {
+	var (
+		cfg     = api.DeploymentValues.Notifications
+		methods []database.NotificationMethod
+	)
+	if cfg.SMTP.Smarthost != "" {
+		methods = append(methods, database.NotificationMethodSmtp)
+	}
+	if cfg.Webhook.Endpoint != (serpent.URL{}) {
+		methods = append(methods, database.NotificationMethodWebhook)
+	}
+	if cfg.Inbox.Enabled.Value() {
+		methods = append(methods, database.NotificationMethodInbox)
+	}
+	return methods
+}
+
 // @Summary Get notification dispatch methods
 // @ID get-notification-dispatch-methods
 // @Security CoderSessionToken
@@ -179,7 +585,8 @@ func (api *API) notificationDispatchMethods(rw http.ResponseWriter, r *http.Requ
 	for _, nm := range database.AllNotificationMethodValues() {
 		// Skip inbox method as for now this is an implicit delivery target and should not appear
 		// anywhere in the Web UI.
-		if nm == database.NotificationMethodInbox {
+		// Skip user targets since these are only selected through user notification preferences.
+		if nm == database.NotificationMethodInbox || nm == database.NotificationMethodUserTarget {
 			continue
 		}
 		methods = append(methods, string(nm))
@@ -238,6 +645,123 @@ func (api *API) postTestNotification(rw http.ResponseWriter, r *http.Request) {
 	rw.WriteHeader(http.StatusNoContent)
 }
 
+// @Summary Get notification webhook secrets
+// @ID get-notification-webhook-secrets
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Notifications
+// @Success 200 {array} codersdk.NotificationWebhookSecret
+// @Router /notifications/webhook-secrets [get]
+func (api *API) notificationWebhookSecrets(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	secrets, err := api.Database.GetNotificationWebhookSecrets(ctx)
+	if err != nil {
+		if rbac.IsUnauthorizedError(err) {
+			httpapi.Forbidden(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to fetch notification webhook secrets.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	now := dbtime.Now()
+	out := make([]codersdk.NotificationWebhookSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		// Secrets which no longer sign deliveries are only cleaned up on the next rotation.
+		if secret.ExpiresAt.Valid && !secret.ExpiresAt.Time.After(now) {
+			continue
+		}
+		out = append(out, convertNotificationWebhookSecret(secret))
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, out)
+}
+
+// @Summary Rotate notification webhook secret
+// @ID rotate-notification-webhook-secret
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param request body codersdk.RotateNotificationWebhookSecretRequest true "Rotation request"
+// @Success 201 {object} codersdk.NotificationWebhookSecret
+// @Router /notifications/webhook-secrets [post]
+func (api *API) postNotificationWebhookSecret(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req codersdk.RotateNotificationWebhookSecretRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if req.GracePeriodMillis < 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid request to rotate notification webhook secret.",
+			Detail:  "'grace_period_ms' must not be negative",
+		})
+		return
+	}
+	gracePeriod := codersdk.DefaultNotificationWebhookSecretGracePeriod
+	if req.GracePeriodMillis > 0 {
+		gracePeriod = time.Duration(req.GracePeriodMillis) * time.Millisecond
+	}
+
+	value := req.Secret
+	if value == "" {
+		var err error
+		value, err = cryptorand.String(32)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Failed to generate notification webhook secret.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+	}
+
+	now := dbtime.Now()
+	var secret database.NotificationWebhookSecret
+	err := api.Database.InTx(func(tx database.Store) error {
+		// Only the most recently rotated secret is kept alongside the new one; any older secrets stop signing
+		// deliveries immediately.
+		if err := tx.DeleteExpiringNotificationWebhookSecrets(ctx); err != nil {
+			return xerrors.Errorf("delete expiring secrets: %w", err)
+		}
+		if err := tx.ExpireActiveNotificationWebhookSecret(ctx, now.Add(gracePeriod)); err != nil {
+			return xerrors.Errorf("expire active secret: %w", err)
+		}
+		var err error
+		secret, err = tx.InsertNotificationWebhookSecret(ctx, database.InsertNotificationWebhookSecretParams{
+			ID:        uuid.New(),
+			Secret:    value,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return xerrors.Errorf("insert secret: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		if rbac.IsUnauthorizedError(err) {
+			httpapi.Forbidden(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to rotate notification webhook secret.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	api.Logger.Info(ctx, "rotated notification webhook secret",
+		slog.F("secret_id", secret.ID), slog.F("grace_period", gracePeriod), slog.F("user_id", httpmw.APIKey(r).UserID))
+
+	resp := convertNotificationWebhookSecret(secret)
+	resp.Secret = secret.Secret
+	httpapi.Write(ctx, rw, http.StatusCreated, resp)
+}
+
 // @Summary Get user notification preferences
 // @ID get-user-notification-preferences
 // @Security CoderSessionToken
@@ -313,8 +837,85 @@ func (api *API) putUserNotificationPreferences(rw http.ResponseWriter, r *http.R
 		input.Disableds = append(input.Disableds, disabled)
 	}
 
+	targetInputs := make([]database.UpdateUserNotificationPreferenceTargetParams, 0, len(prefs.TemplateTargetMap))
+	for tmplID, targetID := range prefs.TemplateTargetMap {
+		id, err := uuid.Parse(tmplID)
+		if err != nil {
+			logger.Warn(ctx, "failed to parse notification template UUID", slog.F("input", tmplID), slog.Error(err))
+
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Unable to parse notification template UUID.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+
+		targetInput := database.UpdateUserNotificationPreferenceTargetParams{
+			UserID:                 user.ID,
+			NotificationTemplateID: id,
+		}
+		if targetID != "" {
+			target, ok := api.userNotificationTarget(ctx, rw, user, targetID)
+			if !ok {
+				return
+			}
+			targetInput.UserTargetID = uuid.NullUUID{UUID: target.ID, Valid: true}
+		}
+		targetInputs = append(targetInputs, targetInput)
+	}
+
+	digestInputs := make([]database.UpdateUserNotificationPreferenceDigestParams, 0, len(prefs.TemplateDigestMap))
+	for tmplID, digest := range prefs.TemplateDigestMap {
+		id, err := uuid.Parse(tmplID)
+		if err != nil {
+			logger.Warn(ctx, "failed to parse notification template UUID", slog.F("input", tmplID), slog.Error(err))
+
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Unable to parse notification template UUID.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		// One-time passcodes must arrive immediately, and digests cannot themselves be digested.
+		if digest && (id == notifications.TemplateUserRequestedOneTimePasscode || id == notifications.TemplateNotificationDigest) {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Notifications from this template cannot be delivered as a digest.",
+				Detail:  fmt.Sprintf("notification template %q", id),
+			})
+			return
+		}
+
+		digestInputs = append(digestInputs, database.UpdateUserNotificationPreferenceDigestParams{
+			UserID:                 user.ID,
+			NotificationTemplateID: id,
+			Digest:                 digest,
+		})
+	}
+
 	// Update preferences with params.
-	updated, err := api.Database.UpdateUserNotificationPreferences(ctx, input)
+	var updated int64
+	err := api.Database.InTx(func(tx database.Store) error {
+		var err error
+		updated, err = tx.UpdateUserNotificationPreferences(ctx, input)
+		if err != nil {
+			return err
+		}
+		for _, targetInput := range targetInputs {
+			n, err := tx.UpdateUserNotificationPreferenceTarget(ctx, targetInput)
+			if err != nil {
+				return err
+			}
+			updated += n
+		}
+		for _, digestInput := range digestInputs {
+			n, err := tx.UpdateUserNotificationPreferenceDigest(ctx, digestInput)
+			if err != nil {
+				return err
+			}
+			updated += n
+		}
+		return nil
+	}, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to update preferences", slog.Error(err))
 
@@ -343,6 +944,298 @@ func (api *API) putUserNotificationPreferences(rw http.ResponseWriter, r *http.R
 	httpapi.Write(ctx, rw, http.StatusOK, out)
 }
 
+// maxNotificationSnooze is the longest notifications can be snoozed for. Messages which are held for much longer would
+// be purged along with other old messages before they could be delivered.
+const maxNotificationSnooze = 72 * time.Hour
+
+// @Summary Get user notification quiet hours
+// @ID get-user-notification-quiet-hours
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Notifications
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {object} codersdk.UserNotificationQuietHours
+// @Router /users/{user}/notifications/quiet-hours [get]
+func (api *API) userNotificationQuietHours(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	quietHours, err := api.notificationQuietHours(ctx, user)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to retrieve user notification quiet hours.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, quietHours)
+}
+
+// @Summary Update user notification quiet hours
+// @ID update-user-notification-quiet-hours
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param user path string true "User ID, name, or me"
+// @Param request body codersdk.UpdateUserNotificationQuietHoursRequest true "Quiet hours"
+// @Success 200 {object} codersdk.UserNotificationQuietHours
+// @Router /users/{user}/notifications/quiet-hours [put]
+func (api *API) putUserNotificationQuietHours(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	var req codersdk.UpdateUserNotificationQuietHoursRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	if req.SnoozeUntil != nil && req.SnoozeUntil.After(dbtime.Now().Add(maxNotificationSnooze)) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Notifications cannot be snoozed for longer than %s.", maxNotificationSnooze),
+		})
+		return
+	}
+
+	_, err := api.Database.UpdateUserNotificationQuietHours(ctx, database.UpdateUserNotificationQuietHoursParams{
+		UserID:                 user.ID,
+		NotificationQuietHours: strconv.FormatBool(req.Enabled),
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to update user notification quiet hours.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	// A snooze which has already ended is cleared, rather than stored.
+	var snoozeUntil string
+	if req.SnoozeUntil != nil && req.SnoozeUntil.After(dbtime.Now()) {
+		snoozeUntil = req.SnoozeUntil.UTC().Format(time.RFC3339)
+	}
+	_, err = api.Database.UpdateUserNotificationSnoozeUntil(ctx, database.UpdateUserNotificationSnoozeUntilParams{
+		UserID:                  user.ID,
+		NotificationSnoozeUntil: snoozeUntil,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to update user notification snooze.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	quietHours, err := api.notificationQuietHours(ctx, user)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to retrieve user notification quiet hours.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, quietHours)
+}
+
+// notificationQuietHours returns the given user's notification quiet hours settings, along with their quiet hours
+// schedule.
+func (api *API) notificationQuietHours(ctx context.Context, user database.User) (codersdk.UserNotificationQuietHours, error) {
+	out := codersdk.UserNotificationQuietHours{
+		DurationMillis: api.DeploymentValues.Notifications.QuietHoursDuration.Value().Milliseconds(),
+	}
+
+	enabled, err := api.Database.GetUserNotificationQuietHours(ctx, user.ID)
+	if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+		return out, xerrors.Errorf("get quiet hours setting: %w", err)
+	}
+	out.Enabled = enabled == "true"
+
+	snoozeUntil, err := api.Database.GetUserNotificationSnoozeUntil(ctx, user.ID)
+	if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+		return out, xerrors.Errorf("get snooze setting: %w", err)
+	}
+	if snoozeUntil != "" {
+		t, err := time.Parse(time.RFC3339, snoozeUntil)
+		if err != nil {
+			return out, xerrors.Errorf("parse snooze setting: %w", err)
+		}
+		if t.After(dbtime.Now()) {
+			out.SnoozeUntil = &t
+		}
+	}
+
+	if store := api.UserQuietHoursScheduleStore.Load(); store != nil {
+		opts, err := (*store).Get(ctx, api.Database, user.ID)
+		if err != nil {
+			return out, xerrors.Errorf("get quiet hours schedule: %w", err)
+		}
+		if opts.Schedule != nil {
+			out.Schedule = opts.Schedule.String()
+		}
+	}
+
+	return out, nil
+}
+
+// @Summary Get user notification targets
+// @ID get-user-notification-targets
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Notifications
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {array} codersdk.NotificationUserTarget
+// @Router /users/{user}/notifications/targets [get]
+func (api *API) userNotificationTargets(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	targets, err := api.Database.GetNotificationUserTargetsByUserID(ctx, user.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to retrieve user notification targets.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	out := make([]codersdk.NotificationUserTarget, 0, len(targets))
+	for _, target := range targets {
+		out = append(out, convertNotificationUserTarget(target))
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, out)
+}
+
+// @Summary Create user notification target
+// @ID create-user-notification-target
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags Notifications
+// @Param request body codersdk.CreateNotificationUserTargetRequest true "Target"
+// @Param user path string true "User ID, name, or me"
+// @Success 201 {object} codersdk.NotificationUserTarget
+// @Router /users/{user}/notifications/targets [post]
+func (api *API) postUserNotificationTarget(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	var req codersdk.CreateNotificationUserTargetRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Invalid request to create notification target.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if !api.DeploymentValues.Notifications.UserTargetsAllowPrivateNetworks.Value() {
+		// Hostnames are checked again once resolved at delivery time.
+		if err := dispatch.ValidatePublicEndpoint(req.Endpoint); err != nil {
+			httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+				Message: "Invalid request to create notification target.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+	}
+
+	now := dbtime.Now()
+	target, err := api.Database.InsertNotificationUserTarget(ctx, database.InsertNotificationUserTargetParams{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Name:      req.Name,
+		Type:      database.NotificationUserTargetType(req.Type),
+		Endpoint:  req.Endpoint,
+		Secret:    req.Secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		if database.IsUniqueViolation(err, database.UniqueNotificationUserTargetsUserIDNameKey) {
+			httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+				Message: fmt.Sprintf("A notification target named %q already exists.", req.Name),
+			})
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to create notification target.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusCreated, convertNotificationUserTarget(target))
+}
+
+// @Summary Delete user notification target
+// @ID delete-user-notification-target
+// @Security CoderSessionToken
+// @Tags Notifications
+// @Param user path string true "User ID, name, or me"
+// @Param target path string true "Target ID" format(uuid)
+// @Success 204
+// @Router /users/{user}/notifications/targets/{target} [delete]
+func (api *API) deleteUserNotificationTarget(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		user = httpmw.UserParam(r)
+	)
+
+	target, ok := api.userNotificationTarget(ctx, rw, user, chi.URLParam(r, "target"))
+	if !ok {
+		return
+	}
+
+	if err := api.Database.DeleteNotificationUserTargetByID(ctx, target.ID); err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to delete notification target.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// userNotificationTarget fetches the target with the given ID, ensuring that it belongs to the given user.
+// If false is returned, a response has already been written.
+func (api *API) userNotificationTarget(ctx context.Context, rw http.ResponseWriter, user database.User, rawID string) (database.NotificationUserTarget, bool) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "Unable to parse notification target UUID.",
+			Detail:  err.Error(),
+		})
+		return database.NotificationUserTarget{}, false
+	}
+
+	target, err := api.Database.GetNotificationUserTargetByID(ctx, id)
+	if httpapi.Is404Error(err) || (err == nil && target.UserID != user.ID) {
+		httpapi.ResourceNotFound(rw)
+		return database.NotificationUserTarget{}, false
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Failed to retrieve notification target.",
+			Detail:  err.Error(),
+		})
+		return database.NotificationUserTarget{}, false
+	}
+	return target, true
+}
+
 // @Summary Send a custom notification
 // @ID send-a-custom-notification
 // @Security CoderSessionToken
@@ -440,6 +1333,10 @@ func convertNotificationTemplates(in []database.NotificationTemplate) (out []cod
 			Method:           string(tmpl.Method.NotificationMethod),
 			Kind:             string(tmpl.Kind),
 			EnabledByDefault: tmpl.EnabledByDefault,
+			Urgent:           tmpl.Urgent,
+
+			TitleTemplateOverride: tmpl.TitleTemplateOverride.String,
+			BodyTemplateOverride:  tmpl.BodyTemplateOverride.String,
 		})
 	}
 
@@ -448,12 +1345,42 @@ func convertNotificationTemplates(in []database.NotificationTemplate) (out []cod
 
 func convertNotificationPreferences(in []database.NotificationPreference) (out []codersdk.NotificationPreference) {
 	for _, pref := range in {
+		var userTargetID *uuid.UUID
+		if pref.UserTargetID.Valid {
+			userTargetID = &pref.UserTargetID.UUID
+		}
 		out = append(out, codersdk.NotificationPreference{
 			NotificationTemplateID: pref.NotificationTemplateID,
 			Disabled:               pref.Disabled,
+			UserTargetID:           userTargetID,
+			Digest:                 pref.Digest,
 			UpdatedAt:              pref.UpdatedAt,
 		})
 	}
 
 	return out
 }
+
+func convertNotificationUserTarget(in database.NotificationUserTarget) codersdk.NotificationUserTarget {
+	return codersdk.NotificationUserTarget{
+		ID:        in.ID,
+		UserID:    in.UserID,
+		Name:      in.Name,
+		Type:      codersdk.NotificationUserTargetType(in.Type),
+		Endpoint:  in.Endpoint,
+		HasSecret: in.Secret != "",
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+	}
+}
+
+func convertNotificationWebhookSecret(in database.NotificationWebhookSecret) codersdk.NotificationWebhookSecret {
+	out := codersdk.NotificationWebhookSecret{
+		ID:        in.ID,
+		CreatedAt: in.CreatedAt,
+	}
+	if in.ExpiresAt.Valid {
+		out.ExpiresAt = &in.ExpiresAt.Time
+	}
+	return out
+}
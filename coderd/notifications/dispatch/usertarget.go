@@ -0,0 +1,194 @@
+package dispatch
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	markdown "github.com/coder/coder/v2/coderd/render"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/quartz"
+)
+
+type UserTargetStore interface {
+	GetNotificationUserTargetByPreference(ctx context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error)
+}
+
+// UserTargetHandler dispatches notification messages to the webhook or chat endpoint which the recipient
+// has chosen for the notification template.
+type UserTargetHandler struct {
+	log   slog.Logger
+	store UserTargetStore
+	clock quartz.Clock
+
+	cl *http.Client
+}
+
+// SlackPayload describes the JSON payload delivered to Slack-compatible incoming webhooks (Slack, Mattermost).
+type SlackPayload struct {
+	Text string `json:"text"`
+}
+
+func NewUserTargetHandler(cfg codersdk.NotificationsConfig, log slog.Logger, store UserTargetStore, clock quartz.Clock) *UserTargetHandler {
+	cl := newHTTPClient(log)
+	if !cfg.UserTargetsAllowPrivateNetworks.Value() {
+		t, ok := cl.Transport.(*http.Transport)
+		if !ok {
+			t = &http.Transport{}
+			cl.Transport = t
+		}
+		// Addresses are checked once resolved, so that hostnames which resolve to private addresses are refused
+		// too. A proxy would connect on our behalf, bypassing the check, so none is used.
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   publicAddressControl,
+		}
+		t.DialContext = dialer.DialContext
+		t.Proxy = nil
+	}
+	return &UserTargetHandler{log: log, store: store, clock: clock, cl: cl}
+}
+
+// ValidatePublicEndpoint returns an error if the endpoint refers to localhost, or to an IP address which is not
+// publicly routable. Hostnames are not resolved; deliveries refuse to connect to them if they resolve to such an
+// address.
+func ValidatePublicEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return xerrors.Errorf("parse endpoint: %w", err)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return xerrors.Errorf("endpoint must not refer to localhost")
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !isPublicAddress(ip) {
+		return xerrors.Errorf("endpoint must not refer to loopback, link-local or private network address %s", ip)
+	}
+	return nil
+}
+
+// errPrivateAddress is returned when a delivery is refused because the endpoint resolved to an address which is not
+// publicly routable. Retrying would not change the outcome, so such failures are permanent.
+var errPrivateAddress = xerrors.New("refusing to connect to loopback, link-local or private network address")
+
+// publicAddressControl refuses connections to addresses which are not publicly routable.
+func publicAddressControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return xerrors.Errorf("parse address %q: %w", address, err)
+	}
+	if !isPublicAddress(addrPort.Addr()) {
+		return xerrors.Errorf("%w %s", errPrivateAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// cgnatPrefix is the shared address space used for carrier-grade NAT, which is not publicly routable.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+func isPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !cgnatPrefix.Contains(ip)
+}
+
+func (u *UserTargetHandler) Dispatcher(payload types.MessagePayload, titleMarkdown, bodyMarkdown string, _ template.FuncMap) (DeliveryFunc, error) {
+	titlePlaintext, err := markdown.PlaintextFromMarkdown(titleMarkdown)
+	if err != nil {
+		return nil, xerrors.Errorf("render title: %w", err)
+	}
+	bodyPlaintext, err := markdown.PlaintextFromMarkdown(bodyMarkdown)
+	if err != nil {
+		return nil, xerrors.Errorf("render body: %w", err)
+	}
+
+	return u.dispatch(payload, titlePlaintext, titleMarkdown, bodyPlaintext, bodyMarkdown), nil
+}
+
+func (u *UserTargetHandler) dispatch(msgPayload types.MessagePayload, titlePlaintext, titleMarkdown, bodyPlaintext, bodyMarkdown string) DeliveryFunc {
+	return func(ctx context.Context, msgID uuid.UUID) (retryable bool, err error) {
+		userID, err := uuid.Parse(msgPayload.UserID)
+		if err != nil {
+			return false, xerrors.Errorf("parse user ID: %w", err)
+		}
+		templateID, err := uuid.Parse(msgPayload.NotificationTemplateID)
+		if err != nil {
+			return false, xerrors.Errorf("parse template ID: %w", err)
+		}
+
+		// The target is resolved at delivery time so that changes to (or removal of) the target apply to
+		// messages which are already enqueued.
+		target, err := u.store.GetNotificationUserTargetByPreference(ctx, database.GetNotificationUserTargetByPreferenceParams{
+			UserID:                 userID,
+			NotificationTemplateID: templateID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, xerrors.New("no notification target configured")
+			}
+			return true, xerrors.Errorf("get notification target: %w", err)
+		}
+
+		var body []byte
+		switch target.Type {
+		case database.NotificationUserTargetTypeWebhook:
+			body, err = json.Marshal(WebhookPayload{
+				Version:       "1.1",
+				MsgID:         msgID,
+				Title:         titlePlaintext,
+				TitleMarkdown: titleMarkdown,
+				Body:          bodyPlaintext,
+				BodyMarkdown:  bodyMarkdown,
+				Payload:       msgPayload,
+			})
+		case database.NotificationUserTargetTypeSlack:
+			body, err = json.Marshal(SlackPayload{
+				Text: slackText(titlePlaintext, bodyPlaintext, msgPayload.Actions),
+			})
+		default:
+			return false, xerrors.Errorf("unsupported notification target type %q", target.Type)
+		}
+		if err != nil {
+			return false, xerrors.Errorf("marshal payload: %v", err)
+		}
+
+		var headers map[string]string
+		if target.Secret != "" {
+			headers = map[string]string{codersdk.WebhookSignatureHeader: codersdk.SignWebhookPayload(body, u.clock.Now(), target.Secret)}
+		}
+
+		retryable, err = postJSON(ctx, u.cl, u.log, msgID, target.Endpoint, body, headers)
+		if errors.Is(err, errPrivateAddress) {
+			return false, err
+		}
+		return retryable, err
+	}
+}
+
+func slackText(title, body string, actions []types.TemplateAction) string {
+	var sb strings.Builder
+	_, _ = sb.WriteString(title)
+	_, _ = sb.WriteString("\n\n")
+	_, _ = sb.WriteString(body)
+	for _, action := range actions {
+		_, _ = sb.WriteString(fmt.Sprintf("\n%s: %s", action.Label, action.URL))
+	}
+	return sb.String()
+}
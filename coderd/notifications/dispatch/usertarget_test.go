@@ -0,0 +1,217 @@
+package dispatch_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog"
+	"cdr.dev/slog/sloggers/slogtest"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/notifications/dispatch"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
+	"github.com/coder/serpent"
+)
+
+func TestUserTarget(t *testing.T) {
+	t.Parallel()
+
+	const (
+		titleMarkdown = "this *is* _the_ title"
+		bodyMarkdown  = "~this~ is the `body`"
+	)
+
+	// Signatures are timestamped with the handler's clock, so verifying them against the mock's time only succeeds
+	// if the clock is used.
+	clock := quartz.NewMock(t)
+	userID := uuid.New()
+	templateID := uuid.New()
+	msgPayload := types.MessagePayload{
+		Version:                "1.2",
+		NotificationName:       "test",
+		NotificationTemplateID: templateID.String(),
+		UserID:                 userID.String(),
+		Actions: []types.TemplateAction{
+			{Label: "View workspace", URL: "https://coder.example.com/@bob/ws"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		targetType database.NotificationUserTargetType
+		secret     string
+		noTarget   bool
+		// denyPrivate refuses delivery to the test server, which listens on a loopback address.
+		denyPrivate bool
+		statusCode  int
+		assertFn    func(t *testing.T, msgID uuid.UUID, r *http.Request, body []byte)
+
+		expectSuccess   bool
+		expectRetryable bool
+		expectErr       string
+	}{
+		{
+			name:       "webhook",
+			targetType: database.NotificationUserTargetTypeWebhook,
+			statusCode: http.StatusOK,
+			assertFn: func(t *testing.T, msgID uuid.UUID, r *http.Request, body []byte) {
+				var payload dispatch.WebhookPayload
+				assert.NoError(t, json.Unmarshal(body, &payload))
+				assert.Equal(t, msgID, payload.MsgID)
+				assert.Equal(t, "this is the title", payload.Title)
+				assert.Equal(t, titleMarkdown, payload.TitleMarkdown)
+				assert.Empty(t, r.Header.Get(codersdk.WebhookSignatureHeader))
+			},
+			expectSuccess: true,
+		},
+		{
+			name:       "webhook signed",
+			targetType: database.NotificationUserTargetTypeWebhook,
+			secret:     "hunter2",
+			statusCode: http.StatusOK,
+			assertFn: func(t *testing.T, _ uuid.UUID, r *http.Request, body []byte) {
+				assert.NoError(t, codersdk.VerifyWebhookSignature(r.Header.Get(codersdk.WebhookSignatureHeader), body, []string{"hunter2"}, codersdk.DefaultWebhookSignatureTolerance, clock.Now()))
+			},
+			expectSuccess: true,
+		},
+		{
+			name:       "slack",
+			targetType: database.NotificationUserTargetTypeSlack,
+			statusCode: http.StatusOK,
+			assertFn: func(t *testing.T, _ uuid.UUID, _ *http.Request, body []byte) {
+				var payload dispatch.SlackPayload
+				assert.NoError(t, json.Unmarshal(body, &payload))
+				assert.Equal(t, "this is the title\n\nthis is the body\nView workspace: https://coder.example.com/@bob/ws", payload.Text)
+			},
+			expectSuccess: true,
+		},
+		{
+			name:            "no target",
+			noTarget:        true,
+			expectSuccess:   false,
+			expectRetryable: false,
+			expectErr:       "no notification target configured",
+		},
+		{
+			name:            "non-200 response",
+			targetType:      database.NotificationUserTargetTypeSlack,
+			statusCode:      http.StatusInternalServerError,
+			expectSuccess:   false,
+			expectRetryable: true,
+			expectErr:       "non-2xx response (500)",
+		},
+		{
+			name:            "private network refused",
+			targetType:      database.NotificationUserTargetTypeWebhook,
+			denyPrivate:     true,
+			expectSuccess:   false,
+			expectRetryable: false,
+			expectErr:       "refusing to connect to loopback, link-local or private network address",
+		},
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+
+	// nolint:paralleltest // Irrelevant as of Go v1.22
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testutil.Context(t, testutil.WaitLong)
+			msgID := uuid.New()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, msgID.String(), r.Header.Get("X-Message-Id"))
+				if tc.assertFn != nil {
+					tc.assertFn(t, msgID, r, body)
+				}
+				w.WriteHeader(tc.statusCode)
+			}))
+			t.Cleanup(server.Close)
+
+			store := &fakeUserTargetStore{err: sql.ErrNoRows}
+			if !tc.noTarget {
+				store = &fakeUserTargetStore{target: database.NotificationUserTarget{
+					ID:       uuid.New(),
+					UserID:   userID,
+					Name:     "personal",
+					Type:     tc.targetType,
+					Endpoint: server.URL,
+					Secret:   tc.secret,
+				}}
+			}
+
+			cfg := codersdk.NotificationsConfig{UserTargetsAllowPrivateNetworks: serpent.Bool(!tc.denyPrivate)}
+			handler := dispatch.NewUserTargetHandler(cfg, logger.With(slog.F("test", tc.name)), store, clock)
+			deliveryFn, err := handler.Dispatcher(msgPayload, titleMarkdown, bodyMarkdown, helpers())
+			require.NoError(t, err)
+
+			retryable, err := deliveryFn(ctx, msgID)
+			if tc.expectSuccess {
+				require.NoError(t, err)
+				require.False(t, retryable)
+				require.Equal(t, database.GetNotificationUserTargetByPreferenceParams{
+					UserID:                 userID,
+					NotificationTemplateID: templateID,
+				}, store.lastArg)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.expectErr)
+			require.Equal(t, tc.expectRetryable, retryable)
+		})
+	}
+}
+
+func TestValidatePublicEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for endpoint, expectErr := range map[string]bool{
+		"https://example.com/hook":       false,
+		"https://93.184.215.14/hook":     false,
+		"http://localhost:8080/hook":     true,
+		"http://api.localhost/hook":      true,
+		"http://127.0.0.1/hook":          true,
+		"http://[::1]/hook":              true,
+		"http://169.254.169.254/latest":  true,
+		"http://10.0.0.1/hook":           true,
+		"http://192.168.1.1/hook":        true,
+		"http://100.64.0.1/hook":         true,
+		"http://[fd00::1]/hook":          true,
+		"http://[::ffff:127.0.0.1]/hook": true,
+		"http://0.0.0.0/hook":            true,
+	} {
+		err := dispatch.ValidatePublicEndpoint(endpoint)
+		if expectErr {
+			require.Error(t, err, endpoint)
+		} else {
+			require.NoError(t, err, endpoint)
+		}
+	}
+}
+
+type fakeUserTargetStore struct {
+	target  database.NotificationUserTarget
+	err     error
+	lastArg database.GetNotificationUserTargetByPreferenceParams
+}
+
+func (f *fakeUserTargetStore) GetNotificationUserTargetByPreference(_ context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error) {
+	f.lastArg = arg
+	return f.target, f.err
+}
@@ -15,15 +15,25 @@ import (
 
 	"cdr.dev/slog"
 
+	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/notifications/types"
 	markdown "github.com/coder/coder/v2/coderd/render"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/quartz"
 )
 
+type WebhookSecretStore interface {
+	GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error)
+}
+
 // WebhookHandler dispatches notification messages via an HTTP POST webhook.
+// When a shared secret has been configured, deliveries are signed with every secret which is still valid so that
+// receivers can verify them throughout a rotation; see codersdk.VerifyWebhookSignature.
 type WebhookHandler struct {
-	cfg codersdk.NotificationsWebhookConfig
-	log slog.Logger
+	cfg   codersdk.NotificationsWebhookConfig
+	log   slog.Logger
+	store WebhookSecretStore
+	clock quartz.Clock
 
 	cl *http.Client
 }
@@ -39,7 +49,12 @@ type WebhookPayload struct {
 	BodyMarkdown  string               `json:"body_markdown"`
 }
 
-func NewWebhookHandler(cfg codersdk.NotificationsWebhookConfig, log slog.Logger) *WebhookHandler {
+func NewWebhookHandler(cfg codersdk.NotificationsWebhookConfig, log slog.Logger, store WebhookSecretStore, clock quartz.Clock) *WebhookHandler {
+	return &WebhookHandler{cfg: cfg, log: log, store: store, clock: clock, cl: newHTTPClient(log)}
+}
+
+// newHTTPClient creates an HTTP client with its own connection pool.
+func newHTTPClient(log slog.Logger) *http.Client {
 	// Create a new transport in favor of reusing the default, since other http clients may interfere.
 	// http.Transport maintains its own connection pool, and we want to avoid cross-contamination.
 	var rt http.RoundTripper
@@ -55,7 +70,7 @@ func NewWebhookHandler(cfg codersdk.NotificationsWebhookConfig, log slog.Logger)
 		rt = t.Clone()
 	}
 
-	return &WebhookHandler{cfg: cfg, log: log, cl: &http.Client{Transport: rt}}
+	return &http.Client{Transport: rt}
 }
 
 func (w *WebhookHandler) Dispatcher(payload types.MessagePayload, titleMarkdown, bodyMarkdown string, _ template.FuncMap) (DeliveryFunc, error) {
@@ -92,40 +107,68 @@ func (w *WebhookHandler) dispatch(msgPayload types.MessagePayload, titlePlaintex
 			return false, xerrors.Errorf("marshal payload: %v", err)
 		}
 
-		// Prepare request.
-		// Outer context has a deadline (see CODER_NOTIFICATIONS_DISPATCH_TIMEOUT).
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(m))
+		// Secrets are resolved at delivery time so that a rotation applies to messages which are already enqueued.
+		now := w.clock.Now()
+		secrets, err := w.store.GetNotificationWebhookSecrets(ctx)
 		if err != nil {
-			return false, xerrors.Errorf("create HTTP request: %v", err)
+			return true, xerrors.Errorf("get webhook secrets: %w", err)
 		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("X-Message-Id", msgID.String())
-
-		// Send request.
-		resp, err := w.cl.Do(req)
-		if err != nil {
-			if errors.Is(err, context.DeadlineExceeded) {
-				return true, xerrors.Errorf("request timeout: %w", err)
+		var valid []string
+		for _, secret := range secrets {
+			if secret.ExpiresAt.Valid && !secret.ExpiresAt.Time.After(now) {
+				continue
 			}
+			valid = append(valid, secret.Secret)
+		}
 
-			return true, xerrors.Errorf("request failed: %w", err)
+		var headers map[string]string
+		if len(valid) > 0 {
+			headers = map[string]string{codersdk.WebhookSignatureHeader: codersdk.SignWebhookPayload(m, now, valid...)}
 		}
-		defer resp.Body.Close()
-
-		// Handle response.
-		if resp.StatusCode/100 > 2 {
-			// Body could be quite long here, let's grab the first 512B and hope it contains useful debug info.
-			respBody := make([]byte, 512)
-			lr := io.LimitReader(resp.Body, int64(len(respBody)))
-			n, err := lr.Read(respBody)
-			if err != nil && !errors.Is(err, io.EOF) {
-				return true, xerrors.Errorf("non-2xx response (%d), read body: %w", resp.StatusCode, err)
-			}
-			w.log.Warn(ctx, "unsuccessful delivery", slog.F("status_code", resp.StatusCode),
-				slog.F("response", string(respBody[:n])), slog.F("msg_id", msgID))
-			return true, xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+
+		return postJSON(ctx, w.cl, w.log, msgID, endpoint, m, headers)
+	}
+}
+
+// postJSON delivers the given JSON body to the endpoint, setting any additional headers given.
+// Any failure to deliver, or non-2xx response, is considered retryable.
+func postJSON(ctx context.Context, cl *http.Client, log slog.Logger, msgID uuid.UUID, endpoint string, body []byte, headers map[string]string) (retryable bool, err error) {
+	// Prepare request.
+	// Outer context has a deadline (see CODER_NOTIFICATIONS_DISPATCH_TIMEOUT).
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return false, xerrors.Errorf("create HTTP request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Message-Id", msgID.String())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	// Send request.
+	resp, err := cl.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return true, xerrors.Errorf("request timeout: %w", err)
 		}
 
-		return false, nil
+		return true, xerrors.Errorf("request failed: %w", err)
 	}
+	defer resp.Body.Close()
+
+	// Handle response.
+	if resp.StatusCode/100 > 2 {
+		// Body could be quite long here, let's grab the first 512B and hope it contains useful debug info.
+		respBody := make([]byte, 512)
+		lr := io.LimitReader(resp.Body, int64(len(respBody)))
+		n, err := lr.Read(respBody)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return true, xerrors.Errorf("non-2xx response (%d), read body: %w", resp.StatusCode, err)
+		}
+		log.Warn(ctx, "unsuccessful delivery", slog.F("status_code", resp.StatusCode),
+			slog.F("response", string(respBody[:n])), slog.F("msg_id", msgID))
+		return true, xerrors.Errorf("non-2xx response (%d)", resp.StatusCode)
+	}
+
+	return false, nil
 }
@@ -2,8 +2,10 @@ package dispatch_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -18,10 +20,12 @@ import (
 	"cdr.dev/slog/sloggers/slogtest"
 	"github.com/coder/serpent"
 
+	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/notifications/dispatch"
 	"github.com/coder/coder/v2/coderd/notifications/types"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/quartz"
 )
 
 func TestWebhook(t *testing.T) {
@@ -39,11 +43,15 @@ func TestWebhook(t *testing.T) {
 		NotificationName: "test",
 	}
 
+	// Secret expiry and signatures are evaluated against the handler's clock.
+	clock := quartz.NewMock(t)
+
 	tests := []struct {
 		name           string
 		serverURL      string
 		serverDeadline time.Time
 		serverFn       func(uuid.UUID, http.ResponseWriter, *http.Request)
+		secrets        []database.NotificationWebhookSecret
 
 		expectSuccess   bool
 		expectRetryable bool
@@ -63,6 +71,7 @@ func TestWebhook(t *testing.T) {
 				assert.Equal(t, titleMarkdown, payload.TitleMarkdown)
 				assert.Equal(t, bodyPlaintext, payload.Body)
 				assert.Equal(t, bodyMarkdown, payload.BodyMarkdown)
+				assert.Empty(t, r.Header.Get(codersdk.WebhookSignatureHeader))
 
 				w.WriteHeader(http.StatusOK)
 				_, err = w.Write([]byte(fmt.Sprintf("received %s", payload.MsgID)))
@@ -70,6 +79,32 @@ func TestWebhook(t *testing.T) {
 			},
 			expectSuccess: true,
 		},
+		{
+			name: "signed",
+			secrets: []database.NotificationWebhookSecret{
+				{Secret: "current"},
+				{Secret: "previous", ExpiresAt: sql.NullTime{Time: clock.Now().Add(time.Hour), Valid: true}},
+				{Secret: "expired", ExpiresAt: sql.NullTime{Time: clock.Now().Add(-time.Hour), Valid: true}},
+			},
+			serverFn: func(msgID uuid.UUID, w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				header := r.Header.Get(codersdk.WebhookSignatureHeader)
+
+				// Both the active and the rotated (but not yet expired) secrets are accepted.
+				for _, secret := range []string{"current", "previous"} {
+					assert.NoError(t, codersdk.VerifyWebhookSignature(header, body, []string{secret}, codersdk.DefaultWebhookSignatureTolerance, clock.Now()))
+				}
+				assert.ErrorIs(t, codersdk.VerifyWebhookSignature(header, body, []string{"expired"}, codersdk.DefaultWebhookSignatureTolerance, clock.Now()), codersdk.ErrWebhookSignatureMismatch)
+
+				var payload dispatch.WebhookPayload
+				assert.NoError(t, json.Unmarshal(body, &payload))
+				assert.Equal(t, msgID, payload.MsgID)
+
+				w.WriteHeader(http.StatusOK)
+			},
+			expectSuccess: true,
+		},
 		{
 			name: "invalid endpoint",
 			// Build a deliberately invalid URL to fail validation.
@@ -140,7 +175,7 @@ func TestWebhook(t *testing.T) {
 			cfg := codersdk.NotificationsWebhookConfig{
 				Endpoint: *serpent.URLOf(endpoint),
 			}
-			handler := dispatch.NewWebhookHandler(cfg, logger.With(slog.F("test", tc.name)))
+			handler := dispatch.NewWebhookHandler(cfg, logger.With(slog.F("test", tc.name)), &fakeWebhookSecretStore{secrets: tc.secrets}, clock)
 			deliveryFn, err := handler.Dispatcher(msgPayload, titleMarkdown, bodyMarkdown, helpers())
 			require.NoError(t, err)
 
@@ -156,3 +191,11 @@ func TestWebhook(t *testing.T) {
 		})
 	}
 }
+
+type fakeWebhookSecretStore struct {
+	secrets []database.NotificationWebhookSecret
+}
+
+func (f *fakeWebhookSecretStore) GetNotificationWebhookSecrets(context.Context) ([]database.NotificationWebhookSecret, error) {
+	return f.secrets, nil
+}
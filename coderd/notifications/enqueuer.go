@@ -41,6 +41,7 @@ type StoreEnqueuer struct {
 	defaultMethod  database.NotificationMethod
 	defaultEnabled bool
 	inboxEnabled   bool
+	digestEnabled  bool
 
 	// helpers holds a map of template funcs which are used when rendering templates. These need to be passed in because
 	// the template funcs will return values which are inappropriately encapsulated in this struct.
@@ -57,7 +58,9 @@ func NewStoreEnqueuer(cfg codersdk.NotificationsConfig, store Store, helpers tem
 	// As of 2025-03-25, setting this to `inbox` would cause a crash on the deployment
 	// notification settings page. Until we make a future decision on this we want to disallow
 	// setting it.
-	if err := method.Scan(cfg.Method.String()); err != nil || method == database.NotificationMethodInbox {
+	//
+	// `user_target` is only selected through a user's notification preferences, and cannot be a default.
+	if err := method.Scan(cfg.Method.String()); err != nil || method == database.NotificationMethodInbox || method == database.NotificationMethodUserTarget {
 		return nil, InvalidDefaultNotificationMethodError{Method: cfg.Method.String()}
 	}
 
@@ -67,6 +70,7 @@ func NewStoreEnqueuer(cfg codersdk.NotificationsConfig, store Store, helpers tem
 		defaultMethod:  method,
 		defaultEnabled: cfg.Enabled(),
 		inboxEnabled:   cfg.Inbox.Enabled.Value(),
+		digestEnabled:  cfg.DigestWindow.Value() > 0,
 		helpers:        helpers,
 		clock:          clock,
 	}, nil
@@ -91,7 +95,7 @@ func (s *StoreEnqueuer) EnqueueWithData(ctx context.Context, userID, templateID
 		return nil, xerrors.Errorf("new message metadata: %w", err)
 	}
 
-	payload, err := s.buildPayload(metadata, labels, data, targets)
+	payload, err := buildPayload(metadata, labels, data, targets, s.helpers)
 	if err != nil {
 		s.log.Warn(ctx, "failed to build payload", slog.F("template_id", templateID), slog.F("user_id", userID), slog.Error(err))
 		return nil, xerrors.Errorf("enqueue notification (payload build): %w", err)
@@ -103,7 +107,10 @@ func (s *StoreEnqueuer) EnqueueWithData(ctx context.Context, userID, templateID
 	}
 
 	methods := []database.NotificationMethod{}
-	if metadata.CustomMethod.Valid {
+	// A target chosen by the user takes precedence over the template's method and the deployment default.
+	if metadata.UserTargetID.Valid {
+		methods = append(methods, database.NotificationMethodUserTarget)
+	} else if metadata.CustomMethod.Valid {
 		methods = append(methods, metadata.CustomMethod.NotificationMethod)
 	} else if s.defaultEnabled {
 		methods = append(methods, s.defaultMethod)
@@ -126,6 +133,28 @@ func (s *StoreEnqueuer) EnqueueWithData(ctx context.Context, userID, templateID
 		}
 
 		id := uuid.New()
+
+		// Messages which the user has chosen to receive as a digest are held, and later delivered as a single message
+		// by the digest generator. Inbox messages are always delivered individually.
+		if metadata.Digest && s.digestEnabled && method != database.NotificationMethodInbox {
+			err = s.store.InsertNotificationDigestMessage(ctx, database.InsertNotificationDigestMessageParams{
+				ID:                     id,
+				UserID:                 userID,
+				NotificationTemplateID: templateID,
+				Method:                 method,
+				Payload:                input,
+				Targets:                targets,
+				CreatedBy:              createdBy,
+				CreatedAt:              dbtime.Time(s.clock.Now().UTC()),
+			})
+			if err != nil {
+				s.log.Warn(ctx, "failed to hold notification for digest", slog.F("template_id", templateID), slog.F("input", input), slog.Error(err))
+				return nil, xerrors.Errorf("hold notification for digest: %w", err)
+			}
+			uuids = append(uuids, id)
+			continue
+		}
+
 		err = s.store.EnqueueNotificationMessage(ctx, database.EnqueueNotificationMessageParams{
 			ID:                     id,
 			UserID:                 userID,
@@ -179,7 +208,7 @@ func (s *StoreEnqueuer) EnqueueWithData(ctx context.Context, userID, templateID
 // buildPayload creates the payload that the notification will for variable substitution and/or routing.
 // The payload contains information about the recipient, the event that triggered the notification, and any subsequent
 // actions which can be taken by the recipient.
-func (s *StoreEnqueuer) buildPayload(metadata database.FetchNewMessageMetadataRow, labels map[string]string, data map[string]any, targets []uuid.UUID) (*types.MessagePayload, error) {
+func buildPayload(metadata database.FetchNewMessageMetadataRow, labels map[string]string, data map[string]any, targets []uuid.UUID, helpers template.FuncMap) (*types.MessagePayload, error) {
 	payload := types.MessagePayload{
 		Version: "1.2",
 
@@ -199,7 +228,7 @@ func (s *StoreEnqueuer) buildPayload(metadata database.FetchNewMessageMetadataRo
 	}
 
 	// Execute any templates in actions.
-	out, err := render.GoTemplate(string(metadata.Actions), payload, s.helpers)
+	out, err := render.GoTemplate(string(metadata.Actions), payload, helpers)
 	if err != nil {
 		return nil, xerrors.Errorf("render actions: %w", err)
 	}
@@ -51,6 +51,7 @@ var (
 var (
 	TemplateTestNotification   = uuid.MustParse("c425f63e-716a-4bf4-ae24-78348f706c3f")
 	TemplateCustomNotification = uuid.MustParse("39b1e189-c857-4b0c-877a-511144c18516")
+	TemplateNotificationDigest = uuid.MustParse("b4f8a2d6-3c1e-4f7a-9d5b-8e2c6a4f1d93")
 )
 
 // Task-related events.
@@ -59,4 +60,5 @@ var (
 	TemplateTaskIdle      = uuid.MustParse("d4a6271c-cced-4ed0-84ad-afd02a9c7799")
 	TemplateTaskCompleted = uuid.MustParse("8c5a4d12-9f7e-4b3a-a1c8-6e4f2d9b5a7c")
 	TemplateTaskFailed    = uuid.MustParse("3b7e8f1a-4c2d-49a6-b5e9-7f3a1c8d6b4e")
+	TemplateTaskStopped   = uuid.MustParse("3a0e6837-1fab-4752-88e8-949b2033ba80")
 )
@@ -59,3 +59,10 @@ func (n *notifier) fetchLogoURL(ctx context.Context) (string, error) {
 	}
 	return logoURL, nil
 }
+
+// FetchHelpers returns the given helpers along with those which depend on the deployment's appearance settings, as
+// they are available when notifications are rendered for delivery.
+func FetchHelpers(ctx context.Context, store Store, helpers template.FuncMap) (template.FuncMap, error) {
+	n := &notifier{store: store, helpers: helpers}
+	return n.fetchHelpers(ctx)
+}
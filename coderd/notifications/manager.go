@@ -16,6 +16,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/pubsub"
 	"github.com/coder/coder/v2/coderd/notifications/dispatch"
 	"github.com/coder/coder/v2/coderd/pproflabel"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/quartz"
 )
@@ -51,6 +52,8 @@ type Manager struct {
 
 	metrics *Metrics
 
+	quietHours QuietHoursScheduleFunc
+
 	success, failure chan dispatchResult
 
 	mu       sync.Mutex // Protects following.
@@ -67,6 +70,18 @@ type Manager struct {
 
 type ManagerOption func(*Manager)
 
+// QuietHoursScheduleFunc returns the quiet hours schedule of the given user, or nil if the user has none. Each
+// scheduled time marks the start of a window which lasts for CODER_NOTIFICATIONS_QUIET_HOURS_DURATION.
+type QuietHoursScheduleFunc func(ctx context.Context, userID uuid.UUID) (*cron.Schedule, error)
+
+// WithQuietHours defers the delivery of non-urgent messages to users who have enabled quiet hours until the end of
+// their quiet hours window.
+func WithQuietHours(fn QuietHoursScheduleFunc) ManagerOption {
+	return func(m *Manager) {
+		m.quietHours = fn
+	}
+}
+
 // WithTestClock is used in testing to set the quartz clock on the manager
 func WithTestClock(clock quartz.Clock) ManagerOption {
 	return func(m *Manager) {
@@ -80,7 +95,7 @@ func WithTestClock(clock quartz.Clock) ManagerOption {
 // access URL etc.
 func NewManager(cfg codersdk.NotificationsConfig, store Store, ps pubsub.Pubsub, helpers template.FuncMap, metrics *Metrics, log slog.Logger, opts ...ManagerOption) (*Manager, error) {
 	var method database.NotificationMethod
-	if err := method.Scan(cfg.Method.String()); err != nil {
+	if err := method.Scan(cfg.Method.String()); err != nil || method == database.NotificationMethodUserTarget {
 		return nil, xerrors.Errorf("notification method %q is invalid", cfg.Method)
 	}
 
@@ -111,23 +126,25 @@ func NewManager(cfg codersdk.NotificationsConfig, store Store, ps pubsub.Pubsub,
 		stop: make(chan any),
 		done: make(chan any),
 
-		handlers: defaultHandlers(cfg, log, store, ps),
-		helpers:  helpers,
+		helpers: helpers,
 
 		clock: quartz.NewReal(),
 	}
 	for _, o := range opts {
 		o(m)
 	}
+	// Handlers are built once the options have been applied so that they share the manager's clock.
+	m.handlers = defaultHandlers(cfg, log, store, ps, m.clock)
 	return m, nil
 }
 
 // defaultHandlers builds a set of known handlers; panics if any error occurs as these handlers should be valid at compile time.
-func defaultHandlers(cfg codersdk.NotificationsConfig, log slog.Logger, store Store, ps pubsub.Pubsub) map[database.NotificationMethod]Handler {
+func defaultHandlers(cfg codersdk.NotificationsConfig, log slog.Logger, store Store, ps pubsub.Pubsub, clock quartz.Clock) map[database.NotificationMethod]Handler {
 	return map[database.NotificationMethod]Handler{
-		database.NotificationMethodSmtp:    dispatch.NewSMTPHandler(cfg.SMTP, log.Named("dispatcher.smtp")),
-		database.NotificationMethodWebhook: dispatch.NewWebhookHandler(cfg.Webhook, log.Named("dispatcher.webhook")),
-		database.NotificationMethodInbox:   dispatch.NewInboxHandler(log.Named("dispatcher.inbox"), store, ps),
+		database.NotificationMethodSmtp:       dispatch.NewSMTPHandler(cfg.SMTP, log.Named("dispatcher.smtp")),
+		database.NotificationMethodWebhook:    dispatch.NewWebhookHandler(cfg.Webhook, log.Named("dispatcher.webhook"), store, clock),
+		database.NotificationMethodInbox:      dispatch.NewInboxHandler(log.Named("dispatcher.inbox"), store, ps),
+		database.NotificationMethodUserTarget: dispatch.NewUserTargetHandler(cfg, log.Named("dispatcher.user_target"), store, clock),
 	}
 }
 
@@ -175,7 +192,7 @@ func (m *Manager) loop(ctx context.Context) error {
 
 	var eg errgroup.Group
 
-	m.notifier = newNotifier(ctx, m.cfg, uuid.New(), m.log, m.store, m.handlers, m.helpers, m.metrics, m.clock)
+	m.notifier = newNotifier(ctx, m.cfg, uuid.New(), m.log, m.store, m.handlers, m.helpers, m.metrics, m.clock, m.quietHours)
 	eg.Go(func() error {
 		// run the notifier which will handle dequeueing and dispatching notifications.
 		return m.notifier.run(m.success, m.failure)
@@ -49,6 +49,7 @@ import (
 	"github.com/coder/coder/v2/coderd/notifications/dispatch/smtptest"
 	"github.com/coder/coder/v2/coderd/notifications/types"
 	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
 	"github.com/coder/coder/v2/coderd/util/syncmap"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/testutil"
@@ -460,7 +461,7 @@ func TestRetries(t *testing.T) {
 	cfg.RetryInterval = serpent.Duration(time.Second) // query uses second-precision
 	cfg.FetchInterval = serpent.Duration(time.Millisecond * 100)
 
-	handler := newDispatchInterceptor(dispatch.NewWebhookHandler(cfg.Webhook, logger.Named("webhook")))
+	handler := newDispatchInterceptor(dispatch.NewWebhookHandler(cfg.Webhook, logger.Named("webhook"), store, quartz.NewReal()))
 
 	// Intercept calls to submit the buffered updates to the store.
 	storeInterceptor := &syncInterceptor{Store: store}
@@ -1273,6 +1274,40 @@ func TestNotificationTemplates_Golden(t *testing.T) {
 				Data: map[string]any{},
 			},
 		},
+		{
+			name: "TemplateNotificationDigest",
+			id:   notifications.TemplateNotificationDigest,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels:       map[string]string{},
+				Data: map[string]any{
+					"notification_name": "Template Deleted",
+					"count":             2,
+					"window":            "hour",
+					"messages": []map[string]any{
+						{
+							"title":      "Template \"alpha\" deleted",
+							"body":       "The template **alpha** was deleted by **admin**.",
+							"actions":    []map[string]any{},
+							"created_at": "2024-10-11T08:03:06Z",
+						},
+						{
+							"title": "Template \"beta\" deleted",
+							"body":  "The template **beta** was deleted by **admin**.",
+							"actions": []map[string]any{
+								{
+									"label": "View templates",
+									"url":   "http://test.com/templates",
+								},
+							},
+							"created_at": "2024-10-11T08:33:06Z",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "TemplateTaskWorking",
 			id:   notifications.TemplateTaskWorking,
@@ -1329,6 +1364,21 @@ func TestNotificationTemplates_Golden(t *testing.T) {
 				Data: map[string]any{},
 			},
 		},
+		{
+			name: "TemplateTaskStopped",
+			id:   notifications.TemplateTaskStopped,
+			payload: types.MessagePayload{
+				UserName:     "Bobby",
+				UserEmail:    "bobby@coder.com",
+				UserUsername: "bobby",
+				Labels: map[string]string{
+					"task":      "my-task",
+					"workspace": "my-workspace",
+					"reason":    "it was idle for 30 minutes",
+				},
+				Data: map[string]any{},
+			},
+		},
 	}
 
 	// We must have a test case for every notification_template. This is enforced below:
@@ -1824,6 +1874,136 @@ func TestDisabledAfterEnqueue(t *testing.T) {
 	}, testutil.WaitLong, testutil.IntervalFast, "did not find the expected inhibited message")
 }
 
+func TestQuietHours(t *testing.T) {
+	t.Parallel()
+
+	// SETUP
+	if !dbtestutil.WillUsePostgres() {
+		t.Skip("This test requires postgres; it is testing business-logic implemented in the database")
+	}
+
+	setup := func(t *testing.T) (context.Context, database.Store, *notifications.Manager, *fakeHandler, notifications.Enqueuer, database.User) {
+		ctx := dbauthz.AsNotifier(testutil.Context(t, testutil.WaitSuperLong))
+		store, pubsub := dbtestutil.NewDB(t)
+		logger := testutil.Logger(t)
+
+		method := database.NotificationMethodSmtp
+		cfg := defaultNotificationsConfig(method)
+		cfg.QuietHoursDuration = serpent.Duration(time.Hour)
+
+		// GIVEN: a user whose quiet hours started a minute ago
+		start := time.Now().UTC().Add(-time.Minute)
+		sched, err := cron.Daily(fmt.Sprintf("CRON_TZ=UTC %d %d * * *", start.Minute(), start.Hour()))
+		require.NoError(t, err)
+		user := createSampleUser(t, store)
+
+		handler := &fakeHandler{}
+		mgr, err := notifications.NewManager(cfg, store, pubsub, defaultHelpers(), createMetrics(), logger.Named("manager"),
+			notifications.WithQuietHours(func(_ context.Context, userID uuid.UUID) (*cron.Schedule, error) {
+				if userID != user.ID {
+					return nil, nil
+				}
+				return sched, nil
+			}))
+		require.NoError(t, err)
+		mgr.WithHandlers(map[database.NotificationMethod]notifications.Handler{
+			method:                           handler,
+			database.NotificationMethodInbox: handler,
+		})
+		t.Cleanup(func() {
+			assert.NoError(t, mgr.Stop(ctx))
+		})
+
+		enq, err := notifications.NewStoreEnqueuer(cfg, store, defaultHelpers(), logger.Named("enqueuer"), quartz.NewReal())
+		require.NoError(t, err)
+		return ctx, store, mgr, handler, enq, user
+	}
+
+	t.Run("DeferredUntilWindowEnds", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, store, mgr, handler, enq, user := setup(t)
+
+		// GIVEN: the user has enabled quiet hours
+		_, err := store.UpdateUserNotificationQuietHours(ctx, database.UpdateUserNotificationQuietHoursParams{
+			UserID:                 user.ID,
+			NotificationQuietHours: "true",
+		})
+		require.NoError(t, err)
+
+		// WHEN: a non-urgent and an urgent notification are enqueued, each to SMTP and the inbox
+		deferredIDs, err := enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"type": "success"}, "test")
+		require.NoError(t, err)
+		require.Len(t, deferredIDs, 2)
+		urgentIDs, err := enq.Enqueue(ctx, user.ID, notifications.TemplateTestNotification, map[string]string{"type": "success"}, "test")
+		require.NoError(t, err)
+		mgr.Run(ctx)
+
+		// THEN: all but the non-urgent SMTP message are delivered
+		require.Eventually(t, func() bool {
+			handler.mu.RLock()
+			defer handler.mu.RUnlock()
+			return len(handler.succeeded) == 3
+		}, testutil.WaitLong, testutil.IntervalFast)
+
+		handler.mu.RLock()
+		for _, id := range urgentIDs {
+			assert.Contains(t, handler.succeeded, id.String())
+		}
+		handler.mu.RUnlock()
+
+		// THEN: the non-urgent SMTP message is returned to the queue until the quiet hours end
+		require.EventuallyWithT(t, func(ct *assert.CollectT) {
+			msgs, err := store.GetNotificationMessagesByStatus(ctx, database.GetNotificationMessagesByStatusParams{
+				Status: database.NotificationMessageStatusPending,
+				Limit:  10,
+			})
+			assert.NoError(ct, err)
+			if assert.Len(ct, msgs, 1) {
+				assert.Contains(ct, deferredIDs, msgs[0].ID)
+				assert.Contains(ct, msgs[0].StatusReason.String, "quiet hours")
+				assert.WithinDuration(ct, time.Now().Add(59*time.Minute), msgs[0].NextRetryAfter.Time, time.Minute)
+			}
+		}, testutil.WaitLong, testutil.IntervalFast)
+	})
+
+	t.Run("Snoozed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, store, mgr, handler, enq, user := setup(t)
+
+		// GIVEN: the user has snoozed their notifications, but not enabled quiet hours
+		_, err := store.UpdateUserNotificationSnoozeUntil(ctx, database.UpdateUserNotificationSnoozeUntilParams{
+			UserID:                  user.ID,
+			NotificationSnoozeUntil: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+		require.NoError(t, err)
+
+		// WHEN: a non-urgent and an urgent notification are enqueued, each to SMTP and the inbox
+		_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateWorkspaceDeleted, map[string]string{"type": "success"}, "test")
+		require.NoError(t, err)
+		_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateTestNotification, map[string]string{"type": "success"}, "test")
+		require.NoError(t, err)
+		mgr.Run(ctx)
+
+		// THEN: all but the non-urgent SMTP message are delivered
+		require.Eventually(t, func() bool {
+			handler.mu.RLock()
+			defer handler.mu.RUnlock()
+			return len(handler.succeeded) == 3
+		}, testutil.WaitLong, testutil.IntervalFast)
+
+		// THEN: the non-urgent SMTP message is never acquired while the snooze lasts
+		msgs, err := store.GetNotificationMessagesByStatus(ctx, database.GetNotificationMessagesByStatusParams{
+			Status: database.NotificationMessageStatusPending,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Zero(t, msgs[0].AttemptCount.Int32)
+	})
+}
+
 func TestCustomNotificationMethod(t *testing.T) {
 	t.Parallel()
 
@@ -1926,6 +2106,91 @@ func TestCustomNotificationMethod(t *testing.T) {
 	}, testutil.WaitLong, testutil.IntervalFast)
 }
 
+func TestUserTargetNotificationMethod(t *testing.T) {
+	t.Parallel()
+
+	// SETUP
+	if !dbtestutil.WillUsePostgres() {
+		t.Skip("This test requires postgres; it relies on business-logic only implemented in the database")
+	}
+
+	ctx := dbauthz.AsNotifier(testutil.Context(t, testutil.WaitSuperLong))
+	store, pubsub := dbtestutil.NewDB(t)
+	logger := testutil.Logger(t)
+
+	type delivery struct {
+		signature string
+		payload   dispatch.WebhookPayload
+	}
+	received := make(chan delivery, 1)
+
+	// SETUP:
+	// Start mock server to simulate the user's webhook endpoint.
+	mockTargetSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := codersdk.VerifyWebhookRequest(r, "hunter2")
+		assert.NoError(t, err)
+		var payload dispatch.WebhookPayload
+		assert.NoError(t, json.Unmarshal(body, &payload))
+
+		received <- delivery{signature: r.Header.Get(codersdk.WebhookSignatureHeader), payload: payload}
+		close(received)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockTargetSrv.Close()
+
+	// GIVEN: a user who has routed a notification template to their own signed webhook.
+	tmpl := notifications.TemplateWorkspaceDormant
+	user := createSampleUser(t, store)
+	target := dbgen.NotificationUserTarget(t, store, database.NotificationUserTarget{
+		UserID:   user.ID,
+		Type:     database.NotificationUserTargetTypeWebhook,
+		Endpoint: mockTargetSrv.URL,
+		Secret:   "hunter2",
+	})
+	_, err := store.UpdateUserNotificationPreferenceTarget(ctx, database.UpdateUserNotificationPreferenceTargetParams{
+		UserID:                 user.ID,
+		UserTargetID:           uuid.NullUUID{UUID: target.ID, Valid: true},
+		NotificationTemplateID: tmpl,
+	})
+	require.NoError(t, err)
+
+	// GIVEN: a manager whose default method would otherwise handle the message.
+	cfg := defaultNotificationsConfig(database.NotificationMethodSmtp)
+	// The mock server listens on a loopback address.
+	cfg.UserTargetsAllowPrivateNetworks = true
+	mgr, err := notifications.NewManager(cfg, store, pubsub, defaultHelpers(), createMetrics(), logger.Named("manager"))
+	require.NoError(t, err)
+	handler := &fakeHandler{}
+	mgr.WithHandlers(map[database.NotificationMethod]notifications.Handler{
+		database.NotificationMethodSmtp:       handler,
+		database.NotificationMethodInbox:      &fakeHandler{},
+		database.NotificationMethodUserTarget: dispatch.NewUserTargetHandler(cfg, logger.Named("user_target"), store, quartz.NewReal()),
+	})
+	t.Cleanup(func() {
+		_ = mgr.Stop(ctx)
+	})
+
+	enq, err := notifications.NewStoreEnqueuer(cfg, store, defaultHelpers(), logger.Named("enqueuer"), quartz.NewReal())
+	require.NoError(t, err)
+
+	// WHEN: a notification of that template is enqueued.
+	msgID, err := enq.Enqueue(ctx, user.ID, tmpl, map[string]string{"name": "bobby", "reason": "idle", "timeTilDormant": "24h"}, "test")
+	require.NoError(t, err)
+	mgr.Run(ctx)
+
+	// THEN: the notification should be delivered to the user's target, signed with their secret.
+	got := testutil.TryReceive(ctx, t, received)
+	require.Equal(t, msgID[0], got.payload.MsgID)
+	require.NotEmpty(t, got.signature)
+
+	// THEN: the default method should not be used.
+	handler.mu.RLock()
+	defer handler.mu.RUnlock()
+	require.Empty(t, handler.succeeded)
+	require.Empty(t, handler.failed)
+}
+
 func TestNotificationsTemplates(t *testing.T) {
 	t.Parallel()
 
@@ -2,10 +2,12 @@ package notifications
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"sync"
 	"text/template"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/sync/errgroup"
@@ -63,12 +65,15 @@ type notifier struct {
 	metrics  *Metrics
 	helpers  template.FuncMap
 
+	quietHours QuietHoursScheduleFunc
+
 	// clock is for testing
 	clock quartz.Clock
 }
 
 func newNotifier(outerCtx context.Context, cfg codersdk.NotificationsConfig, id uuid.UUID, log slog.Logger, db Store,
 	hr map[database.NotificationMethod]Handler, helpers template.FuncMap, metrics *Metrics, clock quartz.Clock,
+	quietHours QuietHoursScheduleFunc,
 ) *notifier {
 	gracefulCtx, gracefulCancel := context.WithCancel(outerCtx)
 	return &notifier{
@@ -84,6 +89,7 @@ func newNotifier(outerCtx context.Context, cfg codersdk.NotificationsConfig, id
 		helpers:        helpers,
 		metrics:        metrics,
 		clock:          clock,
+		quietHours:     quietHours,
 	}
 }
 
@@ -177,6 +183,19 @@ func (n *notifier) process(ctx context.Context, success chan<- dispatchResult, f
 			continue
 		}
 
+		// Non-urgent messages to users in their quiet hours are returned to the queue until the window ends.
+		if until, ok := n.quietHoursEnd(ctx, msg); ok {
+			err := n.store.DeferNotificationMessage(ctx, database.DeferNotificationMessageParams{
+				ID:             msg.ID,
+				StatusReason:   sql.NullString{String: "Deferred until the end of the recipient's quiet hours", Valid: true},
+				NextRetryAfter: sql.NullTime{Time: until, Valid: true},
+			})
+			if err != nil {
+				n.log.Error(ctx, "failed to defer message", slog.F("msg_id", msg.ID), slog.Error(err))
+			}
+			continue
+		}
+
 		// A message failing to be prepared correctly should not affect other messages.
 		deliverFn, err := n.prepare(ctx, msg)
 		if err != nil {
@@ -205,6 +224,37 @@ func (n *notifier) process(ctx context.Context, success chan<- dispatchResult, f
 	return nil
 }
 
+// quietHoursEnd returns the end of the recipient's current quiet hours window if the given message should be deferred
+// until then. Urgent messages and inbox notifications are never deferred.
+func (n *notifier) quietHoursEnd(ctx context.Context, msg database.AcquireNotificationMessagesRow) (time.Time, bool) {
+	if n.quietHours == nil || !msg.QuietHours || msg.Urgent || msg.Method == database.NotificationMethodInbox {
+		return time.Time{}, false
+	}
+
+	duration := n.cfg.QuietHoursDuration.Value()
+	if duration <= 0 {
+		return time.Time{}, false
+	}
+
+	sched, err := n.quietHours(ctx, msg.UserID)
+	if err != nil {
+		// Err on the side of delivering the message.
+		n.log.Warn(ctx, "failed to get quiet hours schedule", slog.F("msg_id", msg.ID), slog.F("user_id", msg.UserID), slog.Error(err))
+		return time.Time{}, false
+	}
+	if sched == nil {
+		return time.Time{}, false
+	}
+
+	// The window we're in, if any, is the one which started within the last duration.
+	now := n.clock.Now()
+	start := sched.Next(now.Add(-duration))
+	if start.After(now) {
+		return time.Time{}, false
+	}
+	return start.Add(duration), true
+}
+
 // fetch retrieves messages from the queue by "acquiring a lease" whereby this notifier is the exclusive handler of these
 // messages until they are dispatched - or until the lease expires (in exceptional cases).
 func (n *notifier) fetch(ctx context.Context) ([]database.AcquireNotificationMessagesRow, error) {
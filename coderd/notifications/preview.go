@@ -0,0 +1,48 @@
+package notifications
+
+import (
+	"text/template"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/notifications/render"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+)
+
+// SamplePayload builds a payload with which the given template can be previewed, or sent as a test, to the given
+// user. Labels which are referenced by the template's effective title, body or actions but are not given are filled
+// with a placeholder of the form "[name]".
+func SamplePayload(tmpl database.NotificationTemplate, user database.User, labels map[string]string, data map[string]any, helpers template.FuncMap) (types.MessagePayload, error) {
+	sampleLabels := make(map[string]string, len(labels))
+	for _, in := range []string{tmpl.EffectiveTitleTemplate(), tmpl.EffectiveBodyTemplate(), string(tmpl.Actions)} {
+		names, err := render.Labels(in)
+		if err != nil {
+			return types.MessagePayload{}, xerrors.Errorf("inspect template labels: %w", err)
+		}
+		for _, name := range names {
+			sampleLabels[name] = "[" + name + "]"
+		}
+	}
+	for k, v := range labels {
+		sampleLabels[k] = v
+	}
+
+	actions := tmpl.Actions
+	if len(actions) == 0 {
+		actions = []byte("[]")
+	}
+	payload, err := buildPayload(database.FetchNewMessageMetadataRow{
+		NotificationName:       tmpl.Name,
+		NotificationTemplateID: tmpl.ID,
+		Actions:                actions,
+		UserID:                 user.ID,
+		UserEmail:              user.Email,
+		UserName:               user.Name,
+		UserUsername:           user.Username,
+	}, sampleLabels, data, nil, helpers)
+	if err != nil {
+		return types.MessagePayload{}, err
+	}
+	return *payload, nil
+}
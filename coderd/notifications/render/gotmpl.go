@@ -3,6 +3,7 @@ package render
 import (
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"golang.org/x/xerrors"
 
@@ -33,3 +34,68 @@ func GoTemplate(in string, payload types.MessagePayload, extraFuncs template.Fun
 
 	return out.String(), nil
 }
+
+// Labels returns the names of the labels referenced by the given template, e.g. "name" for {{ .Labels.name }}, in
+// the order in which they first appear. Functions do not need to be defined to inspect the template.
+func Labels(in string) ([]string, error) {
+	tree := parse.New("text")
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(in, "", "", map[string]*parse.Tree{}); err != nil {
+		return nil, xerrors.Errorf("template parse: %w", err)
+	}
+
+	var (
+		labels []string
+		seen   = map[string]struct{}{}
+		walk   func(node parse.Node)
+	)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(&n.BranchNode)
+		case *parse.RangeNode:
+			walk(&n.BranchNode)
+		case *parse.WithNode:
+			walk(&n.BranchNode)
+		case *parse.BranchNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			if len(n.Ident) < 2 || n.Ident[0] != "Labels" {
+				return
+			}
+			if _, ok := seen[n.Ident[1]]; !ok {
+				seen[n.Ident[1]] = struct{}{}
+				labels = append(labels, n.Ident[1])
+			}
+		}
+	}
+	walk(tree.Root)
+
+	return labels, nil
+}
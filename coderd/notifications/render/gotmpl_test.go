@@ -84,3 +84,16 @@ func TestGoTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestLabels(t *testing.T) {
+	t.Parallel()
+
+	labels, err := render.Labels(`Workspace {{ .Labels.name }} was {{ if .Labels.reason }}deleted ({{ .Labels.reason }}){{ else }}removed{{ end }}
+{{ range $k, $v := .Data.items }}{{ $k }}: {{ $v }}{{ end }}
+{{ base_url }}/@{{ .UserUsername }}/{{ .Labels.name | printf "%s" }} {{ .Labels.owner }}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "reason", "owner"}, labels)
+
+	_, err = render.Labels("{{ .Labels.name ")
+	require.Error(t, err)
+}
@@ -0,0 +1,302 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/coderd/notifications/render"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/coderd/util/slice"
+)
+
+const (
+	digestInterval = time.Minute
+	digestAppName  = "Coder"
+	digestLogoURL  = "https://coder.com/coder-logo-horizontal.png"
+)
+
+// NewDigestGenerator periodically combines the notifications which users have chosen to receive as a digest into a
+// single message per user, notification template, and delivery method. A digest is sent once its oldest message has
+// been held for the given window.
+//
+// Held messages are kept in the database, so digests survive restarts, and only one replica sends digests at a time.
+func NewDigestGenerator(ctx context.Context, logger slog.Logger, db database.Store, helpers template.FuncMap, window time.Duration, clk quartz.Clock) io.Closer {
+	closed := make(chan struct{})
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	//nolint:gocritic // The system delivers digests without direct user input.
+	ctx = dbauthz.AsSystemRestricted(ctx)
+
+	ticker := clk.NewTicker(digestInterval)
+	ticker.Stop()
+	doTick := func(start time.Time) {
+		defer ticker.Reset(digestInterval)
+		// Start a transaction to grab advisory lock, we don't want to send the same digest from multiple replicas.
+		if err := db.InTx(func(tx database.Store) error {
+			ok, err := tx.TryAcquireLock(ctx, database.LockIDNotificationsDigestGenerator)
+			if err != nil {
+				return xerrors.Errorf("failed to acquire digest generator lock: %w", err)
+			}
+			if !ok {
+				logger.Debug(ctx, "unable to acquire lock for sending notification digests, skipping")
+				return nil
+			}
+
+			sent, err := sendDigests(ctx, logger, tx, helpers, window, start)
+			if err != nil {
+				return xerrors.Errorf("unable to send notification digests: %w", err)
+			}
+
+			logger.Debug(ctx, "digest generator finished", slog.F("digests", sent), slog.F("duration", clk.Since(start)))
+			return nil
+		}, nil); err != nil {
+			logger.Error(ctx, "failed to send notification digests", slog.Error(err))
+			return
+		}
+	}
+
+	go func() {
+		defer close(closed)
+		defer ticker.Stop()
+		// Force an initial tick, which sends any digests that fell due while no replica was running.
+		doTick(dbtime.Time(clk.Now()).UTC())
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Debug(ctx, "closing digest generator")
+				return
+			case tick := <-ticker.C:
+				ticker.Stop()
+
+				doTick(dbtime.Time(tick).UTC())
+			}
+		}
+	}()
+	return &reportGenerator{
+		cancel: cancelFunc,
+		closed: closed,
+	}
+}
+
+// sendDigests enqueues a digest for every group of held messages whose oldest message was held at least window ago,
+// and removes the messages it has combined. It returns the number of digests enqueued.
+func sendDigests(ctx context.Context, logger slog.Logger, db database.Store, helpers template.FuncMap, window time.Duration, now time.Time) (int, error) {
+	held, err := db.GetDueNotificationDigestMessages(ctx, dbtime.Time(now.Add(-window)).UTC())
+	if err != nil {
+		return 0, xerrors.Errorf("unable to fetch held notifications: %w", err)
+	}
+	if len(held) == 0 {
+		return 0, nil
+	}
+
+	helpers, err = digestHelpers(ctx, db, helpers)
+	if err != nil {
+		return 0, err
+	}
+
+	// Messages are ordered so that the messages of each digest are contiguous.
+	var sent int
+	for start := 0; start < len(held); {
+		end := start + 1
+		for end < len(held) && sameDigest(held[start], held[end]) {
+			end++
+		}
+		group := held[start:end]
+		start = end
+
+		if err := sendDigest(ctx, logger, db, helpers, window, now, group); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func sameDigest(a, b database.NotificationDigestMessage) bool {
+	return a.UserID == b.UserID && a.NotificationTemplateID == b.NotificationTemplateID && a.Method == b.Method
+}
+
+func sendDigest(ctx context.Context, logger slog.Logger, db database.Store, helpers template.FuncMap, window time.Duration, now time.Time, group []database.NotificationDigestMessage) error {
+	first := group[0]
+	logger = logger.With(slog.F("user_id", first.UserID), slog.F("notification_template_id", first.NotificationTemplateID), slog.F("method", first.Method))
+
+	tmpl, err := db.GetNotificationTemplateByID(ctx, first.NotificationTemplateID)
+	if err != nil {
+		return xerrors.Errorf("unable to fetch notification template: %w", err)
+	}
+
+	var (
+		digest   types.MessagePayload
+		ids      = make([]uuid.UUID, 0, len(group))
+		targets  []uuid.UUID
+		messages = make([]map[string]any, 0, len(group))
+	)
+	for _, msg := range group {
+		ids = append(ids, msg.ID)
+		targets = append(targets, msg.Targets...)
+
+		var payload types.MessagePayload
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			logger.Warn(ctx, "unable to decode held notification, skipping", slog.F("msg_id", msg.ID), slog.Error(err))
+			continue
+		}
+		if len(messages) == 0 {
+			digest = payload
+		}
+
+		// A message which cannot be rendered is still listed, so that the user knows it was sent.
+		title, err := render.GoTemplate(tmpl.EffectiveTitleTemplate(), payload, helpers)
+		if err != nil {
+			logger.Warn(ctx, "unable to render held notification title", slog.F("msg_id", msg.ID), slog.Error(err))
+			title = tmpl.Name
+		}
+		body, err := render.GoTemplate(tmpl.EffectiveBodyTemplate(), payload, helpers)
+		if err != nil {
+			logger.Warn(ctx, "unable to render held notification body", slog.F("msg_id", msg.ID), slog.Error(err))
+			body = ""
+		}
+
+		actions := make([]map[string]any, 0, len(payload.Actions))
+		for _, action := range payload.Actions {
+			actions = append(actions, map[string]any{
+				"label": action.Label,
+				"url":   action.URL,
+			})
+		}
+
+		messages = append(messages, map[string]any{
+			"title":      title,
+			"body":       body,
+			"actions":    actions,
+			"created_at": msg.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	enabled, err := digestEnabled(ctx, db, first.UserID)
+	if err != nil {
+		return err
+	}
+	if len(messages) == 0 || !enabled {
+		// Either nothing could be decoded, or the user has disabled digests altogether; the held messages are dropped.
+		logger.Debug(ctx, "notification digest not enqueued", slog.F("messages", len(messages)), slog.F("enabled", enabled))
+		if err := db.DeleteNotificationDigestMessages(ctx, ids); err != nil {
+			return xerrors.Errorf("unable to delete held notifications: %w", err)
+		}
+		return nil
+	}
+
+	// The digest keeps the recipient and the original notification template of the held messages, so that it is
+	// routed exactly as they would have been, including to a user-defined target.
+	digest.NotificationName = tmpl.Name
+	digest.NotificationTemplateID = first.NotificationTemplateID.String()
+	digest.Actions = []types.TemplateAction{}
+	digest.Labels = map[string]string{}
+	digest.Data = map[string]any{
+		"notification_name": tmpl.Name,
+		"count":             len(messages),
+		"window":            formatDigestWindow(window),
+		"messages":          messages,
+	}
+	digest.Targets = slice.Unique(targets)
+
+	input, err := json.Marshal(digest)
+	if err != nil {
+		return xerrors.Errorf("unable to encode digest payload: %w", err)
+	}
+
+	err = db.EnqueueNotificationMessage(ctx, database.EnqueueNotificationMessageParams{
+		ID:                     uuid.New(),
+		NotificationTemplateID: notifications.TemplateNotificationDigest,
+		UserID:                 first.UserID,
+		Method:                 first.Method,
+		Payload:                input,
+		Targets:                digest.Targets,
+		CreatedBy:              "digest_generator",
+		CreatedAt:              dbtime.Time(now).UTC(),
+	})
+	if err != nil {
+		return xerrors.Errorf("unable to enqueue notification digest: %w", err)
+	}
+
+	if err := db.DeleteNotificationDigestMessages(ctx, ids); err != nil {
+		return xerrors.Errorf("unable to delete held notifications: %w", err)
+	}
+	return nil
+}
+
+// digestEnabled reports whether the user receives digests at all. This is checked up front, rather than relying on the
+// trigger which inhibits disabled notifications, since a failed insert would abort the surrounding transaction.
+func digestEnabled(ctx context.Context, db database.Store, userID uuid.UUID) (bool, error) {
+	prefs, err := db.GetUserNotificationPreferences(ctx, userID)
+	if err != nil {
+		return false, xerrors.Errorf("unable to fetch notification preferences: %w", err)
+	}
+	for _, pref := range prefs {
+		if pref.NotificationTemplateID == notifications.TemplateNotificationDigest {
+			return !pref.Disabled, nil
+		}
+	}
+	return true, nil
+}
+
+// digestHelpers adds the helpers which the notifier resolves at dispatch time, since held messages are rendered
+// before they are enqueued.
+func digestHelpers(ctx context.Context, db database.Store, helpers template.FuncMap) (template.FuncMap, error) {
+	appName, err := db.GetApplicationName(ctx)
+	if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+		return nil, xerrors.Errorf("unable to fetch application name: %w", err)
+	}
+	if appName == "" {
+		appName = digestAppName
+	}
+	logoURL, err := db.GetLogoURL(ctx)
+	if err != nil && !xerrors.Is(err, sql.ErrNoRows) {
+		return nil, xerrors.Errorf("unable to fetch logo URL: %w", err)
+	}
+	if logoURL == "" {
+		logoURL = digestLogoURL
+	}
+
+	out := make(template.FuncMap, len(helpers)+2)
+	for k, v := range helpers {
+		out[k] = v
+	}
+	out["app_name"] = func() string { return appName }
+	out["logo_url"] = func() string { return logoURL }
+	return out, nil
+}
+
+// formatDigestWindow describes the window in words, e.g. "hour" or "30 minutes".
+func formatDigestWindow(window time.Duration) string {
+	unit := func(n int64, name string) string {
+		if n == 1 {
+			return name
+		}
+		return fmt.Sprintf("%d %ss", n, name)
+	}
+	switch {
+	case window >= 24*time.Hour && window%(24*time.Hour) == 0:
+		return unit(int64(window/(24*time.Hour)), "day")
+	case window >= time.Hour && window%time.Hour == 0:
+		return unit(int64(window/time.Hour), "hour")
+	case window >= time.Minute && window%time.Minute == 0:
+		return unit(int64(window/time.Minute), "minute")
+	default:
+		return window.String()
+	}
+}
@@ -0,0 +1,124 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/notifications"
+	"github.com/coder/coder/v2/coderd/notifications/types"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func TestSendDigests(t *testing.T) {
+	t.Parallel()
+
+	const window = time.Hour
+
+	// Setup
+	ctx, logger, db, _, _, clk := setup(t)
+	helpers := map[string]any{
+		"base_url": func() string { return "http://test.com" },
+	}
+	enq, err := notifications.NewStoreEnqueuer(codersdk.NotificationsConfig{
+		Method:       serpent.String(database.NotificationMethodSmtp),
+		SMTP:         codersdk.NotificationsEmailConfig{Smarthost: "localhost:25"},
+		DigestWindow: serpent.Duration(window),
+	}, db, helpers, logger, clk)
+	require.NoError(t, err)
+
+	// Given: a user who receives template deletions as a digest, and another who does not.
+	digestUser := dbgen.User(t, db, database.User{})
+	otherUser := dbgen.User(t, db, database.User{})
+	_, err = db.UpdateUserNotificationPreferenceDigest(ctx, database.UpdateUserNotificationPreferenceDigestParams{
+		UserID:                 digestUser.ID,
+		NotificationTemplateID: notifications.TemplateTemplateDeleted,
+		Digest:                 true,
+	})
+	require.NoError(t, err)
+
+	// When: both users are notified of three deleted templates.
+	for _, name := range []string{"alpha", "beta", "gamma"} {
+		for _, user := range []database.User{digestUser, otherUser} {
+			_, err = enq.Enqueue(ctx, user.ID, notifications.TemplateTemplateDeleted, map[string]string{"name": name, "initiator": "admin"}, "test")
+			require.NoError(t, err)
+		}
+	}
+
+	// Then: only the other user's notifications are enqueued immediately.
+	require.Len(t, pendingMessages(ctx, t, db, notifications.TemplateTemplateDeleted), 3)
+
+	// When: the digest generator runs before the window has elapsed.
+	sent, err := sendDigests(ctx, logger, db, helpers, window, clk.Now().Add(window/2))
+
+	// Then: no digest is sent.
+	require.NoError(t, err)
+	require.Zero(t, sent)
+	require.Empty(t, pendingMessages(ctx, t, db, notifications.TemplateNotificationDigest))
+
+	// When: the digest generator runs once the window has elapsed.
+	sent, err = sendDigests(ctx, logger, db, helpers, window, clk.Now().Add(window))
+
+	// Then: a single digest containing all three notifications is enqueued for the user.
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	digests := pendingMessages(ctx, t, db, notifications.TemplateNotificationDigest)
+	require.Len(t, digests, 1)
+	require.Equal(t, digestUser.ID, digests[0].UserID)
+	require.Equal(t, database.NotificationMethodSmtp, digests[0].Method)
+
+	var payload types.MessagePayload
+	require.NoError(t, json.Unmarshal(digests[0].Payload, &payload))
+	require.Equal(t, notifications.TemplateTemplateDeleted.String(), payload.NotificationTemplateID)
+	require.EqualValues(t, 3, payload.Data["count"])
+	require.Equal(t, "hour", payload.Data["window"])
+	require.Len(t, payload.Data["messages"], 3)
+
+	// Then: the held messages are gone, so the digest is not sent again.
+	sent, err = sendDigests(ctx, logger, db, helpers, window, clk.Now().Add(2*window))
+	require.NoError(t, err)
+	require.Zero(t, sent)
+}
+
+func TestFormatDigestWindow(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		window   time.Duration
+		expected string
+	}{
+		{window: time.Hour, expected: "hour"},
+		{window: 4 * time.Hour, expected: "4 hours"},
+		{window: 30 * time.Minute, expected: "30 minutes"},
+		{window: 24 * time.Hour, expected: "day"},
+		{window: 90 * time.Second, expected: "1m30s"},
+	} {
+		require.Equal(t, tc.expected, formatDigestWindow(tc.window))
+	}
+}
+
+func pendingMessages(ctx context.Context, t *testing.T, db database.Store, templateID uuid.UUID) []database.NotificationMessage {
+	t.Helper()
+
+	msgs, err := db.GetNotificationMessagesByStatus(ctx, database.GetNotificationMessagesByStatusParams{
+		Status: database.NotificationMessageStatusPending,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+
+	var out []database.NotificationMessage
+	for _, msg := range msgs {
+		if msg.NotificationTemplateID == templateID {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
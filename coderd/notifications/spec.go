@@ -19,7 +19,9 @@ type Store interface {
 	AcquireNotificationMessages(ctx context.Context, params database.AcquireNotificationMessagesParams) ([]database.AcquireNotificationMessagesRow, error)
 	BulkMarkNotificationMessagesSent(ctx context.Context, arg database.BulkMarkNotificationMessagesSentParams) (int64, error)
 	BulkMarkNotificationMessagesFailed(ctx context.Context, arg database.BulkMarkNotificationMessagesFailedParams) (int64, error)
+	DeferNotificationMessage(ctx context.Context, arg database.DeferNotificationMessageParams) error
 	EnqueueNotificationMessage(ctx context.Context, arg database.EnqueueNotificationMessageParams) error
+	InsertNotificationDigestMessage(ctx context.Context, arg database.InsertNotificationDigestMessageParams) error
 	FetchNewMessageMetadata(ctx context.Context, arg database.FetchNewMessageMetadataParams) (database.FetchNewMessageMetadataRow, error)
 	GetNotificationMessagesByStatus(ctx context.Context, arg database.GetNotificationMessagesByStatusParams) ([]database.NotificationMessage, error)
 	GetNotificationsSettings(ctx context.Context) (string, error)
@@ -27,6 +29,8 @@ type Store interface {
 	GetLogoURL(ctx context.Context) (string, error)
 
 	InsertInboxNotification(ctx context.Context, arg database.InsertInboxNotificationParams) (database.InboxNotification, error)
+	GetNotificationUserTargetByPreference(ctx context.Context, arg database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error)
+	GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error)
 }
 
 // Handler is responsible for preparing and delivering a notification by a given method.
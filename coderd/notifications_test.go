@@ -4,13 +4,16 @@ import (
 	"net/http"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/coder/serpent"
 
+	"github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/coderdtest"
 	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/notifications"
 	"github.com/coder/coder/v2/coderd/notifications/notificationstest"
@@ -271,6 +274,288 @@ func TestNotificationPreferences(t *testing.T) {
 	})
 }
 
+func TestNotificationUserTargets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Create and delete", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, member := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		// When: a member creates a signed webhook target.
+		target, err := memberClient.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "personal",
+			Type:     codersdk.NotificationUserTargetTypeWebhook,
+			Endpoint: "https://example.com/hook",
+			Secret:   "hunter2",
+		})
+		require.NoError(t, err)
+		require.Equal(t, member.ID, target.UserID)
+		require.True(t, target.HasSecret)
+
+		// Then: the target is listed.
+		targets, err := memberClient.GetUserNotificationTargets(ctx, codersdk.Me)
+		require.NoError(t, err)
+		require.Len(t, targets, 1)
+		require.Equal(t, target.ID, targets[0].ID)
+
+		// And: a second target with the same name is rejected.
+		_, err = memberClient.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "personal",
+			Type:     codersdk.NotificationUserTargetTypeSlack,
+			Endpoint: "https://hooks.slack.com/services/abc",
+		})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusConflict, sdkError.StatusCode())
+
+		// When: the target is deleted.
+		err = memberClient.DeleteUserNotificationTarget(ctx, codersdk.Me, target.ID)
+		require.NoError(t, err)
+
+		// Then: no targets remain.
+		targets, err = memberClient.GetUserNotificationTargets(ctx, codersdk.Me)
+		require.NoError(t, err)
+		require.Len(t, targets, 0)
+	})
+
+	t.Run("Invalid endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		_, err := memberClient.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "personal",
+			Type:     codersdk.NotificationUserTargetTypeWebhook,
+			Endpoint: "ftp://example.com",
+		})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+
+		// Internal services must not be reachable through the server.
+		_, err = memberClient.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "metadata",
+			Type:     codersdk.NotificationUserTargetTypeWebhook,
+			Endpoint: "http://169.254.169.254/latest/meta-data",
+		})
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+	})
+
+	t.Run("Route template to target", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, member := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		target, err := memberClient.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "mattermost",
+			Type:     codersdk.NotificationUserTargetTypeSlack,
+			Endpoint: "https://mattermost.example.com/hooks/abc",
+		})
+		require.NoError(t, err)
+
+		// When: a template is routed to the target.
+		template := notifications.TemplateWorkspaceDeleted
+		prefs, err := memberClient.UpdateUserNotificationPreferences(ctx, member.ID, codersdk.UpdateUserNotificationPreferences{
+			TemplateTargetMap: map[string]string{
+				template.String(): target.ID.String(),
+			},
+		})
+
+		// Then: the preference refers to the target, and the notification remains enabled.
+		require.NoError(t, err)
+		require.Len(t, prefs, 1)
+		require.Equal(t, template, prefs[0].NotificationTemplateID)
+		require.False(t, prefs[0].Disabled)
+		require.NotNil(t, prefs[0].UserTargetID)
+		require.Equal(t, target.ID, *prefs[0].UserTargetID)
+
+		// When: the target is deleted.
+		err = memberClient.DeleteUserNotificationTarget(ctx, codersdk.Me, target.ID)
+		require.NoError(t, err)
+
+		// Then: the preference no longer refers to it.
+		prefs, err = memberClient.GetUserNotificationPreferences(ctx, member.ID)
+		require.NoError(t, err)
+		require.Len(t, prefs, 1)
+		require.Nil(t, prefs[0].UserTargetID)
+	})
+
+	t.Run("Cannot use another user's target", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		member1Client, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+		member2Client, member2 := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		// Given: a target belonging to another member.
+		target, err := member1Client.CreateUserNotificationTarget(ctx, codersdk.Me, codersdk.CreateNotificationUserTargetRequest{
+			Name:     "personal",
+			Type:     codersdk.NotificationUserTargetTypeWebhook,
+			Endpoint: "https://example.com/hook",
+		})
+		require.NoError(t, err)
+
+		// When: attempting to route a template to it.
+		_, err = member2Client.UpdateUserNotificationPreferences(ctx, member2.ID, codersdk.UpdateUserNotificationPreferences{
+			TemplateTargetMap: map[string]string{
+				notifications.TemplateWorkspaceDeleted.String(): target.ID.String(),
+			},
+		})
+
+		// Then: the target should not be found.
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusNotFound, sdkError.StatusCode())
+	})
+}
+
+func TestNotificationDigestPreferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Enable digest", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, member := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		// When: a template is delivered as a digest.
+		template := notifications.TemplateWorkspaceMarkedForDeletion
+		prefs, err := memberClient.UpdateUserNotificationPreferences(ctx, member.ID, codersdk.UpdateUserNotificationPreferences{
+			TemplateDigestMap: map[string]bool{
+				template.String(): true,
+			},
+		})
+
+		// Then: the preference is a digest, and the notification remains enabled.
+		require.NoError(t, err)
+		require.Len(t, prefs, 1)
+		require.Equal(t, template, prefs[0].NotificationTemplateID)
+		require.False(t, prefs[0].Disabled)
+		require.True(t, prefs[0].Digest)
+
+		// When: the template is disabled.
+		prefs, err = memberClient.UpdateUserNotificationPreferences(ctx, member.ID, codersdk.UpdateUserNotificationPreferences{
+			TemplateDisabledMap: map[string]bool{
+				template.String(): true,
+			},
+		})
+
+		// Then: the digest preference is retained.
+		require.NoError(t, err)
+		require.Len(t, prefs, 1)
+		require.True(t, prefs[0].Disabled)
+		require.True(t, prefs[0].Digest)
+	})
+
+	t.Run("Cannot digest one-time passcodes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, member := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		_, err := memberClient.UpdateUserNotificationPreferences(ctx, member.ID, codersdk.UpdateUserNotificationPreferences{
+			TemplateDigestMap: map[string]bool{
+				notifications.TemplateUserRequestedOneTimePasscode.String(): true,
+			},
+		})
+
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+	})
+}
+
+func TestNotificationWebhookSecrets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Rotate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		_ = coderdtest.CreateFirstUser(t, api)
+
+		// Given: no secret has been configured.
+		secrets, err := api.GetNotificationWebhookSecrets(ctx)
+		require.NoError(t, err)
+		require.Empty(t, secrets)
+
+		// When: a secret is configured.
+		first, err := api.RotateNotificationWebhookSecret(ctx, codersdk.RotateNotificationWebhookSecretRequest{
+			Secret: "hunter2",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "hunter2", first.Secret)
+		require.Nil(t, first.ExpiresAt)
+
+		// When: the secret is rotated without giving a new value.
+		second, err := api.RotateNotificationWebhookSecret(ctx, codersdk.RotateNotificationWebhookSecretRequest{
+			GracePeriodMillis: time.Hour.Milliseconds(),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, second.Secret)
+		require.NotEqual(t, first.Secret, second.Secret)
+
+		// Then: both secrets are listed, without their values, and the first expires after the grace period.
+		secrets, err = api.GetNotificationWebhookSecrets(ctx)
+		require.NoError(t, err)
+		require.Len(t, secrets, 2)
+		require.Equal(t, second.ID, secrets[0].ID)
+		require.Nil(t, secrets[0].ExpiresAt)
+		require.Empty(t, secrets[0].Secret)
+		require.Equal(t, first.ID, secrets[1].ID)
+		require.NotNil(t, secrets[1].ExpiresAt)
+		require.WithinDuration(t, time.Now().Add(time.Hour), *secrets[1].ExpiresAt, time.Minute)
+		require.Empty(t, secrets[1].Secret)
+
+		// When: the secret is rotated again.
+		third, err := api.RotateNotificationWebhookSecret(ctx, codersdk.RotateNotificationWebhookSecretRequest{})
+		require.NoError(t, err)
+
+		// Then: the first secret no longer signs deliveries.
+		secrets, err = api.GetNotificationWebhookSecrets(ctx)
+		require.NoError(t, err)
+		require.Len(t, secrets, 2)
+		require.Equal(t, third.ID, secrets[0].ID)
+		require.Equal(t, second.ID, secrets[1].ID)
+	})
+
+	t.Run("Insufficient permissions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		_, err := memberClient.RotateNotificationWebhookSecret(ctx, codersdk.RotateNotificationWebhookSecretRequest{})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+
+		_, err = memberClient.GetNotificationWebhookSecrets(ctx)
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+	})
+}
+
 func TestNotificationDispatchMethods(t *testing.T) {
 	t.Parallel()
 
@@ -297,7 +582,7 @@ func TestNotificationDispatchMethods(t *testing.T) {
 
 	var allMethods []string
 	for _, nm := range database.AllNotificationMethodValues() {
-		if nm == database.NotificationMethodInbox {
+		if nm == database.NotificationMethodInbox || nm == database.NotificationMethodUserTarget {
 			continue
 		}
 		allMethods = append(allMethods, string(nm))
@@ -378,6 +663,306 @@ func TestNotificationTest(t *testing.T) {
 	})
 }
 
+func TestNotificationTemplateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OverrideAndReset", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		auditor := audit.NewMock()
+		opts := createOpts(t)
+		opts.Auditor = auditor
+		api := coderdtest.New(t, opts)
+		_ = coderdtest.CreateFirstUser(t, api)
+
+		// When: the title and body of a template are overridden.
+		auditor.ResetLogs()
+		updated, err := api.UpdateNotificationTemplateOverrides(ctx, notifications.TemplateWorkspaceDeleted, codersdk.UpdateNotificationTemplateOverrides{
+			TitleTemplate: "Goodbye, {{.Labels.name}}",
+			BodyTemplate:  "Hi {{.UserName}}, **{{.Labels.name}}** is gone.",
+		})
+		require.NoError(t, err)
+
+		// Then: the overrides are returned alongside the built-in templates, and the change is audited.
+		require.Equal(t, "Goodbye, {{.Labels.name}}", updated.TitleTemplateOverride)
+		require.NotEmpty(t, updated.TitleTemplate)
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeNotificationTemplate,
+			ResourceID:   notifications.TemplateWorkspaceDeleted,
+			Action:       database.AuditActionWrite,
+		}))
+
+		templates, err := api.GetSystemNotificationTemplates(ctx)
+		require.NoError(t, err)
+		idx := slices.IndexFunc(templates, func(tmpl codersdk.NotificationTemplate) bool {
+			return tmpl.ID == notifications.TemplateWorkspaceDeleted
+		})
+		require.NotEqual(t, -1, idx)
+		require.Equal(t, updated.BodyTemplateOverride, templates[idx].BodyTemplateOverride)
+
+		// Then: the overrides are previewed with placeholders for labels which are not given.
+		preview, err := api.PreviewNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplatePreviewRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "Goodbye, [name]", preview.Title)
+		require.Contains(t, preview.BodyHTML, "<strong>[name]</strong>")
+
+		preview, err = api.PreviewNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplatePreviewRequest{
+			TitleTemplate: "Farewell, {{.Labels.name}}",
+			Labels:        map[string]string{"name": "dev"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Farewell, dev", preview.Title)
+
+		// When: the overrides are removed.
+		auditor.ResetLogs()
+		err = api.DeleteNotificationTemplateOverrides(ctx, notifications.TemplateWorkspaceDeleted)
+		require.NoError(t, err)
+		require.Len(t, auditor.AuditLogs(), 1)
+
+		// Then: the built-in templates are used again.
+		preview, err = api.PreviewNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplatePreviewRequest{})
+		require.NoError(t, err)
+		require.NotContains(t, preview.Title, "Goodbye")
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		_ = coderdtest.CreateFirstUser(t, api)
+
+		_, err := api.UpdateNotificationTemplateOverrides(ctx, notifications.TemplateWorkspaceDeleted, codersdk.UpdateNotificationTemplateOverrides{
+			TitleTemplate: "{{.Labels.name",
+		})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+
+		_, err = api.PreviewNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplatePreviewRequest{
+			BodyTemplate: "{{ unknown_function }}",
+		})
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+	})
+
+	t.Run("SendTest", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		opts := createOpts(t)
+		opts.DeploymentValues.Notifications.Inbox.Enabled = true
+		api, db := coderdtest.NewWithDatabase(t, opts)
+		firstUser := coderdtest.CreateFirstUser(t, api)
+
+		// When: a test is sent through a method which is not configured.
+		err := api.TestNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplateTestRequest{
+			Method: string(database.NotificationMethodWebhook),
+		})
+
+		// Then: it is rejected.
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+
+		// When: a test is sent through the inbox, twice.
+		for range 2 {
+			err = api.TestNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplateTestRequest{
+				Method: string(database.NotificationMethodInbox),
+				Labels: map[string]string{"name": "dev"},
+			})
+			require.NoError(t, err)
+		}
+
+		// Then: both messages are enqueued for the requesting user.
+		//nolint:gocritic // Unit test.
+		msgs, err := db.GetNotificationMessagesByStatus(dbauthz.AsSystemRestricted(ctx), database.GetNotificationMessagesByStatusParams{
+			Status: database.NotificationMessageStatusPending,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		msgs = slices.DeleteFunc(msgs, func(msg database.NotificationMessage) bool {
+			return msg.NotificationTemplateID != notifications.TemplateWorkspaceDeleted
+		})
+		require.Len(t, msgs, 2)
+		for _, msg := range msgs {
+			require.Equal(t, firstUser.UserID, msg.UserID)
+			require.Equal(t, database.NotificationMethodInbox, msg.Method)
+		}
+	})
+
+	t.Run("Insufficient permissions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		_, err := memberClient.UpdateNotificationTemplateOverrides(ctx, notifications.TemplateWorkspaceDeleted, codersdk.UpdateNotificationTemplateOverrides{
+			TitleTemplate: "Goodbye",
+		})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+
+		_, err = memberClient.PreviewNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplatePreviewRequest{})
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+
+		err = memberClient.TestNotificationTemplate(ctx, notifications.TemplateWorkspaceDeleted, codersdk.NotificationTemplateTestRequest{})
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+	})
+}
+
+func TestNotificationTemplateUrgency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MarkUrgent", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		auditor := audit.NewMock()
+		opts := createOpts(t)
+		opts.Auditor = auditor
+		api := coderdtest.New(t, opts)
+		_ = coderdtest.CreateFirstUser(t, api)
+
+		// When: a template is marked as urgent.
+		auditor.ResetLogs()
+		updated, err := api.UpdateNotificationTemplateUrgency(ctx, notifications.TemplateWorkspaceDormant, codersdk.UpdateNotificationTemplateUrgency{
+			Urgent: true,
+		})
+		require.NoError(t, err)
+
+		// Then: the change is returned and audited.
+		require.True(t, updated.Urgent)
+		require.True(t, auditor.Contains(t, database.AuditLog{
+			ResourceType: database.ResourceTypeNotificationTemplate,
+			ResourceID:   notifications.TemplateWorkspaceDormant,
+			Action:       database.AuditActionWrite,
+		}))
+
+		// When: the template is marked as urgent again.
+		auditor.ResetLogs()
+		_, err = api.UpdateNotificationTemplateUrgency(ctx, notifications.TemplateWorkspaceDormant, codersdk.UpdateNotificationTemplateUrgency{
+			Urgent: true,
+		})
+		require.NoError(t, err)
+
+		// Then: nothing changed, so nothing is audited.
+		require.Empty(t, auditor.AuditLogs())
+	})
+
+	t.Run("Insufficient permissions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		_, err := memberClient.UpdateNotificationTemplateUrgency(ctx, notifications.TemplateWorkspaceDormant, codersdk.UpdateNotificationTemplateUrgency{
+			Urgent: true,
+		})
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusForbidden, sdkError.StatusCode())
+	})
+}
+
+func TestUserNotificationQuietHours(t *testing.T) {
+	t.Parallel()
+
+	t.Run("EnableAndSnooze", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		opts := createOpts(t)
+		opts.DeploymentValues.Notifications.QuietHoursDuration = serpent.Duration(6 * time.Hour)
+		api := coderdtest.New(t, opts)
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		// Given: a user who has never changed their quiet hours.
+		quietHours, err := memberClient.GetUserNotificationQuietHours(ctx, codersdk.Me)
+		require.NoError(t, err)
+
+		// Then: quiet hours are off, nothing is snoozed, and quiet hours schedules are unavailable in AGPL.
+		require.False(t, quietHours.Enabled)
+		require.Nil(t, quietHours.SnoozeUntil)
+		require.Empty(t, quietHours.Schedule)
+		require.Equal(t, (6 * time.Hour).Milliseconds(), quietHours.DurationMillis)
+
+		// When: the user enables quiet hours and snoozes their notifications.
+		snoozeUntil := time.Now().Add(time.Hour).Truncate(time.Second)
+		quietHours, err = memberClient.UpdateUserNotificationQuietHours(ctx, codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+			Enabled:     true,
+			SnoozeUntil: &snoozeUntil,
+		})
+		require.NoError(t, err)
+
+		// Then: both are stored.
+		require.True(t, quietHours.Enabled)
+		require.NotNil(t, quietHours.SnoozeUntil)
+		require.True(t, snoozeUntil.Equal(*quietHours.SnoozeUntil))
+
+		// When: the user stops snoozing.
+		quietHours, err = memberClient.UpdateUserNotificationQuietHours(ctx, codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+			Enabled: true,
+		})
+		require.NoError(t, err)
+
+		// Then: the snooze is cleared but quiet hours stay on.
+		require.True(t, quietHours.Enabled)
+		require.Nil(t, quietHours.SnoozeUntil)
+
+		// When: the user turns quiet hours off and snoozes until a time which has passed.
+		past := time.Now().Add(-time.Hour)
+		quietHours, err = memberClient.UpdateUserNotificationQuietHours(ctx, codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+			SnoozeUntil: &past,
+		})
+		require.NoError(t, err)
+
+		// Then: neither is in effect.
+		require.False(t, quietHours.Enabled)
+		require.Nil(t, quietHours.SnoozeUntil)
+
+		// When: the user snoozes for longer than messages are kept.
+		longSnooze := time.Now().Add(7 * 24 * time.Hour)
+		_, err = memberClient.UpdateUserNotificationQuietHours(ctx, codersdk.Me, codersdk.UpdateUserNotificationQuietHoursRequest{
+			SnoozeUntil: &longSnooze,
+		})
+
+		// Then: it is rejected.
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusBadRequest, sdkError.StatusCode())
+	})
+
+	t.Run("Insufficient permissions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitSuperLong)
+		api := coderdtest.New(t, createOpts(t))
+		firstUser := coderdtest.CreateFirstUser(t, api)
+		memberClient, _ := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+		_, otherMember := coderdtest.CreateAnotherUser(t, api, firstUser.OrganizationID)
+
+		// When: a member tries to change another member's quiet hours.
+		_, err := memberClient.UpdateUserNotificationQuietHours(ctx, otherMember.ID.String(), codersdk.UpdateUserNotificationQuietHoursRequest{
+			Enabled: true,
+		})
+
+		// Then: they cannot see the other member at all.
+		var sdkError *codersdk.Error
+		require.ErrorAs(t, err, &sdkError)
+		require.Equal(t, http.StatusNotFound, sdkError.StatusCode())
+	})
+}
+
 func TestCustomNotification(t *testing.T) {
 	t.Parallel()
 
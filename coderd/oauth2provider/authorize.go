@@ -89,6 +89,16 @@ func ShowAuthorizePage(accessURL *url.URL) http.HandlerFunc {
 			return
 		}
 
+		scopes, err := requestedScopes(app, params.scope)
+		if err != nil {
+			site.RenderStaticErrorPage(rw, r, site.ErrorPageData{Status: http.StatusBadRequest, HideStatus: false, Title: "Invalid Scope", Description: err.Error(), RetryEnabled: false, DashboardURL: accessURL.String(), Warnings: nil})
+			return
+		}
+		scopeNames := make([]string, 0, len(scopes))
+		for _, scope := range scopes {
+			scopeNames = append(scopeNames, string(scope))
+		}
+
 		cancel := params.redirectURL
 		cancelQuery := params.redirectURL.Query()
 		cancelQuery.Add("error", "access_denied")
@@ -100,6 +110,7 @@ func ShowAuthorizePage(accessURL *url.URL) http.HandlerFunc {
 			CancelURI:   cancel.String(),
 			RedirectURI: r.URL.String(),
 			Username:    ua.FriendlyName,
+			Scopes:      scopeNames,
 		})
 	}
 }
@@ -136,7 +147,13 @@ func ProcessAuthorize(db database.Store) http.HandlerFunc {
 			}
 		}
 
-		// TODO: Ignoring scope for now, but should look into implementing.
+		// The access token the code is exchanged for is limited to these scopes.
+		scopes, err := requestedScopes(app, params.scope)
+		if err != nil {
+			httpapi.WriteOAuth2Error(ctx, rw, http.StatusBadRequest, "invalid_scope", err.Error())
+			return
+		}
+
 		code, err := GenerateSecret()
 		if err != nil {
 			httpapi.WriteOAuth2Error(r.Context(), rw, http.StatusInternalServerError, "server_error", "Failed to generate OAuth2 app authorization code")
@@ -171,6 +188,7 @@ func ProcessAuthorize(db database.Store) http.HandlerFunc {
 				ResourceUri:         sql.NullString{String: params.resource, Valid: params.resource != ""},
 				CodeChallenge:       sql.NullString{String: params.codeChallenge, Valid: params.codeChallenge != ""},
 				CodeChallengeMethod: sql.NullString{String: params.codeChallengeMethod, Valid: params.codeChallengeMethod != ""},
+				Scopes:              scopes,
 			})
 			if err != nil {
 				return xerrors.Errorf("insert oauth2 authorization code: %w", err)
@@ -0,0 +1,57 @@
+package oauth2provider
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/rbac"
+)
+
+// requestedScopes returns the API key scopes an authorization request asks
+// for. Scopes are space-delimited (RFC 6749 section 3.3) and must be public
+// API key scopes. If the app registered any API key scopes, the request may
+// only ask for a subset of them and defaults to all of them. An empty result
+// grants all scopes, which keeps apps that don't use scopes working.
+func requestedScopes(app database.OAuth2ProviderApp, requested []string) (database.APIKeyScopes, error) {
+	// Registration doesn't validate the scope, so clients may have registered
+	// scopes meant for other servers, e.g. "openid". Those are ignored.
+	var registered []string
+	for _, name := range strings.Fields(app.Scope.String) {
+		if rbac.IsExternalScope(rbac.ScopeName(name)) {
+			registered = append(registered, name)
+		}
+	}
+
+	var names []string
+	for _, scope := range requested {
+		names = append(names, strings.Fields(scope)...)
+	}
+	if len(names) == 0 {
+		names = registered
+	}
+
+	scopes := make(database.APIKeyScopes, 0, len(names))
+	for _, name := range names {
+		if !rbac.IsExternalScope(rbac.ScopeName(name)) {
+			return nil, xerrors.Errorf("invalid or unsupported scope %q", name)
+		}
+		if len(registered) > 0 && !slices.Contains(registered, name) {
+			return nil, xerrors.Errorf("scope %q was not registered for this app", name)
+		}
+
+		scope := database.APIKeyScope(name)
+		switch name {
+		case "all":
+			scope = database.ApiKeyScopeCoderAll
+		case "application_connect":
+			scope = database.ApiKeyScopeCoderApplicationConnect
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
@@ -0,0 +1,87 @@
+package oauth2provider
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+func TestRequestedScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		registered string
+		requested  []string
+		expected   database.APIKeyScopes
+		errorIs    string
+	}{
+		{
+			name:     "NoScopes",
+			expected: database.APIKeyScopes{},
+		},
+		{
+			name:      "SpaceDelimited",
+			requested: []string{"workspace:read template:read"},
+			expected:  database.APIKeyScopes{database.ApiKeyScopeWorkspaceRead, database.ApiKeyScopeTemplateRead},
+		},
+		{
+			name:      "Duplicates",
+			requested: []string{"workspace:read", "workspace:read"},
+			expected:  database.APIKeyScopes{database.ApiKeyScopeWorkspaceRead},
+		},
+		{
+			name:      "LegacyAll",
+			requested: []string{"all"},
+			expected:  database.APIKeyScopes{database.ApiKeyScopeCoderAll},
+		},
+		{
+			name:      "Unsupported",
+			requested: []string{"workspace:read openid"},
+			errorIs:   `invalid or unsupported scope "openid"`,
+		},
+		{
+			name:       "DefaultsToRegistered",
+			registered: "workspace:read openid",
+			expected:   database.APIKeyScopes{database.ApiKeyScopeWorkspaceRead},
+		},
+		{
+			name:       "SubsetOfRegistered",
+			registered: "workspace:read template:read",
+			requested:  []string{"template:read"},
+			expected:   database.APIKeyScopes{database.ApiKeyScopeTemplateRead},
+		},
+		{
+			name:       "NotRegistered",
+			registered: "workspace:read",
+			requested:  []string{"workspace:ssh"},
+			errorIs:    `scope "workspace:ssh" was not registered for this app`,
+		},
+		{
+			name:       "OnlyForeignScopesRegistered",
+			registered: "openid profile",
+			requested:  []string{"workspace:ssh"},
+			expected:   database.APIKeyScopes{database.ApiKeyScopeWorkspaceSsh},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := database.OAuth2ProviderApp{
+				Scope: sql.NullString{String: tc.registered, Valid: tc.registered != ""},
+			}
+			scopes, err := requestedScopes(app, tc.requested)
+			if tc.errorIs != "" {
+				require.ErrorContains(t, err, tc.errorIs)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, scopes)
+		})
+	}
+}
@@ -258,13 +258,14 @@ func authorizationCodeGrant(ctx context.Context, db database.Store, app database
 		return oauth2.Token{}, err
 	}
 
-	// Generate the API key we will swap for the code.
-	// TODO: We are ignoring scopes for now.
+	// Generate the API key we will swap for the code. It is limited to the
+	// scopes the user authorized, or all scopes if none were requested.
 	tokenName := fmt.Sprintf("%s_%s_oauth_session_token", dbCode.UserID, app.ID)
 	key, sessionToken, err := apikey.Generate(apikey.CreateParams{
 		UserID:          dbCode.UserID,
 		LoginType:       database.LoginTypeOAuth2ProviderApp,
 		DefaultLifetime: lifetimes.DefaultDuration.Value(),
+		Scopes:          dbCode.Scopes,
 		// For now, we allow only one token per app and user at a time.
 		TokenName: tokenName,
 	})
@@ -392,13 +393,14 @@ func refreshTokenGrant(ctx context.Context, db database.Store, app database.OAut
 		return oauth2.Token{}, err
 	}
 
-	// Generate the new API key.
-	// TODO: We are ignoring scopes for now.
+	// Generate the new API key with the same scopes as the previous one.
 	tokenName := fmt.Sprintf("%s_%s_oauth_session_token", prevKey.UserID, app.ID)
 	key, sessionToken, err := apikey.Generate(apikey.CreateParams{
 		UserID:          prevKey.UserID,
 		LoginType:       database.LoginTypeOAuth2ProviderApp,
 		DefaultLifetime: lifetimes.DefaultDuration.Value(),
+		Scopes:          prevKey.Scopes,
+		AllowList:       prevKey.AllowList,
 		// For now, we allow only one token per app and user at a time.
 		TokenName: tokenName,
 	})
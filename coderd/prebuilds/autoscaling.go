@@ -0,0 +1,90 @@
+package prebuilds
+
+import (
+	"math"
+	"time"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// DefaultAutoscalingBuildTime is the time assumed to build a prebuilt workspace
+// of a preset which was never built successfully.
+const DefaultAutoscalingBuildTime = 5 * time.Minute
+
+// AutoscalingConfig controls the demand-based sizing of prebuild pools.
+//
+// When enabled, the number of prebuilt workspaces kept for a preset is sized so
+// that a claim finds one ready with at least TargetHitProbability, given the
+// rate at which the preset's prebuilt workspaces were claimed over the last
+// Window and the time taken to replace a claimed one. The desired instances
+// configured on the preset are always kept as a floor, and the pool never grows
+// beyond MaxInstances.
+type AutoscalingConfig struct {
+	Window               time.Duration
+	TargetHitProbability float64
+	MaxInstances         int32
+	// ReconciliationInterval is how often prebuild pools are replenished, which
+	// adds to the time taken to replace a claimed prebuilt workspace.
+	ReconciliationInterval time.Duration
+}
+
+func NewAutoscalingConfig(cfg codersdk.PrebuildsConfig) AutoscalingConfig {
+	maxInstances := cfg.AutoscalingMaxInstances.Value()
+	if maxInstances > math.MaxInt32 {
+		maxInstances = math.MaxInt32
+	}
+
+	return AutoscalingConfig{
+		Window:               cfg.AutoscalingWindow.Value(),
+		TargetHitProbability: cfg.AutoscalingTargetHitProbability.Value(),
+		// #nosec G115 - Safe conversion as maxInstances is clamped to the int32 range above
+		MaxInstances:           int32(maxInstances),
+		ReconciliationInterval: cfg.ReconciliationInterval.Value(),
+	}
+}
+
+// Enabled returns true if prebuild pools should be sized by demand.
+func (c AutoscalingConfig) Enabled() bool {
+	return c.Window > 0 && c.TargetHitProbability > 0 && c.MaxInstances > 0
+}
+
+// AutoscalingState describes how the desired number of prebuilt workspaces of a
+// preset was derived from its demand.
+type AutoscalingState struct {
+	Configured    int32         // Number of prebuilds desired as defined in the preset, or its matching schedule
+	ClaimAttempts int32         // Number of attempts to claim a prebuild within the autoscaling window
+	ClaimMisses   int32         // Number of claim attempts which found no prebuild to claim
+	ClaimRate     float64       // Claim attempts per second within the autoscaling window
+	ReplenishTime time.Duration // Expected time to replace a claimed prebuild
+	Desired       int32         // Number of prebuilds desired after autoscaling
+}
+
+// WarmPoolSize returns the smallest number of prebuilt workspaces which serves
+// claims with at least the target hit probability, given the expected number of
+// claims made while a claimed prebuilt workspace is replaced. The result is
+// capped at maxInstances.
+//
+// Each claim starts the replacement of the claimed prebuilt workspace, so with
+// k prebuilt workspaces a claim misses when k or more replacements are still
+// in progress. Treating claims as a Poisson process, the number of replacements
+// in progress follows a Poisson distribution with a mean of the expected claims
+// during a replacement, and a claim hits with probability P(N <= k-1).
+func WarmPoolSize(expectedClaims float64, targetHitProbability float64, maxInstances int32) int32 {
+	if expectedClaims <= 0 || targetHitProbability <= 0 || maxInstances <= 0 {
+		return 0
+	}
+
+	var cdf float64
+	logMean := math.Log(expectedClaims)
+	for k := int32(1); k <= maxInstances; k++ {
+		// Add P(N = k-1) to the CDF, computed in log space to avoid overflowing
+		// the factorial.
+		n := float64(k - 1)
+		lgamma, _ := math.Lgamma(n + 1)
+		cdf += math.Exp(n*logMean - expectedClaims - lgamma)
+		if cdf >= targetHitProbability {
+			return k
+		}
+	}
+	return maxInstances
+}
@@ -0,0 +1,425 @@
+package prebuilds_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog"
+
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/prebuilds"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWarmPoolSize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name                 string
+		expectedClaims       float64
+		targetHitProbability float64
+		maxInstances         int32
+		expected             int32
+	}{
+		{
+			name:                 "NoDemand",
+			expectedClaims:       0,
+			targetHitProbability: 0.95,
+			maxInstances:         10,
+			expected:             0,
+		},
+		{
+			// P(N = 0) = e^-0.05 = 0.951
+			name:                 "LowDemand",
+			expectedClaims:       0.05,
+			targetHitProbability: 0.95,
+			maxInstances:         10,
+			expected:             1,
+		},
+		{
+			// P(N <= 2) = 0.920, P(N <= 3) = 0.981
+			name:                 "ModerateDemand",
+			expectedClaims:       1,
+			targetHitProbability: 0.95,
+			maxInstances:         10,
+			expected:             4,
+		},
+		{
+			// P(N <= 3) = 0.981, P(N <= 4) = 0.996
+			name:                 "HigherTarget",
+			expectedClaims:       1,
+			targetHitProbability: 0.99,
+			maxInstances:         10,
+			expected:             5,
+		},
+		{
+			name:                 "CappedAtMax",
+			expectedClaims:       100,
+			targetHitProbability: 0.95,
+			maxInstances:         10,
+			expected:             10,
+		},
+		{
+			name:                 "NoMax",
+			expectedClaims:       1,
+			targetHitProbability: 0.95,
+			maxInstances:         0,
+			expected:             0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, prebuilds.WarmPoolSize(tc.expectedClaims, tc.targetHitProbability, tc.maxInstances))
+		})
+	}
+}
+
+func TestCalculateAutoscaling(t *testing.T) {
+	t.Parallel()
+
+	// Demand is matched to presets by template and name, whichever template version it was observed for.
+	current := opts[optionSet1]
+	enabled := prebuilds.AutoscalingConfig{
+		Window:                 time.Hour,
+		TargetHitProbability:   0.95,
+		MaxInstances:           10,
+		ReconciliationInterval: time.Minute,
+	}
+	// 30 claims an hour, with prebuilds taking 6 minutes to replace, is an expected 3 claims during a
+	// replacement. P(N <= 5) = 0.916, P(N <= 6) = 0.966, so 7 prebuilds are needed.
+	demand := database.GetPrebuildClaimDemandRow{
+		TemplateID:       current.templateID,
+		PresetName:       current.presetName,
+		ClaimAttempts:    30,
+		ClaimMisses:      10,
+		AverageBuildSecs: (5 * time.Minute).Seconds(),
+	}
+
+	cases := []struct {
+		name        string
+		instances   int32
+		autoscaling prebuilds.AutoscalingConfig
+		demand      []database.GetPrebuildClaimDemandRow
+		expected    prebuilds.AutoscalingState
+	}{
+		{
+			name:        "Disabled",
+			instances:   1,
+			autoscaling: prebuilds.AutoscalingConfig{},
+			demand:      []database.GetPrebuildClaimDemandRow{demand},
+			expected:    prebuilds.AutoscalingState{Configured: 1, Desired: 1},
+		},
+		{
+			name:        "NoDemand",
+			instances:   1,
+			autoscaling: enabled,
+			expected:    prebuilds.AutoscalingState{Configured: 1, Desired: 1},
+		},
+		{
+			name:        "OtherPresetDemand",
+			instances:   1,
+			autoscaling: enabled,
+			demand: []database.GetPrebuildClaimDemandRow{{
+				TemplateID:    current.templateID,
+				PresetName:    "other-preset",
+				ClaimAttempts: 30,
+			}},
+			expected: prebuilds.AutoscalingState{Configured: 1, Desired: 1},
+		},
+		{
+			name:        "Demand",
+			instances:   1,
+			autoscaling: enabled,
+			demand:      []database.GetPrebuildClaimDemandRow{demand},
+			expected: prebuilds.AutoscalingState{
+				Configured:    1,
+				ClaimAttempts: 30,
+				ClaimMisses:   10,
+				ClaimRate:     30.0 / 3600,
+				ReplenishTime: 6 * time.Minute,
+				Desired:       7,
+			},
+		},
+		{
+			name:        "NeverBuilt",
+			instances:   1,
+			autoscaling: enabled,
+			demand: []database.GetPrebuildClaimDemandRow{{
+				TemplateID:    current.templateID,
+				PresetName:    current.presetName,
+				ClaimAttempts: 30,
+				ClaimMisses:   30,
+			}},
+			expected: prebuilds.AutoscalingState{
+				Configured:    1,
+				ClaimAttempts: 30,
+				ClaimMisses:   30,
+				ClaimRate:     30.0 / 3600,
+				ReplenishTime: time.Minute + prebuilds.DefaultAutoscalingBuildTime,
+				Desired:       7,
+			},
+		},
+		{
+			name:        "ConfiguredFloor",
+			instances:   9,
+			autoscaling: enabled,
+			demand:      []database.GetPrebuildClaimDemandRow{demand},
+			expected: prebuilds.AutoscalingState{
+				Configured:    9,
+				ClaimAttempts: 30,
+				ClaimMisses:   10,
+				ClaimRate:     30.0 / 3600,
+				ReplenishTime: 6 * time.Minute,
+				Desired:       9,
+			},
+		},
+		{
+			name:      "CappedAtMax",
+			instances: 1,
+			autoscaling: prebuilds.AutoscalingConfig{
+				Window:                 enabled.Window,
+				TargetHitProbability:   enabled.TargetHitProbability,
+				MaxInstances:           5,
+				ReconciliationInterval: enabled.ReconciliationInterval,
+			},
+			demand: []database.GetPrebuildClaimDemandRow{demand},
+			expected: prebuilds.AutoscalingState{
+				Configured:    1,
+				ClaimAttempts: 30,
+				ClaimMisses:   10,
+				ClaimRate:     30.0 / 3600,
+				ReplenishTime: 6 * time.Minute,
+				Desired:       5,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			presets := []database.GetTemplatePresetsWithPrebuildsRow{
+				preset(true, tc.instances, current),
+			}
+			snapshot := prebuilds.NewGlobalSnapshot(presets, nil, nil, nil, nil, nil, tc.demand, tc.autoscaling, quartz.NewMock(t), testutil.Logger(t))
+			ps, err := snapshot.FilterByPreset(current.presetID)
+			require.NoError(t, err)
+
+			state := ps.CalculateAutoscaling()
+			require.InDelta(t, tc.expected.ClaimRate, state.ClaimRate, 1e-9)
+			state.ClaimRate = tc.expected.ClaimRate
+			require.Equal(t, tc.expected, state)
+			require.Equal(t, tc.expected.Desired, ps.CalculateState().Desired)
+		})
+	}
+}
+
+// TestAutoscalingSimulation simulates users claiming the prebuilt workspaces of a preset at a steady rate, while the
+// preset's prebuilds are reconciled periodically and take a while to build, and checks the share of claims which find a
+// prebuilt workspace to claim.
+func TestAutoscalingSimulation(t *testing.T) {
+	t.Parallel()
+
+	const (
+		claimsPerMinute        = 0.5
+		buildTime              = 5 * time.Minute
+		reconciliationInterval = time.Minute
+		duration               = 24 * time.Hour
+	)
+
+	autoscaling := prebuilds.AutoscalingConfig{
+		Window:                 time.Hour,
+		TargetHitProbability:   0.95,
+		MaxInstances:           10,
+		ReconciliationInterval: reconciliationInterval,
+	}
+
+	t.Run("Autoscaled", func(t *testing.T) {
+		t.Parallel()
+
+		sim := newClaimSimulation(t, autoscaling, claimsPerMinute, buildTime)
+		sim.run(t, duration)
+
+		// With an expected 3 claims while a prebuild is replaced, 7 prebuilds are needed to
+		// reach the target; the claim rate observed in the window varies around the real one.
+		require.GreaterOrEqual(t, sim.hitRate(), autoscaling.TargetHitProbability)
+		require.InDelta(t, 7, sim.desired, 2)
+	})
+
+	t.Run("Fixed", func(t *testing.T) {
+		t.Parallel()
+
+		sim := newClaimSimulation(t, prebuilds.AutoscalingConfig{ReconciliationInterval: reconciliationInterval}, claimsPerMinute, buildTime)
+		sim.run(t, duration)
+
+		// A single prebuild is usually claimed before it is replaced.
+		require.Less(t, sim.hitRate(), 0.5)
+		require.EqualValues(t, 1, sim.desired)
+	})
+}
+
+type simulatedClaim struct {
+	at  time.Time
+	hit bool
+}
+
+type claimSimulation struct {
+	clock           *quartz.Mock
+	logger          slog.Logger
+	rand            *rand.Rand
+	autoscaling     prebuilds.AutoscalingConfig
+	claimsPerMinute float64
+	buildTime       time.Duration
+	opts            options
+
+	ready    []database.GetRunningPrebuiltWorkspacesRow
+	building []time.Time
+	claims   []simulatedClaim
+	start    time.Time
+	desired  int32
+}
+
+func newClaimSimulation(t *testing.T, autoscaling prebuilds.AutoscalingConfig, claimsPerMinute float64, buildTime time.Duration) *claimSimulation {
+	clock := quartz.NewMock(t)
+	return &claimSimulation{
+		clock:  clock,
+		logger: testutil.Logger(t),
+		//nolint:gosec // The simulation is seeded so that it is deterministic.
+		rand:            rand.New(rand.NewSource(1)),
+		autoscaling:     autoscaling,
+		claimsPerMinute: claimsPerMinute,
+		buildTime:       buildTime,
+		opts:            opts[optionSet0],
+		start:           clock.Now(),
+	}
+}
+
+func (s *claimSimulation) run(t *testing.T, duration time.Duration) {
+	ctx := testutil.Context(t, testutil.WaitShort)
+	interval := s.autoscaling.ReconciliationInterval
+
+	for end := s.clock.Now().Add(duration); s.clock.Now().Before(end); {
+		s.clock.Advance(interval).MustWait(ctx)
+		now := s.clock.Now()
+
+		// Prebuilds which finished building become claimable.
+		s.building = slices.DeleteFunc(s.building, func(readyAt time.Time) bool {
+			if readyAt.After(now) {
+				return false
+			}
+			workspace := s.opts
+			workspace.prebuiltWorkspaceID = uuid.New()
+			s.ready = append(s.ready, prebuiltWorkspace(workspace, s.clock))
+			return true
+		})
+
+		for range poisson(s.rand, s.claimsPerMinute*interval.Minutes()) {
+			claim := simulatedClaim{at: now, hit: len(s.ready) > 0}
+			if claim.hit {
+				s.ready = s.ready[1:]
+			}
+			s.claims = append(s.claims, claim)
+		}
+
+		s.reconcile(t, now)
+	}
+}
+
+func (s *claimSimulation) reconcile(t *testing.T, now time.Time) {
+	var demand []database.GetPrebuildClaimDemandRow
+	if s.autoscaling.Enabled() {
+		row := database.GetPrebuildClaimDemandRow{
+			TemplateID:       s.opts.templateID,
+			PresetName:       s.opts.presetName,
+			AverageBuildSecs: s.buildTime.Seconds(),
+		}
+		for _, claim := range s.claims {
+			if claim.at.Before(now.Add(-s.autoscaling.Window)) {
+				continue
+			}
+			row.ClaimAttempts++
+			if !claim.hit {
+				row.ClaimMisses++
+			}
+		}
+		if row.ClaimAttempts > 0 {
+			demand = append(demand, row)
+		}
+	}
+
+	var inProgress []database.CountInProgressPrebuildsRow
+	if len(s.building) > 0 {
+		inProgress = append(inProgress, database.CountInProgressPrebuildsRow{
+			TemplateID:        s.opts.templateID,
+			TemplateVersionID: s.opts.templateVersionID,
+			Transition:        database.WorkspaceTransitionStart,
+			// #nosec G115 - Safe conversion as the number of prebuilds being built is small
+			Count:    int32(len(s.building)),
+			PresetID: uuid.NullUUID{UUID: s.opts.presetID, Valid: true},
+		})
+	}
+
+	presets := []database.GetTemplatePresetsWithPrebuildsRow{
+		preset(true, 1, s.opts),
+	}
+	snapshot := prebuilds.NewGlobalSnapshot(presets, nil, s.ready, inProgress, nil, nil, demand, s.autoscaling, s.clock, s.logger)
+	ps, err := snapshot.FilterByPreset(s.opts.presetID)
+	require.NoError(t, err)
+
+	s.desired = ps.CalculateState().Desired
+	actions, err := ps.CalculateActions(backoffInterval)
+	require.NoError(t, err)
+	for _, action := range actions {
+		switch action.ActionType {
+		case prebuilds.ActionTypeCreate:
+			for range action.Create {
+				s.building = append(s.building, now.Add(s.buildTime))
+			}
+		case prebuilds.ActionTypeDelete:
+			s.ready = slices.DeleteFunc(s.ready, func(workspace database.GetRunningPrebuiltWorkspacesRow) bool {
+				return slices.Contains(action.DeleteIDs, workspace.ID)
+			})
+		}
+	}
+}
+
+// hitRate returns the share of claims which found a prebuilt workspace to claim, ignoring those made
+// before the first autoscaling window has passed.
+func (s *claimSimulation) hitRate() float64 {
+	var hits, total int
+	warmup := s.start.Add(s.autoscaling.Window)
+	for _, claim := range s.claims {
+		if claim.at.Before(warmup) {
+			continue
+		}
+		total++
+		if claim.hit {
+			hits++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(hits) / float64(total)
+}
+
+// poisson returns a sample of a Poisson distribution with the given mean.
+func poisson(r *rand.Rand, mean float64) int {
+	limit := math.Exp(-mean)
+	var k int
+	for p := r.Float64(); p > limit; p *= r.Float64() {
+		k++
+	}
+	return k
+}
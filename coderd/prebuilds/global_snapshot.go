@@ -22,6 +22,8 @@ type GlobalSnapshot struct {
 	PrebuildsInProgress   []database.CountInProgressPrebuildsRow
 	Backoffs              []database.GetPresetsBackoffRow
 	HardLimitedPresetsMap map[uuid.UUID]database.GetPresetsAtFailureLimitRow
+	ClaimDemand           []database.GetPrebuildClaimDemandRow
+	Autoscaling           AutoscalingConfig
 	clock                 quartz.Clock
 	logger                slog.Logger
 }
@@ -33,6 +35,8 @@ func NewGlobalSnapshot(
 	prebuildsInProgress []database.CountInProgressPrebuildsRow,
 	backoffs []database.GetPresetsBackoffRow,
 	hardLimitedPresets []database.GetPresetsAtFailureLimitRow,
+	claimDemand []database.GetPrebuildClaimDemandRow,
+	autoscaling AutoscalingConfig,
 	clock quartz.Clock,
 	logger slog.Logger,
 ) GlobalSnapshot {
@@ -48,6 +52,8 @@ func NewGlobalSnapshot(
 		PrebuildsInProgress:   prebuildsInProgress,
 		Backoffs:              backoffs,
 		HardLimitedPresetsMap: hardLimitedPresetsMap,
+		ClaimDemand:           claimDemand,
+		Autoscaling:           autoscaling,
 		clock:                 clock,
 		logger:                logger,
 	}
@@ -90,6 +96,16 @@ func (s GlobalSnapshot) FilterByPreset(presetID uuid.UUID) (*PresetSnapshot, err
 
 	_, isHardLimited := s.HardLimitedPresetsMap[preset.ID]
 
+	// Demand is tracked by template and preset name, so that it carries over
+	// to the presets of new template versions.
+	var claimDemandPtr *database.GetPrebuildClaimDemandRow
+	claimDemand, found := slice.Find(s.ClaimDemand, func(row database.GetPrebuildClaimDemandRow) bool {
+		return row.TemplateID == preset.TemplateID && row.PresetName == preset.Name
+	})
+	if found {
+		claimDemandPtr = &claimDemand
+	}
+
 	presetSnapshot := NewPresetSnapshot(
 		preset,
 		prebuildSchedules,
@@ -98,6 +114,8 @@ func (s GlobalSnapshot) FilterByPreset(presetID uuid.UUID) (*PresetSnapshot, err
 		inProgress,
 		backoffPtr,
 		isHardLimited,
+		claimDemandPtr,
+		s.Autoscaling,
 		s.clock,
 		s.logger,
 	)
@@ -43,6 +43,7 @@ const (
 // - Expired: prebuilds running and expired due to the preset's TTL
 // - InProgress: prebuilds currently in progress
 // - Backoff: holds failure info to decide if prebuild creation should be backed off
+// - ClaimDemand: holds the recent claims of the preset's prebuilds, used for autoscaling
 type PresetSnapshot struct {
 	Preset            database.GetTemplatePresetsWithPrebuildsRow
 	PrebuildSchedules []database.TemplateVersionPresetPrebuildSchedule
@@ -51,6 +52,8 @@ type PresetSnapshot struct {
 	InProgress        []database.CountInProgressPrebuildsRow
 	Backoff           *database.GetPresetsBackoffRow
 	IsHardLimited     bool
+	ClaimDemand       *database.GetPrebuildClaimDemandRow
+	Autoscaling       AutoscalingConfig
 	clock             quartz.Clock
 	logger            slog.Logger
 }
@@ -63,6 +66,8 @@ func NewPresetSnapshot(
 	inProgress []database.CountInProgressPrebuildsRow,
 	backoff *database.GetPresetsBackoffRow,
 	isHardLimited bool,
+	claimDemand *database.GetPrebuildClaimDemandRow,
+	autoscaling AutoscalingConfig,
 	clock quartz.Clock,
 	logger slog.Logger,
 ) PresetSnapshot {
@@ -74,6 +79,8 @@ func NewPresetSnapshot(
 		InProgress:        inProgress,
 		Backoff:           backoff,
 		IsHardLimited:     isHardLimited,
+		ClaimDemand:       claimDemand,
+		Autoscaling:       autoscaling,
 		clock:             clock,
 		logger:            logger,
 	}
@@ -88,7 +95,7 @@ func NewPresetSnapshot(
 type ReconciliationState struct {
 	Actual     int32 // Number of currently running prebuilds, i.e., non-expired, expired and extraneous prebuilds
 	Expired    int32 // Number of currently running prebuilds that exceeded their allowed time-to-live (TTL)
-	Desired    int32 // Number of prebuilds desired as defined in the preset
+	Desired    int32 // Number of prebuilds desired as defined in the preset, or as autoscaled
 	Eligible   int32 // Number of prebuilds that are ready to be claimed
 	Extraneous int32 // Number of extra running prebuilds beyond the desired count
 
@@ -204,10 +211,49 @@ func (p PresetSnapshot) CalculateDesiredInstances(at time.Time) int32 {
 	return p.Preset.DesiredInstances.Int32
 }
 
+// CalculateAutoscaling returns the number of desired instances, sized by the recent demand for the
+// preset's prebuilds when autoscaling is enabled. The number of instances returned by
+// CalculateDesiredInstances is always kept as a floor.
+func (p PresetSnapshot) CalculateAutoscaling() AutoscalingState {
+	configured := p.CalculateDesiredInstances(p.clock.Now())
+	state := AutoscalingState{
+		Configured: configured,
+		Desired:    configured,
+	}
+	if !p.Autoscaling.Enabled() || p.ClaimDemand == nil {
+		return state
+	}
+
+	state.ClaimAttempts = p.ClaimDemand.ClaimAttempts
+	state.ClaimMisses = p.ClaimDemand.ClaimMisses
+	state.ClaimRate = float64(p.ClaimDemand.ClaimAttempts) / p.Autoscaling.Window.Seconds()
+	buildTime := time.Duration(p.ClaimDemand.AverageBuildSecs * float64(time.Second))
+	if buildTime <= 0 {
+		// The preset was never built successfully, so assume a typical build time rather than undersizing its pool.
+		buildTime = DefaultAutoscalingBuildTime
+	}
+	state.ReplenishTime = p.Autoscaling.ReconciliationInterval + buildTime
+
+	warm := WarmPoolSize(state.ClaimRate*state.ReplenishTime.Seconds(), p.Autoscaling.TargetHitProbability, p.Autoscaling.MaxInstances)
+	state.Desired = max(configured, warm)
+
+	p.logger.Debug(context.Background(), "autoscaled desired instances",
+		slog.F("preset_id", p.Preset.ID),
+		slog.F("configured_instances", state.Configured),
+		slog.F("claim_attempts", state.ClaimAttempts),
+		slog.F("claim_misses", state.ClaimMisses),
+		slog.F("claim_rate", state.ClaimRate),
+		slog.F("replenish_time", state.ReplenishTime.String()),
+		slog.F("desired_instances", state.Desired),
+	)
+
+	return state
+}
+
 // CalculateState computes the current state of prebuilds for a preset, including:
 // - Actual: Number of currently running prebuilds, i.e., non-expired and expired prebuilds
 // - Expired: Number of currently running expired prebuilds
-// - Desired: Number of prebuilds desired as defined in the preset
+// - Desired: Number of prebuilds desired as defined in the preset, or as autoscaled by demand
 // - Eligible: Number of prebuilds that are ready to be claimed
 // - Extraneous: Number of extra running prebuilds beyond the desired count
 // - Starting/Stopping/Deleting: Counts of prebuilds in various transition states
@@ -232,7 +278,7 @@ func (p PresetSnapshot) CalculateState() *ReconciliationState {
 	expired = int32(len(p.Expired))
 
 	if p.isActive() {
-		desired = p.CalculateDesiredInstances(p.clock.Now())
+		desired = p.CalculateAutoscaling().Desired
 		eligible = p.countEligible()
 		extraneous = max(actual-expired-desired, 0)
 	}
@@ -1279,7 +1279,7 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 
 				bag := audit.BaggageFromContext(ctx)
 
-				audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceBuild]{
+				audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.AuditableWorkspaceBuild]{
 					Audit:            *auditor,
 					Log:              s.Logger,
 					UserID:           job.InitiatorID,
@@ -1287,8 +1287,8 @@ func (s *server) FailJob(ctx context.Context, failJob *proto.FailedJob) (*proto.
 					RequestID:        job.ID,
 					IP:               bag.IP,
 					Action:           auditAction,
-					Old:              previousBuild,
-					New:              build,
+					Old:              s.auditableBuild(ctx, previousBuild),
+					New:              s.auditableBuild(ctx, build),
 					Status:           http.StatusInternalServerError,
 					AdditionalFields: wriBytes,
 				})
@@ -1897,6 +1897,19 @@ func (s *server) completeWorkspaceBuildJob(ctx context.Context, job database.Pro
 		if !workspace.IsPrebuild() {
 			templateScheduleStore := *s.TemplateScheduleStore.Load()
 
+			windows, err := schedule.GetWorkspaceWindows(ctx, db, workspace.WorkspaceTable())
+			if err != nil {
+				return xerrors.Errorf("get workspace schedule windows: %w", err)
+			}
+			// Autostarted builds stay running for the TTL of the window that
+			// started them.
+			window := 0
+			if workspaceBuild.Reason == database.BuildReasonAutostart {
+				if started, ok := windows.StartedBy(workspaceBuild.CreatedAt); ok {
+					window = started
+				}
+			}
+
 			autoStop, err := schedule.CalculateAutostop(ctx, schedule.CalculateAutostopParams{
 				Database:                    db,
 				TemplateScheduleStore:       templateScheduleStore,
@@ -1906,18 +1919,24 @@ func (s *server) completeWorkspaceBuildJob(ctx context.Context, job database.Pro
 				Workspace:                 workspace.WorkspaceTable(),
 				// Allowed to be the empty string.
 				WorkspaceAutostart: workspace.AutostartSchedule.String,
+				Windows:            windows,
+				Window:             window,
 			})
 			if err != nil {
 				return xerrors.Errorf("calculate auto stop: %w", err)
 			}
 
-			if workspace.AutostartSchedule.Valid {
+			if windows.HasAutostart() {
 				templateScheduleOptions, err := templateScheduleStore.Get(ctx, db, workspace.TemplateID)
 				if err != nil {
 					return xerrors.Errorf("get template schedule options: %w", err)
 				}
+				templateScheduleOptions.AutostartExceptions, err = schedule.GetAutostartExceptions(ctx, db, workspace.OrganizationID, workspace.ID)
+				if err != nil {
+					return xerrors.Errorf("get autostart exceptions: %w", err)
+				}
 
-				nextStartAt, err := schedule.NextAllowedAutostart(now, workspace.AutostartSchedule.String, templateScheduleOptions)
+				nextStartAt, _, err := windows.NextAllowedAutostart(now, templateScheduleOptions)
 				if err == nil {
 					err = db.UpdateWorkspaceNextStartAt(ctx, database.UpdateWorkspaceNextStartAtParams{
 						ID:          workspace.ID,
@@ -2326,7 +2345,7 @@ func (s *server) completeWorkspaceBuildJob(ctx context.Context, job database.Pro
 
 		bag := audit.BaggageFromContext(ctx)
 
-		audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceBuild]{
+		audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.AuditableWorkspaceBuild]{
 			Audit:            *auditor,
 			Log:              s.Logger,
 			UserID:           job.InitiatorID,
@@ -2334,8 +2353,8 @@ func (s *server) completeWorkspaceBuildJob(ctx context.Context, job database.Pro
 			RequestID:        job.ID,
 			IP:               bag.IP,
 			Action:           auditAction,
-			Old:              previousBuild,
-			New:              workspaceBuild,
+			Old:              s.auditableBuild(ctx, previousBuild),
+			New:              s.auditableBuild(ctx, workspaceBuild),
 			Status:           http.StatusOK,
 			AdditionalFields: wriBytes,
 		})
@@ -2489,6 +2508,9 @@ func (s *server) notifyWorkspaceDeleted(ctx context.Context, workspace database.
 		case database.BuildReasonAutodelete:
 			reason = "autodeleted due to dormancy"
 			initiator = "autobuild"
+		case database.BuildReasonTaskAutodelete:
+			reason = "autodeleted after the task's retention period"
+			initiator = "autobuild"
 		default:
 			reason = string(build.Reason)
 		}
@@ -3243,6 +3265,24 @@ func auditActionFromTransition(transition database.WorkspaceTransition) database
 	}
 }
 
+// auditableBuild returns the build along with its parameters so that
+// parameter changes between builds show up in the audit diff.
+func (s *server) auditableBuild(ctx context.Context, build database.WorkspaceBuild) database.AuditableWorkspaceBuild {
+	if build.ID == uuid.Nil {
+		return build.Auditable(nil, nil)
+	}
+	params, err := s.Database.GetWorkspaceBuildParameters(ctx, build.ID)
+	if err != nil {
+		s.Logger.Error(ctx, "audit log - get build parameters", slog.F("workspace_build_id", build.ID), slog.Error(err))
+	}
+	// Without the template version parameters, every value is redacted.
+	templateParams, err := s.Database.GetTemplateVersionParameters(ctx, build.TemplateVersionID)
+	if err != nil {
+		s.Logger.Error(ctx, "audit log - get template version parameters", slog.F("template_version_id", build.TemplateVersionID), slog.Error(err))
+	}
+	return build.Auditable(params, templateParams)
+}
+
 type TemplateVersionImportJob struct {
 	TemplateVersionID  uuid.UUID                `json:"template_version_id"`
 	UserVariableValues []codersdk.VariableValue `json:"user_variable_values"`
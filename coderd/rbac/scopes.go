@@ -222,6 +222,31 @@ func (s Scope) Name() RoleIdentifier {
 	return s.Identifier
 }
 
+// Grants returns true if the scope permits the action on the resource type.
+// Negated permissions take precedence. The allow list is not considered, as
+// it restricts individual resources rather than resource types.
+func (s Scope) Grants(resourceType string, action policy.Action) bool {
+	perms := slices.Concat(s.Site, s.User)
+	for _, org := range s.ByOrgID {
+		perms = append(perms, org.Org...)
+	}
+
+	granted := false
+	for _, perm := range perms {
+		if perm.ResourceType != policy.WildcardSymbol && perm.ResourceType != resourceType {
+			continue
+		}
+		if perm.Action != policy.WildcardSymbol && perm.Action != action {
+			continue
+		}
+		if perm.Negate {
+			return false
+		}
+		granted = true
+	}
+	return granted
+}
+
 func ExpandScope(scope ScopeName) (Scope, error) {
 	if role, ok := builtinScopes[scope]; ok {
 		return role, nil
@@ -61,3 +61,47 @@ func TestExpandScope(t *testing.T) {
 		}
 	})
 }
+
+func TestScopeGrants(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		scope    rbac.ScopeName
+		resource string
+		action   policy.Action
+		granted  bool
+	}{
+		{name: "AllGrantsAnything", scope: rbac.ScopeAll, resource: rbac.ResourceTemplate.Type, action: policy.ActionDelete, granted: true},
+		{name: "AllGrantsWildcard", scope: rbac.ScopeAll, resource: policy.WildcardSymbol, action: policy.WildcardSymbol, granted: true},
+		{name: "LowLevelExact", scope: "workspace:read", resource: rbac.ResourceWorkspace.Type, action: policy.ActionRead, granted: true},
+		{name: "LowLevelOtherAction", scope: "workspace:read", resource: rbac.ResourceWorkspace.Type, action: policy.ActionSSH, granted: false},
+		{name: "LowLevelOtherResource", scope: "workspace:read", resource: rbac.ResourceTemplate.Type, action: policy.ActionRead, granted: false},
+		{name: "LowLevelNotWildcard", scope: "workspace:read", resource: policy.WildcardSymbol, action: policy.WildcardSymbol, granted: false},
+		{name: "LowLevelWildcardAction", scope: "workspace:*", resource: rbac.ResourceWorkspace.Type, action: policy.ActionSSH, granted: true},
+		{name: "Composite", scope: "coder:workspaces.access", resource: rbac.ResourceWorkspace.Type, action: policy.ActionSSH, granted: true},
+		{name: "CompositeMissing", scope: "coder:workspaces.access", resource: rbac.ResourceWorkspace.Type, action: policy.ActionDelete, granted: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := tc.scope.Expand()
+			require.NoError(t, err)
+			require.Equal(t, tc.granted, s.Grants(tc.resource, tc.action))
+		})
+	}
+
+	t.Run("NegatedTakesPrecedence", func(t *testing.T) {
+		t.Parallel()
+		s := rbac.Scope{
+			Role: rbac.Role{
+				Site: []rbac.Permission{
+					{ResourceType: policy.WildcardSymbol, Action: policy.WildcardSymbol},
+					{ResourceType: rbac.ResourceTemplate.Type, Action: policy.ActionDelete, Negate: true},
+				},
+			},
+		}
+		require.True(t, s.Grants(rbac.ResourceTemplate.Type, policy.ActionRead))
+		require.False(t, s.Grants(rbac.ResourceTemplate.Type, policy.ActionDelete))
+	})
+}
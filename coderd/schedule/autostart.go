@@ -29,23 +29,41 @@ func NextAutostart(at time.Time, wsSchedule string, templateSchedule TemplateSch
 	// definition of "Saturday" depends on the location of the schedule.
 	zonedTransition := nextTransition.In(sched.Location())
 	allowed := templateSchedule.AutostartRequirement.DaysMap()[zonedTransition.Weekday()]
+	// Exceptions such as public holidays are also evaluated in the location of
+	// the schedule.
+	if _, excepted := templateSchedule.AutostartExceptions.Find(zonedTransition); excepted {
+		allowed = false
+	}
 
 	return zonedTransition, allowed
 }
 
 // NextAllowedAutostart returns the next valid autostart time after 'at', based on the workspace's
-// cron schedule and the template's allowed days. It searches up to 7 days ahead to find a match.
+// cron schedule, the template's allowed days and the autostart exceptions. It searches up to 7 days
+// ahead, or 7 days past the end of any exception it encounters, to find a match.
 func NextAllowedAutostart(at time.Time, wsSchedule string, templateSchedule TemplateScheduleOptions) (time.Time, error) {
 	next := at
+	horizon := 7 * 24 * time.Hour
 
 	// Our cron schedules work on a weekly basis, so to ensure we've exhausted all
 	// possible autostart times we need to check up to 7 days worth of autostarts.
-	for next.Sub(at) < 7*24*time.Hour {
+	for next.Sub(at) < horizon {
 		var valid bool
 		next, valid = NextAutostart(next, wsSchedule, templateSchedule)
 		if valid {
 			return next, nil
 		}
+		if next.IsZero() {
+			break
+		}
+
+		// Skip straight to the end of an exception rather than stepping through
+		// every day of it, then search a full week beyond it.
+		if exception, ok := templateSchedule.AutostartExceptions.Find(next); ok {
+			end := exception.End(next.Location())
+			next = end.Add(-time.Nanosecond)
+			horizon = max(horizon, end.Sub(at)+7*24*time.Hour)
+		}
 	}
 
 	return time.Time{}, ErrNoAllowedAutostart
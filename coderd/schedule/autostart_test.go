@@ -38,4 +38,73 @@ func TestNextAllowedAutostart(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, time.Date(2024, time.January, 8, 9, 0, 0, 0, time.UTC), next)
 	})
+
+	t.Run("SkipsExceptions", func(t *testing.T) {
+		t.Parallel()
+
+		// Friday 22nd December 2023, after the autostart time.
+		at := time.Date(2023, time.December, 22, 10, 0, 0, 0, time.UTC)
+		// Monday-Friday 9:00AM UTC
+		sched := "CRON_TZ=UTC 00 09 * * 1-5"
+		opts := schedule.TemplateScheduleOptions{
+			AutostartRequirement: schedule.TemplateAutostartRequirement{
+				DaysOfWeek: 0b01111111,
+			},
+			AutostartExceptions: schedule.AutostartExceptions{
+				{Name: "Christmas", StartDate: "2023-12-25", EndDate: "2023-12-26"},
+			},
+		}
+
+		// Given: the next scheduled autostart falls on an exception
+		next, allowed := schedule.NextAutostart(at, sched, opts)
+		// Then: it is not allowed
+		require.False(t, allowed)
+		require.Equal(t, time.Date(2023, time.December, 25, 9, 0, 0, 0, time.UTC), next)
+
+		// Then: the next allowed autostart is the first day after the exception
+		next, err := schedule.NextAllowedAutostart(at, sched, opts)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2023, time.December, 27, 9, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("ExceptionLongerThanAWeek", func(t *testing.T) {
+		t.Parallel()
+
+		at := time.Date(2023, time.December, 22, 10, 0, 0, 0, time.UTC)
+		sched := "CRON_TZ=UTC 00 09 * * 1-5"
+		opts := schedule.TemplateScheduleOptions{
+			AutostartRequirement: schedule.TemplateAutostartRequirement{
+				DaysOfWeek: 0b01111111,
+			},
+			AutostartExceptions: schedule.AutostartExceptions{
+				{Name: "Shutdown", StartDate: "2023-12-23", EndDate: "2024-01-07"},
+			},
+		}
+
+		// Given: a company shutdown that lasts over two weeks
+		// Then: the search continues past the usual 7 day horizon
+		next, err := schedule.NextAllowedAutostart(at, sched, opts)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, time.January, 8, 9, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("ExceptionInScheduleTimezone", func(t *testing.T) {
+		t.Parallel()
+
+		// 23:00 UTC on the 24th is 09:00 on the 25th in Sydney.
+		at := time.Date(2023, time.December, 24, 12, 0, 0, 0, time.UTC)
+		sched := "CRON_TZ=Australia/Sydney 00 09 * * *"
+		opts := schedule.TemplateScheduleOptions{
+			AutostartRequirement: schedule.TemplateAutostartRequirement{
+				DaysOfWeek: 0b01111111,
+			},
+			AutostartExceptions: schedule.AutostartExceptions{
+				{Name: "Christmas", StartDate: "2023-12-25", EndDate: "2023-12-25"},
+			},
+		}
+
+		next, err := schedule.NextAllowedAutostart(at, sched, opts)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2023, time.December, 25, 22, 0, 0, 0, time.UTC), next.UTC())
+	})
 }
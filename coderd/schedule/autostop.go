@@ -63,6 +63,14 @@ type CalculateAutostopParams struct {
 	//      max_deadline will be updated to be much later than expected.
 	WorkspaceBuildCompletedAt time.Time
 	Workspace                 database.WorkspaceTable
+	// Windows are the workspace's schedule windows, see GetWorkspaceWindows.
+	// If empty, the workspace's TTL and WorkspaceAutostart form its only
+	// window.
+	Windows Windows
+	// Window is the index of the window whose TTL applies to the build, see
+	// Windows.StartedBy. Builds that were not autostarted use the first window,
+	// the workspace's own.
+	Window int
 }
 
 type AutostopTime struct {
@@ -123,24 +131,38 @@ func CalculateAutostop(ctx context.Context, params CalculateAutostopParams) (Aut
 		return autostop, xerrors.Errorf("get template schedule options: %w", err)
 	}
 
-	ttl := workspaceTTL(workspace, templateSchedule)
+	windows := params.Windows
+	if len(windows) == 0 {
+		windows = Windows{{AutostartSchedule: params.WorkspaceAutostart, TTL: workspace.Ttl}}
+	}
+	window := params.Window
+	if window < 0 || window >= len(windows) {
+		window = 0
+	}
+
+	ttl := windows[window].ttl(templateSchedule)
 	if ttl > 0 {
 		// Only apply non-zero TTLs.
 		autostop.Deadline = buildCompletedAt.Add(ttl)
-		if params.WorkspaceAutostart != "" {
-			// If the deadline passes the next autostart, we need to extend the deadline to
-			// autostart + deadline. ActivityBumpWorkspace already covers this case
-			// when extending the deadline.
-			//
-			// Situation this is solving.
-			// 1. User has workspace with auto-start at 9:00am, 12 hour auto-stop.
-			// 2. Coder stops workspace at 9pm
-			// 3. User starts workspace at 9:45pm.
-			//	- The initial deadline is calculated to be 9:45am
-			//	- This crosses the autostart deadline, so the deadline is extended to 9pm
-			nextAutostart, ok := NextAutostart(params.WorkspaceBuildCompletedAt, params.WorkspaceAutostart, templateSchedule)
-			if ok && autostop.Deadline.After(nextAutostart) {
-				autostop.Deadline = nextAutostart.Add(ttl)
+		// If the deadline passes the next autostart, we need to extend the deadline to
+		// autostart + deadline. ActivityBumpWorkspace already covers this case
+		// when extending the deadline.
+		//
+		// Situation this is solving.
+		// 1. User has workspace with auto-start at 9:00am, 12 hour auto-stop.
+		// 2. Coder stops workspace at 9pm
+		// 3. User starts workspace at 9:45pm.
+		//	- The initial deadline is calculated to be 9:45am
+		//	- This crosses the autostart deadline, so the deadline is extended to 9pm
+		//
+		// With several windows, the deadline is extended by the TTL of the
+		// window that starts first. A window without a TTL keeps the workspace
+		// running.
+		nextAutostart, next, ok := windows.NextAutostart(params.WorkspaceBuildCompletedAt, templateSchedule)
+		if ok && autostop.Deadline.After(nextAutostart) {
+			autostop.Deadline = time.Time{}
+			if nextTTL := windows[next].ttl(templateSchedule); nextTTL > 0 {
+				autostop.Deadline = nextAutostart.Add(nextTTL)
 			}
 		}
 	}
@@ -251,26 +273,6 @@ func CalculateAutostop(ctx context.Context, params CalculateAutostopParams) (Aut
 	return autostop, nil
 }
 
-// workspaceTTL returns the TTL to use for a workspace.
-//
-// If the template forbids custom workspace TTLs, then we always use the
-// template's configured TTL (or 0 if the template has no TTL configured).
-func workspaceTTL(workspace database.WorkspaceTable, templateSchedule TemplateScheduleOptions) time.Duration {
-	// If the template forbids custom workspace TTLs, then we always use the
-	// template's configured TTL (or 0 if the template has no TTL configured).
-	if !templateSchedule.UserAutostopEnabled {
-		// This is intentionally a nested if statement because of the else if.
-		if templateSchedule.DefaultTTL > 0 {
-			return templateSchedule.DefaultTTL
-		}
-		return 0
-	}
-	if workspace.Ttl.Valid {
-		return time.Duration(workspace.Ttl.Int64)
-	}
-	return 0
-}
-
 // truncateMidnight truncates a time to midnight in the time object's timezone.
 // t.Truncate(24 * time.Hour) truncates based on the internal time and doesn't
 // factor daylight savings properly.
@@ -90,6 +90,10 @@ func TestCalculateAutoStop(t *testing.T) {
 		// workspace is made, so it takes precedence unless
 		// templateAllowAutostop is false.
 		workspaceTTL time.Duration
+		// windows are additional schedule windows after the workspace's own,
+		// and window is the index of the window that started the build.
+		windows schedule.Windows
+		window  int
 
 		// expectedDeadline is copied from expectedMaxDeadline if unset.
 		expectedDeadline    time.Time
@@ -483,6 +487,48 @@ func TestCalculateAutoStop(t *testing.T) {
 			expectedMaxDeadline: time.Date(pastDateNight.Year(), pastDateNight.Month(), pastDateNight.Day()+1, 11, 0, 0, 0, chicago),
 			errContains:         "",
 		},
+		{
+			// Builds started by an additional window use its TTL.
+			name: "AutostartWindowTTL",
+			// Thursday at 2pm, when the second window starts.
+			buildCompletedAt:      time.Date(pastDateNight.Year(), pastDateNight.Month(), pastDateNight.Day()+1, 14, 0, 0, 0, chicago),
+			templateAllowAutostop: true,
+			workspaceTTL:          time.Hour * 8,
+			// At 6am Monday to Wednesday
+			wsAutostart: "CRON_TZ=America/Chicago 0 6 * * 1-3",
+			windows: schedule.Windows{{
+				// At 2pm Thursday and Friday
+				AutostartSchedule: "CRON_TZ=America/Chicago 0 14 * * 4-5",
+				TTL:               sql.NullInt64{Valid: true, Int64: int64(time.Hour * 10)},
+			}},
+			window: 1,
+			templateAutoStart: schedule.TemplateAutostartRequirement{
+				DaysOfWeek: 0b01111111,
+			},
+
+			expectedDeadline:    time.Date(pastDateNight.Year(), pastDateNight.Month(), pastDateNight.Day()+2, 0, 0, 0, 0, chicago),
+			expectedMaxDeadline: time.Time{},
+		},
+		{
+			// Same as AutostopCrossAutostartBorder, but the autostart is an
+			// additional window with its own TTL.
+			name: "AutostopCrossAutostartWindowBorder",
+			// Starting at 9:45pm, with the window at 9am.
+			buildCompletedAt:      pastDateNight,
+			templateAllowAutostop: true,
+			workspaceTTL:          time.Hour * 12,
+			windows: schedule.Windows{{
+				// At 9am every morning
+				AutostartSchedule: "CRON_TZ=America/Chicago 0 9 * * *",
+				TTL:               sql.NullInt64{Valid: true, Int64: int64(time.Hour * 4)},
+			}},
+			templateAutoStart: schedule.TemplateAutostartRequirement{
+				DaysOfWeek: 0b01111111,
+			},
+
+			expectedDeadline:    time.Date(pastDateNight.Year(), pastDateNight.Month(), pastDateNight.Day()+1, 13, 0, 0, 0, chicago),
+			expectedMaxDeadline: time.Time{},
+		},
 	}
 
 	for _, c := range cases {
@@ -567,6 +613,11 @@ func TestCalculateAutoStop(t *testing.T) {
 				AutostartSchedule: autostart,
 			})
 
+			var windows schedule.Windows
+			if len(c.windows) > 0 {
+				windows = append(schedule.WorkspaceWindows(workspace, nil), c.windows...)
+			}
+
 			autostop, err := schedule.CalculateAutostop(ctx, schedule.CalculateAutostopParams{
 				Database:                    db,
 				TemplateScheduleStore:       templateScheduleStore,
@@ -574,6 +625,8 @@ func TestCalculateAutoStop(t *testing.T) {
 				WorkspaceBuildCompletedAt:   c.buildCompletedAt,
 				Workspace:                   workspace,
 				WorkspaceAutostart:          c.wsAutostart,
+				Windows:                     windows,
+				Window:                      c.window,
 			})
 			if c.errContains != "" {
 				require.Error(t, err)
@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+)
+
+// ExceptionDateLayout is the format of the start and end dates of an
+// autostart exception.
+const ExceptionDateLayout = "2006-01-02"
+
+// AutostartException is a named range of days on which workspaces must not be
+// autostarted, such as a public holiday or a company shutdown. Dates are
+// interpreted in the timezone of each workspace's autostart schedule.
+type AutostartException struct {
+	Name string `json:"name"`
+	// StartDate is the first day of the exception, formatted as YYYY-MM-DD.
+	StartDate string `json:"start_date"`
+	// EndDate is the last day of the exception (inclusive), formatted as
+	// YYYY-MM-DD.
+	EndDate string `json:"end_date"`
+}
+
+// Validate returns an error if the exception does not have a name or does
+// not describe a valid range of dates.
+func (e AutostartException) Validate() error {
+	if e.Name == "" {
+		return xerrors.New("exception name is required")
+	}
+	start, err := time.Parse(ExceptionDateLayout, e.StartDate)
+	if err != nil {
+		return xerrors.Errorf("exception %q: invalid start date %q, expected YYYY-MM-DD", e.Name, e.StartDate)
+	}
+	end, err := time.Parse(ExceptionDateLayout, e.EndDate)
+	if err != nil {
+		return xerrors.Errorf("exception %q: invalid end date %q, expected YYYY-MM-DD", e.Name, e.EndDate)
+	}
+	if end.Before(start) {
+		return xerrors.Errorf("exception %q: end date %s is before start date %s", e.Name, e.EndDate, e.StartDate)
+	}
+	return nil
+}
+
+// Contains returns true if the date of t, in t's location, falls within the
+// exception.
+func (e AutostartException) Contains(t time.Time) bool {
+	// Dates formatted as YYYY-MM-DD sort lexically.
+	date := t.Format(ExceptionDateLayout)
+	return date >= e.StartDate && date <= e.EndDate
+}
+
+// End returns the start of the day after the exception's last day in the
+// given location, i.e. the earliest time at which autostart may resume.
+func (e AutostartException) End(loc *time.Location) time.Time {
+	end, err := time.ParseInLocation(ExceptionDateLayout, e.EndDate, loc)
+	if err != nil {
+		return time.Time{}
+	}
+	return end.AddDate(0, 0, 1)
+}
+
+// AutostartExceptions is the set of exceptions that apply to a workspace.
+type AutostartExceptions []AutostartException
+
+// Find returns the first exception containing t.
+func (es AutostartExceptions) Find(t time.Time) (AutostartException, bool) {
+	for _, e := range es {
+		if e.Contains(t) {
+			return e, true
+		}
+	}
+	return AutostartException{}, false
+}
+
+// Upcoming returns the exceptions that have not yet ended at t, in t's
+// location.
+func (es AutostartExceptions) Upcoming(t time.Time) AutostartExceptions {
+	date := t.Format(ExceptionDateLayout)
+	upcoming := AutostartExceptions{}
+	for _, e := range es {
+		if e.EndDate >= date {
+			upcoming = append(upcoming, e)
+		}
+	}
+	return upcoming
+}
+
+// ParseAutostartExceptions decodes the exceptions stored on an autostart
+// exception calendar.
+func ParseAutostartExceptions(raw json.RawMessage) (AutostartExceptions, error) {
+	if len(raw) == 0 {
+		return AutostartExceptions{}, nil
+	}
+	var exceptions AutostartExceptions
+	if err := json.Unmarshal(raw, &exceptions); err != nil {
+		return nil, xerrors.Errorf("unmarshal autostart exceptions: %w", err)
+	}
+	return exceptions, nil
+}
+
+// GetAutostartExceptions returns the exceptions from all of the organization's
+// autostart exception calendars, unless the workspace has opted out of them.
+// workspaceID may be uuid.Nil for a workspace that has not been created yet.
+func GetAutostartExceptions(ctx context.Context, db database.Store, organizationID, workspaceID uuid.UUID) (AutostartExceptions, error) {
+	if workspaceID != uuid.Nil {
+		optedOut, err := db.GetWorkspaceAutostartExceptionOptOut(ctx, workspaceID)
+		if err != nil {
+			return nil, xerrors.Errorf("get workspace autostart exception opt out: %w", err)
+		}
+		if optedOut {
+			return nil, nil
+		}
+	}
+
+	calendars, err := db.GetAutostartExceptionCalendarsByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, xerrors.Errorf("get autostart exception calendars: %w", err)
+	}
+	var exceptions AutostartExceptions
+	for _, calendar := range calendars {
+		calendarExceptions, err := ParseAutostartExceptions(calendar.Exceptions)
+		if err != nil {
+			return nil, xerrors.Errorf("calendar %q: %w", calendar.Name, err)
+		}
+		exceptions = append(exceptions, calendarExceptions...)
+	}
+	return exceptions, nil
+}
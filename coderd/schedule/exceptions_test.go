@@ -0,0 +1,72 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/schedule"
+)
+
+func TestAutostartException(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name      string
+			exception schedule.AutostartException
+			err       string
+		}{
+			{name: "OK", exception: schedule.AutostartException{Name: "Christmas", StartDate: "2025-12-25", EndDate: "2025-12-26"}},
+			{name: "SingleDay", exception: schedule.AutostartException{Name: "Christmas", StartDate: "2025-12-25", EndDate: "2025-12-25"}},
+			{name: "NoName", exception: schedule.AutostartException{StartDate: "2025-12-25", EndDate: "2025-12-26"}, err: "name is required"},
+			{name: "BadStart", exception: schedule.AutostartException{Name: "x", StartDate: "25/12/2025", EndDate: "2025-12-26"}, err: "invalid start date"},
+			{name: "BadEnd", exception: schedule.AutostartException{Name: "x", StartDate: "2025-12-25", EndDate: ""}, err: "invalid end date"},
+			{name: "EndBeforeStart", exception: schedule.AutostartException{Name: "x", StartDate: "2025-12-26", EndDate: "2025-12-25"}, err: "is before start date"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				err := tc.exception.Validate()
+				if tc.err == "" {
+					require.NoError(t, err)
+					return
+				}
+				require.ErrorContains(t, err, tc.err)
+			})
+		}
+	})
+
+	t.Run("FindAndUpcoming", func(t *testing.T) {
+		t.Parallel()
+
+		exceptions := schedule.AutostartExceptions{
+			{Name: "Christmas", StartDate: "2025-12-25", EndDate: "2025-12-26"},
+			{Name: "New Year", StartDate: "2026-01-01", EndDate: "2026-01-01"},
+		}
+
+		found, ok := exceptions.Find(time.Date(2025, time.December, 26, 23, 59, 0, 0, time.UTC))
+		require.True(t, ok)
+		require.Equal(t, "Christmas", found.Name)
+		_, ok = exceptions.Find(time.Date(2025, time.December, 27, 0, 0, 0, 0, time.UTC))
+		require.False(t, ok)
+
+		upcoming := exceptions.Upcoming(time.Date(2025, time.December, 27, 0, 0, 0, 0, time.UTC))
+		require.Len(t, upcoming, 1)
+		require.Equal(t, "New Year", upcoming[0].Name)
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		t.Parallel()
+
+		raw := json.RawMessage(`[{"name":"Christmas","start_date":"2025-12-25","end_date":"2025-12-26"}]`)
+		exceptions, err := schedule.ParseAutostartExceptions(raw)
+		require.NoError(t, err)
+		require.Equal(t, schedule.AutostartExceptions{
+			{Name: "Christmas", StartDate: "2025-12-25", EndDate: "2025-12-26"},
+		}, exceptions)
+	})
+}
@@ -0,0 +1,162 @@
+// Package ical parses the events of iCalendar (RFC 5545) files, such as the
+// public holiday calendars published by most calendar providers, into
+// autostart exceptions.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/schedule"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+)
+
+// Parse reads the VEVENT components of an iCalendar file and returns one
+// exception per event. Each exception covers every day the event touches, so
+// timed events exclude the whole of their days. Recurrence rules are not
+// expanded.
+func Parse(r io.Reader) ([]schedule.AutostartException, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		exceptions []schedule.AutostartException
+		inEvent    bool
+		// nested counts the components open within the current event, such
+		// as VALARM, whose properties do not describe the event.
+		nested     int
+		summary    string
+		start, end string
+	)
+	for i, line := range lines {
+		name, _, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT") && !inEvent:
+			inEvent = true
+			nested = 0
+			summary, start, end = "", "", ""
+		case name == "END" && strings.EqualFold(value, "VEVENT") && nested == 0:
+			if !inEvent {
+				return nil, xerrors.Errorf("line %d: END:VEVENT without BEGIN:VEVENT", i+1)
+			}
+			inEvent = false
+			exception, err := toException(summary, start, end)
+			if err != nil {
+				return nil, xerrors.Errorf("event %q: %w", summary, err)
+			}
+			exceptions = append(exceptions, exception)
+		case !inEvent:
+			continue
+		case name == "BEGIN":
+			nested++
+		case name == "END":
+			if nested == 0 {
+				return nil, xerrors.Errorf("line %d: END:%s without BEGIN:%s", i+1, value, value)
+			}
+			nested--
+		case nested > 0:
+			continue
+		case name == "SUMMARY":
+			summary = unescape(value)
+		case name == "DTSTART":
+			start = value
+		case name == "DTEND":
+			end = value
+		}
+	}
+	if inEvent {
+		return nil, xerrors.New("unterminated VEVENT")
+	}
+	return exceptions, nil
+}
+
+// toException converts the raw DTSTART and DTEND of an event to an inclusive
+// range of dates.
+func toException(summary, rawStart, rawEnd string) (schedule.AutostartException, error) {
+	if summary == "" {
+		summary = "Untitled event"
+	}
+	if rawStart == "" {
+		return schedule.AutostartException{}, xerrors.New("missing DTSTART")
+	}
+	start, err := parseTime(rawStart)
+	if err != nil {
+		return schedule.AutostartException{}, xerrors.Errorf("parse DTSTART: %w", err)
+	}
+	last := start
+	if rawEnd != "" {
+		end, err := parseTime(rawEnd)
+		if err != nil {
+			return schedule.AutostartException{}, xerrors.Errorf("parse DTEND: %w", err)
+		}
+		// DTEND is exclusive, so an all-day event ends the day before its
+		// DTEND, and a timed event ending at midnight does not touch the
+		// following day.
+		if end.After(start) {
+			last = end.Add(-time.Nanosecond)
+		}
+	}
+	return schedule.AutostartException{
+		Name:      summary,
+		StartDate: start.Format(schedule.ExceptionDateLayout),
+		EndDate:   last.Format(schedule.ExceptionDateLayout),
+	}, nil
+}
+
+// parseTime parses a DATE or DATE-TIME value. Time zones are discarded, so
+// the dates are the ones written in the file. DATE values are midnight.
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSuffix(value, "Z")
+	if len(value) == len(dateLayout) {
+		return time.Parse(dateLayout, value)
+	}
+	return time.Parse(dateTimeLayout, value)
+}
+
+// unfold joins content lines that were folded onto multiple physical lines.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// splitProperty splits a content line such as
+// "DTSTART;VALUE=DATE:20251225" into its upper-cased name, its parameters and
+// its value.
+func splitProperty(line string) (name, params, value string, ok bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", "", false
+	}
+	name, params, _ = strings.Cut(head, ";")
+	return strings.ToUpper(name), strings.ToUpper(params), value, true
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(s)
+}
@@ -0,0 +1,88 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/coderd/schedule/ical"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		// Lines are CRLF terminated and the second summary is folded.
+		calendar := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//Example//Holidays//EN",
+			"BEGIN:VEVENT",
+			"UID:christmas@example.com",
+			"DTSTART;VALUE=DATE:20251225",
+			"DTEND;VALUE=DATE:20251227",
+			"SUMMARY:Christmas Day\\, Boxing Day",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"DTSTART;VALUE=DATE:20260101",
+			"SUMMARY:New Year's",
+			"  Day",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"DTSTART:20260415T090000Z",
+			"DTEND:20260417T170000Z",
+			"SUMMARY:Offsite",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"DTSTART:20260504T180000",
+			"DTEND:20260505T000000",
+			"SUMMARY:Release party",
+			"BEGIN:VALARM",
+			"TRIGGER:-PT15M",
+			"ACTION:DISPLAY",
+			"SUMMARY:Reminder",
+			"END:VALARM",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")
+
+		exceptions, err := ical.Parse(strings.NewReader(calendar))
+		require.NoError(t, err)
+		require.Equal(t, []schedule.AutostartException{
+			{Name: "Christmas Day, Boxing Day", StartDate: "2025-12-25", EndDate: "2025-12-26"},
+			{Name: "New Year's Day", StartDate: "2026-01-01", EndDate: "2026-01-01"},
+			{Name: "Offsite", StartDate: "2026-04-15", EndDate: "2026-04-17"},
+			// A timed event which ends at midnight does not touch the next
+			// day, and the summary of its alarm is ignored.
+			{Name: "Release party", StartDate: "2026-05-04", EndDate: "2026-05-04"},
+		}, exceptions)
+		for _, exception := range exceptions {
+			require.NoError(t, exception.Validate())
+		}
+	})
+
+	t.Run("MissingStart", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ical.Parse(strings.NewReader("BEGIN:VEVENT\nSUMMARY:Nothing\nEND:VEVENT\n"))
+		require.ErrorContains(t, err, "missing DTSTART")
+	})
+
+	t.Run("Unterminated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ical.Parse(strings.NewReader("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\n"))
+		require.ErrorContains(t, err, "unterminated VEVENT")
+	})
+
+	t.Run("UnmatchedEnd", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ical.Parse(strings.NewReader("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nEND:VALARM\nEND:VEVENT\n"))
+		require.ErrorContains(t, err, "END:VALARM without BEGIN:VALARM")
+	})
+}
@@ -128,6 +128,10 @@ type TemplateScheduleOptions struct {
 	AutostopRequirement TemplateAutostopRequirement
 	// AutostartRequirement dictates when the workspace can be auto started.
 	AutostartRequirement TemplateAutostartRequirement
+	// AutostartExceptions are the days on which the workspace must not be
+	// auto started. They are not stored on the template: callers populate
+	// them from the workspace's organization with GetAutostartExceptions.
+	AutostartExceptions AutostartExceptions
 	// FailureTTL dictates the duration after which failed workspaces will be
 	// stopped automatically.
 	FailureTTL time.Duration
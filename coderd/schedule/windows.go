@@ -0,0 +1,194 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/schedule/cron"
+)
+
+// Window is a workspace autostart schedule paired with the TTL of the builds
+// it starts. The timezone of a window is part of its cron schedule.
+type Window struct {
+	// AutostartSchedule is a weekly cron schedule. It is empty for the first
+	// window of a workspace that has a TTL but no autostart schedule.
+	AutostartSchedule string
+	// TTL is the time that builds started by the window stay running. Invalid
+	// disables autostop.
+	TTL sql.NullInt64
+}
+
+// ttl returns the TTL to use for builds started by the window.
+//
+// If the template forbids custom workspace TTLs, then we always use the
+// template's configured TTL (or 0 if the template has no TTL configured).
+func (w Window) ttl(templateSchedule TemplateScheduleOptions) time.Duration {
+	if !templateSchedule.UserAutostopEnabled {
+		// This is intentionally a nested if statement because of the else if.
+		if templateSchedule.DefaultTTL > 0 {
+			return templateSchedule.DefaultTTL
+		}
+		return 0
+	}
+	if w.TTL.Valid {
+		return time.Duration(w.TTL.Int64)
+	}
+	return 0
+}
+
+// Windows are the schedule windows of a workspace. The first window is always
+// the workspace's own autostart schedule and TTL, followed by its additional
+// windows in order. When several windows start at the same time, the earliest
+// window in the list takes precedence, so that overlapping windows resolve
+// deterministically.
+type Windows []Window
+
+// WorkspaceWindows returns the schedule windows of the workspace given its
+// additional windows.
+func WorkspaceWindows(workspace database.WorkspaceTable, additional []database.WorkspaceScheduleWindow) Windows {
+	windows := make(Windows, 0, len(additional)+1)
+	windows = append(windows, Window{
+		AutostartSchedule: workspace.AutostartSchedule.String,
+		TTL:               workspace.Ttl,
+	})
+	for _, w := range additional {
+		windows = append(windows, Window{
+			AutostartSchedule: w.AutostartSchedule,
+			TTL:               w.Ttl,
+		})
+	}
+	return windows
+}
+
+// GetWorkspaceWindows fetches the additional windows of the workspace and
+// returns all of its schedule windows.
+func GetWorkspaceWindows(ctx context.Context, db database.Store, workspace database.WorkspaceTable) (Windows, error) {
+	additional, err := db.GetWorkspaceScheduleWindowsByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		return nil, xerrors.Errorf("get workspace schedule windows: %w", err)
+	}
+	return WorkspaceWindows(workspace, additional), nil
+}
+
+// HasAutostart returns true if any of the windows has an autostart schedule.
+func (ws Windows) HasAutostart() bool {
+	for _, w := range ws {
+		if w.AutostartSchedule != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// NextAutostart returns the earliest next autostart after "at" across all
+// windows that is allowed by the template schedule, along with the index of
+// the window it belongs to. Only the next occurrence of each window is
+// considered, as with NextAutostart.
+func (ws Windows) NextAutostart(at time.Time, templateSchedule TemplateScheduleOptions) (time.Time, int, bool) {
+	var (
+		earliest time.Time
+		index    = -1
+	)
+	for i, w := range ws {
+		if w.AutostartSchedule == "" {
+			continue
+		}
+		next, allowed := NextAutostart(at, w.AutostartSchedule, templateSchedule)
+		if !allowed {
+			continue
+		}
+		// Strictly before, so that ties go to the earlier window.
+		if index == -1 || next.Before(earliest) {
+			earliest, index = next, i
+		}
+	}
+	return earliest, index, index != -1
+}
+
+// NextAllowedAutostart returns the earliest next valid autostart after "at"
+// across all windows, along with the index of the window it belongs to. See
+// NextAllowedAutostart.
+func (ws Windows) NextAllowedAutostart(at time.Time, templateSchedule TemplateScheduleOptions) (time.Time, int, error) {
+	var (
+		earliest time.Time
+		index    = -1
+	)
+	for i, w := range ws {
+		if w.AutostartSchedule == "" {
+			continue
+		}
+		next, err := NextAllowedAutostart(at, w.AutostartSchedule, templateSchedule)
+		if err != nil {
+			continue
+		}
+		// Strictly before, so that ties go to the earlier window.
+		if index == -1 || next.Before(earliest) {
+			earliest, index = next, i
+		}
+	}
+	if index == -1 {
+		return time.Time{}, -1, ErrNoAllowedAutostart
+	}
+	return earliest, index, nil
+}
+
+// StartedBy returns the index of the window that most recently scheduled an
+// autostart at or before "at", looking back up to a week. This is the window
+// whose TTL applies to an autostarted build. Ties go to the earlier window.
+func (ws Windows) StartedBy(at time.Time) (int, bool) {
+	var (
+		latest time.Time
+		index  = -1
+	)
+	for i, w := range ws {
+		if w.AutostartSchedule == "" {
+			continue
+		}
+		sched, err := cron.Weekly(w.AutostartSchedule)
+		if err != nil {
+			continue
+		}
+		// The cron library can only look forward, so walk the occurrences of
+		// the last week up to "at".
+		var last time.Time
+		for next := sched.Next(at.Add(-7 * 24 * time.Hour)); !next.IsZero() && !next.After(at); next = sched.Next(next) {
+			last = next
+		}
+		if last.IsZero() {
+			continue
+		}
+		// Strictly after, so that ties go to the earlier window.
+		if index == -1 || last.After(latest) {
+			latest, index = last, i
+		}
+	}
+	return index, index != -1
+}
+
+// Excepted returns true if "t" is an autostart of one of the windows that
+// falls on one of the exceptions, in the timezone of that window.
+func (ws Windows) Excepted(t time.Time, exceptions AutostartExceptions) bool {
+	if len(exceptions) == 0 {
+		return false
+	}
+	for _, w := range ws {
+		if w.AutostartSchedule == "" {
+			continue
+		}
+		sched, err := cron.Weekly(w.AutostartSchedule)
+		if err != nil {
+			continue
+		}
+		if !sched.Next(t.Add(-time.Minute)).Equal(t) {
+			continue
+		}
+		if _, excepted := exceptions.Find(t.In(sched.Location())); excepted {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package schedule_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/schedule"
+)
+
+func TestWindows(t *testing.T) {
+	t.Parallel()
+
+	// Shift work: 06:00 Monday to Wednesday and 14:00 Thursday and Friday.
+	workspace := database.WorkspaceTable{
+		ID:                uuid.New(),
+		AutostartSchedule: sql.NullString{Valid: true, String: "CRON_TZ=Europe/London 0 6 * * 1-3"},
+		Ttl:               sql.NullInt64{Valid: true, Int64: int64(8 * time.Hour)},
+	}
+	windows := schedule.WorkspaceWindows(workspace, []database.WorkspaceScheduleWindow{
+		{
+			WorkspaceID:       workspace.ID,
+			Position:          1,
+			AutostartSchedule: "CRON_TZ=Europe/London 0 14 * * 4-5",
+			Ttl:               sql.NullInt64{Valid: true, Int64: int64(10 * time.Hour)},
+		},
+	})
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	templateSchedule := schedule.TemplateScheduleOptions{
+		UserAutostartEnabled: true,
+		AutostartRequirement: schedule.TemplateAutostartRequirement{DaysOfWeek: 0b01111111},
+	}
+
+	t.Run("NextAllowedAutostart", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tc := range []struct {
+			name   string
+			at     time.Time
+			next   time.Time
+			window int
+		}{
+			// Wednesday 2025-01-08 after the morning start.
+			{name: "Wednesday", at: time.Date(2025, 1, 8, 7, 0, 0, 0, london), next: time.Date(2025, 1, 9, 14, 0, 0, 0, london), window: 1},
+			// Friday 2025-01-10 after the afternoon start.
+			{name: "Friday", at: time.Date(2025, 1, 10, 15, 0, 0, 0, london), next: time.Date(2025, 1, 13, 6, 0, 0, 0, london), window: 0},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				next, window, err := windows.NextAllowedAutostart(tc.at, templateSchedule)
+				require.NoError(t, err)
+				require.True(t, tc.next.Equal(next), "expected %s, got %s", tc.next, next)
+				require.Equal(t, tc.window, window)
+			})
+		}
+	})
+
+	t.Run("TemplateRestrictions", func(t *testing.T) {
+		t.Parallel()
+
+		// Thursdays and Fridays are not allowed, so only the first window
+		// applies.
+		restricted := templateSchedule
+		restricted.AutostartRequirement.DaysOfWeek = 0b01100111
+		next, window, err := windows.NextAllowedAutostart(time.Date(2025, 1, 8, 7, 0, 0, 0, london), restricted)
+		require.NoError(t, err)
+		require.True(t, time.Date(2025, 1, 13, 6, 0, 0, 0, london).Equal(next), "got %s", next)
+		require.Equal(t, 0, window)
+	})
+
+	t.Run("OverlapPrefersEarlierWindow", func(t *testing.T) {
+		t.Parallel()
+
+		overlapping := schedule.Windows{
+			{AutostartSchedule: "CRON_TZ=UTC 0 9 * * *", TTL: sql.NullInt64{Valid: true, Int64: int64(time.Hour)}},
+			{AutostartSchedule: "CRON_TZ=UTC 0 9 * * *", TTL: sql.NullInt64{Valid: true, Int64: int64(2 * time.Hour)}},
+			// The same instant expressed in another timezone.
+			{AutostartSchedule: "CRON_TZ=Europe/Paris 0 10 * * *", TTL: sql.NullInt64{Valid: true, Int64: int64(3 * time.Hour)}},
+		}
+		at := time.Date(2025, 1, 8, 8, 0, 0, 0, time.UTC)
+		_, window, err := overlapping.NextAllowedAutostart(at, templateSchedule)
+		require.NoError(t, err)
+		require.Equal(t, 0, window)
+
+		window, ok := overlapping.StartedBy(at.Add(90 * time.Minute))
+		require.True(t, ok)
+		require.Equal(t, 0, window)
+	})
+
+	t.Run("StartedBy", func(t *testing.T) {
+		t.Parallel()
+
+		// Thursday afternoon, shortly after the second window started.
+		window, ok := windows.StartedBy(time.Date(2025, 1, 9, 14, 1, 0, 0, london))
+		require.True(t, ok)
+		require.Equal(t, 1, window)
+
+		// Thursday morning, the last start was Wednesday's.
+		window, ok = windows.StartedBy(time.Date(2025, 1, 9, 9, 0, 0, 0, london))
+		require.True(t, ok)
+		require.Equal(t, 0, window)
+	})
+
+	t.Run("NoAutostart", func(t *testing.T) {
+		t.Parallel()
+
+		ttlOnly := schedule.WorkspaceWindows(database.WorkspaceTable{
+			Ttl: sql.NullInt64{Valid: true, Int64: int64(time.Hour)},
+		}, nil)
+		require.Len(t, ttlOnly, 1)
+		require.False(t, ttlOnly.HasAutostart())
+		_, _, err := ttlOnly.NextAllowedAutostart(time.Now(), templateSchedule)
+		require.ErrorIs(t, err, schedule.ErrNoAllowedAutostart)
+		_, ok := ttlOnly.StartedBy(time.Now())
+		require.False(t, ok)
+	})
+
+	t.Run("Excepted", func(t *testing.T) {
+		t.Parallel()
+
+		exceptions := schedule.AutostartExceptions{
+			{Name: "Shutdown", StartDate: "2025-01-09", EndDate: "2025-01-09"},
+		}
+		require.True(t, windows.Excepted(time.Date(2025, 1, 9, 14, 0, 0, 0, london).UTC(), exceptions))
+		require.False(t, windows.Excepted(time.Date(2025, 1, 10, 14, 0, 0, 0, london).UTC(), exceptions))
+		// Not a start of any window.
+		require.False(t, windows.Excepted(time.Date(2025, 1, 9, 15, 0, 0, 0, london).UTC(), exceptions))
+	})
+}
@@ -689,7 +689,7 @@ func (r *remoteReporter) createSnapshot() (*Snapshot, error) {
 		return nil
 	})
 	eg.Go(func() error {
-		metrics, err := r.options.Database.GetPrebuildMetrics(ctx)
+		metrics, err := r.options.Database.GetPrebuildMetrics(ctx, dbtime.Now())
 		if err != nil {
 			return xerrors.Errorf("get prebuild metrics: %w", err)
 		}
@@ -554,7 +554,7 @@ type mockDB struct {
 	database.Store
 }
 
-func (*mockDB) GetPrebuildMetrics(context.Context) ([]database.GetPrebuildMetricsRow, error) {
+func (*mockDB) GetPrebuildMetrics(context.Context, time.Time) ([]database.GetPrebuildMetricsRow, error) {
 	return []database.GetPrebuildMetricsRow{
 		{
 			TemplateName:     "template1",
@@ -579,7 +579,7 @@ type emptyMockDB struct {
 	database.Store
 }
 
-func (*emptyMockDB) GetPrebuildMetrics(context.Context) ([]database.GetPrebuildMetricsRow, error) {
+func (*emptyMockDB) GetPrebuildMetrics(context.Context, time.Time) ([]database.GetPrebuildMetricsRow, error) {
 	return []database.GetPrebuildMetricsRow{}, nil
 }
 
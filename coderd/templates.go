@@ -184,7 +184,7 @@ func (api *API) postTemplateByOrganization(rw http.ResponseWriter, r *http.Reque
 			Action:         database.AuditActionCreate,
 			OrganizationID: organization.ID,
 		})
-		templateVersionAudit, commitTemplateVersionAudit = audit.InitRequest[database.TemplateVersion](rw, &audit.RequestParams{
+		templateVersionAudit, commitTemplateVersionAudit = audit.InitRequest[database.AuditableTemplateVersion](rw, &audit.RequestParams{
 			Audit:          auditor,
 			Log:            api.Logger,
 			Request:        r,
@@ -263,7 +263,8 @@ func (api *API) postTemplateByOrganization(rw http.ResponseWriter, r *http.Reque
 		})
 		return
 	}
-	templateVersionAudit.Old = templateVersion
+	// Variables are fixed when the version is created, so they are omitted.
+	templateVersionAudit.Old = templateVersion.Auditable(nil)
 	if templateVersion.TemplateID.Valid {
 		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
 			Message: fmt.Sprintf("Template version %s is already part of a template", createTemplate.VersionID),
@@ -489,7 +490,7 @@ func (api *API) postTemplateByOrganization(rw http.ResponseWriter, r *http.Reque
 			UUID:  dbTemplate.ID,
 			Valid: true,
 		}
-		templateVersionAudit.New = newTemplateVersion
+		templateVersionAudit.New = newTemplateVersion.Auditable(nil)
 
 		return nil
 	}, database.DefaultTXOptions().WithID("postTemplate"))
@@ -772,6 +773,7 @@ func (api *API) patchTemplateMeta(rw http.ResponseWriter, r *http.Request) {
 	if req.UseClassicParameterFlow != nil {
 		classicTemplateFlow = *req.UseClassicParameterFlow
 	}
+	recordSessions := ptr.NilToDefault(req.RecordSessions, template.RecordSessions)
 
 	displayName := ptr.NilToDefault(req.DisplayName, template.DisplayName)
 	description := ptr.NilToDefault(req.Description, template.Description)
@@ -798,7 +800,8 @@ func (api *API) patchTemplateMeta(rw http.ResponseWriter, r *http.Request) {
 			(deprecationMessage == template.Deprecated) &&
 			(classicTemplateFlow == template.UseClassicParameterFlow) &&
 			maxPortShareLevel == template.MaxPortSharingLevel &&
-			corsBehavior == template.CorsBehavior {
+			corsBehavior == template.CorsBehavior &&
+			recordSessions == template.RecordSessions {
 			return nil
 		}
 
@@ -841,6 +844,7 @@ func (api *API) patchTemplateMeta(rw http.ResponseWriter, r *http.Request) {
 			MaxPortSharingLevel:          maxPortShareLevel,
 			UseClassicParameterFlow:      classicTemplateFlow,
 			CorsBehavior:                 corsBehavior,
+			RecordSessions:               recordSessions,
 		})
 		if err != nil {
 			return xerrors.Errorf("update template metadata: %w", err)
@@ -1125,6 +1129,7 @@ func (api *API) convertTemplate(
 		MaxPortShareLevel:       maxPortShareLevel,
 		UseClassicParameterFlow: template.UseClassicParameterFlow,
 		CORSBehavior:            codersdk.CORSBehavior(template.CorsBehavior),
+		RecordSessions:          template.RecordSessions,
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	stdslog "log/slog"
 	"net/http"
 	"os"
+	"slices"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -1268,7 +1269,7 @@ func (api *API) setArchiveTemplateVersion(archive bool) func(rw http.ResponseWri
 			ctx               = r.Context()
 			templateVersion   = httpmw.TemplateVersionParam(r)
 			auditor           = *api.Auditor.Load()
-			aReq, commitAudit = audit.InitRequest[database.TemplateVersion](rw, &audit.RequestParams{
+			aReq, commitAudit = audit.InitRequest[database.AuditableTemplateVersion](rw, &audit.RequestParams{
 				Audit:          auditor,
 				Log:            api.Logger,
 				Request:        r,
@@ -1277,7 +1278,8 @@ func (api *API) setArchiveTemplateVersion(archive bool) func(rw http.ResponseWri
 			})
 		)
 		defer commitAudit()
-		aReq.Old = templateVersion
+		// Variables are fixed when the version is created, so they are omitted.
+		aReq.Old = templateVersion.Auditable(nil)
 
 		verb := "archived"
 		if !archive {
@@ -1456,7 +1458,7 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 		apiKey            = httpmw.APIKey(r)
 		organization      = httpmw.OrganizationParam(r)
 		auditor           = *api.Auditor.Load()
-		aReq, commitAudit = audit.InitRequest[database.TemplateVersion](rw, &audit.RequestParams{
+		aReq, commitAudit = audit.InitRequest[database.AuditableTemplateVersion](rw, &audit.RequestParams{
 			Audit:          auditor,
 			Log:            api.Logger,
 			Request:        r,
@@ -1473,6 +1475,7 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 	}
 
 	dynamicTemplate := true // Default to using dynamic templates
+	var activeVariables []database.TemplateVersionVariable
 	if req.TemplateID != uuid.Nil {
 		tpl, err := api.Database.GetTemplateByID(ctx, req.TemplateID)
 		if httpapi.Is404Error(err) {
@@ -1489,6 +1492,15 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 			return
 		}
 		dynamicTemplate = !tpl.UseClassicParameterFlow
+
+		activeVariables, err = api.Database.GetTemplateVersionVariables(ctx, tpl.ActiveVersionID)
+		if err != nil && !httpapi.Is404Error(err) {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching template variables.",
+				Detail:  err.Error(),
+			})
+			return
+		}
 	}
 
 	if req.ExampleID != "" && req.FileID != uuid.Nil {
@@ -1748,7 +1760,10 @@ func (api *API) postTemplateVersionsByOrganization(rw http.ResponseWriter, r *ht
 		// Each failure case in the tx should have already written a response.
 		return
 	}
-	aReq.New = templateVersion
+	// Variables are only stored once the import job completes, so the audit
+	// diff compares the provided values against the active version instead.
+	aReq.Old = database.TemplateVersion{}.Auditable(activeVariables)
+	aReq.New = templateVersion.Auditable(templateVersionAuditVariables(activeVariables, req.UserVariableValues))
 	err = provisionerjobs.PostJob(api.Pubsub, provisionerJob)
 	if err != nil {
 		// Client probably doesn't care about this error, so just log it.
@@ -1994,6 +2009,29 @@ func convertTemplateVersionVariable(variable database.TemplateVersionVariable) c
 	return templateVariable
 }
 
+// templateVersionAuditVariables applies the user provided values on top of the
+// active version's variables, the same way the import job resolves omitted
+// values. Whether a new variable is sensitive is only known once the import
+// completes, so new variables are treated as sensitive.
+func templateVersionAuditVariables(active []database.TemplateVersionVariable, values []codersdk.VariableValue) []database.TemplateVersionVariable {
+	variables := slices.Clone(active)
+	for _, value := range values {
+		i := slices.IndexFunc(variables, func(v database.TemplateVersionVariable) bool {
+			return v.Name == value.Name
+		})
+		if i < 0 {
+			variables = append(variables, database.TemplateVersionVariable{
+				Name:      value.Name,
+				Value:     value.Value,
+				Sensitive: true,
+			})
+			continue
+		}
+		variables[i].Value = value.Value
+	}
+	return variables
+}
+
 func watchTemplateChannel(id uuid.UUID) string {
 	return fmt.Sprintf("template:%s", id)
 }
@@ -430,6 +430,13 @@ func (api *API) patchWorkspaceAgentAppStatus(rw http.ResponseWriter, r *http.Req
 	// Notify on state change to Working/Idle for AI tasks
 	api.enqueueAITaskStateNotification(ctx, app.ID, latestAppStatus, req.State, workspace)
 
+	// Move multi-step tasks on to their next step once the agent becomes idle.
+	// Repeated idle reports retry steps whose prompt could not be sent.
+	if req.State == codersdk.WorkspaceAppStatusStateIdle {
+		becameIdle := len(latestAppStatus) == 0 || latestAppStatus[0].State != database.WorkspaceAppStatusStateIdle
+		api.advanceTaskSteps(workspace, workspaceAgent.ID, app.ID, becameIdle)
+	}
+
 	httpapi.Write(ctx, rw, http.StatusOK, nil)
 }
 
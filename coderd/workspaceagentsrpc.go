@@ -155,6 +155,7 @@ func (api *API) workspaceAgentRPC(rw http.ResponseWriter, r *http.Request) {
 		DerpMapUpdateFrequency:    api.Options.DERPMapUpdateFrequency,
 		ExternalAuthConfigs:       api.ExternalAuthConfigs,
 		Experiments:               api.Experiments,
+		SessionRecordingMaxSize:   api.DeploymentValues.SessionRecordingMaxSize.Value() * 1024 * 1024,
 
 		// Optional:
 		UpdateAgentMetricsFn: api.UpdateAgentMetrics,
@@ -399,6 +399,13 @@ func (api *API) postWorkspaceBuildsInternal(
 			})
 		}
 
+		// Starting a stopped task counts towards its owner's running tasks.
+		if transition == database.WorkspaceTransitionStart && previousWorkspaceBuild.HasAITask.Bool {
+			if err := api.checkRunningTaskLimit(ctx, tx, workspace.OwnerID, workspace.ID); err != nil {
+				return err
+			}
+		}
+
 		if createBuild.TemplateVersionID != uuid.Nil {
 			builder = builder.VersionID(createBuild.TemplateVersionID)
 		}
@@ -472,9 +479,26 @@ func (api *API) postWorkspaceBuildsInternal(
 			if err != nil {
 				api.Logger.Error(ctx, "failed to marshal build resource info for audit", slog.Error(err))
 			}
+			previousParameters, err := api.Database.GetWorkspaceBuildParameters(ctx, previousWorkspaceBuild.ID)
+			if err != nil {
+				api.Logger.Error(ctx, "failed to get previous build parameters for audit", slog.Error(err))
+			}
+			parameters, err := api.Database.GetWorkspaceBuildParameters(ctx, workspaceBuild.ID)
+			if err != nil {
+				api.Logger.Error(ctx, "failed to get build parameters for audit", slog.Error(err))
+			}
+			// Without the template version parameters, every value is redacted.
+			previousTemplateParameters, err := api.Database.GetTemplateVersionParameters(ctx, previousWorkspaceBuild.TemplateVersionID)
+			if err != nil {
+				api.Logger.Error(ctx, "failed to get previous template version parameters for audit", slog.Error(err))
+			}
+			templateParameters, err := api.Database.GetTemplateVersionParameters(ctx, workspaceBuild.TemplateVersionID)
+			if err != nil {
+				api.Logger.Error(ctx, "failed to get template version parameters for audit", slog.Error(err))
+			}
 			auditor := api.Auditor.Load()
 			bag := audit.BaggageFromContext(ctx)
-			audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.WorkspaceBuild]{
+			audit.BackgroundAudit(ctx, &audit.BackgroundAuditParams[database.AuditableWorkspaceBuild]{
 				Audit:            *auditor,
 				Log:              api.Logger,
 				UserID:           provisionerJob.InitiatorID,
@@ -482,8 +506,8 @@ func (api *API) postWorkspaceBuildsInternal(
 				RequestID:        provisionerJob.ID,
 				IP:               bag.IP,
 				Action:           database.AuditActionDelete,
-				Old:              previousWorkspaceBuild,
-				New:              *workspaceBuild,
+				Old:              previousWorkspaceBuild.Auditable(previousParameters, previousTemplateParameters),
+				New:              workspaceBuild.Auditable(parameters, templateParameters),
 				Status:           http.StatusOK,
 				AdditionalFields: briBytes,
 			})
@@ -0,0 +1,143 @@
+package coderd
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/coder/coder/v2/coderd/autobuild"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// workspaceLifecycleDecisionsLimit is the number of recent lifecycle
+// decisions returned for a workspace.
+const workspaceLifecycleDecisionsLimit = 20
+
+// @Summary Get workspace lifecycle
+// @Description Returns the upcoming automatic transitions of a workspace, and
+// @Description the recent decisions made about it by the autobuild lifecycle executor.
+// @ID get-workspace-lifecycle
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {object} codersdk.WorkspaceLifecycle
+// @Router /workspaces/{workspace}/lifecycle [get]
+func (api *API) workspaceLifecycle(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	// nolint:gocritic // The caller may not be able to read the owner, but
+	// the owner's status determines whether the workspace is autostarted.
+	owner, err := api.Database.GetUserByID(dbauthz.AsSystemRestricted(ctx), workspace.OwnerID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace owner.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	build, err := api.Database.GetLatestWorkspaceBuildByWorkspaceID(ctx, workspace.ID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching latest workspace build.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	job, err := api.Database.GetProvisionerJobByID(ctx, build.JobID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching latest workspace build job.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	templateSchedule, err := (*api.TemplateScheduleStore.Load()).Get(ctx, api.Database, workspace.TemplateID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting template schedule options.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	windows, err := schedule.GetWorkspaceWindows(ctx, api.Database, workspace.WorkspaceTable())
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace schedule windows.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if windows.HasAutostart() {
+		templateSchedule.AutostartExceptions, err = schedule.GetAutostartExceptions(ctx, api.Database, workspace.OrganizationID, workspace.ID)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching autostart exceptions.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+	}
+
+	decisions, err := api.Database.GetWorkspaceLifecycleDecisionsByWorkspaceID(ctx, database.GetWorkspaceLifecycleDecisionsByWorkspaceIDParams{
+		WorkspaceID: workspace.ID,
+		LimitCount:  workspaceLifecycleDecisionsLimit,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace lifecycle decisions.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := codersdk.WorkspaceLifecycle{
+		DryRun:    api.DeploymentValues.AutobuildDryRun.Value(),
+		Upcoming:  autobuild.UpcomingTransitions(owner, workspace, windows, build, job, templateSchedule),
+		Decisions: make([]codersdk.WorkspaceLifecycleDecision, 0, len(decisions)),
+	}
+	if resp.Upcoming == nil {
+		resp.Upcoming = []codersdk.WorkspaceLifecycleTransition{}
+	}
+	for _, d := range decisions {
+		decision, err := convertWorkspaceLifecycleDecision(d)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error converting workspace lifecycle decision.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		resp.Decisions = append(resp.Decisions, decision)
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+func convertWorkspaceLifecycleDecision(d database.WorkspaceLifecycleDecision) (codersdk.WorkspaceLifecycleDecision, error) {
+	decision := codersdk.WorkspaceLifecycleDecision{
+		ID:        d.ID,
+		CreatedAt: d.CreatedAt,
+		Outcome:   codersdk.WorkspaceLifecycleOutcome(d.Outcome),
+		Checks:    []codersdk.WorkspaceLifecycleCheck{},
+		Detail:    d.Detail,
+	}
+	if d.Transition.Valid {
+		decision.Transition = codersdk.WorkspaceTransition(d.Transition.WorkspaceTransition)
+	}
+	if d.BuildReason.Valid {
+		decision.Reason = codersdk.BuildReason(d.BuildReason.BuildReason)
+	}
+	if len(d.Checks) > 0 {
+		if err := json.Unmarshal(d.Checks, &decision.Checks); err != nil {
+			return codersdk.WorkspaceLifecycleDecision{}, err
+		}
+	}
+	return decision, nil
+}
@@ -0,0 +1,69 @@
+package coderd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	memberClient, member := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	// Given: a running workspace with a deadline
+	deadline := dbtime.Now().Add(time.Hour)
+	r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Seed(database.WorkspaceBuild{
+		Transition: database.WorkspaceTransitionStart,
+		Deadline:   deadline,
+	}).Do()
+
+	// Given: a decision made about it
+	decision, err := db.InsertWorkspaceLifecycleDecision(ctx, database.InsertWorkspaceLifecycleDecisionParams{
+		ID:          uuid.New(),
+		WorkspaceID: r.Workspace.ID,
+		CreatedAt:   dbtime.Now(),
+		Outcome:     database.WorkspaceLifecycleOutcomeSkipped,
+		Checks:      []byte(`[{"check":"autostop","passed":false,"detail":"deadline is in an hour"}]`),
+		Detail:      "last transition not valid for autostart or autostop",
+	})
+	require.NoError(t, err)
+
+	lifecycle, err := memberClient.WorkspaceLifecycle(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+
+	// Then: the workspace is stopped at its deadline
+	require.False(t, lifecycle.DryRun)
+	require.Len(t, lifecycle.Upcoming, 1)
+	require.Equal(t, codersdk.WorkspaceTransitionStop, lifecycle.Upcoming[0].Transition)
+	require.Equal(t, codersdk.BuildReasonAutostop, lifecycle.Upcoming[0].Reason)
+	require.WithinDuration(t, deadline, lifecycle.Upcoming[0].At, time.Second)
+
+	// Then: the decision is returned
+	require.Len(t, lifecycle.Decisions, 1)
+	require.Equal(t, decision.ID, lifecycle.Decisions[0].ID)
+	require.Equal(t, codersdk.WorkspaceLifecycleOutcomeSkipped, lifecycle.Decisions[0].Outcome)
+	require.Empty(t, lifecycle.Decisions[0].Transition)
+	require.Equal(t, decision.Detail, lifecycle.Decisions[0].Detail)
+	require.Equal(t, []codersdk.WorkspaceLifecycleCheck{{
+		Check:  "autostop",
+		Passed: false,
+		Detail: "deadline is in an hour",
+	}}, lifecycle.Decisions[0].Checks)
+}
@@ -388,7 +388,7 @@ func (api *API) postWorkspacesByOrganization(rw http.ResponseWriter, r *http.Req
 		AvatarURL: member.AvatarURL,
 	}
 
-	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, req, r)
+	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, req, r, nil)
 	if err != nil {
 		httperror.WriteResponseError(ctx, rw, err)
 		return
@@ -484,7 +484,7 @@ func (api *API) postUserWorkspaces(rw http.ResponseWriter, r *http.Request) {
 
 	defer commitAudit()
 
-	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, req, r)
+	w, err := createWorkspace(ctx, aReq, apiKey.UserID, api, owner, req, r, nil)
 	if err != nil {
 		httperror.WriteResponseError(ctx, rw, err)
 		return
@@ -499,6 +499,9 @@ type workspaceOwner struct {
 	AvatarURL string
 }
 
+// createWorkspace creates a workspace and starts its first build. If inTx is
+// not nil, it is called with the new workspace in the same transaction, so that
+// records which belong to the workspace are created along with it.
 func createWorkspace(
 	ctx context.Context,
 	auditReq *audit.Request[database.WorkspaceTable],
@@ -507,6 +510,7 @@ func createWorkspace(
 	owner workspaceOwner,
 	req codersdk.CreateWorkspaceRequest,
 	r *http.Request,
+	inTx func(db database.Store, workspace database.Workspace) error,
 ) (codersdk.Workspace, error) {
 	template, err := requestTemplate(ctx, req, api.Database)
 	if err != nil {
@@ -574,6 +578,15 @@ func createWorkspace(
 
 	nextStartAt := sql.NullTime{}
 	if dbAutostartSchedule.Valid {
+		// The workspace does not exist yet, so it cannot have opted out of
+		// the organization's autostart exceptions.
+		templateSchedule.AutostartExceptions, err = schedule.GetAutostartExceptions(ctx, api.Database, template.OrganizationID, uuid.Nil)
+		if err != nil {
+			return codersdk.Workspace{}, httperror.NewResponseError(http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching autostart exceptions.",
+				Detail:  err.Error(),
+			})
+		}
 		next, err := schedule.NextAllowedAutostart(dbtime.Now(), dbAutostartSchedule.String, templateSchedule)
 		if err == nil {
 			nextStartAt = sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
@@ -627,6 +640,9 @@ func createWorkspace(
 		provisionerJob     *database.ProvisionerJob
 		workspaceBuild     *database.WorkspaceBuild
 		provisionerDaemons []database.GetEligibleProvisionerDaemonsByProvisionerJobIDsRow
+		// prebuildClaim records the attempt to claim a prebuilt workspace, if
+		// one was made.
+		prebuildClaim *database.InsertPrebuildClaimParams
 	)
 
 	err = api.Database.InTx(func(db database.Store) error {
@@ -634,7 +650,10 @@ func createWorkspace(
 			prebuildsClaimer = *api.PrebuildsClaimer.Load()
 			workspaceID      uuid.UUID
 			claimedWorkspace *database.Workspace
+			claimAttempted   bool
+			claimLatency     time.Duration
 		)
+		prebuildClaim = nil
 
 		// Use injected Clock to allow time mocking in tests
 		now := dbtime.Time(api.Clock.Now())
@@ -665,9 +684,13 @@ func createWorkspace(
 			// Try and claim an eligible prebuild, if available.
 			// On successful claim, initialize all lifecycle fields from template and workspace-level config
 			// so the newly claimed workspace is properly managed by the lifecycle executor.
+			claimStart := api.Clock.Now()
 			claimedWorkspace, err = claimPrebuild(
 				ctx, prebuildsClaimer, db, api.Logger, now, req.Name, owner,
 				templateVersionPresetID, dbAutostartSchedule, nextStartAt, dbTTL)
+			claimLatency = api.Clock.Since(claimStart)
+			// Only deployments which support prebuilds record claim attempts.
+			claimAttempted = !errors.Is(err, prebuilds.ErrAGPLDoesNotSupportPrebuiltWorkspaces)
 			// If claiming fails with an expected error (no claimable prebuilds or AGPL does not support prebuilds),
 			// we fall back to creating a new workspace. Otherwise, propagate the unexpected error.
 			if err != nil {
@@ -755,12 +778,47 @@ func createWorkspace(
 			},
 			audit.WorkspaceBuildBaggageFromRequest(r),
 		)
-		return err
+		if err != nil {
+			return err
+		}
+
+		if inTx != nil {
+			if err := inTx(db, workspace); err != nil {
+				return err
+			}
+		}
+
+		if claimAttempted {
+			prebuildClaim = &database.InsertPrebuildClaimParams{
+				ID:          uuid.New(),
+				PresetID:    templateVersionPresetID,
+				WorkspaceID: uuid.NullUUID{UUID: workspaceID, Valid: true},
+				BuildID:     uuid.NullUUID{UUID: workspaceBuild.ID, Valid: true},
+				Hit:         claimedWorkspace != nil,
+				// #nosec G115 - Safe conversion as claiming a prebuilt workspace takes far less than 24 days
+				ClaimLatencyMs: int32(claimLatency.Milliseconds()),
+				CreatedAt:      now,
+			}
+		}
+		return nil
 	}, nil)
 	if err != nil {
 		return codersdk.Workspace{}, err
 	}
 
+	if prebuildClaim != nil {
+		// Record whether the workspace was served from a prebuilt workspace,
+		// which prebuild autoscaling and insights are derived from. A failure to
+		// record it is logged rather than failing the request.
+		// nolint:gocritic // The user creating the workspace is not allowed to
+		// write prebuild claims.
+		err = api.Database.InsertPrebuildClaim(dbauthz.AsSystemRestricted(ctx), *prebuildClaim)
+		if err != nil {
+			api.Logger.Warn(ctx, "failed to record prebuild claim",
+				slog.F("template_version_preset_id", prebuildClaim.PresetID), slog.Error(err))
+		}
+	}
+
 	err = provisionerjobs.PostJob(api.Pubsub, *provisionerJob)
 	if err != nil {
 		// Client probably doesn't care about this error, so just log it.
@@ -1143,9 +1201,30 @@ func (api *API) putWorkspaceAutostart(rw http.ResponseWriter, r *http.Request) {
 	// Use injected Clock to allow time mocking in tests
 	now := api.Clock.Now()
 
+	// The new schedule replaces the workspace's first window, while any
+	// additional windows keep their schedules.
+	scheduledWorkspace := workspace.WorkspaceTable()
+	scheduledWorkspace.AutostartSchedule = dbSched
+	windows, err := schedule.GetWorkspaceWindows(ctx, api.Database, scheduledWorkspace)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace schedule windows.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
 	nextStartAt := sql.NullTime{}
-	if dbSched.Valid {
-		next, err := schedule.NextAllowedAutostart(now, dbSched.String, templateSchedule)
+	if windows.HasAutostart() {
+		templateSchedule.AutostartExceptions, err = schedule.GetAutostartExceptions(ctx, api.Database, workspace.OrganizationID, workspace.ID)
+		if err != nil {
+			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+				Message: "Internal error fetching autostart exceptions.",
+				Detail:  err.Error(),
+			})
+			return
+		}
+		next, _, err := windows.NextAllowedAutostart(now, templateSchedule)
 		if err != nil {
 			httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 				Message: "Internal error calculating workspace autostart schedule.",
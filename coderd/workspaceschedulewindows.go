@@ -0,0 +1,192 @@
+package coderd
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/httpapi"
+	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/schedule"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// @Summary Get workspace schedule windows
+// @ID get-workspace-schedule-windows
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Success 200 {array} codersdk.WorkspaceScheduleWindow
+// @Router /workspaces/{workspace}/schedule-windows [get]
+func (api *API) workspaceScheduleWindows(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	windows, err := api.Database.GetWorkspaceScheduleWindowsByWorkspaceID(ctx, workspace.ID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error fetching workspace schedule windows.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := make([]codersdk.WorkspaceScheduleWindow, 0, len(windows))
+	for _, w := range windows {
+		resp = append(resp, codersdk.WorkspaceScheduleWindow{
+			Schedule:  w.AutostartSchedule,
+			TTLMillis: convertWorkspaceTTLMillis(w.Ttl),
+		})
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Update workspace schedule windows
+// @ID update-workspace-schedule-windows
+// @Security CoderSessionToken
+// @Accept json
+// @Tags Workspaces
+// @Param workspace path string true "Workspace ID" format(uuid)
+// @Param request body codersdk.UpdateWorkspaceScheduleWindowsRequest true "Schedule windows update request"
+// @Success 204
+// @Router /workspaces/{workspace}/schedule-windows [put]
+func (api *API) putWorkspaceScheduleWindows(rw http.ResponseWriter, r *http.Request) {
+	var (
+		ctx       = r.Context()
+		workspace = httpmw.WorkspaceParam(r)
+	)
+
+	var req codersdk.UpdateWorkspaceScheduleWindowsRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	// Autostart configuration is not supported for prebuilt workspaces.
+	// Prebuild lifecycle is managed by the reconciliation loop, with scheduling behavior
+	// defined per preset at the template level, not per workspace.
+	if workspace.IsPrebuild() {
+		httpapi.Write(ctx, rw, http.StatusConflict, codersdk.Response{
+			Message: "Schedule windows are not supported for prebuilt workspaces",
+			Detail:  "Prebuilt workspace scheduling is configured per preset at the template level. Workspace-level overrides are not supported.",
+		})
+		return
+	}
+
+	templateSchedule, err := (*api.TemplateScheduleStore.Load()).Get(ctx, api.Database, workspace.TemplateID)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting template schedule options.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if len(req.Windows) > 0 && !templateSchedule.UserAutostartEnabled {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Autostart is not allowed for workspaces using this template.",
+			Validations: []codersdk.ValidationError{{Field: "windows", Detail: "Autostart is not allowed for workspaces using this template."}},
+		})
+		return
+	}
+
+	// Use injected Clock to allow time mocking in tests
+	now := dbtime.Time(api.Clock.Now())
+
+	params := make([]database.InsertWorkspaceScheduleWindowParams, 0, len(req.Windows))
+	var validations []codersdk.ValidationError
+	for i, w := range req.Windows {
+		sched, err := validWorkspaceSchedule(&w.Schedule)
+		if err == nil && !sched.Valid {
+			err = xerrors.New("schedule is required")
+		}
+		if err != nil {
+			validations = append(validations, codersdk.ValidationError{Field: fmt.Sprintf("windows[%d].schedule", i), Detail: err.Error()})
+			continue
+		}
+		if w.TTLMillis != nil && !templateSchedule.UserAutostopEnabled {
+			validations = append(validations, codersdk.ValidationError{Field: fmt.Sprintf("windows[%d].ttl_ms", i), Detail: "Custom autostop TTL is not allowed for workspaces using this template."})
+			continue
+		}
+		// don't override 0 ttl with template default here because it
+		// indicates disabled autostop
+		ttl, err := validWorkspaceTTLMillis(w.TTLMillis, 0)
+		if err != nil {
+			validations = append(validations, codersdk.ValidationError{Field: fmt.Sprintf("windows[%d].ttl_ms", i), Detail: err.Error()})
+			continue
+		}
+		params = append(params, database.InsertWorkspaceScheduleWindowParams{
+			WorkspaceID: workspace.ID,
+			// The workspace's own schedule is its first window.
+			Position:          int32(i + 1), //nolint:gosec // Bounded by the request size.
+			AutostartSchedule: sched.String,
+			Ttl:               ttl,
+			CreatedAt:         now,
+		})
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid schedule windows.",
+			Validations: validations,
+		})
+		return
+	}
+
+	err = api.Database.InTx(func(tx database.Store) error {
+		if err := tx.DeleteWorkspaceScheduleWindowsByWorkspaceID(ctx, workspace.ID); err != nil {
+			return xerrors.Errorf("delete schedule windows: %w", err)
+		}
+		additional := make([]database.WorkspaceScheduleWindow, 0, len(params))
+		for _, p := range params {
+			w, err := tx.InsertWorkspaceScheduleWindow(ctx, p)
+			if err != nil {
+				return xerrors.Errorf("insert schedule window: %w", err)
+			}
+			additional = append(additional, w)
+		}
+
+		nextStartAt := sql.NullTime{}
+		windows := schedule.WorkspaceWindows(workspace.WorkspaceTable(), additional)
+		if windows.HasAutostart() {
+			exceptions, err := schedule.GetAutostartExceptions(ctx, tx, workspace.OrganizationID, workspace.ID)
+			if err != nil {
+				return xerrors.Errorf("get autostart exceptions: %w", err)
+			}
+			templateSchedule.AutostartExceptions = exceptions
+			// If no window can autostart, the lifecycle executor is left to
+			// retry.
+			if next, _, err := windows.NextAllowedAutostart(now, templateSchedule); err == nil {
+				nextStartAt = sql.NullTime{Valid: true, Time: dbtime.Time(next.UTC())}
+			}
+		}
+		if err := tx.UpdateWorkspaceNextStartAt(ctx, database.UpdateWorkspaceNextStartAtParams{
+			ID:          workspace.ID,
+			NextStartAt: nextStartAt,
+		}); err != nil {
+			return xerrors.Errorf("update next start at: %w", err)
+		}
+		return nil
+	}, nil)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating workspace schedule windows.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,76 @@
+package coderd_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/coderd/util/ptr"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestWorkspaceScheduleWindows(t *testing.T) {
+	t.Parallel()
+
+	client, db := coderdtest.NewWithDatabase(t, nil)
+	owner := coderdtest.CreateFirstUser(t, client)
+	memberClient, member := coderdtest.CreateAnotherUser(t, client, owner.OrganizationID)
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+
+	// Given: a workspace without an autostart schedule
+	r := dbfake.WorkspaceBuild(t, db, database.WorkspaceTable{
+		OwnerID:        member.ID,
+		OrganizationID: owner.OrganizationID,
+	}).Do()
+
+	// When: an invalid window is set
+	err := memberClient.UpdateWorkspaceScheduleWindows(ctx, r.Workspace.ID, codersdk.UpdateWorkspaceScheduleWindowsRequest{
+		Windows: []codersdk.WorkspaceScheduleWindow{{Schedule: "CRON_TZ=UTC 0 14 * * *"}, {Schedule: "not a schedule"}},
+	})
+	// Then: it is rejected
+	var sdkErr *codersdk.Error
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, http.StatusBadRequest, sdkErr.StatusCode())
+	require.Len(t, sdkErr.Validations, 1)
+	require.Equal(t, "windows[1].schedule", sdkErr.Validations[0].Field)
+
+	// When: a valid window is set
+	window := codersdk.WorkspaceScheduleWindow{
+		Schedule:  "CRON_TZ=UTC 0 14 * * *",
+		TTLMillis: ptr.Ref((10 * time.Hour).Milliseconds()),
+	}
+	err = memberClient.UpdateWorkspaceScheduleWindows(ctx, r.Workspace.ID, codersdk.UpdateWorkspaceScheduleWindowsRequest{
+		Windows: []codersdk.WorkspaceScheduleWindow{window},
+	})
+	require.NoError(t, err)
+
+	// Then: it is returned
+	windows, err := memberClient.WorkspaceScheduleWindows(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.Equal(t, []codersdk.WorkspaceScheduleWindow{window}, windows)
+
+	// Then: the workspace is next autostarted by the window
+	ws, err := memberClient.Workspace(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.NotNil(t, ws.NextStartAt)
+	require.Equal(t, 14, ws.NextStartAt.UTC().Hour())
+
+	// When: the windows are removed
+	err = memberClient.UpdateWorkspaceScheduleWindows(ctx, r.Workspace.ID, codersdk.UpdateWorkspaceScheduleWindowsRequest{})
+	require.NoError(t, err)
+
+	// Then: the workspace is no longer autostarted
+	windows, err = memberClient.WorkspaceScheduleWindows(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.Empty(t, windows)
+	ws, err = memberClient.Workspace(ctx, r.Workspace.ID)
+	require.NoError(t, err)
+	require.Nil(t, ws.NextStartAt)
+}
@@ -49,6 +49,7 @@ const (
 	WorkspaceEventKindStatsUpdate     WorkspaceEventKind = "stats_update"
 	WorkspaceEventKindMetadataUpdate  WorkspaceEventKind = "mtd_update"
 	WorkspaceEventKindAppHealthUpdate WorkspaceEventKind = "app_health"
+	WorkspaceEventKindTaskStepUpdate  WorkspaceEventKind = "task_step_update"
 
 	WorkspaceEventKindAgentLifecycleUpdate  WorkspaceEventKind = "agt_lifecycle_update"
 	WorkspaceEventKindAgentConnectionUpdate WorkspaceEventKind = "agt_connection_update"
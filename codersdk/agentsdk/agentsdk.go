@@ -127,6 +127,16 @@ type Manifest struct {
 	Metadata                 []codersdk.WorkspaceAgentMetadataDescription `json:"metadata"`
 	Scripts                  []codersdk.WorkspaceAgentScript              `json:"scripts"`
 	Devcontainers            []codersdk.WorkspaceAgentDevcontainer        `json:"devcontainers"`
+	SessionRecording         SessionRecordingConfig                       `json:"session_recording"`
+}
+
+// SessionRecordingConfig controls whether the agent records SSH and
+// reconnecting PTY sessions and uploads them to coderd.
+type SessionRecordingConfig struct {
+	Enabled bool `json:"enabled"`
+	// MaxSizeBytes is the maximum size of a single recording. Output past
+	// this limit is dropped and the recording is marked as truncated.
+	MaxSizeBytes int64 `json:"max_size_bytes"`
 }
 
 type LogSource struct {
@@ -256,6 +266,18 @@ func (c *Client) ConnectRPC26(ctx context.Context) (
 	return proto.NewDRPCAgentClient(conn), tailnetproto.NewDRPCTailnetClient(conn), nil
 }
 
+// ConnectRPC27 returns a dRPC client to the Agent API v2.7. It is useful when you want to be
+// maximally compatible with Coderd Release Versions from 2.28+
+func (c *Client) ConnectRPC27(ctx context.Context) (
+	proto.DRPCAgentClient27, tailnetproto.DRPCTailnetClient27, error,
+) {
+	conn, err := c.connectRPCVersion(ctx, apiversion.New(2, 7))
+	if err != nil {
+		return nil, nil, err
+	}
+	return proto.NewDRPCAgentClient(conn), tailnetproto.NewDRPCTailnetClient(conn), nil
+}
+
 // ConnectRPC connects to the workspace agent API and tailnet API
 func (c *Client) ConnectRPC(ctx context.Context) (drpc.Conn, error) {
 	return c.connectRPCVersion(ctx, proto.CurrentVersion)
@@ -62,6 +62,10 @@ func ManifestFromProto(manifest *proto.Manifest) (Manifest, error) {
 		DisableDirectConnections: manifest.DisableDirectConnections,
 		Metadata:                 MetadataDescriptionsFromProto(manifest.Metadata),
 		Devcontainers:            devcontainers,
+		SessionRecording: SessionRecordingConfig{
+			Enabled:      manifest.GetSessionRecording().GetEnabled(),
+			MaxSizeBytes: manifest.GetSessionRecording().GetMaxSizeBytes(),
+		},
 	}, nil
 }
 
@@ -90,6 +94,10 @@ func ProtoFromManifest(manifest Manifest) (*proto.Manifest, error) {
 		Apps:                     apps,
 		Metadata:                 ProtoFromMetadataDescriptions(manifest.Metadata),
 		Devcontainers:            ProtoFromDevcontainers(manifest.Devcontainers),
+		SessionRecording: &proto.SessionRecordingConfig{
+			Enabled:      manifest.SessionRecording.Enabled,
+			MaxSizeBytes: manifest.SessionRecording.MaxSizeBytes,
+		},
 	}, nil
 }
 
@@ -138,6 +138,10 @@ func TestManifest(t *testing.T) {
 				ConfigPath:      "/home/coder/coder/.devcontainer/devcontainer.json",
 			},
 		},
+		SessionRecording: agentsdk.SessionRecordingConfig{
+			Enabled:      true,
+			MaxSizeBytes: 50 << 20,
+		},
 	}
 	p, err := agentsdk.ProtoFromManifest(manifest)
 	require.NoError(t, err)
@@ -162,6 +166,7 @@ func TestManifest(t *testing.T) {
 	require.Equal(t, manifest.Metadata, back.Metadata)
 	require.Equal(t, manifest.Scripts, back.Scripts)
 	require.Equal(t, manifest.Devcontainers, back.Devcontainers)
+	require.Equal(t, manifest.SessionRecording, back.SessionRecording)
 }
 
 func TestSubsystems(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -12,15 +13,16 @@ import (
 )
 
 type AIBridgeInterception struct {
-	ID          uuid.UUID            `json:"id" format:"uuid"`
-	InitiatorID uuid.UUID            `json:"initiator_id" format:"uuid"`
-	Provider    string               `json:"provider"`
-	Model       string               `json:"model"`
-	Metadata    map[string]any       `json:"metadata"`
-	StartedAt   time.Time            `json:"started_at" format:"date-time"`
-	TokenUsages []AIBridgeTokenUsage `json:"token_usages"`
-	UserPrompts []AIBridgeUserPrompt `json:"user_prompts"`
-	ToolUsages  []AIBridgeToolUsage  `json:"tool_usages"`
+	ID              uuid.UUID                `json:"id" format:"uuid"`
+	InitiatorID     uuid.UUID                `json:"initiator_id" format:"uuid"`
+	Provider        string                   `json:"provider"`
+	Model           string                   `json:"model"`
+	Metadata        map[string]any           `json:"metadata"`
+	StartedAt       time.Time                `json:"started_at" format:"date-time"`
+	TokenUsages     []AIBridgeTokenUsage     `json:"token_usages"`
+	UserPrompts     []AIBridgeUserPrompt     `json:"user_prompts"`
+	ToolUsages      []AIBridgeToolUsage      `json:"tool_usages"`
+	PolicyDecisions []AIBridgePolicyDecision `json:"policy_decisions"`
 }
 
 type AIBridgeTokenUsage struct {
@@ -55,6 +57,22 @@ type AIBridgeToolUsage struct {
 	CreatedAt          time.Time      `json:"created_at" format:"date-time"`
 }
 
+type AIBridgePolicyAction string
+
+const (
+	AIBridgePolicyActionRedact AIBridgePolicyAction = "redact"
+	AIBridgePolicyActionBlock  AIBridgePolicyAction = "block"
+)
+
+type AIBridgePolicyDecision struct {
+	ID             uuid.UUID            `json:"id" format:"uuid"`
+	InterceptionID uuid.UUID            `json:"interception_id" format:"uuid"`
+	Policy         string               `json:"policy"`
+	Action         AIBridgePolicyAction `json:"action" enums:"redact,block"`
+	Reason         string               `json:"reason"`
+	CreatedAt      time.Time            `json:"created_at" format:"date-time"`
+}
+
 type AIBridgeListInterceptionsResponse struct {
 	Results []AIBridgeInterception `json:"results"`
 }
@@ -123,3 +141,307 @@ func (c *ExperimentalClient) AIBridgeListInterceptions(ctx context.Context, filt
 	var resp AIBridgeListInterceptionsResponse
 	return resp, json.NewDecoder(res.Body).Decode(&resp)
 }
+
+type AIBridgeLimitScope string
+
+const (
+	AIBridgeLimitScopeUser         AIBridgeLimitScope = "user"
+	AIBridgeLimitScopeGroup        AIBridgeLimitScope = "group"
+	AIBridgeLimitScopeOrganization AIBridgeLimitScope = "organization"
+)
+
+// AIBridgeLimit is a set of token budgets and request rate limits enforced by
+// AI Bridge. Group and organization limits are shared by all of their members.
+// A nil limit is unlimited.
+type AIBridgeLimit struct {
+	Scope              AIBridgeLimitScope `json:"scope" enums:"user,group,organization"`
+	ScopeID            uuid.UUID          `json:"scope_id" format:"uuid"`
+	DailyTokenBudget   *int64             `json:"daily_token_budget,omitempty"`
+	MonthlyTokenBudget *int64             `json:"monthly_token_budget,omitempty"`
+	RequestsPerMinute  *int32             `json:"requests_per_minute,omitempty"`
+	CreatedAt          time.Time          `json:"created_at" format:"date-time"`
+	UpdatedAt          time.Time          `json:"updated_at" format:"date-time"`
+}
+
+type UpsertAIBridgeLimitRequest struct {
+	DailyTokenBudget   *int64 `json:"daily_token_budget,omitempty"`
+	MonthlyTokenBudget *int64 `json:"monthly_token_budget,omitempty"`
+	RequestsPerMinute  *int32 `json:"requests_per_minute,omitempty"`
+}
+
+// AIBridgeLimitUsage is a limit along with the usage counted against it.
+// Token budgets are counted per UTC day and calendar month.
+type AIBridgeLimitUsage struct {
+	Limit              AIBridgeLimit `json:"limit"`
+	DailyTokens        int64         `json:"daily_tokens"`
+	MonthlyTokens      int64         `json:"monthly_tokens"`
+	RequestsLastMinute int64         `json:"requests_last_minute"`
+	DailyResetsAt      time.Time     `json:"daily_resets_at" format:"date-time"`
+	MonthlyResetsAt    time.Time     `json:"monthly_resets_at" format:"date-time"`
+	Exceeded           bool          `json:"exceeded"`
+}
+
+// AIBridgeUsage is a user's own AI Bridge usage, along with every limit which
+// applies to them.
+type AIBridgeUsage struct {
+	UserID             uuid.UUID            `json:"user_id" format:"uuid"`
+	DailyTokens        int64                `json:"daily_tokens"`
+	MonthlyTokens      int64                `json:"monthly_tokens"`
+	RequestsLastMinute int64                `json:"requests_last_minute"`
+	Limits             []AIBridgeLimitUsage `json:"limits"`
+}
+
+// AIBridgeLimits returns every AI Bridge limit in the deployment.
+func (c *ExperimentalClient) AIBridgeLimits(ctx context.Context) ([]AIBridgeLimit, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/experimental/aibridge/limits", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var resp []AIBridgeLimit
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// UpsertAIBridgeLimit creates or replaces the AI Bridge limit for the given
+// user, group or organization.
+func (c *ExperimentalClient) UpsertAIBridgeLimit(ctx context.Context, scope AIBridgeLimitScope, scopeID uuid.UUID, req UpsertAIBridgeLimitRequest) (AIBridgeLimit, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/experimental/aibridge/limits/%s/%s", scope, scopeID), req)
+	if err != nil {
+		return AIBridgeLimit{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeLimit{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeLimit
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// DeleteAIBridgeLimit removes the AI Bridge limit for the given user, group or
+// organization.
+func (c *ExperimentalClient) DeleteAIBridgeLimit(ctx context.Context, scope AIBridgeLimitScope, scopeID uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/experimental/aibridge/limits/%s/%s", scope, scopeID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+// AIBridgeUsage returns the AI Bridge usage of the given user, and of every
+// limit which applies to them.
+func (c *ExperimentalClient) AIBridgeUsage(ctx context.Context, user string) (AIBridgeUsage, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/experimental/aibridge/users/%s/usage", user), nil)
+	if err != nil {
+		return AIBridgeUsage{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeUsage{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeUsage
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// AIBridgeCaptureSettings controls whether the full requests and responses
+// bridged for a user are captured for debugging.
+type AIBridgeCaptureSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+// AIBridgeCaptureRedactedValue replaces the values of captured headers which
+// carry credentials.
+const AIBridgeCaptureRedactedValue = "<redacted>"
+
+// AIBridgeInterceptionCapture is the request which started an interception and
+// the response to it, as bridged. Credentials and well-known secrets are
+// redacted, and bodies are truncated to the deployment's maximum size.
+type AIBridgeInterceptionCapture struct {
+	InterceptionID        uuid.UUID           `json:"interception_id" format:"uuid"`
+	Method                string              `json:"method"`
+	Path                  string              `json:"path"`
+	RequestHeaders        map[string][]string `json:"request_headers"`
+	RequestBody           string              `json:"request_body"`
+	RequestBodyTruncated  bool                `json:"request_body_truncated"`
+	ResponseStatus        int                 `json:"response_status"`
+	ResponseHeaders       map[string][]string `json:"response_headers"`
+	ResponseBody          string              `json:"response_body"`
+	ResponseBodyTruncated bool                `json:"response_body_truncated"`
+	CreatedAt             time.Time           `json:"created_at" format:"date-time"`
+}
+
+// AIBridgeCaptureSettings returns whether AI Bridge requests of the given user
+// are captured.
+func (c *ExperimentalClient) AIBridgeCaptureSettings(ctx context.Context, user string) (AIBridgeCaptureSettings, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/experimental/aibridge/users/%s/capture", user), nil)
+	if err != nil {
+		return AIBridgeCaptureSettings{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeCaptureSettings{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeCaptureSettings
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// UpdateAIBridgeCaptureSettings opts the given user in to or out of AI Bridge
+// request capture.
+func (c *ExperimentalClient) UpdateAIBridgeCaptureSettings(ctx context.Context, user string, req AIBridgeCaptureSettings) (AIBridgeCaptureSettings, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/experimental/aibridge/users/%s/capture", user), req)
+	if err != nil {
+		return AIBridgeCaptureSettings{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeCaptureSettings{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeCaptureSettings
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// AIBridgeInterceptionCapture returns the captured request and response of
+// the given interception.
+func (c *ExperimentalClient) AIBridgeInterceptionCapture(ctx context.Context, interceptionID uuid.UUID) (AIBridgeInterceptionCapture, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/experimental/aibridge/interceptions/%s/capture", interceptionID), nil)
+	if err != nil {
+		return AIBridgeInterceptionCapture{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeInterceptionCapture{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeInterceptionCapture
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// AIBridgeModelPrice is the price of a model's tokens, used to estimate the
+// cost of AI Bridge usage. A model of "*" prices every model of the provider
+// which has no price of its own. Prices are in whichever currency the
+// deployment chooses.
+type AIBridgeModelPrice struct {
+	Provider                    string  `json:"provider"`
+	Model                       string  `json:"model"`
+	InputPricePerMillionTokens  float64 `json:"input_price_per_million_tokens"`
+	OutputPricePerMillionTokens float64 `json:"output_price_per_million_tokens"`
+}
+
+type UpdateAIBridgeModelPricesRequest struct {
+	Prices []AIBridgeModelPrice `json:"prices"`
+}
+
+// AIBridgeModelPrices returns the price table used to estimate the cost of AI
+// Bridge usage.
+func (c *ExperimentalClient) AIBridgeModelPrices(ctx context.Context) ([]AIBridgeModelPrice, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/experimental/aibridge/prices", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var resp []AIBridgeModelPrice
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+// UpdateAIBridgeModelPrices replaces the price table used to estimate the cost
+// of AI Bridge usage.
+func (c *ExperimentalClient) UpdateAIBridgeModelPrices(ctx context.Context, req UpdateAIBridgeModelPricesRequest) ([]AIBridgeModelPrice, error) {
+	res, err := c.Request(ctx, http.MethodPut, "/api/experimental/aibridge/prices", req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+	var resp []AIBridgeModelPrice
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
+type AIBridgeInsightsGroupBy string
+
+const (
+	AIBridgeInsightsGroupByUser     AIBridgeInsightsGroupBy = "user"
+	AIBridgeInsightsGroupByTemplate AIBridgeInsightsGroupBy = "template"
+	AIBridgeInsightsGroupByProvider AIBridgeInsightsGroupBy = "provider"
+	AIBridgeInsightsGroupByModel    AIBridgeInsightsGroupBy = "model"
+)
+
+// AIBridgeInsightsGroupByValues is every dimension AI Bridge usage may be
+// grouped by.
+var AIBridgeInsightsGroupByValues = []AIBridgeInsightsGroupBy{
+	AIBridgeInsightsGroupByUser,
+	AIBridgeInsightsGroupByTemplate,
+	AIBridgeInsightsGroupByProvider,
+	AIBridgeInsightsGroupByModel,
+}
+
+// AIBridgeInsightsRequest requests AI Bridge usage between two times, which
+// must be on the hour. Usage is grouped by every dimension if GroupBy is empty.
+type AIBridgeInsightsRequest struct {
+	StartTime time.Time                 `json:"start_time" format:"date-time"`
+	EndTime   time.Time                 `json:"end_time" format:"date-time"`
+	GroupBy   []AIBridgeInsightsGroupBy `json:"group_by"`
+}
+
+// AIBridgeInsightsRow is the usage of one group. Fields for dimensions which
+// were not grouped by are left empty, and usage made outside of a workspace
+// has the nil template ID.
+type AIBridgeInsightsRow struct {
+	UserID         uuid.UUID `json:"user_id" format:"uuid"`
+	Username       string    `json:"username"`
+	TemplateID     uuid.UUID `json:"template_id" format:"uuid"`
+	TemplateName   string    `json:"template_name"`
+	Provider       string    `json:"provider"`
+	Model          string    `json:"model"`
+	Requests       int64     `json:"requests"`
+	InputTokens    int64     `json:"input_tokens"`
+	OutputTokens   int64     `json:"output_tokens"`
+	EstimatedCost  float64   `json:"estimated_cost"`
+	UnpricedTokens int64     `json:"unpriced_tokens"`
+}
+
+// AIBridgeInsightsResponse is AI Bridge usage along with its estimated cost.
+// Tokens of models which have no price are counted as unpriced rather than
+// estimated.
+type AIBridgeInsightsResponse struct {
+	StartTime time.Time                 `json:"start_time" format:"date-time"`
+	EndTime   time.Time                 `json:"end_time" format:"date-time"`
+	GroupBy   []AIBridgeInsightsGroupBy `json:"group_by"`
+	Rows      []AIBridgeInsightsRow     `json:"rows"`
+	Total     AIBridgeInsightsRow       `json:"total"`
+}
+
+// AIBridgeInsights returns the AI Bridge usage of the deployment along with its
+// estimated cost.
+func (c *ExperimentalClient) AIBridgeInsights(ctx context.Context, req AIBridgeInsightsRequest) (AIBridgeInsightsResponse, error) {
+	qp := url.Values{}
+	qp.Add("start_time", req.StartTime.Format(time.RFC3339))
+	qp.Add("end_time", req.EndTime.Format(time.RFC3339))
+	if len(req.GroupBy) > 0 {
+		groupBy := make([]string, 0, len(req.GroupBy))
+		for _, g := range req.GroupBy {
+			groupBy = append(groupBy, string(g))
+		}
+		qp.Add("group_by", strings.Join(groupBy, ","))
+	}
+
+	res, err := c.Request(ctx, http.MethodGet, "/api/experimental/aibridge/insights?"+qp.Encode(), nil)
+	if err != nil {
+		return AIBridgeInsightsResponse{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return AIBridgeInsightsResponse{}, ReadBodyAsError(res)
+	}
+	var resp AIBridgeInsightsResponse
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
 
+	"github.com/coder/coder/v2/coderd/tracing"
 	"github.com/coder/terraform-provider-coder/v2/provider"
 )
 
@@ -65,6 +66,24 @@ type CreateTaskRequest struct {
 	TemplateVersionPresetID uuid.UUID `json:"template_version_preset_id,omitempty" format:"uuid"`
 	Input                   string    `json:"input"`
 	Name                    string    `json:"name,omitempty"`
+	// Steps defines a multi-step task. The first step's prompt starts the
+	// task, and each following prompt is sent once the agent reports the
+	// previous step idle. Input must be empty when steps are given.
+	Steps []CreateTaskStep `json:"steps,omitempty"`
+	// RichParameterValues are the values of the template's parameters other
+	// than the AI prompt, which is taken from the input.
+	RichParameterValues []WorkspaceBuildParameter `json:"rich_parameter_values,omitempty"`
+}
+
+// CreateTaskStep is a single step of a multi-step task.
+//
+// Experimental: This type is experimental and may change in the future.
+type CreateTaskStep struct {
+	Prompt string `json:"prompt"`
+	// CompletionCheck is an optional command which is run in the workspace
+	// once the agent reports the step idle. A non-zero exit code fails the
+	// task instead of moving on to the next step.
+	CompletionCheck string `json:"completion_check,omitempty"`
 }
 
 // CreateTask creates a new task.
@@ -122,12 +141,42 @@ type Task struct {
 	WorkspaceAgentHealth    *WorkspaceAgentHealth    `json:"workspace_agent_health" table:"workspace agent health"`
 	WorkspaceAppID          uuid.NullUUID            `json:"workspace_app_id" format:"uuid" table:"workspace app id"`
 	InitialPrompt           string                   `json:"initial_prompt" table:"initial prompt"`
+	Steps                   []TaskStep               `json:"steps,omitempty" table:"-"`
 	Status                  WorkspaceStatus          `json:"status" enums:"pending,starting,running,stopping,stopped,failed,canceling,canceled,deleting,deleted" table:"status"`
 	CurrentState            *TaskStateEntry          `json:"current_state" table:"cs,recursive_inline"`
 	CreatedAt               time.Time                `json:"created_at" format:"date-time" table:"created at"`
 	UpdatedAt               time.Time                `json:"updated_at" format:"date-time" table:"updated at"`
 }
 
+// TaskStepStatus represents the progress of a single step of a multi-step
+// task.
+//
+// Experimental: This type is experimental and may change in the future.
+type TaskStepStatus string
+
+// TaskStepStatus enums.
+const (
+	TaskStepStatusPending   TaskStepStatus = "pending"
+	TaskStepStatusRunning   TaskStepStatus = "running"
+	TaskStepStatusChecking  TaskStepStatus = "checking"
+	TaskStepStatusCompleted TaskStepStatus = "completed"
+	TaskStepStatusFailed    TaskStepStatus = "failed"
+)
+
+// TaskStep represents a single step of a multi-step task.
+//
+// Experimental: This type is experimental and may change in the future.
+type TaskStep struct {
+	Index           int32          `json:"index"`
+	Prompt          string         `json:"prompt"`
+	CompletionCheck string         `json:"completion_check,omitempty"`
+	Status          TaskStepStatus `json:"status" enums:"pending,running,checking,completed,failed"`
+	// CheckExitCode is the exit code of the completion check, if it has run.
+	CheckExitCode *int32     `json:"check_exit_code,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty" format:"date-time"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty" format:"date-time"`
+}
+
 // TaskStateEntry represents a single entry in the task's state history.
 //
 // Experimental: This type is experimental and may change in the future.
@@ -297,3 +346,95 @@ func (c *ExperimentalClient) TaskLogs(ctx context.Context, user string, id uuid.
 
 	return logs, nil
 }
+
+// TaskEventType indicates the kind of change a task event reports.
+//
+// Experimental: This type is experimental and may change in the future.
+type TaskEventType string
+
+// TaskEventType enums.
+const (
+	// TaskEventTypeStatus is sent with the current task when a watch starts,
+	// and whenever the status of the task's workspace, agent or steps
+	// changes.
+	TaskEventTypeStatus TaskEventType = "status"
+	// TaskEventTypeState is sent when the task's AI agent reports a new state.
+	TaskEventTypeState TaskEventType = "state"
+	// TaskEventTypeLog is sent for each new log entry of a task.
+	TaskEventTypeLog TaskEventType = "log"
+)
+
+// TaskEvent is a change to a watched task.
+//
+// Experimental: This type is experimental and may change in the future.
+type TaskEvent struct {
+	Type   TaskEventType `json:"type" enums:"status,state,log"`
+	TaskID uuid.UUID     `json:"task_id" format:"uuid"`
+	// Task is set for status and state events.
+	Task *Task `json:"task,omitempty"`
+	// Log is set for log events.
+	Log *TaskLogEntry `json:"log,omitempty"`
+}
+
+// WatchTask streams the changes to a task. The first event is a status event
+// with the current task. The channel is closed when the task is deleted or
+// the context is canceled.
+//
+// Experimental: This method is experimental and may change in the future.
+func (c *ExperimentalClient) WatchTask(ctx context.Context, user string, id uuid.UUID) (<-chan TaskEvent, error) {
+	return c.watchTaskEvents(ctx, fmt.Sprintf("/api/experimental/tasks/%s/%s/watch", user, id.String()))
+}
+
+// WatchTasks streams the changes to all of a user's tasks. A status event is
+// sent for each existing task when the watch starts.
+//
+// Experimental: This method is experimental and may change in the future.
+func (c *ExperimentalClient) WatchTasks(ctx context.Context, user string) (<-chan TaskEvent, error) {
+	return c.watchTaskEvents(ctx, fmt.Sprintf("/api/experimental/tasks/%s/watch", user))
+}
+
+func (c *ExperimentalClient) watchTaskEvents(ctx context.Context, path string) (<-chan TaskEvent, error) {
+	ctx, span := tracing.StartSpan(ctx)
+	defer span.End()
+	//nolint:bodyclose
+	res, err := c.Request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, ReadBodyAsError(res)
+	}
+	nextEvent := ServerSentEventReader(ctx, res.Body)
+
+	events := make(chan TaskEvent, 256)
+	go func() {
+		defer close(events)
+		defer res.Body.Close()
+
+		for {
+			sse, err := nextEvent()
+			if err != nil {
+				return
+			}
+			if sse.Type != ServerSentEventTypeData {
+				continue
+			}
+			b, ok := sse.Data.([]byte)
+			if !ok {
+				return
+			}
+			var event TaskEvent
+			if err := json.Unmarshal(b, &event); err != nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case events <- event:
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -45,6 +45,7 @@ const (
 	// connection log.
 	ResourceTypeWorkspaceApp ResourceType = "workspace_app"
 	ResourceTypeTask         ResourceType = "task"
+	ResourceTypeMCPTool      ResourceType = "mcp_tool"
 )
 
 func (r ResourceType) FriendlyString() string {
@@ -103,6 +104,8 @@ func (r ResourceType) FriendlyString() string {
 		return "workspace app"
 	case ResourceTypeTask:
 		return "task"
+	case ResourceTypeMCPTool:
+		return "mcp tool"
 	default:
 		return "unknown"
 	}
@@ -130,7 +133,8 @@ const (
 	// connection log.
 	AuditActionOpen AuditAction = "open"
 	// Deprecated: This action is unused.
-	AuditActionClose AuditAction = "close"
+	AuditActionClose  AuditAction = "close"
+	AuditActionInvoke AuditAction = "invoke"
 )
 
 func (a AuditAction) Friendly() string {
@@ -161,6 +165,8 @@ func (a AuditAction) Friendly() string {
 		return "opened"
 	case AuditActionClose:
 		return "closed"
+	case AuditActionInvoke:
+		return "invoked"
 	default:
 		return "unknown"
 	}
@@ -211,6 +217,38 @@ type AuditLogResponse struct {
 	Count     int64      `json:"count"`
 }
 
+// AuditLogVerifyResponse is the result of verifying the audit log hash chain.
+type AuditLogVerifyResponse struct {
+	// Verified is true if every entry in the hash chain is intact.
+	Verified bool `json:"verified"`
+	// Entries is the number of hash chain entries that were checked.
+	Entries  int64 `json:"entries"`
+	FirstSeq int64 `json:"first_seq"`
+	LastSeq  int64 `json:"last_seq"`
+	// LastHash is the hex-encoded hash of the last intact entry. Recording it
+	// elsewhere allows detecting audit logs removed from the end of the chain.
+	LastHash   string              `json:"last_hash"`
+	BrokenLink *AuditLogBrokenLink `json:"broken_link,omitempty"`
+	// Purged lists the entries whose audit logs were purged, which were
+	// verified from their checkpoints rather than their audit logs. Purges
+	// that retention does not account for should be investigated.
+	Purged []AuditLogPurgedRange `json:"purged"`
+}
+
+// AuditLogPurgedRange is a range of hash chain entries, inclusive, whose audit
+// logs were purged.
+type AuditLogPurgedRange struct {
+	FirstSeq int64 `json:"first_seq"`
+	LastSeq  int64 `json:"last_seq"`
+}
+
+// AuditLogBrokenLink is the first hash chain entry that failed verification.
+type AuditLogBrokenLink struct {
+	Seq        int64     `json:"seq"`
+	AuditLogID uuid.UUID `json:"audit_log_id" format:"uuid"`
+	Reason     string    `json:"reason"`
+}
+
 type CreateTestAuditLogRequest struct {
 	Action           AuditAction     `json:"action,omitempty" enums:"create,write,delete,start,stop"`
 	ResourceType     ResourceType    `json:"resource_type,omitempty" enums:"template,template_version,user,workspace,workspace_build,git_ssh_key,auditable_group"`
@@ -251,6 +289,23 @@ func (c *Client) AuditLogs(ctx context.Context, req AuditLogsRequest) (AuditLogR
 	return logRes, nil
 }
 
+// VerifyAuditLogs walks the audit log hash chain and reports the first broken
+// link, if any.
+func (c *Client) VerifyAuditLogs(ctx context.Context) (AuditLogVerifyResponse, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/v2/audit/verify", nil)
+	if err != nil {
+		return AuditLogVerifyResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return AuditLogVerifyResponse{}, ReadBodyAsError(res)
+	}
+
+	var resp AuditLogVerifyResponse
+	return resp, json.NewDecoder(res.Body).Decode(&resp)
+}
+
 // CreateTestAuditLog creates a fake audit log. Only owners of the organization
 // can perform this action. It's used for testing purposes.
 func (c *Client) CreateTestAuditLog(ctx context.Context, req CreateTestAuditLogRequest) error {
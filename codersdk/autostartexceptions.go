@@ -0,0 +1,156 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+// AutostartException is a named range of days on which workspaces are not
+// autostarted, such as a public holiday or a company shutdown. Dates are
+// interpreted in the timezone of each workspace's autostart schedule.
+type AutostartException struct {
+	Name string `json:"name"`
+	// StartDate is the first day of the exception, formatted as YYYY-MM-DD.
+	StartDate string `json:"start_date"`
+	// EndDate is the last day of the exception (inclusive), formatted as
+	// YYYY-MM-DD.
+	EndDate string `json:"end_date"`
+}
+
+// AutostartExceptionCalendar is a named set of autostart exceptions that
+// applies to every workspace in an organization.
+type AutostartExceptionCalendar struct {
+	ID             uuid.UUID            `json:"id" format:"uuid"`
+	OrganizationID uuid.UUID            `json:"organization_id" format:"uuid"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	Exceptions     []AutostartException `json:"exceptions"`
+	CreatedAt      time.Time            `json:"created_at" format:"date-time"`
+	UpdatedAt      time.Time            `json:"updated_at" format:"date-time"`
+}
+
+// UpsertAutostartExceptionCalendarRequest creates or replaces an autostart
+// exception calendar.
+type UpsertAutostartExceptionCalendarRequest struct {
+	Description string               `json:"description"`
+	Exceptions  []AutostartException `json:"exceptions"`
+}
+
+// WorkspaceAutostartExceptions describes the autostart exceptions that apply
+// to a workspace.
+type WorkspaceAutostartExceptions struct {
+	// Ignore is true if the workspace is autostarted even on the days
+	// excluded by its organization's exception calendars.
+	Ignore bool `json:"ignore"`
+	// Upcoming are the organization's exceptions that have not yet ended.
+	Upcoming []AutostartException `json:"upcoming"`
+}
+
+// UpdateWorkspaceAutostartExceptionsRequest opts a workspace in or out of its
+// organization's autostart exception calendars.
+type UpdateWorkspaceAutostartExceptionsRequest struct {
+	Ignore bool `json:"ignore"`
+}
+
+// AutostartExceptionCalendars returns the autostart exception calendars of an
+// organization.
+func (c *Client) AutostartExceptionCalendars(ctx context.Context, organizationID uuid.UUID) ([]AutostartExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/organizations/%s/autostart-calendars", organizationID.String()),
+		nil,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+
+	var calendars []AutostartExceptionCalendar
+	return calendars, json.NewDecoder(res.Body).Decode(&calendars)
+}
+
+// UpsertAutostartExceptionCalendar creates or replaces the named autostart
+// exception calendar of an organization.
+func (c *Client) UpsertAutostartExceptionCalendar(ctx context.Context, organizationID uuid.UUID, name string, req UpsertAutostartExceptionCalendarRequest) (AutostartExceptionCalendar, error) {
+	res, err := c.Request(ctx, http.MethodPut,
+		fmt.Sprintf("/api/v2/organizations/%s/autostart-calendars/%s", organizationID.String(), url.PathEscape(name)),
+		req,
+	)
+	if err != nil {
+		return AutostartExceptionCalendar{}, xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return AutostartExceptionCalendar{}, ReadBodyAsError(res)
+	}
+
+	var calendar AutostartExceptionCalendar
+	return calendar, json.NewDecoder(res.Body).Decode(&calendar)
+}
+
+// DeleteAutostartExceptionCalendar deletes the named autostart exception
+// calendar of an organization.
+func (c *Client) DeleteAutostartExceptionCalendar(ctx context.Context, organizationID uuid.UUID, name string) error {
+	res, err := c.Request(ctx, http.MethodDelete,
+		fmt.Sprintf("/api/v2/organizations/%s/autostart-calendars/%s", organizationID.String(), url.PathEscape(name)),
+		nil,
+	)
+	if err != nil {
+		return xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+// WorkspaceAutostartExceptions returns the autostart exceptions that apply to
+// a workspace.
+func (c *Client) WorkspaceAutostartExceptions(ctx context.Context, workspaceID uuid.UUID) (WorkspaceAutostartExceptions, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/workspaces/%s/autostart-exceptions", workspaceID.String()),
+		nil,
+	)
+	if err != nil {
+		return WorkspaceAutostartExceptions{}, xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceAutostartExceptions{}, ReadBodyAsError(res)
+	}
+
+	var exceptions WorkspaceAutostartExceptions
+	return exceptions, json.NewDecoder(res.Body).Decode(&exceptions)
+}
+
+// UpdateWorkspaceAutostartExceptions opts a workspace in or out of its
+// organization's autostart exception calendars.
+func (c *Client) UpdateWorkspaceAutostartExceptions(ctx context.Context, workspaceID uuid.UUID, req UpdateWorkspaceAutostartExceptionsRequest) error {
+	res, err := c.Request(ctx, http.MethodPut,
+		fmt.Sprintf("/api/v2/workspaces/%s/autostart-exceptions", workspaceID.String()),
+		req,
+	)
+	if err != nil {
+		return xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package codersdk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/netip"
 	"strings"
@@ -124,3 +126,21 @@ func (c *Client) ConnectionLogs(ctx context.Context, req ConnectionLogsRequest)
 	}
 	return logRes, nil
 }
+
+// ContentTypeAsciicast is the content type of session recordings, which are
+// stored in the asciicast v2 format.
+const ContentTypeAsciicast = "application/x-asciicast"
+
+// ConnectionLogRecording returns the session recording of the connection log
+// entry in the asciicast v2 format. The caller must close the returned reader.
+func (c *Client) ConnectionLogRecording(ctx context.Context, connectionLogID uuid.UUID) (io.ReadCloser, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/connectionlog/%s/recording", connectionLogID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, ReadBodyAsError(res)
+	}
+	return res.Body, nil
+}
@@ -451,6 +451,7 @@ type DeploymentValues struct {
 	// HTTPAddress is a string because it may be set to zero to disable.
 	HTTPAddress                     serpent.String                       `json:"http_address,omitempty" typescript:",notnull"`
 	AutobuildPollInterval           serpent.Duration                     `json:"autobuild_poll_interval,omitempty"`
+	AutobuildDryRun                 serpent.Bool                         `json:"autobuild_dry_run,omitempty"`
 	JobReaperDetectorInterval       serpent.Duration                     `json:"job_hang_detector_interval,omitempty"`
 	DERP                            DERP                                 `json:"derp,omitempty" typescript:",notnull"`
 	Prometheus                      PrometheusConfig                     `json:"prometheus,omitempty" typescript:",notnull"`
@@ -505,6 +506,9 @@ type DeploymentValues struct {
 	Prebuilds                       PrebuildsConfig                      `json:"workspace_prebuilds,omitempty" typescript:",notnull"`
 	HideAITasks                     serpent.Bool                         `json:"hide_ai_tasks,omitempty" typescript:",notnull"`
 	AI                              AIConfig                             `json:"ai,omitempty"`
+	AuditLogging                    AuditLoggingConfig                   `json:"audit_logging,omitempty" typescript:",notnull"`
+	Retention                       RetentionConfig                      `json:"retention,omitempty" typescript:",notnull"`
+	SessionRecordingMaxSize         serpent.Int64                        `json:"session_recording_max_size,omitempty" typescript:",notnull"`
 
 	Config      serpent.YAMLConfigPath `json:"config,omitempty" typescript:",notnull"`
 	WriteConfig serpent.Bool           `json:"write_config,omitempty" typescript:",notnull"`
@@ -841,6 +845,12 @@ type NotificationsConfig struct {
 	Method serpent.String `json:"method"`
 	// How long to wait while a notification is being sent before giving up.
 	DispatchTimeout serpent.Duration `json:"dispatch_timeout"`
+	// How long to hold notifications which users have chosen to receive as a digest.
+	DigestWindow serpent.Duration `json:"digest_window"`
+	// How long each user's quiet hours last, from the start of their quiet hours schedule.
+	QuietHoursDuration serpent.Duration `json:"quiet_hours_duration"`
+	// Whether users' personal notification targets may deliver to loopback, link-local and private network addresses.
+	UserTargetsAllowPrivateNetworks serpent.Bool `json:"user_targets_allow_private_networks"`
 	// SMTP settings.
 	SMTP NotificationsEmailConfig `json:"email" typescript:",notnull"`
 	// Webhook settings.
@@ -930,6 +940,18 @@ type PrebuildsConfig struct {
 	// no new prebuilds will be created until the limit is reset.
 	// FailureHardLimit is disabled when set to zero.
 	FailureHardLimit serpent.Int64 `json:"failure_hard_limit" typescript:"failure_hard_limit"`
+
+	// AutoscalingWindow is the time window over which claims of prebuilt workspaces are
+	// observed to size prebuild pools by demand. Autoscaling is disabled when set to zero.
+	AutoscalingWindow serpent.Duration `json:"autoscaling_window" typescript:",notnull"`
+
+	// AutoscalingTargetHitProbability is the probability with which a claim should find an
+	// eligible prebuilt workspace when pools are sized by demand.
+	AutoscalingTargetHitProbability serpent.Float64 `json:"autoscaling_target_hit_probability" typescript:",notnull"`
+
+	// AutoscalingMaxInstances is the maximum number of prebuilt workspaces kept for a preset
+	// when pools are sized by demand. Presets configured with more instances keep their count.
+	AutoscalingMaxInstances serpent.Int64 `json:"autoscaling_max_instances" typescript:",notnull"`
 }
 
 const (
@@ -1176,6 +1198,39 @@ func (c *DeploymentValues) Options() serpent.OptionSet {
 			Name: "AIBridge",
 			YAML: "aibridge",
 		}
+		deploymentGroupAITasks = serpent.Group{
+			Name:        "AI Tasks",
+			YAML:        "aiTasks",
+			Description: "Configure lifecycle policies for the workspaces of AI tasks.",
+		}
+		deploymentGroupAuditLogging = serpent.Group{
+			Name:        "Audit Logging",
+			YAML:        "auditLogging",
+			Description: "Protect audit logs from tampering, and stream them to external systems, such as a SIEM, as they are recorded.",
+		}
+		deploymentGroupAuditLoggingSyslog = serpent.Group{
+			Name:        "Syslog",
+			Parent:      &deploymentGroupAuditLogging,
+			Description: "Forward audit logs to a syslog receiver using RFC 5424 over TCP or TLS.",
+			YAML:        "syslog",
+		}
+		deploymentGroupAuditLoggingHTTP = serpent.Group{
+			Name:        "HTTP",
+			Parent:      &deploymentGroupAuditLogging,
+			Description: "Send batches of audit logs to an HTTP endpoint as newline-delimited JSON.",
+			YAML:        "http",
+		}
+		deploymentGroupAuditLoggingFile = serpent.Group{
+			Name:        "File",
+			Parent:      &deploymentGroupAuditLogging,
+			Description: "Append audit logs to a rotating file as JSON lines.",
+			YAML:        "file",
+		}
+		deploymentGroupRetention = serpent.Group{
+			Name:        "Retention",
+			YAML:        "retention",
+			Description: "Configure how long records are kept in the database before they are purged. Set a retention period to zero to keep records forever.",
+		}
 	)
 
 	httpAddress := serpent.Option{
@@ -1424,6 +1479,14 @@ func (c *DeploymentValues) Options() serpent.OptionSet {
 			YAML:        "jobHangDetectorInterval",
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 		},
+		{
+			Name:        "Autobuild Dry Run",
+			Description: "Compute and record the scheduled transitions of workspaces, such as autostart, autostop and dormancy, without building any workspaces. Run \"coder schedule explain\" to see what would have happened.",
+			Flag:        "autobuild-dry-run",
+			Env:         "CODER_AUTOBUILD_DRY_RUN",
+			Value:       &c.AutobuildDryRun,
+			YAML:        "autobuildDryRun",
+		},
 		httpAddress,
 		tlsBindAddress,
 		{
@@ -2929,6 +2992,38 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "dispatchTimeout",
 			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
 		},
+		{
+			Name:        "Notifications: Digest Window",
+			Description: "How long to hold notifications which users have chosen to receive as a digest before delivering them as a single message. Set to 0 to disable digests, in which case all notifications are delivered individually.",
+			Flag:        "notifications-digest-window",
+			Env:         "CODER_NOTIFICATIONS_DIGEST_WINDOW",
+			Value:       &c.Notifications.DigestWindow,
+			Default:     time.Hour.String(),
+			Group:       &deploymentGroupNotifications,
+			YAML:        "digestWindow",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Notifications: Quiet Hours Duration",
+			Description: "How long quiet hours last, from the start of each user's quiet hours schedule. Users who enable quiet hours for notifications have their non-urgent notifications deferred until their quiet hours end.",
+			Flag:        "notifications-quiet-hours-duration",
+			Env:         "CODER_NOTIFICATIONS_QUIET_HOURS_DURATION",
+			Value:       &c.Notifications.QuietHoursDuration,
+			Default:     (8 * time.Hour).String(),
+			Group:       &deploymentGroupNotifications,
+			YAML:        "quietHoursDuration",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Notifications: User Targets Allow Private Networks",
+			Description: "Allow users' personal notification targets to deliver to loopback, link-local and private network addresses. By default, these addresses are refused so that users cannot reach internal services through the Coder server.",
+			Flag:        "notifications-user-targets-allow-private-networks",
+			Env:         "CODER_NOTIFICATIONS_USER_TARGETS_ALLOW_PRIVATE_NETWORKS",
+			Value:       &c.Notifications.UserTargetsAllowPrivateNetworks,
+			Default:     "false",
+			Group:       &deploymentGroupNotifications,
+			YAML:        "userTargetsAllowPrivateNetworks",
+		},
 		{
 			Name:        "Notifications: Email: From Address",
 			Description: "The sender's address to use.",
@@ -3226,6 +3321,37 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "failure_hard_limit",
 			Hidden:      true,
 		},
+		{
+			Name:        "Autoscaling Window",
+			Description: "Time window over which claims of prebuilt workspaces are observed to size prebuild pools by demand; disabled when set to zero.",
+			Flag:        "workspace-prebuilds-autoscaling-window",
+			Env:         "CODER_WORKSPACE_PREBUILDS_AUTOSCALING_WINDOW",
+			Value:       &c.Prebuilds.AutoscalingWindow,
+			Default:     "0",
+			Group:       &deploymentGroupPrebuilds,
+			YAML:        "autoscaling_window",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Autoscaling Target Hit Probability",
+			Description: "Probability with which a claim should find an eligible prebuilt workspace when prebuild pools are sized by demand.",
+			Flag:        "workspace-prebuilds-autoscaling-target-hit-probability",
+			Env:         "CODER_WORKSPACE_PREBUILDS_AUTOSCALING_TARGET_HIT_PROBABILITY",
+			Value:       &c.Prebuilds.AutoscalingTargetHitProbability,
+			Default:     "0.95",
+			Group:       &deploymentGroupPrebuilds,
+			YAML:        "autoscaling_target_hit_probability",
+		},
+		{
+			Name:        "Autoscaling Max Instances",
+			Description: "Maximum number of prebuilt workspaces kept for a preset when prebuild pools are sized by demand. Presets configured with more instances keep their configured count.",
+			Flag:        "workspace-prebuilds-autoscaling-max-instances",
+			Env:         "CODER_WORKSPACE_PREBUILDS_AUTOSCALING_MAX_INSTANCES",
+			Value:       &c.Prebuilds.AutoscalingMaxInstances,
+			Default:     "10",
+			Group:       &deploymentGroupPrebuilds,
+			YAML:        "autoscaling_max_instances",
+		},
 		{
 			Name:        "Hide AI Tasks",
 			Description: "Hide AI tasks from the dashboard.",
@@ -3237,6 +3363,200 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "hideAITasks",
 		},
 
+		// Audit Logging Options
+		{
+			Name:        "Audit Logging: Hash Chain",
+			Description: "Chain each audit log to the previous one with a SHA-256 hash, so that modified or deleted audit logs can be detected with 'coder audit verify'. Inserting audit logs is serialized while this is enabled.",
+			Flag:        "audit-logging-hash-chain",
+			Env:         "CODER_AUDIT_LOGGING_HASH_CHAIN",
+			Value:       &c.AuditLogging.HashChain,
+			Default:     "false",
+			Group:       &deploymentGroupAuditLogging,
+			YAML:        "hashChain",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: Address",
+			Description: "The host:port of a syslog receiver to forward audit logs to. Audit logs are not forwarded to syslog if unset.",
+			Flag:        "audit-logging-syslog-address",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_ADDRESS",
+			Value:       &c.AuditLogging.Syslog.Address,
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "address",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: TLS",
+			Description: "Connect to the syslog receiver using TLS.",
+			Flag:        "audit-logging-syslog-tls",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_TLS",
+			Value:       &c.AuditLogging.Syslog.TLS,
+			Default:     "false",
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "tls",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: Syslog: TLS CA File",
+			Description: "A PEM encoded CA bundle used to verify the syslog receiver. The system roots are used if unset.",
+			Flag:        "audit-logging-syslog-tls-ca-file",
+			Env:         "CODER_AUDIT_LOGGING_SYSLOG_TLS_CA_FILE",
+			Value:       &c.AuditLogging.Syslog.TLSCAFile,
+			Group:       &deploymentGroupAuditLoggingSyslog,
+			YAML:        "tlsCAFile",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Endpoint",
+			Description: "The URL which batches of audit logs are sent to with an HTTP POST request. Audit logs are not sent over HTTP if unset.",
+			Flag:        "audit-logging-http-endpoint",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_ENDPOINT",
+			Value:       &c.AuditLogging.HTTP.Endpoint,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "endpoint",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Headers",
+			Description: "Headers added to every request to the audit log endpoint, e.g. for authentication. Each header is specified as \"Name: value\".",
+			Flag:        "audit-logging-http-headers",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_HEADERS",
+			Value:       &c.AuditLogging.HTTP.Headers,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true").Mark(annotationSecretKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Batch Size",
+			Description: "The maximum number of audit logs sent in a single request.",
+			Flag:        "audit-logging-http-batch-size",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_BATCH_SIZE",
+			Value:       &c.AuditLogging.HTTP.BatchSize,
+			Default:     "100",
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "batchSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Flush Interval",
+			Description: "The maximum time an audit log waits for its batch to fill before it is sent.",
+			Flag:        "audit-logging-http-flush-interval",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_FLUSH_INTERVAL",
+			Value:       &c.AuditLogging.HTTP.FlushInterval,
+			Default:     (5 * time.Second).String(),
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "flushInterval",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true").Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Buffer Directory",
+			Description: "The directory in which audit logs are kept until the endpoint accepts them, so that they survive restarts and outages. Defaults to a directory within the cache directory.",
+			Flag:        "audit-logging-http-buffer-dir",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_BUFFER_DIR",
+			Value:       &c.AuditLogging.HTTP.BufferDir,
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "bufferDir",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: HTTP: Max Buffered Events",
+			Description: "The maximum number of audit logs kept in the buffer directory. When exceeded, the oldest audit logs are dropped. Set to zero for no limit.",
+			Flag:        "audit-logging-http-max-buffered-events",
+			Env:         "CODER_AUDIT_LOGGING_HTTP_MAX_BUFFERED_EVENTS",
+			Value:       &c.AuditLogging.HTTP.MaxBufferedEvents,
+			Default:     "100000",
+			Group:       &deploymentGroupAuditLoggingHTTP,
+			YAML:        "maxBufferedEvents",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: File: Path",
+			Description: "The file to append audit logs to, one JSON object per line. Audit logs are not written to a file if unset.",
+			Flag:        "audit-logging-file-path",
+			Env:         "CODER_AUDIT_LOGGING_FILE_PATH",
+			Value:       &c.AuditLogging.File.Path,
+			Group:       &deploymentGroupAuditLoggingFile,
+			YAML:        "path",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: File: Max Size",
+			Description: "The size in megabytes at which the audit log file is rotated.",
+			Flag:        "audit-logging-file-max-size",
+			Env:         "CODER_AUDIT_LOGGING_FILE_MAX_SIZE",
+			Value:       &c.AuditLogging.File.MaxSize,
+			Default:     "100",
+			Group:       &deploymentGroupAuditLoggingFile,
+			YAML:        "maxSize",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+		{
+			Name:        "Audit Logging: File: Max Backups",
+			Description: "The number of rotated audit log files to keep. Set to zero to keep all of them.",
+			Flag:        "audit-logging-file-max-backups",
+			Env:         "CODER_AUDIT_LOGGING_FILE_MAX_BACKUPS",
+			Value:       &c.AuditLogging.File.MaxBackups,
+			Default:     "10",
+			Group:       &deploymentGroupAuditLoggingFile,
+			YAML:        "maxBackups",
+			Annotations: serpent.Annotations{}.Mark(annotationEnterpriseKey, "true"),
+		},
+
+		// Retention Options
+		{
+			Name:        "Audit Logs Retention",
+			Description: "How long audit logs are kept before they are purged. Audit logs are kept forever if set to zero. If the audit log hash chain is enabled, the chain entries of purged audit logs are replaced by checkpoints, so that the remaining chain can still be verified.",
+			Flag:        "audit-logs-retention",
+			Env:         "CODER_AUDIT_LOGS_RETENTION",
+			Value:       &c.Retention.AuditLogs,
+			Default:     "0",
+			Group:       &deploymentGroupRetention,
+			YAML:        "auditLogs",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Connection Logs Retention",
+			Description: "How long connection logs are kept before they are purged. Connection logs are kept forever if set to zero.",
+			Flag:        "connection-logs-retention",
+			Env:         "CODER_CONNECTION_LOGS_RETENTION",
+			Value:       &c.Retention.ConnectionLogs,
+			Default:     "0",
+			Group:       &deploymentGroupRetention,
+			YAML:        "connectionLogs",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "Session Recordings Retention",
+			Description: "How long terminal session recordings are kept before they are purged. Session recordings are kept forever if set to zero.",
+			Flag:        "session-recordings-retention",
+			Env:         "CODER_SESSION_RECORDINGS_RETENTION",
+			Value:       &c.Retention.SessionRecordings,
+			Default:     "0",
+			Group:       &deploymentGroupRetention,
+			YAML:        "sessionRecordings",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "AI Bridge Captures Retention",
+			Description: "How long the requests and responses captured by AI Bridge are kept before they are purged. Captures are kept forever if set to zero.",
+			Flag:        "aibridge-captures-retention",
+			Env:         "CODER_AIBRIDGE_CAPTURES_RETENTION",
+			Value:       &c.Retention.AIBridgeCaptures,
+			Default:     "168h", // 7 days.
+			Group:       &deploymentGroupRetention,
+			YAML:        "aibridgeCaptures",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+			Hidden:      true,
+		},
+		{
+			Name:        "Session Recording Max Size",
+			Description: "The maximum size in megabytes of a single terminal session recording. Templates opt in to session recording. Once a session reaches this size, the rest of it is not recorded.",
+			Flag:        "session-recording-max-size",
+			Env:         "CODER_SESSION_RECORDING_MAX_SIZE",
+			Value:       &c.SessionRecordingMaxSize,
+			Default:     "50",
+			YAML:        "sessionRecordingMaxSize",
+		},
+
 		// AIBridge Options
 		{
 			Name:        "AIBridge Enabled",
@@ -3293,15 +3613,147 @@ Write out the current server config as YAML to stdout.`,
 			YAML:        "key",
 			Hidden:      true,
 		},
+		{
+			Name:        "AIBridge Policy Secret Detection",
+			Description: "What to do with prompts which contain secrets such as API keys, access tokens and private keys: \"off\" forwards them untouched, \"redact\" replaces each secret with a placeholder and \"block\" rejects the request.",
+			Flag:        "aibridge-policy-secret-detection",
+			Env:         "CODER_AIBRIDGE_POLICY_SECRET_DETECTION",
+			Value:       serpent.EnumOf(&c.AI.BridgeConfig.Policy.SecretDetection, AIBridgeSecretDetectionModes...),
+			Default:     string(AIBridgeSecretDetectionOff),
+			Group:       &deploymentGroupAIBridge,
+			YAML:        "policy_secret_detection",
+			Hidden:      true,
+		},
+		{
+			Name:        "AIBridge Policy Secret Patterns",
+			Description: "Additional regular expressions which are treated as secrets by the secret detection policy, on top of the built-in patterns.",
+			Flag:        "aibridge-policy-secret-patterns",
+			Env:         "CODER_AIBRIDGE_POLICY_SECRET_PATTERNS",
+			Value:       &c.AI.BridgeConfig.Policy.SecretPatterns,
+			Default:     "",
+			Group:       &deploymentGroupAIBridge,
+			YAML:        "policy_secret_patterns",
+			Hidden:      true,
+		},
+		{
+			Name:        "AIBridge Policy Denied Tools",
+			Description: "Names of tools which models may not be offered, whether defined by the client or injected from an MCP server. A \"*\" matches any sequence of characters.",
+			Flag:        "aibridge-policy-denied-tools",
+			Env:         "CODER_AIBRIDGE_POLICY_DENIED_TOOLS",
+			Value:       &c.AI.BridgeConfig.Policy.DeniedTools,
+			Default:     "",
+			Group:       &deploymentGroupAIBridge,
+			YAML:        "policy_denied_tools",
+			Hidden:      true,
+		},
+		{
+			Name:        "AIBridge Policy Max Prompt Size",
+			Description: "The maximum size in bytes of the messages in a single request. Larger requests are rejected. Set to 0 to disable.",
+			Flag:        "aibridge-policy-max-prompt-bytes",
+			Env:         "CODER_AIBRIDGE_POLICY_MAX_PROMPT_BYTES",
+			Value:       &c.AI.BridgeConfig.Policy.MaxPromptBytes,
+			Default:     "0",
+			Group:       &deploymentGroupAIBridge,
+			YAML:        "policy_max_prompt_bytes",
+			Hidden:      true,
+		},
+		{
+			Name:        "AIBridge Capture Max Body Size",
+			Description: "The maximum size in bytes of each request and response body captured for users who have opted in to request capture. Larger bodies are truncated. Set to 0 to disable capture entirely.",
+			Flag:        "aibridge-capture-max-body-bytes",
+			Env:         "CODER_AIBRIDGE_CAPTURE_MAX_BODY_BYTES",
+			Value:       &c.AI.BridgeConfig.Capture.MaxBodyBytes,
+			Default:     "1048576", // 1 MiB.
+			Group:       &deploymentGroupAIBridge,
+			YAML:        "capture_max_body_bytes",
+			Hidden:      true,
+		},
+
+		// AI Tasks Options
+		{
+			Name:        "AI Tasks Idle Stop After",
+			Description: "Stop the workspace of a task once its agent has reported that it is idle or complete for this long. Set to 0 to disable.",
+			Flag:        "ai-tasks-idle-stop-after",
+			Env:         "CODER_AI_TASKS_IDLE_STOP_AFTER",
+			Value:       &c.AI.TasksConfig.IdleStopAfter,
+			Default:     "0",
+			Group:       &deploymentGroupAITasks,
+			YAML:        "idleStopAfter",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "AI Tasks Retention Period",
+			Description: "Delete the workspace of a task once it has been stopped for this long. Set to 0 to disable.",
+			Flag:        "ai-tasks-retention-period",
+			Env:         "CODER_AI_TASKS_RETENTION_PERIOD",
+			Value:       &c.AI.TasksConfig.RetentionPeriod,
+			Default:     "0",
+			Group:       &deploymentGroupAITasks,
+			YAML:        "retentionPeriod",
+			Annotations: serpent.Annotations{}.Mark(annotationFormatDuration, "true"),
+		},
+		{
+			Name:        "AI Tasks Max Running Per User",
+			Description: "The maximum number of tasks each user may have running at once. Creating or starting a task beyond the limit fails, and the idle tasks of users over the limit are stopped. Set to 0 to disable.",
+			Flag:        "ai-tasks-max-running-per-user",
+			Env:         "CODER_AI_TASKS_MAX_RUNNING_PER_USER",
+			Value:       &c.AI.TasksConfig.MaxRunningPerUser,
+			Default:     "0",
+			Group:       &deploymentGroupAITasks,
+			YAML:        "maxRunningPerUser",
+		},
 	}
 
 	return opts
 }
 
+type AuditLoggingConfig struct {
+	// HashChain appends every audit log to a tamper-evident hash chain.
+	HashChain serpent.Bool             `json:"hash_chain" typescript:",notnull"`
+	Syslog    AuditLoggingSyslogConfig `json:"syslog" typescript:",notnull"`
+	HTTP      AuditLoggingHTTPConfig   `json:"http" typescript:",notnull"`
+	File      AuditLoggingFileConfig   `json:"file" typescript:",notnull"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// Address is the host:port of the syslog receiver.
+	Address   serpent.String `json:"address" typescript:",notnull"`
+	TLS       serpent.Bool   `json:"tls" typescript:",notnull"`
+	TLSCAFile serpent.String `json:"tls_ca_file" typescript:",notnull"`
+}
+
+type AuditLoggingHTTPConfig struct {
+	Endpoint serpent.URL `json:"endpoint" typescript:",notnull"`
+	// Headers are formatted as "Name: value".
+	Headers           serpent.StringArray `json:"headers" typescript:",notnull"`
+	BatchSize         serpent.Int64       `json:"batch_size" typescript:",notnull"`
+	FlushInterval     serpent.Duration    `json:"flush_interval" typescript:",notnull"`
+	BufferDir         serpent.String      `json:"buffer_dir" typescript:",notnull"`
+	MaxBufferedEvents serpent.Int64       `json:"max_buffered_events" typescript:",notnull"`
+}
+
+type AuditLoggingFileConfig struct {
+	Path serpent.String `json:"path" typescript:",notnull"`
+	// MaxSize is the size in megabytes at which the file is rotated.
+	MaxSize    serpent.Int64 `json:"max_size" typescript:",notnull"`
+	MaxBackups serpent.Int64 `json:"max_backups" typescript:",notnull"`
+}
+
+// RetentionConfig is how long records are kept in the database before they are
+// purged. A zero duration keeps records forever.
+type RetentionConfig struct {
+	AuditLogs         serpent.Duration `json:"audit_logs" typescript:",notnull"`
+	ConnectionLogs    serpent.Duration `json:"connection_logs" typescript:",notnull"`
+	SessionRecordings serpent.Duration `json:"session_recordings" typescript:",notnull"`
+	AIBridgeCaptures  serpent.Duration `json:"aibridge_captures" typescript:",notnull"`
+}
+
 type AIBridgeConfig struct {
 	Enabled   serpent.Bool            `json:"enabled" typescript:",notnull"`
 	OpenAI    AIBridgeOpenAIConfig    `json:"openai" typescript:",notnull"`
 	Anthropic AIBridgeAnthropicConfig `json:"anthropic" typescript:",notnull"`
+	Policy    AIBridgePolicyConfig    `json:"policy" typescript:",notnull"`
+	Capture   AIBridgeCaptureConfig   `json:"capture" typescript:",notnull"`
 }
 
 type AIBridgeOpenAIConfig struct {
@@ -3314,8 +3766,46 @@ type AIBridgeAnthropicConfig struct {
 	Key     serpent.String `json:"key" typescript:",notnull"`
 }
 
+type AIBridgeSecretDetection string
+
+const (
+	AIBridgeSecretDetectionOff    AIBridgeSecretDetection = "off"
+	AIBridgeSecretDetectionRedact AIBridgeSecretDetection = "redact"
+	AIBridgeSecretDetectionBlock  AIBridgeSecretDetection = "block"
+)
+
+var AIBridgeSecretDetectionModes = []string{
+	string(AIBridgeSecretDetectionOff),
+	string(AIBridgeSecretDetectionRedact),
+	string(AIBridgeSecretDetectionBlock),
+}
+
+// AIBridgePolicyConfig configures the content policies which AI Bridge applies
+// to requests before they are forwarded to providers.
+type AIBridgePolicyConfig struct {
+	SecretDetection string              `json:"secret_detection" typescript:",notnull"`
+	SecretPatterns  serpent.StringArray `json:"secret_patterns" typescript:",notnull"`
+	DeniedTools     serpent.StringArray `json:"denied_tools" typescript:",notnull"`
+	MaxPromptBytes  serpent.Int64       `json:"max_prompt_bytes" typescript:",notnull"`
+}
+
+// AIBridgeCaptureConfig configures the capture of full requests and responses
+// for users who have opted in. See AIBridgeUserCaptureSettings.
+type AIBridgeCaptureConfig struct {
+	MaxBodyBytes serpent.Int64 `json:"max_body_bytes" typescript:",notnull"`
+}
+
 type AIConfig struct {
 	BridgeConfig AIBridgeConfig `json:"bridge,omitempty"`
+	TasksConfig  AITasksConfig  `json:"tasks,omitempty"`
+}
+
+// AITasksConfig holds the lifecycle policies which are applied to the
+// workspaces of AI tasks. A zero value disables the policy.
+type AITasksConfig struct {
+	IdleStopAfter     serpent.Duration `json:"idle_stop_after" typescript:",notnull"`
+	RetentionPeriod   serpent.Duration `json:"retention_period" typescript:",notnull"`
+	MaxRunningPerUser serpent.Int64    `json:"max_running_per_user" typescript:",notnull"`
 }
 
 type SupportConfig struct {
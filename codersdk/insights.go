@@ -313,3 +313,83 @@ func (c *Client) GetUserStatusCounts(ctx context.Context, req GetUserStatusCount
 	var result GetUserStatusCountsResponse
 	return result, json.NewDecoder(resp.Body).Decode(&result)
 }
+
+// PrebuildsInsightsResponse is the response from the prebuilds insights
+// endpoint.
+type PrebuildsInsightsResponse struct {
+	Report PrebuildsInsightsReport `json:"report"`
+}
+
+// PrebuildsInsightsReport is the report from the prebuilds insights endpoint.
+type PrebuildsInsightsReport struct {
+	StartTime   time.Time                `json:"start_time" format:"date-time"`
+	EndTime     time.Time                `json:"end_time" format:"date-time"`
+	TemplateIDs []uuid.UUID              `json:"template_ids" format:"uuid"`
+	Presets     []PresetPrebuildInsights `json:"presets"`
+}
+
+// PresetPrebuildInsights shows how often and how quickly the workspaces created
+// with a preset were served from prebuilt workspaces. Percentiles are -1 when
+// there is nothing to measure, e.g. when no workspace was served from a
+// prebuilt workspace or none of their agents became ready.
+type PresetPrebuildInsights struct {
+	TemplateID          uuid.UUID `json:"template_id" format:"uuid"`
+	TemplateName        string    `json:"template_name"`
+	TemplateDisplayName string    `json:"template_display_name"`
+	OrganizationName    string    `json:"organization_name"`
+	PresetName          string    `json:"preset_name"`
+	// Workspaces is the number of workspaces created with the preset.
+	Workspaces int64 `json:"workspaces" example:"40"`
+	// Hits is the number of those workspaces which were served from a prebuilt
+	// workspace.
+	Hits    int64   `json:"hits" example:"36"`
+	HitRate float64 `json:"hit_rate" example:"0.9"`
+	// ClaimLatencyMS is the time taken to claim a prebuilt workspace, or to
+	// find there is none to claim.
+	ClaimLatencyMS PrebuildInsightsPercentiles `json:"claim_latency_ms"`
+	// HitTimeToReadySeconds is the time from the creation of a workspace served
+	// from a prebuilt workspace until its agents were ready.
+	HitTimeToReadySeconds PrebuildInsightsPercentiles `json:"hit_time_to_ready_seconds"`
+	// MissTimeToReadySeconds is the time from the creation of a workspace built
+	// from scratch until its agents were ready.
+	MissTimeToReadySeconds PrebuildInsightsPercentiles `json:"miss_time_to_ready_seconds"`
+}
+
+// PrebuildInsightsPercentiles shows the median and 95th percentile of a
+// measurement.
+type PrebuildInsightsPercentiles struct {
+	P50 float64 `json:"p50" example:"12.5"`
+	P95 float64 `json:"p95" example:"48.2"`
+}
+
+type PrebuildsInsightsRequest struct {
+	StartTime   time.Time   `json:"start_time" format:"date-time"`
+	EndTime     time.Time   `json:"end_time" format:"date-time"`
+	TemplateIDs []uuid.UUID `json:"template_ids" format:"uuid"`
+}
+
+func (c *Client) PrebuildsInsights(ctx context.Context, req PrebuildsInsightsRequest) (PrebuildsInsightsResponse, error) {
+	qp := url.Values{}
+	qp.Add("start_time", req.StartTime.Format(insightsTimeLayout))
+	qp.Add("end_time", req.EndTime.Format(insightsTimeLayout))
+	if len(req.TemplateIDs) > 0 {
+		var templateIDs []string
+		for _, id := range req.TemplateIDs {
+			templateIDs = append(templateIDs, id.String())
+		}
+		qp.Add("template_ids", strings.Join(templateIDs, ","))
+	}
+
+	reqURL := fmt.Sprintf("/api/v2/insights/prebuilds?%s", qp.Encode())
+	resp, err := c.Request(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return PrebuildsInsightsResponse{}, xerrors.Errorf("make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return PrebuildsInsightsResponse{}, ReadBodyAsError(resp)
+	}
+	var result PrebuildsInsightsResponse
+	return result, json.NewDecoder(resp.Body).Decode(&result)
+}
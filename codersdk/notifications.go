@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -27,6 +28,15 @@ type NotificationTemplate struct {
 	Method           string    `json:"method"`
 	Kind             string    `json:"kind"`
 	EnabledByDefault bool      `json:"enabled_by_default"`
+	// TitleTemplateOverride is this deployment's customization of the title, which is used instead of TitleTemplate
+	// when set.
+	TitleTemplateOverride string `json:"title_template_override,omitempty"`
+	// BodyTemplateOverride is this deployment's customization of the body, which is used instead of BodyTemplate when
+	// set.
+	BodyTemplateOverride string `json:"body_template_override,omitempty"`
+	// Urgent notifications are delivered immediately, even during the recipient's quiet hours or while they have
+	// snoozed notifications.
+	Urgent bool `json:"urgent"`
 }
 
 type NotificationMethodsResponse struct {
@@ -37,7 +47,83 @@ type NotificationMethodsResponse struct {
 type NotificationPreference struct {
 	NotificationTemplateID uuid.UUID `json:"id" format:"uuid"`
 	Disabled               bool      `json:"disabled"`
-	UpdatedAt              time.Time `json:"updated_at" format:"date-time"`
+	// UserTargetID is the user-defined target which notifications from this template are delivered to, instead of
+	// the template's or deployment's notification method.
+	UserTargetID *uuid.UUID `json:"user_target_id,omitempty" format:"uuid"`
+	// Digest is true when notifications from this template are held and delivered as a single digest.
+	Digest    bool      `json:"digest"`
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
+
+type NotificationUserTargetType string
+
+const (
+	NotificationUserTargetTypeWebhook NotificationUserTargetType = "webhook"
+	// NotificationUserTargetTypeSlack delivers to Slack-compatible incoming webhooks, which includes Mattermost.
+	NotificationUserTargetTypeSlack NotificationUserTargetType = "slack"
+)
+
+// NotificationUserTarget is a webhook or chat endpoint defined by a user, to which their notifications can be delivered.
+type NotificationUserTarget struct {
+	ID       uuid.UUID                  `json:"id" format:"uuid"`
+	UserID   uuid.UUID                  `json:"user_id" format:"uuid"`
+	Name     string                     `json:"name"`
+	Type     NotificationUserTargetType `json:"type" enums:"webhook,slack"`
+	Endpoint string                     `json:"endpoint"`
+	// HasSecret indicates whether deliveries to this target are signed. The secret itself is never returned.
+	HasSecret bool      `json:"has_secret"`
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+	UpdatedAt time.Time `json:"updated_at" format:"date-time"`
+}
+
+type CreateNotificationUserTargetRequest struct {
+	Name     string                     `json:"name" validate:"required"`
+	Type     NotificationUserTargetType `json:"type" validate:"required" enums:"webhook,slack"`
+	Endpoint string                     `json:"endpoint" validate:"required"`
+	// Secret is used to sign webhook deliveries with an HMAC-SHA256 signature. It is optional.
+	Secret string `json:"secret,omitempty"`
+}
+
+func (c CreateNotificationUserTargetRequest) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return xerrors.Errorf("'name' must not be empty")
+	}
+	switch c.Type {
+	case NotificationUserTargetTypeWebhook, NotificationUserTargetTypeSlack:
+	default:
+		return xerrors.Errorf("%q is not a valid target type; %q and %q are the available options",
+			c.Type, NotificationUserTargetTypeWebhook, NotificationUserTargetTypeSlack)
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return xerrors.Errorf("parse 'endpoint': %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return xerrors.Errorf("'endpoint' must be an absolute http or https URL")
+	}
+	return nil
+}
+
+// DefaultNotificationWebhookSecretGracePeriod is how long a rotated webhook secret continues to sign deliveries when
+// no grace period is given.
+const DefaultNotificationWebhookSecretGracePeriod = 24 * time.Hour
+
+// NotificationWebhookSecret is a shared secret used to sign deliveries to the deployment's notification webhook.
+type NotificationWebhookSecret struct {
+	ID uuid.UUID `json:"id" format:"uuid"`
+	// Secret is only returned once, in response to a rotation.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at" format:"date-time"`
+	// ExpiresAt is unset for the active secret. A rotated secret continues to sign deliveries until this time.
+	ExpiresAt *time.Time `json:"expires_at,omitempty" format:"date-time"`
+}
+
+type RotateNotificationWebhookSecretRequest struct {
+	// Secret is the new shared secret. A random secret is generated if it is omitted.
+	Secret string `json:"secret,omitempty"`
+	// GracePeriodMillis is how long the current secret continues to sign deliveries alongside the new one, so that
+	// receivers can be updated without rejecting deliveries. Defaults to 24 hours.
+	GracePeriodMillis int64 `json:"grace_period_ms,omitempty"`
 }
 
 // GetNotificationsSettings retrieves the notifications settings, which currently just describes whether all
@@ -94,6 +180,65 @@ func (c *Client) UpdateNotificationTemplateMethod(ctx context.Context, notificat
 	return nil
 }
 
+// UpdateNotificationTemplateOverrides replaces this deployment's customizations of a notification template's title
+// and body. The built-in templates are used for anything which is not overridden.
+func (c *Client) UpdateNotificationTemplateOverrides(ctx context.Context, notificationTemplateID uuid.UUID, req UpdateNotificationTemplateOverrides) (NotificationTemplate, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/notifications/templates/%s/overrides", notificationTemplateID), req)
+	if err != nil {
+		return NotificationTemplate{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return NotificationTemplate{}, ReadBodyAsError(res)
+	}
+	var template NotificationTemplate
+	return template, json.NewDecoder(res.Body).Decode(&template)
+}
+
+// DeleteNotificationTemplateOverrides reverts a notification template to its built-in title and body.
+func (c *Client) DeleteNotificationTemplateOverrides(ctx context.Context, notificationTemplateID uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/notifications/templates/%s/overrides", notificationTemplateID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+// PreviewNotificationTemplate renders a notification template against sample data, without sending it.
+func (c *Client) PreviewNotificationTemplate(ctx context.Context, notificationTemplateID uuid.UUID, req NotificationTemplatePreviewRequest) (NotificationTemplatePreview, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/notifications/templates/%s/preview", notificationTemplateID), req)
+	if err != nil {
+		return NotificationTemplatePreview{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return NotificationTemplatePreview{}, ReadBodyAsError(res)
+	}
+	var preview NotificationTemplatePreview
+	return preview, json.NewDecoder(res.Body).Decode(&preview)
+}
+
+// TestNotificationTemplate sends a notification template to the requesting user, rendered against sample data.
+func (c *Client) TestNotificationTemplate(ctx context.Context, notificationTemplateID uuid.UUID, req NotificationTemplateTestRequest) error {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/notifications/templates/%s/test", notificationTemplateID), req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
 // GetSystemNotificationTemplates retrieves all notification templates pertaining to internal system events.
 func (c *Client) GetSystemNotificationTemplates(ctx context.Context) ([]NotificationTemplate, error) {
 	res, err := c.Request(ctx, http.MethodGet, "/api/v2/notifications/templates/system", nil)
@@ -169,6 +314,133 @@ func (c *Client) UpdateUserNotificationPreferences(ctx context.Context, userID u
 	return prefs, nil
 }
 
+// UpdateNotificationTemplateUrgency marks a notification template as urgent, or not. Urgent notifications bypass
+// quiet hours and snoozes.
+func (c *Client) UpdateNotificationTemplateUrgency(ctx context.Context, notificationTemplateID uuid.UUID, req UpdateNotificationTemplateUrgency) (NotificationTemplate, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/notifications/templates/%s/urgency", notificationTemplateID), req)
+	if err != nil {
+		return NotificationTemplate{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return NotificationTemplate{}, ReadBodyAsError(res)
+	}
+	var template NotificationTemplate
+	return template, json.NewDecoder(res.Body).Decode(&template)
+}
+
+// GetUserNotificationQuietHours returns when the given user's non-urgent notifications are deferred.
+func (c *Client) GetUserNotificationQuietHours(ctx context.Context, user string) (UserNotificationQuietHours, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/users/%s/notifications/quiet-hours", user), nil)
+	if err != nil {
+		return UserNotificationQuietHours{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return UserNotificationQuietHours{}, ReadBodyAsError(res)
+	}
+	var quietHours UserNotificationQuietHours
+	return quietHours, json.NewDecoder(res.Body).Decode(&quietHours)
+}
+
+// UpdateUserNotificationQuietHours changes when the given user's non-urgent notifications are deferred.
+func (c *Client) UpdateUserNotificationQuietHours(ctx context.Context, user string, req UpdateUserNotificationQuietHoursRequest) (UserNotificationQuietHours, error) {
+	res, err := c.Request(ctx, http.MethodPut, fmt.Sprintf("/api/v2/users/%s/notifications/quiet-hours", user), req)
+	if err != nil {
+		return UserNotificationQuietHours{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return UserNotificationQuietHours{}, ReadBodyAsError(res)
+	}
+	var quietHours UserNotificationQuietHours
+	return quietHours, json.NewDecoder(res.Body).Decode(&quietHours)
+}
+
+// GetUserNotificationTargets retrieves the notification targets defined by a given user.
+func (c *Client) GetUserNotificationTargets(ctx context.Context, user string) ([]NotificationUserTarget, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/users/%s/notifications/targets", user), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+
+	var targets []NotificationUserTarget
+	return targets, json.NewDecoder(res.Body).Decode(&targets)
+}
+
+// CreateUserNotificationTarget creates a notification target for a given user.
+func (c *Client) CreateUserNotificationTarget(ctx context.Context, user string, req CreateNotificationUserTargetRequest) (NotificationUserTarget, error) {
+	res, err := c.Request(ctx, http.MethodPost, fmt.Sprintf("/api/v2/users/%s/notifications/targets", user), req)
+	if err != nil {
+		return NotificationUserTarget{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return NotificationUserTarget{}, ReadBodyAsError(res)
+	}
+
+	var target NotificationUserTarget
+	return target, json.NewDecoder(res.Body).Decode(&target)
+}
+
+// DeleteUserNotificationTarget deletes a notification target belonging to a given user. Any notification preferences
+// which referenced the target revert to the template's or deployment's notification method.
+func (c *Client) DeleteUserNotificationTarget(ctx context.Context, user string, targetID uuid.UUID) error {
+	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/users/%s/notifications/targets/%s", user, targetID), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
+
+// GetNotificationWebhookSecrets retrieves the secrets which currently sign webhook deliveries. The secrets
+// themselves are not returned.
+func (c *Client) GetNotificationWebhookSecrets(ctx context.Context) ([]NotificationWebhookSecret, error) {
+	res, err := c.Request(ctx, http.MethodGet, "/api/v2/notifications/webhook-secrets", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+
+	var secrets []NotificationWebhookSecret
+	return secrets, json.NewDecoder(res.Body).Decode(&secrets)
+}
+
+// RotateNotificationWebhookSecret replaces the secret used to sign webhook deliveries. The previous secret continues
+// to sign deliveries for the requested grace period, and any secret rotated out before it stops immediately.
+func (c *Client) RotateNotificationWebhookSecret(ctx context.Context, req RotateNotificationWebhookSecretRequest) (NotificationWebhookSecret, error) {
+	res, err := c.Request(ctx, http.MethodPost, "/api/v2/notifications/webhook-secrets", req)
+	if err != nil {
+		return NotificationWebhookSecret{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return NotificationWebhookSecret{}, ReadBodyAsError(res)
+	}
+
+	var secret NotificationWebhookSecret
+	return secret, json.NewDecoder(res.Body).Decode(&secret)
+}
+
 // GetNotificationDispatchMethods the available and default notification dispatch methods.
 func (c *Client) GetNotificationDispatchMethods(ctx context.Context) (NotificationMethodsResponse, error) {
 	res, err := c.Request(ctx, http.MethodGet, "/api/v2/notifications/dispatch-methods", nil)
@@ -207,12 +479,82 @@ func (c *Client) PostTestNotification(ctx context.Context) error {
 	return nil
 }
 
+// UpdateNotificationTemplateOverrides customizes a notification template for this deployment. Both templates use the
+// same markdown and Go templating syntax as the built-in templates. An empty template reverts to the built-in one.
+type UpdateNotificationTemplateOverrides struct {
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+}
+
+type UpdateNotificationTemplateUrgency struct {
+	Urgent bool `json:"urgent"`
+}
+
+// NotificationTemplatePreviewRequest renders a notification template against sample data.
+type NotificationTemplatePreviewRequest struct {
+	// TitleTemplate is rendered instead of the template's current title when set, so that changes can be previewed
+	// before they are saved.
+	TitleTemplate string `json:"title_template,omitempty"`
+	// BodyTemplate is rendered instead of the template's current body when set.
+	BodyTemplate string `json:"body_template,omitempty"`
+	// Labels are substituted into the sample payload. Labels which are referenced by the template but not given are
+	// filled with a placeholder of the form "[name]".
+	Labels map[string]string `json:"labels,omitempty"`
+	Data   map[string]any    `json:"data,omitempty"`
+}
+
+type NotificationTemplatePreview struct {
+	// Title is the rendered title as plain text.
+	Title string `json:"title"`
+	// Body is the rendered body as markdown.
+	Body string `json:"body"`
+	// BodyHTML is the rendered body converted to HTML, as used in emails.
+	BodyHTML string `json:"body_html"`
+}
+
+// NotificationTemplateTestRequest sends a notification template to the requesting user.
+type NotificationTemplateTestRequest struct {
+	// Method is the dispatch method to deliver the test message through. It must be enabled for this deployment.
+	// Defaults to the deployment's notification method.
+	Method string            `json:"method,omitempty" example:"smtp"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Data   map[string]any    `json:"data,omitempty"`
+}
+
 type UpdateNotificationTemplateMethod struct {
 	Method string `json:"method,omitempty" example:"webhook"`
 }
 
+// UserNotificationQuietHours controls when a user's non-urgent notifications are deferred. Deferred notifications are
+// delivered once the quiet hours or snooze end, rather than being dropped. Urgent notifications and Coder Inbox are
+// never deferred.
+type UserNotificationQuietHours struct {
+	// Enabled defers non-urgent notifications during the user's quiet hours.
+	Enabled bool `json:"enabled"`
+	// Schedule is the start of the user's quiet hours, as a cron expression. It is empty when quiet hours schedules
+	// are not available, in which case Enabled has no effect.
+	Schedule string `json:"schedule"`
+	// DurationMillis is how long quiet hours last from the start of each window.
+	DurationMillis int64 `json:"duration_ms" format:"int64"`
+	// SnoozeUntil defers all non-urgent notifications until the given time.
+	SnoozeUntil *time.Time `json:"snooze_until,omitempty" format:"date-time"`
+}
+
+type UpdateUserNotificationQuietHoursRequest struct {
+	Enabled bool `json:"enabled"`
+	// SnoozeUntil defers all non-urgent notifications until the given time, which must be within 72 hours. Omit it,
+	// or give a time in the past, to stop snoozing.
+	SnoozeUntil *time.Time `json:"snooze_until,omitempty" format:"date-time"`
+}
+
 type UpdateUserNotificationPreferences struct {
 	TemplateDisabledMap map[string]bool `json:"template_disabled_map"`
+	// TemplateTargetMap maps notification template IDs to the ID of one of the user's notification targets.
+	// An empty target ID clears the target, reverting to the template's or deployment's notification method.
+	TemplateTargetMap map[string]string `json:"template_target_map,omitempty"`
+	// TemplateDigestMap maps notification template IDs to whether their notifications should be held and
+	// delivered as a single digest, rather than individually.
+	TemplateDigestMap map[string]bool `json:"template_digest_map,omitempty"`
 }
 
 type WebpushMessageAction struct {
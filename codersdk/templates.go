@@ -64,6 +64,9 @@ type Template struct {
 	CORSBehavior         CORSBehavior                 `json:"cors_behavior"`
 
 	UseClassicParameterFlow bool `json:"use_classic_parameter_flow"`
+	// RecordSessions records the terminal output of SSH and web terminal
+	// sessions to workspaces created from this template.
+	RecordSessions bool `json:"record_sessions"`
 }
 
 // WeekdaysToBitmap converts a list of weekdays to a bitmap in accordance with
@@ -263,6 +266,10 @@ type UpdateTemplateMeta struct {
 	// made the default.
 	// An "opt-out" is present in case the new feature breaks some existing templates.
 	UseClassicParameterFlow *bool `json:"use_classic_parameter_flow,omitempty"`
+	// RecordSessions records the terminal output of SSH and web terminal
+	// sessions to workspaces created from this template. Recordings can be
+	// played back from the matching connection log.
+	RecordSessions *bool `json:"record_sessions,omitempty"`
 }
 
 type TemplateExample struct {
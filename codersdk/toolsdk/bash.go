@@ -82,6 +82,7 @@ Examples:
 			Required: []string{"workspace", "command"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceBashArgs) (res WorkspaceBashResult, err error) {
 		if args.Workspace == "" {
 			return WorkspaceBashResult{}, xerrors.New("workspace name cannot be empty")
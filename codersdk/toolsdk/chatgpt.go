@@ -300,6 +300,7 @@ List workspaces with multiple filters - running workspaces owned by "alice".
 			Required: []string{"query"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead, codersdk.APIKeyScopeTemplateRead},
 	Handler: func(ctx context.Context, deps Deps, args SearchArgs) (SearchResult, error) {
 		query, err := parseSearchQuery(args.Query)
 		if err != nil {
@@ -420,6 +421,7 @@ var ChatGPTFetch = Tool[FetchArgs, FetchResult]{
 			Required: []string{"id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead, codersdk.APIKeyScopeTemplateRead},
 	Handler: func(ctx context.Context, deps Deps, args FetchArgs) (FetchResult, error) {
 		objectID, err := parseObjectID(args.ID)
 		if err != nil {
@@ -0,0 +1,528 @@
+package toolsdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/aisdk-go"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+const gitDirectoryDescription = "The absolute path of the git repository, or of a directory inside it, in the workspace."
+
+// maxGitPatchSize is the maximum size of the patch returned by
+// coder_workspace_git_diff. Larger patches are truncated.
+const maxGitPatchSize = 1 << 18 // 256KiB
+
+type WorkspaceGitStatusArgs struct {
+	Workspace string `json:"workspace"`
+	Directory string `json:"directory"`
+}
+
+type WorkspaceGitStatusFile struct {
+	Path string `json:"path"`
+	// OrigPath is the path the file was renamed or copied from.
+	OrigPath string `json:"orig_path,omitempty"`
+	// Staged and Unstaged are the changes to the file in the index and the
+	// working tree, e.g. "modified", "added", "deleted", "renamed" or
+	// "untracked". They are empty if there are no changes.
+	Staged     string `json:"staged,omitempty"`
+	Unstaged   string `json:"unstaged,omitempty"`
+	Conflicted bool   `json:"conflicted,omitempty"`
+}
+
+type WorkspaceGitStatusResponse struct {
+	// Branch is empty when HEAD is detached.
+	Branch string `json:"branch"`
+	// Commit is empty when the branch has no commits yet.
+	Commit   string                   `json:"commit"`
+	Upstream string                   `json:"upstream,omitempty"`
+	Ahead    int                      `json:"ahead"`
+	Behind   int                      `json:"behind"`
+	Clean    bool                     `json:"clean"`
+	Files    []WorkspaceGitStatusFile `json:"files"`
+}
+
+var WorkspaceGitStatus = Tool[WorkspaceGitStatusArgs, WorkspaceGitStatusResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceGitStatus,
+		Description: `Get the status of a git repository in a workspace.
+
+Returns the current branch and commit, how far the branch is ahead of or behind
+its upstream, and the staged, unstaged, untracked and conflicted files.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+				"directory": map[string]any{
+					"type":        "string",
+					"description": gitDirectoryDescription,
+				},
+			},
+			Required: []string{"workspace", "directory"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceGitStatusArgs) (WorkspaceGitStatusResponse, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, 5*time.Minute, xerrors.New("MCP handler timeout after 5 min"))
+		defer cancel()
+
+		git, closeGit, err := dialWorkspaceGit(ctx, deps.coderClient, args.Workspace, args.Directory)
+		if err != nil {
+			return WorkspaceGitStatusResponse{}, err
+		}
+		defer closeGit()
+
+		out, err := git.run(ctx, "status", "--porcelain=v2", "--branch", "-z")
+		if err != nil {
+			return WorkspaceGitStatusResponse{}, err
+		}
+		return parseGitStatus(out)
+	},
+}
+
+type WorkspaceGitDiffArgs struct {
+	Workspace string   `json:"workspace"`
+	Directory string   `json:"directory"`
+	Staged    bool     `json:"staged,omitempty"`
+	Paths     []string `json:"paths,omitempty"`
+}
+
+type WorkspaceGitDiffFile struct {
+	Path string `json:"path"`
+	// OrigPath is the path the file was renamed or copied from.
+	OrigPath  string `json:"orig_path,omitempty"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Binary    bool   `json:"binary,omitempty"`
+}
+
+type WorkspaceGitDiffResponse struct {
+	Files []WorkspaceGitDiffFile `json:"files"`
+	Patch string                 `json:"patch"`
+	// Truncated is true when the patch exceeded 256KiB and was cut short.
+	Truncated bool `json:"truncated"`
+}
+
+var WorkspaceGitDiff = Tool[WorkspaceGitDiffArgs, WorkspaceGitDiffResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceGitDiff,
+		Description: `Show the changes in a git repository in a workspace.
+
+By default the unstaged changes in the working tree are shown. Set staged to
+show the changes that will be committed instead. Returns the number of added
+and deleted lines per file along with the patch, which is truncated at 256KiB.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+				"directory": map[string]any{
+					"type":        "string",
+					"description": gitDirectoryDescription,
+				},
+				"staged": map[string]any{
+					"type":        "boolean",
+					"description": "Whether to show the staged changes instead of the unstaged changes.",
+				},
+				"paths": map[string]any{
+					"type":        "array",
+					"description": "Limit the diff to these paths, relative to the directory.",
+					"items": map[string]any{
+						"type": "string",
+					},
+				},
+			},
+			Required: []string{"workspace", "directory"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceGitDiffArgs) (WorkspaceGitDiffResponse, error) {
+		ctx, cancel := context.WithTimeoutCause(ctx, 5*time.Minute, xerrors.New("MCP handler timeout after 5 min"))
+		defer cancel()
+
+		git, closeGit, err := dialWorkspaceGit(ctx, deps.coderClient, args.Workspace, args.Directory)
+		if err != nil {
+			return WorkspaceGitDiffResponse{}, err
+		}
+		defer closeGit()
+
+		diffArgs := []string{"diff", "--no-color", "--no-ext-diff"}
+		if args.Staged {
+			diffArgs = append(diffArgs, "--cached")
+		}
+		pathArgs := append([]string{"--"}, args.Paths...)
+
+		out, err := git.run(ctx, slices.Concat(diffArgs, []string{"--numstat", "-z"}, pathArgs)...)
+		if err != nil {
+			return WorkspaceGitDiffResponse{}, err
+		}
+		files, err := parseGitNumstat(out)
+		if err != nil {
+			return WorkspaceGitDiffResponse{}, err
+		}
+
+		patch, err := git.run(ctx, slices.Concat(diffArgs, pathArgs)...)
+		if err != nil {
+			return WorkspaceGitDiffResponse{}, err
+		}
+		res := WorkspaceGitDiffResponse{
+			Files: files,
+			Patch: string(patch),
+		}
+		if len(patch) > maxGitPatchSize {
+			res.Patch = strings.ToValidUTF8(string(patch[:maxGitPatchSize]), "")
+			res.Truncated = true
+		}
+		return res, nil
+	},
+}
+
+type WorkspaceGitCommitArgs struct {
+	Workspace string   `json:"workspace"`
+	Directory string   `json:"directory"`
+	Message   string   `json:"message"`
+	All       bool     `json:"all,omitempty"`
+	Paths     []string `json:"paths,omitempty"`
+}
+
+type WorkspaceGitCommitResponse struct {
+	Commit string                 `json:"commit"`
+	Branch string                 `json:"branch"`
+	Files  []WorkspaceGitDiffFile `json:"files"`
+	// Output is the output of git commit, including the output of any hooks.
+	Output string `json:"output"`
+}
+
+var WorkspaceGitCommit = Tool[WorkspaceGitCommitArgs, WorkspaceGitCommitResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceGitCommit,
+		Description: `Create a commit in a git repository in a workspace.
+
+The staged changes are committed. Set paths to stage those paths first, or all
+to also commit the changes to all tracked files. Untracked files are only
+committed when they are staged or listed in paths. Returns the new commit and
+the files it changed.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+				"directory": map[string]any{
+					"type":        "string",
+					"description": gitDirectoryDescription,
+				},
+				"message": map[string]any{
+					"type":        "string",
+					"description": "The commit message.",
+				},
+				"all": map[string]any{
+					"type":        "boolean",
+					"description": "Whether to commit the changes to all tracked files, like git commit --all.",
+				},
+				"paths": map[string]any{
+					"type":        "array",
+					"description": "Paths to stage before committing, relative to the directory.",
+					"items": map[string]any{
+						"type": "string",
+					},
+				},
+			},
+			Required: []string{"workspace", "directory", "message"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceGitCommitArgs) (WorkspaceGitCommitResponse, error) {
+		if strings.TrimSpace(args.Message) == "" {
+			return WorkspaceGitCommitResponse{}, xerrors.New("message cannot be empty")
+		}
+
+		ctx, cancel := context.WithTimeoutCause(ctx, 5*time.Minute, xerrors.New("MCP handler timeout after 5 min"))
+		defer cancel()
+
+		git, closeGit, err := dialWorkspaceGit(ctx, deps.coderClient, args.Workspace, args.Directory)
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+		defer closeGit()
+
+		if len(args.Paths) > 0 {
+			if _, err := git.run(ctx, append([]string{"add", "--"}, args.Paths...)...); err != nil {
+				return WorkspaceGitCommitResponse{}, err
+			}
+		}
+
+		commitArgs := []string{"commit", "--message", args.Message}
+		if args.All {
+			commitArgs = append(commitArgs, "--all")
+		}
+		output, err := git.run(ctx, commitArgs...)
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+
+		commit, err := git.run(ctx, "rev-parse", "HEAD")
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+		branch, err := git.run(ctx, "rev-parse", "--abbrev-ref", "HEAD")
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+		out, err := git.run(ctx, "diff-tree", "--root", "--no-commit-id", "-r", "-M", "--numstat", "-z", "HEAD")
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+		files, err := parseGitNumstat(out)
+		if err != nil {
+			return WorkspaceGitCommitResponse{}, err
+		}
+
+		return WorkspaceGitCommitResponse{
+			Commit: strings.TrimSpace(string(commit)),
+			Branch: strings.TrimSpace(string(branch)),
+			Files:  files,
+			Output: strings.TrimSpace(string(output)),
+		}, nil
+	},
+}
+
+// workspaceGit runs git commands in a directory of a workspace over SSH.
+type workspaceGit struct {
+	client    *gossh.Client
+	directory string
+}
+
+// dialWorkspaceGit connects to the workspace agent. The returned function
+// closes the connection.
+func dialWorkspaceGit(ctx context.Context, client *codersdk.Client, workspace, directory string) (workspaceGit, func(), error) {
+	if workspace == "" {
+		return workspaceGit{}, nil, xerrors.New("workspace name cannot be empty")
+	}
+	if directory == "" {
+		return workspaceGit{}, nil, xerrors.New("directory cannot be empty")
+	}
+
+	conn, err := newAgentConn(ctx, client, workspace)
+	if err != nil {
+		return workspaceGit{}, nil, err
+	}
+	sshClient, err := conn.SSHClient(ctx)
+	if err != nil {
+		conn.Close()
+		return workspaceGit{}, nil, xerrors.Errorf("failed to create SSH client: %w", err)
+	}
+	return workspaceGit{client: sshClient, directory: directory}, func() {
+		_ = sshClient.Close()
+		_ = conn.Close()
+	}, nil
+}
+
+// run runs git with the given arguments and returns its stdout. If git exits
+// with a non-zero status the error contains its output.
+func (g workspaceGit) run(ctx context.Context, args ...string) ([]byte, error) {
+	session, err := g.client.NewSession()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create SSH session: %w", err)
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	command := []string{"git", "-C", shellQuote(g.directory)}
+	for _, arg := range args {
+		command = append(command, shellQuote(arg))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(strings.Join(command, " "))
+	}()
+	select {
+	case <-ctx.Done():
+		_ = session.Close()
+		return nil, context.Cause(ctx)
+	case err = <-done:
+	}
+	if err != nil {
+		var exitErr *gossh.ExitError
+		if !errors.As(err, &exitErr) {
+			return nil, xerrors.Errorf("failed to run git %s: %w", args[0], err)
+		}
+		// Some failures, such as there being nothing to commit, are only
+		// reported on stdout.
+		output := strings.TrimSpace(stderr.String())
+		if output == "" {
+			output = strings.TrimSpace(stdout.String())
+		}
+		return nil, xerrors.Errorf("git %s exited with status %d: %s", args[0], exitErr.ExitStatus(), output)
+	}
+	return stdout.Bytes(), nil
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// parseGitStatus parses the output of git status --porcelain=v2 --branch -z.
+func parseGitStatus(out []byte) (WorkspaceGitStatusResponse, error) {
+	res := WorkspaceGitStatusResponse{
+		Files: []WorkspaceGitStatusFile{},
+	}
+	entries := strings.Split(string(out), "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if entry == "" {
+			continue
+		}
+
+		switch entry[0] {
+		case '#':
+			fields := strings.Fields(entry)
+			if len(fields) < 3 {
+				continue
+			}
+			switch fields[1] {
+			case "branch.oid":
+				if fields[2] != "(initial)" {
+					res.Commit = fields[2]
+				}
+			case "branch.head":
+				if fields[2] != "(detached)" {
+					res.Branch = fields[2]
+				}
+			case "branch.upstream":
+				res.Upstream = fields[2]
+			case "branch.ab":
+				if len(fields) != 4 {
+					return WorkspaceGitStatusResponse{}, xerrors.Errorf("unexpected git status header %q", entry)
+				}
+				res.Ahead, _ = strconv.Atoi(strings.TrimPrefix(fields[2], "+"))
+				res.Behind, _ = strconv.Atoi(strings.TrimPrefix(fields[3], "-"))
+			}
+		case '1':
+			// 1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+			fields := strings.SplitN(entry, " ", 9)
+			if len(fields) != 9 {
+				return WorkspaceGitStatusResponse{}, xerrors.Errorf("unexpected git status entry %q", entry)
+			}
+			res.Files = append(res.Files, gitStatusFile(fields[1], fields[8]))
+		case '2':
+			// 2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>, followed
+			// by the original path as a separate entry.
+			fields := strings.SplitN(entry, " ", 10)
+			if len(fields) != 10 || i+1 >= len(entries) {
+				return WorkspaceGitStatusResponse{}, xerrors.Errorf("unexpected git status entry %q", entry)
+			}
+			file := gitStatusFile(fields[1], fields[9])
+			i++
+			file.OrigPath = entries[i]
+			res.Files = append(res.Files, file)
+		case 'u':
+			// u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+			fields := strings.SplitN(entry, " ", 11)
+			if len(fields) != 11 {
+				return WorkspaceGitStatusResponse{}, xerrors.Errorf("unexpected git status entry %q", entry)
+			}
+			file := gitStatusFile(fields[1], fields[10])
+			file.Conflicted = true
+			res.Files = append(res.Files, file)
+		case '?':
+			res.Files = append(res.Files, WorkspaceGitStatusFile{
+				Path:     strings.TrimPrefix(entry, "? "),
+				Unstaged: "untracked",
+			})
+		}
+	}
+	res.Clean = len(res.Files) == 0
+	return res, nil
+}
+
+func gitStatusFile(xy, path string) WorkspaceGitStatusFile {
+	file := WorkspaceGitStatusFile{Path: path}
+	if len(xy) == 2 {
+		file.Staged = gitFileStatus(xy[0])
+		file.Unstaged = gitFileStatus(xy[1])
+	}
+	return file
+}
+
+func gitFileStatus(status byte) string {
+	switch status {
+	case 'M':
+		return "modified"
+	case 'T':
+		return "type_changed"
+	case 'A':
+		return "added"
+	case 'D':
+		return "deleted"
+	case 'R':
+		return "renamed"
+	case 'C':
+		return "copied"
+	case 'U':
+		return "unmerged"
+	default:
+		return ""
+	}
+}
+
+// parseGitNumstat parses the output of git diff --numstat -z.
+func parseGitNumstat(out []byte) ([]WorkspaceGitDiffFile, error) {
+	files := []WorkspaceGitDiffFile{}
+	entries := strings.Split(string(out), "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := strings.TrimPrefix(entries[i], "\n")
+		if entry == "" {
+			continue
+		}
+
+		// <added>\t<deleted>\t<path>. Renames and copies have an empty path
+		// followed by the original and new paths as separate entries.
+		fields := strings.SplitN(entry, "\t", 3)
+		if len(fields) != 3 {
+			return nil, xerrors.Errorf("unexpected git numstat entry %q", entry)
+		}
+		file := WorkspaceGitDiffFile{Path: fields[2]}
+		if fields[0] == "-" && fields[1] == "-" {
+			file.Binary = true
+		} else {
+			var err error
+			if file.Additions, err = strconv.Atoi(fields[0]); err != nil {
+				return nil, xerrors.Errorf("unexpected git numstat entry %q: %w", entry, err)
+			}
+			if file.Deletions, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, xerrors.Errorf("unexpected git numstat entry %q: %w", entry, err)
+			}
+		}
+		if file.Path == "" {
+			if i+2 >= len(entries) {
+				return nil, xerrors.Errorf("unexpected git numstat entry %q", entry)
+			}
+			file.OrigPath, file.Path = entries[i+1], entries[i+2]
+			i += 2
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
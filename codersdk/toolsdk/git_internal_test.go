@@ -0,0 +1,95 @@
+package toolsdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Changes", func(t *testing.T) {
+		t.Parallel()
+
+		out := "# branch.oid 6f1c7e2a9d3b4c5e8f0a1b2c3d4e5f6a7b8c9d0e\x00" +
+			"# branch.head feature\x00" +
+			"# branch.upstream origin/feature\x00" +
+			"# branch.ab +2 -1\x00" +
+			"1 .M N... 100644 100644 100644 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 src/main.go\x00" +
+			"1 A. N... 000000 100644 100644 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 file with spaces.txt\x00" +
+			"2 R. N... 100644 100644 100644 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 R100 new.txt\x00old.txt\x00" +
+			"u UU N... 100644 100644 100644 100644 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 0000000000000000000000000000000000000000 conflict.txt\x00" +
+			"? untracked.txt\x00"
+
+		res, err := parseGitStatus([]byte(out))
+		require.NoError(t, err)
+		require.Equal(t, WorkspaceGitStatusResponse{
+			Branch:   "feature",
+			Commit:   "6f1c7e2a9d3b4c5e8f0a1b2c3d4e5f6a7b8c9d0e",
+			Upstream: "origin/feature",
+			Ahead:    2,
+			Behind:   1,
+			Files: []WorkspaceGitStatusFile{
+				{Path: "src/main.go", Unstaged: "modified"},
+				{Path: "file with spaces.txt", Staged: "added"},
+				{Path: "new.txt", OrigPath: "old.txt", Staged: "renamed"},
+				{Path: "conflict.txt", Staged: "unmerged", Unstaged: "unmerged", Conflicted: true},
+				{Path: "untracked.txt", Unstaged: "untracked"},
+			},
+		}, res)
+	})
+
+	t.Run("InitialDetached", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := parseGitStatus([]byte("# branch.oid (initial)\x00# branch.head (detached)\x00"))
+		require.NoError(t, err)
+		require.Equal(t, WorkspaceGitStatusResponse{
+			Clean: true,
+			Files: []WorkspaceGitStatusFile{},
+		}, res)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseGitStatus([]byte("1 .M N...\x00"))
+		require.ErrorContains(t, err, "unexpected git status entry")
+	})
+}
+
+func TestParseGitNumstat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Changes", func(t *testing.T) {
+		t.Parallel()
+
+		out := "3\t1\tsrc/main.go\x00" +
+			"-\t-\timage.png\x00" +
+			"0\t0\t\x00old name.txt\x00new name.txt\x00"
+
+		files, err := parseGitNumstat([]byte(out))
+		require.NoError(t, err)
+		require.Equal(t, []WorkspaceGitDiffFile{
+			{Path: "src/main.go", Additions: 3, Deletions: 1},
+			{Path: "image.png", Binary: true},
+			{Path: "new name.txt", OrigPath: "old name.txt"},
+		}, files)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		files, err := parseGitNumstat(nil)
+		require.NoError(t, err)
+		require.Empty(t, files)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseGitNumstat([]byte("3\tsrc/main.go\x00"))
+		require.ErrorContains(t, err, "unexpected git numstat entry")
+	})
+}
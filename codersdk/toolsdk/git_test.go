@@ -0,0 +1,161 @@
+package toolsdk_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/codersdk/toolsdk"
+)
+
+// These subtests share a repository and depend on each other's changes.
+// nolint:tparallel,paralleltest
+func TestWorkspaceGit(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("git tools use a POSIX shell")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t, nil)
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.NewWorkspaceAgentWaiter(t, client, workspace.ID).Wait()
+	tb, err := toolsdk.NewDeps(client)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	writeFile := func(name, content string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	// Given: a repository with a commit, an unstaged change, a staged file and
+	// an untracked file.
+	git("init")
+	git("symbolic-ref", "HEAD", "refs/heads/main")
+	git("config", "user.name", "Coder")
+	git("config", "user.email", "test@coder.com")
+	git("config", "commit.gpgsign", "false")
+	writeFile("a.txt", "one\n")
+	git("add", "a.txt")
+	git("commit", "--message", "Initial commit")
+	writeFile("a.txt", "two\n")
+	writeFile("b.txt", "untracked\n")
+	writeFile("c.txt", "staged\n")
+	git("add", "c.txt")
+
+	t.Run("Status", func(t *testing.T) {
+		res, err := testTool(t, toolsdk.WorkspaceGitStatus, tb, toolsdk.WorkspaceGitStatusArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "main", res.Branch)
+		require.Len(t, res.Commit, 40)
+		require.False(t, res.Clean)
+		require.ElementsMatch(t, []toolsdk.WorkspaceGitStatusFile{
+			{Path: "a.txt", Unstaged: "modified"},
+			{Path: "b.txt", Unstaged: "untracked"},
+			{Path: "c.txt", Staged: "added"},
+		}, res.Files)
+	})
+
+	t.Run("NotARepository", func(t *testing.T) {
+		_, err := testTool(t, toolsdk.WorkspaceGitStatus, tb, toolsdk.WorkspaceGitStatusArgs{
+			Workspace: workspace.Name,
+			Directory: t.TempDir(),
+		})
+		require.ErrorContains(t, err, "not a git repository")
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		res, err := testTool(t, toolsdk.WorkspaceGitDiff, tb, toolsdk.WorkspaceGitDiffArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []toolsdk.WorkspaceGitDiffFile{
+			{Path: "a.txt", Additions: 1, Deletions: 1},
+		}, res.Files)
+		require.Contains(t, res.Patch, "-one\n+two\n")
+		require.False(t, res.Truncated)
+	})
+
+	t.Run("DiffStaged", func(t *testing.T) {
+		res, err := testTool(t, toolsdk.WorkspaceGitDiff, tb, toolsdk.WorkspaceGitDiffArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+			Staged:    true,
+			Paths:     []string{"c.txt"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []toolsdk.WorkspaceGitDiffFile{
+			{Path: "c.txt", Additions: 1},
+		}, res.Files)
+		require.Contains(t, res.Patch, "+staged\n")
+	})
+
+	t.Run("CommitEmptyMessage", func(t *testing.T) {
+		_, err := testTool(t, toolsdk.WorkspaceGitCommit, tb, toolsdk.WorkspaceGitCommitArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+		})
+		require.ErrorContains(t, err, "message cannot be empty")
+	})
+
+	t.Run("Commit", func(t *testing.T) {
+		// When: committing the staged file along with a.txt.
+		res, err := testTool(t, toolsdk.WorkspaceGitCommit, tb, toolsdk.WorkspaceGitCommitArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+			Message:   "Update a's content",
+			Paths:     []string{"a.txt"},
+		})
+		require.NoError(t, err)
+
+		// Then: both files are part of the commit and only the untracked file
+		// remains.
+		require.Equal(t, "main", res.Branch)
+		require.Len(t, res.Commit, 40)
+		require.ElementsMatch(t, []toolsdk.WorkspaceGitDiffFile{
+			{Path: "a.txt", Additions: 1, Deletions: 1},
+			{Path: "c.txt", Additions: 1},
+		}, res.Files)
+
+		status, err := testTool(t, toolsdk.WorkspaceGitStatus, tb, toolsdk.WorkspaceGitStatusArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+		})
+		require.NoError(t, err)
+		require.Equal(t, res.Commit, status.Commit)
+		require.Equal(t, []toolsdk.WorkspaceGitStatusFile{
+			{Path: "b.txt", Unstaged: "untracked"},
+		}, status.Files)
+
+		out, err := exec.Command("git", "-C", dir, "log", "-1", "--format=%s").CombinedOutput()
+		require.NoError(t, err, string(out))
+		require.Equal(t, "Update a's content\n", string(out))
+	})
+
+	t.Run("CommitNothingStaged", func(t *testing.T) {
+		_, err := testTool(t, toolsdk.WorkspaceGitCommit, tb, toolsdk.WorkspaceGitCommitArgs{
+			Workspace: workspace.Name,
+			Directory: dir,
+			Message:   "Nothing",
+		})
+		require.ErrorContains(t, err, "nothing added to commit")
+	})
+}
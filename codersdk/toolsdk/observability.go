@@ -0,0 +1,304 @@
+package toolsdk
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/aisdk-go"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+type WorkspaceListeningPortsArgs struct {
+	Workspace string `json:"workspace"`
+}
+
+type WorkspaceListeningPortsResponse struct {
+	Ports []codersdk.WorkspaceAgentListeningPort `json:"ports"`
+}
+
+var WorkspaceListeningPorts = Tool[WorkspaceListeningPortsArgs, WorkspaceListeningPortsResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceListeningPorts,
+		Description: `List the ports that processes are listening on in a workspace.
+
+Ports which are reserved by the agent or are below the minimum port are not
+listed. Use coder_workspace_port_forward to get a URL for one of the ports.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+			},
+			Required: []string{"workspace"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceListeningPortsArgs) (WorkspaceListeningPortsResponse, error) {
+		_, workspaceAgent, err := findWorkspaceAgent(ctx, deps.coderClient, args.Workspace)
+		if err != nil {
+			return WorkspaceListeningPortsResponse{}, err
+		}
+		if workspaceAgent.Status != codersdk.WorkspaceAgentConnected {
+			return WorkspaceListeningPortsResponse{}, xerrors.Errorf("agent %q is %s", workspaceAgent.Name, workspaceAgent.Status)
+		}
+
+		res, err := deps.coderClient.WorkspaceAgentListeningPorts(ctx, workspaceAgent.ID)
+		if err != nil {
+			return WorkspaceListeningPortsResponse{}, xerrors.Errorf("failed to list listening ports: %w", err)
+		}
+		ports := res.Ports
+		if ports == nil {
+			ports = []codersdk.WorkspaceAgentListeningPort{}
+		}
+		sort.Slice(ports, func(i, j int) bool {
+			return ports[i].Port < ports[j].Port
+		})
+		return WorkspaceListeningPortsResponse{Ports: ports}, nil
+	},
+}
+
+type WorkspaceScriptLogsArgs struct {
+	Workspace string `json:"workspace"`
+	LogSource string `json:"log_source,omitempty"`
+	Tail      int    `json:"tail,omitempty"`
+}
+
+type WorkspaceScriptLog struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Level     codersdk.LogLevel `json:"level"`
+	LogSource string            `json:"log_source"`
+	Output    string            `json:"output"`
+}
+
+type WorkspaceScriptLogsResponse struct {
+	// LogSources are the names of all log sources of the agent, which can be
+	// used to filter the logs.
+	LogSources []string             `json:"log_sources"`
+	Logs       []WorkspaceScriptLog `json:"logs"`
+	// Truncated is true when older lines were omitted to respect the tail.
+	Truncated bool `json:"truncated"`
+}
+
+const (
+	defaultScriptLogsTail = 100
+	maxScriptLogsTail     = 1000
+)
+
+var WorkspaceScriptLogs = Tool[WorkspaceScriptLogsArgs, WorkspaceScriptLogsResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceScriptLogs,
+		Description: `Tail the logs of the scripts run by a workspace agent, such as startup scripts.
+
+Each script writes to a log source. Pass log_source to only return the logs of
+one script; the names of all log sources are included in the response.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+				"log_source": map[string]any{
+					"type":        "string",
+					"description": "The display name or ID of the log source to return logs for. Defaults to all log sources.",
+				},
+				"tail": map[string]any{
+					"type":        "integer",
+					"description": "The number of most recent log lines to return. Defaults to 100, maximum of 1000.",
+					"default":     defaultScriptLogsTail,
+					"minimum":     1,
+					"maximum":     maxScriptLogsTail,
+				},
+			},
+			Required: []string{"workspace"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceScriptLogsArgs) (WorkspaceScriptLogsResponse, error) {
+		tail := args.Tail
+		if tail <= 0 {
+			tail = defaultScriptLogsTail
+		}
+		if tail > maxScriptLogsTail {
+			return WorkspaceScriptLogsResponse{}, xerrors.Errorf("tail cannot exceed %d", maxScriptLogsTail)
+		}
+
+		_, workspaceAgent, err := findWorkspaceAgent(ctx, deps.coderClient, args.Workspace)
+		if err != nil {
+			return WorkspaceScriptLogsResponse{}, err
+		}
+
+		res := WorkspaceScriptLogsResponse{
+			LogSources: make([]string, 0, len(workspaceAgent.LogSources)),
+			Logs:       []WorkspaceScriptLog{},
+		}
+		sourceNames := make(map[string]string, len(workspaceAgent.LogSources))
+		var sourceID string
+		for _, source := range workspaceAgent.LogSources {
+			sourceNames[source.ID.String()] = source.DisplayName
+			res.LogSources = append(res.LogSources, source.DisplayName)
+			if args.LogSource != "" && (strings.EqualFold(source.DisplayName, args.LogSource) || source.ID.String() == args.LogSource) {
+				sourceID = source.ID.String()
+			}
+		}
+		if args.LogSource != "" && sourceID == "" {
+			return WorkspaceScriptLogsResponse{}, xerrors.Errorf("log source %q not found, available log sources: %s", args.LogSource, strings.Join(res.LogSources, ", "))
+		}
+
+		logs, closer, err := deps.coderClient.WorkspaceAgentLogsAfter(ctx, workspaceAgent.ID, 0, false)
+		if err != nil {
+			return WorkspaceScriptLogsResponse{}, xerrors.Errorf("failed to get agent logs: %w", err)
+		}
+		defer closer.Close()
+		for logChunk := range logs {
+			for _, log := range logChunk {
+				if sourceID != "" && log.SourceID.String() != sourceID {
+					continue
+				}
+				res.Logs = append(res.Logs, WorkspaceScriptLog{
+					CreatedAt: log.CreatedAt,
+					Level:     log.Level,
+					LogSource: sourceNames[log.SourceID.String()],
+					Output:    log.Output,
+				})
+			}
+		}
+		if len(res.Logs) > tail {
+			res.Logs = res.Logs[len(res.Logs)-tail:]
+			res.Truncated = true
+		}
+		return res, nil
+	},
+}
+
+type WorkspaceMetadataArgs struct {
+	Workspace string `json:"workspace"`
+}
+
+type WorkspaceAgentMetadataValue struct {
+	Key         string    `json:"key"`
+	DisplayName string    `json:"display_name"`
+	Value       string    `json:"value"`
+	Error       string    `json:"error,omitempty"`
+	CollectedAt time.Time `json:"collected_at"`
+}
+
+type WorkspaceResourceMetadataValue struct {
+	Resource  string `json:"resource"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Sensitive bool   `json:"sensitive"`
+}
+
+type WorkspaceMetadataResponse struct {
+	// Agent holds the metadata collected by the agent's metadata scripts.
+	Agent []WorkspaceAgentMetadataValue `json:"agent"`
+	// Resources holds the metadata attached to the workspace resources by
+	// the template.
+	Resources []WorkspaceResourceMetadataValue `json:"resources"`
+}
+
+var WorkspaceMetadata = Tool[WorkspaceMetadataArgs, WorkspaceMetadataResponse]{
+	Tool: aisdk.Tool{
+		Name: ToolNameWorkspaceMetadata,
+		Description: `Read the metadata of a workspace.
+
+This includes the latest values collected by the agent's metadata scripts, such
+as CPU or disk usage, and the metadata the template attaches to workspace
+resources. Sensitive resource metadata values are redacted.`,
+		Schema: aisdk.Schema{
+			Properties: map[string]any{
+				"workspace": map[string]any{
+					"type":        "string",
+					"description": workspaceDescription,
+				},
+			},
+			Required: []string{"workspace"},
+		},
+	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
+	UserClientOptional: true,
+	Handler: func(ctx context.Context, deps Deps, args WorkspaceMetadataArgs) (WorkspaceMetadataResponse, error) {
+		workspace, workspaceAgent, err := findWorkspaceAgent(ctx, deps.coderClient, args.Workspace)
+		if err != nil {
+			return WorkspaceMetadataResponse{}, err
+		}
+
+		res := WorkspaceMetadataResponse{
+			Agent:     []WorkspaceAgentMetadataValue{},
+			Resources: []WorkspaceResourceMetadataValue{},
+		}
+		for _, resource := range workspace.LatestBuild.Resources {
+			for _, item := range resource.Metadata {
+				value := item.Value
+				if item.Sensitive {
+					value = "<redacted>"
+				}
+				res.Resources = append(res.Resources, WorkspaceResourceMetadataValue{
+					Resource:  resource.Type + "." + resource.Name,
+					Key:       item.Key,
+					Value:     value,
+					Sensitive: item.Sensitive,
+				})
+			}
+		}
+
+		// The watch endpoint sends the current values as its first event, so
+		// the stream is closed once it has been received.
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		metadata, errs := deps.coderClient.WatchWorkspaceAgentMetadata(watchCtx, workspaceAgent.ID)
+		select {
+		case <-ctx.Done():
+			return WorkspaceMetadataResponse{}, ctx.Err()
+		case err := <-errs:
+			return WorkspaceMetadataResponse{}, xerrors.Errorf("failed to get agent metadata: %w", err)
+		case items := <-metadata:
+			for _, item := range items {
+				res.Agent = append(res.Agent, WorkspaceAgentMetadataValue{
+					Key:         item.Description.Key,
+					DisplayName: item.Description.DisplayName,
+					Value:       strings.TrimSpace(item.Result.Value),
+					Error:       item.Result.Error,
+					CollectedAt: item.Result.CollectedAt,
+				})
+			}
+		}
+		sort.Slice(res.Agent, func(i, j int) bool {
+			return res.Agent[i].Key < res.Agent[j].Key
+		})
+		return res, nil
+	},
+}
+
+// findWorkspaceAgent finds the workspace and agent by name. Unlike
+// findWorkspaceAndAgent it does not start the workspace, which read-only tools
+// should not do.
+func findWorkspaceAgent(ctx context.Context, client *codersdk.Client, workspace string) (codersdk.Workspace, codersdk.WorkspaceAgent, error) {
+	if workspace == "" {
+		return codersdk.Workspace{}, codersdk.WorkspaceAgent{}, xerrors.New("workspace name cannot be empty")
+	}
+
+	workspaceName := NormalizeWorkspaceInput(workspace)
+	var agentName string
+	if parts := strings.Split(workspaceName, "."); len(parts) >= 2 {
+		workspaceName, agentName = parts[0], parts[1]
+	}
+
+	ws, err := namedWorkspace(ctx, client, workspaceName)
+	if err != nil {
+		return codersdk.Workspace{}, codersdk.WorkspaceAgent{}, xerrors.Errorf("failed to find workspace: %w", err)
+	}
+	workspaceAgent, err := getWorkspaceAgent(ws, agentName)
+	if err != nil {
+		return codersdk.Workspace{}, codersdk.WorkspaceAgent{}, err
+	}
+	return ws, workspaceAgent, nil
+}
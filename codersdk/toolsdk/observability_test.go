@@ -0,0 +1,199 @@
+package toolsdk_test
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/agent"
+	"github.com/coder/coder/v2/agent/agenttest"
+	"github.com/coder/coder/v2/coderd/coderdtest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbfake"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/toolsdk"
+	"github.com/coder/coder/v2/codersdk/workspacesdk"
+	"github.com/coder/coder/v2/provisionersdk/proto"
+	"github.com/coder/coder/v2/testutil"
+)
+
+// setupWorkspaceWithBuild is like setupWorkspaceForAgent, but allows the
+// workspace build to be customized.
+// nolint:gocritic // This is in a test package and does not end up in the build
+func setupWorkspaceWithBuild(t *testing.T, build func(dbfake.WorkspaceBuildBuilder) dbfake.WorkspaceBuildBuilder) (*codersdk.Client, database.WorkspaceTable, string) {
+	t.Helper()
+
+	client, store := coderdtest.NewWithDatabase(t, nil)
+	client.SetLogger(testutil.Logger(t).Named("client"))
+	first := coderdtest.CreateFirstUser(t, client)
+	userClient, user := coderdtest.CreateAnotherUserMutators(t, client, first.OrganizationID, nil, func(r *codersdk.CreateUserRequestWithOrgs) {
+		r.Username = "myuser"
+	})
+	r := build(dbfake.WorkspaceBuild(t, store, database.WorkspaceTable{
+		Name:           "myworkspace",
+		OrganizationID: first.OrganizationID,
+		OwnerID:        user.ID,
+	})).Do()
+
+	return userClient, r.Workspace, r.AgentToken
+}
+
+func TestWorkspaceListeningPorts(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" && runtime.GOOS != "windows" {
+		t.Skip("listening ports are only detected on Linux and Windows")
+	}
+
+	client, workspace, agentToken := setupWorkspaceForAgent(t, nil)
+	_ = agenttest.New(t, client.URL, agentToken, func(o *agent.Options) {
+		o.PortCacheDuration = time.Millisecond
+	})
+	coderdtest.NewWorkspaceAgentWaiter(t, client, workspace.ID).Wait()
+	tb, err := toolsdk.NewDeps(client)
+	require.NoError(t, err)
+
+	// Given: a process listening on a port which the agent doesn't ignore.
+	var port uint16
+	for {
+		l, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = l.Close() })
+		port = uint16(l.Addr().(*net.TCPAddr).Port) //nolint:gosec // Ports fit in uint16.
+		if _, ignored := workspacesdk.AgentIgnoredListeningPorts[port]; !ignored {
+			break
+		}
+	}
+
+	// When: listing the listening ports.
+	res, err := testTool(t, toolsdk.WorkspaceListeningPorts, tb, toolsdk.WorkspaceListeningPortsArgs{
+		Workspace: workspace.Name,
+	})
+	require.NoError(t, err)
+
+	// Then: the port is listed.
+	require.True(t, slices.ContainsFunc(res.Ports, func(p codersdk.WorkspaceAgentListeningPort) bool {
+		return p.Network == "tcp" && p.Port == port
+	}), "port %d not listed in %v", port, res.Ports)
+}
+
+func TestWorkspaceScriptLogs(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("scripts use a POSIX shell")
+	}
+
+	client, workspace, agentToken := setupWorkspaceWithBuild(t, func(b dbfake.WorkspaceBuildBuilder) dbfake.WorkspaceBuildBuilder {
+		return b.WithAgent(func(agents []*proto.Agent) []*proto.Agent {
+			agents[0].Scripts = []*proto.Script{
+				{
+					DisplayName: "Setup",
+					Script:      "echo setup-1; echo setup-2; echo setup-3",
+					RunOnStart:  true,
+				},
+				{
+					DisplayName: "Other",
+					Script:      "echo other",
+					RunOnStart:  true,
+				},
+			}
+			return agents
+		})
+	})
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.NewWorkspaceAgentWaiter(t, client, workspace.ID).Wait()
+	tb, err := toolsdk.NewDeps(client)
+	require.NoError(t, err)
+
+	t.Run("UnknownLogSource", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := testTool(t, toolsdk.WorkspaceScriptLogs, tb, toolsdk.WorkspaceScriptLogsArgs{
+			Workspace: workspace.Name,
+			LogSource: "missing",
+		})
+		require.ErrorContains(t, err, `log source "missing" not found`)
+		require.ErrorContains(t, err, "Setup")
+	})
+
+	t.Run("TailLogSource", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		// The agent sends its logs asynchronously, so wait for all of them.
+		var res toolsdk.WorkspaceScriptLogsResponse
+		testutil.Eventually(ctx, t, func(context.Context) bool {
+			var err error
+			res, err = testTool(t, toolsdk.WorkspaceScriptLogs, tb, toolsdk.WorkspaceScriptLogsArgs{
+				Workspace: workspace.Name,
+				LogSource: "setup",
+				Tail:      2,
+			})
+			require.NoError(t, err)
+			return len(res.Logs) == 2 && res.Logs[1].Output == "setup-3"
+		}, testutil.IntervalFast)
+
+		require.ElementsMatch(t, []string{"Setup", "Other"}, res.LogSources)
+		require.True(t, res.Truncated)
+		require.Equal(t, "setup-2", res.Logs[0].Output)
+		for _, log := range res.Logs {
+			require.Equal(t, "Setup", log.LogSource)
+		}
+	})
+}
+
+func TestWorkspaceMetadata(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("metadata scripts use a POSIX shell")
+	}
+
+	client, workspace, agentToken := setupWorkspaceWithBuild(t, func(b dbfake.WorkspaceBuildBuilder) dbfake.WorkspaceBuildBuilder {
+		return b.Resource(&proto.Resource{
+			Name: "home",
+			Type: "docker_volume",
+			Metadata: []*proto.Resource_Metadata{
+				{Key: "size", Value: "10GiB"},
+				{Key: "password", Value: "hunter2", Sensitive: true},
+			},
+		}).WithAgent(func(agents []*proto.Agent) []*proto.Agent {
+			agents[0].Metadata = []*proto.Agent_Metadata{{
+				DisplayName: "Greeting",
+				Key:         "greeting",
+				Script:      "echo hello",
+				Interval:    1,
+				Timeout:     1,
+			}}
+			return agents
+		})
+	})
+	_ = agenttest.New(t, client.URL, agentToken)
+	coderdtest.NewWorkspaceAgentWaiter(t, client, workspace.ID).Wait()
+	tb, err := toolsdk.NewDeps(client)
+	require.NoError(t, err)
+
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	// The agent collects metadata in the background, so wait for the first
+	// value to be reported.
+	var res toolsdk.WorkspaceMetadataResponse
+	testutil.Eventually(ctx, t, func(context.Context) bool {
+		res, err = testTool(t, toolsdk.WorkspaceMetadata, tb, toolsdk.WorkspaceMetadataArgs{
+			Workspace: workspace.Name,
+		})
+		require.NoError(t, err)
+		return len(res.Agent) == 1 && res.Agent[0].Value == "hello"
+	}, testutil.IntervalFast)
+
+	require.Equal(t, "greeting", res.Agent[0].Key)
+	require.Equal(t, "Greeting", res.Agent[0].DisplayName)
+	require.ElementsMatch(t, []toolsdk.WorkspaceResourceMetadataValue{
+		{Resource: "docker_volume.home", Key: "size", Value: "10GiB"},
+		{Resource: "docker_volume.home", Key: "password", Value: "<redacted>", Sensitive: true},
+	}, res.Resources)
+}
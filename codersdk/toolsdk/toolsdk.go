@@ -51,6 +51,12 @@ const (
 	ToolNameWorkspaceEditFiles          = "coder_workspace_edit_files"
 	ToolNameWorkspacePortForward        = "coder_workspace_port_forward"
 	ToolNameWorkspaceListApps           = "coder_workspace_list_apps"
+	ToolNameWorkspaceListeningPorts     = "coder_workspace_listening_ports"
+	ToolNameWorkspaceScriptLogs         = "coder_workspace_script_logs"
+	ToolNameWorkspaceMetadata           = "coder_workspace_metadata"
+	ToolNameWorkspaceGitStatus          = "coder_workspace_git_status"
+	ToolNameWorkspaceGitDiff            = "coder_workspace_git_diff"
+	ToolNameWorkspaceGitCommit          = "coder_workspace_git_commit"
 	ToolNameCreateTask                  = "coder_create_task"
 	ToolNameDeleteTask                  = "coder_delete_task"
 	ToolNameListTasks                   = "coder_list_tasks"
@@ -98,6 +104,11 @@ type Tool[Arg, Ret any] struct {
 	aisdk.Tool
 	Handler HandlerFunc[Arg, Ret]
 
+	// Scopes are the API key scopes a client needs to use this tool. The
+	// remote MCP server only exposes the tool to clients whose API key has
+	// all of them. Tools without scopes require an unrestricted API key.
+	Scopes []codersdk.APIKeyScope
+
 	// UserClientOptional indicates whether this tool can function without a valid
 	// user authentication token. If true, the tool will be available even when
 	// running in an unauthenticated mode with just an agent token.
@@ -112,6 +123,7 @@ type Tool[Arg, Ret any] struct {
 func (t Tool[Arg, Ret]) Generic() GenericTool {
 	return GenericTool{
 		Tool:               t.Tool,
+		Scopes:             t.Scopes,
 		UserClientOptional: t.UserClientOptional,
 		Handler: wrap(func(ctx context.Context, deps Deps, args json.RawMessage) (json.RawMessage, error) {
 			var typedArgs Arg
@@ -135,6 +147,11 @@ type GenericTool struct {
 	aisdk.Tool
 	Handler GenericHandlerFunc
 
+	// Scopes are the API key scopes a client needs to use this tool. The
+	// remote MCP server only exposes the tool to clients whose API key has
+	// all of them. Tools without scopes require an unrestricted API key.
+	Scopes []codersdk.APIKeyScope
+
 	// UserClientOptional indicates whether this tool can function without a valid
 	// user authentication token. If true, the tool will be available even when
 	// running in an unauthenticated mode with just an agent token.
@@ -231,6 +248,12 @@ var All = []GenericTool{
 	WorkspaceEditFiles.Generic(),
 	WorkspacePortForward.Generic(),
 	WorkspaceListApps.Generic(),
+	WorkspaceListeningPorts.Generic(),
+	WorkspaceScriptLogs.Generic(),
+	WorkspaceMetadata.Generic(),
+	WorkspaceGitStatus.Generic(),
+	WorkspaceGitDiff.Generic(),
+	WorkspaceGitCommit.Generic(),
 	CreateTask.Generic(),
 	DeleteTask.Generic(),
 	ListTasks.Generic(),
@@ -329,6 +352,7 @@ This returns more data than list_workspaces to reduce token usage.`,
 			Required: []string{"workspace_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
 	Handler: func(ctx context.Context, deps Deps, args GetWorkspaceArgs) (codersdk.Workspace, error) {
 		wsID, err := uuid.Parse(args.WorkspaceID)
 		if err != nil {
@@ -376,6 +400,7 @@ is provisioned correctly and the agent can connect to the control plane.
 			Required: []string{"user", "template_version_id", "name", "rich_parameters"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceCreate, codersdk.APIKeyScopeTemplateUse},
 	Handler: func(ctx context.Context, deps Deps, args CreateWorkspaceArgs) (codersdk.Workspace, error) {
 		tvID, err := uuid.Parse(args.TemplateVersionID)
 		if err != nil {
@@ -421,6 +446,7 @@ var ListWorkspaces = Tool[ListWorkspacesArgs, []MinimalWorkspace]{
 			Required: []string{},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
 	Handler: func(ctx context.Context, deps Deps, args ListWorkspacesArgs) ([]MinimalWorkspace, error) {
 		owner := args.Owner
 		if owner == "" {
@@ -458,6 +484,7 @@ var ListTemplates = Tool[NoArgs, []MinimalTemplate]{
 			Required:   []string{},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateRead},
 	Handler: func(ctx context.Context, deps Deps, _ NoArgs) ([]MinimalTemplate, error) {
 		templates, err := deps.coderClient.Templates(ctx, codersdk.TemplateFilter{})
 		if err != nil {
@@ -495,6 +522,7 @@ var ListTemplateVersionParameters = Tool[ListTemplateVersionParametersArgs, []co
 			Required: []string{"template_version_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateRead},
 	Handler: func(ctx context.Context, deps Deps, args ListTemplateVersionParametersArgs) ([]codersdk.TemplateVersionParameter, error) {
 		templateVersionID, err := uuid.Parse(args.TemplateVersionID)
 		if err != nil {
@@ -517,6 +545,7 @@ var GetAuthenticatedUser = Tool[NoArgs, codersdk.User]{
 			Required:   []string{},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeUserReadPersonal},
 	Handler: func(ctx context.Context, deps Deps, _ NoArgs) (codersdk.User, error) {
 		return deps.coderClient.User(ctx, "me")
 	},
@@ -550,6 +579,7 @@ var CreateWorkspaceBuild = Tool[CreateWorkspaceBuildArgs, codersdk.WorkspaceBuil
 			Required: []string{"workspace_id", "transition"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceUpdate},
 	Handler: func(ctx context.Context, deps Deps, args CreateWorkspaceBuildArgs) (codersdk.WorkspaceBuild, error) {
 		workspaceID, err := uuid.Parse(args.WorkspaceID)
 		if err != nil {
@@ -1043,6 +1073,7 @@ The file_id provided is a reference to a tar file you have uploaded containing t
 			Required: []string{"file_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateUpdate},
 	Handler: func(ctx context.Context, deps Deps, args CreateTemplateVersionArgs) (codersdk.TemplateVersion, error) {
 		me, err := deps.coderClient.User(ctx, "me")
 		if err != nil {
@@ -1093,6 +1124,7 @@ var GetWorkspaceAgentLogs = Tool[GetWorkspaceAgentLogsArgs, []string]{
 			Required: []string{"workspace_agent_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
 	Handler: func(ctx context.Context, deps Deps, args GetWorkspaceAgentLogsArgs) ([]string, error) {
 		workspaceAgentID, err := uuid.Parse(args.WorkspaceAgentID)
 		if err != nil {
@@ -1132,6 +1164,7 @@ var GetWorkspaceBuildLogs = Tool[GetWorkspaceBuildLogsArgs, []string]{
 			Required: []string{"workspace_build_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
 	Handler: func(ctx context.Context, deps Deps, args GetWorkspaceBuildLogsArgs) ([]string, error) {
 		workspaceBuildID, err := uuid.Parse(args.WorkspaceBuildID)
 		if err != nil {
@@ -1167,6 +1200,7 @@ var GetTemplateVersionLogs = Tool[GetTemplateVersionLogsArgs, []string]{
 			Required: []string{"template_version_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateRead},
 	Handler: func(ctx context.Context, deps Deps, args GetTemplateVersionLogsArgs) ([]string, error) {
 		templateVersionID, err := uuid.Parse(args.TemplateVersionID)
 		if err != nil {
@@ -1207,6 +1241,7 @@ var UpdateTemplateActiveVersion = Tool[UpdateTemplateActiveVersionArgs, string]{
 			Required: []string{"template_id", "template_version_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateUpdate},
 	Handler: func(ctx context.Context, deps Deps, args UpdateTemplateActiveVersionArgs) (string, error) {
 		templateID, err := uuid.Parse(args.TemplateID)
 		if err != nil {
@@ -1244,6 +1279,7 @@ var UploadTarFile = Tool[UploadTarFileArgs, codersdk.UploadResponse]{
 			Required: []string{"files"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeFileCreate},
 	Handler: func(ctx context.Context, deps Deps, args UploadTarFileArgs) (codersdk.UploadResponse, error) {
 		pipeReader, pipeWriter := io.Pipe()
 		done := make(chan struct{})
@@ -1319,6 +1355,7 @@ var CreateTemplate = Tool[CreateTemplateArgs, codersdk.Template]{
 			Required: []string{"name", "display_name", "description", "version_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateCreate},
 	Handler: func(ctx context.Context, deps Deps, args CreateTemplateArgs) (codersdk.Template, error) {
 		me, err := deps.coderClient.User(ctx, "me")
 		if err != nil {
@@ -1358,6 +1395,7 @@ var DeleteTemplate = Tool[DeleteTemplateArgs, codersdk.Response]{
 			Required: []string{"template_id"},
 		},
 	},
+	Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeTemplateDelete},
 	Handler: func(ctx context.Context, deps Deps, args DeleteTemplateArgs) (codersdk.Response, error) {
 		templateID, err := uuid.Parse(args.TemplateID)
 		if err != nil {
@@ -1425,6 +1463,7 @@ var WorkspaceLS = Tool[WorkspaceLSArgs, WorkspaceLSResponse]{
 			Required: []string{"path", "workspace"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceLSArgs) (WorkspaceLSResponse, error) {
 		conn, err := newAgentConn(ctx, deps.coderClient, args.Workspace)
@@ -1490,6 +1529,7 @@ var WorkspaceReadFile = Tool[WorkspaceReadFileArgs, WorkspaceReadFileResponse]{
 			Required: []string{"path", "workspace"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceReadFileArgs) (WorkspaceReadFileResponse, error) {
 		conn, err := newAgentConn(ctx, deps.coderClient, args.Workspace)
@@ -1551,6 +1591,7 @@ var WorkspaceWriteFile = Tool[WorkspaceWriteFileArgs, codersdk.Response]{
 			Required: []string{"path", "workspace", "content"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceWriteFileArgs) (codersdk.Response, error) {
 		conn, err := newAgentConn(ctx, deps.coderClient, args.Workspace)
@@ -1613,6 +1654,7 @@ var WorkspaceEditFile = Tool[WorkspaceEditFileArgs, codersdk.Response]{
 			Required: []string{"path", "workspace", "edits"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceEditFileArgs) (codersdk.Response, error) {
 		conn, err := newAgentConn(ctx, deps.coderClient, args.Workspace)
@@ -1690,6 +1732,7 @@ var WorkspaceEditFiles = Tool[WorkspaceEditFilesArgs, codersdk.Response]{
 			Required: []string{"workspace", "files"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceSsh},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceEditFilesArgs) (codersdk.Response, error) {
 		conn, err := newAgentConn(ctx, deps.coderClient, args.Workspace)
@@ -1736,6 +1779,7 @@ var WorkspacePortForward = Tool[WorkspacePortForwardArgs, WorkspacePortForwardRe
 			Required: []string{"workspace", "port"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead, codersdk.APIKeyScopeWorkspaceApplicationConnect},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspacePortForwardArgs) (WorkspacePortForwardResponse, error) {
 		workspaceName := NormalizeWorkspaceInput(args.Workspace)
@@ -1789,6 +1833,7 @@ var WorkspaceListApps = Tool[WorkspaceListAppsArgs, WorkspaceListAppsResponse]{
 			Required: []string{"workspace"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeWorkspaceRead},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args WorkspaceListAppsArgs) (WorkspaceListAppsResponse, error) {
 		workspaceName := NormalizeWorkspaceInput(args.Workspace)
@@ -1846,6 +1891,7 @@ var CreateTask = Tool[CreateTaskArgs, codersdk.Task]{
 			Required: []string{"input", "template_version_id"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskCreate},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args CreateTaskArgs) (codersdk.Task, error) {
 		if args.Input == "" {
@@ -1901,6 +1947,7 @@ var DeleteTask = Tool[DeleteTaskArgs, codersdk.Response]{
 			Required: []string{"task_id"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskDelete},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args DeleteTaskArgs) (codersdk.Response, error) {
 		if args.TaskID == "" {
@@ -1964,6 +2011,7 @@ var ListTasks = Tool[ListTasksArgs, ListTasksResponse]{
 			Required: []string{},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskRead},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args ListTasksArgs) (ListTasksResponse, error) {
 		if args.User == "" {
@@ -2008,6 +2056,7 @@ var GetTaskStatus = Tool[GetTaskStatusArgs, GetTaskStatusResponse]{
 			Required: []string{"task_id"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskRead},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args GetTaskStatusArgs) (GetTaskStatusResponse, error) {
 		if args.TaskID == "" {
@@ -2060,6 +2109,7 @@ var SendTaskInput = Tool[SendTaskInputArgs, codersdk.Response]{
 			Required: []string{"task_id", "input"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskUpdate},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args SendTaskInputArgs) (codersdk.Response, error) {
 		if args.TaskID == "" {
@@ -2107,6 +2157,7 @@ var GetTaskLogs = Tool[GetTaskLogsArgs, codersdk.TaskLogsResponse]{
 			Required: []string{"task_id"},
 		},
 	},
+	Scopes:             []codersdk.APIKeyScope{codersdk.APIKeyScopeTaskRead},
 	UserClientOptional: true,
 	Handler: func(ctx context.Context, deps Deps, args GetTaskLogsArgs) (codersdk.TaskLogsResponse, error) {
 		if args.TaskID == "" {
@@ -0,0 +1,125 @@
+package codersdk_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"msg_id":"8c8a0f6e-7f0a-4c1f-9f57-bfb1b5f7f2a1"}`)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		header  string
+		body    []byte
+		secrets []string
+		now     time.Time
+		err     error
+	}{
+		{
+			name:    "OK",
+			header:  codersdk.SignWebhookPayload(body, now, "current"),
+			body:    body,
+			secrets: []string{"current"},
+			now:     now,
+		},
+		{
+			name:    "RotatedSecret",
+			header:  codersdk.SignWebhookPayload(body, now, "current", "previous"),
+			body:    body,
+			secrets: []string{"previous"},
+			now:     now,
+		},
+		{
+			name:    "ReceiverHoldsBothSecrets",
+			header:  codersdk.SignWebhookPayload(body, now, "current"),
+			body:    body,
+			secrets: []string{"previous", "current"},
+			now:     now,
+		},
+		{
+			name:    "WrongSecret",
+			header:  codersdk.SignWebhookPayload(body, now, "current"),
+			body:    body,
+			secrets: []string{"other"},
+			now:     now,
+			err:     codersdk.ErrWebhookSignatureMismatch,
+		},
+		{
+			name:    "TamperedBody",
+			header:  codersdk.SignWebhookPayload(body, now, "current"),
+			body:    []byte(`{"msg_id":"tampered"}`),
+			secrets: []string{"current"},
+			now:     now,
+			err:     codersdk.ErrWebhookSignatureMismatch,
+		},
+		{
+			name:    "TamperedTimestamp",
+			header:  strings.Replace(codersdk.SignWebhookPayload(body, now, "current"), "t=", "t=1", 1),
+			body:    body,
+			secrets: []string{"current"},
+			now:     now,
+			err:     codersdk.ErrWebhookSignatureTimestamp,
+		},
+		{
+			name:    "Replayed",
+			header:  codersdk.SignWebhookPayload(body, now, "current"),
+			body:    body,
+			secrets: []string{"current"},
+			now:     now.Add(codersdk.DefaultWebhookSignatureTolerance + time.Second),
+			err:     codersdk.ErrWebhookSignatureTimestamp,
+		},
+		{
+			name:    "Missing",
+			header:  "",
+			body:    body,
+			secrets: []string{"current"},
+			now:     now,
+			err:     codersdk.ErrWebhookSignatureMissing,
+		},
+		{
+			name:    "Unsigned",
+			header:  codersdk.SignWebhookPayload(body, now),
+			body:    body,
+			secrets: []string{"current"},
+			now:     now,
+			err:     codersdk.ErrWebhookSignatureMissing,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := codersdk.VerifyWebhookSignature(tc.header, tc.body, tc.secrets, codersdk.DefaultWebhookSignatureTolerance, tc.now)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestVerifyWebhookRequest(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"title":"hello"}`)
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	r.Header.Set(codersdk.WebhookSignatureHeader, codersdk.SignWebhookPayload(body, time.Now(), "secret"))
+
+	got, err := codersdk.VerifyWebhookRequest(r, "secret")
+	require.NoError(t, err)
+	require.Equal(t, body, got)
+}
@@ -49,6 +49,9 @@ const (
 	// BuildReasonDormancy "dormancy" is used when a build to stop a workspace is triggered due to inactivity (dormancy).
 	// The initiator id/username in this case is the workspace owner and can be ignored.
 	BuildReasonDormancy BuildReason = "dormancy"
+	// BuildReasonAutodelete "autodelete" is used when a build to delete a workspace is triggered by dormancy autodeletion.
+	// The initiator id/username in this case is the workspace owner and can be ignored.
+	BuildReasonAutodelete BuildReason = "autodelete"
 	// BuildReasonDashboard "dashboard" is used when a build to start a workspace is triggered by the dashboard.
 	BuildReasonDashboard BuildReason = "dashboard"
 	// BuildReasonCLI "cli" is used when a build to start a workspace is triggered by the CLI.
@@ -59,6 +62,12 @@ const (
 	BuildReasonVSCodeConnection BuildReason = "vscode_connection"
 	// BuildReasonJetbrainsConnection "jetbrains_connection" is used when a build to start a workspace is triggered by a JetBrains connection.
 	BuildReasonJetbrainsConnection BuildReason = "jetbrains_connection"
+	// BuildReasonTaskAutostop "task_autostop" is used when a build to stop a task's workspace is triggered by a task lifecycle policy.
+	// The initiator id/username in this case is the workspace owner and can be ignored.
+	BuildReasonTaskAutostop BuildReason = "task_autostop"
+	// BuildReasonTaskAutodelete "task_autodelete" is used when a build to delete a task's workspace is triggered by a task lifecycle policy.
+	// The initiator id/username in this case is the workspace owner and can be ignored.
+	BuildReasonTaskAutodelete BuildReason = "task_autodelete"
 )
 
 // WorkspaceBuild is an at-point representation of a workspace state.
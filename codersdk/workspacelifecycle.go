@@ -0,0 +1,98 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+// WorkspaceLifecycleOutcome is what the autobuild lifecycle executor did
+// with a workspace it evaluated.
+type WorkspaceLifecycleOutcome string
+
+const (
+	// WorkspaceLifecycleOutcomeTransitioned is used when the workspace was
+	// built, or marked dormant.
+	WorkspaceLifecycleOutcomeTransitioned WorkspaceLifecycleOutcome = "transitioned"
+	// WorkspaceLifecycleOutcomeDryRun is used when the workspace would have
+	// transitioned, but the executor is in dry-run mode.
+	WorkspaceLifecycleOutcomeDryRun WorkspaceLifecycleOutcome = "dry_run"
+	// WorkspaceLifecycleOutcomeSkipped is used when the workspace was not
+	// eligible for any transition, or no provisioner was available.
+	WorkspaceLifecycleOutcomeSkipped WorkspaceLifecycleOutcome = "skipped"
+	// WorkspaceLifecycleOutcomeFailed is used when the transition failed.
+	WorkspaceLifecycleOutcomeFailed WorkspaceLifecycleOutcome = "failed"
+)
+
+// WorkspaceLifecycle explains the automatic transitions of a workspace: those
+// coming up according to its schedule and the template's policies, and the
+// recent decisions of the autobuild lifecycle executor.
+type WorkspaceLifecycle struct {
+	// DryRun is true if the deployment computes transitions without executing
+	// them.
+	DryRun bool `json:"dry_run"`
+	// Upcoming transitions, ordered by time.
+	Upcoming []WorkspaceLifecycleTransition `json:"upcoming"`
+	// Decisions made recently, most recent first. Decisions are only kept
+	// for a few days.
+	Decisions []WorkspaceLifecycleDecision `json:"decisions"`
+}
+
+// WorkspaceLifecycleTransition is an upcoming automatic transition of a
+// workspace. It happens on the first tick of the autobuild lifecycle
+// executor after At, unless the workspace changes before then.
+type WorkspaceLifecycleTransition struct {
+	Transition WorkspaceTransition `json:"transition,omitempty" enums:"start,stop,delete"`
+	Reason     BuildReason         `json:"reason" enums:"autostart,autostop,dormancy,autodelete"`
+	At         time.Time           `json:"at" format:"date-time"`
+}
+
+// WorkspaceLifecycleDecision is a decision made by the autobuild lifecycle
+// executor about a workspace on one of its ticks.
+type WorkspaceLifecycleDecision struct {
+	ID        uuid.UUID                 `json:"id" format:"uuid"`
+	CreatedAt time.Time                 `json:"created_at" format:"date-time"`
+	Outcome   WorkspaceLifecycleOutcome `json:"outcome" enums:"transitioned,dry_run,skipped,failed"`
+	// Transition is empty if the workspace was not eligible for a
+	// transition, or was only marked dormant.
+	Transition WorkspaceTransition `json:"transition,omitempty" enums:"start,stop,delete"`
+	Reason     BuildReason         `json:"reason,omitempty"`
+	// Checks are the eligibility checks evaluated, in order.
+	Checks []WorkspaceLifecycleCheck `json:"checks"`
+	// Detail explains why the decision was skipped or failed.
+	Detail string `json:"detail,omitempty"`
+}
+
+// WorkspaceLifecycleCheck is the result of one of the eligibility checks made
+// by the autobuild lifecycle executor, such as whether the workspace's
+// deadline has passed.
+type WorkspaceLifecycleCheck struct {
+	Check  string `json:"check"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// WorkspaceLifecycle returns the upcoming automatic transitions of a
+// workspace and the recent decisions made about it.
+func (c *Client) WorkspaceLifecycle(ctx context.Context, workspaceID uuid.UUID) (WorkspaceLifecycle, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/workspaces/%s/lifecycle", workspaceID.String()),
+		nil,
+	)
+	if err != nil {
+		return WorkspaceLifecycle{}, xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return WorkspaceLifecycle{}, ReadBodyAsError(res)
+	}
+
+	var lifecycle WorkspaceLifecycle
+	return lifecycle, json.NewDecoder(res.Body).Decode(&lifecycle)
+}
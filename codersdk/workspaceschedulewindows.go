@@ -0,0 +1,68 @@
+package codersdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+)
+
+// WorkspaceScheduleWindow is an additional autostart window of a workspace.
+// The workspace's own autostart schedule and TTL form its first window. When
+// windows start at the same time, the earlier window takes precedence.
+type WorkspaceScheduleWindow struct {
+	// Schedule is expected to be of the form `CRON_TZ=<IANA Timezone> <min> <hour> * * <dow>`
+	// Example: `CRON_TZ=US/Central 30 9 * * 1-5` represents 0930 in the timezone US/Central
+	// on weekdays (Mon-Fri). `CRON_TZ` defaults to UTC if not present.
+	Schedule string `json:"schedule"`
+	// TTLMillis is how long workspaces started by the window stay running.
+	// If nil, autostop is disabled for them.
+	TTLMillis *int64 `json:"ttl_ms,omitempty"`
+}
+
+// UpdateWorkspaceScheduleWindowsRequest replaces the additional autostart
+// windows of a workspace.
+type UpdateWorkspaceScheduleWindowsRequest struct {
+	Windows []WorkspaceScheduleWindow `json:"windows"`
+}
+
+// WorkspaceScheduleWindows returns the additional autostart windows of a
+// workspace.
+func (c *Client) WorkspaceScheduleWindows(ctx context.Context, workspaceID uuid.UUID) ([]WorkspaceScheduleWindow, error) {
+	res, err := c.Request(ctx, http.MethodGet,
+		fmt.Sprintf("/api/v2/workspaces/%s/schedule-windows", workspaceID.String()),
+		nil,
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, ReadBodyAsError(res)
+	}
+
+	var windows []WorkspaceScheduleWindow
+	return windows, json.NewDecoder(res.Body).Decode(&windows)
+}
+
+// UpdateWorkspaceScheduleWindows replaces the additional autostart windows of
+// a workspace. An empty list removes them.
+func (c *Client) UpdateWorkspaceScheduleWindows(ctx context.Context, workspaceID uuid.UUID, req UpdateWorkspaceScheduleWindowsRequest) error {
+	res, err := c.Request(ctx, http.MethodPut,
+		fmt.Sprintf("/api/v2/workspaces/%s/schedule-windows", workspaceID.String()),
+		req,
+	)
+	if err != nil {
+		return xerrors.Errorf("execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return ReadBodyAsError(res)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package backends
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+// StreamingBackend is a Backend which delivers audit logs to an external sink.
+// It must be closed to flush any buffered audit logs.
+type StreamingBackend interface {
+	audit.Backend
+	io.Closer
+}
+
+// Event is the JSON representation of an audit log sent to external sinks by
+// the streaming backends.
+type Event struct {
+	ID               uuid.UUID       `json:"id"`
+	Time             time.Time       `json:"time"`
+	OrganizationID   uuid.UUID       `json:"organization_id"`
+	UserID           uuid.UUID       `json:"user_id"`
+	Actor            *audit.Actor    `json:"actor,omitempty"`
+	IP               string          `json:"ip"`
+	UserAgent        string          `json:"user_agent"`
+	ResourceType     string          `json:"resource_type"`
+	ResourceID       uuid.UUID       `json:"resource_id"`
+	ResourceTarget   string          `json:"resource_target"`
+	Action           string          `json:"action"`
+	Diff             json.RawMessage `json:"diff"`
+	StatusCode       int32           `json:"status_code"`
+	AdditionalFields json.RawMessage `json:"additional_fields"`
+	RequestID        uuid.UUID       `json:"request_id"`
+}
+
+// NewEvent converts an audit log into the representation sent to external
+// sinks.
+func NewEvent(alog database.AuditLog, details audit.BackendDetails) Event {
+	ev := Event{
+		ID:               alog.ID,
+		Time:             alog.Time,
+		OrganizationID:   alog.OrganizationID,
+		UserID:           alog.UserID,
+		Actor:            details.Actor,
+		UserAgent:        alog.UserAgent.String,
+		ResourceType:     string(alog.ResourceType),
+		ResourceID:       alog.ResourceID,
+		ResourceTarget:   alog.ResourceTarget,
+		Action:           string(alog.Action),
+		Diff:             rawJSON(alog.Diff),
+		StatusCode:       alog.StatusCode,
+		AdditionalFields: rawJSON(alog.AdditionalFields),
+		RequestID:        alog.RequestID,
+	}
+	if alog.Ip.Valid {
+		ev.IP = alog.Ip.IPNet.IP.String()
+	}
+	return ev
+}
+
+// rawJSON guards against an empty column producing invalid JSON.
+func rawJSON(b []byte) json.RawMessage {
+	if len(b) == 0 || !json.Valid(b) {
+		return json.RawMessage("{}")
+	}
+	return b
+}
+
+const (
+	ns        = "coderd"
+	subsystem = "audit_export"
+
+	LabelBackend = "backend"
+	LabelReason  = "reason"
+
+	// DropReasonBufferFull means the backend could not keep up with the rate
+	// of audit logs, and its buffer was full.
+	DropReasonBufferFull = "buffer_full"
+	// DropReasonRejected means the sink permanently rejected the audit log.
+	DropReasonRejected = "rejected"
+	// DropReasonClosed means the backend was closed before the audit log could
+	// be delivered.
+	DropReasonClosed = "closed"
+	// DropReasonError means the audit log could not be encoded or written.
+	DropReasonError = "error"
+)
+
+// Metrics are shared by all streaming backends, and are labeled by backend. A
+// nil *Metrics records nothing.
+type Metrics struct {
+	Delivered   *prometheus.CounterVec
+	Dropped     *prometheus.CounterVec
+	DeliveryLag *prometheus.HistogramVec
+}
+
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	return &Metrics{
+		Delivered: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "delivered_total", Namespace: ns, Subsystem: subsystem,
+			Help: "The number of audit logs delivered to an external sink.",
+		}, []string{LabelBackend}),
+		Dropped: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "dropped_total", Namespace: ns, Subsystem: subsystem,
+			Help: fmt.Sprintf("The number of audit logs which were not delivered to an external sink, aggregated by reason (%s, %s, %s, %s).",
+				DropReasonBufferFull, DropReasonRejected, DropReasonClosed, DropReasonError),
+		}, []string{LabelBackend, LabelReason}),
+		DeliveryLag: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Name: "delivery_lag_seconds", Namespace: ns, Subsystem: subsystem,
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 900, 3600},
+			Help:    "The time elapsed between an audit log being recorded and delivered to an external sink.",
+		}, []string{LabelBackend}),
+	}
+}
+
+func (m *Metrics) delivered(backend string, recorded, now time.Time) {
+	if m == nil {
+		return
+	}
+	m.Delivered.WithLabelValues(backend).Inc()
+	m.DeliveryLag.WithLabelValues(backend).Observe(now.Sub(recorded).Seconds())
+}
+
+func (m *Metrics) dropped(backend, reason string, n int) {
+	if m == nil {
+		return
+	}
+	m.Dropped.WithLabelValues(backend, reason).Add(float64(n))
+}
@@ -0,0 +1,85 @@
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const fileBackendName = "file"
+
+type FileOptions struct {
+	// Path is the file audit logs are appended to, one JSON object per line.
+	Path string
+	// MaxSizeMB is the size at which the file is rotated. Zero uses the
+	// lumberjack default of 100 megabytes.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep. Zero keeps all of
+	// them.
+	MaxBackups int
+	Metrics    *Metrics
+	Clock      quartz.Clock
+}
+
+type fileBackend struct {
+	mu      sync.Mutex
+	out     *lumberjack.Logger
+	metrics *Metrics
+	clock   quartz.Clock
+}
+
+// NewFile returns a backend which appends audit logs to a rotating JSON lines
+// file. Writes are synchronous, so an audit log is on disk once Export returns.
+func NewFile(opts FileOptions) (StreamingBackend, error) {
+	if opts.Path == "" {
+		return nil, xerrors.New("file path is required")
+	}
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+	return &fileBackend{
+		out: &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+		},
+		metrics: opts.Metrics,
+		clock:   opts.Clock,
+	}, nil
+}
+
+func (*fileBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *fileBackend) Export(_ context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	line, err := json.Marshal(NewEvent(alog, details))
+	if err != nil {
+		b.metrics.dropped(fileBackendName, DropReasonError, 1)
+		return xerrors.Errorf("encode audit log: %w", err)
+	}
+	line = append(line, '\n')
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, err := b.out.Write(line); err != nil {
+		b.metrics.dropped(fileBackendName, DropReasonError, 1)
+		return xerrors.Errorf("write audit log: %w", err)
+	}
+	b.metrics.delivered(fileBackendName, alog.Time, b.clock.Now())
+	return nil
+}
+
+func (b *fileBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.out.Close()
+}
@@ -0,0 +1,60 @@
+package backends_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+)
+
+func TestFileBackend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		path    = filepath.Join(t.TempDir(), "audit.jsonl")
+		metrics = backends.NewMetrics(prometheus.NewRegistry())
+		actor   = &audit.Actor{Username: "alice", Email: "alice@coder.com"}
+		alog1   = audittest.RandomLog()
+		alog2   = audittest.RandomLog()
+	)
+
+	b, err := backends.NewFile(backends.FileOptions{Path: path, Metrics: metrics})
+	require.NoError(t, err)
+	require.Equal(t, audit.FilterDecisionExport, b.Decision())
+
+	require.NoError(t, b.Export(ctx, alog1, audit.BackendDetails{Actor: actor}))
+	require.NoError(t, b.Export(ctx, alog2, audit.BackendDetails{}))
+	require.NoError(t, b.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var got []backends.Event
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var ev backends.Event
+		require.NoError(t, json.Unmarshal(s.Bytes(), &ev))
+		got = append(got, ev)
+	}
+	require.NoError(t, s.Err())
+	require.Len(t, got, 2)
+	require.Equal(t, alog1.ID, got[0].ID)
+	require.Equal(t, string(alog1.Action), got[0].Action)
+	require.Equal(t, actor, got[0].Actor)
+	require.Equal(t, alog2.ID, got[1].ID)
+	require.Nil(t, got[1].Actor)
+
+	require.EqualValues(t, 2, promtest.ToFloat64(metrics.Delivered.WithLabelValues("file")))
+}
@@ -0,0 +1,516 @@
+package backends
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const (
+	httpBackendName = "http"
+	// httpSegmentName is the batch which audit logs are currently appended
+	// to. Once full or old enough, it is renamed to a ready batch.
+	httpSegmentName = "pending.ndjson.tmp"
+	httpBatchSuffix = ".ndjson"
+	// httpFlushTimeout bounds how long Close waits for buffered audit logs to
+	// be delivered. Anything left is delivered after the next start.
+	httpFlushTimeout   = 10 * time.Second
+	httpRequestTimeout = 30 * time.Second
+	// Failed requests are retried with an exponential backoff between these
+	// delays.
+	httpRetryMinDelay = time.Second
+	httpRetryMaxDelay = time.Minute
+
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+)
+
+type HTTPOptions struct {
+	// Endpoint receives batches of audit logs as POST requests with a body of
+	// newline-delimited JSON.
+	Endpoint string
+	// Headers are added to every request, e.g. for authentication.
+	Headers http.Header
+	// BatchSize is the maximum number of audit logs sent in one request.
+	BatchSize int
+	// FlushInterval is the maximum time an audit log waits for its batch to
+	// fill before it is sent.
+	FlushInterval time.Duration
+	// BufferDir holds audit logs which have not been delivered yet, so that
+	// they survive restarts and outages of the endpoint.
+	BufferDir string
+	// MaxBufferedEvents limits the number of audit logs kept in BufferDir.
+	// When exceeded, the oldest batches are dropped. Zero means no limit.
+	MaxBufferedEvents int
+	Client            *http.Client
+	Logger            slog.Logger
+	Metrics           *Metrics
+	Clock             quartz.Clock
+}
+
+type httpBatch struct {
+	name  string
+	count int
+}
+
+type httpBackend struct {
+	opts HTTPOptions
+
+	mu sync.Mutex
+	// segment is the open pending batch, if any.
+	segment      *os.File
+	segmentCount int
+	segmentStart time.Time
+	// flushTimer makes the pending batch ready once it is FlushInterval old.
+	flushTimer *quartz.Timer
+	ready      []httpBatch
+	// sending is set while the oldest ready batch is being delivered, so
+	// that it is not dropped from under the sender.
+	sending  bool
+	buffered int
+	seq      int
+	closed   bool
+
+	signal  chan struct{}
+	closing chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewHTTP returns a backend which sends batches of audit logs to an HTTP
+// endpoint. Audit logs are buffered on disk until the endpoint accepts them,
+// and failed requests are retried with a backoff. A batch rejected with a
+// client error, other than 408 or 429, is dropped.
+func NewHTTP(opts HTTPOptions) (StreamingBackend, error) {
+	if opts.Endpoint == "" {
+		return nil, xerrors.New("http endpoint is required")
+	}
+	if u, err := url.Parse(opts.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, xerrors.Errorf("invalid http endpoint %q: must be an http or https URL", opts.Endpoint)
+	}
+	if opts.BufferDir == "" {
+		return nil, xerrors.New("http buffer directory is required")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = defaultFlushInterval
+	}
+	if opts.MaxBufferedEvents > 0 && opts.MaxBufferedEvents < opts.BatchSize {
+		return nil, xerrors.Errorf("max buffered events (%d) must not be less than the batch size (%d)", opts.MaxBufferedEvents, opts.BatchSize)
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: httpRequestTimeout}
+	}
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+	if err := os.MkdirAll(opts.BufferDir, 0o700); err != nil {
+		return nil, xerrors.Errorf("create http buffer directory: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &httpBackend{
+		opts:    opts,
+		signal:  make(chan struct{}, 1),
+		closing: make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	if err := b.recover(); err != nil {
+		cancel()
+		return nil, err
+	}
+	go b.run()
+	return b, nil
+}
+
+// recover picks up the batches left behind by a previous run, including the
+// pending batch, which may end in a partially written line.
+func (b *httpBackend) recover() error {
+	pending := filepath.Join(b.opts.BufferDir, httpSegmentName)
+	data, err := os.ReadFile(pending)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return xerrors.Errorf("read pending batch: %w", err)
+	default:
+		if i := bytes.LastIndexByte(data, '\n'); i >= 0 {
+			if err := os.WriteFile(b.batchPath(b.nextBatchName()), data[:i+1], 0o600); err != nil {
+				return xerrors.Errorf("recover pending batch: %w", err)
+			}
+		}
+		if err := os.Remove(pending); err != nil {
+			return xerrors.Errorf("remove pending batch: %w", err)
+		}
+	}
+
+	entries, err := os.ReadDir(b.opts.BufferDir)
+	if err != nil {
+		return xerrors.Errorf("read http buffer directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), httpBatchSuffix) {
+			continue
+		}
+		count, err := countLines(b.batchPath(entry.Name()))
+		if err != nil {
+			return xerrors.Errorf("read batch %q: %w", entry.Name(), err)
+		}
+		b.ready = append(b.ready, httpBatch{name: entry.Name(), count: count})
+		b.buffered += count
+	}
+	// Batch names sort in the order they were created.
+	sort.Slice(b.ready, func(i, j int) bool { return b.ready[i].name < b.ready[j].name })
+	return nil
+}
+
+func countLines(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var count int
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 16<<20)
+	for s.Scan() {
+		count++
+	}
+	return count, s.Err()
+}
+
+func (*httpBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *httpBackend) Export(_ context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	line, err := json.Marshal(NewEvent(alog, details))
+	if err != nil {
+		b.opts.Metrics.dropped(httpBackendName, DropReasonError, 1)
+		return xerrors.Errorf("encode audit log: %w", err)
+	}
+	line = append(line, '\n')
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		b.opts.Metrics.dropped(httpBackendName, DropReasonClosed, 1)
+		return nil
+	}
+	if !b.makeRoom() {
+		b.opts.Metrics.dropped(httpBackendName, DropReasonBufferFull, 1)
+		return nil
+	}
+
+	if b.segment == nil {
+		b.segment, err = os.OpenFile(b.batchPath(httpSegmentName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			b.segment = nil
+			b.opts.Metrics.dropped(httpBackendName, DropReasonError, 1)
+			return xerrors.Errorf("open pending batch: %w", err)
+		}
+		b.segmentStart = b.opts.Clock.Now()
+		b.flushTimer = b.opts.Clock.AfterFunc(b.opts.FlushInterval, b.flush, "http", "flush")
+	}
+	if _, err := b.segment.Write(line); err != nil {
+		b.opts.Metrics.dropped(httpBackendName, DropReasonError, 1)
+		return xerrors.Errorf("write pending batch: %w", err)
+	}
+	b.segmentCount++
+	b.buffered++
+
+	if b.segmentCount >= b.opts.BatchSize {
+		if err := b.rotate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// makeRoom drops the oldest batches until another audit log fits within
+// MaxBufferedEvents. It reports whether there is room.
+func (b *httpBackend) makeRoom() bool {
+	if b.opts.MaxBufferedEvents <= 0 {
+		return true
+	}
+	for b.buffered >= b.opts.MaxBufferedEvents {
+		i := 0
+		if b.sending {
+			i = 1
+		}
+		if i >= len(b.ready) {
+			return false
+		}
+		batch := b.ready[i]
+		b.ready = append(b.ready[:i], b.ready[i+1:]...)
+		b.buffered -= batch.count
+		b.opts.Metrics.dropped(httpBackendName, DropReasonBufferFull, batch.count)
+		if err := os.Remove(b.batchPath(batch.name)); err != nil {
+			b.opts.Logger.Warn(b.ctx, "remove dropped audit log batch", slog.F("batch", batch.name), slog.Error(err))
+		}
+		b.opts.Logger.Warn(b.ctx, "http audit log buffer is full, dropped oldest batch", slog.F("count", batch.count))
+	}
+	return true
+}
+
+// rotate makes the pending batch ready to send. The caller must hold mu.
+func (b *httpBackend) rotate() error {
+	if b.segment == nil {
+		return nil
+	}
+	if b.flushTimer != nil {
+		b.flushTimer.Stop()
+		b.flushTimer = nil
+	}
+	count := b.segmentCount
+	err := b.segment.Close()
+	b.segment = nil
+	b.segmentCount = 0
+	if err != nil {
+		return xerrors.Errorf("close pending batch: %w", err)
+	}
+
+	name := b.nextBatchName()
+	if err := os.Rename(b.batchPath(httpSegmentName), b.batchPath(name)); err != nil {
+		return xerrors.Errorf("rename pending batch: %w", err)
+	}
+	b.ready = append(b.ready, httpBatch{name: name, count: count})
+	select {
+	case b.signal <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (b *httpBackend) nextBatchName() string {
+	b.seq++
+	return fmt.Sprintf("%020d-%06d%s", b.opts.Clock.Now().UnixNano(), b.seq, httpBatchSuffix)
+}
+
+func (b *httpBackend) batchPath(name string) string {
+	return filepath.Join(b.opts.BufferDir, name)
+}
+
+// flush makes the pending batch ready if it has waited FlushInterval for more
+// audit logs. A batch which was made ready in the meantime, e.g. because it
+// filled up, may have been followed by a newer one, which is left alone.
+func (b *httpBackend) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.segment == nil || b.opts.Clock.Since(b.segmentStart) < b.opts.FlushInterval {
+		return
+	}
+	if err := b.rotate(); err != nil {
+		b.opts.Logger.Error(b.ctx, "flush audit log batch", slog.Error(err))
+	}
+}
+
+func (b *httpBackend) run() {
+	defer close(b.done)
+
+	for {
+		if !b.sendReady() {
+			return
+		}
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-b.closing:
+			b.sendReady()
+			return
+		case <-b.signal:
+		}
+	}
+}
+
+// sendReady sends ready batches oldest first, until there are none left. It
+// returns false if the backend was closed while a batch was being retried.
+func (b *httpBackend) sendReady() bool {
+	for {
+		b.mu.Lock()
+		if len(b.ready) == 0 {
+			b.mu.Unlock()
+			return true
+		}
+		batch := b.ready[0]
+		b.sending = true
+		b.mu.Unlock()
+
+		if !b.send(batch) {
+			b.mu.Lock()
+			b.sending = false
+			b.mu.Unlock()
+			return false
+		}
+	}
+}
+
+func (b *httpBackend) send(batch httpBatch) bool {
+	logger := b.opts.Logger.With(slog.F("batch", batch.name), slog.F("count", batch.count))
+	data, err := os.ReadFile(b.batchPath(batch.name))
+	if err != nil {
+		logger.Error(b.ctx, "read audit log batch", slog.Error(err))
+		b.opts.Metrics.dropped(httpBackendName, DropReasonError, batch.count)
+		b.finish(batch)
+		return true
+	}
+
+	delay := httpRetryMinDelay
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if !b.wait(delay) {
+				return false
+			}
+			delay = min(delay*2, httpRetryMaxDelay)
+		}
+
+		err := b.post(data)
+		if err == nil {
+			now := b.opts.Clock.Now()
+			for _, recorded := range eventTimes(data) {
+				b.opts.Metrics.delivered(httpBackendName, recorded, now)
+			}
+			b.finish(batch)
+			return true
+		}
+		var rejected *httpRejectedError
+		if errors.As(err, &rejected) {
+			logger.Error(b.ctx, "audit log batch rejected by http endpoint", slog.Error(err))
+			b.opts.Metrics.dropped(httpBackendName, DropReasonRejected, batch.count)
+			b.finish(batch)
+			return true
+		}
+		logger.Warn(b.ctx, "send audit log batch, will retry", slog.Error(err), slog.F("delay", delay))
+	}
+}
+
+// wait blocks for the given delay, returning false if the backend is stopped
+// in the meantime.
+func (b *httpBackend) wait(delay time.Duration) bool {
+	timer := b.opts.Clock.NewTimer(delay, "http", "retry")
+	defer timer.Stop()
+	select {
+	case <-b.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// finish removes a batch once it has been delivered or dropped.
+func (b *httpBackend) finish(batch httpBatch) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sending = false
+	if len(b.ready) > 0 && b.ready[0].name == batch.name {
+		b.ready = b.ready[1:]
+		b.buffered -= batch.count
+	}
+	if err := os.Remove(b.batchPath(batch.name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		b.opts.Logger.Warn(b.ctx, "remove audit log batch", slog.F("batch", batch.name), slog.Error(err))
+	}
+}
+
+type httpRejectedError struct {
+	status int
+}
+
+func (e *httpRejectedError) Error() string {
+	return fmt.Sprintf("rejected with status %d", e.status)
+}
+
+func (b *httpBackend) post(data []byte) error {
+	req, err := http.NewRequestWithContext(b.ctx, http.MethodPost, b.opts.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return xerrors.Errorf("create request: %w", err)
+	}
+	for k, v := range b.opts.Headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := b.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests:
+		return xerrors.Errorf("unexpected status %d", resp.StatusCode)
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return &httpRejectedError{status: resp.StatusCode}
+	default:
+		return xerrors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+}
+
+// eventTimes returns the time each audit log in a batch was recorded.
+func eventTimes(data []byte) []time.Time {
+	var times []time.Time
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		var ev struct {
+			Time time.Time `json:"time"`
+		}
+		if len(line) == 0 || json.Unmarshal(line, &ev) != nil {
+			continue
+		}
+		times = append(times, ev.Time)
+	}
+	return times
+}
+
+// Close stops accepting audit logs and tries to deliver those buffered.
+// Anything which cannot be delivered in time is kept in the buffer directory
+// and delivered after the next start.
+func (b *httpBackend) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		<-b.done
+		return nil
+	}
+	b.closed = true
+	err := b.rotate()
+	b.mu.Unlock()
+	close(b.closing)
+
+	timer := b.opts.Clock.NewTimer(httpFlushTimeout, "http", "close")
+	defer timer.Stop()
+	select {
+	case <-b.done:
+	case <-timer.C:
+		b.cancel()
+		<-b.done
+	}
+	b.cancel()
+	return err
+}
@@ -0,0 +1,282 @@
+package backends_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestHTTPBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Batches", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		batches := make(chan []backends.Event, 2)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			batches <- decodeBatch(t, r.Body)
+		}))
+		defer srv.Close()
+
+		clk := quartz.NewMock(t)
+		metrics := backends.NewMetrics(prometheus.NewRegistry())
+		b, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:      srv.URL,
+			Headers:       http.Header{"Authorization": []string{"Bearer secret"}},
+			BatchSize:     2,
+			FlushInterval: testutil.WaitLong,
+			BufferDir:     t.TempDir(),
+			Logger:        slogtest.Make(t, nil),
+			Metrics:       metrics,
+			Clock:         clk,
+		})
+		require.NoError(t, err)
+		defer b.Close()
+		require.Equal(t, audit.FilterDecisionExport, b.Decision())
+
+		export := func() uuid.UUID {
+			alog := audittest.RandomLog()
+			require.NoError(t, b.Export(ctx, alog, audit.BackendDetails{}))
+			return alog.ID
+		}
+		ids := []uuid.UUID{export(), export()}
+
+		// A full batch is sent immediately.
+		batch := testutil.RequireReceive(ctx, t, batches)
+		require.Len(t, batch, 2)
+		require.Equal(t, ids[0], batch[0].ID)
+		require.Equal(t, ids[1], batch[1].ID)
+
+		// The rest is sent once it has waited the flush interval, however long
+		// after the previous batch it was exported.
+		clk.Advance(testutil.WaitLong / 2).MustWait(ctx)
+		ids = append(ids, export())
+		clk.Advance(testutil.WaitLong / 2).MustWait(ctx)
+		require.Empty(t, batches)
+		clk.Advance(testutil.WaitLong / 2).MustWait(ctx)
+		batch = testutil.RequireReceive(ctx, t, batches)
+		require.Len(t, batch, 1)
+		require.Equal(t, ids[2], batch[0].ID)
+
+		require.Eventually(t, func() bool {
+			return promtest.ToFloat64(metrics.Delivered.WithLabelValues("http")) == 3
+		}, testutil.WaitShort, testutil.IntervalFast)
+	})
+
+	t.Run("Retries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		var attempts atomic.Int32
+		batches := make(chan []backends.Event, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			batches <- decodeBatch(t, r.Body)
+		}))
+		defer srv.Close()
+
+		clk := quartz.NewMock(t)
+		retryTrap := clk.Trap().NewTimer("http", "retry")
+		defer retryTrap.Close()
+		b, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:  srv.URL,
+			BatchSize: 1,
+			BufferDir: t.TempDir(),
+			Logger:    slogtest.Make(t, nil),
+			Clock:     clk,
+		})
+		require.NoError(t, err)
+		defer b.Close()
+
+		alog := audittest.RandomLog()
+		require.NoError(t, b.Export(ctx, alog, audit.BackendDetails{}))
+
+		// The failed request is retried after a backoff.
+		retryTrap.MustWait(ctx).MustRelease(ctx)
+		require.EqualValues(t, 1, attempts.Load())
+		clk.Advance(time.Second).MustWait(ctx)
+
+		batch := testutil.RequireReceive(ctx, t, batches)
+		require.Len(t, batch, 1)
+		require.Equal(t, alog.ID, batch[0].ID)
+		require.EqualValues(t, 2, attempts.Load())
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer srv.Close()
+
+		dir := t.TempDir()
+		metrics := backends.NewMetrics(prometheus.NewRegistry())
+		b, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:  srv.URL,
+			BatchSize: 1,
+			BufferDir: dir,
+			Logger:    slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}),
+			Metrics:   metrics,
+		})
+		require.NoError(t, err)
+		defer b.Close()
+
+		require.NoError(t, b.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+
+		// The batch is not retried, and is removed from the buffer.
+		require.Eventually(t, func() bool {
+			return promtest.ToFloat64(metrics.Dropped.WithLabelValues("http", backends.DropReasonRejected)) == 1
+		}, testutil.WaitShort, testutil.IntervalFast)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("BufferFull", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		received := make(chan []backends.Event, 2)
+		unblock := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received <- decodeBatch(t, r.Body)
+			<-unblock
+		}))
+		defer srv.Close()
+
+		metrics := backends.NewMetrics(prometheus.NewRegistry())
+		b, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:          srv.URL,
+			BatchSize:         1,
+			MaxBufferedEvents: 2,
+			BufferDir:         t.TempDir(),
+			Logger:            slogtest.Make(t, nil),
+			Metrics:           metrics,
+		})
+		require.NoError(t, err)
+		defer b.Close()
+
+		// The first audit log is being delivered to an endpoint which does not
+		// respond.
+		first := audittest.RandomLog()
+		require.NoError(t, b.Export(ctx, first, audit.BackendDetails{}))
+		batch := testutil.RequireReceive(ctx, t, received)
+		require.Equal(t, first.ID, batch[0].ID)
+
+		// Meanwhile, the buffer overflows, so the oldest batch which is not
+		// being delivered is dropped.
+		second, third := audittest.RandomLog(), audittest.RandomLog()
+		require.NoError(t, b.Export(ctx, second, audit.BackendDetails{}))
+		require.NoError(t, b.Export(ctx, third, audit.BackendDetails{}))
+		require.EqualValues(t, 1, promtest.ToFloat64(metrics.Dropped.WithLabelValues("http", backends.DropReasonBufferFull)))
+
+		close(unblock)
+		batch = testutil.RequireReceive(ctx, t, received)
+		require.Equal(t, third.ID, batch[0].ID)
+	})
+
+	t.Run("Recovers", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		batches := make(chan []backends.Event, 2)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			batches <- decodeBatch(t, r.Body)
+		}))
+		defer srv.Close()
+
+		// Given: a buffer left behind by a previous run, with a batch which
+		// was ready to send, and a pending batch whose last line was only
+		// partially written.
+		dir := t.TempDir()
+		ready, pending := audittest.RandomLog(), audittest.RandomLog()
+		writeBatch(t, filepath.Join(dir, "00000000000000000001-000001.ndjson"), encodeEvent(t, ready))
+		writeBatch(t, filepath.Join(dir, "pending.ndjson.tmp"), encodeEvent(t, pending), []byte(`{"id":"trunc`))
+
+		// When: the backend starts.
+		b, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:  srv.URL,
+			BufferDir: dir,
+			Logger:    slogtest.Make(t, nil),
+		})
+		require.NoError(t, err)
+		defer b.Close()
+
+		// Then: both batches are delivered in order, without the partial line.
+		batch := testutil.RequireReceive(ctx, t, batches)
+		require.Len(t, batch, 1)
+		require.Equal(t, ready.ID, batch[0].ID)
+		batch = testutil.RequireReceive(ctx, t, batches)
+		require.Len(t, batch, 1)
+		require.Equal(t, pending.ID, batch[0].ID)
+	})
+}
+
+func decodeBatch(t *testing.T, r io.Reader) []backends.Event {
+	t.Helper()
+
+	var events []backends.Event
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		var ev backends.Event
+		if !assert.NoError(t, json.Unmarshal(s.Bytes(), &ev)) {
+			return nil
+		}
+		events = append(events, ev)
+	}
+	assert.NoError(t, s.Err())
+	return events
+}
+
+func encodeEvent(t *testing.T, alog database.AuditLog) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(backends.NewEvent(alog, audit.BackendDetails{}))
+	require.NoError(t, err)
+	return b
+}
+
+func writeBatch(t *testing.T, path string, lines ...[]byte) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	for i, line := range lines {
+		buf.Write(line)
+		// The last line of a pending batch may be incomplete.
+		if i < len(lines)-1 || filepath.Ext(path) == ".ndjson" {
+			buf.WriteByte('\n')
+		}
+	}
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+}
@@ -2,9 +2,11 @@ package backends
 
 import (
 	"context"
+	"database/sql"
 
 	"golang.org/x/xerrors"
 
+	agplaudit "github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/enterprise/audit"
 )
@@ -16,10 +18,28 @@ type postgresBackend struct {
 	// pointing to the Coderd database.
 	internal bool
 	db       database.Store
+	// hashChain indicates if each audit log should be appended to the audit
+	// log hash chain.
+	hashChain bool
 }
 
-func NewPostgres(db database.Store, internal bool) audit.Backend {
-	return &postgresBackend{db: db, internal: internal}
+type PostgresOption func(*postgresBackend)
+
+// WithHashChain appends every audit log to a hash chain which can later be
+// verified to detect audit logs that were modified or deleted. Inserting
+// audit logs is serialized while this is enabled.
+func WithHashChain() PostgresOption {
+	return func(b *postgresBackend) {
+		b.hashChain = true
+	}
+}
+
+func NewPostgres(db database.Store, internal bool, opts ...PostgresOption) audit.Backend {
+	b := &postgresBackend{db: db, internal: internal}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
 }
 
 func (b *postgresBackend) Decision() audit.FilterDecision {
@@ -31,6 +51,10 @@ func (b *postgresBackend) Decision() audit.FilterDecision {
 }
 
 func (b *postgresBackend) Export(ctx context.Context, alog database.AuditLog, _ audit.BackendDetails) error {
+	if b.hashChain {
+		return b.exportChained(ctx, alog)
+	}
+
 	_, err := b.db.InsertAuditLog(ctx, database.InsertAuditLogParams(alog))
 	if err != nil {
 		return xerrors.Errorf("insert audit log: %w", err)
@@ -38,3 +62,56 @@ func (b *postgresBackend) Export(ctx context.Context, alog database.AuditLog, _
 
 	return nil
 }
+
+func (b *postgresBackend) exportChained(ctx context.Context, alog database.AuditLog) error {
+	return b.db.InTx(func(tx database.Store) error {
+		// The chain must be appended to by one transaction at a time,
+		// otherwise two audit logs could claim the same previous entry.
+		err := tx.AcquireLock(ctx, database.LockIDAuditLogHashChain)
+		if err != nil {
+			return xerrors.Errorf("acquire hash chain lock: %w", err)
+		}
+
+		var (
+			seq  int64 = 1
+			prev       = []byte{}
+		)
+		latest, err := tx.GetLatestAuditLogHashChainEntry(ctx)
+		switch {
+		case err == nil:
+			seq = latest.Seq + 1
+			prev = latest.Hash
+		case !xerrors.Is(err, sql.ErrNoRows):
+			return xerrors.Errorf("get latest hash chain entry: %w", err)
+		}
+		// The latest entries may have been purged if their audit logs were
+		// older than ones chained before them, in which case the chain
+		// continues from their checkpoint.
+		checkpoint, err := tx.GetLatestAuditLogHashChainCheckpoint(ctx)
+		switch {
+		case err == nil && checkpoint.Seq >= seq:
+			seq = checkpoint.Seq + 1
+			prev = checkpoint.Hash
+		case err != nil && !xerrors.Is(err, sql.ErrNoRows):
+			return xerrors.Errorf("get latest hash chain checkpoint: %w", err)
+		}
+
+		// Hash the row as stored, so that it matches what is read back when
+		// the chain is verified.
+		inserted, err := tx.InsertAuditLog(ctx, database.InsertAuditLogParams(alog))
+		if err != nil {
+			return xerrors.Errorf("insert audit log: %w", err)
+		}
+
+		err = tx.InsertAuditLogHashChainEntry(ctx, database.InsertAuditLogHashChainEntryParams{
+			Seq:          seq,
+			AuditLogID:   inserted.ID,
+			PreviousHash: prev,
+			Hash:         agplaudit.HashAuditLog(prev, seq, inserted),
+		})
+		if err != nil {
+			return xerrors.Errorf("insert hash chain entry: %w", err)
+		}
+		return nil
+	}, nil)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	agplaudit "github.com/coder/coder/v2/coderd/audit"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbtestutil"
 	"github.com/coder/coder/v2/enterprise/audit"
@@ -37,4 +38,35 @@ func TestPostgresBackend(t *testing.T) {
 		require.Len(t, got, 1)
 		require.Equal(t, alog.ID, got[0].AuditLog.ID)
 	})
+	t.Run("HashChain", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx, cancel = context.WithCancel(context.Background())
+			db, _       = dbtestutil.NewDB(t)
+			pgb         = backends.NewPostgres(db, true, backends.WithHashChain())
+			alogs       = []database.AuditLog{audittest.RandomLog(), audittest.RandomLog()}
+		)
+		defer cancel()
+
+		for _, alog := range alogs {
+			err := pgb.Export(ctx, alog, audit.BackendDetails{})
+			require.NoError(t, err)
+		}
+
+		entries, err := db.GetAuditLogHashChainEntries(ctx, database.GetAuditLogHashChainEntriesParams{
+			AfterSeq:   0,
+			LimitCount: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, alogs[0].ID, entries[0].AuditLogID)
+		require.Equal(t, alogs[1].ID, entries[1].AuditLogID)
+		require.Equal(t, entries[0].Hash, entries[1].PreviousHash)
+
+		res, err := agplaudit.VerifyHashChain(ctx, db)
+		require.NoError(t, err)
+		require.Nil(t, res.BrokenLink)
+		require.EqualValues(t, 2, res.Entries)
+	})
 }
@@ -0,0 +1,272 @@
+package backends
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"cdr.dev/slog"
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+)
+
+const (
+	syslogBackendName = "syslog"
+	// syslogPriority is facility 13 (log audit) and severity 6
+	// (informational), as defined by RFC 5424.
+	syslogPriority     = 13*8 + 6
+	syslogAppName      = "coder"
+	syslogMsgID        = "audit"
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+	// syslogFlushTimeout bounds how long Close waits for queued audit logs
+	// to be delivered.
+	syslogFlushTimeout = 10 * time.Second
+	// Failed deliveries are retried with an exponential backoff between
+	// these delays.
+	syslogRetryMinDelay = time.Second
+	syslogRetryMaxDelay = time.Minute
+	defaultQueueSize    = 1024
+)
+
+type SyslogOptions struct {
+	// Address is the host:port of a syslog receiver accepting TCP
+	// connections.
+	Address string
+	// TLS enables TLS, as described in RFC 5425.
+	TLS bool
+	// TLSCAFile is an optional PEM encoded CA bundle used to verify the
+	// receiver. The system roots are used when empty.
+	TLSCAFile string
+	// Hostname is reported as the HOSTNAME of every message. Defaults to the
+	// hostname of this machine.
+	Hostname string
+	// QueueSize is the number of audit logs held in memory while the receiver
+	// is unavailable, after which new audit logs are dropped.
+	QueueSize int
+	Logger    slog.Logger
+	Metrics   *Metrics
+	Clock     quartz.Clock
+}
+
+type syslogMessage struct {
+	recorded time.Time
+	frame    []byte
+}
+
+type syslogBackend struct {
+	opts      SyslogOptions
+	tlsConfig *tls.Config
+
+	mu     sync.Mutex
+	closed bool
+	queue  chan syslogMessage
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSyslog returns a backend which forwards audit logs to a syslog receiver
+// over TCP or TLS. Messages are formatted according to RFC 5424, with the
+// audit log as a JSON message body, and framed using octet counting.
+//
+// Export never blocks on the network: audit logs are queued in memory and
+// delivered in the background, reconnecting as necessary.
+func NewSyslog(opts SyslogOptions) (StreamingBackend, error) {
+	if opts.Address == "" {
+		return nil, xerrors.New("syslog address is required")
+	}
+	if _, _, err := net.SplitHostPort(opts.Address); err != nil {
+		return nil, xerrors.Errorf("invalid syslog address %q: %w", opts.Address, err)
+	}
+	if opts.Hostname == "" {
+		opts.Hostname, _ = os.Hostname()
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = defaultQueueSize
+	}
+	if opts.Clock == nil {
+		opts.Clock = quartz.NewReal()
+	}
+
+	var tlsConfig *tls.Config
+	if opts.TLS {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		if opts.TLSCAFile != "" {
+			pem, err := os.ReadFile(opts.TLSCAFile)
+			if err != nil {
+				return nil, xerrors.Errorf("read syslog CA file: %w", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, xerrors.Errorf("no certificates found in syslog CA file %q", opts.TLSCAFile)
+			}
+			tlsConfig.RootCAs = pool
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &syslogBackend{
+		opts:      opts,
+		tlsConfig: tlsConfig,
+		queue:     make(chan syslogMessage, opts.QueueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+func (*syslogBackend) Decision() audit.FilterDecision {
+	return audit.FilterDecisionExport
+}
+
+func (b *syslogBackend) Export(_ context.Context, alog database.AuditLog, details audit.BackendDetails) error {
+	body, err := json.Marshal(NewEvent(alog, details))
+	if err != nil {
+		b.opts.Metrics.dropped(syslogBackendName, DropReasonError, 1)
+		return xerrors.Errorf("encode audit log: %w", err)
+	}
+	msg := syslogMessage{
+		recorded: alog.Time,
+		frame:    syslogFrame(b.opts.Hostname, alog.Time, body),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		b.opts.Metrics.dropped(syslogBackendName, DropReasonClosed, 1)
+		return nil
+	}
+	select {
+	case b.queue <- msg:
+	default:
+		// Failing the request because the SIEM is unavailable would be worse
+		// than missing an exported audit log, which is still stored.
+		b.opts.Metrics.dropped(syslogBackendName, DropReasonBufferFull, 1)
+		b.opts.Logger.Warn(b.ctx, "syslog audit queue is full, dropping audit log", slog.F("audit_log_id", alog.ID))
+	}
+	return nil
+}
+
+// syslogFrame formats an RFC 5424 message, prefixed by its length as required
+// by RFC 5425 and RFC 6587.
+func syslogFrame(hostname string, t time.Time, body []byte) []byte {
+	if hostname == "" {
+		hostname = "-"
+	}
+	msg := fmt.Sprintf("<%d>1 %s %s %s - %s - %s",
+		syslogPriority, t.UTC().Format(time.RFC3339Nano), hostname, syslogAppName, syslogMsgID, body)
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
+
+func (b *syslogBackend) run() {
+	defer close(b.done)
+
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	for msg := range b.queue {
+		delivered := false
+		delay := syslogRetryMinDelay
+		for attempt := 0; ; attempt++ {
+			if attempt > 0 {
+				if !b.wait(delay) {
+					break
+				}
+				delay = min(delay*2, syslogRetryMaxDelay)
+			}
+
+			if conn == nil {
+				var err error
+				conn, err = b.dial()
+				if err != nil {
+					b.opts.Logger.Warn(b.ctx, "connect to syslog receiver", slog.F("address", b.opts.Address), slog.Error(err))
+					continue
+				}
+			}
+			// Socket deadlines are enforced against the wall clock, so the
+			// injected clock must not be used here.
+			_ = conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+			if _, err := conn.Write(msg.frame); err != nil {
+				b.opts.Logger.Warn(b.ctx, "write to syslog receiver", slog.F("address", b.opts.Address), slog.Error(err))
+				_ = conn.Close()
+				conn = nil
+				continue
+			}
+			delivered = true
+			break
+		}
+		if !delivered {
+			// The backend was closed before the receiver became available.
+			b.opts.Metrics.dropped(syslogBackendName, DropReasonClosed, 1+len(b.queue))
+			return
+		}
+		b.opts.Metrics.delivered(syslogBackendName, msg.recorded, b.opts.Clock.Now())
+	}
+}
+
+// wait blocks for the given delay, returning false if the backend is stopped
+// in the meantime.
+func (b *syslogBackend) wait(delay time.Duration) bool {
+	timer := b.opts.Clock.NewTimer(delay, "syslog", "retry")
+	defer timer.Stop()
+	select {
+	case <-b.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (b *syslogBackend) dial() (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(b.ctx, syslogDialTimeout)
+	defer cancel()
+	if b.tlsConfig != nil {
+		d := &tls.Dialer{Config: b.tlsConfig}
+		return d.DialContext(ctx, "tcp", b.opts.Address)
+	}
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", b.opts.Address)
+}
+
+// Close stops accepting audit logs and waits for queued audit logs to be
+// delivered. Audit logs which cannot be delivered in time are dropped.
+func (b *syslogBackend) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		<-b.done
+		return nil
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+
+	timer := b.opts.Clock.NewTimer(syslogFlushTimeout, "syslog", "close")
+	defer timer.Stop()
+	select {
+	case <-b.done:
+	case <-timer.C:
+		b.cancel()
+		<-b.done
+	}
+	b.cancel()
+	return nil
+}
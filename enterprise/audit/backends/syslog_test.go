@@ -0,0 +1,224 @@
+package backends_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"cdr.dev/slog/sloggers/slogtest"
+	"github.com/coder/quartz"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/audit"
+	"github.com/coder/coder/v2/enterprise/audit/audittest"
+	"github.com/coder/coder/v2/enterprise/audit/backends"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestSyslogBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ln.Close()
+
+		frames := make(chan string, 2)
+		go func() {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			r := bufio.NewReader(conn)
+			for {
+				frame, err := readOctetCountedFrame(r)
+				if err != nil {
+					return
+				}
+				frames <- frame
+			}
+		}()
+
+		metrics := backends.NewMetrics(prometheus.NewRegistry())
+		b, err := backends.NewSyslog(backends.SyslogOptions{
+			Address:  ln.Addr().String(),
+			Hostname: "coderd-0",
+			Logger:   slogtest.Make(t, nil),
+			Metrics:  metrics,
+		})
+		require.NoError(t, err)
+		defer b.Close()
+		require.Equal(t, audit.FilterDecisionExport, b.Decision())
+
+		alogs := []database.AuditLog{audittest.RandomLog(), audittest.RandomLog()}
+		for _, alog := range alogs {
+			require.NoError(t, b.Export(ctx, alog, audit.BackendDetails{}))
+		}
+
+		for _, alog := range alogs {
+			frame := testutil.RequireReceive(ctx, t, frames)
+			// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID SD MSG
+			parts := strings.SplitN(frame, " ", 8)
+			require.Len(t, parts, 8)
+			require.Equal(t, "<110>1", parts[0])
+			require.Equal(t, "coderd-0", parts[2])
+			require.Equal(t, "coder", parts[3])
+			require.Equal(t, "audit", parts[5])
+
+			var ev backends.Event
+			require.NoError(t, json.Unmarshal([]byte(parts[7]), &ev))
+			require.Equal(t, alog.ID, ev.ID)
+		}
+		require.Eventually(t, func() bool {
+			return promtest.ToFloat64(metrics.Delivered.WithLabelValues("syslog")) == 2
+		}, testutil.WaitShort, testutil.IntervalFast)
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitShort)
+		// Reserve an address which nothing is listening on.
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		require.NoError(t, ln.Close())
+
+		clk := quartz.NewMock(t)
+		metrics := backends.NewMetrics(prometheus.NewRegistry())
+		b, err := backends.NewSyslog(backends.SyslogOptions{
+			Address:   addr,
+			QueueSize: 1,
+			Logger:    slogtest.Make(t, nil),
+			Metrics:   metrics,
+			Clock:     clk,
+		})
+		require.NoError(t, err)
+
+		// The receiver is unavailable, so the queue fills up and further
+		// audit logs are dropped rather than blocking the caller.
+		for range 3 {
+			require.NoError(t, b.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		}
+		require.GreaterOrEqual(t, promtest.ToFloat64(metrics.Dropped.WithLabelValues("syslog", backends.DropReasonBufferFull)), 1.0)
+
+		// Closing gives up on the queued audit logs once the flush timeout
+		// has elapsed. Reconnection attempts may be due in the meantime.
+		trap := clk.Trap().NewTimer("syslog", "close")
+		defer trap.Close()
+		closed := make(chan error, 1)
+		go func() {
+			closed <- b.Close()
+		}()
+		call := trap.MustWait(ctx)
+		call.MustRelease(ctx)
+		for elapsed := time.Duration(0); elapsed < call.Duration; {
+			d, w := clk.AdvanceNext()
+			w.MustWait(ctx)
+			elapsed += d
+		}
+		require.NoError(t, testutil.RequireReceive(ctx, t, closed))
+
+		dropped := func() float64 {
+			return promtest.ToFloat64(metrics.Dropped.WithLabelValues("syslog", backends.DropReasonBufferFull)) +
+				promtest.ToFloat64(metrics.Dropped.WithLabelValues("syslog", backends.DropReasonClosed))
+		}
+		require.Zero(t, promtest.ToFloat64(metrics.Delivered.WithLabelValues("syslog")))
+		require.EqualValues(t, 3, dropped())
+
+		// Audit logs exported after closing are dropped.
+		require.NoError(t, b.Export(ctx, audittest.RandomLog(), audit.BackendDetails{}))
+		require.EqualValues(t, 4, dropped())
+	})
+}
+
+func TestSyslogBackendReconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.Context(t, testutil.WaitShort)
+	// Reserve an address which nothing is listening on yet.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	clk := quartz.NewMock(t)
+	retryTrap := clk.Trap().NewTimer("syslog", "retry")
+	defer retryTrap.Close()
+	metrics := backends.NewMetrics(prometheus.NewRegistry())
+	b, err := backends.NewSyslog(backends.SyslogOptions{
+		Address: addr,
+		Logger:  slogtest.Make(t, nil),
+		Metrics: metrics,
+		Clock:   clk,
+	})
+	require.NoError(t, err)
+	defer b.Close()
+
+	alog := audittest.RandomLog()
+	require.NoError(t, b.Export(ctx, alog, audit.BackendDetails{}))
+
+	// The first connection attempt fails, so another is made after a backoff.
+	call := retryTrap.MustWait(ctx)
+	require.Equal(t, time.Second, call.Duration)
+	call.MustRelease(ctx)
+
+	ln, err = net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer ln.Close()
+	frames := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		frame, err := readOctetCountedFrame(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		frames <- frame
+	}()
+
+	clk.Advance(call.Duration).MustWait(ctx)
+	frame := testutil.RequireReceive(ctx, t, frames)
+	parts := strings.SplitN(frame, " ", 8)
+	require.Len(t, parts, 8)
+	var ev backends.Event
+	require.NoError(t, json.Unmarshal([]byte(parts[7]), &ev))
+	require.Equal(t, alog.ID, ev.ID)
+	require.Eventually(t, func() bool {
+		return promtest.ToFloat64(metrics.Delivered.WithLabelValues("syslog")) == 1
+	}, testutil.WaitShort, testutil.IntervalFast)
+}
+
+// readOctetCountedFrame reads a single syslog message framed as described in
+// RFC 6587, section 3.4.1.
+func readOctetCountedFrame(r *bufio.Reader) (string, error) {
+	length, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
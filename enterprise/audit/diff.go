@@ -61,6 +61,16 @@ func diffValues(left, right any, table Table) audit.Map {
 			continue
 		}
 
+		// Parameters are diffed by name so only the changed values show up,
+		// e.g. "parameters.region".
+		if leftParams, ok := leftI.(database.AuditableParameters); ok {
+			//nolint:forcetypeassert // Both sides are the same type.
+			for name, change := range diffParameters(leftParams, rightI.(database.AuditableParameters), atype == ActionSecret) {
+				baseDiff[diffName+"."+name] = change
+			}
+			continue
+		}
+
 		// coerce struct types that would produce bad diffs.
 		if leftI, rightI, ok = convertDiffType(leftI, rightI); ok {
 			leftF, rightF = reflect.ValueOf(leftI), reflect.ValueOf(rightI)
@@ -90,6 +100,50 @@ func diffValues(left, right any, table Table) audit.Map {
 	return baseDiff
 }
 
+// diffParameters compares two sets of parameters by name. Added parameters have
+// an empty old value and removed parameters an empty new value. Values are
+// redacted if the parameter is sensitive on either side, or if secret is set.
+func diffParameters(left, right database.AuditableParameters, secret bool) audit.Map {
+	type entry struct {
+		value     string
+		sensitive bool
+	}
+	index := func(params database.AuditableParameters) map[string]entry {
+		m := make(map[string]entry, len(params))
+		for _, p := range params {
+			m[p.Name] = entry{value: p.Value, sensitive: p.Sensitive}
+		}
+		return m
+	}
+
+	var (
+		diff     = audit.Map{}
+		leftMap  = index(left)
+		rightMap = index(right)
+		names    = make(map[string]struct{}, len(leftMap)+len(rightMap))
+	)
+	for name := range leftMap {
+		names[name] = struct{}{}
+	}
+	for name := range rightMap {
+		names[name] = struct{}{}
+	}
+
+	for name := range names {
+		l, inLeft := leftMap[name]
+		r, inRight := rightMap[name]
+		if inLeft == inRight && l.value == r.value {
+			continue
+		}
+		if secret || l.sensitive || r.sensitive {
+			diff[name] = audit.OldNew{Old: "", New: "", Secret: true}
+			continue
+		}
+		diff[name] = audit.OldNew{Old: l.value, New: r.value}
+	}
+	return diff
+}
+
 // convertDiffType converts external struct types to primitive types.
 //
 //nolint:forcetypeassert
@@ -305,7 +305,7 @@ func Test_diff(t *testing.T) {
 	runDiffTests(t, []diffTest{
 		{
 			name: "Create",
-			left: audit.Empty[database.TemplateVersion](),
+			left: audit.Empty[database.AuditableTemplateVersion](),
 			right: database.TemplateVersion{
 				ID:             uuid.UUID{1},
 				TemplateID:     uuid.NullUUID{UUID: uuid.UUID{2}, Valid: true},
@@ -314,7 +314,7 @@ func Test_diff(t *testing.T) {
 				OrganizationID: uuid.UUID{3},
 				Name:           "rust",
 				CreatedBy:      uuid.UUID{4},
-			},
+			}.Auditable(nil),
 			exp: audit.Map{
 				"id":          audit.OldNew{Old: "", New: uuid.UUID{1}.String()},
 				"template_id": audit.OldNew{Old: "null", New: uuid.UUID{2}.String()},
@@ -324,7 +324,7 @@ func Test_diff(t *testing.T) {
 		},
 		{
 			name: "CreateNullTemplateID",
-			left: audit.Empty[database.TemplateVersion](),
+			left: audit.Empty[database.AuditableTemplateVersion](),
 			right: database.TemplateVersion{
 				ID:             uuid.UUID{1},
 				TemplateID:     uuid.NullUUID{},
@@ -333,13 +333,108 @@ func Test_diff(t *testing.T) {
 				OrganizationID: uuid.UUID{3},
 				Name:           "rust",
 				CreatedBy:      uuid.UUID{4},
-			},
+			}.Auditable(nil),
 			exp: audit.Map{
 				"id":         audit.OldNew{Old: "", New: uuid.UUID{1}.String()},
 				"created_by": audit.OldNew{Old: "", New: uuid.UUID{4}.String()},
 				"name":       audit.OldNew{Old: "", New: "rust"},
 			},
 		},
+		{
+			name: "Variables",
+			left: database.TemplateVersion{}.Auditable([]database.TemplateVersionVariable{
+				{Name: "region", Value: "us"},
+				{Name: "token", Value: "old-secret", Sensitive: true},
+				{Name: "size", Value: "small"},
+				{Name: "removed", Value: "gone"},
+			}),
+			right: database.TemplateVersion{}.Auditable([]database.TemplateVersionVariable{
+				{Name: "region", Value: "eu"},
+				{Name: "token", Value: "new-secret", Sensitive: true},
+				{Name: "size", Value: "small"},
+				{Name: "added", Value: "new"},
+			}),
+			exp: audit.Map{
+				"variables.region":  audit.OldNew{Old: "us", New: "eu"},
+				"variables.token":   audit.OldNew{Old: "", New: "", Secret: true},
+				"variables.removed": audit.OldNew{Old: "gone", New: ""},
+				"variables.added":   audit.OldNew{Old: "", New: "new"},
+			},
+		},
+	})
+
+	runDiffTests(t, []diffTest{
+		{
+			name: "ParametersChanged",
+			left: database.WorkspaceBuild{
+				ID:                uuid.UUID{1},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "region", Value: "us"},
+				{Name: "cpu", Value: "2"},
+			}, []database.TemplateVersionParameter{
+				{Name: "region"},
+				{Name: "cpu"},
+			}),
+			right: database.WorkspaceBuild{
+				ID:                uuid.UUID{3},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "region", Value: "us"},
+				{Name: "cpu", Value: "4"},
+			}, []database.TemplateVersionParameter{
+				{Name: "region"},
+				{Name: "cpu"},
+			}),
+			exp: audit.Map{
+				"parameters.cpu": audit.OldNew{Old: "2", New: "4"},
+			},
+		},
+		{
+			name: "ParametersSensitive",
+			left: database.WorkspaceBuild{
+				ID:                uuid.UUID{1},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "token", Value: "old-secret"},
+				{Name: "cpu", Value: "2"},
+			}, []database.TemplateVersionParameter{
+				{Name: "token", Ephemeral: true},
+				{Name: "cpu"},
+			}),
+			right: database.WorkspaceBuild{
+				ID:                uuid.UUID{3},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "token", Value: "new-secret"},
+				{Name: "cpu", Value: "4"},
+			}, []database.TemplateVersionParameter{
+				{Name: "token", Ephemeral: true},
+				{Name: "cpu"},
+			}),
+			exp: audit.Map{
+				"parameters.token": audit.OldNew{Old: "", New: "", Secret: true},
+				"parameters.cpu":   audit.OldNew{Old: "2", New: "4"},
+			},
+		},
+		{
+			name: "ParametersUnknown",
+			left: database.WorkspaceBuild{
+				ID:                uuid.UUID{1},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "cpu", Value: "2"},
+			}, nil),
+			right: database.WorkspaceBuild{
+				ID:                uuid.UUID{3},
+				TemplateVersionID: uuid.UUID{2},
+			}.Auditable([]database.WorkspaceBuildParameter{
+				{Name: "cpu", Value: "4"},
+			}, nil),
+			exp: audit.Map{
+				"parameters.cpu": audit.OldNew{Old: "", New: "", Secret: true},
+			},
+		},
 	})
 
 	runDiffTests(t, []diffTest{
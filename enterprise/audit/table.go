@@ -18,16 +18,17 @@ import (
 // AuditableResources map (below) as our documentation - generated in scripts/auditdocgen/main.go -
 // depends upon it.
 var AuditActionMap = map[string][]codersdk.AuditAction{
-	"GitSSHKey":       {codersdk.AuditActionCreate},
-	"Template":        {codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"TemplateVersion": {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
-	"User":            {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"Workspace":       {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"WorkspaceBuild":  {codersdk.AuditActionStart, codersdk.AuditActionStop},
-	"Group":           {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
-	"APIKey":          {codersdk.AuditActionLogin, codersdk.AuditActionLogout, codersdk.AuditActionRegister, codersdk.AuditActionCreate, codersdk.AuditActionDelete},
-	"License":         {codersdk.AuditActionCreate, codersdk.AuditActionDelete},
-	"Task":            {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"GitSSHKey":        {codersdk.AuditActionCreate},
+	"Template":         {codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"TemplateVersion":  {codersdk.AuditActionCreate, codersdk.AuditActionWrite},
+	"User":             {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"Workspace":        {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"WorkspaceBuild":   {codersdk.AuditActionStart, codersdk.AuditActionStop},
+	"Group":            {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"APIKey":           {codersdk.AuditActionLogin, codersdk.AuditActionLogout, codersdk.AuditActionRegister, codersdk.AuditActionCreate, codersdk.AuditActionDelete},
+	"License":          {codersdk.AuditActionCreate, codersdk.AuditActionDelete},
+	"Task":             {codersdk.AuditActionCreate, codersdk.AuditActionWrite, codersdk.AuditActionDelete},
+	"AuditMCPToolCall": {codersdk.AuditActionInvoke},
 }
 
 type Action string
@@ -117,8 +118,9 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"activity_bump":                     ActionTrack,
 		"use_classic_parameter_flow":        ActionTrack,
 		"cors_behavior":                     ActionTrack,
+		"record_sessions":                   ActionTrack,
 	},
-	&database.TemplateVersion{}: {
+	&database.AuditableTemplateVersion{}: {
 		"id":                      ActionTrack,
 		"template_id":             ActionTrack,
 		"organization_id":         ActionIgnore, // Never changes.
@@ -137,6 +139,7 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"source_example_id":       ActionIgnore, // Never changes.
 		"has_ai_task":             ActionIgnore, // Never changes.
 		"has_external_agent":      ActionIgnore, // Never changes.
+		"variables":               ActionTrack,  // Diffed by name, sensitive values are redacted.
 	},
 	&database.User{}: {
 		"id":                           ActionTrack,
@@ -178,7 +181,7 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"group_acl":          ActionTrack,
 		"user_acl":           ActionTrack,
 	},
-	&database.WorkspaceBuild{}: {
+	&database.AuditableWorkspaceBuild{}: {
 		"id":                         ActionIgnore,
 		"created_at":                 ActionIgnore,
 		"updated_at":                 ActionIgnore,
@@ -200,6 +203,7 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"has_ai_task":                ActionIgnore, // Never changes.
 		"ai_task_sidebar_app_id":     ActionIgnore, // Never changes.
 		"has_external_agent":         ActionIgnore, // Never changes.
+		"parameters":                 ActionTrack,  // Diffed by name.
 	},
 	&database.AuditableGroup{}: {
 		"id":              ActionTrack,
@@ -233,6 +237,13 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"to_login_type":   ActionTrack,
 		"user_id":         ActionTrack,
 	},
+	&database.AuditMCPToolCall{}: {
+		"user_id":   ActionTrack,
+		"tool_name": ActionTrack,
+		"toolset":   ActionTrack,
+		"error":     ActionTrack,
+		"scopes":    ActionTrack,
+	},
 	&database.HealthSettings{}: {
 		"id":                     ActionIgnore,
 		"dismissed_healthchecks": ActionTrack,
@@ -321,15 +332,18 @@ var auditableResourcesTypes = map[any]map[string]Action{
 		"icon":         ActionTrack,
 	},
 	&database.NotificationTemplate{}: {
-		"id":                 ActionIgnore,
-		"name":               ActionTrack,
-		"title_template":     ActionTrack,
-		"body_template":      ActionTrack,
-		"actions":            ActionTrack,
-		"group":              ActionTrack,
-		"method":             ActionTrack,
-		"kind":               ActionTrack,
-		"enabled_by_default": ActionTrack,
+		"id":                      ActionIgnore,
+		"name":                    ActionTrack,
+		"title_template":          ActionTrack,
+		"body_template":           ActionTrack,
+		"actions":                 ActionTrack,
+		"group":                   ActionTrack,
+		"method":                  ActionTrack,
+		"kind":                    ActionTrack,
+		"enabled_by_default":      ActionTrack,
+		"title_template_override": ActionTrack,
+		"body_template_override":  ActionTrack,
+		"urgent":                  ActionTrack,
 	},
 	&idpsync.OrganizationSyncSettings{}: {
 		"field":          ActionTrack,
@@ -36,10 +36,16 @@ func newAIBridgeDaemon(coderAPI *coderd.API) (*aibridged.Server, error) {
 		return nil, xerrors.Errorf("create request pool: %w", err)
 	}
 
+	// Setup content policies which are applied to requests before they are forwarded.
+	policies, err := aibridged.NewPolicies(coderAPI.DeploymentValues.AI.BridgeConfig.Policy)
+	if err != nil {
+		return nil, xerrors.Errorf("create policies: %w", err)
+	}
+
 	// Create daemon.
 	srv, err := aibridged.New(ctx, pool, func(dialCtx context.Context) (aibridged.DRPCClient, error) {
 		return coderAPI.CreateInMemoryAIBridgeServer(dialCtx)
-	}, logger)
+	}, logger, policies...)
 	if err != nil {
 		return nil, xerrors.Errorf("start in-memory aibridge daemon: %w", err)
 	}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/cli"
+	"github.com/coder/coder/v2/cli/cliui"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func (r *RootCmd) audit() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "audit",
+		Short: "Manage audit logs",
+		Long: "Administrators can use these commands to check the integrity of audit logs.\n" + cli.FormatExamples(
+			cli.Example{
+				Description: "Verify that audit logs have not been tampered with.",
+				Command:     "coder audit verify",
+			},
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.auditVerify(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) auditVerify() *serpent.Command {
+	formatter := cliui.NewOutputFormatter(
+		cliui.ChangeFormatterData(cliui.TextFormat(), func(data any) (any, error) {
+			res, ok := data.(codersdk.AuditLogVerifyResponse)
+			if !ok {
+				return "", xerrors.Errorf("expected codersdk.AuditLogVerifyResponse, got %T", data)
+			}
+			return formatAuditLogVerification(res), nil
+		}),
+		cliui.JSONFormat(),
+	)
+
+	cmd := &serpent.Command{
+		Use:   "verify",
+		Short: "Verify the audit log hash chain",
+		Long: "Walk the audit log hash chain from the oldest entry, and report the first " +
+			"audit log that was modified or deleted. Exits with a non-zero status if the " +
+			"chain is broken. The hash chain must be enabled with --audit-logging-hash-chain.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.VerifyAuditLogs(inv.Context())
+			if err != nil {
+				return xerrors.Errorf("verify audit logs: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), res)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+
+			if res.BrokenLink != nil {
+				return xerrors.Errorf("audit log hash chain is broken at entry %d", res.BrokenLink.Seq)
+			}
+			return nil
+		},
+	}
+
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+func formatAuditLogVerification(res codersdk.AuditLogVerifyResponse) string {
+	if res.Entries == 0 {
+		return "The audit log hash chain is empty."
+	}
+
+	var sb strings.Builder
+	if res.BrokenLink == nil {
+		_, _ = fmt.Fprintf(&sb, "Verified %d audit log(s), entries %d to %d.\n", res.Entries, res.FirstSeq, res.LastSeq)
+		for _, purged := range res.Purged {
+			_, _ = fmt.Fprintf(&sb, "Entries %d to %d were purged and verified from their checkpoints.\n", purged.FirstSeq, purged.LastSeq)
+		}
+		_, _ = fmt.Fprintf(&sb, "Last hash: %s", res.LastHash)
+		return sb.String()
+	}
+
+	_, _ = fmt.Fprintf(&sb, "The audit log hash chain is broken at entry %d.\n", res.BrokenLink.Seq)
+	_, _ = fmt.Fprintf(&sb, "Audit log: %s\n", res.BrokenLink.AuditLogID)
+	_, _ = fmt.Fprintf(&sb, "Reason: %s", res.BrokenLink.Reason)
+	if res.LastHash != "" {
+		_, _ = fmt.Fprintf(&sb, "\nLast intact hash: %s", res.LastHash)
+	}
+	return sb.String()
+}
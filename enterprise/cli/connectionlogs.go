@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/serpent"
+
+	"github.com/coder/coder/v2/cli"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// connectionLogsExportPageSize is the number of connection logs requested at
+// a time while exporting.
+const connectionLogsExportPageSize = 1000
+
+func (r *RootCmd) connectionLogs() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "connectionlogs",
+		Short: "Manage connection logs",
+		Long: "Administrators can use these commands to export the SSH, app and port-forwarding sessions recorded in connection logs.\n" + cli.FormatExamples(
+			cli.Example{
+				Description: "Export the connection logs of the past week as CSV.",
+				Command:     "coder connectionlogs export --since 7d --format csv > connections.csv",
+			},
+			cli.Example{
+				Description: "Export the SSH connections to a workspace owner's workspaces.",
+				Command:     `coder connectionlogs export --search "workspace_owner:alice type:ssh"`,
+			},
+			cli.Example{
+				Description: "Download a session recording to play back with asciinema.",
+				Command:     "coder connectionlogs recording <connection-log-id> > session.cast",
+			},
+		),
+		Aliases: []string{"connectionlog"},
+		Handler: func(inv *serpent.Invocation) error {
+			return inv.Command.HelpHandler(inv)
+		},
+		Children: []*serpent.Command{
+			r.connectionLogsExport(),
+			r.connectionLogsRecording(),
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) connectionLogsExport() *serpent.Command {
+	var (
+		since  string
+		format string
+		search string
+	)
+	cmd := &serpent.Command{
+		Use:   "export",
+		Short: "Export connection logs",
+		Long: "Stream connection logs to stdout, newest first. Connection logs recorded " +
+			"after the export has started are not included.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "since",
+				Description: "Only export connection logs recorded after this time. Accepts a duration relative to now, such as 24h or 7d, or an RFC 3339 timestamp.",
+				Value:       serpent.StringOf(&since),
+			},
+			{
+				Flag:        "format",
+				Description: "The format to export connection logs in.",
+				Default:     "jsonl",
+				Value:       serpent.EnumOf(&format, "jsonl", "csv"),
+			},
+			{
+				Flag:          "search",
+				FlagShorthand: "q",
+				Description:   "Filter connection logs with the same search query as the dashboard, e.g. \"workspace_owner:alice type:ssh status:ongoing\".",
+				Value:         serpent.StringOf(&search),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			query, err := connectionLogsExportQuery(search, since, now)
+			if err != nil {
+				return err
+			}
+
+			var w connectionLogWriter
+			switch format {
+			case "csv":
+				w = newConnectionLogCSVWriter(inv.Stdout)
+			default:
+				w = newConnectionLogJSONWriter(inv.Stdout)
+			}
+
+			for offset := 0; ; offset += connectionLogsExportPageSize {
+				res, err := client.ConnectionLogs(inv.Context(), codersdk.ConnectionLogsRequest{
+					SearchQuery: query,
+					Pagination: codersdk.Pagination{
+						Limit:  connectionLogsExportPageSize,
+						Offset: offset,
+					},
+				})
+				if err != nil {
+					return xerrors.Errorf("get connection logs: %w", err)
+				}
+				for _, log := range res.ConnectionLogs {
+					if err := w.Write(log); err != nil {
+						return xerrors.Errorf("write connection log: %w", err)
+					}
+				}
+				if err := w.Flush(); err != nil {
+					return xerrors.Errorf("write connection logs: %w", err)
+				}
+				if len(res.ConnectionLogs) < connectionLogsExportPageSize {
+					return nil
+				}
+			}
+		},
+	}
+	return cmd
+}
+
+func (r *RootCmd) connectionLogsRecording() *serpent.Command {
+	cmd := &serpent.Command{
+		Use:   "recording <connection-log-id>",
+		Short: "Download a session recording",
+		Long: "Write the recording of an SSH or reconnecting PTY session to stdout in the " +
+			"asciicast v2 format. Sessions are only recorded for templates with session recording enabled.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(1),
+		),
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			id, err := uuid.Parse(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("invalid connection log ID %q: %w", inv.Args[0], err)
+			}
+
+			recording, err := client.ConnectionLogRecording(inv.Context(), id)
+			if err != nil {
+				return xerrors.Errorf("get session recording: %w", err)
+			}
+			defer recording.Close()
+
+			_, err = io.Copy(inv.Stdout, recording)
+			if err != nil {
+				return xerrors.Errorf("write session recording: %w", err)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// connectionLogsExportQuery returns the search query used to export
+// connection logs. The results are pinned to connection logs recorded before
+// now, so that new connections do not shift the pages being exported.
+func connectionLogsExportQuery(search, since string, now time.Time) (string, error) {
+	var terms []string
+	if search != "" {
+		terms = append(terms, search)
+	}
+	if since != "" {
+		if strings.Contains(search, "connected_after:") {
+			return "", xerrors.New("--since cannot be combined with a connected_after search filter")
+		}
+		after, err := parseSince(since, now)
+		if err != nil {
+			return "", xerrors.Errorf("parse --since: %w", err)
+		}
+		terms = append(terms, fmt.Sprintf("connected_after:%q", after.UTC().Format(time.RFC3339Nano)))
+	}
+	if !strings.Contains(search, "connected_before:") {
+		terms = append(terms, fmt.Sprintf("connected_before:%q", now.UTC().Format(time.RFC3339Nano)))
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// parseSince parses either a duration before now, which may be expressed in
+// days, or an RFC 3339 timestamp.
+func parseSince(s string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err == nil && n >= 0 {
+			return now.Add(-time.Duration(n) * 24 * time.Hour), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		if d < 0 {
+			return time.Time{}, xerrors.Errorf("duration %q must not be negative", s)
+		}
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("%q is neither a duration nor an RFC 3339 timestamp", s)
+	}
+	return t, nil
+}
+
+type connectionLogWriter interface {
+	Write(log codersdk.ConnectionLog) error
+	Flush() error
+}
+
+type connectionLogJSONWriter struct {
+	enc *json.Encoder
+}
+
+func newConnectionLogJSONWriter(w io.Writer) *connectionLogJSONWriter {
+	return &connectionLogJSONWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *connectionLogJSONWriter) Write(log codersdk.ConnectionLog) error {
+	return w.enc.Encode(log)
+}
+
+func (*connectionLogJSONWriter) Flush() error {
+	return nil
+}
+
+var connectionLogCSVHeader = []string{
+	"id",
+	"connect_time",
+	"organization",
+	"workspace_owner",
+	"workspace_id",
+	"workspace_name",
+	"agent_name",
+	"type",
+	"ip",
+	"connection_id",
+	"disconnect_time",
+	"disconnect_reason",
+	"exit_code",
+	"user",
+	"slug_or_port",
+	"user_agent",
+	"status_code",
+}
+
+type connectionLogCSVWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func newConnectionLogCSVWriter(w io.Writer) *connectionLogCSVWriter {
+	return &connectionLogCSVWriter{w: csv.NewWriter(w)}
+}
+
+func (w *connectionLogCSVWriter) Write(log codersdk.ConnectionLog) error {
+	if !w.headerWritten {
+		if err := w.w.Write(connectionLogCSVHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	record := make(map[string]string, len(connectionLogCSVHeader))
+	record["id"] = log.ID.String()
+	record["connect_time"] = log.ConnectTime.Format(time.RFC3339Nano)
+	record["organization"] = log.Organization.Name
+	record["workspace_owner"] = log.WorkspaceOwnerUsername
+	record["workspace_id"] = log.WorkspaceID.String()
+	record["workspace_name"] = log.WorkspaceName
+	record["agent_name"] = log.AgentName
+	record["type"] = string(log.Type)
+	if log.IP != nil {
+		record["ip"] = log.IP.String()
+	}
+	if ssh := log.SSHInfo; ssh != nil {
+		record["connection_id"] = ssh.ConnectionID.String()
+		if ssh.DisconnectTime != nil {
+			record["disconnect_time"] = ssh.DisconnectTime.Format(time.RFC3339Nano)
+		}
+		record["disconnect_reason"] = ssh.DisconnectReason
+		if ssh.ExitCode != nil {
+			record["exit_code"] = strconv.Itoa(int(*ssh.ExitCode))
+		}
+	}
+	if web := log.WebInfo; web != nil {
+		if web.User != nil {
+			record["user"] = web.User.Username
+		}
+		record["slug_or_port"] = web.SlugOrPort
+		record["user_agent"] = web.UserAgent
+		record["status_code"] = strconv.Itoa(int(web.StatusCode))
+	}
+
+	row := make([]string, 0, len(connectionLogCSVHeader))
+	for _, column := range connectionLogCSVHeader {
+		row = append(row, record[column])
+	}
+	return w.w.Write(row)
+}
+
+func (w *connectionLogCSVWriter) Flush() error {
+	if !w.headerWritten {
+		// Write the header even if there are no connection logs.
+		if err := w.w.Write(connectionLogCSVHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,146 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/cli/clitest"
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/coderd/database/dbgen"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/enterprise/coderd/coderdenttest"
+	"github.com/coder/coder/v2/enterprise/coderd/license"
+	"github.com/coder/coder/v2/testutil"
+)
+
+func TestConnectionLogsExport(t *testing.T) {
+	t.Parallel()
+
+	client, db, owner := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		ConnectionLogging: true,
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureConnectionLog: 1,
+			},
+		},
+	})
+	tpl := dbgen.Template(t, db, database.Template{
+		OrganizationID: owner.OrganizationID,
+		CreatedBy:      owner.UserID,
+	})
+	ws := dbgen.Workspace(t, db, database.WorkspaceTable{
+		OwnerID:        owner.UserID,
+		OrganizationID: owner.OrganizationID,
+		TemplateID:     tpl.ID,
+	})
+	now := dbtime.Now()
+	oldLog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Time:             now.Add(-48 * time.Hour),
+		Type:             database.ConnectionTypeSsh,
+		OrganizationID:   owner.OrganizationID,
+		WorkspaceOwnerID: owner.UserID,
+		WorkspaceID:      ws.ID,
+	})
+	recentLog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Time:             now.Add(-time.Hour),
+		Type:             database.ConnectionTypeSsh,
+		OrganizationID:   owner.OrganizationID,
+		WorkspaceOwnerID: owner.UserID,
+		WorkspaceID:      ws.ID,
+	})
+
+	t.Run("JSONL", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		inv, conf := newCLI(t, "connectionlogs", "export")
+		var out bytes.Buffer
+		inv.Stdout = &out
+		clitest.SetupConfig(t, client, conf)
+
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 2)
+		var got codersdk.ConnectionLog
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+		require.Equal(t, recentLog.ID, got.ID, "newest connection log should be first")
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &got))
+		require.Equal(t, oldLog.ID, got.ID)
+	})
+
+	t.Run("CSVSince", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testutil.Context(t, testutil.WaitMedium)
+		inv, conf := newCLI(t, "connectionlogs", "export", "--since", "1d", "--format", "csv")
+		var out bytes.Buffer
+		inv.Stdout = &out
+		clitest.SetupConfig(t, client, conf)
+
+		require.NoError(t, inv.WithContext(ctx).Run())
+
+		records, err := csv.NewReader(&out).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2, "expected a header and one connection log")
+		require.Equal(t, "id", records[0][0])
+		require.Equal(t, recentLog.ID.String(), records[1][0])
+		require.Equal(t, "ssh", records[1][7])
+	})
+}
+
+func TestConnectionLogsRecording(t *testing.T) {
+	t.Parallel()
+
+	client, db, owner := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		ConnectionLogging: true,
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureConnectionLog: 1,
+			},
+		},
+	})
+	tpl := dbgen.Template(t, db, database.Template{
+		OrganizationID: owner.OrganizationID,
+		CreatedBy:      owner.UserID,
+	})
+	ws := dbgen.Workspace(t, db, database.WorkspaceTable{
+		OwnerID:        owner.UserID,
+		OrganizationID: owner.OrganizationID,
+		TemplateID:     tpl.ID,
+	})
+	connectionID := uuid.New()
+	clog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Type:             database.ConnectionTypeSsh,
+		OrganizationID:   owner.OrganizationID,
+		WorkspaceOwnerID: owner.UserID,
+		WorkspaceID:      ws.ID,
+		AgentName:        "dev",
+		ConnectionID:     uuid.NullUUID{UUID: connectionID, Valid: true},
+	})
+	recording := `{"version":2,"width":80,"height":24,"timestamp":0}` + "\n" + `[0.100000, "o", "hello"]` + "\n"
+	_ = dbgen.WorkspaceSessionRecording(t, db, database.UpsertWorkspaceSessionRecordingParams{
+		OrganizationID: owner.OrganizationID,
+		WorkspaceID:    ws.ID,
+		AgentName:      "dev",
+		ConnectionID:   connectionID,
+		Completed:      true,
+	}, []byte(recording))
+
+	ctx := testutil.Context(t, testutil.WaitMedium)
+	inv, conf := newCLI(t, "connectionlogs", "recording", clog.ID.String())
+	var out bytes.Buffer
+	inv.Stdout = &out
+	clitest.SetupConfig(t, client, conf)
+
+	require.NoError(t, inv.WithContext(ctx).Run())
+	require.Equal(t, recording, out.String())
+}
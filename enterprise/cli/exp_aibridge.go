@@ -3,11 +3,16 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
 
+	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/serpent"
 )
@@ -23,6 +28,7 @@ func (r *RootCmd) aibridge() *serpent.Command {
 		},
 		Children: []*serpent.Command{
 			r.aibridgeInterceptions(),
+			r.aibridgeInsights(),
 		},
 	}
 	return cmd
@@ -37,6 +43,7 @@ func (r *RootCmd) aibridgeInterceptions() *serpent.Command {
 		},
 		Children: []*serpent.Command{
 			r.aibridgeInterceptionsList(),
+			r.aibridgeInterceptionsReplay(),
 		},
 	}
 	return cmd
@@ -164,3 +171,242 @@ func (r *RootCmd) aibridgeInterceptionsList() *serpent.Command {
 		},
 	}
 }
+
+// aibridgeReplaySkippedHeaders are captured headers which are not replayed,
+// since they describe the original connection rather than the request.
+var aibridgeReplaySkippedHeaders = []string{
+	"Accept-Encoding",
+	"Connection",
+	"Content-Length",
+	"Host",
+}
+
+func (r *RootCmd) aibridgeInterceptionsReplay() *serpent.Command {
+	var baseURL string
+
+	return &serpent.Command{
+		Use:   "replay <interception-id>",
+		Short: "Re-issue the captured request of an AIBridge interception.",
+		Long: "The request must have been captured for the user who made it. By default, the request is sent through " +
+			"the deployment's AIBridge to the provider it was originally made to, and is attributed to you. Use " +
+			"--url to send it elsewhere, such as a local stub. Credentials are never captured, so none are sent " +
+			"to other URLs.\n\nThe response body is written to stdout.",
+		Middleware: serpent.RequireNArgs(1),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "url",
+				Description: "The base URL to send the request to, in place of the deployment's AIBridge. The captured path is appended to it.",
+				Value:       serpent.StringOf(&baseURL),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			interceptionID, err := uuid.Parse(inv.Args[0])
+			if err != nil {
+				return xerrors.Errorf("parse interception ID %q: %w", inv.Args[0], err)
+			}
+
+			capture, err := codersdk.NewExperimentalClient(client).AIBridgeInterceptionCapture(inv.Context(), interceptionID)
+			if err != nil {
+				return xerrors.Errorf("get interception capture: %w", err)
+			}
+			if capture.RequestBodyTruncated {
+				return xerrors.New("the captured request body was truncated, so the request cannot be replayed")
+			}
+
+			target := baseURL
+			if target == "" {
+				target = client.URL.JoinPath("/api/experimental/aibridge").String()
+			}
+			req, err := http.NewRequestWithContext(inv.Context(), capture.Method, strings.TrimSuffix(target, "/")+capture.Path, strings.NewReader(capture.RequestBody))
+			if err != nil {
+				return xerrors.Errorf("create request: %w", err)
+			}
+			for name, values := range capture.RequestHeaders {
+				if slices.Contains(aibridgeReplaySkippedHeaders, http.CanonicalHeaderKey(name)) {
+					continue
+				}
+				for _, value := range values {
+					if value == codersdk.AIBridgeCaptureRedactedValue {
+						continue
+					}
+					req.Header.Add(name, value)
+				}
+			}
+			if baseURL == "" {
+				req.Header.Set("Authorization", "Bearer "+client.SessionToken())
+			}
+
+			res, err := client.HTTPClient.Do(req)
+			if err != nil {
+				return xerrors.Errorf("replay request: %w", err)
+			}
+			defer res.Body.Close()
+
+			_, err = io.Copy(inv.Stdout, res.Body)
+			if err != nil {
+				return xerrors.Errorf("read response: %w", err)
+			}
+			if res.StatusCode < 200 || res.StatusCode >= 300 {
+				return xerrors.Errorf("replayed request failed with status %d (captured status was %d)", res.StatusCode, capture.ResponseStatus)
+			}
+			return nil
+		},
+	}
+}
+
+const defaultInsightsRange = 30 * 24 * time.Hour
+
+func (r *RootCmd) aibridgeInsights() *serpent.Command {
+	var (
+		startTimeRaw string
+		endTimeRaw   string
+		groupBy      []string
+
+		formatter = cliui.NewOutputFormatter(
+			cliui.ChangeFormatterData(
+				cliui.TableFormat([]aibridgeInsightsRow{}, nil),
+				func(data any) (any, error) {
+					resp, ok := data.(codersdk.AIBridgeInsightsResponse)
+					if !ok {
+						return nil, xerrors.Errorf("expected codersdk.AIBridgeInsightsResponse, got %T", data)
+					}
+					return aibridgeInsightsToRows(resp), nil
+				},
+			),
+			cliui.JSONFormat(),
+		)
+	)
+
+	groupByValues := make([]string, 0, len(codersdk.AIBridgeInsightsGroupByValues))
+	for _, g := range codersdk.AIBridgeInsightsGroupByValues {
+		groupByValues = append(groupByValues, string(g))
+	}
+
+	cmd := &serpent.Command{
+		Use:   "insights",
+		Short: "Show AIBridge usage and its estimated cost.",
+		Long: "Usage is rolled up hourly, so the start time is rounded down and the end time is rounded up to the hour. " +
+			"Costs are estimated with the model prices configured by administrators, and tokens of models without a price are reported as unpriced.",
+		Middleware: serpent.RequireNArgs(0),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "start-time",
+				Description: fmt.Sprintf("Show usage from this time. Defaults to 30 days before the end time. Accepts a time in the RFC 3339 format, e.g. %q.", time.RFC3339),
+				Default:     "",
+				Value:       serpent.StringOf(&startTimeRaw),
+			},
+			{
+				Flag:        "end-time",
+				Description: fmt.Sprintf("Show usage until this time. Defaults to now. Accepts a time in the RFC 3339 format, e.g. %q.", time.RFC3339),
+				Default:     "",
+				Value:       serpent.StringOf(&endTimeRaw),
+			},
+			{
+				Flag:        "group-by",
+				Description: "Dimensions to group usage by.",
+				Default:     strings.Join(groupByValues, ","),
+				Value:       serpent.EnumArrayOf(&groupBy, groupByValues...),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+
+			endTime := time.Now()
+			if endTimeRaw != "" {
+				endTime, err = time.Parse(time.RFC3339, endTimeRaw)
+				if err != nil {
+					return xerrors.Errorf("parse end time %q: %w", endTimeRaw, err)
+				}
+			}
+			startTime := endTime.Add(-defaultInsightsRange)
+			if startTimeRaw != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeRaw)
+				if err != nil {
+					return xerrors.Errorf("parse start time %q: %w", startTimeRaw, err)
+				}
+			}
+			startTime = startTime.Truncate(time.Hour)
+			if !endTime.Equal(endTime.Truncate(time.Hour)) {
+				endTime = endTime.Truncate(time.Hour).Add(time.Hour)
+			}
+			if !startTime.Before(endTime) {
+				return xerrors.New("start time must be before end time")
+			}
+
+			req := codersdk.AIBridgeInsightsRequest{
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+			for _, g := range groupBy {
+				req.GroupBy = append(req.GroupBy, codersdk.AIBridgeInsightsGroupBy(g))
+			}
+
+			expCli := codersdk.NewExperimentalClient(client)
+			resp, err := expCli.AIBridgeInsights(inv.Context(), req)
+			if err != nil {
+				return xerrors.Errorf("get insights: %w", err)
+			}
+
+			out, err := formatter.Format(inv.Context(), resp)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(inv.Stdout, out)
+			return err
+		},
+	}
+
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+type aibridgeInsightsRow struct {
+	User           string `table:"user,nosort"`
+	Template       string `table:"template"`
+	Provider       string `table:"provider"`
+	Model          string `table:"model"`
+	Requests       int64  `table:"requests"`
+	InputTokens    int64  `table:"input tokens"`
+	OutputTokens   int64  `table:"output tokens"`
+	EstimatedCost  string `table:"estimated cost"`
+	UnpricedTokens int64  `table:"unpriced tokens"`
+}
+
+// aibridgeInsightsToRows converts insights into table rows, most expensive
+// first, followed by their total.
+func aibridgeInsightsToRows(resp codersdk.AIBridgeInsightsResponse) []aibridgeInsightsRow {
+	byTemplate := slices.Contains(resp.GroupBy, codersdk.AIBridgeInsightsGroupByTemplate)
+	toRow := func(row codersdk.AIBridgeInsightsRow) aibridgeInsightsRow {
+		out := aibridgeInsightsRow{
+			User:           row.Username,
+			Template:       row.TemplateName,
+			Provider:       row.Provider,
+			Model:          row.Model,
+			Requests:       row.Requests,
+			InputTokens:    row.InputTokens,
+			OutputTokens:   row.OutputTokens,
+			EstimatedCost:  fmt.Sprintf("%.2f", row.EstimatedCost),
+			UnpricedTokens: row.UnpricedTokens,
+		}
+		if byTemplate && row.TemplateID == uuid.Nil {
+			out.Template = "(none)"
+		}
+		return out
+	}
+
+	rows := make([]aibridgeInsightsRow, 0, len(resp.Rows)+1)
+	for _, row := range resp.Rows {
+		rows = append(rows, toRow(row))
+	}
+	total := toRow(resp.Total)
+	total.User, total.Template = "Total", ""
+	return append(rows, total)
+}
@@ -3,6 +3,9 @@ package cli_test
 import (
 	"bytes"
 	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -225,3 +228,177 @@ func requireHasInterceptions(t *testing.T, out []byte, ids []uuid.UUID) {
 		require.Equal(t, id, results[i].ID)
 	}
 }
+
+func TestAIBridgeInsights(t *testing.T) {
+	t.Parallel()
+
+	dv := coderdtest.DeploymentValues(t)
+	dv.Experiments = []string{string(codersdk.ExperimentAIBridge)}
+	client, db, owner := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		Options: &coderdtest.Options{
+			DeploymentValues: dv,
+		},
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAIBridge: 1,
+			},
+		},
+	})
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	_, err := codersdk.NewExperimentalClient(client).UpdateAIBridgeModelPrices(ctx, codersdk.UpdateAIBridgeModelPricesRequest{
+		Prices: []codersdk.AIBridgeModelPrice{
+			{Provider: "openai", Model: "gpt-4.1", InputPricePerMillionTokens: 2, OutputPricePerMillionTokens: 8},
+		},
+	})
+	require.NoError(t, err)
+
+	anHourAgo := dbtime.Now().Add(-time.Hour).Truncate(time.Hour)
+	interception := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: owner.UserID,
+		Provider:    "openai",
+		Model:       "gpt-4.1",
+		StartedAt:   anHourAgo,
+	})
+	dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+		InterceptionID: interception.ID,
+		InputTokens:    1_000_000,
+		OutputTokens:   1_000_000,
+		CreatedAt:      anHourAgo,
+	})
+	require.NoError(t, db.UpsertAIBridgeUsageStats(ctx))
+
+	t.Run("Table", func(t *testing.T) {
+		t.Parallel()
+
+		inv, root := newCLI(t, "exp", "aibridge", "insights", "--group-by", "model")
+		clitest.SetupConfig(t, client, root)
+		out := bytes.NewBuffer(nil)
+		inv.Stdout = out
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+
+		require.Contains(t, out.String(), "gpt-4.1")
+		require.Contains(t, out.String(), "10.00")
+		require.Contains(t, out.String(), "Total")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		inv, root := newCLI(t, "exp", "aibridge", "insights", "--output", "json")
+		clitest.SetupConfig(t, client, root)
+		out := bytes.NewBuffer(nil)
+		inv.Stdout = out
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+
+		var resp codersdk.AIBridgeInsightsResponse
+		require.NoError(t, json.Unmarshal(out.Bytes(), &resp))
+		require.Len(t, resp.Rows, 1)
+		require.Equal(t, owner.UserID, resp.Rows[0].UserID)
+		require.InDelta(t, 10, resp.Total.EstimatedCost, 0.0001)
+	})
+}
+
+func TestAIBridgeReplayInterception(t *testing.T) {
+	t.Parallel()
+
+	dv := coderdtest.DeploymentValues(t)
+	dv.Experiments = []string{string(codersdk.ExperimentAIBridge)}
+	client, db, owner := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		Options: &coderdtest.Options{
+			DeploymentValues: dv,
+		},
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAIBridge: 1,
+			},
+		},
+	})
+
+	type receivedRequest struct {
+		method, path, body string
+		header             http.Header
+	}
+	received := make(chan receivedRequest, 1)
+	stub := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedRequest{method: r.Method, path: r.URL.Path, body: string(body), header: r.Header.Clone()}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{"id":"chatcmpl-replayed"}`))
+	}))
+	t.Cleanup(stub.Close)
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		interception := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+			InitiatorID: owner.UserID,
+		})
+		dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+			InterceptionID: interception.ID,
+			Method:         http.MethodPost,
+			Path:           "/openai/v1/chat/completions",
+			RequestHeaders: json.RawMessage(`{"Authorization":["<redacted>"],"Content-Type":["application/json"],"User-Agent":["codex/1.0"]}`),
+			RequestBody:    `{"model":"gpt-4.1","messages":[{"role":"user","content":"hi"}]}`,
+		})
+
+		// When: the interception is replayed against a stub.
+		inv, root := newCLI(t, "exp", "aibridge", "interceptions", "replay", interception.ID.String(), "--url", stub.URL)
+		clitest.SetupConfig(t, client, root)
+		ctx := testutil.Context(t, testutil.WaitLong)
+		out := bytes.NewBuffer(nil)
+		inv.Stdout = out
+		err := inv.WithContext(ctx).Run()
+		require.NoError(t, err)
+
+		// Then: the stub receives the captured request, without credentials.
+		req := testutil.TryReceive(ctx, t, received)
+		require.Equal(t, http.MethodPost, req.method)
+		require.Equal(t, "/openai/v1/chat/completions", req.path)
+		require.JSONEq(t, `{"model":"gpt-4.1","messages":[{"role":"user","content":"hi"}]}`, req.body)
+		require.Equal(t, "application/json", req.header.Get("Content-Type"))
+		require.Equal(t, "codex/1.0", req.header.Get("User-Agent"))
+		require.Empty(t, req.header.Get("Authorization"))
+		require.Empty(t, req.header.Get(codersdk.SessionTokenHeader))
+
+		// Then: the response is written to stdout.
+		require.JSONEq(t, `{"id":"chatcmpl-replayed"}`, out.String())
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		t.Parallel()
+
+		interception := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+			InitiatorID: owner.UserID,
+		})
+		dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+			InterceptionID:       interception.ID,
+			RequestBody:          `{"model":"gpt-4.1","messa`,
+			RequestBodyTruncated: true,
+		})
+
+		inv, root := newCLI(t, "exp", "aibridge", "interceptions", "replay", interception.ID.String(), "--url", stub.URL)
+		clitest.SetupConfig(t, client, root)
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		require.ErrorContains(t, err, "truncated")
+	})
+
+	t.Run("NotCaptured", func(t *testing.T) {
+		t.Parallel()
+
+		interception := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+			InitiatorID: owner.UserID,
+		})
+
+		inv, root := newCLI(t, "exp", "aibridge", "interceptions", "replay", interception.ID.String(), "--url", stub.URL)
+		clitest.SetupConfig(t, client, root)
+		ctx := testutil.Context(t, testutil.WaitLong)
+		err := inv.WithContext(ctx).Run()
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+	})
+}
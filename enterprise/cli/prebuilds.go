@@ -2,12 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"golang.org/x/xerrors"
 
 	"github.com/coder/serpent"
 
 	"github.com/coder/coder/v2/cli"
+	"github.com/coder/coder/v2/cli/cliui"
 	"github.com/coder/coder/v2/codersdk"
 )
 
@@ -24,6 +26,10 @@ func (r *RootCmd) prebuilds() *serpent.Command {
 				Description: "Resume Coder prebuilt workspace reconciliation if it has been paused.",
 				Command:     "coder prebuilds resume",
 			},
+			cli.Example{
+				Description: "Show prebuild hit rates and time to ready over the last week.",
+				Command:     "coder prebuilds stats",
+			},
 		),
 		Aliases: []string{"prebuild"},
 		Handler: func(inv *serpent.Invocation) error {
@@ -32,6 +38,7 @@ func (r *RootCmd) prebuilds() *serpent.Command {
 		Children: []*serpent.Command{
 			r.pausePrebuilds(),
 			r.resumePrebuilds(),
+			r.prebuildsStats(),
 		},
 	}
 	return cmd
@@ -90,3 +97,127 @@ func (r *RootCmd) resumePrebuilds() *serpent.Command {
 	}
 	return cmd
 }
+
+func (r *RootCmd) prebuildsStats() *serpent.Command {
+	var days int64
+	formatter := cliui.NewOutputFormatter(
+		cliui.TableFormat([]prebuildsStatsRow{}, []string{
+			"template",
+			"preset",
+			"workspaces",
+			"hit rate",
+			"hit ready p50",
+			"hit ready p95",
+			"miss ready p50",
+			"miss ready p95",
+		}),
+		cliui.JSONFormat(),
+	)
+
+	cmd := &serpent.Command{
+		Use:   "stats",
+		Short: "Show prebuild hit rates and time to ready",
+		Long: "Shows, for each preset, the share of workspaces created with it which were served from a prebuilt " +
+			"workspace, and the time until their agents were ready compared to workspaces built from scratch.",
+		Middleware: serpent.Chain(
+			serpent.RequireNArgs(0),
+		),
+		Options: serpent.OptionSet{
+			{
+				Flag:        "days",
+				Description: "Number of days to show statistics for, including today.",
+				Default:     "7",
+				Value:       serpent.Int64Of(&days),
+			},
+		},
+		Handler: func(inv *serpent.Invocation) error {
+			client, err := r.InitClient(inv)
+			if err != nil {
+				return err
+			}
+			if days < 1 {
+				return xerrors.New("days must be at least 1")
+			}
+
+			// Insights must start at midnight and end on the hour.
+			now := time.Now()
+			year, month, day := now.Date()
+			startTime := time.Date(year, month, day, 0, 0, 0, 0, now.Location()).AddDate(0, 0, -int(days-1))
+			endTime := time.Date(year, month, day, now.Hour(), 0, 0, 0, now.Location()).Add(time.Hour)
+
+			resp, err := client.PrebuildsInsights(inv.Context(), codersdk.PrebuildsInsightsRequest{
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+			if err != nil {
+				return xerrors.Errorf("get prebuilds insights: %w", err)
+			}
+
+			if len(resp.Report.Presets) == 0 {
+				_, _ = fmt.Fprintln(inv.Stderr, "No workspaces were created with presets in this period.")
+				return nil
+			}
+
+			out, err := formatter.Format(inv.Context(), prebuildsStatsToRows(resp.Report.Presets))
+			if err != nil {
+				return xerrors.Errorf("display prebuilds stats: %w", err)
+			}
+
+			_, _ = fmt.Fprintln(inv.Stdout, out)
+			return nil
+		},
+	}
+
+	formatter.AttachOptions(&cmd.Options)
+	return cmd
+}
+
+type prebuildsStatsRow struct {
+	// For json output:
+	Preset codersdk.PresetPrebuildInsights `table:"-"`
+
+	// For table output:
+	Template        string `json:"-" table:"template,default_sort"`
+	PresetName      string `json:"-" table:"preset"`
+	Organization    string `json:"-" table:"organization"`
+	Workspaces      int64  `json:"-" table:"workspaces"`
+	Hits            int64  `json:"-" table:"hits"`
+	HitRate         string `json:"-" table:"hit rate"`
+	HitReadyP50     string `json:"-" table:"hit ready p50"`
+	HitReadyP95     string `json:"-" table:"hit ready p95"`
+	MissReadyP50    string `json:"-" table:"miss ready p50"`
+	MissReadyP95    string `json:"-" table:"miss ready p95"`
+	ClaimLatencyP50 string `json:"-" table:"claim p50"`
+	ClaimLatencyP95 string `json:"-" table:"claim p95"`
+}
+
+func prebuildsStatsToRows(presets []codersdk.PresetPrebuildInsights) []prebuildsStatsRow {
+	rows := make([]prebuildsStatsRow, 0, len(presets))
+	for _, preset := range presets {
+		rows = append(rows, prebuildsStatsRow{
+			Preset:          preset,
+			Template:        preset.TemplateName,
+			PresetName:      preset.PresetName,
+			Organization:    preset.OrganizationName,
+			Workspaces:      preset.Workspaces,
+			Hits:            preset.Hits,
+			HitRate:         fmt.Sprintf("%.0f%%", preset.HitRate*100),
+			HitReadyP50:     formatPrebuildsStatsDuration(preset.HitTimeToReadySeconds.P50, time.Second),
+			HitReadyP95:     formatPrebuildsStatsDuration(preset.HitTimeToReadySeconds.P95, time.Second),
+			MissReadyP50:    formatPrebuildsStatsDuration(preset.MissTimeToReadySeconds.P50, time.Second),
+			MissReadyP95:    formatPrebuildsStatsDuration(preset.MissTimeToReadySeconds.P95, time.Second),
+			ClaimLatencyP50: formatPrebuildsStatsDuration(preset.ClaimLatencyMS.P50, time.Millisecond),
+			ClaimLatencyP95: formatPrebuildsStatsDuration(preset.ClaimLatencyMS.P95, time.Millisecond),
+		})
+	}
+	return rows
+}
+
+// formatPrebuildsStatsDuration formats a percentile measured in the given unit.
+// Percentiles are negative when there was nothing to measure.
+func formatPrebuildsStatsDuration(value float64, unit time.Duration) string {
+	if value < 0 {
+		return "-"
+	}
+	return time.Duration(value * float64(unit)).Round(unit).String()
+}
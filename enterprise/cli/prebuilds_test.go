@@ -3,7 +3,10 @@ package cli_test
 import (
 	"bytes"
 	"database/sql"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -358,6 +361,117 @@ func TestPrebuildsSettingsAPI(t *testing.T) {
 // TestSchedulePrebuilds verifies the CLI schedule command when used with prebuilds.
 // Running the command on an unclaimed prebuild fails, but after the prebuild is
 // claimed (becoming a regular workspace) it succeeds as expected.
+func TestPrebuildsStats(t *testing.T) {
+	t.Parallel()
+
+	report := codersdk.PrebuildsInsightsReport{
+		Presets: []codersdk.PresetPrebuildInsights{
+			{
+				TemplateName:           "docker",
+				OrganizationName:       "coder",
+				PresetName:             "Large",
+				Workspaces:             40,
+				Hits:                   36,
+				HitRate:                0.9,
+				ClaimLatencyMS:         codersdk.PrebuildInsightsPercentiles{P50: 12, P95: 48},
+				HitTimeToReadySeconds:  codersdk.PrebuildInsightsPercentiles{P50: 12.4, P95: 48.2},
+				MissTimeToReadySeconds: codersdk.PrebuildInsightsPercentiles{P50: 300, P95: 612},
+			},
+			{
+				TemplateName:           "podman",
+				OrganizationName:       "coder",
+				PresetName:             "Small",
+				Workspaces:             2,
+				ClaimLatencyMS:         codersdk.PrebuildInsightsPercentiles{P50: 10, P95: 10},
+				HitTimeToReadySeconds:  codersdk.PrebuildInsightsPercentiles{P50: -1, P95: -1},
+				MissTimeToReadySeconds: codersdk.PrebuildInsightsPercentiles{P50: 90, P95: 120},
+			},
+		},
+	}
+
+	// newStatsServer serves the given prebuilds insights report.
+	newStatsServer := func(t *testing.T, report codersdk.PrebuildsInsightsReport) *codersdk.Client {
+		t.Helper()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v2/insights/prebuilds" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			rw.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(rw).Encode(codersdk.PrebuildsInsightsResponse{Report: report})
+		}))
+		t.Cleanup(srv.Close)
+		return codersdk.New(testutil.MustURL(t, srv.URL))
+	}
+
+	t.Run("Table", func(t *testing.T) {
+		t.Parallel()
+
+		client := newStatsServer(t, report)
+		inv, conf := newCLI(t, "prebuilds", "stats")
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		clitest.SetupConfig(t, client, conf)
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitShort)).Run()
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Regexp(t, `^TEMPLATE\s+PRESET\s+WORKSPACES\s+HIT RATE\s+HIT READY P50\s+HIT READY P95\s+MISS READY P50\s+MISS READY P95\s*$`, lines[0])
+		assert.Regexp(t, `^docker\s+Large\s+40\s+90%\s+12s\s+48s\s+5m0s\s+10m12s\s*$`, lines[1])
+		assert.Regexp(t, `^podman\s+Small\s+2\s+0%\s+-\s+-\s+1m30s\s+2m0s\s*$`, lines[2])
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		client := newStatsServer(t, report)
+		inv, conf := newCLI(t, "prebuilds", "stats", "--output", "json")
+		var stdout bytes.Buffer
+		inv.Stdout = &stdout
+		clitest.SetupConfig(t, client, conf)
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitShort)).Run()
+		require.NoError(t, err)
+
+		var rows []struct {
+			Preset codersdk.PresetPrebuildInsights `json:"preset"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &rows))
+		require.Len(t, rows, 2)
+		assert.Equal(t, report.Presets[0], rows[0].Preset)
+		assert.Equal(t, report.Presets[1], rows[1].Preset)
+	})
+
+	t.Run("NoPresets", func(t *testing.T) {
+		t.Parallel()
+
+		client := newStatsServer(t, codersdk.PrebuildsInsightsReport{})
+		inv, conf := newCLI(t, "prebuilds", "stats")
+		var stderr bytes.Buffer
+		inv.Stderr = &stderr
+		clitest.SetupConfig(t, client, conf)
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitShort)).Run()
+		require.NoError(t, err)
+		assert.Contains(t, stderr.String(), "No workspaces were created with presets in this period.")
+	})
+
+	t.Run("InvalidDays", func(t *testing.T) {
+		t.Parallel()
+
+		client := newStatsServer(t, report)
+		inv, conf := newCLI(t, "prebuilds", "stats", "--days", "0")
+		clitest.SetupConfig(t, client, conf)
+
+		err := inv.WithContext(testutil.Context(t, testutil.WaitShort)).Run()
+		require.ErrorContains(t, err, "days must be at least 1")
+	})
+}
+
 func TestSchedulePrebuilds(t *testing.T) {
 	t.Parallel()
 
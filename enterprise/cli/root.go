@@ -25,6 +25,8 @@ func (r *RootCmd) enterpriseOnly() []*serpent.Command {
 		r.prebuilds(),
 		r.provisionerd(),
 		r.externalWorkspaces(),
+		r.audit(),
+		r.connectionLogs(),
 	}
 }
 
@@ -9,7 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"path/filepath"
+	"strings"
 
 	"golang.org/x/xerrors"
 	"tailscale.com/derp"
@@ -79,11 +82,28 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			options.DERPServer.SetMeshKey(meshKey)
 		}
 
+		closers := &multiCloser{}
+
+		var postgresOpts []backends.PostgresOption
+		if options.DeploymentValues.AuditLogging.HashChain.Value() {
+			postgresOpts = append(postgresOpts, backends.WithHashChain())
+		}
+		auditBackends := []audit.Backend{
+			backends.NewPostgres(options.Database, true, postgresOpts...),
+			backends.NewSlog(options.Logger),
+		}
+		streamingBackends, err := auditStreamingBackends(options)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("configure audit logging: %w", err)
+		}
+		for _, backend := range streamingBackends {
+			auditBackends = append(auditBackends, backend)
+			closers.Add(backend)
+		}
 		options.Auditor = audit.NewAuditor(
 			options.Database,
 			audit.DefaultFilter,
-			backends.NewPostgres(options.Database, true),
-			backends.NewSlog(options.Logger),
+			auditBackends...,
 		)
 
 		options.TrialGenerator = trialer.New(options.Database, "https://v2-licensor.coder.com/trial", coderd.Keys)
@@ -109,12 +129,14 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			for idx, ek := range encKeys {
 				dk, err := base64.StdEncoding.DecodeString(ek)
 				if err != nil {
+					_ = closers.Close()
 					return nil, nil, xerrors.Errorf("decode external-token-encryption-key %d: %w", idx, err)
 				}
 				keys = append(keys, dk)
 			}
 			cs, err := dbcrypt.NewCiphers(keys...)
 			if err != nil {
+				_ = closers.Close()
 				return nil, nil, xerrors.Errorf("initialize encryption: %w", err)
 			}
 			o.ExternalTokenEncryption = cs
@@ -124,11 +146,10 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 			o.LicenseKeys = coderd.Keys
 		}
 
-		closers := &multiCloser{}
-
 		// Create the enterprise API.
 		api, err := coderd.New(ctx, o)
 		if err != nil {
+			_ = closers.Close()
 			return nil, nil, err
 		}
 		closers.Add(api)
@@ -186,6 +207,84 @@ func (r *RootCmd) Server(_ func()) *serpent.Command {
 	return cmd
 }
 
+// auditStreamingBackends returns the audit backends which forward audit logs to
+// the external sinks configured for the deployment. Each must be closed to flush
+// buffered audit logs.
+func auditStreamingBackends(options *agplcoderd.Options) ([]backends.StreamingBackend, error) {
+	var (
+		cfg     = options.DeploymentValues.AuditLogging
+		logger  = options.Logger.Named("audit_export")
+		metrics = backends.NewMetrics(options.PrometheusRegistry)
+		out     []backends.StreamingBackend
+	)
+	closeAll := func() {
+		for _, backend := range out {
+			_ = backend.Close()
+		}
+	}
+
+	if cfg.Syslog.Address.Value() != "" {
+		backend, err := backends.NewSyslog(backends.SyslogOptions{
+			Address:   cfg.Syslog.Address.Value(),
+			TLS:       cfg.Syslog.TLS.Value(),
+			TLSCAFile: cfg.Syslog.TLSCAFile.Value(),
+			Logger:    logger.Named("syslog"),
+			Metrics:   metrics,
+		})
+		if err != nil {
+			return nil, xerrors.Errorf("syslog: %w", err)
+		}
+		out = append(out, backend)
+	}
+
+	if cfg.HTTP.Endpoint.String() != "" {
+		headers := make(http.Header)
+		for _, header := range cfg.HTTP.Headers.Value() {
+			name, value, ok := strings.Cut(header, ":")
+			if !ok || strings.TrimSpace(name) == "" {
+				closeAll()
+				return nil, xerrors.Errorf("http: invalid header %q, must be formatted as \"Name: value\"", header)
+			}
+			headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+		}
+		bufferDir := cfg.HTTP.BufferDir.Value()
+		if bufferDir == "" {
+			bufferDir = filepath.Join(options.CacheDir, "audit-logging-http")
+		}
+		backend, err := backends.NewHTTP(backends.HTTPOptions{
+			Endpoint:          cfg.HTTP.Endpoint.String(),
+			Headers:           headers,
+			BatchSize:         int(cfg.HTTP.BatchSize.Value()),
+			FlushInterval:     cfg.HTTP.FlushInterval.Value(),
+			BufferDir:         bufferDir,
+			MaxBufferedEvents: int(cfg.HTTP.MaxBufferedEvents.Value()),
+			Logger:            logger.Named("http"),
+			Metrics:           metrics,
+		})
+		if err != nil {
+			closeAll()
+			return nil, xerrors.Errorf("http: %w", err)
+		}
+		out = append(out, backend)
+	}
+
+	if cfg.File.Path.Value() != "" {
+		backend, err := backends.NewFile(backends.FileOptions{
+			Path:       cfg.File.Path.Value(),
+			MaxSizeMB:  int(cfg.File.MaxSize.Value()),
+			MaxBackups: int(cfg.File.MaxBackups.Value()),
+			Metrics:    metrics,
+		})
+		if err != nil {
+			closeAll()
+			return nil, xerrors.Errorf("file: %w", err)
+		}
+		out = append(out, backend)
+	}
+
+	return out, nil
+}
+
 type multiCloser struct {
 	closers []io.Closer
 }
@@ -1,10 +1,18 @@
 package coderd
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
 
@@ -12,10 +20,15 @@ import (
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbauthz"
+	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
 	"github.com/coder/coder/v2/coderd/searchquery"
+	"github.com/coder/coder/v2/coderd/util/ptr"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/enterprise/x/aibridgedserver"
 )
 
 const (
@@ -150,10 +163,659 @@ func populatedAndConvertAIBridgeInterceptions(ctx context.Context, db database.S
 		toolUsagesMap[row.InterceptionID] = append(toolUsagesMap[row.InterceptionID], row)
 	}
 
+	//nolint:gocritic // This is a system function until we implement a join for aibridge interceptions. AIBridge interception subresources use the same authorization call as their parent.
+	policyDecisionRows, err := db.ListAIBridgePolicyDecisionsByInterceptionIDs(dbauthz.AsSystemRestricted(ctx), ids)
+	if err != nil {
+		return nil, xerrors.Errorf("get linked aibridge policy decisions from database: %w", err)
+	}
+	policyDecisionsMap := make(map[uuid.UUID][]database.AIBridgePolicyDecision, len(dbInterceptions))
+	for _, row := range policyDecisionRows {
+		policyDecisionsMap[row.InterceptionID] = append(policyDecisionsMap[row.InterceptionID], row)
+	}
+
 	items := make([]codersdk.AIBridgeInterception, len(dbInterceptions))
 	for i, row := range dbInterceptions {
-		items[i] = db2sdk.AIBridgeInterception(row, tokenUsagesMap[row.ID], userPromptsMap[row.ID], toolUsagesMap[row.ID])
+		items[i] = db2sdk.AIBridgeInterception(row, tokenUsagesMap[row.ID], userPromptsMap[row.ID], toolUsagesMap[row.ID], policyDecisionsMap[row.ID])
 	}
 
 	return items, nil
 }
+
+// @Summary List AIBridge limits
+// @ID list-aibridge-limits
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Success 200 {array} codersdk.AIBridgeLimit
+// @Router /api/experimental/aibridge/limits [get]
+func (api *API) aiBridgeListLimits(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionRead, rbac.ResourceDeploymentConfig) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	limits, err := api.Database.GetAIBridgeLimits(ctx)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge limits.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.List(limits, db2sdk.AIBridgeLimit))
+}
+
+// @Summary Upsert AIBridge limit
+// @ID upsert-aibridge-limit
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags AIBridge
+// @Param scope path string true "Limit scope" Enums(user,group,organization)
+// @Param scope_id path string true "User, group or organization ID" format(uuid)
+// @Param request body codersdk.UpsertAIBridgeLimitRequest true "Limit"
+// @Success 200 {object} codersdk.AIBridgeLimit
+// @Router /api/experimental/aibridge/limits/{scope}/{scope_id} [put]
+func (api *API) aiBridgeUpsertLimit(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceDeploymentConfig) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	scope, scopeID, ok := parseAIBridgeLimitScope(rw, r)
+	if !ok {
+		return
+	}
+
+	var req codersdk.UpsertAIBridgeLimitRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	var validations []codersdk.ValidationError
+	if req.DailyTokenBudget != nil && *req.DailyTokenBudget <= 0 {
+		validations = append(validations, codersdk.ValidationError{Field: "daily_token_budget", Detail: "Must be positive."})
+	}
+	if req.MonthlyTokenBudget != nil && *req.MonthlyTokenBudget <= 0 {
+		validations = append(validations, codersdk.ValidationError{Field: "monthly_token_budget", Detail: "Must be positive."})
+	}
+	if req.RequestsPerMinute != nil && *req.RequestsPerMinute <= 0 {
+		validations = append(validations, codersdk.ValidationError{Field: "requests_per_minute", Detail: "Must be positive."})
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid AIBridge limit.",
+			Validations: validations,
+		})
+		return
+	}
+	if req.DailyTokenBudget == nil && req.MonthlyTokenBudget == nil && req.RequestsPerMinute == nil {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: "At least one limit must be set.",
+			Detail:  "Delete the limit to remove it entirely.",
+		})
+		return
+	}
+
+	// Limits are not linked to their subject by foreign keys, so validate
+	// that the subject exists here.
+	var err error
+	switch scope {
+	case database.AIBridgeLimitScopeUser:
+		_, err = api.Database.GetUserByID(ctx, scopeID)
+	case database.AIBridgeLimitScopeGroup:
+		_, err = api.Database.GetGroupByID(ctx, scopeID)
+	case database.AIBridgeLimitScopeOrganization:
+		_, err = api.Database.GetOrganizationByID(ctx, scopeID)
+	}
+	if httpapi.Is404Error(err) {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("No %s with ID %q exists.", scope, scopeID),
+		})
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: fmt.Sprintf("Internal error getting %s.", scope),
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	params := database.UpsertAIBridgeLimitParams{
+		Scope:     scope,
+		ScopeID:   scopeID,
+		UpdatedAt: dbtime.Now(),
+	}
+	if req.DailyTokenBudget != nil {
+		params.DailyTokenBudget = sql.NullInt64{Int64: *req.DailyTokenBudget, Valid: true}
+	}
+	if req.MonthlyTokenBudget != nil {
+		params.MonthlyTokenBudget = sql.NullInt64{Int64: *req.MonthlyTokenBudget, Valid: true}
+	}
+	if req.RequestsPerMinute != nil {
+		params.RequestsPerMinute = sql.NullInt32{Int32: *req.RequestsPerMinute, Valid: true}
+	}
+	limit, err := api.Database.UpsertAIBridgeLimit(ctx, params)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating AIBridge limit.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.AIBridgeLimit(limit))
+}
+
+// @Summary Delete AIBridge limit
+// @ID delete-aibridge-limit
+// @Security CoderSessionToken
+// @Tags AIBridge
+// @Param scope path string true "Limit scope" Enums(user,group,organization)
+// @Param scope_id path string true "User, group or organization ID" format(uuid)
+// @Success 204
+// @Router /api/experimental/aibridge/limits/{scope}/{scope_id} [delete]
+func (api *API) aiBridgeDeleteLimit(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceDeploymentConfig) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	scope, scopeID, ok := parseAIBridgeLimitScope(rw, r)
+	if !ok {
+		return
+	}
+
+	err := api.Database.DeleteAIBridgeLimit(ctx, database.DeleteAIBridgeLimitParams{
+		Scope:   scope,
+		ScopeID: scopeID,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error deleting AIBridge limit.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Get AIBridge usage of user
+// @ID get-aibridge-usage-of-user
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {object} codersdk.AIBridgeUsage
+// @Router /api/experimental/aibridge/users/{user}/usage [get]
+func (api *API) aiBridgeUserUsage(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := httpmw.UserParam(r)
+
+	if !api.Authorize(r, policy.ActionRead, rbac.ResourceAibridgeInterception.WithOwner(user.ID.String())) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+
+	// Users may see the pooled usage of their groups and organizations, since
+	// it determines whether their own requests are allowed.
+	//nolint:gocritic // Pooled usage spans other users' interceptions.
+	sysCtx := dbauthz.AsSystemRestricted(ctx)
+	now := dbtime.Now()
+	usages, err := aibridgedserver.GetLimitUsages(sysCtx, api.Database, user.ID, now)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge limit usage.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	own, err := api.Database.GetAIBridgeLimitUsage(ctx, aibridgedserver.LimitUsageParams(database.AIBridgeLimitScopeUser, user.ID, now))
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge usage.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := codersdk.AIBridgeUsage{
+		UserID:             user.ID,
+		DailyTokens:        own.DailyTokens,
+		MonthlyTokens:      own.MonthlyTokens,
+		RequestsLastMinute: own.RecentRequests,
+		Limits:             make([]codersdk.AIBridgeLimitUsage, 0, len(usages)),
+	}
+	for _, usage := range usages {
+		resp.Limits = append(resp.Limits, codersdk.AIBridgeLimitUsage{
+			Limit:              db2sdk.AIBridgeLimit(usage.Limit),
+			DailyTokens:        usage.Usage.DailyTokens,
+			MonthlyTokens:      usage.Usage.MonthlyTokens,
+			RequestsLastMinute: usage.Usage.RecentRequests,
+			DailyResetsAt:      usage.DayResetsAt,
+			MonthlyResetsAt:    usage.MonthResetsAt,
+			Exceeded:           len(usage.Violations(now)) > 0,
+		})
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// @Summary Get AIBridge capture settings of user
+// @ID get-aibridge-capture-settings-of-user
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {object} codersdk.AIBridgeCaptureSettings
+// @Router /api/experimental/aibridge/users/{user}/capture [get]
+func (api *API) aiBridgeUserCaptureSettings(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := httpmw.UserParam(r)
+
+	capture, err := api.Database.GetUserAIBridgeCapture(ctx, user.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		if dbauthz.IsNotAuthorizedError(err) {
+			httpapi.ResourceNotFound(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge capture settings.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.AIBridgeCaptureSettings{
+		Enabled: capture == "true",
+	})
+}
+
+// @Summary Update AIBridge capture settings of user
+// @ID update-aibridge-capture-settings-of-user
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags AIBridge
+// @Param user path string true "User ID, name, or me"
+// @Param request body codersdk.AIBridgeCaptureSettings true "Capture settings"
+// @Success 200 {object} codersdk.AIBridgeCaptureSettings
+// @Router /api/experimental/aibridge/users/{user}/capture [put]
+func (api *API) aiBridgeUpdateUserCaptureSettings(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := httpmw.UserParam(r)
+
+	var req codersdk.AIBridgeCaptureSettings
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	updated, err := api.Database.UpdateUserAIBridgeCapture(ctx, database.UpdateUserAIBridgeCaptureParams{
+		UserID:          user.ID,
+		AibridgeCapture: strconv.FormatBool(req.Enabled),
+	})
+	if err != nil {
+		if dbauthz.IsNotAuthorizedError(err) {
+			httpapi.ResourceNotFound(rw)
+			return
+		}
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating AIBridge capture settings.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.AIBridgeCaptureSettings{
+		Enabled: updated.Value == "true",
+	})
+}
+
+// @Summary Get AIBridge interception capture
+// @ID get-aibridge-interception-capture
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Param interception path string true "Interception ID" format(uuid)
+// @Success 200 {object} codersdk.AIBridgeInterceptionCapture
+// @Router /api/experimental/aibridge/interceptions/{interception}/capture [get]
+func (api *API) aiBridgeInterceptionCapture(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	interceptionID, ok := httpmw.ParseUUIDParam(rw, r, "interception")
+	if !ok {
+		return
+	}
+
+	capture, err := api.Database.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, interceptionID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge interception capture.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	resp := codersdk.AIBridgeInterceptionCapture{
+		InterceptionID:        capture.InterceptionID,
+		Method:                capture.Method,
+		Path:                  capture.Path,
+		RequestBody:           capture.RequestBody,
+		RequestBodyTruncated:  capture.RequestBodyTruncated,
+		ResponseStatus:        int(capture.ResponseStatus),
+		ResponseBody:          capture.ResponseBody,
+		ResponseBodyTruncated: capture.ResponseBodyTruncated,
+		CreatedAt:             capture.CreatedAt,
+	}
+	if err := json.Unmarshal(capture.RequestHeaders, &resp.RequestHeaders); err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error decoding captured request headers.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+	if err := json.Unmarshal(capture.ResponseHeaders, &resp.ResponseHeaders); err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error decoding captured response headers.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+func parseAIBridgeLimitScope(rw http.ResponseWriter, r *http.Request) (database.AIBridgeLimitScope, uuid.UUID, bool) {
+	ctx := r.Context()
+
+	scope := database.AIBridgeLimitScope(chi.URLParam(r, "scope"))
+	if !scope.Valid() {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message: fmt.Sprintf("Invalid limit scope %q.", scope),
+			Detail:  fmt.Sprintf("Valid scopes are %v.", database.AllAIBridgeLimitScopeValues()),
+		})
+		return "", uuid.Nil, false
+	}
+
+	scopeID, ok := httpmw.ParseUUIDParam(rw, r, "scope_id")
+	if !ok {
+		return "", uuid.Nil, false
+	}
+	return scope, scopeID, true
+}
+
+// @Summary List AIBridge model prices
+// @ID list-aibridge-model-prices
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Success 200 {array} codersdk.AIBridgeModelPrice
+// @Router /api/experimental/aibridge/prices [get]
+func (api *API) aiBridgeListModelPrices(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionRead, rbac.ResourceAibridgeInterception) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	prices, err := api.Database.GetAIBridgeModelPrices(ctx)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge model prices.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.List(prices, db2sdk.AIBridgeModelPrice))
+}
+
+// @Summary Update AIBridge model prices
+// @ID update-aibridge-model-prices
+// @Security CoderSessionToken
+// @Accept json
+// @Produce json
+// @Tags AIBridge
+// @Param request body codersdk.UpdateAIBridgeModelPricesRequest true "Model prices"
+// @Success 200 {array} codersdk.AIBridgeModelPrice
+// @Router /api/experimental/aibridge/prices [put]
+func (api *API) aiBridgeUpdateModelPrices(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionUpdate, rbac.ResourceDeploymentConfig) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	var req codersdk.UpdateAIBridgeModelPricesRequest
+	if !httpapi.Read(ctx, rw, r, &req) {
+		return
+	}
+
+	var (
+		validations []codersdk.ValidationError
+		seen        = make(map[aiBridgeModelKey]struct{}, len(req.Prices))
+		params      = database.InsertAIBridgeModelPricesParams{UpdatedAt: dbtime.Now()}
+	)
+	for i, price := range req.Prices {
+		field := fmt.Sprintf("prices[%d]", i)
+		if price.Provider == "" || price.Model == "" {
+			validations = append(validations, codersdk.ValidationError{Field: field, Detail: "Provider and model are required."})
+		}
+		if price.InputPricePerMillionTokens < 0 || price.OutputPricePerMillionTokens < 0 {
+			validations = append(validations, codersdk.ValidationError{Field: field, Detail: "Prices must not be negative."})
+		}
+		key := aiBridgeModelKey{provider: price.Provider, model: price.Model}
+		if _, ok := seen[key]; ok {
+			validations = append(validations, codersdk.ValidationError{Field: field, Detail: fmt.Sprintf("Model %q of provider %q is priced more than once.", price.Model, price.Provider)})
+		}
+		seen[key] = struct{}{}
+
+		params.Provider = append(params.Provider, price.Provider)
+		params.Model = append(params.Model, price.Model)
+		params.InputPricePerMillionTokens = append(params.InputPricePerMillionTokens, price.InputPricePerMillionTokens)
+		params.OutputPricePerMillionTokens = append(params.OutputPricePerMillionTokens, price.OutputPricePerMillionTokens)
+	}
+	if len(validations) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Invalid AIBridge model prices.",
+			Validations: validations,
+		})
+		return
+	}
+
+	var prices []database.AIBridgeModelPrice
+	err := api.Database.InTx(func(tx database.Store) error {
+		if err := tx.DeleteAIBridgeModelPrices(ctx); err != nil {
+			return xerrors.Errorf("delete model prices: %w", err)
+		}
+		if err := tx.InsertAIBridgeModelPrices(ctx, params); err != nil {
+			return xerrors.Errorf("insert model prices: %w", err)
+		}
+		var err error
+		prices, err = tx.GetAIBridgeModelPrices(ctx)
+		if err != nil {
+			return xerrors.Errorf("get model prices: %w", err)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error updating AIBridge model prices.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, db2sdk.List(prices, db2sdk.AIBridgeModelPrice))
+}
+
+// aiBridgeInsights returns the AIBridge usage of the deployment, grouped by
+// user, template, provider and model, along with its estimated cost.
+//
+// @Summary Get AIBridge usage insights
+// @ID get-aibridge-usage-insights
+// @Security CoderSessionToken
+// @Produce json
+// @Tags AIBridge
+// @Param start_time query string true "Start time, on the hour" format(date-time)
+// @Param end_time query string true "End time, on the hour" format(date-time)
+// @Param group_by query string false "Comma separated dimensions to group by. Available values are: user, template, provider, model. Defaults to all."
+// @Success 200 {object} codersdk.AIBridgeInsightsResponse
+// @Router /api/experimental/aibridge/insights [get]
+func (api *API) aiBridgeInsights(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !api.Authorize(r, policy.ActionRead, rbac.ResourceAibridgeInterception) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	p := httpapi.NewQueryParamParser().RequiredNotEmpty("start_time", "end_time")
+	vals := r.URL.Query()
+	var (
+		startTime = p.Time(vals, time.Time{}, "start_time", time.RFC3339)
+		endTime   = p.Time(vals, time.Time{}, "end_time", time.RFC3339)
+		groupBy   = httpapi.ParseCustomList(p, vals, codersdk.AIBridgeInsightsGroupByValues, "group_by", func(v string) (codersdk.AIBridgeInsightsGroupBy, error) {
+			g := codersdk.AIBridgeInsightsGroupBy(v)
+			if !slices.Contains(codersdk.AIBridgeInsightsGroupByValues, g) {
+				return "", xerrors.Errorf("must be one of %v", codersdk.AIBridgeInsightsGroupByValues)
+			}
+			return g, nil
+		})
+	)
+	p.ErrorExcessParams(vals)
+	// The usage is rolled up hourly, so finer ranges cannot be honored.
+	for name, t := range map[string]time.Time{"start_time": startTime, "end_time": endTime} {
+		if !t.Equal(t.Truncate(time.Hour)) {
+			p.Errors = append(p.Errors, codersdk.ValidationError{Field: name, Detail: fmt.Sprintf("Query param %q must be on the hour.", name)})
+		}
+	}
+	if len(p.Errors) == 0 && !startTime.Before(endTime) {
+		p.Errors = append(p.Errors, codersdk.ValidationError{Field: "start_time", Detail: "Query param \"start_time\" must be before \"end_time\"."})
+	}
+	if len(p.Errors) > 0 {
+		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
+			Message:     "Query parameters have invalid values.",
+			Validations: p.Errors,
+		})
+		return
+	}
+	if len(groupBy) == 0 {
+		groupBy = codersdk.AIBridgeInsightsGroupByValues
+	}
+
+	stats, err := api.Database.GetAIBridgeUsageStats(ctx, database.GetAIBridgeUsageStatsParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge usage stats.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	prices, err := api.Database.GetAIBridgeModelPrices(ctx)
+	if err != nil {
+		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
+			Message: "Internal error getting AIBridge model prices.",
+			Detail:  err.Error(),
+		})
+		return
+	}
+
+	httpapi.Write(ctx, rw, http.StatusOK, codersdk.AIBridgeInsightsResponse{
+		StartTime: startTime,
+		EndTime:   endTime,
+		GroupBy:   groupBy,
+		Rows:      aiBridgeInsightsRows(stats, prices, groupBy),
+		Total:     aiBridgeInsightsRows(stats, prices, nil)[0],
+	})
+}
+
+type aiBridgeModelKey struct {
+	provider, model string
+}
+
+// aiBridgeInsightsRows prices the usage of each model and sums it by the given
+// dimensions, most expensive first. A single row is always returned when no
+// dimensions are given, even if there is no usage.
+func aiBridgeInsightsRows(stats []database.GetAIBridgeUsageStatsRow, prices []database.AIBridgeModelPrice, groupBy []codersdk.AIBridgeInsightsGroupBy) []codersdk.AIBridgeInsightsRow {
+	priceOf := make(map[aiBridgeModelKey]database.AIBridgeModelPrice, len(prices))
+	for _, price := range prices {
+		priceOf[aiBridgeModelKey{provider: price.Provider, model: price.Model}] = price
+	}
+
+	rows := make(map[codersdk.AIBridgeInsightsRow]*codersdk.AIBridgeInsightsRow)
+	if len(groupBy) == 0 {
+		rows[codersdk.AIBridgeInsightsRow{}] = &codersdk.AIBridgeInsightsRow{}
+	}
+	for _, stat := range stats {
+		var key codersdk.AIBridgeInsightsRow
+		for _, g := range groupBy {
+			switch g {
+			case codersdk.AIBridgeInsightsGroupByUser:
+				key.UserID, key.Username = stat.UserID, stat.Username
+			case codersdk.AIBridgeInsightsGroupByTemplate:
+				key.TemplateID, key.TemplateName = stat.TemplateID, stat.TemplateName
+			case codersdk.AIBridgeInsightsGroupByProvider:
+				key.Provider = stat.Provider
+			case codersdk.AIBridgeInsightsGroupByModel:
+				key.Model = stat.Model
+			}
+		}
+		row, ok := rows[key]
+		if !ok {
+			row = ptr.Ref(key)
+			rows[key] = row
+		}
+
+		row.Requests += stat.Requests
+		row.InputTokens += stat.InputTokens
+		row.OutputTokens += stat.OutputTokens
+
+		// Fall back to the price of every model of the provider.
+		price, ok := priceOf[aiBridgeModelKey{provider: stat.Provider, model: stat.Model}]
+		if !ok {
+			price, ok = priceOf[aiBridgeModelKey{provider: stat.Provider, model: "*"}]
+		}
+		if !ok {
+			row.UnpricedTokens += stat.InputTokens + stat.OutputTokens
+			continue
+		}
+		row.EstimatedCost += (float64(stat.InputTokens)*price.InputPricePerMillionTokens + float64(stat.OutputTokens)*price.OutputPricePerMillionTokens) / 1_000_000
+	}
+
+	out := make([]codersdk.AIBridgeInsightsRow, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *row)
+	}
+	slices.SortFunc(out, func(a, b codersdk.AIBridgeInsightsRow) int {
+		return cmp.Or(
+			cmp.Compare(b.EstimatedCost, a.EstimatedCost),
+			cmp.Compare(b.InputTokens+b.OutputTokens, a.InputTokens+a.OutputTokens),
+			cmp.Compare(a.Username, b.Username),
+			cmp.Compare(a.TemplateName, b.TemplateName),
+			cmp.Compare(a.Provider, b.Provider),
+			cmp.Compare(a.Model, b.Model),
+		)
+	})
+	return out
+}
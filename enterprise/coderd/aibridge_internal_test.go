@@ -0,0 +1,69 @@
+package coderd
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/codersdk"
+)
+
+func Test_aiBridgeInsightsRows(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice    = uuid.UUID{1}
+		bob      = uuid.UUID{2}
+		template = uuid.UUID{3}
+	)
+	stats := []database.GetAIBridgeUsageStatsRow{
+		{UserID: alice, Username: "alice", TemplateID: template, TemplateName: "docker", Provider: "openai", Model: "gpt-4.1", Requests: 2, InputTokens: 1_000_000, OutputTokens: 500_000},
+		{UserID: alice, Username: "alice", Provider: "anthropic", Model: "claude-sonnet", Requests: 1, InputTokens: 1_000_000, OutputTokens: 1_000_000},
+		{UserID: bob, Username: "bob", TemplateID: template, TemplateName: "docker", Provider: "openai", Model: "gpt-4.1", Requests: 1, InputTokens: 2_000_000},
+		{UserID: bob, Username: "bob", Provider: "google", Model: "gemini", Requests: 1, InputTokens: 7, OutputTokens: 3},
+	}
+	prices := []database.AIBridgeModelPrice{
+		{Provider: "openai", Model: "gpt-4.1", InputPricePerMillionTokens: 2, OutputPricePerMillionTokens: 8},
+		{Provider: "anthropic", Model: "*", InputPricePerMillionTokens: 3, OutputPricePerMillionTokens: 15},
+	}
+
+	t.Run("Total", func(t *testing.T) {
+		t.Parallel()
+
+		rows := aiBridgeInsightsRows(stats, prices, nil)
+		require.Equal(t, []codersdk.AIBridgeInsightsRow{
+			{Requests: 5, InputTokens: 4_000_007, OutputTokens: 1_500_003, EstimatedCost: 28, UnpricedTokens: 10},
+		}, rows)
+	})
+
+	t.Run("NoUsage", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []codersdk.AIBridgeInsightsRow{{}}, aiBridgeInsightsRows(nil, prices, nil))
+		require.Empty(t, aiBridgeInsightsRows(nil, prices, []codersdk.AIBridgeInsightsGroupBy{codersdk.AIBridgeInsightsGroupByUser}))
+	})
+
+	t.Run("ByTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		rows := aiBridgeInsightsRows(stats, prices, []codersdk.AIBridgeInsightsGroupBy{codersdk.AIBridgeInsightsGroupByTemplate})
+		require.Equal(t, []codersdk.AIBridgeInsightsRow{
+			{Requests: 2, InputTokens: 1_000_007, OutputTokens: 1_000_003, EstimatedCost: 18, UnpricedTokens: 10},
+			{TemplateID: template, TemplateName: "docker", Requests: 3, InputTokens: 3_000_000, OutputTokens: 500_000, EstimatedCost: 10},
+		}, rows)
+	})
+
+	t.Run("ByUserAndModel", func(t *testing.T) {
+		t.Parallel()
+
+		rows := aiBridgeInsightsRows(stats, prices, []codersdk.AIBridgeInsightsGroupBy{codersdk.AIBridgeInsightsGroupByUser, codersdk.AIBridgeInsightsGroupByModel})
+		require.Equal(t, []codersdk.AIBridgeInsightsRow{
+			{UserID: alice, Username: "alice", Model: "claude-sonnet", Requests: 1, InputTokens: 1_000_000, OutputTokens: 1_000_000, EstimatedCost: 18},
+			{UserID: alice, Username: "alice", Model: "gpt-4.1", Requests: 2, InputTokens: 1_000_000, OutputTokens: 500_000, EstimatedCost: 6},
+			{UserID: bob, Username: "bob", Model: "gpt-4.1", Requests: 1, InputTokens: 2_000_000, EstimatedCost: 4},
+			{UserID: bob, Username: "bob", Model: "gemini", Requests: 1, InputTokens: 7, OutputTokens: 3, UnpricedTokens: 10},
+		}, rows)
+	})
+}
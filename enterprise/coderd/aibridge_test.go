@@ -13,6 +13,7 @@ import (
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
 	"github.com/coder/coder/v2/coderd/database/dbgen"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
+	"github.com/coder/coder/v2/coderd/util/ptr"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/cryptorand"
 	"github.com/coder/coder/v2/enterprise/coderd/coderdenttest"
@@ -114,6 +115,16 @@ func TestAIBridgeListInterceptions(t *testing.T) {
 			InterceptionID: i1.ID,
 			CreatedAt:      now.Add(-time.Minute),
 		})
+		i1pd1 := dbgen.AIBridgePolicyDecision(t, db, database.InsertAIBridgePolicyDecisionParams{
+			InterceptionID: i1.ID,
+			Action:         database.AIBridgePolicyActionBlock,
+			CreatedAt:      now,
+		})
+		i1pd2 := dbgen.AIBridgePolicyDecision(t, db, database.InsertAIBridgePolicyDecisionParams{
+			InterceptionID: i1.ID,
+			Action:         database.AIBridgePolicyActionRedact,
+			CreatedAt:      now.Add(-time.Minute),
+		})
 		i2 := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
 			StartedAt: now,
 		})
@@ -121,8 +132,8 @@ func TestAIBridgeListInterceptions(t *testing.T) {
 		// Convert to SDK types for response comparison.
 		// You may notice that the ordering of the inner arrays are ASC, this is
 		// intentional.
-		i1SDK := db2sdk.AIBridgeInterception(i1, []database.AIBridgeTokenUsage{i1tok2, i1tok1}, []database.AIBridgeUserPrompt{i1up2, i1up1}, []database.AIBridgeToolUsage{i1tool2, i1tool1})
-		i2SDK := db2sdk.AIBridgeInterception(i2, nil, nil, nil)
+		i1SDK := db2sdk.AIBridgeInterception(i1, []database.AIBridgeTokenUsage{i1tok2, i1tok1}, []database.AIBridgeUserPrompt{i1up2, i1up1}, []database.AIBridgeToolUsage{i1tool2, i1tool1}, []database.AIBridgePolicyDecision{i1pd2, i1pd1})
+		i2SDK := db2sdk.AIBridgeInterception(i2, nil, nil, nil, nil)
 
 		res, err := experimentalClient.AIBridgeListInterceptions(ctx, codersdk.AIBridgeListInterceptionsFilter{})
 		require.NoError(t, err)
@@ -149,6 +160,11 @@ func TestAIBridgeListInterceptions(t *testing.T) {
 		require.Equal(t, i1SDK.ToolUsages[1].ID, res.Results[1].ToolUsages[1].ID)
 		res.Results[1].ToolUsages[0].CreatedAt = i1SDK.ToolUsages[0].CreatedAt
 		res.Results[1].ToolUsages[1].CreatedAt = i1SDK.ToolUsages[1].CreatedAt
+		require.Len(t, res.Results[1].PolicyDecisions, 2)
+		require.Equal(t, i1SDK.PolicyDecisions[0].ID, res.Results[1].PolicyDecisions[0].ID)
+		require.Equal(t, i1SDK.PolicyDecisions[1].ID, res.Results[1].PolicyDecisions[1].ID)
+		res.Results[1].PolicyDecisions[0].CreatedAt = i1SDK.PolicyDecisions[0].CreatedAt
+		res.Results[1].PolicyDecisions[1].CreatedAt = i1SDK.PolicyDecisions[1].CreatedAt
 
 		require.Equal(t, []codersdk.AIBridgeInterception{i2SDK, i1SDK}, res.Results)
 	})
@@ -330,9 +346,9 @@ func TestAIBridgeListInterceptions(t *testing.T) {
 
 		// Convert to SDK types for response comparison. We don't care about the
 		// inner arrays for this test.
-		i1SDK := db2sdk.AIBridgeInterception(i1, nil, nil, nil)
-		i2SDK := db2sdk.AIBridgeInterception(i2, nil, nil, nil)
-		i3SDK := db2sdk.AIBridgeInterception(i3, nil, nil, nil)
+		i1SDK := db2sdk.AIBridgeInterception(i1, nil, nil, nil, nil)
+		i2SDK := db2sdk.AIBridgeInterception(i2, nil, nil, nil, nil)
+		i3SDK := db2sdk.AIBridgeInterception(i3, nil, nil, nil, nil)
 
 		cases := []struct {
 			name   string
@@ -532,3 +548,298 @@ func TestAIBridgeListInterceptions(t *testing.T) {
 		}
 	})
 }
+
+func TestAIBridgeLimits(t *testing.T) {
+	t.Parallel()
+
+	dv := coderdtest.DeploymentValues(t)
+	dv.Experiments = []string{string(codersdk.ExperimentAIBridge)}
+	adminClient, db, firstUser := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		Options: &coderdtest.Options{
+			DeploymentValues: dv,
+		},
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAIBridge: 1,
+			},
+		},
+	})
+	adminExperimentalClient := codersdk.NewExperimentalClient(adminClient)
+	memberClient, member := coderdtest.CreateAnotherUser(t, adminClient, firstUser.OrganizationID)
+	memberExperimentalClient := codersdk.NewExperimentalClient(memberClient)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	// Given: a daily budget for the member, and a rate limit shared by the
+	// whole organization.
+	userLimit, err := adminExperimentalClient.UpsertAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeUser, member.ID, codersdk.UpsertAIBridgeLimitRequest{
+		DailyTokenBudget: ptr.Ref[int64](100),
+	})
+	require.NoError(t, err)
+	require.Equal(t, ptr.Ref[int64](100), userLimit.DailyTokenBudget)
+	require.Nil(t, userLimit.MonthlyTokenBudget)
+	_, err = adminExperimentalClient.UpsertAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeOrganization, firstUser.OrganizationID, codersdk.UpsertAIBridgeLimitRequest{
+		RequestsPerMinute: ptr.Ref[int32](2),
+	})
+	require.NoError(t, err)
+
+	limits, err := adminExperimentalClient.AIBridgeLimits(ctx)
+	require.NoError(t, err)
+	require.Len(t, limits, 2)
+
+	// Given: the member and the admin have both used AI Bridge.
+	now := dbtime.Now()
+	memberIntc := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: member.ID,
+		StartedAt:   now,
+	})
+	dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+		InterceptionID: memberIntc.ID,
+		InputTokens:    60,
+		OutputTokens:   40,
+		CreatedAt:      now,
+	})
+	adminIntc := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: firstUser.UserID,
+		StartedAt:   now,
+	})
+	dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+		InterceptionID: adminIntc.ID,
+		InputTokens:    5,
+		OutputTokens:   5,
+		CreatedAt:      now,
+	})
+
+	// When: the member checks their usage.
+	usage, err := memberExperimentalClient.AIBridgeUsage(ctx, codersdk.Me)
+	require.NoError(t, err)
+
+	// Then: their own usage is reported, and both limits are exceeded since
+	// the organization's rate limit is shared with the admin.
+	require.Equal(t, member.ID, usage.UserID)
+	require.EqualValues(t, 100, usage.DailyTokens)
+	require.EqualValues(t, 1, usage.RequestsLastMinute)
+	require.Len(t, usage.Limits, 2)
+	for _, l := range usage.Limits {
+		require.True(t, l.Exceeded, "limit %s exceeded", l.Limit.Scope)
+		switch l.Limit.Scope {
+		case codersdk.AIBridgeLimitScopeUser:
+			require.EqualValues(t, 100, l.DailyTokens)
+		case codersdk.AIBridgeLimitScopeOrganization:
+			require.EqualValues(t, 2, l.RequestsLastMinute)
+			require.EqualValues(t, 110, l.MonthlyTokens)
+		}
+		require.True(t, l.DailyResetsAt.After(now))
+	}
+
+	// Members cannot manage limits, nor see the usage of others.
+	_, err = memberExperimentalClient.UpsertAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeUser, member.ID, codersdk.UpsertAIBridgeLimitRequest{
+		DailyTokenBudget: ptr.Ref[int64](1000),
+	})
+	requireSDKErrorStatus(t, err, http.StatusForbidden)
+	_, err = memberExperimentalClient.AIBridgeUsage(ctx, firstUser.UserID.String())
+	requireSDKErrorStatus(t, err, http.StatusNotFound)
+
+	// Limits must be positive, and their subject must exist.
+	_, err = adminExperimentalClient.UpsertAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeUser, member.ID, codersdk.UpsertAIBridgeLimitRequest{
+		DailyTokenBudget: ptr.Ref[int64](0),
+	})
+	requireSDKErrorStatus(t, err, http.StatusBadRequest)
+	_, err = adminExperimentalClient.UpsertAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeGroup, uuid.New(), codersdk.UpsertAIBridgeLimitRequest{
+		DailyTokenBudget: ptr.Ref[int64](10),
+	})
+	requireSDKErrorStatus(t, err, http.StatusBadRequest)
+
+	// When: the member's limit is deleted.
+	err = adminExperimentalClient.DeleteAIBridgeLimit(ctx, codersdk.AIBridgeLimitScopeUser, member.ID)
+	require.NoError(t, err)
+
+	// Then: only the organization's limit remains.
+	usage, err = memberExperimentalClient.AIBridgeUsage(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.Len(t, usage.Limits, 1)
+	require.Equal(t, codersdk.AIBridgeLimitScopeOrganization, usage.Limits[0].Limit.Scope)
+}
+
+func TestAIBridgeCapture(t *testing.T) {
+	t.Parallel()
+
+	dv := coderdtest.DeploymentValues(t)
+	dv.Experiments = []string{string(codersdk.ExperimentAIBridge)}
+	adminClient, db, firstUser := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		Options: &coderdtest.Options{
+			DeploymentValues: dv,
+		},
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAIBridge: 1,
+			},
+		},
+	})
+	adminExperimentalClient := codersdk.NewExperimentalClient(adminClient)
+	memberClient, member := coderdtest.CreateAnotherUser(t, adminClient, firstUser.OrganizationID)
+	memberExperimentalClient := codersdk.NewExperimentalClient(memberClient)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	// Capture is disabled until the user opts in.
+	settings, err := memberExperimentalClient.AIBridgeCaptureSettings(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.False(t, settings.Enabled)
+
+	settings, err = memberExperimentalClient.UpdateAIBridgeCaptureSettings(ctx, codersdk.Me, codersdk.AIBridgeCaptureSettings{Enabled: true})
+	require.NoError(t, err)
+	require.True(t, settings.Enabled)
+	settings, err = memberExperimentalClient.AIBridgeCaptureSettings(ctx, codersdk.Me)
+	require.NoError(t, err)
+	require.True(t, settings.Enabled)
+
+	// Members cannot change the settings of others.
+	_, err = memberExperimentalClient.UpdateAIBridgeCaptureSettings(ctx, firstUser.UserID.String(), codersdk.AIBridgeCaptureSettings{Enabled: true})
+	requireSDKErrorStatus(t, err, http.StatusNotFound)
+
+	// Given: a captured interception of the member.
+	intc := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: member.ID,
+	})
+	dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+		InterceptionID:  intc.ID,
+		RequestHeaders:  []byte(`{"Authorization":["<redacted>"],"Content-Type":["application/json"]}`),
+		RequestBody:     `{"model":"gpt-4.1"}`,
+		ResponseStatus:  http.StatusTeapot,
+		ResponseBody:    `i am a teapot`,
+		ResponseHeaders: []byte(`{}`),
+	})
+
+	// Then: the member and admins can read the capture.
+	for _, client := range []*codersdk.ExperimentalClient{memberExperimentalClient, adminExperimentalClient} {
+		capture, err := client.AIBridgeInterceptionCapture(ctx, intc.ID)
+		require.NoError(t, err)
+		require.Equal(t, intc.ID, capture.InterceptionID)
+		require.Equal(t, http.MethodPost, capture.Method)
+		require.Equal(t, []string{codersdk.AIBridgeCaptureRedactedValue}, capture.RequestHeaders["Authorization"])
+		require.Equal(t, `{"model":"gpt-4.1"}`, capture.RequestBody)
+		require.Equal(t, http.StatusTeapot, capture.ResponseStatus)
+		require.Equal(t, `i am a teapot`, capture.ResponseBody)
+		require.Empty(t, capture.ResponseHeaders)
+	}
+
+	// Then: other members cannot read the capture.
+	otherClient, _ := coderdtest.CreateAnotherUser(t, adminClient, firstUser.OrganizationID)
+	_, err = codersdk.NewExperimentalClient(otherClient).AIBridgeInterceptionCapture(ctx, intc.ID)
+	requireSDKErrorStatus(t, err, http.StatusNotFound)
+
+	// Interceptions which were not captured are not found.
+	uncaptured := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+		InitiatorID: member.ID,
+	})
+	_, err = memberExperimentalClient.AIBridgeInterceptionCapture(ctx, uncaptured.ID)
+	requireSDKErrorStatus(t, err, http.StatusNotFound)
+}
+
+func TestAIBridgeInsights(t *testing.T) {
+	t.Parallel()
+
+	dv := coderdtest.DeploymentValues(t)
+	dv.Experiments = []string{string(codersdk.ExperimentAIBridge)}
+	adminClient, db, firstUser := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		Options: &coderdtest.Options{
+			DeploymentValues: dv,
+		},
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAIBridge: 1,
+			},
+		},
+	})
+	adminExperimentalClient := codersdk.NewExperimentalClient(adminClient)
+	memberClient, member := coderdtest.CreateAnotherUser(t, adminClient, firstUser.OrganizationID)
+	memberExperimentalClient := codersdk.NewExperimentalClient(memberClient)
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	// Given: a price for every Anthropic model, and a more specific one.
+	prices, err := adminExperimentalClient.UpdateAIBridgeModelPrices(ctx, codersdk.UpdateAIBridgeModelPricesRequest{
+		Prices: []codersdk.AIBridgeModelPrice{
+			{Provider: "anthropic", Model: "*", InputPricePerMillionTokens: 3, OutputPricePerMillionTokens: 15},
+			{Provider: "anthropic", Model: "claude-haiku", InputPricePerMillionTokens: 1, OutputPricePerMillionTokens: 5},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+
+	// Given: both users have used AI Bridge in the last hour, and their usage
+	// has been rolled up.
+	anHourAgo := dbtime.Now().Add(-time.Hour).Truncate(time.Hour)
+	for _, usage := range []struct {
+		userID uuid.UUID
+		model  string
+		tokens int64
+	}{
+		{userID: firstUser.UserID, model: "claude-sonnet", tokens: 1_000_000},
+		{userID: member.ID, model: "claude-haiku", tokens: 1_000_000},
+		{userID: member.ID, model: "unpriced", tokens: 10},
+	} {
+		intc := dbgen.AIBridgeInterception(t, db, database.InsertAIBridgeInterceptionParams{
+			InitiatorID: usage.userID,
+			Provider:    "anthropic",
+			Model:       usage.model,
+			StartedAt:   anHourAgo,
+		})
+		dbgen.AIBridgeTokenUsage(t, db, database.InsertAIBridgeTokenUsageParams{
+			InterceptionID: intc.ID,
+			InputTokens:    usage.tokens,
+			OutputTokens:   usage.tokens,
+			CreatedAt:      anHourAgo,
+		})
+	}
+	require.NoError(t, db.UpsertAIBridgeUsageStats(ctx))
+
+	// When: insights are requested by user.
+	insights, err := adminExperimentalClient.AIBridgeInsights(ctx, codersdk.AIBridgeInsightsRequest{
+		StartTime: anHourAgo,
+		EndTime:   anHourAgo.Add(2 * time.Hour),
+		GroupBy:   []codersdk.AIBridgeInsightsGroupBy{codersdk.AIBridgeInsightsGroupByUser},
+	})
+	require.NoError(t, err)
+
+	// Then: each user's usage is priced, most expensive first.
+	require.Len(t, insights.Rows, 2)
+	require.Equal(t, firstUser.UserID, insights.Rows[0].UserID)
+	require.InDelta(t, 18, insights.Rows[0].EstimatedCost, 0.0001)
+	require.Equal(t, member.ID, insights.Rows[1].UserID)
+	require.EqualValues(t, 2, insights.Rows[1].Requests)
+	require.InDelta(t, 6, insights.Rows[1].EstimatedCost, 0.0001)
+	require.EqualValues(t, 20, insights.Rows[1].UnpricedTokens)
+	require.EqualValues(t, 3, insights.Total.Requests)
+	require.InDelta(t, 24, insights.Total.EstimatedCost, 0.0001)
+
+	// Ranges must be on the hour.
+	_, err = adminExperimentalClient.AIBridgeInsights(ctx, codersdk.AIBridgeInsightsRequest{
+		StartTime: anHourAgo.Add(time.Minute),
+		EndTime:   anHourAgo.Add(time.Hour),
+	})
+	requireSDKErrorStatus(t, err, http.StatusBadRequest)
+
+	// Members can neither see insights nor set prices.
+	_, err = memberExperimentalClient.AIBridgeInsights(ctx, codersdk.AIBridgeInsightsRequest{
+		StartTime: anHourAgo,
+		EndTime:   anHourAgo.Add(time.Hour),
+	})
+	requireSDKErrorStatus(t, err, http.StatusForbidden)
+	_, err = memberExperimentalClient.UpdateAIBridgeModelPrices(ctx, codersdk.UpdateAIBridgeModelPricesRequest{})
+	requireSDKErrorStatus(t, err, http.StatusForbidden)
+
+	// Models may only be priced once.
+	_, err = adminExperimentalClient.UpdateAIBridgeModelPrices(ctx, codersdk.UpdateAIBridgeModelPricesRequest{
+		Prices: []codersdk.AIBridgeModelPrice{
+			{Provider: "openai", Model: "gpt-4.1", InputPricePerMillionTokens: 2},
+			{Provider: "openai", Model: "gpt-4.1", InputPricePerMillionTokens: 3},
+		},
+	})
+	requireSDKErrorStatus(t, err, http.StatusBadRequest)
+}
+
+func requireSDKErrorStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var sdkErr *codersdk.Error
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, status, sdkErr.StatusCode())
+}
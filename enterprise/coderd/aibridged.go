@@ -49,7 +49,7 @@ func (api *API) CreateInMemoryAIBridgeServer(dialCtx context.Context) (client ai
 
 	mux := drpcmux.New()
 	srv, err := aibridgedserver.NewServer(api.ctx, api.Database, api.Logger.Named("aibridgedserver"),
-		api.AccessURL.String(), api.ExternalAuthConfigs, api.AGPL.Experiments)
+		api.AccessURL.String(), api.ExternalAuthConfigs, api.AGPL.Experiments, api.DeploymentValues.AI.BridgeConfig.Capture.MaxBodyBytes.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -5,11 +5,17 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	mcpclient "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
 
+	mcpserver "github.com/coder/coder/v2/coderd/mcp"
 	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/codersdk/toolsdk"
 	"github.com/coder/coder/v2/enterprise/coderd/coderdenttest"
 	"github.com/coder/coder/v2/enterprise/coderd/license"
+	"github.com/coder/coder/v2/testutil"
 )
 
 func TestEnterpriseAuditLogs(t *testing.T) {
@@ -103,3 +109,67 @@ func TestEnterpriseAuditLogs(t *testing.T) {
 		require.Equal(t, uuid.Nil, alogs.AuditLogs[0].OrganizationID)
 	})
 }
+
+func TestMCPToolCallAuditLog(t *testing.T) {
+	t.Parallel()
+
+	client, user := coderdenttest.New(t, &coderdenttest.Options{
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureAuditLog: 1,
+			},
+		},
+	})
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	// Given: an API key which may only read the personal details of its user.
+	token, err := client.CreateToken(ctx, codersdk.Me, codersdk.CreateTokenRequest{
+		Scopes: []codersdk.APIKeyScope{codersdk.APIKeyScopeUserReadPersonal},
+	})
+	require.NoError(t, err)
+
+	mcpClient, err := mcpclient.NewStreamableHttpClient(client.URL.String()+mcpserver.MCPEndpoint,
+		transport.WithHTTPHeaders(map[string]string{
+			"Authorization": "Bearer " + token.Key,
+		}))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = mcpClient.Close()
+	})
+	require.NoError(t, mcpClient.Start(ctx))
+	_, err = mcpClient.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: mcp.LATEST_PROTOCOL_VERSION,
+			ClientInfo: mcp.Implementation{
+				Name:    "test-client",
+				Version: "1.0.0",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// When: a tool is called through the MCP server.
+	_, err = mcpClient.CallTool(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      toolsdk.ToolNameGetAuthenticatedUser,
+			Arguments: map[string]any{},
+		},
+	})
+	require.NoError(t, err)
+
+	// Then: the invocation is audited along with the scopes of the API key.
+	alogs, err := client.AuditLogs(ctx, codersdk.AuditLogsRequest{
+		SearchQuery: "resource_type:mcp_tool",
+		Pagination: codersdk.Pagination{
+			Limit: 10,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, alogs.AuditLogs, 1)
+	alog := alogs.AuditLogs[0]
+	require.Equal(t, codersdk.AuditActionInvoke, alog.Action)
+	require.Equal(t, toolsdk.ToolNameGetAuthenticatedUser, alog.ResourceTarget)
+	require.Equal(t, user.UserID, alog.ResourceID)
+	require.Equal(t, "standard", alog.Diff["toolset"].New)
+	require.Equal(t, []any{string(codersdk.APIKeyScopeUserReadPersonal)}, alog.Diff["scopes"].New)
+}
@@ -235,6 +235,16 @@ func New(ctx context.Context, options *Options) (_ *API, err error) {
 			r.Group(func(r chi.Router) {
 				r.Use(apiKeyMiddleware)
 				r.Get("/interceptions", api.aiBridgeListInterceptions)
+				r.Get("/limits", api.aiBridgeListLimits)
+				r.Put("/limits/{scope}/{scope_id}", api.aiBridgeUpsertLimit)
+				r.Delete("/limits/{scope}/{scope_id}", api.aiBridgeDeleteLimit)
+				r.Get("/interceptions/{interception}/capture", api.aiBridgeInterceptionCapture)
+				r.With(httpmw.ExtractUserParam(api.Database)).Get("/users/{user}/usage", api.aiBridgeUserUsage)
+				r.With(httpmw.ExtractUserParam(api.Database)).Get("/users/{user}/capture", api.aiBridgeUserCaptureSettings)
+				r.With(httpmw.ExtractUserParam(api.Database)).Put("/users/{user}/capture", api.aiBridgeUpdateUserCaptureSettings)
+				r.Get("/prices", api.aiBridgeListModelPrices)
+				r.Put("/prices", api.aiBridgeUpdateModelPrices)
+				r.Get("/insights", api.aiBridgeInsights)
 			})
 
 			// This is a bit funky but since aibridge only exposes a HTTP
@@ -268,6 +278,7 @@ func New(ctx context.Context, options *Options) (_ *API, err error) {
 				api.RequireFeatureMW(codersdk.FeatureConnectionLog),
 			)
 			r.Get("/", api.connectionLogs)
+			r.Get("/{connectionlog}/recording", api.connectionLogRecording)
 		})
 		r.Route("/licenses", func(r chi.Router) {
 			r.Use(apiKeyMiddleware)
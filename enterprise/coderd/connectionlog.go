@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"cdr.dev/slog"
 	agpl "github.com/coder/coder/v2/coderd"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/db2sdk"
@@ -83,6 +84,63 @@ func (api *API) connectionLogs(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// @Summary Get connection log session recording
+// @ID get-connection-log-session-recording
+// @Security CoderSessionToken
+// @Produce application/x-asciicast
+// @Tags Enterprise
+// @Param connectionlog path string true "Connection log ID" format(uuid)
+// @Success 200
+// @Router /connectionlog/{connectionlog}/recording [get]
+func (api *API) connectionLogRecording(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	connectionLogID, ok := httpmw.ParseUUIDParam(rw, r, "connectionlog")
+	if !ok {
+		return
+	}
+
+	recording, err := api.Database.GetWorkspaceSessionRecordingByConnectionLogID(ctx, connectionLogID)
+	if httpapi.Is404Error(err) {
+		httpapi.ResourceNotFound(rw)
+		return
+	}
+	if err != nil {
+		httpapi.InternalServerError(rw, err)
+		return
+	}
+
+	rw.Header().Set("Content-Type", codersdk.ContentTypeAsciicast)
+	rw.WriteHeader(http.StatusOK)
+
+	// Stream the recording in pages to avoid loading large recordings into
+	// memory at once.
+	const pageSize = 100
+	afterSeq := int32(-1)
+	for {
+		// nolint:gocritic // Access to the recording was authorized above.
+		chunks, err := api.Database.GetWorkspaceSessionRecordingChunks(dbauthz.AsSystemRestricted(ctx), database.GetWorkspaceSessionRecordingChunksParams{
+			RecordingID: recording.ID,
+			AfterSeq:    afterSeq,
+			LimitCount:  pageSize,
+		})
+		if err != nil {
+			api.Logger.Error(ctx, "fetch session recording chunks", slog.F("recording_id", recording.ID), slog.Error(err))
+			return
+		}
+		for _, chunk := range chunks {
+			_, err = rw.Write(chunk.Data)
+			if err != nil {
+				return
+			}
+			afterSeq = chunk.Seq
+		}
+		if len(chunks) < pageSize {
+			return
+		}
+	}
+}
+
 func convertConnectionLogs(dblogs []database.GetConnectionLogsOffsetRow) []codersdk.ConnectionLog {
 	clogs := make([]codersdk.ConnectionLog, 0, len(dblogs))
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"testing"
 	"time"
 
@@ -253,3 +255,71 @@ func TestConnectionLogs(t *testing.T) {
 		require.Equal(t, updatedClog.DisconnectReason.String, logs.ConnectionLogs[0].SSHInfo.DisconnectReason)
 	})
 }
+
+func TestConnectionLogRecording(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, db, _ := coderdenttest.NewWithDatabase(t, &coderdenttest.Options{
+		ConnectionLogging: true,
+		LicenseOptions: &coderdenttest.LicenseOptions{
+			Features: license.Features{
+				codersdk.FeatureConnectionLog: 1,
+			},
+		},
+	})
+
+	u := dbgen.User(t, db, database.User{})
+	o := dbgen.Organization(t, db, database.Organization{})
+	tpl := dbgen.Template(t, db, database.Template{
+		OrganizationID: o.ID,
+		CreatedBy:      u.ID,
+	})
+	ws := dbgen.Workspace(t, db, database.WorkspaceTable{
+		OwnerID:        u.ID,
+		OrganizationID: o.ID,
+		TemplateID:     tpl.ID,
+	})
+	connectionID := uuid.New()
+	clog := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Type:             database.ConnectionTypeSsh,
+		WorkspaceID:      ws.ID,
+		OrganizationID:   ws.OrganizationID,
+		WorkspaceOwnerID: ws.OwnerID,
+		AgentName:        "dev",
+		ConnectionID:     uuid.NullUUID{UUID: connectionID, Valid: true},
+	})
+	// A connection without a recording.
+	unrecorded := dbgen.ConnectionLog(t, db, database.UpsertConnectionLogParams{
+		Type:             database.ConnectionTypeSsh,
+		WorkspaceID:      ws.ID,
+		OrganizationID:   ws.OrganizationID,
+		WorkspaceOwnerID: ws.OwnerID,
+		AgentName:        "dev",
+		ConnectionID:     uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	})
+
+	chunks := [][]byte{
+		[]byte(`{"version":2,"width":80,"height":24,"timestamp":0}` + "\n"),
+		[]byte(`[0.100000, "o", "hello"]` + "\n"),
+	}
+	_ = dbgen.WorkspaceSessionRecording(t, db, database.UpsertWorkspaceSessionRecordingParams{
+		OrganizationID: ws.OrganizationID,
+		WorkspaceID:    ws.ID,
+		AgentName:      "dev",
+		ConnectionID:   connectionID,
+		Completed:      true,
+	}, chunks...)
+
+	rc, err := client.ConnectionLogRecording(ctx, clog.ID)
+	require.NoError(t, err)
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.Equal(t, string(chunks[0])+string(chunks[1]), string(got))
+
+	_, err = client.ConnectionLogRecording(ctx, unrecorded.ID)
+	var sdkErr *codersdk.Error
+	require.ErrorAs(t, err, &sdkErr)
+	require.Equal(t, http.StatusNotFound, sdkErr.StatusCode())
+}
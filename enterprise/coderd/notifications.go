@@ -42,11 +42,14 @@ func (api *API) updateNotificationTemplateMethod(rw http.ResponseWriter, r *http
 	}
 
 	var nm database.NullNotificationMethod
-	if err := nm.Scan(req.Method); err != nil || !nm.Valid || !nm.NotificationMethod.Valid() {
-		vals := database.AllNotificationMethodValues()
-		acceptable := make([]string, len(vals))
-		for i, v := range vals {
-			acceptable[i] = string(v)
+	// User targets are chosen by each user through their notification preferences, so cannot be set on a template.
+	if err := nm.Scan(req.Method); err != nil || !nm.Valid || !nm.NotificationMethod.Valid() || nm.NotificationMethod == database.NotificationMethodUserTarget {
+		var acceptable []string
+		for _, v := range database.AllNotificationMethodValues() {
+			if v == database.NotificationMethodUserTarget {
+				continue
+			}
+			acceptable = append(acceptable, string(v))
 		}
 
 		httpapi.Write(ctx, rw, http.StatusBadRequest, codersdk.Response{
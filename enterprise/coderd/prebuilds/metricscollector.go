@@ -30,6 +30,15 @@ const (
 	MetricPresetHardLimitedGauge    = namespace + "preset_hard_limited"
 	MetricLastUpdatedGauge          = namespace + "metrics_last_updated"
 	MetricReconciliationPausedGauge = namespace + "reconciliation_paused"
+
+	MetricAutoscalingConfiguredGauge    = namespace + "autoscaling_configured"
+	MetricAutoscalingClaimRateGauge     = namespace + "autoscaling_claim_rate"
+	MetricAutoscalingClaimMissesGauge   = namespace + "autoscaling_claim_misses"
+	MetricAutoscalingReplenishTimeGauge = namespace + "autoscaling_replenish_seconds"
+
+	MetricClaimHitRateGauge         = namespace + "claim_hit_rate"
+	MetricClaimHitTimeToReadyGauge  = namespace + "claim_hit_time_to_ready_seconds"
+	MetricClaimMissTimeToReadyGauge = namespace + "claim_miss_time_to_ready_seconds"
 )
 
 var (
@@ -96,6 +105,55 @@ var (
 		[]string{},
 		nil,
 	)
+	autoscalingConfiguredDesc = prometheus.NewDesc(
+		MetricAutoscalingConfiguredGauge,
+		"Number of prebuilt workspaces configured for each template preset, which is the lower bound of the "+
+			"autoscaled target in coderd_prebuilt_workspaces_desired. Only reported when autoscaling is enabled.",
+		labels,
+		nil,
+	)
+	autoscalingClaimRateDesc = prometheus.NewDesc(
+		MetricAutoscalingClaimRateGauge,
+		"Rate of attempts per second to claim a prebuilt workspace of each template preset within the autoscaling "+
+			"window. Only reported when autoscaling is enabled.",
+		labels,
+		nil,
+	)
+	autoscalingClaimMissesDesc = prometheus.NewDesc(
+		MetricAutoscalingClaimMissesGauge,
+		"Number of attempts to claim a prebuilt workspace of each template preset within the autoscaling window "+
+			"which found none eligible. Only reported when autoscaling is enabled.",
+		labels,
+		nil,
+	)
+	autoscalingReplenishTimeDesc = prometheus.NewDesc(
+		MetricAutoscalingReplenishTimeGauge,
+		"Expected time in seconds to replace a claimed prebuilt workspace of each template preset, used to size "+
+			"the autoscaled target. Only reported when autoscaling is enabled.",
+		labels,
+		nil,
+	)
+	claimHitRateDesc = prometheus.NewDesc(
+		MetricClaimHitRateGauge,
+		"Fraction of the workspaces created with each template preset over the last 24 hours which were served "+
+			"from a prebuilt workspace.",
+		labels,
+		nil,
+	)
+	claimHitTimeToReadyDesc = prometheus.NewDesc(
+		MetricClaimHitTimeToReadyGauge,
+		"Median time in seconds until the agents of workspaces created with each template preset over the last "+
+			"24 hours were ready, for workspaces served from a prebuilt workspace.",
+		labels,
+		nil,
+	)
+	claimMissTimeToReadyDesc = prometheus.NewDesc(
+		MetricClaimMissTimeToReadyGauge,
+		"Median time in seconds until the agents of workspaces created with each template preset over the last "+
+			"24 hours were ready, for workspaces built because no prebuilt workspace was available.",
+		labels,
+		nil,
+	)
 	reconciliationPausedDesc = prometheus.NewDesc(
 		MetricReconciliationPausedGauge,
 		"Indicates whether prebuilds reconciliation is currently paused (1 = paused, 0 = not paused).",
@@ -107,6 +165,9 @@ var (
 const (
 	metricsUpdateInterval = time.Second * 60
 	metricsUpdateTimeout  = time.Second * 10
+
+	// claimInsightsWindow is how far back claims are reported on.
+	claimInsightsWindow = 24 * time.Hour
 )
 
 type MetricsCollector struct {
@@ -149,6 +210,13 @@ func (*MetricsCollector) Describe(descCh chan<- *prometheus.Desc) {
 	descCh <- runningPrebuildsDesc
 	descCh <- eligiblePrebuildsDesc
 	descCh <- presetHardLimitedDesc
+	descCh <- autoscalingConfiguredDesc
+	descCh <- autoscalingClaimRateDesc
+	descCh <- autoscalingClaimMissesDesc
+	descCh <- autoscalingReplenishTimeDesc
+	descCh <- claimHitRateDesc
+	descCh <- claimHitTimeToReadyDesc
+	descCh <- claimMissTimeToReadyDesc
 	descCh <- lastUpdateDesc
 	descCh <- reconciliationPausedDesc
 }
@@ -204,6 +272,25 @@ func (mc *MetricsCollector) Collect(metricsCh chan<- prometheus.Metric) {
 		metricsCh <- prometheus.MustNewConstMetric(desiredPrebuildsDesc, prometheus.GaugeValue, float64(state.Desired), preset.TemplateName, preset.Name, preset.OrganizationName)
 		metricsCh <- prometheus.MustNewConstMetric(runningPrebuildsDesc, prometheus.GaugeValue, float64(state.Actual), preset.TemplateName, preset.Name, preset.OrganizationName)
 		metricsCh <- prometheus.MustNewConstMetric(eligiblePrebuildsDesc, prometheus.GaugeValue, float64(state.Eligible), preset.TemplateName, preset.Name, preset.OrganizationName)
+
+		if presetSnapshot.Autoscaling.Enabled() {
+			autoscaling := presetSnapshot.CalculateAutoscaling()
+			metricsCh <- prometheus.MustNewConstMetric(autoscalingConfiguredDesc, prometheus.GaugeValue, float64(autoscaling.Configured), preset.TemplateName, preset.Name, preset.OrganizationName)
+			metricsCh <- prometheus.MustNewConstMetric(autoscalingClaimRateDesc, prometheus.GaugeValue, autoscaling.ClaimRate, preset.TemplateName, preset.Name, preset.OrganizationName)
+			metricsCh <- prometheus.MustNewConstMetric(autoscalingClaimMissesDesc, prometheus.GaugeValue, float64(autoscaling.ClaimMisses), preset.TemplateName, preset.Name, preset.OrganizationName)
+			metricsCh <- prometheus.MustNewConstMetric(autoscalingReplenishTimeDesc, prometheus.GaugeValue, autoscaling.ReplenishTime.Seconds(), preset.TemplateName, preset.Name, preset.OrganizationName)
+		}
+	}
+
+	for _, insights := range currentState.claimInsights {
+		metricsCh <- prometheus.MustNewConstMetric(claimHitRateDesc, prometheus.GaugeValue, float64(insights.ClaimHits)/float64(insights.ClaimAttempts), insights.TemplateName, insights.PresetName, insights.OrganizationName)
+		// Percentiles are negative when there was no workspace to measure.
+		if insights.HitTimeToReadySecs50 >= 0 {
+			metricsCh <- prometheus.MustNewConstMetric(claimHitTimeToReadyDesc, prometheus.GaugeValue, insights.HitTimeToReadySecs50, insights.TemplateName, insights.PresetName, insights.OrganizationName)
+		}
+		if insights.MissTimeToReadySecs50 >= 0 {
+			metricsCh <- prometheus.MustNewConstMetric(claimMissTimeToReadyDesc, prometheus.GaugeValue, insights.MissTimeToReadySecs50, insights.TemplateName, insights.PresetName, insights.OrganizationName)
+		}
 	}
 
 	mc.isPresetHardLimitedMu.Lock()
@@ -222,6 +309,7 @@ func (mc *MetricsCollector) Collect(metricsCh chan<- prometheus.Metric) {
 
 type metricsState struct {
 	prebuildMetrics []database.GetPrebuildMetricsRow
+	claimInsights   []database.GetPrebuildInsightsRow
 	snapshot        *prebuilds.GlobalSnapshot
 	createdAt       time.Time
 }
@@ -252,19 +340,34 @@ func (mc *MetricsCollector) UpdateState(ctx context.Context, timeout time.Durati
 	fetchCtx, fetchCancel := context.WithTimeout(ctx, timeout)
 	defer fetchCancel()
 
-	prebuildMetrics, err := mc.database.GetPrebuildMetrics(fetchCtx)
+	snapshot, err := mc.snapshotter.SnapshotState(fetchCtx, mc.database)
+	if err != nil {
+		return xerrors.Errorf("snapshot state: %w", err)
+	}
+
+	// Report the same demand from which the reconciler sizes prebuild pools.
+	now := dbtime.Now()
+	autoscalingSince := now
+	if snapshot.Autoscaling.Enabled() {
+		autoscalingSince = now.Add(-snapshot.Autoscaling.Window)
+	}
+	prebuildMetrics, err := mc.database.GetPrebuildMetrics(fetchCtx, autoscalingSince)
 	if err != nil {
 		return xerrors.Errorf("fetch prebuild metrics: %w", err)
 	}
 
-	snapshot, err := mc.snapshotter.SnapshotState(fetchCtx, mc.database)
+	claimInsights, err := mc.database.GetPrebuildInsights(fetchCtx, database.GetPrebuildInsightsParams{
+		StartTime: now.Add(-claimInsightsWindow),
+		EndTime:   now,
+	})
 	if err != nil {
-		return xerrors.Errorf("snapshot state: %w", err)
+		return xerrors.Errorf("fetch prebuild claim insights: %w", err)
 	}
 	mc.logger.Debug(ctx, "fetched prebuilds metrics state", slog.F("duration_secs", fmt.Sprintf("%.2f", time.Since(start).Seconds())))
 
 	mc.latestState.Store(&metricsState{
 		prebuildMetrics: prebuildMetrics,
+		claimInsights:   claimInsights,
 		snapshot:        snapshot,
 		createdAt:       dbtime.Now(),
 	})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,7 @@ import (
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/enterprise/coderd/prebuilds"
 	"github.com/coder/coder/v2/testutil"
+	"github.com/coder/serpent"
 )
 
 func TestMetricsCollector(t *testing.T) {
@@ -568,3 +570,151 @@ func TestMetricsCollector_ReconciliationPausedMetric(t *testing.T) {
 		require.Equal(t, 0.0, metric.GetGauge().GetValue(), "reconciliation should not be paused")
 	})
 }
+
+func TestMetricsCollector_Autoscaling(t *testing.T) {
+	t.Parallel()
+
+	if !dbtestutil.WillUsePostgres() {
+		t.Skip("this test requires postgres")
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	clock := quartz.NewMock(t)
+	db, pubsub := dbtestutil.NewDB(t)
+	cache := files.New(prometheus.NewRegistry(), &coderdtest.FakeAuthorizer{})
+	cfg := codersdk.PrebuildsConfig{
+		ReconciliationInterval:          serpent.Duration(time.Minute),
+		AutoscalingWindow:               serpent.Duration(24 * time.Hour),
+		AutoscalingTargetHitProbability: serpent.Float64(0.95),
+		AutoscalingMaxInstances:         serpent.Int64(10),
+	}
+	reconciler := prebuilds.NewStoreReconciler(db, pubsub, cache, cfg, logger, clock, prometheus.NewRegistry(), newNoopEnqueuer(), newNoopUsageCheckerPtr())
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	collector := prebuilds.NewMetricsCollector(db, logger, reconciler)
+	registry := prometheus.NewPedanticRegistry()
+	registry.Register(collector)
+
+	// Given: a preset with one prebuilt workspace desired, which took an hour to build.
+	org := dbgen.Organization(t, db, database.Organization{})
+	template := setupTestDBTemplateWithinOrg(t, db, database.PrebuildsSystemUserID, false, "default-template", org)
+	templateVersionID := setupTestDBTemplateVersion(ctx, t, clock, db, pubsub, org.ID, database.PrebuildsSystemUserID, template.ID)
+	preset := setupTestDBPreset(t, db, templateVersionID, 1, "default-preset")
+	workspace, _ := setupTestDBWorkspace(
+		t, clock, db, pubsub,
+		database.WorkspaceTransitionStart, database.ProvisionerJobStatusSucceeded, org.ID, preset, template.ID, templateVersionID,
+		database.PrebuildsSystemUserID, database.PrebuildsSystemUserID,
+	)
+	setupTestDBWorkspaceAgent(t, db, workspace.ID, true)
+
+	// Given: three claims of its prebuilt workspaces within the autoscaling window, one of which found none.
+	for i := range 3 {
+		require.NoError(t, db.InsertPrebuildClaim(ctx, database.InsertPrebuildClaimParams{
+			ID:          uuid.New(),
+			PresetID:    preset.ID,
+			WorkspaceID: uuid.NullUUID{UUID: workspace.ID, Valid: true},
+			Hit:         i > 0,
+			CreatedAt:   clock.Now().Add(-time.Hour),
+		}))
+	}
+
+	require.NoError(t, collector.UpdateState(dbauthz.AsPrebuildsOrchestrator(ctx), testutil.WaitLong))
+	metricsFamilies, err := registry.Gather()
+	require.NoError(t, err)
+
+	// Then: 3 claims a day, with prebuilt workspaces taking an hour and a minute to replace, is an
+	// expected 0.127 claims during a replacement. P(N = 0) = 0.881, P(N <= 1) = 0.993, so 2 prebuilt
+	// workspaces are desired.
+	series := findAllMetricSeries(metricsFamilies, map[string]string{
+		"template_name":     template.Name,
+		"preset_name":       preset.Name,
+		"organization_name": org.Name,
+	})
+	for name, expected := range map[string]float64{
+		prebuilds.MetricDesiredGauge:                  2,
+		prebuilds.MetricAutoscalingConfiguredGauge:    1,
+		prebuilds.MetricAutoscalingClaimRateGauge:     3.0 / (24 * 60 * 60),
+		prebuilds.MetricAutoscalingClaimMissesGauge:   1,
+		prebuilds.MetricAutoscalingReplenishTimeGauge: (time.Hour + time.Minute).Seconds(),
+	} {
+		metric, ok := series[name]
+		require.True(t, ok, "metric %s should exist", name)
+		require.InDelta(t, expected, metric.GetGauge().GetValue(), 1e-9, "metric %s", name)
+	}
+}
+
+func TestMetricsCollector_ClaimInsights(t *testing.T) {
+	t.Parallel()
+
+	if !dbtestutil.WillUsePostgres() {
+		t.Skip("this test requires postgres")
+	}
+
+	logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+	clock := quartz.NewMock(t)
+	db, pubsub := dbtestutil.NewDB(t)
+	cache := files.New(prometheus.NewRegistry(), &coderdtest.FakeAuthorizer{})
+	reconciler := prebuilds.NewStoreReconciler(db, pubsub, cache, codersdk.PrebuildsConfig{}, logger, clock, prometheus.NewRegistry(), newNoopEnqueuer(), newNoopUsageCheckerPtr())
+	ctx := testutil.Context(t, testutil.WaitLong)
+
+	collector := prebuilds.NewMetricsCollector(db, logger, reconciler)
+	registry := prometheus.NewPedanticRegistry()
+	registry.Register(collector)
+
+	// Given: a preset with a claimed prebuilt workspace, and a workspace built from scratch.
+	org := dbgen.Organization(t, db, database.Organization{})
+	template := setupTestDBTemplateWithinOrg(t, db, database.PrebuildsSystemUserID, false, "default-template", org)
+	templateVersionID := setupTestDBTemplateVersion(ctx, t, clock, db, pubsub, org.ID, database.PrebuildsSystemUserID, template.ID)
+	preset := setupTestDBPreset(t, db, templateVersionID, 1, "default-preset")
+	readyAt := make(map[bool]time.Time, 2)
+	buildIDs := make(map[bool]uuid.UUID, 2)
+	for _, hit := range []bool{true, false} {
+		workspace, build := setupTestDBWorkspace(
+			t, clock, db, pubsub,
+			database.WorkspaceTransitionStart, database.ProvisionerJobStatusSucceeded, org.ID, preset, template.ID, templateVersionID,
+			database.PrebuildsSystemUserID, database.PrebuildsSystemUserID,
+		)
+		agent := setupTestDBWorkspaceAgent(t, db, workspace.ID, true)
+		agent, err := db.GetWorkspaceAgentByID(ctx, agent.ID)
+		require.NoError(t, err)
+		readyAt[hit] = agent.ReadyAt.Time
+		buildIDs[hit] = build.ID
+	}
+
+	// Given: two claims which were served from the prebuilt workspace 30 seconds before it was ready,
+	// and one which missed and waited 10 minutes for its workspace to be built.
+	for i, hit := range []bool{true, true, false} {
+		timeToReady := 30 * time.Second
+		if !hit {
+			timeToReady = 10 * time.Minute
+		}
+		require.NoError(t, db.InsertPrebuildClaim(ctx, database.InsertPrebuildClaimParams{
+			ID:             uuid.New(),
+			PresetID:       preset.ID,
+			BuildID:        uuid.NullUUID{UUID: buildIDs[hit], Valid: true},
+			Hit:            hit,
+			ClaimLatencyMs: int32(100 * (i + 1)),
+			CreatedAt:      readyAt[hit].Add(-timeToReady),
+		}))
+	}
+
+	require.NoError(t, collector.UpdateState(dbauthz.AsPrebuildsOrchestrator(ctx), testutil.WaitLong))
+	metricsFamilies, err := registry.Gather()
+	require.NoError(t, err)
+
+	// Then: the hit rate and median time to ready of hits and misses are reported.
+	series := findAllMetricSeries(metricsFamilies, map[string]string{
+		"template_name":     template.Name,
+		"preset_name":       preset.Name,
+		"organization_name": org.Name,
+	})
+	for name, expected := range map[string]float64{
+		prebuilds.MetricClaimHitRateGauge:         2.0 / 3.0,
+		prebuilds.MetricClaimHitTimeToReadyGauge:  30,
+		prebuilds.MetricClaimMissTimeToReadyGauge: 600,
+	} {
+		metric, ok := series[name]
+		require.True(t, ok, "metric %s should exist", name)
+		require.InDelta(t, expected, metric.GetGauge().GetValue(), 1e-3, "metric %s", name)
+	}
+}
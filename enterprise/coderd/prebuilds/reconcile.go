@@ -422,6 +422,15 @@ func (c *StoreReconciler) SnapshotState(ctx context.Context, store database.Stor
 			return xerrors.Errorf("failed to get hard limited presets: %w", err)
 		}
 
+		autoscaling := prebuilds.NewAutoscalingConfig(c.cfg)
+		var claimDemand []database.GetPrebuildClaimDemandRow
+		if autoscaling.Enabled() {
+			claimDemand, err = db.GetPrebuildClaimDemand(ctx, c.clock.Now().Add(-autoscaling.Window))
+			if err != nil {
+				return xerrors.Errorf("failed to get prebuild claim demand: %w", err)
+			}
+		}
+
 		state = prebuilds.NewGlobalSnapshot(
 			presetsWithPrebuilds,
 			presetPrebuildSchedules,
@@ -429,6 +438,8 @@ func (c *StoreReconciler) SnapshotState(ctx context.Context, store database.Stor
 			allPrebuildsInProgress,
 			presetsBackoff,
 			hardLimitedPresets,
+			claimDemand,
+			autoscaling,
 			c.clock,
 			c.logger,
 		)
@@ -598,7 +609,7 @@ func (c *StoreReconciler) executeReconciliationAction(ctx context.Context, logge
 		// Unexpected things happen (i.e. bugs or bitflips); let's defend against disastrous outcomes.
 		// See https://blog.robertelder.org/causes-of-bit-flips-in-computer-memory/.
 		// This is obviously not comprehensive protection against this sort of problem, but this is one essential check.
-		desired := ps.CalculateDesiredInstances(c.clock.Now())
+		desired := ps.CalculateAutoscaling().Desired
 
 		if action.Create > desired {
 			logger.Critical(ctx, "determined excessive count of prebuilds to create; clamping to desired count",
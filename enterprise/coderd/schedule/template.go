@@ -238,6 +238,20 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 			return database.Template{}, xerrors.Errorf("get workspaces by template id: %w", err)
 		}
 
+		ids := make([]uuid.UUID, 0, len(workspaces))
+		for _, workspace := range workspaces {
+			ids = append(ids, workspace.ID)
+		}
+		//nolint:gocritic // We need to be able to read the schedules of all workspaces.
+		additionalWindows, err := db.GetWorkspaceScheduleWindowsByWorkspaceIDs(dbauthz.AsSystemRestricted(ctx), ids)
+		if err != nil {
+			return database.Template{}, xerrors.Errorf("get workspace schedule windows: %w", err)
+		}
+		windowsByWorkspace := make(map[uuid.UUID][]database.WorkspaceScheduleWindow)
+		for _, w := range additionalWindows {
+			windowsByWorkspace[w.WorkspaceID] = append(windowsByWorkspace[w.WorkspaceID], w)
+		}
+
 		workspaceIDs := []uuid.UUID{}
 		nextStartAts := []time.Time{}
 
@@ -247,8 +261,16 @@ func (s *EnterpriseTemplateScheduleStore) Set(ctx context.Context, db database.S
 				continue
 			}
 			nextStartAt := time.Time{}
-			if workspace.AutostartSchedule.Valid {
-				next, err := agpl.NextAllowedAutostart(s.now(), workspace.AutostartSchedule.String, templateSchedule)
+			windows := agpl.WorkspaceWindows(workspace, windowsByWorkspace[workspace.ID])
+			if windows.HasAutostart() {
+				// Holidays and shutdowns from the organization's exception
+				// calendars are skipped by autostart.
+				//nolint:gocritic // We need to be able to read the exceptions of all workspaces.
+				templateSchedule.AutostartExceptions, err = agpl.GetAutostartExceptions(dbauthz.AsSystemRestricted(ctx), db, workspace.OrganizationID, workspace.ID)
+				if err != nil {
+					return database.Template{}, xerrors.Errorf("get autostart exceptions: %w", err)
+				}
+				next, _, err := windows.NextAllowedAutostart(s.now(), templateSchedule)
 				if err == nil {
 					nextStartAt = dbtime.Time(next.UTC())
 				}
@@ -368,6 +390,16 @@ func (s *EnterpriseTemplateScheduleStore) updateWorkspaceBuild(ctx context.Conte
 	//
 	// This also matches the behavior of updating a workspace's TTL, where we
 	// don't apply the changes until the workspace is rebuilt.
+	windows, err := agpl.GetWorkspaceWindows(ctx, db, workspace.WorkspaceTable())
+	if err != nil {
+		return xerrors.Errorf("get schedule windows for workspace %q: %w", workspace.ID, err)
+	}
+	window := 0
+	if build.Reason == database.BuildReasonAutostart {
+		if started, ok := windows.StartedBy(build.CreatedAt); ok {
+			window = started
+		}
+	}
 	autostop, err := agpl.CalculateAutostop(ctx, agpl.CalculateAutostopParams{
 		Database:                    db,
 		TemplateScheduleStore:       s,
@@ -375,18 +407,20 @@ func (s *EnterpriseTemplateScheduleStore) updateWorkspaceBuild(ctx context.Conte
 		WorkspaceBuildCompletedAt:   job.CompletedAt.Time,
 		Workspace:                   workspace.WorkspaceTable(),
 		WorkspaceAutostart:          workspace.AutostartSchedule.String,
+		Windows:                     windows,
+		Window:                      window,
 	})
 	if err != nil {
 		return xerrors.Errorf("calculate new autostop for workspace %q: %w", workspace.ID, err)
 	}
 
-	if workspace.AutostartSchedule.Valid {
+	if windows.HasAutostart() {
 		templateScheduleOptions, err := s.Get(ctx, db, workspace.TemplateID)
 		if err != nil {
 			return xerrors.Errorf("get template schedule options: %w", err)
 		}
 
-		nextStartAt, _ := agpl.NextAutostart(s.now(), workspace.AutostartSchedule.String, templateScheduleOptions)
+		nextStartAt, _, _ := windows.NextAutostart(s.now(), templateScheduleOptions)
 
 		err = db.UpdateWorkspaceNextStartAt(ctx, database.UpdateWorkspaceNextStartAtParams{
 			ID:          workspace.ID,
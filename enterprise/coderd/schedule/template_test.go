@@ -1235,3 +1235,69 @@ func must[V any](v V, err error) V {
 	}
 	return v
 }
+
+func TestTemplateUpdateNextStartAtExceptions(t *testing.T) {
+	t.Parallel()
+
+	// Monday 2 June 2025, at noon.
+	now := time.Date(2025, time.June, 2, 12, 0, 0, 0, time.UTC)
+	clock := quartz.NewMock(t)
+	clock.Set(now)
+
+	var (
+		logger = slogtest.Make(t, &slogtest.Options{IgnoreErrors: true}).Leveled(slog.LevelDebug)
+		db, _  = dbtestutil.NewDB(t)
+		ctx    = testutil.Context(t, testutil.WaitLong)
+		user   = dbgen.User(t, db, database.User{})
+	)
+
+	const userQuietHoursSchedule = "CRON_TZ=UTC 0 0 * * *" // midnight UTC
+	userQuietHoursStore, err := schedule.NewEnterpriseUserQuietHoursScheduleStore(userQuietHoursSchedule, true)
+	require.NoError(t, err)
+	userQuietHoursStorePtr := &atomic.Pointer[agplschedule.UserQuietHoursScheduleStore]{}
+	userQuietHoursStorePtr.Store(&userQuietHoursStore)
+	templateScheduleStore := schedule.NewEnterpriseTemplateScheduleStore(userQuietHoursStorePtr, notifications.NewNoopEnqueuer(), logger, clock)
+
+	// Given: a workspace which autostarts every day at 9am.
+	org := dbfake.Organization(t, db).Do()
+	tv := dbfake.TemplateVersion(t, db).Seed(database.TemplateVersion{
+		OrganizationID: org.Org.ID,
+		CreatedBy:      user.ID,
+	}).Do()
+	workspace := dbgen.Workspace(t, db, database.WorkspaceTable{
+		OwnerID:           user.ID,
+		TemplateID:        tv.Template.ID,
+		OrganizationID:    org.Org.ID,
+		AutostartSchedule: sql.NullString{String: "CRON_TZ=UTC 0 9 * * *", Valid: true},
+	})
+
+	// Given: Tuesday is a holiday in the organization's exception calendar.
+	exceptions, err := json.Marshal(agplschedule.AutostartExceptions{
+		{Name: "Holiday", StartDate: "2025-06-03", EndDate: "2025-06-03"},
+	})
+	require.NoError(t, err)
+	_, err = db.UpsertAutostartExceptionCalendar(ctx, database.UpsertAutostartExceptionCalendarParams{
+		ID:             uuid.New(),
+		OrganizationID: org.Org.ID,
+		Name:           "holidays",
+		Exceptions:     exceptions,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	require.NoError(t, err)
+
+	// When: the template only allows autostart on weekdays.
+	_, err = templateScheduleStore.Set(ctx, db, tv.Template, agplschedule.TemplateScheduleOptions{
+		UserAutostartEnabled: true,
+		AutostartRequirement: agplschedule.TemplateAutostartRequirement{
+			DaysOfWeek: 0b00011111,
+		},
+	})
+	require.NoError(t, err)
+
+	// Then: the next start skips the holiday.
+	updated, err := db.GetWorkspaceByID(ctx, workspace.ID)
+	require.NoError(t, err)
+	require.True(t, updated.NextStartAt.Valid)
+	require.Equal(t, time.Date(2025, time.June, 4, 9, 0, 0, 0, time.UTC), updated.NextStartAt.Time.UTC())
+}
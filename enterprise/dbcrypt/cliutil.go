@@ -73,6 +73,25 @@ func Rotate(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciphe
 					return xerrors.Errorf("update external auth link user_id=%s provider_id=%s: %w", externalAuthLink.UserID, externalAuthLink.ProviderID, err)
 				}
 			}
+
+			notificationTargets, err := cryptTx.GetNotificationUserTargetsByUserID(ctx, uid)
+			if err != nil {
+				return xerrors.Errorf("get notification targets for user: %w", err)
+			}
+			for _, target := range notificationTargets {
+				if target.SecretKeyID.String == ciphers[0].HexDigest() {
+					log.Debug(ctx, "skipping notification target", slog.F("user_id", uid), slog.F("current", idx+1), slog.F("cipher", ciphers[0].HexDigest()))
+					continue
+				}
+				if _, err := cryptTx.UpdateNotificationUserTargetSecret(ctx, database.UpdateNotificationUserTargetSecretParams{
+					ID:          target.ID,
+					Secret:      target.Secret,
+					SecretKeyID: sql.NullString{}, // dbcrypt will update as required
+					UpdatedAt:   target.UpdatedAt,
+				}); err != nil {
+					return xerrors.Errorf("update notification target user_id=%s target_id=%s: %w", target.UserID, target.ID, err)
+				}
+			}
 			return nil
 		}, &database.TxOptions{
 			Isolation: sql.LevelRepeatableRead,
@@ -83,6 +102,64 @@ func Rotate(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciphe
 		log.Debug(ctx, "encrypted user tokens", slog.F("user_id", uid), slog.F("current", idx+1), slog.F("cipher", ciphers[0].HexDigest()))
 	}
 
+	err = cryptDB.InTx(func(cryptTx database.Store) error {
+		webhookSecrets, err := cryptTx.GetNotificationWebhookSecrets(ctx)
+		if err != nil {
+			return xerrors.Errorf("get notification webhook secrets: %w", err)
+		}
+		for _, secret := range webhookSecrets {
+			if secret.SecretKeyID.String == ciphers[0].HexDigest() {
+				log.Debug(ctx, "skipping notification webhook secret", slog.F("secret_id", secret.ID), slog.F("cipher", ciphers[0].HexDigest()))
+				continue
+			}
+			if err := cryptTx.UpdateNotificationWebhookSecret(ctx, database.UpdateNotificationWebhookSecretParams{
+				ID:          secret.ID,
+				Secret:      secret.Secret,
+				SecretKeyID: sql.NullString{}, // dbcrypt will update as required
+			}); err != nil {
+				return xerrors.Errorf("update notification webhook secret id=%s: %w", secret.ID, err)
+			}
+		}
+		return nil
+	}, &database.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return xerrors.Errorf("update notification webhook secrets: %w", err)
+	}
+	log.Debug(ctx, "encrypted notification webhook secrets", slog.F("cipher", ciphers[0].HexDigest()))
+
+	captureIDs, err := db.GetAIBridgeInterceptionCaptureIDs(ctx)
+	if err != nil {
+		return xerrors.Errorf("get aibridge interception captures: %w", err)
+	}
+	log.Info(ctx, "encrypting aibridge interception captures", slog.F("capture_count", len(captureIDs)))
+	for idx, id := range captureIDs {
+		err := cryptDB.InTx(func(cryptTx database.Store) error {
+			capture, err := cryptTx.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, id)
+			if err != nil {
+				return xerrors.Errorf("get aibridge interception capture: %w", err)
+			}
+			if capture.RequestBodyKeyID.String == ciphers[0].HexDigest() && capture.ResponseBodyKeyID.String == ciphers[0].HexDigest() {
+				log.Debug(ctx, "skipping aibridge interception capture", slog.F("interception_id", id), slog.F("current", idx+1), slog.F("cipher", ciphers[0].HexDigest()))
+				return nil
+			}
+			return cryptTx.UpdateAIBridgeInterceptionCaptureBodies(ctx, database.UpdateAIBridgeInterceptionCaptureBodiesParams{
+				InterceptionID:    id,
+				RequestBody:       capture.RequestBody,
+				RequestBodyKeyID:  sql.NullString{}, // dbcrypt will update as required
+				ResponseBody:      capture.ResponseBody,
+				ResponseBodyKeyID: sql.NullString{}, // dbcrypt will update as required
+			})
+		}, &database.TxOptions{
+			Isolation: sql.LevelRepeatableRead,
+		})
+		if err != nil {
+			return xerrors.Errorf("update aibridge interception capture interception_id=%s: %w", id, err)
+		}
+		log.Debug(ctx, "encrypted aibridge interception capture", slog.F("interception_id", id), slog.F("current", idx+1), slog.F("cipher", ciphers[0].HexDigest()))
+	}
+
 	// Revoke old keys
 	for _, c := range ciphers[1:] {
 		if err := db.RevokeDBCryptKey(ctx, c.HexDigest()); err != nil {
@@ -163,6 +240,25 @@ func Decrypt(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciph
 					return xerrors.Errorf("update external auth link user_id=%s provider_id=%s: %w", externalAuthLink.UserID, externalAuthLink.ProviderID, err)
 				}
 			}
+
+			notificationTargets, err := tx.GetNotificationUserTargetsByUserID(ctx, uid)
+			if err != nil {
+				return xerrors.Errorf("get notification targets for user: %w", err)
+			}
+			for _, target := range notificationTargets {
+				if !target.SecretKeyID.Valid {
+					log.Debug(ctx, "skipping notification target", slog.F("user_id", uid), slog.F("current", idx+1))
+					continue
+				}
+				if _, err := tx.UpdateNotificationUserTargetSecret(ctx, database.UpdateNotificationUserTargetSecretParams{
+					ID:          target.ID,
+					Secret:      target.Secret,
+					SecretKeyID: sql.NullString{}, // we explicitly want to clear the key id
+					UpdatedAt:   target.UpdatedAt,
+				}); err != nil {
+					return xerrors.Errorf("update notification target user_id=%s target_id=%s: %w", target.UserID, target.ID, err)
+				}
+			}
 			return nil
 		}, &database.TxOptions{
 			Isolation: sql.LevelRepeatableRead,
@@ -173,6 +269,64 @@ func Decrypt(ctx context.Context, log slog.Logger, sqlDB *sql.DB, ciphers []Ciph
 		log.Debug(ctx, "decrypted user tokens", slog.F("user_id", uid), slog.F("current", idx+1), slog.F("cipher", ciphers[0].HexDigest()))
 	}
 
+	err = cryptDB.InTx(func(tx database.Store) error {
+		webhookSecrets, err := tx.GetNotificationWebhookSecrets(ctx)
+		if err != nil {
+			return xerrors.Errorf("get notification webhook secrets: %w", err)
+		}
+		for _, secret := range webhookSecrets {
+			if !secret.SecretKeyID.Valid {
+				log.Debug(ctx, "skipping notification webhook secret", slog.F("secret_id", secret.ID))
+				continue
+			}
+			if err := tx.UpdateNotificationWebhookSecret(ctx, database.UpdateNotificationWebhookSecretParams{
+				ID:          secret.ID,
+				Secret:      secret.Secret,
+				SecretKeyID: sql.NullString{}, // we explicitly want to clear the key id
+			}); err != nil {
+				return xerrors.Errorf("update notification webhook secret id=%s: %w", secret.ID, err)
+			}
+		}
+		return nil
+	}, &database.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return xerrors.Errorf("update notification webhook secrets: %w", err)
+	}
+	log.Debug(ctx, "decrypted notification webhook secrets")
+
+	captureIDs, err := db.GetAIBridgeInterceptionCaptureIDs(ctx)
+	if err != nil {
+		return xerrors.Errorf("get aibridge interception captures: %w", err)
+	}
+	log.Info(ctx, "decrypting aibridge interception captures", slog.F("capture_count", len(captureIDs)))
+	for idx, id := range captureIDs {
+		err := cryptDB.InTx(func(tx database.Store) error {
+			capture, err := tx.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, id)
+			if err != nil {
+				return xerrors.Errorf("get aibridge interception capture: %w", err)
+			}
+			if !capture.RequestBodyKeyID.Valid && !capture.ResponseBodyKeyID.Valid {
+				log.Debug(ctx, "skipping aibridge interception capture", slog.F("interception_id", id), slog.F("current", idx+1))
+				return nil
+			}
+			return tx.UpdateAIBridgeInterceptionCaptureBodies(ctx, database.UpdateAIBridgeInterceptionCaptureBodiesParams{
+				InterceptionID:    id,
+				RequestBody:       capture.RequestBody,
+				RequestBodyKeyID:  sql.NullString{}, // we explicitly want to clear the key id
+				ResponseBody:      capture.ResponseBody,
+				ResponseBodyKeyID: sql.NullString{}, // we explicitly want to clear the key id
+			})
+		}, &database.TxOptions{
+			Isolation: sql.LevelRepeatableRead,
+		})
+		if err != nil {
+			return xerrors.Errorf("update aibridge interception capture interception_id=%s: %w", id, err)
+		}
+		log.Debug(ctx, "decrypted aibridge interception capture", slog.F("interception_id", id), slog.F("current", idx+1))
+	}
+
 	// Revoke _all_ keys
 	for _, c := range ciphers {
 		if err := db.RevokeDBCryptKey(ctx, c.HexDigest()); err != nil {
@@ -193,6 +347,13 @@ DELETE FROM user_links
 DELETE FROM external_auth_links
 	WHERE oauth_access_token_key_id IS NOT NULL
 	OR oauth_refresh_token_key_id IS NOT NULL;
+DELETE FROM notification_user_targets
+	WHERE secret_key_id IS NOT NULL;
+DELETE FROM notification_webhook_secrets
+	WHERE secret_key_id IS NOT NULL;
+DELETE FROM aibridge_interception_captures
+	WHERE request_body_key_id IS NOT NULL
+	OR response_body_key_id IS NOT NULL;
 COMMIT;
 `
 
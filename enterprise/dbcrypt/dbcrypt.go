@@ -276,6 +276,103 @@ func (db *dbCrypt) UpdateExternalAuthLinkRefreshToken(ctx context.Context, param
 	return db.Store.UpdateExternalAuthLinkRefreshToken(ctx, params)
 }
 
+func (db *dbCrypt) InsertNotificationUserTarget(ctx context.Context, params database.InsertNotificationUserTargetParams) (database.NotificationUserTarget, error) {
+	if err := db.encryptField(&params.Secret, &params.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	target, err := db.Store.InsertNotificationUserTarget(ctx, params)
+	if err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	if err := db.decryptField(&target.Secret, target.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	return target, nil
+}
+
+func (db *dbCrypt) GetNotificationUserTargetByID(ctx context.Context, id uuid.UUID) (database.NotificationUserTarget, error) {
+	target, err := db.Store.GetNotificationUserTargetByID(ctx, id)
+	if err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	if err := db.decryptField(&target.Secret, target.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	return target, nil
+}
+
+func (db *dbCrypt) GetNotificationUserTargetByPreference(ctx context.Context, params database.GetNotificationUserTargetByPreferenceParams) (database.NotificationUserTarget, error) {
+	target, err := db.Store.GetNotificationUserTargetByPreference(ctx, params)
+	if err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	if err := db.decryptField(&target.Secret, target.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	return target, nil
+}
+
+func (db *dbCrypt) GetNotificationUserTargetsByUserID(ctx context.Context, userID uuid.UUID) ([]database.NotificationUserTarget, error) {
+	targets, err := db.Store.GetNotificationUserTargetsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range targets {
+		if err := db.decryptField(&targets[idx].Secret, targets[idx].SecretKeyID); err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+func (db *dbCrypt) UpdateNotificationUserTargetSecret(ctx context.Context, params database.UpdateNotificationUserTargetSecretParams) (database.NotificationUserTarget, error) {
+	if err := db.encryptField(&params.Secret, &params.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	target, err := db.Store.UpdateNotificationUserTargetSecret(ctx, params)
+	if err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	if err := db.decryptField(&target.Secret, target.SecretKeyID); err != nil {
+		return database.NotificationUserTarget{}, err
+	}
+	return target, nil
+}
+
+func (db *dbCrypt) GetNotificationWebhookSecrets(ctx context.Context) ([]database.NotificationWebhookSecret, error) {
+	secrets, err := db.Store.GetNotificationWebhookSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range secrets {
+		if err := db.decryptField(&secrets[idx].Secret, secrets[idx].SecretKeyID); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+func (db *dbCrypt) InsertNotificationWebhookSecret(ctx context.Context, params database.InsertNotificationWebhookSecretParams) (database.NotificationWebhookSecret, error) {
+	if err := db.encryptField(&params.Secret, &params.SecretKeyID); err != nil {
+		return database.NotificationWebhookSecret{}, err
+	}
+	secret, err := db.Store.InsertNotificationWebhookSecret(ctx, params)
+	if err != nil {
+		return database.NotificationWebhookSecret{}, err
+	}
+	if err := db.decryptField(&secret.Secret, secret.SecretKeyID); err != nil {
+		return database.NotificationWebhookSecret{}, err
+	}
+	return secret, nil
+}
+
+func (db *dbCrypt) UpdateNotificationWebhookSecret(ctx context.Context, params database.UpdateNotificationWebhookSecretParams) error {
+	if err := db.encryptField(&params.Secret, &params.SecretKeyID); err != nil {
+		return err
+	}
+	return db.Store.UpdateNotificationWebhookSecret(ctx, params)
+}
+
 func (db *dbCrypt) GetCryptoKeys(ctx context.Context) ([]database.CryptoKey, error) {
 	keys, err := db.Store.GetCryptoKeys(ctx)
 	if err != nil {
@@ -351,6 +448,51 @@ func (db *dbCrypt) GetCryptoKeysByFeature(ctx context.Context, feature database.
 	return keys, nil
 }
 
+func (db *dbCrypt) InsertAIBridgeInterceptionCapture(ctx context.Context, params database.InsertAIBridgeInterceptionCaptureParams) (database.AIBridgeInterceptionCapture, error) {
+	if err := db.encryptField(&params.RequestBody, &params.RequestBodyKeyID); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	if err := db.encryptField(&params.ResponseBody, &params.ResponseBodyKeyID); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	capture, err := db.Store.InsertAIBridgeInterceptionCapture(ctx, params)
+	if err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	if err := db.decryptCapture(&capture); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	return capture, nil
+}
+
+func (db *dbCrypt) GetAIBridgeInterceptionCaptureByInterceptionID(ctx context.Context, interceptionID uuid.UUID) (database.AIBridgeInterceptionCapture, error) {
+	capture, err := db.Store.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, interceptionID)
+	if err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	if err := db.decryptCapture(&capture); err != nil {
+		return database.AIBridgeInterceptionCapture{}, err
+	}
+	return capture, nil
+}
+
+func (db *dbCrypt) UpdateAIBridgeInterceptionCaptureBodies(ctx context.Context, params database.UpdateAIBridgeInterceptionCaptureBodiesParams) error {
+	if err := db.encryptField(&params.RequestBody, &params.RequestBodyKeyID); err != nil {
+		return err
+	}
+	if err := db.encryptField(&params.ResponseBody, &params.ResponseBodyKeyID); err != nil {
+		return err
+	}
+	return db.Store.UpdateAIBridgeInterceptionCaptureBodies(ctx, params)
+}
+
+func (db *dbCrypt) decryptCapture(capture *database.AIBridgeInterceptionCapture) error {
+	if err := db.decryptField(&capture.RequestBody, capture.RequestBodyKeyID); err != nil {
+		return err
+	}
+	return db.decryptField(&capture.ResponseBody, capture.ResponseBodyKeyID)
+}
+
 func (db *dbCrypt) encryptField(field *string, digest *sql.NullString) error {
 	// If no cipher is loaded, then we can't encrypt anything!
 	if db.ciphers == nil || db.primaryCipherDigest == "" {
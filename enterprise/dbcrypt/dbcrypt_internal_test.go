@@ -401,6 +401,84 @@ func TestExternalAuthLinks(t *testing.T) {
 	})
 }
 
+func TestNotificationUserTargets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("InsertNotificationUserTarget", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		user := dbgen.User(t, crypt, database.User{})
+		target := dbgen.NotificationUserTarget(t, crypt, database.NotificationUserTarget{
+			UserID: user.ID,
+			Secret: "secret",
+		})
+		require.Equal(t, "secret", target.Secret)
+
+		target, err := db.GetNotificationUserTargetByID(ctx, target.ID)
+		require.NoError(t, err)
+		requireEncryptedEquals(t, ciphers[0], target.Secret, "secret")
+		require.Equal(t, ciphers[0].HexDigest(), target.SecretKeyID.String)
+	})
+
+	t.Run("UpdateNotificationUserTargetSecret", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		user := dbgen.User(t, crypt, database.User{})
+		target := dbgen.NotificationUserTarget(t, crypt, database.NotificationUserTarget{UserID: user.ID})
+		updated, err := crypt.UpdateNotificationUserTargetSecret(ctx, database.UpdateNotificationUserTargetSecretParams{
+			ID:        target.ID,
+			Secret:    "rotated",
+			UpdatedAt: dbtime.Now(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "rotated", updated.Secret)
+
+		target, err = db.GetNotificationUserTargetByID(ctx, target.ID)
+		require.NoError(t, err)
+		requireEncryptedEquals(t, ciphers[0], target.Secret, "rotated")
+	})
+
+	t.Run("GetNotificationUserTargetsByUserID", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("OK", func(t *testing.T) {
+			t.Parallel()
+			db, crypt, ciphers := setup(t)
+			user := dbgen.User(t, crypt, database.User{})
+			_ = dbgen.NotificationUserTarget(t, crypt, database.NotificationUserTarget{
+				UserID: user.ID,
+				Secret: "secret",
+			})
+			targets, err := crypt.GetNotificationUserTargetsByUserID(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, targets, 1)
+			require.Equal(t, "secret", targets[0].Secret)
+			require.Equal(t, ciphers[0].HexDigest(), targets[0].SecretKeyID.String)
+
+			rawTargets, err := db.GetNotificationUserTargetsByUserID(ctx, user.ID)
+			require.NoError(t, err)
+			require.Len(t, rawTargets, 1)
+			requireEncryptedEquals(t, ciphers[0], rawTargets[0].Secret, "secret")
+		})
+
+		t.Run("DecryptErr", func(t *testing.T) {
+			t.Parallel()
+			db, crypt, ciphers := setup(t)
+			user := dbgen.User(t, db, database.User{})
+			_ = dbgen.NotificationUserTarget(t, db, database.NotificationUserTarget{
+				UserID:      user.ID,
+				Secret:      fakeBase64RandomData(t, 32),
+				SecretKeyID: sql.NullString{String: ciphers[0].HexDigest(), Valid: true},
+			})
+			_, err := crypt.GetNotificationUserTargetsByUserID(ctx, user.ID)
+			require.Error(t, err, "expected an error")
+			var derr *DecryptFailedError
+			require.ErrorAs(t, err, &derr, "expected a decrypt error")
+		})
+	})
+}
+
 func TestCryptoKeys(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -877,3 +955,139 @@ func fakeBase64RandomData(t *testing.T, n int) string {
 	require.NoError(t, err)
 	return base64.StdEncoding.EncodeToString(b)
 }
+
+func TestNotificationWebhookSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("InsertNotificationWebhookSecret", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		secret := dbgen.NotificationWebhookSecret(t, crypt, database.NotificationWebhookSecret{
+			Secret: "secret",
+		})
+		require.Equal(t, "secret", secret.Secret)
+
+		rawSecrets, err := db.GetNotificationWebhookSecrets(ctx)
+		require.NoError(t, err)
+		require.Len(t, rawSecrets, 1)
+		requireEncryptedEquals(t, ciphers[0], rawSecrets[0].Secret, "secret")
+		require.Equal(t, ciphers[0].HexDigest(), rawSecrets[0].SecretKeyID.String)
+	})
+
+	t.Run("UpdateNotificationWebhookSecret", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		secret := dbgen.NotificationWebhookSecret(t, crypt, database.NotificationWebhookSecret{})
+		err := crypt.UpdateNotificationWebhookSecret(ctx, database.UpdateNotificationWebhookSecretParams{
+			ID:     secret.ID,
+			Secret: "rotated",
+		})
+		require.NoError(t, err)
+
+		rawSecrets, err := db.GetNotificationWebhookSecrets(ctx)
+		require.NoError(t, err)
+		require.Len(t, rawSecrets, 1)
+		requireEncryptedEquals(t, ciphers[0], rawSecrets[0].Secret, "rotated")
+	})
+
+	t.Run("GetNotificationWebhookSecrets", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("OK", func(t *testing.T) {
+			t.Parallel()
+			_, crypt, ciphers := setup(t)
+			_ = dbgen.NotificationWebhookSecret(t, crypt, database.NotificationWebhookSecret{
+				Secret: "secret",
+			})
+			secrets, err := crypt.GetNotificationWebhookSecrets(ctx)
+			require.NoError(t, err)
+			require.Len(t, secrets, 1)
+			require.Equal(t, "secret", secrets[0].Secret)
+			require.Equal(t, ciphers[0].HexDigest(), secrets[0].SecretKeyID.String)
+		})
+
+		t.Run("DecryptErr", func(t *testing.T) {
+			t.Parallel()
+			db, crypt, ciphers := setup(t)
+			_ = dbgen.NotificationWebhookSecret(t, db, database.NotificationWebhookSecret{
+				Secret:      fakeBase64RandomData(t, 32),
+				SecretKeyID: sql.NullString{String: ciphers[0].HexDigest(), Valid: true},
+			})
+			_, err := crypt.GetNotificationWebhookSecrets(ctx)
+			require.Error(t, err, "expected an error")
+			var derr *DecryptFailedError
+			require.ErrorAs(t, err, &derr, "expected a decrypt error")
+		})
+	})
+}
+
+func TestAIBridgeInterceptionCaptures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("InsertAIBridgeInterceptionCapture", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		capture := dbgen.AIBridgeInterceptionCapture(t, crypt, database.InsertAIBridgeInterceptionCaptureParams{
+			RequestBody:  "request",
+			ResponseBody: "response",
+		})
+		require.Equal(t, "request", capture.RequestBody)
+		require.Equal(t, "response", capture.ResponseBody)
+
+		capture, err := db.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, capture.InterceptionID)
+		require.NoError(t, err)
+		requireEncryptedEquals(t, ciphers[0], capture.RequestBody, "request")
+		requireEncryptedEquals(t, ciphers[0], capture.ResponseBody, "response")
+		require.Equal(t, ciphers[0].HexDigest(), capture.RequestBodyKeyID.String)
+		require.Equal(t, ciphers[0].HexDigest(), capture.ResponseBodyKeyID.String)
+	})
+
+	t.Run("UpdateAIBridgeInterceptionCaptureBodies", func(t *testing.T) {
+		t.Parallel()
+		db, crypt, ciphers := setup(t)
+		capture := dbgen.AIBridgeInterceptionCapture(t, crypt, database.InsertAIBridgeInterceptionCaptureParams{})
+		err := crypt.UpdateAIBridgeInterceptionCaptureBodies(ctx, database.UpdateAIBridgeInterceptionCaptureBodiesParams{
+			InterceptionID: capture.InterceptionID,
+			RequestBody:    "new request",
+			ResponseBody:   "new response",
+		})
+		require.NoError(t, err)
+
+		capture, err = db.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, capture.InterceptionID)
+		require.NoError(t, err)
+		requireEncryptedEquals(t, ciphers[0], capture.RequestBody, "new request")
+		requireEncryptedEquals(t, ciphers[0], capture.ResponseBody, "new response")
+	})
+
+	t.Run("GetAIBridgeInterceptionCaptureByInterceptionID", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("OK", func(t *testing.T) {
+			t.Parallel()
+			_, crypt, _ := setup(t)
+			capture := dbgen.AIBridgeInterceptionCapture(t, crypt, database.InsertAIBridgeInterceptionCaptureParams{
+				RequestBody:  "request",
+				ResponseBody: "response",
+			})
+			capture, err := crypt.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, capture.InterceptionID)
+			require.NoError(t, err)
+			require.Equal(t, "request", capture.RequestBody)
+			require.Equal(t, "response", capture.ResponseBody)
+		})
+
+		t.Run("DecryptErr", func(t *testing.T) {
+			t.Parallel()
+			db, crypt, ciphers := setup(t)
+			capture := dbgen.AIBridgeInterceptionCapture(t, db, database.InsertAIBridgeInterceptionCaptureParams{
+				RequestBody:      fakeBase64RandomData(t, 32),
+				RequestBodyKeyID: sql.NullString{String: ciphers[0].HexDigest(), Valid: true},
+			})
+			_, err := crypt.GetAIBridgeInterceptionCaptureByInterceptionID(ctx, capture.InterceptionID)
+			require.Error(t, err, "expected an error")
+			var derr *DecryptFailedError
+			require.ErrorAs(t, err, &derr, "expected a decrypt error")
+		})
+	})
+}
@@ -32,6 +32,9 @@ type Server struct {
 	// A pool of [aibridge.RequestBridge] instances, which service incoming requests.
 	requestBridgePool Pooler
 
+	// Policies which inspect requests before they are forwarded, in evaluation order.
+	policies []Policy
+
 	logger slog.Logger
 	wg     sync.WaitGroup
 
@@ -48,7 +51,8 @@ type Server struct {
 	shutdownOnce sync.Once
 }
 
-func New(ctx context.Context, pool Pooler, rpcDialer Dialer, logger slog.Logger) (*Server, error) {
+// New creates a [Server]. The given policies are evaluated in order against every request before it is forwarded.
+func New(ctx context.Context, pool Pooler, rpcDialer Dialer, logger slog.Logger, policies ...Policy) (*Server, error) {
 	if rpcDialer == nil {
 		return nil, xerrors.Errorf("nil rpcDialer given")
 	}
@@ -58,6 +62,7 @@ func New(ctx context.Context, pool Pooler, rpcDialer Dialer, logger slog.Logger)
 		logger:            logger,
 		clientDialer:      rpcDialer,
 		requestBridgePool: pool,
+		policies:          policies,
 		clientCh:          make(chan DRPCClient),
 		lifecycleCtx:      ctx,
 		cancelFn:          cancel,
@@ -142,7 +147,7 @@ func (s *Server) GetRequestHandler(ctx context.Context, req Request) (http.Handl
 		return nil, xerrors.New("nil requestBridgePool")
 	}
 
-	reqBridge, err := s.requestBridgePool.Acquire(ctx, req, s.Client, NewMCPProxyFactory(s.logger, s.Client))
+	reqBridge, err := s.requestBridgePool.Acquire(ctx, req, s.Client, NewMCPProxyFactory(s.logger, s.Client, toolDenylist(s.policies)))
 	if err != nil {
 		return nil, xerrors.Errorf("acquire request bridge: %w", err)
 	}
@@ -42,6 +42,21 @@ func (m *MockDRPCClient) EXPECT() *MockDRPCClientMockRecorder {
 	return m.recorder
 }
 
+// CheckLimits mocks base method.
+func (m *MockDRPCClient) CheckLimits(ctx context.Context, in *proto.CheckLimitsRequest) (*proto.CheckLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLimits", ctx, in)
+	ret0, _ := ret[0].(*proto.CheckLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLimits indicates an expected call of CheckLimits.
+func (mr *MockDRPCClientMockRecorder) CheckLimits(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimits", reflect.TypeOf((*MockDRPCClient)(nil).CheckLimits), ctx, in)
+}
+
 // DRPCConn mocks base method.
 func (m *MockDRPCClient) DRPCConn() drpc.Conn {
 	m.ctrl.T.Helper()
@@ -116,6 +131,36 @@ func (mr *MockDRPCClientMockRecorder) RecordInterception(ctx, in any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInterception", reflect.TypeOf((*MockDRPCClient)(nil).RecordInterception), ctx, in)
 }
 
+// RecordInterceptionCapture mocks base method.
+func (m *MockDRPCClient) RecordInterceptionCapture(ctx context.Context, in *proto.RecordInterceptionCaptureRequest) (*proto.RecordInterceptionCaptureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordInterceptionCapture", ctx, in)
+	ret0, _ := ret[0].(*proto.RecordInterceptionCaptureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordInterceptionCapture indicates an expected call of RecordInterceptionCapture.
+func (mr *MockDRPCClientMockRecorder) RecordInterceptionCapture(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordInterceptionCapture", reflect.TypeOf((*MockDRPCClient)(nil).RecordInterceptionCapture), ctx, in)
+}
+
+// RecordPolicyDecision mocks base method.
+func (m *MockDRPCClient) RecordPolicyDecision(ctx context.Context, in *proto.RecordPolicyDecisionRequest) (*proto.RecordPolicyDecisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPolicyDecision", ctx, in)
+	ret0, _ := ret[0].(*proto.RecordPolicyDecisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordPolicyDecision indicates an expected call of RecordPolicyDecision.
+func (mr *MockDRPCClientMockRecorder) RecordPolicyDecision(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPolicyDecision", reflect.TypeOf((*MockDRPCClient)(nil).RecordPolicyDecision), ctx, in)
+}
+
 // RecordPromptUsage mocks base method.
 func (m *MockDRPCClient) RecordPromptUsage(ctx context.Context, in *proto.RecordPromptUsageRequest) (*proto.RecordPromptUsageResponse, error) {
 	m.ctrl.T.Helper()
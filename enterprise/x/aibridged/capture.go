@@ -0,0 +1,236 @@
+package aibridged
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cdr.dev/slog"
+	"github.com/coder/coder/v2/codersdk"
+	"github.com/coder/coder/v2/enterprise/x/aibridged/proto"
+)
+
+// captureRecordTimeout bounds how long a completed request waits for its capture to be recorded.
+const captureRecordTimeout = 10 * time.Second
+
+// captureRedactedHeaders are the headers whose values are never captured, since they carry credentials.
+var captureRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-Api-Key",
+	"Api-Key",
+	"Cookie",
+	"Set-Cookie",
+	"Coder-Session-Token",
+}
+
+func init() {
+	// aibridge sends requests upstream with the provider SDKs, which use [http.DefaultClient] and offer no other way
+	// to reach their transport. Requests without a capture in their context are passed through unchanged.
+	http.DefaultClient.Transport = &captureTransport{base: http.DefaultClient.Transport}
+}
+
+// requestCapture holds a copy of the request which aibridge sends upstream for a bridged request and its response,
+// so that they can be recorded against the interception which aibridge creates for the request. Secrets are redacted
+// from the bodies before they are truncated to maxBodyBytes.
+type requestCapture struct {
+	maxBodyBytes int64
+
+	mu sync.Mutex
+	// captured is set once an upstream request is captured. Only the first is captured, since aibridge may send
+	// further requests for the same interception, e.g. to return the results of injected tool calls.
+	captured bool
+	method   string
+	// path is the bridged route of the request rather than the upstream path, so that the captured request can be
+	// replayed through the bridge.
+	path         string
+	reqHeaders   http.Header
+	reqBody      []byte
+	respStatus   int
+	respHeaders  http.Header
+	respBody     bytes.Buffer
+	respComplete bool
+
+	// interceptionID is set once aibridge records the interception for the request.
+	interceptionID string
+}
+
+type requestCaptureKey struct{}
+
+// startCapture returns a copy of r whose upstream request and response will be captured. The returned request must
+// be passed on in place of r.
+func startCapture(r *http.Request, maxBodyBytes int64) (*http.Request, *requestCapture) {
+	c := &requestCapture{maxBodyBytes: maxBodyBytes, path: r.URL.Path}
+	return r.WithContext(context.WithValue(r.Context(), requestCaptureKey{}, c)), c
+}
+
+// setCaptureInterceptionID relates the capture carried in ctx, if any, to the interception created for its request.
+func setCaptureInterceptionID(ctx context.Context, interceptionID string) {
+	c, ok := ctx.Value(requestCaptureKey{}).(*requestCapture)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interceptionID = interceptionID
+}
+
+// record sends the capture to coderd once the request has been served. Requests which did not result in an
+// interception or did not receive a response from upstream, such as those to passthrough routes, are not recorded.
+func (c *requestCapture) record(ctx context.Context, logger slog.Logger, client proto.DRPCRecorderClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.interceptionID == "" || c.respStatus == 0 {
+		return
+	}
+
+	// The capture is still worth recording if the client went away before the response completed.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), captureRecordTimeout)
+	defer cancel()
+
+	reqHeaders, err := json.Marshal(redactHeaders(c.reqHeaders))
+	if err != nil {
+		logger.Warn(ctx, "failed to encode captured request headers", slog.Error(err))
+		return
+	}
+	respHeaders, err := json.Marshal(redactHeaders(c.respHeaders))
+	if err != nil {
+		logger.Warn(ctx, "failed to encode captured response headers", slog.Error(err))
+		return
+	}
+
+	reqBody, reqTruncated := captureBody(c.reqBody, c.maxBodyBytes)
+	respBody, respTruncated := captureBody(c.respBody.Bytes(), c.maxBodyBytes)
+	_, err = client.RecordInterceptionCapture(ctx, &proto.RecordInterceptionCaptureRequest{
+		InterceptionId:       c.interceptionID,
+		Method:               c.method,
+		Path:                 c.path,
+		RequestHeaders:       reqHeaders,
+		RequestBody:          reqBody,
+		RequestBodyTruncated: reqTruncated,
+		ResponseStatus:       int32(c.respStatus), //nolint:gosec // HTTP status codes fit in int32.
+		ResponseHeaders:      respHeaders,
+		ResponseBody:         respBody,
+		// A response which was not read in full, e.g. because the client went away, is incomplete.
+		ResponseBodyTruncated: respTruncated || !c.respComplete,
+		CreatedAt:             timestamppb.Now(),
+	})
+	if err != nil {
+		logger.Warn(ctx, "failed to record interception capture", slog.F("interception_id", c.interceptionID), slog.Error(err))
+	}
+}
+
+// captureBody redacts any well-known credentials from body, and then truncates it to maxBodyBytes. Redacting first
+// ensures that a credential which straddles the cut is not partially captured. It reports whether body was truncated.
+func captureBody(body []byte, maxBodyBytes int64) ([]byte, bool) {
+	body = redactSecrets(body)
+	if int64(len(body)) <= maxBodyBytes {
+		return body, false
+	}
+	return body[:maxBodyBytes], true
+}
+
+// redactHeaders returns a copy of the given headers with the values of those which carry credentials replaced.
+func redactHeaders(h http.Header) http.Header {
+	redacted := h.Clone()
+	if redacted == nil {
+		return http.Header{}
+	}
+	for _, name := range captureRedactedHeaders {
+		key := http.CanonicalHeaderKey(name)
+		for i := range redacted[key] {
+			redacted[key][i] = codersdk.AIBridgeCaptureRedactedValue
+		}
+	}
+	return redacted
+}
+
+// redactSecrets replaces any well-known credentials in a captured body with a placeholder.
+func redactSecrets(body []byte) []byte {
+	for _, pattern := range DefaultSecretPatterns {
+		body = pattern.Regexp.ReplaceAll(body, []byte(fmt.Sprintf("[REDACTED:%s]", pattern.Name)))
+	}
+	return body
+}
+
+// captureTransport captures the first request sent upstream for a request which carries a [requestCapture] in its
+// context, along with its response.
+type captureTransport struct {
+	// base is the transport requests are sent with, or [http.DefaultTransport] if nil.
+	base http.RoundTripper
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	c, ok := req.Context().Value(requestCaptureKey{}).(*requestCapture)
+	if !ok {
+		return base.RoundTrip(req)
+	}
+	c.mu.Lock()
+	first := !c.captured
+	c.captured = true
+	c.mu.Unlock()
+	if !first {
+		return base.RoundTrip(req)
+	}
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	c.mu.Lock()
+	c.method = req.Method
+	c.reqHeaders = req.Header.Clone()
+	c.reqBody = reqBody
+	c.mu.Unlock()
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.respStatus = resp.StatusCode
+	c.respHeaders = resp.Header.Clone()
+	c.mu.Unlock()
+	resp.Body = &captureResponseBody{ReadCloser: resp.Body, capture: c}
+	return resp, nil
+}
+
+// captureResponseBody tees a response body into its capture as it is read, since aibridge streams responses as
+// server-sent events.
+type captureResponseBody struct {
+	io.ReadCloser
+	capture *requestCapture
+}
+
+func (b *captureResponseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.capture.mu.Lock()
+	defer b.capture.mu.Unlock()
+	_, _ = b.capture.respBody.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		b.capture.respComplete = true
+	}
+	return n, err
+}
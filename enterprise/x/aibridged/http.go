@@ -1,11 +1,19 @@
 package aibridged
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"cdr.dev/slog"
 	"github.com/coder/aibridge"
@@ -19,17 +27,33 @@ var (
 	ErrConnect               = xerrors.New("could not connect to coderd")
 	ErrUnauthorized          = xerrors.New("unauthorized")
 	ErrAcquireRequestHandler = xerrors.New("failed to acquire request handler")
+	ErrCheckLimits           = xerrors.New("failed to check usage limits")
+	ErrReadBody              = xerrors.New("failed to read request body")
+	ErrBodyTooLarge          = xerrors.New("request body too large")
 )
 
+// MaxPolicyBodyBytes is the largest request body which is read in order to evaluate policies, matching the largest
+// request accepted by the Anthropic Messages API.
+const MaxPolicyBodyBytes = 32 << 20
+
+// policyRoutes maps the routes whose requests are inspected by policies to the provider they are bridged to.
+// Passthrough routes carry no prompts, so are not inspected.
+var policyRoutes = map[string]string{
+	"/" + aibridge.ProviderOpenAI + "/v1/chat/completions": aibridge.ProviderOpenAI,
+	"/" + aibridge.ProviderAnthropic + "/v1/messages":      aibridge.ProviderAnthropic,
+}
+
 // ServeHTTP is the entrypoint for requests which will be intercepted by AI Bridge.
-// This function will validate that the given API key may be used to perform the request.
+// This function will validate that the given API key may be used to perform the request,
+// and that its owner has not exceeded any of the usage limits which apply to them.
 //
 // An [aibridge.RequestBridge] instance is acquired from a pool based on the API key's
 // owner (referred to as the "initiator"); this instance is responsible for the
 // AI Bridge-specific handling of the request.
 //
 // A [DRPCClient] is provided to the [aibridge.RequestBridge] instance so that data can
-// be passed up to a [DRPCServer] for persistence.
+// be passed up to a [DRPCServer] for persistence. If the initiator has opted in to capture,
+// the full upstream request and response are recorded against the interception once it completes.
 func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -57,7 +81,7 @@ func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	// Rewire request context to include actor.
-	r = r.WithContext(aibridge.AsActor(ctx, resp.GetOwnerId(), nil))
+	r = r.WithContext(aibridge.AsActor(ctx, resp.GetOwnerId(), actorMetadata(resp)))
 
 	id, err := uuid.Parse(resp.GetOwnerId())
 	if err != nil {
@@ -66,6 +90,30 @@ func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	limits, err := client.CheckLimits(ctx, &proto.CheckLimitsRequest{UserId: id.String()})
+	if err != nil {
+		logger.Warn(ctx, "failed to check usage limits", slog.Error(err))
+		http.Error(rw, ErrCheckLimits.Error(), http.StatusInternalServerError)
+		return
+	}
+	if limits.GetExceeded() {
+		logger.Debug(ctx, "usage limit exceeded", slog.F("user_id", id), slog.F("kind", limits.GetKind().String()))
+		writeLimitExceeded(rw, r, limits)
+		return
+	}
+
+	r, ok := s.enforcePolicies(rw, r, client, id, actorMetadata(resp))
+	if !ok {
+		return
+	}
+
+	// The request which aibridge sends upstream is captured, so that the capture reflects what was bridged.
+	if maxBodyBytes := resp.GetCaptureMaxBodyBytes(); maxBodyBytes > 0 {
+		var capture *requestCapture
+		r, capture = startCapture(r, maxBodyBytes)
+		defer capture.record(ctx, logger, client)
+	}
+
 	handler, err := s.GetRequestHandler(ctx, Request{
 		SessionKey:  key,
 		InitiatorID: id,
@@ -79,6 +127,198 @@ func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	handler.ServeHTTP(rw, r)
 }
 
+// enforcePolicies evaluates the server's policies against the given request, if it is destined for a bridged route.
+//
+// Redactions are applied to the body of the returned request, and the decisions which led to them are carried in
+// its context so that they are recorded once aibridge creates the interception. If a policy blocks the request, an
+// interception is recorded for it along with the decisions, an error is written to rw and false is returned.
+func (s *Server) enforcePolicies(rw http.ResponseWriter, r *http.Request, client DRPCClient, initiatorID uuid.UUID, metadata aibridge.Metadata) (*http.Request, bool) {
+	ctx := r.Context()
+
+	provider, ok := policyRoutes[r.URL.Path]
+	if len(s.policies) == 0 || !ok || r.Method != http.MethodPost {
+		return r, true
+	}
+
+	raw, err := io.ReadAll(http.MaxBytesReader(rw, r.Body, MaxPolicyBodyBytes))
+	_ = r.Body.Close()
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			s.logger.Debug(ctx, "request body too large to evaluate policies", slog.F("limit", maxBytesErr.Limit))
+			http.Error(rw, ErrBodyTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return r, false
+		}
+		s.logger.Warn(ctx, "failed to read request body", slog.Error(err))
+		http.Error(rw, ErrReadBody.Error(), http.StatusBadRequest)
+		return r, false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var body map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber() // Don't lose the precision of any numeric parameters when re-encoding.
+	if err := dec.Decode(&body); err != nil {
+		// Leave it to the provider to reject malformed requests.
+		s.logger.Debug(ctx, "request body is not a JSON object, skipping policies", slog.Error(err))
+		return r, true
+	}
+
+	model, _ := body["model"].(string)
+	req := &PolicyRequest{Provider: provider, Model: model, Body: body}
+
+	var (
+		decisions []PolicyDecision
+		redacted  bool
+	)
+	for _, policy := range s.policies {
+		decision := policy.Evaluate(ctx, req)
+		if decision.Action == PolicyActionAllow {
+			continue
+		}
+		decisions = append(decisions, decision)
+		if decision.Action == PolicyActionRedact {
+			redacted = true
+			continue
+		}
+
+		s.logger.Debug(ctx, "request blocked by policy", slog.F("policy", decision.Policy), slog.F("reason", decision.Reason))
+
+		// The request never reaches aibridge, so record an interception for it here.
+		interceptionID := uuid.NewString()
+		_, err := client.RecordInterception(ctx, &proto.RecordInterceptionRequest{
+			Id:          interceptionID,
+			InitiatorId: initiatorID.String(),
+			Provider:    provider,
+			Model:       model,
+			Metadata:    marshalForProto(metadata),
+			StartedAt:   timestamppb.Now(),
+		})
+		if err == nil {
+			err = recordPolicyDecisions(ctx, client, interceptionID, decisions)
+		}
+		if err != nil {
+			s.logger.Warn(ctx, "failed to record blocked interception", slog.Error(err))
+		}
+
+		writePolicyBlocked(rw, provider, decision)
+		return r, false
+	}
+
+	if redacted {
+		raw, err = json.Marshal(body)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to encode redacted request body", slog.Error(err))
+			http.Error(rw, ErrReadBody.Error(), http.StatusInternalServerError)
+			return r, false
+		}
+		r.Body = io.NopCloser(bytes.NewReader(raw))
+		r.ContentLength = int64(len(raw))
+		r.Header.Set("Content-Length", strconv.Itoa(len(raw)))
+	}
+
+	return r.WithContext(withPolicyDecisions(ctx, decisions)), true
+}
+
+type policyDecisionsKey struct{}
+
+func withPolicyDecisions(ctx context.Context, decisions []PolicyDecision) context.Context {
+	if len(decisions) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, policyDecisionsKey{}, decisions)
+}
+
+func policyDecisionsFromContext(ctx context.Context) []PolicyDecision {
+	decisions, _ := ctx.Value(policyDecisionsKey{}).([]PolicyDecision)
+	return decisions
+}
+
+// writePolicyBlocked responds with a 403 in the error format of the given provider, so that AI clients surface the
+// reason to the user.
+func writePolicyBlocked(rw http.ResponseWriter, provider string, decision PolicyDecision) {
+	msg := fmt.Sprintf("Request blocked by the %q AI Bridge policy: %s", decision.Policy, decision.Reason)
+
+	var body any
+	switch provider {
+	case aibridge.ProviderAnthropic:
+		// https://docs.anthropic.com/en/api/errors
+		body = map[string]any{
+			"type": "error",
+			"error": map[string]any{
+				"type":    "permission_error",
+				"message": msg,
+			},
+		}
+	default:
+		// https://platform.openai.com/docs/guides/error-codes
+		body = map[string]any{
+			"error": map[string]any{
+				"message": msg,
+				"type":    "invalid_request_error",
+				"param":   nil,
+				"code":    "content_policy_violation",
+			},
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusForbidden)
+	_ = json.NewEncoder(rw).Encode(body)
+}
+
+// actorMetadata returns the metadata to record against the interceptions of an authorized request. The workspace and
+// template the key was issued for are included so that usage can be attributed to them.
+func actorMetadata(resp *proto.IsAuthorizedResponse) aibridge.Metadata {
+	if resp.GetTemplateId() == "" {
+		return nil
+	}
+	return aibridge.Metadata{
+		"workspace_id": resp.GetWorkspaceId(),
+		"template_id":  resp.GetTemplateId(),
+	}
+}
+
+// writeLimitExceeded responds with a 429 in the error format of the provider for which the request was destined, so
+// that AI clients surface the message and back off just as if the provider had rate limited them.
+func writeLimitExceeded(rw http.ResponseWriter, r *http.Request, limits *proto.CheckLimitsResponse) {
+	quota := limits.GetKind() != proto.LimitKind_LIMIT_KIND_REQUESTS_PER_MINUTE
+
+	var body any
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/"+aibridge.ProviderAnthropic+"/"):
+		// https://docs.anthropic.com/en/api/errors
+		body = map[string]any{
+			"type": "error",
+			"error": map[string]any{
+				"type":    "rate_limit_error",
+				"message": limits.GetMessage(),
+			},
+		}
+	default:
+		// https://platform.openai.com/docs/guides/error-codes
+		errType, code := "requests", "rate_limit_exceeded"
+		if quota {
+			errType, code = "insufficient_quota", "insufficient_quota"
+		}
+		body = map[string]any{
+			"error": map[string]any{
+				"message": limits.GetMessage(),
+				"type":    errType,
+				"param":   nil,
+				"code":    code,
+			},
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if retryAfter := limits.GetRetryAfterSeconds(); retryAfter > 0 {
+		rw.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	}
+	rw.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(rw).Encode(body)
+}
+
 // ExtractAuthToken extracts authorization token from HTTP request using multiple sources.
 // These sources represent the different ways clients authenticate against AI providers.
 // It checks the Authorization header (Bearer token) and X-Api-Key header.
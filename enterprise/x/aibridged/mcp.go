@@ -36,12 +36,16 @@ var _ MCPProxyBuilder = &MCPProxyFactory{}
 type MCPProxyFactory struct {
 	logger   slog.Logger
 	clientFn ClientFunc
+	// toolDenylist matches tools which are denied by policy on every MCP server, in addition to each server's own
+	// denylist. May be nil.
+	toolDenylist *regexp.Regexp
 }
 
-func NewMCPProxyFactory(logger slog.Logger, clientFn ClientFunc) *MCPProxyFactory {
+func NewMCPProxyFactory(logger slog.Logger, clientFn ClientFunc, toolDenylist *regexp.Regexp) *MCPProxyFactory {
 	return &MCPProxyFactory{
-		logger:   logger,
-		clientFn: clientFn,
+		logger:       logger,
+		clientFn:     clientFn,
+		toolDenylist: toolDenylist,
 	}
 }
 
@@ -162,6 +166,13 @@ func (m *MCPProxyFactory) newStreamableHTTPServerProxy(cfg *proto.MCPServerConfi
 			return nil, ErrCompileRegex
 		}
 	}
+	if m.toolDenylist != nil {
+		if denylist == nil {
+			denylist = m.toolDenylist
+		} else {
+			denylist = regexp.MustCompile(`(?:` + denylist.String() + `)|(?:` + m.toolDenylist.String() + `)`)
+		}
+	}
 
 	// TODO: future improvement:
 	//
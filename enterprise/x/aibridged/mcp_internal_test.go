@@ -42,7 +42,7 @@ func TestMCPRegex(t *testing.T) {
 			t.Parallel()
 
 			logger := testutil.Logger(t)
-			f := NewMCPProxyFactory(logger, nil)
+			f := NewMCPProxyFactory(logger, nil, nil)
 
 			_, err := f.newStreamableHTTPServerProxy(&proto.MCPServerConfig{
 				Id:             "mock",
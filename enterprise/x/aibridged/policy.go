@@ -0,0 +1,349 @@
+package aibridged
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/codersdk"
+)
+
+// PolicyAction describes what a [Policy] decided to do with a request.
+type PolicyAction string
+
+const (
+	PolicyActionAllow  PolicyAction = "allow"
+	PolicyActionRedact PolicyAction = "redact"
+	PolicyActionBlock  PolicyAction = "block"
+)
+
+// PolicyDecision is the outcome of evaluating a [Policy] against a request.
+type PolicyDecision struct {
+	Policy string
+	Action PolicyAction
+	// Reason explains the decision. It is recorded against the interception and, for blocked requests, returned
+	// to the client, so it must never contain the offending content itself.
+	Reason string
+}
+
+// PolicyRequest is an intercepted request which is about to be forwarded to an upstream provider.
+type PolicyRequest struct {
+	Provider string
+	Model    string
+	// Body is the decoded JSON body of the request. Policies which redact content modify it in place.
+	Body map[string]any
+}
+
+// Policy inspects requests before they are forwarded to upstream providers, and may redact their content or
+// block them entirely.
+type Policy interface {
+	Name() string
+	Evaluate(ctx context.Context, req *PolicyRequest) PolicyDecision
+}
+
+// ToolDenier is implemented by policies which deny tools. Tools matched by any such policy are also filtered out
+// of the tools injected from MCP servers.
+type ToolDenier interface {
+	// DeniedTools returns a pattern matching the names of denied tools.
+	DeniedTools() *regexp.Regexp
+}
+
+// toolDenylist combines the denied tools of all the given policies into a single pattern, or returns nil if none
+// of them deny tools.
+func toolDenylist(policies []Policy) *regexp.Regexp {
+	var exprs []string
+	for _, p := range policies {
+		if denier, ok := p.(ToolDenier); ok {
+			exprs = append(exprs, denier.DeniedTools().String())
+		}
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?:` + strings.Join(exprs, `)|(?:`) + `)`)
+}
+
+// NewPolicies creates the built-in policies enabled by the given configuration, in the order in which they
+// should be evaluated.
+func NewPolicies(cfg codersdk.AIBridgePolicyConfig) ([]Policy, error) {
+	var policies []Policy
+
+	if len(cfg.DeniedTools) > 0 {
+		p, err := NewDeniedToolsPolicy(cfg.DeniedTools)
+		if err != nil {
+			return nil, xerrors.Errorf("denied tools policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+
+	switch codersdk.AIBridgeSecretDetection(cfg.SecretDetection) {
+	case "", codersdk.AIBridgeSecretDetectionOff:
+	case codersdk.AIBridgeSecretDetectionRedact, codersdk.AIBridgeSecretDetectionBlock:
+		patterns := slices.Clone(DefaultSecretPatterns)
+		for i, expr := range cfg.SecretPatterns {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, xerrors.Errorf("compile secret pattern %q: %w", expr, err)
+			}
+			patterns = append(patterns, SecretPattern{Name: fmt.Sprintf("custom_%d", i+1), Regexp: re})
+		}
+		policies = append(policies, NewSecretDetectionPolicy(patterns, codersdk.AIBridgeSecretDetection(cfg.SecretDetection) == codersdk.AIBridgeSecretDetectionBlock))
+	default:
+		return nil, xerrors.Errorf("unknown secret detection mode %q", cfg.SecretDetection)
+	}
+
+	if cfg.MaxPromptBytes.Value() < 0 {
+		return nil, xerrors.Errorf("max prompt size must not be negative")
+	}
+	if cfg.MaxPromptBytes.Value() > 0 {
+		policies = append(policies, NewMaxPromptSizePolicy(cfg.MaxPromptBytes.Value()))
+	}
+
+	return policies, nil
+}
+
+// SecretPattern is a named regular expression which matches a kind of secret.
+type SecretPattern struct {
+	Name   string
+	Regexp *regexp.Regexp
+}
+
+// DefaultSecretPatterns match well-known credential formats which should never be sent to a model.
+var DefaultSecretPatterns = []SecretPattern{
+	{Name: "aws_access_key_id", Regexp: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "github_token", Regexp: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{Name: "anthropic_api_key", Regexp: regexp.MustCompile(`\bsk-ant-[A-Za-z0-9_-]{32,}`)},
+	{Name: "openai_api_key", Regexp: regexp.MustCompile(`\bsk-(?:proj|svcacct|admin)-[A-Za-z0-9_-]{20,}`)},
+	{Name: "google_api_key", Regexp: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{Name: "slack_token", Regexp: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{Name: "private_key", Regexp: regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?(?:-----END [A-Z ]*PRIVATE KEY-----|\z)`)},
+}
+
+var _ Policy = &SecretDetectionPolicy{}
+
+// SecretDetectionPolicy finds secrets in the messages of a request, and either replaces them with a placeholder or
+// blocks the request.
+type SecretDetectionPolicy struct {
+	patterns []SecretPattern
+	block    bool
+}
+
+func NewSecretDetectionPolicy(patterns []SecretPattern, block bool) *SecretDetectionPolicy {
+	return &SecretDetectionPolicy{patterns: patterns, block: block}
+}
+
+func (*SecretDetectionPolicy) Name() string {
+	return "secret_detection"
+}
+
+func (p *SecretDetectionPolicy) Evaluate(_ context.Context, req *PolicyRequest) PolicyDecision {
+	matches := make(map[string]int)
+	walkPrompt(req.Body, func(text string) string {
+		for _, pattern := range p.patterns {
+			text = pattern.Regexp.ReplaceAllStringFunc(text, func(match string) string {
+				matches[pattern.Name]++
+				if p.block {
+					return match
+				}
+				return fmt.Sprintf("[REDACTED:%s]", pattern.Name)
+			})
+		}
+		return text
+	})
+	if len(matches) == 0 {
+		return PolicyDecision{Policy: p.Name(), Action: PolicyActionAllow}
+	}
+
+	found := make([]string, 0, len(matches))
+	for _, pattern := range p.patterns {
+		if n, ok := matches[pattern.Name]; ok {
+			found = append(found, fmt.Sprintf("%d %s of %q", n, pluralize(n, "match", "matches"), pattern.Name))
+		}
+	}
+	if p.block {
+		return PolicyDecision{
+			Policy: p.Name(),
+			Action: PolicyActionBlock,
+			Reason: fmt.Sprintf("Prompt contains secrets: found %s.", strings.Join(found, ", ")),
+		}
+	}
+	return PolicyDecision{
+		Policy: p.Name(),
+		Action: PolicyActionRedact,
+		Reason: fmt.Sprintf("Redacted %s.", strings.Join(found, ", ")),
+	}
+}
+
+var (
+	_ Policy     = &DeniedToolsPolicy{}
+	_ ToolDenier = &DeniedToolsPolicy{}
+)
+
+// DeniedToolsPolicy removes denied tools from the tools which a request offers to the model, so that the model
+// cannot call them.
+type DeniedToolsPolicy struct {
+	re *regexp.Regexp
+}
+
+// NewDeniedToolsPolicy creates a policy which denies tools matching any of the given names. A "*" in a name
+// matches any sequence of characters.
+func NewDeniedToolsPolicy(names []string) (*DeniedToolsPolicy, error) {
+	exprs := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, xerrors.New("tool names must not be empty")
+		}
+		exprs = append(exprs, strings.ReplaceAll(regexp.QuoteMeta(name), `\*`, `.*`))
+	}
+	re, err := regexp.Compile(`^(?:` + strings.Join(exprs, "|") + `)$`)
+	if err != nil {
+		return nil, xerrors.Errorf("compile denied tools: %w", err)
+	}
+	return &DeniedToolsPolicy{re: re}, nil
+}
+
+func (*DeniedToolsPolicy) Name() string {
+	return "denied_tools"
+}
+
+func (p *DeniedToolsPolicy) DeniedTools() *regexp.Regexp {
+	return p.re
+}
+
+func (p *DeniedToolsPolicy) Evaluate(_ context.Context, req *PolicyRequest) PolicyDecision {
+	tools, _ := req.Body["tools"].([]any)
+
+	var removed []string
+	kept := make([]any, 0, len(tools))
+	for _, tool := range tools {
+		if name := toolName(tool); name != "" && p.re.MatchString(name) {
+			removed = append(removed, name)
+			continue
+		}
+		kept = append(kept, tool)
+	}
+	if len(removed) == 0 {
+		return PolicyDecision{Policy: p.Name(), Action: PolicyActionAllow}
+	}
+
+	if len(kept) == 0 {
+		// Providers reject requests with an empty list of tools, or a tool choice without tools.
+		delete(req.Body, "tools")
+		delete(req.Body, "tool_choice")
+	} else {
+		req.Body["tools"] = kept
+		if name := toolName(req.Body["tool_choice"]); name != "" && p.re.MatchString(name) {
+			delete(req.Body, "tool_choice")
+		}
+	}
+
+	return PolicyDecision{
+		Policy: p.Name(),
+		Action: PolicyActionRedact,
+		Reason: fmt.Sprintf("Removed denied %s %s.", pluralize(len(removed), "tool", "tools"), strings.Join(removed, ", ")),
+	}
+}
+
+// toolName returns the name of an OpenAI or Anthropic tool definition or tool choice.
+func toolName(v any) string {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return ""
+	}
+	// OpenAI: {"type": "function", "function": {"name": "..."}}.
+	if fn, ok := m["function"].(map[string]any); ok {
+		name, _ := fn["name"].(string)
+		return name
+	}
+	// Anthropic: {"name": "...", ...}.
+	name, _ := m["name"].(string)
+	return name
+}
+
+var _ Policy = &MaxPromptSizePolicy{}
+
+// MaxPromptSizePolicy blocks requests whose messages are larger than a given number of bytes.
+type MaxPromptSizePolicy struct {
+	maxBytes int64
+}
+
+func NewMaxPromptSizePolicy(maxBytes int64) *MaxPromptSizePolicy {
+	return &MaxPromptSizePolicy{maxBytes: maxBytes}
+}
+
+func (*MaxPromptSizePolicy) Name() string {
+	return "max_prompt_size"
+}
+
+func (p *MaxPromptSizePolicy) Evaluate(_ context.Context, req *PolicyRequest) PolicyDecision {
+	var size int64
+	walkPrompt(req.Body, func(text string) string {
+		size += int64(len(text))
+		return text
+	})
+	if size <= p.maxBytes {
+		return PolicyDecision{Policy: p.Name(), Action: PolicyActionAllow}
+	}
+	return PolicyDecision{
+		Policy: p.Name(),
+		Action: PolicyActionBlock,
+		Reason: fmt.Sprintf("Prompt of %d bytes exceeds the maximum of %d bytes.", size, p.maxBytes),
+	}
+}
+
+// promptKeys are the keys of a request body which hold the prompt: the conversation in OpenAI and Anthropic
+// requests, and the system prompt in Anthropic requests.
+var promptKeys = []string{"messages", "system"}
+
+// structuralKeys hold identifiers and encoded media within messages rather than text, and are not inspected.
+var structuralKeys = map[string]bool{
+	"id":            true,
+	"type":          true,
+	"role":          true,
+	"tool_call_id":  true,
+	"tool_use_id":   true,
+	"cache_control": true,
+	"media_type":    true,
+	"data":          true,
+	"url":           true,
+}
+
+// walkPrompt calls fn with each piece of text in the prompt of a request body, replacing it with the result.
+func walkPrompt(body map[string]any, fn func(string) string) {
+	for _, key := range promptKeys {
+		if v, ok := body[key]; ok {
+			body[key] = walkText(v, fn)
+		}
+	}
+}
+
+func walkText(v any, fn func(string) string) any {
+	switch v := v.(type) {
+	case string:
+		return fn(v)
+	case []any:
+		for i := range v {
+			v[i] = walkText(v[i], fn)
+		}
+	case map[string]any:
+		for key, child := range v {
+			if structuralKeys[key] {
+				continue
+			}
+			v[key] = walkText(child, fn)
+		}
+	}
+	return v
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -42,6 +42,8 @@ type DRPCRecorderClient interface {
 	RecordTokenUsage(ctx context.Context, in *RecordTokenUsageRequest) (*RecordTokenUsageResponse, error)
 	RecordPromptUsage(ctx context.Context, in *RecordPromptUsageRequest) (*RecordPromptUsageResponse, error)
 	RecordToolUsage(ctx context.Context, in *RecordToolUsageRequest) (*RecordToolUsageResponse, error)
+	RecordPolicyDecision(ctx context.Context, in *RecordPolicyDecisionRequest) (*RecordPolicyDecisionResponse, error)
+	RecordInterceptionCapture(ctx context.Context, in *RecordInterceptionCaptureRequest) (*RecordInterceptionCaptureResponse, error)
 }
 
 type drpcRecorderClient struct {
@@ -90,11 +92,31 @@ func (c *drpcRecorderClient) RecordToolUsage(ctx context.Context, in *RecordTool
 	return out, nil
 }
 
+func (c *drpcRecorderClient) RecordPolicyDecision(ctx context.Context, in *RecordPolicyDecisionRequest) (*RecordPolicyDecisionResponse, error) {
+	out := new(RecordPolicyDecisionResponse)
+	err := c.cc.Invoke(ctx, "/proto.Recorder/RecordPolicyDecision", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcRecorderClient) RecordInterceptionCapture(ctx context.Context, in *RecordInterceptionCaptureRequest) (*RecordInterceptionCaptureResponse, error) {
+	out := new(RecordInterceptionCaptureResponse)
+	err := c.cc.Invoke(ctx, "/proto.Recorder/RecordInterceptionCapture", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCRecorderServer interface {
 	RecordInterception(context.Context, *RecordInterceptionRequest) (*RecordInterceptionResponse, error)
 	RecordTokenUsage(context.Context, *RecordTokenUsageRequest) (*RecordTokenUsageResponse, error)
 	RecordPromptUsage(context.Context, *RecordPromptUsageRequest) (*RecordPromptUsageResponse, error)
 	RecordToolUsage(context.Context, *RecordToolUsageRequest) (*RecordToolUsageResponse, error)
+	RecordPolicyDecision(context.Context, *RecordPolicyDecisionRequest) (*RecordPolicyDecisionResponse, error)
+	RecordInterceptionCapture(context.Context, *RecordInterceptionCaptureRequest) (*RecordInterceptionCaptureResponse, error)
 }
 
 type DRPCRecorderUnimplementedServer struct{}
@@ -115,9 +137,17 @@ func (s *DRPCRecorderUnimplementedServer) RecordToolUsage(context.Context, *Reco
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCRecorderUnimplementedServer) RecordPolicyDecision(context.Context, *RecordPolicyDecisionRequest) (*RecordPolicyDecisionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCRecorderUnimplementedServer) RecordInterceptionCapture(context.Context, *RecordInterceptionCaptureRequest) (*RecordInterceptionCaptureResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCRecorderDescription struct{}
 
-func (DRPCRecorderDescription) NumMethods() int { return 4 }
+func (DRPCRecorderDescription) NumMethods() int { return 6 }
 
 func (DRPCRecorderDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -157,6 +187,24 @@ func (DRPCRecorderDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*RecordToolUsageRequest),
 					)
 			}, DRPCRecorderServer.RecordToolUsage, true
+	case 4:
+		return "/proto.Recorder/RecordPolicyDecision", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRecorderServer).
+					RecordPolicyDecision(
+						ctx,
+						in1.(*RecordPolicyDecisionRequest),
+					)
+			}, DRPCRecorderServer.RecordPolicyDecision, true
+	case 5:
+		return "/proto.Recorder/RecordInterceptionCapture", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRecorderServer).
+					RecordInterceptionCapture(
+						ctx,
+						in1.(*RecordInterceptionCaptureRequest),
+					)
+			}, DRPCRecorderServer.RecordInterceptionCapture, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -230,6 +278,38 @@ func (x *drpcRecorder_RecordToolUsageStream) SendAndClose(m *RecordToolUsageResp
 	return x.CloseSend()
 }
 
+type DRPCRecorder_RecordPolicyDecisionStream interface {
+	drpc.Stream
+	SendAndClose(*RecordPolicyDecisionResponse) error
+}
+
+type drpcRecorder_RecordPolicyDecisionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRecorder_RecordPolicyDecisionStream) SendAndClose(m *RecordPolicyDecisionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCRecorder_RecordInterceptionCaptureStream interface {
+	drpc.Stream
+	SendAndClose(*RecordInterceptionCaptureResponse) error
+}
+
+type drpcRecorder_RecordInterceptionCaptureStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRecorder_RecordInterceptionCaptureStream) SendAndClose(m *RecordInterceptionCaptureResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCMCPConfiguratorClient interface {
 	DRPCConn() drpc.Conn
 
@@ -349,6 +429,7 @@ type DRPCAuthorizerClient interface {
 	DRPCConn() drpc.Conn
 
 	IsAuthorized(ctx context.Context, in *IsAuthorizedRequest) (*IsAuthorizedResponse, error)
+	CheckLimits(ctx context.Context, in *CheckLimitsRequest) (*CheckLimitsResponse, error)
 }
 
 type drpcAuthorizerClient struct {
@@ -370,8 +451,18 @@ func (c *drpcAuthorizerClient) IsAuthorized(ctx context.Context, in *IsAuthorize
 	return out, nil
 }
 
+func (c *drpcAuthorizerClient) CheckLimits(ctx context.Context, in *CheckLimitsRequest) (*CheckLimitsResponse, error) {
+	out := new(CheckLimitsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Authorizer/CheckLimits", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCAuthorizerServer interface {
 	IsAuthorized(context.Context, *IsAuthorizedRequest) (*IsAuthorizedResponse, error)
+	CheckLimits(context.Context, *CheckLimitsRequest) (*CheckLimitsResponse, error)
 }
 
 type DRPCAuthorizerUnimplementedServer struct{}
@@ -380,9 +471,13 @@ func (s *DRPCAuthorizerUnimplementedServer) IsAuthorized(context.Context, *IsAut
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCAuthorizerUnimplementedServer) CheckLimits(context.Context, *CheckLimitsRequest) (*CheckLimitsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCAuthorizerDescription struct{}
 
-func (DRPCAuthorizerDescription) NumMethods() int { return 1 }
+func (DRPCAuthorizerDescription) NumMethods() int { return 2 }
 
 func (DRPCAuthorizerDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -395,6 +490,15 @@ func (DRPCAuthorizerDescription) Method(n int) (string, drpc.Encoding, drpc.Rece
 						in1.(*IsAuthorizedRequest),
 					)
 			}, DRPCAuthorizerServer.IsAuthorized, true
+	case 1:
+		return "/proto.Authorizer/CheckLimits", drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuthorizerServer).
+					CheckLimits(
+						ctx,
+						in1.(*CheckLimitsRequest),
+					)
+			}, DRPCAuthorizerServer.CheckLimits, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -419,3 +523,19 @@ func (x *drpcAuthorizer_IsAuthorizedStream) SendAndClose(m *IsAuthorizedResponse
 	}
 	return x.CloseSend()
 }
+
+type DRPCAuthorizer_CheckLimitsStream interface {
+	drpc.Stream
+	SendAndClose(*CheckLimitsResponse) error
+}
+
+type drpcAuthorizer_CheckLimitsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuthorizer_CheckLimitsStream) SendAndClose(m *CheckLimitsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_enterprise_x_aibridged_proto_aibridged_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -32,7 +32,42 @@ func (t *recorderTranslation) RecordInterception(ctx context.Context, req *aibri
 		Metadata:    marshalForProto(req.Metadata),
 		StartedAt:   timestamppb.New(req.StartedAt),
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	setCaptureInterceptionID(ctx, req.ID)
+
+	// Policies are evaluated before the interception exists, so their decisions are carried in the request context
+	// until they can be related to it.
+	return recordPolicyDecisions(ctx, t.client, req.ID, policyDecisionsFromContext(ctx))
+}
+
+// recordPolicyDecisions records the given redactions and blocks against an interception. Allow decisions are not
+// recorded.
+func recordPolicyDecisions(ctx context.Context, client proto.DRPCRecorderClient, interceptionID string, decisions []PolicyDecision) error {
+	for _, decision := range decisions {
+		var action proto.PolicyAction
+		switch decision.Action {
+		case PolicyActionRedact:
+			action = proto.PolicyAction_POLICY_ACTION_REDACT
+		case PolicyActionBlock:
+			action = proto.PolicyAction_POLICY_ACTION_BLOCK
+		default:
+			continue
+		}
+
+		_, err := client.RecordPolicyDecision(ctx, &proto.RecordPolicyDecisionRequest{
+			InterceptionId: interceptionID,
+			Policy:         decision.Policy,
+			Action:         action,
+			Reason:         decision.Reason,
+			CreatedAt:      timestamppb.Now(),
+		})
+		if err != nil {
+			return xerrors.Errorf("record %q policy decision: %w", decision.Policy, err)
+		}
+	}
+	return nil
 }
 
 func (t *recorderTranslation) RecordPromptUsage(ctx context.Context, req *aibridge.PromptUsageRecord) error {
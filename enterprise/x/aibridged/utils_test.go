@@ -1,7 +1,9 @@
 package aibridged_test
 
 import (
+	"io"
 	"net/http"
+	"sync"
 	"sync/atomic"
 )
 
@@ -9,11 +11,19 @@ var _ http.Handler = &mockAIUpstreamServer{}
 
 type mockAIUpstreamServer struct {
 	hitCounter atomic.Int32
+
+	mu       sync.Mutex
+	lastBody []byte
 }
 
-func (m *mockAIUpstreamServer) ServeHTTP(rw http.ResponseWriter, _ *http.Request) {
+func (m *mockAIUpstreamServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	m.hitCounter.Add(1)
 
+	body, _ := io.ReadAll(r.Body)
+	m.mu.Lock()
+	m.lastBody = body
+	m.mu.Unlock()
+
 	rw.WriteHeader(http.StatusTeapot)
 	_, _ = rw.Write([]byte(`i am a teapot`))
 }
@@ -21,3 +31,10 @@ func (m *mockAIUpstreamServer) ServeHTTP(rw http.ResponseWriter, _ *http.Request
 func (m *mockAIUpstreamServer) Hits() int32 {
 	return m.hitCounter.Load()
 }
+
+// LastBody returns the body of the last request received by the server.
+func (m *mockAIUpstreamServer) LastBody() []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastBody
+}
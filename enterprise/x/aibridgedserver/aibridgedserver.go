@@ -6,8 +6,11 @@ import (
 	"crypto/subtle"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"math"
 	"net/url"
 	"slices"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -56,6 +59,8 @@ type store interface {
 	InsertAIBridgeTokenUsage(ctx context.Context, arg database.InsertAIBridgeTokenUsageParams) (database.AIBridgeTokenUsage, error)
 	InsertAIBridgeUserPrompt(ctx context.Context, arg database.InsertAIBridgeUserPromptParams) (database.AIBridgeUserPrompt, error)
 	InsertAIBridgeToolUsage(ctx context.Context, arg database.InsertAIBridgeToolUsageParams) (database.AIBridgeToolUsage, error)
+	InsertAIBridgePolicyDecision(ctx context.Context, arg database.InsertAIBridgePolicyDecisionParams) (database.AIBridgePolicyDecision, error)
+	InsertAIBridgeInterceptionCapture(ctx context.Context, arg database.InsertAIBridgeInterceptionCaptureParams) (database.AIBridgeInterceptionCapture, error)
 
 	// MCPConfigurator-related queries.
 	GetExternalAuthLinksByUserID(ctx context.Context, userID uuid.UUID) ([]database.ExternalAuthLink, error)
@@ -63,6 +68,9 @@ type store interface {
 	// Authorizer-related queries.
 	GetAPIKeyByID(ctx context.Context, id string) (database.APIKey, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (database.User, error)
+	GetWorkspaceByID(ctx context.Context, id uuid.UUID) (database.Workspace, error)
+	GetUserAIBridgeCapture(ctx context.Context, userID uuid.UUID) (string, error)
+	LimitStore
 }
 
 type Server struct {
@@ -73,11 +81,14 @@ type Server struct {
 	store               store
 	logger              slog.Logger
 	externalAuthConfigs map[string]*externalauth.Config
+	// captureMaxBodyBytes is the maximum size of each body captured for users
+	// who have opted in to capture. Capture is disabled if zero.
+	captureMaxBodyBytes int64
 
 	coderMCPConfig *proto.MCPServerConfig // may be nil if not available
 }
 
-func NewServer(lifecycleCtx context.Context, store store, logger slog.Logger, accessURL string, externalAuthConfigs []*externalauth.Config, experiments codersdk.Experiments) (*Server, error) {
+func NewServer(lifecycleCtx context.Context, store store, logger slog.Logger, accessURL string, externalAuthConfigs []*externalauth.Config, experiments codersdk.Experiments, captureMaxBodyBytes int64) (*Server, error) {
 	eac := make(map[string]*externalauth.Config, len(externalAuthConfigs))
 
 	for _, cfg := range externalAuthConfigs {
@@ -98,6 +109,7 @@ func NewServer(lifecycleCtx context.Context, store store, logger slog.Logger, ac
 		store:               store,
 		logger:              logger.Named("aibridgedserver"),
 		externalAuthConfigs: eac,
+		captureMaxBodyBytes: captureMaxBodyBytes,
 		coderMCPConfig:      coderMCPConfig,
 	}, nil
 }
@@ -204,6 +216,76 @@ func (s *Server) RecordToolUsage(ctx context.Context, in *proto.RecordToolUsageR
 	return &proto.RecordToolUsageResponse{}, nil
 }
 
+func (s *Server) RecordPolicyDecision(ctx context.Context, in *proto.RecordPolicyDecisionRequest) (*proto.RecordPolicyDecisionResponse, error) {
+	//nolint:gocritic // AIBridged has specific authz rules.
+	ctx = dbauthz.AsAIBridged(ctx)
+
+	intcID, err := uuid.Parse(in.GetInterceptionId())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse interception_id %q: %w", in.GetInterceptionId(), err)
+	}
+
+	var action database.AIBridgePolicyAction
+	switch in.GetAction() {
+	case proto.PolicyAction_POLICY_ACTION_REDACT:
+		action = database.AIBridgePolicyActionRedact
+	case proto.PolicyAction_POLICY_ACTION_BLOCK:
+		action = database.AIBridgePolicyActionBlock
+	default:
+		return nil, xerrors.Errorf("unsupported policy action %q", in.GetAction().String())
+	}
+
+	_, err = s.store.InsertAIBridgePolicyDecision(ctx, database.InsertAIBridgePolicyDecisionParams{
+		ID:             uuid.New(),
+		InterceptionID: intcID,
+		Policy:         in.GetPolicy(),
+		Action:         action,
+		Reason:         in.GetReason(),
+		CreatedAt:      in.GetCreatedAt().AsTime(),
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("insert policy decision: %w", err)
+	}
+	return &proto.RecordPolicyDecisionResponse{}, nil
+}
+
+func (s *Server) RecordInterceptionCapture(ctx context.Context, in *proto.RecordInterceptionCaptureRequest) (*proto.RecordInterceptionCaptureResponse, error) {
+	//nolint:gocritic // AIBridged has specific authz rules.
+	ctx = dbauthz.AsAIBridged(ctx)
+
+	intcID, err := uuid.Parse(in.GetInterceptionId())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse interception_id %q: %w", in.GetInterceptionId(), err)
+	}
+
+	_, err = s.store.InsertAIBridgeInterceptionCapture(ctx, database.InsertAIBridgeInterceptionCaptureParams{
+		InterceptionID:        intcID,
+		Method:                in.GetMethod(),
+		Path:                  in.GetPath(),
+		RequestHeaders:        headersOrEmpty(in.GetRequestHeaders()),
+		RequestBody:           string(in.GetRequestBody()),
+		RequestBodyTruncated:  in.GetRequestBodyTruncated(),
+		ResponseStatus:        in.GetResponseStatus(),
+		ResponseHeaders:       headersOrEmpty(in.GetResponseHeaders()),
+		ResponseBody:          string(in.GetResponseBody()),
+		ResponseBodyTruncated: in.GetResponseBodyTruncated(),
+		CreatedAt:             in.GetCreatedAt().AsTime(),
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("insert interception capture: %w", err)
+	}
+	return &proto.RecordInterceptionCaptureResponse{}, nil
+}
+
+// headersOrEmpty returns the given JSON-encoded headers, or an empty object if
+// none were given, since captured headers cannot be null.
+func headersOrEmpty(headers []byte) json.RawMessage {
+	if len(headers) == 0 {
+		return json.RawMessage("{}")
+	}
+	return headers
+}
+
 func (s *Server) GetMCPServerConfigs(_ context.Context, _ *proto.GetMCPServerConfigsRequest) (*proto.GetMCPServerConfigsResponse, error) {
 	cfgs := make([]*proto.MCPServerConfig, 0, len(s.externalAuthConfigs))
 	for _, eac := range s.externalAuthConfigs {
@@ -378,8 +460,97 @@ func (s *Server) IsAuthorized(ctx context.Context, in *proto.IsAuthorizedRequest
 		return nil, ErrSystemUser
 	}
 
-	return &proto.IsAuthorizedResponse{
+	resp := &proto.IsAuthorizedResponse{
 		OwnerId: key.UserID.String(),
+	}
+
+	// Keys issued to workspaces identify the workspace they were issued for,
+	// which allows usage to be attributed to its template. Attribution is
+	// best-effort, so the key remains authorized if the lookup fails.
+	if workspaceID, ok := workspaceIDFromTokenName(key.UserID, key.TokenName); ok {
+		workspace, err := s.store.GetWorkspaceByID(ctx, workspaceID)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to retrieve API key workspace", slog.F("key_id", keyID), slog.F("workspace_id", workspaceID), slog.Error(err))
+		} else {
+			resp.WorkspaceId = workspace.ID.String()
+			resp.TemplateId = workspace.TemplateID.String()
+		}
+	}
+
+	// Users may opt in to having their requests captured for debugging.
+	// Capture is best-effort, so the key remains authorized if the lookup
+	// fails.
+	if s.captureMaxBodyBytes > 0 {
+		capture, err := s.store.GetUserAIBridgeCapture(ctx, key.UserID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			s.logger.Warn(ctx, "failed to retrieve user capture setting", slog.F("user_id", key.UserID), slog.Error(err))
+		case capture == "true":
+			resp.CaptureMaxBodyBytes = s.captureMaxBodyBytes
+		}
+	}
+
+	return resp, nil
+}
+
+// workspaceIDFromTokenName extracts the workspace ID from the name of a
+// workspace session token, as produced by
+// provisionerdserver.WorkspaceSessionTokenName.
+func workspaceIDFromTokenName(ownerID uuid.UUID, tokenName string) (uuid.UUID, bool) {
+	rest, ok := strings.CutPrefix(tokenName, ownerID.String()+"_")
+	if !ok {
+		return uuid.Nil, false
+	}
+	rest, ok = strings.CutSuffix(rest, "_session_token")
+	if !ok {
+		return uuid.Nil, false
+	}
+	workspaceID, err := uuid.Parse(rest)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return workspaceID, true
+}
+
+// CheckLimits validates that the given user has not exceeded any of the token budgets or request rate limits which
+// apply to them. If several have been exceeded, the one which will take longest to clear is reported.
+//
+// The check is not atomic with recording the request: concurrent requests are all allowed until one of them has
+// been recorded, so a limit may be overshot by the requests a user makes at the same time. Limits are meant to
+// contain runaway usage rather than to meter it exactly, so this is accepted over serializing every request.
+func (s *Server) CheckLimits(ctx context.Context, in *proto.CheckLimitsRequest) (*proto.CheckLimitsResponse, error) {
+	//nolint:gocritic // AIBridged has specific authz rules.
+	ctx = dbauthz.AsAIBridged(ctx)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return nil, xerrors.Errorf("invalid user ID %q: %w", in.GetUserId(), err)
+	}
+
+	now := dbtime.Now()
+	usages, err := GetLimitUsages(ctx, s.store, userID, now)
+	if err != nil {
+		return nil, xerrors.Errorf("get limit usages: %w", err)
+	}
+
+	var worst *LimitViolation
+	for _, usage := range usages {
+		for _, v := range usage.Violations(now) {
+			if worst == nil || v.RetryAfter > worst.RetryAfter {
+				worst = &v
+			}
+		}
+	}
+	if worst == nil {
+		return &proto.CheckLimitsResponse{}, nil
+	}
+
+	return &proto.CheckLimitsResponse{
+		Exceeded:          true,
+		Kind:              worst.Kind,
+		Message:           worst.Message,
+		RetryAfterSeconds: int64(math.Ceil(worst.RetryAfter.Seconds())),
 	}, nil
 }
 
@@ -21,11 +21,13 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"cdr.dev/slog/sloggers/slogtest"
 	"github.com/coder/coder/v2/coderd/database"
 	"github.com/coder/coder/v2/coderd/database/dbmock"
 	"github.com/coder/coder/v2/coderd/database/dbtime"
 	"github.com/coder/coder/v2/coderd/externalauth"
 	codermcp "github.com/coder/coder/v2/coderd/mcp"
+	"github.com/coder/coder/v2/coderd/provisionerdserver"
 	"github.com/coder/coder/v2/codersdk"
 	"github.com/coder/coder/v2/cryptorand"
 	"github.com/coder/coder/v2/enterprise/x/aibridged"
@@ -44,14 +46,19 @@ var requiredExperiments = []codersdk.Experiment{
 func TestAuthorization(t *testing.T) {
 	t.Parallel()
 
+	workspace := database.Workspace{ID: uuid.New(), TemplateID: uuid.New()}
+
 	cases := []struct {
 		name string
 		// Key will be set to the same key passed to mocksFn if unset.
 		key string
+		// tokenName is set on the API key passed to mocksFn.
+		tokenName func(user database.User) string
 		// mocksFn is called with a valid API key and user. If the test needs
 		// invalid values, it should just mutate them directly.
-		mocksFn     func(db *dbmock.MockStore, apiKey database.APIKey, user database.User)
-		expectedErr error
+		mocksFn            func(db *dbmock.MockStore, apiKey database.APIKey, user database.User)
+		expectedErr        error
+		expectedTemplateID string
 	}{
 		{
 			name:        "invalid key format",
@@ -112,6 +119,41 @@ func TestAuthorization(t *testing.T) {
 				db.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
 			},
 		},
+		{
+			name: "workspace session token",
+			tokenName: func(user database.User) string {
+				return provisionerdserver.WorkspaceSessionTokenName(user.ID, workspace.ID)
+			},
+			mocksFn: func(db *dbmock.MockStore, apiKey database.APIKey, user database.User) {
+				db.EXPECT().GetAPIKeyByID(gomock.Any(), apiKey.ID).Times(1).Return(apiKey, nil)
+				db.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
+				db.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Times(1).Return(workspace, nil)
+			},
+			expectedTemplateID: workspace.TemplateID.String(),
+		},
+		{
+			// Usage cannot be attributed to a template, but the key is still valid.
+			name: "workspace session token unknown workspace",
+			tokenName: func(user database.User) string {
+				return provisionerdserver.WorkspaceSessionTokenName(user.ID, workspace.ID)
+			},
+			mocksFn: func(db *dbmock.MockStore, apiKey database.APIKey, user database.User) {
+				db.EXPECT().GetAPIKeyByID(gomock.Any(), apiKey.ID).Times(1).Return(apiKey, nil)
+				db.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
+				db.EXPECT().GetWorkspaceByID(gomock.Any(), workspace.ID).Times(1).Return(database.Workspace{}, sql.ErrNoRows)
+			},
+		},
+		{
+			// Only the owner's workspaces are considered.
+			name: "workspace session token of another user",
+			tokenName: func(_ database.User) string {
+				return provisionerdserver.WorkspaceSessionTokenName(uuid.New(), workspace.ID)
+			},
+			mocksFn: func(db *dbmock.MockStore, apiKey database.APIKey, user database.User) {
+				db.EXPECT().GetAPIKeyByID(gomock.Any(), apiKey.ID).Times(1).Return(apiKey, nil)
+				db.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -161,6 +203,9 @@ func TestAuthorization(t *testing.T) {
 				Scopes:    []database.APIKeyScope{database.ApiKeyScopeCoderAll},
 				TokenName: "",
 			}
+			if tc.tokenName != nil {
+				apiKey.TokenName = tc.tokenName(user)
+			}
 			if tc.key == "" {
 				tc.key = token
 			}
@@ -170,16 +215,181 @@ func TestAuthorization(t *testing.T) {
 				tc.mocksFn(db, apiKey, user)
 			}
 
-			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, "/", nil, requiredExperiments)
+			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, "/", nil, requiredExperiments, 0)
 			require.NoError(t, err)
 			require.NotNil(t, srv)
 
-			_, err = srv.IsAuthorized(t.Context(), &proto.IsAuthorizedRequest{Key: tc.key})
+			resp, err := srv.IsAuthorized(t.Context(), &proto.IsAuthorizedRequest{Key: tc.key})
 			if tc.expectedErr != nil {
 				require.Error(t, err)
 				require.ErrorIs(t, err, tc.expectedErr)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, user.ID.String(), resp.GetOwnerId())
+				require.Equal(t, tc.expectedTemplateID, resp.GetTemplateId())
+			}
+		})
+	}
+}
+
+// TestAuthorizationCapture validates that capture is only enabled for users
+// who have opted in, and only if the deployment allows it.
+func TestAuthorizationCapture(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name                string
+		captureMaxBodyBytes int64
+		// setting is the user's capture setting, or nil if it has never been set.
+		setting      *string
+		settingErr   error
+		expectedSize int64
+	}{
+		{
+			name:                "disabled by deployment",
+			captureMaxBodyBytes: 0,
+		},
+		{
+			name:                "never set",
+			captureMaxBodyBytes: 1024,
+			settingErr:          sql.ErrNoRows,
+		},
+		{
+			name:                "opted out",
+			captureMaxBodyBytes: 1024,
+			setting:             strPtr("false"),
+		},
+		{
+			name:                "opted in",
+			captureMaxBodyBytes: 1024,
+			setting:             strPtr("true"),
+			expectedSize:        1024,
+		},
+		{
+			// Capture is best-effort, so the key is still authorized.
+			name:                "lookup failed",
+			captureMaxBodyBytes: 1024,
+			settingErr:          sql.ErrConnDone,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			db := dbmock.NewMockStore(ctrl)
+			logger := slogtest.Make(t, &slogtest.Options{IgnoreErrors: true})
+
+			now := dbtime.Now()
+			user := database.User{ID: uuid.New(), Status: database.UserStatusActive}
+			keyID, _ := cryptorand.String(10)
+			keySecret, _ := cryptorand.String(22)
+			keySecretHashed := sha256.Sum256([]byte(keySecret))
+			apiKey := database.APIKey{
+				ID:           keyID,
+				HashedSecret: keySecretHashed[:],
+				UserID:       user.ID,
+				ExpiresAt:    now.Add(time.Hour),
+			}
+
+			db.EXPECT().GetAPIKeyByID(gomock.Any(), apiKey.ID).Times(1).Return(apiKey, nil)
+			db.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
+			if tc.captureMaxBodyBytes > 0 {
+				var setting string
+				if tc.setting != nil {
+					setting = *tc.setting
+				}
+				db.EXPECT().GetUserAIBridgeCapture(gomock.Any(), user.ID).Times(1).Return(setting, tc.settingErr)
+			}
+
+			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, "/", nil, requiredExperiments, tc.captureMaxBodyBytes)
+			require.NoError(t, err)
+
+			// When: the user's key is authorized.
+			resp, err := srv.IsAuthorized(t.Context(), &proto.IsAuthorizedRequest{Key: fmt.Sprintf("%s-%s", keyID, keySecret)})
+
+			// Then: capture is enabled only if both the deployment and the user allow it.
+			require.NoError(t, err)
+			require.Equal(t, user.ID.String(), resp.GetOwnerId())
+			require.Equal(t, tc.expectedSize, resp.GetCaptureMaxBodyBytes())
+		})
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	orgID := uuid.New()
+
+	cases := []struct {
+		name         string
+		limits       []database.AIBridgeLimit
+		usage        map[uuid.UUID]database.GetAIBridgeLimitUsageRow
+		expectedKind proto.LimitKind
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name: "within limits",
+			limits: []database.AIBridgeLimit{
+				{Scope: database.AIBridgeLimitScopeUser, ScopeID: userID, DailyTokenBudget: sql.NullInt64{Int64: 1000, Valid: true}, RequestsPerMinute: sql.NullInt32{Int32: 10, Valid: true}},
+			},
+			usage: map[uuid.UUID]database.GetAIBridgeLimitUsageRow{
+				userID: {DailyTokens: 999, MonthlyTokens: 5000, RecentRequests: 9},
+			},
+		},
+		{
+			name: "requests per minute",
+			limits: []database.AIBridgeLimit{
+				{Scope: database.AIBridgeLimitScopeUser, ScopeID: userID, RequestsPerMinute: sql.NullInt32{Int32: 10, Valid: true}},
+			},
+			usage: map[uuid.UUID]database.GetAIBridgeLimitUsageRow{
+				userID: {RecentRequests: 10},
+			},
+			expectedKind: proto.LimitKind_LIMIT_KIND_REQUESTS_PER_MINUTE,
+		},
+		{
+			// The monthly budget takes longer to replenish, so it is reported.
+			name: "longest retry reported",
+			limits: []database.AIBridgeLimit{
+				{Scope: database.AIBridgeLimitScopeOrganization, ScopeID: orgID, MonthlyTokenBudget: sql.NullInt64{Int64: 10000, Valid: true}},
+				{Scope: database.AIBridgeLimitScopeUser, ScopeID: userID, DailyTokenBudget: sql.NullInt64{Int64: 1000, Valid: true}},
+			},
+			usage: map[uuid.UUID]database.GetAIBridgeLimitUsageRow{
+				orgID:  {DailyTokens: 2000, MonthlyTokens: 10001},
+				userID: {DailyTokens: 1000, MonthlyTokens: 1000},
+			},
+			expectedKind: proto.LimitKind_LIMIT_KIND_MONTHLY_TOKEN_BUDGET,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			db := dbmock.NewMockStore(ctrl)
+			logger := testutil.Logger(t)
+
+			db.EXPECT().GetAIBridgeLimitsByUserID(gomock.Any(), userID).Times(1).Return(tc.limits, nil)
+			db.EXPECT().GetAIBridgeLimitUsage(gomock.Any(), gomock.Any()).Times(len(tc.limits)).DoAndReturn(func(_ context.Context, arg database.GetAIBridgeLimitUsageParams) (database.GetAIBridgeLimitUsageRow, error) {
+				assert.True(t, !arg.MonthStart.After(arg.DayStart), "month starts before day")
+				return tc.usage[arg.ScopeID], nil
+			})
+
+			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, "/", nil, requiredExperiments, 0)
+			require.NoError(t, err)
+
+			resp, err := srv.CheckLimits(t.Context(), &proto.CheckLimitsRequest{UserId: userID.String()})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedKind != proto.LimitKind_LIMIT_KIND_UNSPECIFIED, resp.GetExceeded())
+			require.Equal(t, tc.expectedKind, resp.GetKind())
+			if resp.GetExceeded() {
+				require.NotEmpty(t, resp.GetMessage())
+				require.Positive(t, resp.GetRetryAfterSeconds())
 			}
 		})
 	}
@@ -245,7 +455,7 @@ func TestGetMCPServerConfigs(t *testing.T) {
 			logger := testutil.Logger(t)
 
 			accessURL := "https://my-cool-deployment.com"
-			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, accessURL, tc.externalAuthConfigs, tc.experiments)
+			srv, err := aibridgedserver.NewServer(t.Context(), db, logger, accessURL, tc.externalAuthConfigs, tc.experiments, 0)
 			require.NoError(t, err)
 			require.NotNil(t, srv)
 
@@ -295,7 +505,7 @@ func TestGetMCPServerAccessTokensBatch(t *testing.T) {
 		{
 			ID: "3",
 		},
-	}, requiredExperiments)
+	}, requiredExperiments, 0)
 	require.NoError(t, err)
 	require.NotNil(t, srv)
 
@@ -689,6 +899,180 @@ func TestRecordToolUsage(t *testing.T) {
 	)
 }
 
+func TestRecordPolicyDecision(t *testing.T) {
+	t.Parallel()
+
+	testRecordMethod(t,
+		func(srv *aibridgedserver.Server, ctx context.Context, req *proto.RecordPolicyDecisionRequest) (*proto.RecordPolicyDecisionResponse, error) {
+			return srv.RecordPolicyDecision(ctx, req)
+		},
+		[]testRecordMethodCase[*proto.RecordPolicyDecisionRequest]{
+			{
+				name: "valid block decision",
+				request: &proto.RecordPolicyDecisionRequest{
+					InterceptionId: uuid.NewString(),
+					Policy:         "max_prompt_size",
+					Action:         proto.PolicyAction_POLICY_ACTION_BLOCK,
+					Reason:         "Prompt of 2048 bytes exceeds the maximum of 1024 bytes.",
+					CreatedAt:      timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordPolicyDecisionRequest) {
+					interceptionID, err := uuid.Parse(req.GetInterceptionId())
+					assert.NoError(t, err, "parse interception UUID")
+
+					db.EXPECT().InsertAIBridgePolicyDecision(gomock.Any(), gomock.Cond(func(p database.InsertAIBridgePolicyDecisionParams) bool {
+						if !assert.NotEqual(t, uuid.Nil, p.ID, "ID") ||
+							!assert.Equal(t, interceptionID, p.InterceptionID, "interception ID") ||
+							!assert.Equal(t, req.GetPolicy(), p.Policy, "policy") ||
+							!assert.Equal(t, database.AIBridgePolicyActionBlock, p.Action, "action") ||
+							!assert.Equal(t, req.GetReason(), p.Reason, "reason") ||
+							!assert.WithinDuration(t, req.GetCreatedAt().AsTime(), p.CreatedAt, time.Second, "created at") {
+							return false
+						}
+						return true
+					})).Return(database.AIBridgePolicyDecision{
+						ID:             uuid.New(),
+						InterceptionID: interceptionID,
+						Policy:         req.GetPolicy(),
+						Action:         database.AIBridgePolicyActionBlock,
+						Reason:         req.GetReason(),
+						CreatedAt:      req.GetCreatedAt().AsTime(),
+					}, nil)
+				},
+			},
+			{
+				name: "valid redact decision",
+				request: &proto.RecordPolicyDecisionRequest{
+					InterceptionId: uuid.NewString(),
+					Policy:         "secret_detection",
+					Action:         proto.PolicyAction_POLICY_ACTION_REDACT,
+					Reason:         `Redacted 1 match of "github_token".`,
+					CreatedAt:      timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordPolicyDecisionRequest) {
+					db.EXPECT().InsertAIBridgePolicyDecision(gomock.Any(), gomock.Cond(func(p database.InsertAIBridgePolicyDecisionParams) bool {
+						return assert.Equal(t, database.AIBridgePolicyActionRedact, p.Action, "action")
+					})).Return(database.AIBridgePolicyDecision{}, nil)
+				},
+			},
+			{
+				name: "invalid interception ID",
+				request: &proto.RecordPolicyDecisionRequest{
+					InterceptionId: "not-a-uuid",
+					Policy:         "secret_detection",
+					Action:         proto.PolicyAction_POLICY_ACTION_REDACT,
+					CreatedAt:      timestamppb.Now(),
+				},
+				expectedErr: "failed to parse interception_id",
+			},
+			{
+				name: "unspecified action",
+				request: &proto.RecordPolicyDecisionRequest{
+					InterceptionId: uuid.NewString(),
+					Policy:         "secret_detection",
+					CreatedAt:      timestamppb.Now(),
+				},
+				expectedErr: "unsupported policy action",
+			},
+			{
+				name: "database error",
+				request: &proto.RecordPolicyDecisionRequest{
+					InterceptionId: uuid.NewString(),
+					Policy:         "denied_tools",
+					Action:         proto.PolicyAction_POLICY_ACTION_REDACT,
+					CreatedAt:      timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordPolicyDecisionRequest) {
+					db.EXPECT().InsertAIBridgePolicyDecision(gomock.Any(), gomock.Any()).Return(database.AIBridgePolicyDecision{}, sql.ErrConnDone)
+				},
+				expectedErr: "insert policy decision",
+			},
+		},
+	)
+}
+
+func TestRecordInterceptionCapture(t *testing.T) {
+	t.Parallel()
+
+	testRecordMethod(t,
+		func(srv *aibridgedserver.Server, ctx context.Context, req *proto.RecordInterceptionCaptureRequest) (*proto.RecordInterceptionCaptureResponse, error) {
+			return srv.RecordInterceptionCapture(ctx, req)
+		},
+		[]testRecordMethodCase[*proto.RecordInterceptionCaptureRequest]{
+			{
+				name: "valid capture",
+				request: &proto.RecordInterceptionCaptureRequest{
+					InterceptionId:        uuid.NewString(),
+					Method:                "POST",
+					Path:                  "/openai/v1/chat/completions",
+					RequestHeaders:        []byte(`{"Authorization":["<redacted>"]}`),
+					RequestBody:           []byte(`{"model":"gpt-4.1"}`),
+					ResponseStatus:        200,
+					ResponseHeaders:       []byte(`{"Content-Type":["application/json"]}`),
+					ResponseBody:          []byte(`{"id":"chatcmpl-1"}`),
+					ResponseBodyTruncated: true,
+					CreatedAt:             timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordInterceptionCaptureRequest) {
+					interceptionID, err := uuid.Parse(req.GetInterceptionId())
+					assert.NoError(t, err, "parse interception UUID")
+
+					db.EXPECT().InsertAIBridgeInterceptionCapture(gomock.Any(), gomock.Cond(func(p database.InsertAIBridgeInterceptionCaptureParams) bool {
+						if !assert.Equal(t, interceptionID, p.InterceptionID, "interception ID") ||
+							!assert.Equal(t, req.GetMethod(), p.Method, "method") ||
+							!assert.Equal(t, req.GetPath(), p.Path, "path") ||
+							!assert.JSONEq(t, string(req.GetRequestHeaders()), string(p.RequestHeaders), "request headers") ||
+							!assert.Equal(t, string(req.GetRequestBody()), p.RequestBody, "request body") ||
+							!assert.False(t, p.RequestBodyTruncated, "request body truncated") ||
+							!assert.EqualValues(t, req.GetResponseStatus(), p.ResponseStatus, "response status") ||
+							!assert.JSONEq(t, string(req.GetResponseHeaders()), string(p.ResponseHeaders), "response headers") ||
+							!assert.Equal(t, string(req.GetResponseBody()), p.ResponseBody, "response body") ||
+							!assert.True(t, p.ResponseBodyTruncated, "response body truncated") ||
+							!assert.WithinDuration(t, req.GetCreatedAt().AsTime(), p.CreatedAt, time.Second, "created at") {
+							return false
+						}
+						return true
+					})).Return(database.AIBridgeInterceptionCapture{InterceptionID: interceptionID}, nil)
+				},
+			},
+			{
+				name: "missing headers",
+				request: &proto.RecordInterceptionCaptureRequest{
+					InterceptionId: uuid.NewString(),
+					Method:         "POST",
+					Path:           "/anthropic/v1/messages",
+					CreatedAt:      timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordInterceptionCaptureRequest) {
+					db.EXPECT().InsertAIBridgeInterceptionCapture(gomock.Any(), gomock.Cond(func(p database.InsertAIBridgeInterceptionCaptureParams) bool {
+						return assert.JSONEq(t, "{}", string(p.RequestHeaders), "request headers") &&
+							assert.JSONEq(t, "{}", string(p.ResponseHeaders), "response headers")
+					})).Return(database.AIBridgeInterceptionCapture{}, nil)
+				},
+			},
+			{
+				name: "invalid interception ID",
+				request: &proto.RecordInterceptionCaptureRequest{
+					InterceptionId: "not-a-uuid",
+					CreatedAt:      timestamppb.Now(),
+				},
+				expectedErr: "failed to parse interception_id",
+			},
+			{
+				name: "database error",
+				request: &proto.RecordInterceptionCaptureRequest{
+					InterceptionId: uuid.NewString(),
+					CreatedAt:      timestamppb.Now(),
+				},
+				setupMocks: func(t *testing.T, db *dbmock.MockStore, req *proto.RecordInterceptionCaptureRequest) {
+					db.EXPECT().InsertAIBridgeInterceptionCapture(gomock.Any(), gomock.Any()).Return(database.AIBridgeInterceptionCapture{}, sql.ErrConnDone)
+				},
+				expectedErr: "insert interception capture",
+			},
+		},
+	)
+}
+
 type testRecordMethodCase[Req any] struct {
 	name    string
 	request Req
@@ -718,7 +1102,7 @@ func testRecordMethod[Req any, Resp any](
 			}
 
 			ctx := testutil.Context(t, testutil.WaitLong)
-			srv, err := aibridgedserver.NewServer(ctx, db, logger, "/", nil, requiredExperiments)
+			srv, err := aibridgedserver.NewServer(ctx, db, logger, "/", nil, requiredExperiments, 0)
 			require.NoError(t, err)
 
 			resp, err := callMethod(srv, ctx, tc.request)
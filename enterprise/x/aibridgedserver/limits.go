@@ -0,0 +1,109 @@
+package aibridgedserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/xerrors"
+
+	"github.com/coder/coder/v2/coderd/database"
+	"github.com/coder/coder/v2/enterprise/x/aibridged/proto"
+)
+
+// LimitStore is the subset of [database.Store] required to evaluate AI Bridge limits.
+type LimitStore interface {
+	GetAIBridgeLimitsByUserID(ctx context.Context, userID uuid.UUID) ([]database.AIBridgeLimit, error)
+	GetAIBridgeLimitUsage(ctx context.Context, arg database.GetAIBridgeLimitUsageParams) (database.GetAIBridgeLimitUsageRow, error)
+}
+
+// LimitUsage is a limit which applies to a user, along with the usage which
+// has been counted against it. Usage of group and organization limits is pooled
+// across all of their members.
+type LimitUsage struct {
+	Limit database.AIBridgeLimit
+	Usage database.GetAIBridgeLimitUsageRow
+
+	// DayResetsAt and MonthResetsAt are when the daily and monthly token
+	// budgets are next replenished.
+	DayResetsAt   time.Time
+	MonthResetsAt time.Time
+}
+
+// LimitViolation describes a limit which has been exceeded.
+type LimitViolation struct {
+	Kind       proto.LimitKind
+	Message    string
+	RetryAfter time.Duration
+}
+
+// GetLimitUsages returns every limit which applies to the given user along with
+// its current usage. Token budgets are counted per UTC day and calendar month,
+// and requests are counted over the minute preceding now.
+func GetLimitUsages(ctx context.Context, store LimitStore, userID uuid.UUID, now time.Time) ([]LimitUsage, error) {
+	limits, err := store.GetAIBridgeLimitsByUserID(ctx, userID)
+	if err != nil {
+		return nil, xerrors.Errorf("get limits: %w", err)
+	}
+
+	usages := make([]LimitUsage, 0, len(limits))
+	for _, limit := range limits {
+		params := LimitUsageParams(limit.Scope, limit.ScopeID, now)
+		usage, err := store.GetAIBridgeLimitUsage(ctx, params)
+		if err != nil {
+			return nil, xerrors.Errorf("get usage of %s limit %q: %w", limit.Scope, limit.ScopeID, err)
+		}
+		usages = append(usages, LimitUsage{
+			Limit:         limit,
+			Usage:         usage,
+			DayResetsAt:   params.DayStart.AddDate(0, 0, 1),
+			MonthResetsAt: params.MonthStart.AddDate(0, 1, 0),
+		})
+	}
+	return usages, nil
+}
+
+// LimitUsageParams returns the parameters with which to count the usage of the
+// given user, group or organization over the windows which contain now.
+func LimitUsageParams(scope database.AIBridgeLimitScope, scopeID uuid.UUID, now time.Time) database.GetAIBridgeLimitUsageParams {
+	now = now.UTC()
+	return database.GetAIBridgeLimitUsageParams{
+		ScopeID:     scopeID,
+		Scope:       scope,
+		DayStart:    time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		MinuteStart: now.Add(-time.Minute),
+		MonthStart:  time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// Violations returns every part of the limit which has been exceeded as of now.
+// A budget is exceeded once the tokens used reach it, since a request in flight
+// may use any number of further tokens.
+func (u LimitUsage) Violations(now time.Time) []LimitViolation {
+	var violations []LimitViolation
+	subject := fmt.Sprintf("%s %s", u.Limit.Scope, u.Limit.ScopeID)
+	if u.Limit.DailyTokenBudget.Valid && u.Usage.DailyTokens >= u.Limit.DailyTokenBudget.Int64 {
+		violations = append(violations, LimitViolation{
+			Kind:       proto.LimitKind_LIMIT_KIND_DAILY_TOKEN_BUDGET,
+			Message:    fmt.Sprintf("The daily token budget of %d tokens for %s has been used.", u.Limit.DailyTokenBudget.Int64, subject),
+			RetryAfter: u.DayResetsAt.Sub(now),
+		})
+	}
+	if u.Limit.MonthlyTokenBudget.Valid && u.Usage.MonthlyTokens >= u.Limit.MonthlyTokenBudget.Int64 {
+		violations = append(violations, LimitViolation{
+			Kind:       proto.LimitKind_LIMIT_KIND_MONTHLY_TOKEN_BUDGET,
+			Message:    fmt.Sprintf("The monthly token budget of %d tokens for %s has been used.", u.Limit.MonthlyTokenBudget.Int64, subject),
+			RetryAfter: u.MonthResetsAt.Sub(now),
+		})
+	}
+	if u.Limit.RequestsPerMinute.Valid && u.Usage.RecentRequests >= int64(u.Limit.RequestsPerMinute.Int32) {
+		violations = append(violations, LimitViolation{
+			Kind:    proto.LimitKind_LIMIT_KIND_REQUESTS_PER_MINUTE,
+			Message: fmt.Sprintf("The rate limit of %d requests per minute for %s has been reached.", u.Limit.RequestsPerMinute.Int32, subject),
+			// The oldest request counted leaves the window within a minute.
+			RetryAfter: time.Minute,
+		})
+	}
+	return violations
+}
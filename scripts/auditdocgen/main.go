@@ -118,10 +118,17 @@ func updateAuditDoc(doc []byte, auditableResourcesMap AuditableResourcesMap) ([]
 
 	for _, resourceName := range sortedResourceNames {
 		readableResourceName := resourceName
+		switch resourceName {
 		// AuditableGroup is really a combination of Group and GroupMember resources
 		// but we use the label 'Group' in our docs to avoid confusion.
-		if resourceName == "AuditableGroup" {
+		case "AuditableGroup":
 			readableResourceName = "Group"
+		// These include their parameters and variables, but are documented
+		// under the resource name.
+		case "AuditableTemplateVersion":
+			readableResourceName = "TemplateVersion"
+		case "AuditableWorkspaceBuild":
+			readableResourceName = "WorkspaceBuild"
 		}
 
 		// Create a string of audit actions for each resource
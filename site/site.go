@@ -1125,6 +1125,9 @@ type RenderOAuthAllowData struct {
 	CancelURI   string
 	RedirectURI string
 	Username    string
+	// Scopes are the API key scopes requested by the app. The app is
+	// requesting full access if empty.
+	Scopes []string
 }
 
 // RenderOAuthAllowPage renders the static page for a user to "Allow" an create
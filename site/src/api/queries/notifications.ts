@@ -30,6 +30,9 @@ export const updateUserNotificationPreferences = (
 			return API.putUserNotificationPreferences(userId, req);
 		},
 		onMutate: (data) => {
+			const previous = queryClient.getQueryData<NotificationPreference[]>(
+				userNotificationPreferencesKey(userId),
+			);
 			queryClient.setQueryData(
 				userNotificationPreferencesKey(userId),
 				Object.entries(data.template_disabled_map).map(
@@ -37,6 +40,7 @@ export const updateUserNotificationPreferences = (
 						({
 							id,
 							disabled,
+							digest: previous?.find((p) => p.id === id)?.digest ?? false,
 							updated_at: new Date().toISOString(),
 						}) satisfies NotificationPreference,
 				),
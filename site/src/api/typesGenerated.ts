@@ -16,11 +16,97 @@ export interface AIBridgeAnthropicConfig {
 	readonly key: string;
 }
 
+// From codersdk/deployment.go
+/**
+ * AIBridgeCaptureConfig configures the capture of full requests and responses
+ * for users who have opted in. See AIBridgeUserCaptureSettings.
+ */
+export interface AIBridgeCaptureConfig {
+	readonly max_body_bytes: number;
+}
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeCaptureRedactedValue replaces the values of captured headers which
+ * carry credentials.
+ */
+export const AIBridgeCaptureRedactedValue = "<redacted>";
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeCaptureSettings controls whether the full requests and responses
+ * bridged for a user are captured for debugging.
+ */
+export interface AIBridgeCaptureSettings {
+	readonly enabled: boolean;
+}
+
 // From codersdk/deployment.go
 export interface AIBridgeConfig {
 	readonly enabled: boolean;
 	readonly openai: AIBridgeOpenAIConfig;
 	readonly anthropic: AIBridgeAnthropicConfig;
+	readonly policy: AIBridgePolicyConfig;
+	readonly capture: AIBridgeCaptureConfig;
+}
+
+// From codersdk/aibridge.go
+export type AIBridgeInsightsGroupBy =
+	| "model"
+	| "provider"
+	| "template"
+	| "user";
+
+export const AIBridgeInsightsGroupBys: AIBridgeInsightsGroupBy[] = [
+	"model",
+	"provider",
+	"template",
+	"user",
+];
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeInsightsRequest requests AI Bridge usage between two times, which
+ * must be on the hour. Usage is grouped by every dimension if GroupBy is empty.
+ */
+export interface AIBridgeInsightsRequest {
+	readonly start_time: string;
+	readonly end_time: string;
+	readonly group_by: readonly AIBridgeInsightsGroupBy[];
+}
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeInsightsResponse is AI Bridge usage along with its estimated cost.
+ * Tokens of models which have no price are counted as unpriced rather than
+ * estimated.
+ */
+export interface AIBridgeInsightsResponse {
+	readonly start_time: string;
+	readonly end_time: string;
+	readonly group_by: readonly AIBridgeInsightsGroupBy[];
+	readonly rows: readonly AIBridgeInsightsRow[];
+	readonly total: AIBridgeInsightsRow;
+}
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeInsightsRow is the usage of one group. Fields for dimensions which
+ * were not grouped by are left empty, and usage made outside of a workspace
+ * has the nil template ID.
+ */
+export interface AIBridgeInsightsRow {
+	readonly user_id: string;
+	readonly username: string;
+	readonly template_id: string;
+	readonly template_name: string;
+	readonly provider: string;
+	readonly model: string;
+	readonly requests: number;
+	readonly input_tokens: number;
+	readonly output_tokens: number;
+	readonly estimated_cost: number;
+	readonly unpriced_tokens: number;
 }
 
 // From codersdk/aibridge.go
@@ -35,6 +121,67 @@ export interface AIBridgeInterception {
 	readonly token_usages: readonly AIBridgeTokenUsage[];
 	readonly user_prompts: readonly AIBridgeUserPrompt[];
 	readonly tool_usages: readonly AIBridgeToolUsage[];
+	readonly policy_decisions: readonly AIBridgePolicyDecision[];
+}
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeInterceptionCapture is the request which started an interception and
+ * the response to it, as bridged. Credentials and well-known secrets are
+ * redacted, and bodies are truncated to the deployment's maximum size.
+ */
+export interface AIBridgeInterceptionCapture {
+	readonly interception_id: string;
+	readonly method: string;
+	readonly path: string;
+	readonly request_headers: Record<string, string[]>;
+	readonly request_body: string;
+	readonly request_body_truncated: boolean;
+	readonly response_status: number;
+	readonly response_headers: Record<string, string[]>;
+	readonly response_body: string;
+	readonly response_body_truncated: boolean;
+	readonly created_at: string;
+}
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeLimit is a set of token budgets and request rate limits enforced by
+ * AI Bridge. Group and organization limits are shared by all of their members.
+ * A nil limit is unlimited.
+ */
+export interface AIBridgeLimit {
+	readonly scope: AIBridgeLimitScope;
+	readonly scope_id: string;
+	readonly daily_token_budget?: number;
+	readonly monthly_token_budget?: number;
+	readonly requests_per_minute?: number;
+	readonly created_at: string;
+	readonly updated_at: string;
+}
+
+// From codersdk/aibridge.go
+export type AIBridgeLimitScope = "group" | "organization" | "user";
+
+export const AIBridgeLimitScopes: AIBridgeLimitScope[] = [
+	"group",
+	"organization",
+	"user",
+];
+
+// From codersdk/aibridge.go
+/**
+ * AIBridgeLimitUsage is a limit along with the usage counted against it.
+ * Token budgets are counted per UTC day and calendar month.
+ */
+export interface AIBridgeLimitUsage {
+	readonly limit: AIBridgeLimit;
+	readonly daily_tokens: number;
+	readonly monthly_tokens: number;
+	readonly requests_last_minute: number;
+	readonly daily_resets_at: string;
+	readonly monthly_resets_at: string;
+	readonly exceeded: boolean;
 }
 
 // From codersdk/aibridge.go
@@ -42,12 +189,65 @@ export interface AIBridgeListInterceptionsResponse {
 	readonly results: readonly AIBridgeInterception[];
 }
 
+// From codersdk/aibridge.go
+/**
+ * AIBridgeModelPrice is the price of a model's tokens, used to estimate the
+ * cost of AI Bridge usage. A model of "*" prices every model of the provider
+ * which has no price of its own. Prices are in whichever currency the
+ * deployment chooses.
+ */
+export interface AIBridgeModelPrice {
+	readonly provider: string;
+	readonly model: string;
+	readonly input_price_per_million_tokens: number;
+	readonly output_price_per_million_tokens: number;
+}
+
 // From codersdk/deployment.go
 export interface AIBridgeOpenAIConfig {
 	readonly base_url: string;
 	readonly key: string;
 }
 
+// From codersdk/aibridge.go
+export type AIBridgePolicyAction = "block" | "redact";
+
+export const AIBridgePolicyActions: AIBridgePolicyAction[] = [
+	"block",
+	"redact",
+];
+
+// From codersdk/deployment.go
+/**
+ * AIBridgePolicyConfig configures the content policies which AI Bridge applies
+ * to requests before they are forwarded to providers.
+ */
+export interface AIBridgePolicyConfig {
+	readonly secret_detection: string;
+	readonly secret_patterns: string;
+	readonly denied_tools: string;
+	readonly max_prompt_bytes: number;
+}
+
+// From codersdk/aibridge.go
+export interface AIBridgePolicyDecision {
+	readonly id: string;
+	readonly interception_id: string;
+	readonly policy: string;
+	readonly action: AIBridgePolicyAction;
+	readonly reason: string;
+	readonly created_at: string;
+}
+
+// From codersdk/deployment.go
+export type AIBridgeSecretDetection = "block" | "off" | "redact";
+
+export const AIBridgeSecretDetections: AIBridgeSecretDetection[] = [
+	"block",
+	"off",
+	"redact",
+];
+
 // From codersdk/aibridge.go
 export interface AIBridgeTokenUsage {
 	readonly id: string;
@@ -75,6 +275,19 @@ export interface AIBridgeToolUsage {
 	readonly created_at: string;
 }
 
+// From codersdk/aibridge.go
+/**
+ * AIBridgeUsage is a user's own AI Bridge usage, along with every limit which
+ * applies to them.
+ */
+export interface AIBridgeUsage {
+	readonly user_id: string;
+	readonly daily_tokens: number;
+	readonly monthly_tokens: number;
+	readonly requests_last_minute: number;
+	readonly limits: readonly AIBridgeLimitUsage[];
+}
+
 // From codersdk/aibridge.go
 export interface AIBridgeUserPrompt {
 	readonly id: string;
@@ -89,6 +302,7 @@ export interface AIBridgeUserPrompt {
 // From codersdk/deployment.go
 export interface AIConfig {
 	readonly bridge?: AIBridgeConfig;
+	readonly tasks?: AITasksConfig;
 }
 
 // From codersdk/aitasks.go
@@ -100,6 +314,17 @@ export interface AIConfig {
  */
 export const AITaskPromptParameterName = "AI Prompt";
 
+// From codersdk/deployment.go
+/**
+ * AITasksConfig holds the lifecycle policies which are applied to the
+ * workspaces of AI tasks. A zero value disables the policy.
+ */
+export interface AITasksConfig {
+	readonly idle_stop_after: number;
+	readonly retention_period: number;
+	readonly max_running_per_user: number;
+}
+
 // From codersdk/aitasks.go
 /**
  * AITasksPromptsResponse represents the response from the AITaskPrompts method.
@@ -660,6 +885,7 @@ export type AuditAction =
 	| "create"
 	| "delete"
 	| "disconnect"
+	| "invoke"
 	| "login"
 	| "logout"
 	| "open"
@@ -675,6 +901,7 @@ export const AuditActions: AuditAction[] = [
 	"create",
 	"delete",
 	"disconnect",
+	"invoke",
 	"login",
 	"logout",
 	"open",
@@ -726,12 +953,105 @@ export interface AuditLog {
 	readonly user: User | null;
 }
 
+// From codersdk/audit.go
+/**
+ * AuditLogBrokenLink is the first hash chain entry that failed verification.
+ */
+export interface AuditLogBrokenLink {
+	readonly seq: number;
+	readonly audit_log_id: string;
+	readonly reason: string;
+}
+
+// From codersdk/audit.go
+/**
+ * AuditLogPurgedRange is a range of hash chain entries, inclusive, whose audit
+ * logs were purged.
+ */
+export interface AuditLogPurgedRange {
+	readonly first_seq: number;
+	readonly last_seq: number;
+}
+
 // From codersdk/audit.go
 export interface AuditLogResponse {
 	readonly audit_logs: readonly AuditLog[];
 	readonly count: number;
 }
 
+// From codersdk/audit.go
+/**
+ * AuditLogVerifyResponse is the result of verifying the audit log hash chain.
+ */
+export interface AuditLogVerifyResponse {
+	/**
+	 * Verified is true if every entry in the hash chain is intact.
+	 */
+	readonly verified: boolean;
+	/**
+	 * Entries is the number of hash chain entries that were checked.
+	 */
+	readonly entries: number;
+	readonly first_seq: number;
+	readonly last_seq: number;
+	/**
+	 * LastHash is the hex-encoded hash of the last intact entry. Recording it
+	 * elsewhere allows detecting audit logs removed from the end of the chain.
+	 */
+	readonly last_hash: string;
+	readonly broken_link?: AuditLogBrokenLink;
+	/**
+	 * Purged lists the entries whose audit logs were purged, which were
+	 * verified from their checkpoints rather than their audit logs. Purges
+	 * that retention does not account for should be investigated.
+	 */
+	readonly purged: readonly AuditLogPurgedRange[];
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingConfig {
+	/**
+	 * HashChain appends every audit log to a tamper-evident hash chain.
+	 */
+	readonly hash_chain: boolean;
+	readonly syslog: AuditLoggingSyslogConfig;
+	readonly http: AuditLoggingHTTPConfig;
+	readonly file: AuditLoggingFileConfig;
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingFileConfig {
+	readonly path: string;
+	/**
+	 * MaxSize is the size in megabytes at which the file is rotated.
+	 */
+	readonly max_size: number;
+	readonly max_backups: number;
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingHTTPConfig {
+	readonly endpoint: string;
+	/**
+	 * Headers are formatted as "Name: value".
+	 */
+	readonly headers: string;
+	readonly batch_size: number;
+	readonly flush_interval: number;
+	readonly buffer_dir: string;
+	readonly max_buffered_events: number;
+}
+
+// From codersdk/deployment.go
+export interface AuditLoggingSyslogConfig {
+	/**
+	 * Address is the host:port of the syslog receiver.
+	 */
+	readonly address: string;
+	readonly tls: boolean;
+	readonly tls_ca_file: string;
+}
+
 // From codersdk/audit.go
 export interface AuditLogsRequest extends Pagination {
 	readonly q?: string;
@@ -835,6 +1155,40 @@ export type AutomaticUpdates = "always" | "never";
 
 export const AutomaticUpdateses: AutomaticUpdates[] = ["always", "never"];
 
+// From codersdk/autostartexceptions.go
+/**
+ * AutostartException is a named range of days on which workspaces are not
+ * autostarted, such as a public holiday or a company shutdown. Dates are
+ * interpreted in the timezone of each workspace's autostart schedule.
+ */
+export interface AutostartException {
+	readonly name: string;
+	/**
+	 * StartDate is the first day of the exception, formatted as YYYY-MM-DD.
+	 */
+	readonly start_date: string;
+	/**
+	 * EndDate is the last day of the exception (inclusive), formatted as
+	 * YYYY-MM-DD.
+	 */
+	readonly end_date: string;
+}
+
+// From codersdk/autostartexceptions.go
+/**
+ * AutostartExceptionCalendar is a named set of autostart exceptions that
+ * applies to every workspace in an organization.
+ */
+export interface AutostartExceptionCalendar {
+	readonly id: string;
+	readonly organization_id: string;
+	readonly name: string;
+	readonly description: string;
+	readonly exceptions: readonly AutostartException[];
+	readonly created_at: string;
+	readonly updated_at: string;
+}
+
 // From codersdk/deployment.go
 /**
  * AvailableExperiments is an expandable type that returns all safe experiments
@@ -913,6 +1267,7 @@ export interface BuildInfoResponse {
 
 // From codersdk/workspacebuilds.go
 export type BuildReason =
+	| "autodelete"
 	| "autostart"
 	| "autostop"
 	| "cli"
@@ -921,9 +1276,12 @@ export type BuildReason =
 	| "initiator"
 	| "jetbrains_connection"
 	| "ssh_connection"
+	| "task_autodelete"
+	| "task_autostop"
 	| "vscode_connection";
 
 export const BuildReasons: BuildReason[] = [
+	"autodelete",
 	"autostart",
 	"autostop",
 	"cli",
@@ -932,6 +1290,8 @@ export const BuildReasons: BuildReason[] = [
 	"initiator",
 	"jetbrains_connection",
 	"ssh_connection",
+	"task_autodelete",
+	"task_autostop",
 	"vscode_connection",
 ];
 
@@ -1103,6 +1463,13 @@ export const ConnectionTypes: ConnectionType[] = [
 	"workspace_app",
 ];
 
+// From codersdk/connectionlog.go
+/**
+ * ContentTypeAsciicast is the content type of session recordings, which are
+ * stored in the asciicast v2 format.
+ */
+export const ContentTypeAsciicast = "application/x-asciicast";
+
 // From codersdk/files.go
 export const ContentTypeTar = "application/x-tar";
 
@@ -1156,6 +1523,17 @@ export interface CreateGroupRequest {
 	readonly quota_allowance: number;
 }
 
+// From codersdk/notifications.go
+export interface CreateNotificationUserTargetRequest {
+	readonly name: string;
+	readonly type: NotificationUserTargetType;
+	readonly endpoint: string;
+	/**
+	 * Secret is used to sign webhook deliveries with an HMAC-SHA256 signature. It is optional.
+	 */
+	readonly secret?: string;
+}
+
 // From codersdk/organizations.go
 export interface CreateOrganizationRequest {
 	readonly name: string;
@@ -1189,6 +1567,33 @@ export interface CreateTaskRequest {
 	readonly template_version_preset_id?: string;
 	readonly input: string;
 	readonly name?: string;
+	/**
+	 * Steps defines a multi-step task. The first step's prompt starts the
+	 * task, and each following prompt is sent once the agent reports the
+	 * previous step idle. Input must be empty when steps are given.
+	 */
+	readonly steps?: readonly CreateTaskStep[];
+	/**
+	 * RichParameterValues are the values of the template's parameters other
+	 * than the AI prompt, which is taken from the input.
+	 */
+	readonly rich_parameter_values?: readonly WorkspaceBuildParameter[];
+}
+
+// From codersdk/aitasks.go
+/**
+ * CreateTaskStep is a single step of a multi-step task.
+ *
+ * Experimental: This type is experimental and may change in the future.
+ */
+export interface CreateTaskStep {
+	readonly prompt: string;
+	/**
+	 * CompletionCheck is an optional command which is run in the workspace
+	 * once the agent reports the step idle. A non-zero exit code fails the
+	 * task instead of moving on to the next step.
+	 */
+	readonly completion_check?: string;
 }
 
 // From codersdk/organizations.go
@@ -1714,6 +2119,7 @@ export interface DeploymentValues {
 	 */
 	readonly http_address?: string;
 	readonly autobuild_poll_interval?: number;
+	readonly autobuild_dry_run?: boolean;
 	readonly job_hang_detector_interval?: number;
 	readonly derp?: DERP;
 	readonly prometheus?: PrometheusConfig;
@@ -1768,6 +2174,9 @@ export interface DeploymentValues {
 	readonly workspace_prebuilds?: PrebuildsConfig;
 	readonly hide_ai_tasks?: boolean;
 	readonly ai?: AIConfig;
+	readonly audit_logging?: AuditLoggingConfig;
+	readonly retention?: RetentionConfig;
+	readonly session_recording_max_size?: number;
 	readonly config?: string;
 	readonly write_config?: boolean;
 	/**
@@ -2696,6 +3105,15 @@ export interface NotificationMethodsResponse {
 export interface NotificationPreference {
 	readonly id: string;
 	readonly disabled: boolean;
+	/**
+	 * UserTargetID is the user-defined target which notifications from this template are delivered to, instead of
+	 * the template's or deployment's notification method.
+	 */
+	readonly user_target_id?: string;
+	/**
+	 * Digest is true when notifications from this template are held and delivered as a single digest.
+	 */
+	readonly digest: boolean;
 	readonly updated_at: string;
 }
 
@@ -2710,6 +3128,118 @@ export interface NotificationTemplate {
 	readonly method: string;
 	readonly kind: string;
 	readonly enabled_by_default: boolean;
+	/**
+	 * TitleTemplateOverride is this deployment's customization of the title, which is used instead of TitleTemplate
+	 * when set.
+	 */
+	readonly title_template_override?: string;
+	/**
+	 * BodyTemplateOverride is this deployment's customization of the body, which is used instead of BodyTemplate when
+	 * set.
+	 */
+	readonly body_template_override?: string;
+	/**
+	 * Urgent notifications are delivered immediately, even during the recipient's quiet hours or while they have
+	 * snoozed notifications.
+	 */
+	readonly urgent: boolean;
+}
+
+// From codersdk/notifications.go
+export interface NotificationTemplatePreview {
+	/**
+	 * Title is the rendered title as plain text.
+	 */
+	readonly title: string;
+	/**
+	 * Body is the rendered body as markdown.
+	 */
+	readonly body: string;
+	/**
+	 * BodyHTML is the rendered body converted to HTML, as used in emails.
+	 */
+	readonly body_html: string;
+}
+
+// From codersdk/notifications.go
+/**
+ * NotificationTemplatePreviewRequest renders a notification template against sample data.
+ */
+export interface NotificationTemplatePreviewRequest {
+	/**
+	 * TitleTemplate is rendered instead of the template's current title when set, so that changes can be previewed
+	 * before they are saved.
+	 */
+	readonly title_template?: string;
+	/**
+	 * BodyTemplate is rendered instead of the template's current body when set.
+	 */
+	readonly body_template?: string;
+	/**
+	 * Labels are substituted into the sample payload. Labels which are referenced by the template but not given are
+	 * filled with a placeholder of the form "[name]".
+	 */
+	readonly labels?: Record<string, string>;
+	// empty interface{} type, falling back to unknown
+	readonly data?: Record<string, unknown>;
+}
+
+// From codersdk/notifications.go
+/**
+ * NotificationTemplateTestRequest sends a notification template to the requesting user.
+ */
+export interface NotificationTemplateTestRequest {
+	/**
+	 * Method is the dispatch method to deliver the test message through. It must be enabled for this deployment.
+	 * Defaults to the deployment's notification method.
+	 */
+	readonly method?: string;
+	readonly labels?: Record<string, string>;
+	// empty interface{} type, falling back to unknown
+	readonly data?: Record<string, unknown>;
+}
+
+// From codersdk/notifications.go
+/**
+ * NotificationUserTarget is a webhook or chat endpoint defined by a user, to which their notifications can be delivered.
+ */
+export interface NotificationUserTarget {
+	readonly id: string;
+	readonly user_id: string;
+	readonly name: string;
+	readonly type: NotificationUserTargetType;
+	readonly endpoint: string;
+	/**
+	 * HasSecret indicates whether deliveries to this target are signed. The secret itself is never returned.
+	 */
+	readonly has_secret: boolean;
+	readonly created_at: string;
+	readonly updated_at: string;
+}
+
+// From codersdk/notifications.go
+export type NotificationUserTargetType = "slack" | "webhook";
+
+export const NotificationUserTargetTypes: NotificationUserTargetType[] = [
+	"slack",
+	"webhook",
+];
+
+// From codersdk/notifications.go
+/**
+ * NotificationWebhookSecret is a shared secret used to sign deliveries to the deployment's notification webhook.
+ */
+export interface NotificationWebhookSecret {
+	readonly id: string;
+	/**
+	 * Secret is only returned once, in response to a rotation.
+	 */
+	readonly secret?: string;
+	readonly created_at: string;
+	/**
+	 * ExpiresAt is unset for the active secret. A rotated secret continues to sign deliveries until this time.
+	 */
+	readonly expires_at?: string;
 }
 
 // From codersdk/deployment.go
@@ -2760,6 +3290,18 @@ export interface NotificationsConfig {
 	 * How long to wait while a notification is being sent before giving up.
 	 */
 	readonly dispatch_timeout: number;
+	/**
+	 * How long to hold notifications which users have chosen to receive as a digest.
+	 */
+	readonly digest_window: number;
+	/**
+	 * How long each user's quiet hours last, from the start of their quiet hours schedule.
+	 */
+	readonly quiet_hours_duration: number;
+	/**
+	 * Whether users' personal notification targets may deliver to loopback, link-local and private network addresses.
+	 */
+	readonly user_targets_allow_private_networks: boolean;
 	/**
 	 * SMTP settings.
 	 */
@@ -3406,6 +3948,16 @@ export interface PprofConfig {
 	readonly address: string;
 }
 
+// From codersdk/insights.go
+/**
+ * PrebuildInsightsPercentiles shows the median and 95th percentile of a
+ * measurement.
+ */
+export interface PrebuildInsightsPercentiles {
+	readonly p50: number;
+	readonly p95: number;
+}
+
 // From codersdk/deployment.go
 export interface PrebuildsConfig {
 	/**
@@ -3429,6 +3981,48 @@ export interface PrebuildsConfig {
 	 * FailureHardLimit is disabled when set to zero.
 	 */
 	readonly failure_hard_limit: number;
+	/**
+	 * AutoscalingWindow is the time window over which claims of prebuilt workspaces are
+	 * observed to size prebuild pools by demand. Autoscaling is disabled when set to zero.
+	 */
+	readonly autoscaling_window: number;
+	/**
+	 * AutoscalingTargetHitProbability is the probability with which a claim should find an
+	 * eligible prebuilt workspace when pools are sized by demand.
+	 */
+	readonly autoscaling_target_hit_probability: number;
+	/**
+	 * AutoscalingMaxInstances is the maximum number of prebuilt workspaces kept for a preset
+	 * when pools are sized by demand. Presets configured with more instances keep their count.
+	 */
+	readonly autoscaling_max_instances: number;
+}
+
+// From codersdk/insights.go
+/**
+ * PrebuildsInsightsReport is the report from the prebuilds insights endpoint.
+ */
+export interface PrebuildsInsightsReport {
+	readonly start_time: string;
+	readonly end_time: string;
+	readonly template_ids: readonly string[];
+	readonly presets: readonly PresetPrebuildInsights[];
+}
+
+// From codersdk/insights.go
+export interface PrebuildsInsightsRequest {
+	readonly start_time: string;
+	readonly end_time: string;
+	readonly template_ids: readonly string[];
+}
+
+// From codersdk/insights.go
+/**
+ * PrebuildsInsightsResponse is the response from the prebuilds insights
+ * endpoint.
+ */
+export interface PrebuildsInsightsResponse {
+	readonly report: PrebuildsInsightsReport;
 }
 
 // From codersdk/prebuilds.go
@@ -3453,6 +4047,46 @@ export interface PresetParameter {
 	readonly Value: string;
 }
 
+// From codersdk/insights.go
+/**
+ * PresetPrebuildInsights shows how often and how quickly the workspaces created
+ * with a preset were served from prebuilt workspaces. Percentiles are -1 when
+ * there is nothing to measure, e.g. when no workspace was served from a
+ * prebuilt workspace or none of their agents became ready.
+ */
+export interface PresetPrebuildInsights {
+	readonly template_id: string;
+	readonly template_name: string;
+	readonly template_display_name: string;
+	readonly organization_name: string;
+	readonly preset_name: string;
+	/**
+	 * Workspaces is the number of workspaces created with the preset.
+	 */
+	readonly workspaces: number;
+	/**
+	 * Hits is the number of those workspaces which were served from a prebuilt
+	 * workspace.
+	 */
+	readonly hits: number;
+	readonly hit_rate: number;
+	/**
+	 * ClaimLatencyMS is the time taken to claim a prebuilt workspace, or to
+	 * find there is none to claim.
+	 */
+	readonly claim_latency_ms: PrebuildInsightsPercentiles;
+	/**
+	 * HitTimeToReadySeconds is the time from the creation of a workspace served
+	 * from a prebuilt workspace until its agents were ready.
+	 */
+	readonly hit_time_to_ready_seconds: PrebuildInsightsPercentiles;
+	/**
+	 * MissTimeToReadySeconds is the time from the creation of a workspace built
+	 * from scratch until its agents were ready.
+	 */
+	readonly miss_time_to_ready_seconds: PrebuildInsightsPercentiles;
+}
+
 // From codersdk/parameters.go
 export interface PreviewParameter extends PreviewParameterData {
 	readonly value: NullHCLString;
@@ -4044,6 +4678,7 @@ export type ResourceType =
 	| "idp_sync_settings_organization"
 	| "idp_sync_settings_role"
 	| "license"
+	| "mcp_tool"
 	| "notification_template"
 	| "notifications_settings"
 	| "oauth2_provider_app"
@@ -4072,6 +4707,7 @@ export const ResourceTypes: ResourceType[] = [
 	"idp_sync_settings_organization",
 	"idp_sync_settings_role",
 	"license",
+	"mcp_tool",
 	"notification_template",
 	"notifications_settings",
 	"oauth2_provider_app",
@@ -4119,6 +4755,18 @@ export interface Response {
 	readonly validations?: readonly ValidationError[];
 }
 
+// From codersdk/deployment.go
+/**
+ * RetentionConfig is how long records are kept in the database before they are
+ * purged. A zero duration keeps records forever.
+ */
+export interface RetentionConfig {
+	readonly audit_logs: number;
+	readonly connection_logs: number;
+	readonly session_recordings: number;
+	readonly aibridge_captures: number;
+}
+
 // From codersdk/roles.go
 /**
  * Role is a longer form of SlimRole that includes permissions details.
@@ -4215,6 +4863,19 @@ export const RoleTemplateAdmin = "template-admin";
  */
 export const RoleUserAdmin = "user-admin";
 
+// From codersdk/notifications.go
+export interface RotateNotificationWebhookSecretRequest {
+	/**
+	 * Secret is the new shared secret. A random secret is generated if it is omitted.
+	 */
+	readonly secret?: string;
+	/**
+	 * GracePeriodMillis is how long the current secret continues to sign deliveries alongside the new one, so that
+	 * receivers can be updated without rejecting deliveries. Defaults to 24 hours.
+	 */
+	readonly grace_period_ms?: number;
+}
+
 // From codersdk/deployment.go
 /**
  * SSHConfig is configuration the cli & vscode extension use for configuring
@@ -4702,12 +5363,37 @@ export interface Task {
 	readonly workspace_agent_health: WorkspaceAgentHealth | null;
 	readonly workspace_app_id: string | null;
 	readonly initial_prompt: string;
+	readonly steps?: readonly TaskStep[];
 	readonly status: WorkspaceStatus;
 	readonly current_state: TaskStateEntry | null;
 	readonly created_at: string;
 	readonly updated_at: string;
 }
 
+// From codersdk/aitasks.go
+/**
+ * TaskEvent is a change to a watched task.
+ *
+ * Experimental: This type is experimental and may change in the future.
+ */
+export interface TaskEvent {
+	readonly type: TaskEventType;
+	readonly task_id: string;
+	/**
+	 * Task is set for status and state events.
+	 */
+	readonly task?: Task;
+	/**
+	 * Log is set for log events.
+	 */
+	readonly log?: TaskLogEntry;
+}
+
+// From codersdk/aitasks.go
+export type TaskEventType = "log" | "state" | "status";
+
+export const TaskEventTypes: TaskEventType[] = ["log", "state", "status"];
+
 // From codersdk/aitasks.go
 /**
  * TaskLogEntry represents a single log entry for a task.
@@ -4769,6 +5455,41 @@ export const TaskStates: TaskState[] = [
 	"working",
 ];
 
+// From codersdk/aitasks.go
+/**
+ * TaskStep represents a single step of a multi-step task.
+ *
+ * Experimental: This type is experimental and may change in the future.
+ */
+export interface TaskStep {
+	readonly index: number;
+	readonly prompt: string;
+	readonly completion_check?: string;
+	readonly status: TaskStepStatus;
+	/**
+	 * CheckExitCode is the exit code of the completion check, if it has run.
+	 */
+	readonly check_exit_code?: number;
+	readonly started_at?: string;
+	readonly completed_at?: string;
+}
+
+// From codersdk/aitasks.go
+export type TaskStepStatus =
+	| "checking"
+	| "completed"
+	| "failed"
+	| "pending"
+	| "running";
+
+export const TaskStepStatuses: TaskStepStatus[] = [
+	"checking",
+	"completed",
+	"failed",
+	"pending",
+	"running",
+];
+
 // From codersdk/aitasks.go
 /**
  * TasksFilter filters the list of tasks.
@@ -4850,6 +5571,11 @@ export interface Template {
 	readonly max_port_share_level: WorkspaceAgentPortShareLevel;
 	readonly cors_behavior: CORSBehavior;
 	readonly use_classic_parameter_flow: boolean;
+	/**
+	 * RecordSessions records the terminal output of SSH and web terminal
+	 * sessions to workspaces created from this template.
+	 */
+	readonly record_sessions: boolean;
 }
 
 // From codersdk/templates.go
@@ -5216,6 +5942,11 @@ export interface TransitionStats {
 	readonly P95: number | null;
 }
 
+// From codersdk/aibridge.go
+export interface UpdateAIBridgeModelPricesRequest {
+	readonly prices: readonly AIBridgeModelPrice[];
+}
+
 // From codersdk/templates.go
 export interface UpdateActiveTemplateVersion {
 	readonly id: string;
@@ -5272,6 +6003,21 @@ export interface UpdateNotificationTemplateMethod {
 	readonly method?: string;
 }
 
+// From codersdk/notifications.go
+/**
+ * UpdateNotificationTemplateOverrides customizes a notification template for this deployment. Both templates use the
+ * same markdown and Go templating syntax as the built-in templates. An empty template reverts to the built-in one.
+ */
+export interface UpdateNotificationTemplateOverrides {
+	readonly title_template?: string;
+	readonly body_template?: string;
+}
+
+// From codersdk/notifications.go
+export interface UpdateNotificationTemplateUrgency {
+	readonly urgent: boolean;
+}
+
 // From codersdk/organizations.go
 export interface UpdateOrganizationRequest {
 	readonly name?: string;
@@ -5371,6 +6117,12 @@ export interface UpdateTemplateMeta {
 	 * An "opt-out" is present in case the new feature breaks some existing templates.
 	 */
 	readonly use_classic_parameter_flow?: boolean;
+	/**
+	 * RecordSessions records the terminal output of SSH and web terminal
+	 * sessions to workspaces created from this template. Recordings can be
+	 * played back from the matching connection log.
+	 */
+	readonly record_sessions?: boolean;
 }
 
 // From codersdk/users.go
@@ -5382,6 +6134,26 @@ export interface UpdateUserAppearanceSettingsRequest {
 // From codersdk/notifications.go
 export interface UpdateUserNotificationPreferences {
 	readonly template_disabled_map: Record<string, boolean>;
+	/**
+	 * TemplateTargetMap maps notification template IDs to the ID of one of the user's notification targets.
+	 * An empty target ID clears the target, reverting to the template's or deployment's notification method.
+	 */
+	readonly template_target_map?: Record<string, string>;
+	/**
+	 * TemplateDigestMap maps notification template IDs to whether their notifications should be held and
+	 * delivered as a single digest, rather than individually.
+	 */
+	readonly template_digest_map?: Record<string, boolean>;
+}
+
+// From codersdk/notifications.go
+export interface UpdateUserNotificationQuietHoursRequest {
+	readonly enabled: boolean;
+	/**
+	 * SnoozeUntil defers all non-urgent notifications until the given time, which must be within 72 hours. Omit it,
+	 * or give a time in the past, to stop snoozing.
+	 */
+	readonly snooze_until?: string;
 }
 
 // From codersdk/users.go
@@ -5438,6 +6210,15 @@ export interface UpdateWorkspaceAutomaticUpdatesRequest {
 	readonly automatic_updates: AutomaticUpdates;
 }
 
+// From codersdk/autostartexceptions.go
+/**
+ * UpdateWorkspaceAutostartExceptionsRequest opts a workspace in or out of its
+ * organization's autostart exception calendars.
+ */
+export interface UpdateWorkspaceAutostartExceptionsRequest {
+	readonly ignore: boolean;
+}
+
 // From codersdk/workspaces.go
 /**
  * UpdateWorkspaceAutostartRequest is a request to update a workspace's autostart schedule.
@@ -5471,6 +6252,15 @@ export interface UpdateWorkspaceRequest {
 	readonly name?: string;
 }
 
+// From codersdk/workspaceschedulewindows.go
+/**
+ * UpdateWorkspaceScheduleWindowsRequest replaces the additional autostart
+ * windows of a workspace.
+ */
+export interface UpdateWorkspaceScheduleWindowsRequest {
+	readonly windows: readonly WorkspaceScheduleWindow[];
+}
+
 // From codersdk/workspaces.go
 /**
  * UpdateWorkspaceTTLRequest is a request to update a workspace's TTL.
@@ -5487,6 +6277,23 @@ export interface UploadResponse {
 	readonly hash: string;
 }
 
+// From codersdk/aibridge.go
+export interface UpsertAIBridgeLimitRequest {
+	readonly daily_token_budget?: number;
+	readonly monthly_token_budget?: number;
+	readonly requests_per_minute?: number;
+}
+
+// From codersdk/autostartexceptions.go
+/**
+ * UpsertAutostartExceptionCalendarRequest creates or replaces an autostart
+ * exception calendar.
+ */
+export interface UpsertAutostartExceptionCalendarRequest {
+	readonly description: string;
+	readonly exceptions: readonly AutostartException[];
+}
+
 // From codersdk/workspaceagentportshare.go
 export interface UpsertWorkspaceAgentPortShareRequest {
 	readonly agent_name: string;
@@ -5612,6 +6419,32 @@ export interface UserLoginType {
 	readonly login_type: LoginType;
 }
 
+// From codersdk/notifications.go
+/**
+ * UserNotificationQuietHours controls when a user's non-urgent notifications are deferred. Deferred notifications are
+ * delivered once the quiet hours or snooze end, rather than being dropped. Urgent notifications and Coder Inbox are
+ * never deferred.
+ */
+export interface UserNotificationQuietHours {
+	/**
+	 * Enabled defers non-urgent notifications during the user's quiet hours.
+	 */
+	readonly enabled: boolean;
+	/**
+	 * Schedule is the start of the user's quiet hours, as a cron expression. It is empty when quiet hours schedules
+	 * are not available, in which case Enabled has no effect.
+	 */
+	readonly schedule: string;
+	/**
+	 * DurationMillis is how long quiet hours last from the start of each window.
+	 */
+	readonly duration_ms: number;
+	/**
+	 * SnoozeUntil defers all non-urgent notifications until the given time.
+	 */
+	readonly snooze_until?: string;
+}
+
 // From codersdk/users.go
 export interface UserParameter {
 	readonly name: string;
@@ -5706,6 +6539,22 @@ export interface VariableValue {
 	readonly value: string;
 }
 
+// From codersdk/webhooksignature.go
+/**
+ * WebhookSignatureHeader is the header carrying the signature of a
+ * webhook notification delivery. Its value has the form
+ * "t=<unix seconds>,v1=<hex>[,v1=<hex>...]", with one "v1" entry per
+ * secret that was valid at the time of delivery.
+ */
+export const WebhookSignatureHeader = "X-Coder-Signature";
+
+// From codersdk/webhooksignature.go
+/**
+ * WebhookSignatureScheme identifies the signing scheme: HMAC-SHA256 over
+ * "<timestamp>.<body>".
+ */
+export const WebhookSignatureScheme = "v1";
+
 // From codersdk/notifications.go
 export interface WebpushMessage {
 	readonly icon: string;
@@ -6288,6 +7137,23 @@ export const WorkspaceAppStatusStates: WorkspaceAppStatusState[] = [
 	"working",
 ];
 
+// From codersdk/autostartexceptions.go
+/**
+ * WorkspaceAutostartExceptions describes the autostart exceptions that apply
+ * to a workspace.
+ */
+export interface WorkspaceAutostartExceptions {
+	/**
+	 * Ignore is true if the workspace is autostarted even on the days
+	 * excluded by its organization's exception calendars.
+	 */
+	readonly ignore: boolean;
+	/**
+	 * Upcoming are the organization's exceptions that have not yet ended.
+	 */
+	readonly upcoming: readonly AutostartException[];
+}
+
 // From codersdk/workspacebuilds.go
 /**
  * WorkspaceBuild is an at-point representation of a workspace state.
@@ -6387,6 +7253,92 @@ export interface WorkspaceHealth {
 	readonly failing_agents: readonly string[]; // FailingAgents lists the IDs of the agents that are failing, if any.
 }
 
+// From codersdk/workspacelifecycle.go
+/**
+ * WorkspaceLifecycle explains the automatic transitions of a workspace: those
+ * coming up according to its schedule and the template's policies, and the
+ * recent decisions of the autobuild lifecycle executor.
+ */
+export interface WorkspaceLifecycle {
+	/**
+	 * DryRun is true if the deployment computes transitions without executing
+	 * them.
+	 */
+	readonly dry_run: boolean;
+	/**
+	 * Upcoming transitions, ordered by time.
+	 */
+	readonly upcoming: readonly WorkspaceLifecycleTransition[];
+	/**
+	 * Decisions made recently, most recent first. Decisions are only kept
+	 * for a few days.
+	 */
+	readonly decisions: readonly WorkspaceLifecycleDecision[];
+}
+
+// From codersdk/workspacelifecycle.go
+/**
+ * WorkspaceLifecycleCheck is the result of one of the eligibility checks made
+ * by the autobuild lifecycle executor, such as whether the workspace's
+ * deadline has passed.
+ */
+export interface WorkspaceLifecycleCheck {
+	readonly check: string;
+	readonly passed: boolean;
+	readonly detail: string;
+}
+
+// From codersdk/workspacelifecycle.go
+/**
+ * WorkspaceLifecycleDecision is a decision made by the autobuild lifecycle
+ * executor about a workspace on one of its ticks.
+ */
+export interface WorkspaceLifecycleDecision {
+	readonly id: string;
+	readonly created_at: string;
+	readonly outcome: WorkspaceLifecycleOutcome;
+	/**
+	 * Transition is empty if the workspace was not eligible for a
+	 * transition, or was only marked dormant.
+	 */
+	readonly transition?: WorkspaceTransition;
+	readonly reason?: BuildReason;
+	/**
+	 * Checks are the eligibility checks evaluated, in order.
+	 */
+	readonly checks: readonly WorkspaceLifecycleCheck[];
+	/**
+	 * Detail explains why the decision was skipped or failed.
+	 */
+	readonly detail?: string;
+}
+
+// From codersdk/workspacelifecycle.go
+export type WorkspaceLifecycleOutcome =
+	| "dry_run"
+	| "failed"
+	| "skipped"
+	| "transitioned";
+
+export const WorkspaceLifecycleOutcomes: WorkspaceLifecycleOutcome[] = [
+	"dry_run",
+	"failed",
+	"skipped",
+	"transitioned",
+];
+
+// From codersdk/workspacelifecycle.go
+/**
+ * WorkspaceLifecycleTransition is an upcoming automatic transition of a
+ * workspace. It happens on the first tick of the autobuild lifecycle
+ * executor after At, unless the workspace changes before then.
+ */
+export interface WorkspaceLifecycleTransition {
+	readonly transition?: WorkspaceTransition;
+	readonly reason: BuildReason;
+	readonly at: string;
+}
+
 // From codersdk/workspaces.go
 export interface WorkspaceOptions {
 	readonly include_deleted?: boolean;
@@ -6482,6 +7434,26 @@ export type WorkspaceRole = "admin" | "" | "use";
 
 export const WorkspaceRoles: WorkspaceRole[] = ["admin", "", "use"];
 
+// From codersdk/workspaceschedulewindows.go
+/**
+ * WorkspaceScheduleWindow is an additional autostart window of a workspace.
+ * The workspace's own autostart schedule and TTL form its first window. When
+ * windows start at the same time, the earlier window takes precedence.
+ */
+export interface WorkspaceScheduleWindow {
+	/**
+	 * Schedule is expected to be of the form `CRON_TZ=<IANA Timezone> <min> <hour> * * <dow>`
+	 * Example: `CRON_TZ=US/Central 30 9 * * 1-5` represents 0930 in the timezone US/Central
+	 * on weekdays (Mon-Fri). `CRON_TZ` defaults to UTC if not present.
+	 */
+	readonly schedule: string;
+	/**
+	 * TTLMillis is how long workspaces started by the window stay running.
+	 * If nil, autostop is disabled for them.
+	 */
+	readonly ttl_ms?: number;
+}
+
 // From codersdk/workspacebuilds.go
 export type WorkspaceStatus =
 	| "canceled"
@@ -846,6 +846,7 @@ export const MockTemplate: TypesGen.Template = {
 	max_port_share_level: "public",
 	use_classic_parameter_flow: false,
 	cors_behavior: "simple",
+	record_sessions: false,
 };
 
 const _MockTemplateVersionFiles: TemplateVersionFiles = {
@@ -4550,36 +4551,43 @@ export const MockNotificationPreferences: TypesGen.NotificationPreference[] = [
 	{
 		id: "f44d9314-ad03-4bc8-95d0-5cad491da6b6",
 		disabled: false,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "381df2a9-c0c0-4749-420f-80a9280c66f9",
 		disabled: true,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "f517da0b-cdc9-410f-ab89-a86107c420ed",
 		disabled: false,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "c34a0c09-0704-4cac-bd1c-0c0146811c2b",
 		disabled: false,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "0ea69165-ec14-4314-91f1-69566ac3c5a0",
 		disabled: false,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "51ce2fdf-c9ca-4be1-8d70-628674f9bc42",
 		disabled: false,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 	{
 		id: "4e19c0ac-94e1-4532-9515-d1801aa283b2",
 		disabled: true,
+		digest: false,
 		updated_at: "2024-08-06T11:58:37.755053Z",
 	},
 ];
@@ -4598,6 +4606,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "webhook",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "f517da0b-cdc9-410f-ab89-a86107c420ed",
@@ -4611,6 +4620,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "smtp",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "f44d9314-ad03-4bc8-95d0-5cad491da6b6",
@@ -4624,6 +4634,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "4e19c0ac-94e1-4532-9515-d1801aa283b2",
@@ -4637,6 +4648,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "0ea69165-ec14-4314-91f1-69566ac3c5a0",
@@ -4650,6 +4662,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "smtp",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "c34a0c09-0704-4cac-bd1c-0c0146811c2b",
@@ -4663,6 +4676,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "smtp",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "51ce2fdf-c9ca-4be1-8d70-628674f9bc42",
@@ -4676,6 +4690,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "webhook",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "template-event-1",
@@ -4689,6 +4704,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "smtp",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "template-event-2",
@@ -4702,6 +4718,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "webhook",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "d4a6271c-cced-4ed0-84ad-afd02a9c7799",
@@ -4714,6 +4731,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 		{
 			id: "bd4b7168-d05e-4e19-ad0f-3593b77aa90f",
@@ -4727,6 +4745,7 @@ export const MockSystemNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "",
 			kind: "system",
 			enabled_by_default: true,
+			urgent: false,
 		},
 	];
 
@@ -4742,6 +4761,7 @@ export const MockCustomNotificationTemplates: TypesGen.NotificationTemplate[] =
 			method: "",
 			kind: "custom",
 			enabled_by_default: true,
+			urgent: false,
 		},
 	];
 
@@ -91,12 +91,22 @@ export const getDisplayWorkspaceBuildInitiatedBy = (
 		case "autostart":
 		case "autostop":
 		case "dormancy":
+		case "autodelete":
+		case "task_autostop":
+		case "task_autodelete":
 			return "Coder";
 	}
 	return undefined;
 };
 
-export const systemBuildReasons = ["autostart", "autostop", "dormancy"];
+export const systemBuildReasons = [
+	"autostart",
+	"autostop",
+	"dormancy",
+	"autodelete",
+	"task_autostop",
+	"task_autodelete",
+];
 
 export const buildReasonLabels: Record<TypesGen.BuildReason, string> = {
 	// User build reasons
@@ -111,6 +121,9 @@ export const buildReasonLabels: Record<TypesGen.BuildReason, string> = {
 	autostart: "Autostart",
 	autostop: "Autostop",
 	dormancy: "Dormancy",
+	autodelete: "Autodelete",
+	task_autostop: "Task Autostop",
+	task_autodelete: "Task Autodelete",
 };
 
 const getWorkspaceBuildDurationInSeconds = (